@@ -0,0 +1,319 @@
+package api
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/kimitzu/kimitzu-go/repo"
+	"github.com/kimitzu/kimitzu-go/schema"
+)
+
+// minAPIPasswordLength is the shortest password the admin API accepts
+const minAPIPasswordLength = 8
+
+// apiAuthState is a snapshot of the credentials requests are checked against
+type apiAuthState struct {
+	Authenticated bool
+	Username      string
+	// Password is the hex encoded SHA256 hash of the password
+	Password string
+	Cookie   http.Cookie
+}
+
+// validBasicAuth reports whether the request carries the API username and
+// password. It is false when no username and password are set.
+func (s apiAuthState) validBasicAuth(r *http.Request) bool {
+	username, password, ok := r.BasicAuth()
+	if !ok || s.Username == "" || s.Password == "" {
+		return false
+	}
+	h := sha256.Sum256([]byte(password))
+	return username == s.Username && strings.EqualFold(hex.EncodeToString(h[:]), s.Password)
+}
+
+// validCookie reports whether the request carries the authentication cookie
+func (s apiAuthState) validCookie(r *http.Request) bool {
+	cookie, err := r.Cookie("OpenBazaar_Auth_Cookie")
+	return err == nil && s.Cookie.Value != "" && cookie.Value == s.Cookie.Value
+}
+
+// apiCredentials holds the API credentials shared by the JSON API and the
+// websocket so the admin API can change them while the node is running
+type apiCredentials struct {
+	mtx   sync.RWMutex
+	state apiAuthState
+	// remote is set when the API listens on a non-loopback address, in
+	// which case authentication cannot be turned off
+	remote bool
+}
+
+func newAPICredentials(authCookie http.Cookie, config schema.APIConfig, addr net.Addr) *apiCredentials {
+	c := &apiCredentials{
+		state: apiAuthState{
+			Authenticated: config.Authenticated,
+			Username:      config.Username,
+			Password:      config.Password,
+			Cookie:        authCookie,
+		},
+	}
+	if addr != nil {
+		host, _, err := net.SplitHostPort(addr.String())
+		if err != nil {
+			host = addr.String()
+		}
+		ip := net.ParseIP(host)
+		c.remote = ip == nil || !ip.IsLoopback()
+	}
+	return c
+}
+
+func (c *apiCredentials) get() apiAuthState {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	return c.state
+}
+
+// update applies the change to a copy of the credentials and keeps it if
+// fn succeeds. Changes are serialized so concurrent admin requests cannot
+// overwrite each other.
+func (c *apiCredentials) update(fn func(s *apiAuthState) error) (apiAuthState, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	s := c.state
+	if err := fn(&s); err != nil {
+		return c.state, err
+	}
+	c.state = s
+	return s, nil
+}
+
+// saveAPICredentials writes the credentials to the JSON-API section of the
+// config file. The file is replaced atomically so a crash cannot leave the
+// node with a truncated config.
+func saveAPICredentials(repoPath string, s apiAuthState) error {
+	cfgPath := path.Join(repoPath, "config")
+	configFile, err := ioutil.ReadFile(cfgPath)
+	if err != nil {
+		return err
+	}
+	configJSON := make(map[string]interface{})
+	if err := json.Unmarshal(configFile, &configJSON); err != nil {
+		return err
+	}
+	apiCfg, err := schema.GetAPIConfig(configFile)
+	if err != nil {
+		return err
+	}
+	apiCfg.Authenticated = s.Authenticated
+	apiCfg.Username = s.Username
+	apiCfg.Password = s.Password
+	if len(apiCfg.AllowedIPs) == 0 {
+		apiCfg.AllowedIPs = []string{}
+	}
+	configJSON["JSON-API"] = apiCfg
+
+	out, err := json.MarshalIndent(configJSON, "", "    ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(cfgPath, out)
+}
+
+// saveAuthCookie writes the authentication cookie to the cookie file the
+// node reads on startup
+func saveAuthCookie(repoPath string, cookie http.Cookie) error {
+	return writeFileAtomic(path.Join(repoPath, ".cookie"), []byte(cookie.Name+"="+cookie.Value))
+}
+
+// writeFileAtomic replaces the file by writing a temporary file next to it
+// and renaming it over the original. The permissions of the original file
+// are kept.
+func writeFileAtomic(name string, data []byte) error {
+	perm := os.FileMode(0600)
+	if info, err := os.Stat(name); err == nil {
+		perm = info.Mode().Perm()
+	}
+	f, err := ioutil.TempFile(filepath.Dir(name), "."+filepath.Base(name)+".tmp")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		os.Remove(tmp)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if err := os.Chmod(tmp, perm); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if err := os.Rename(tmp, name); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return nil
+}
+
+type adminAuthResponse struct {
+	Authenticated bool   `json:"authenticated"`
+	Username      string `json:"username"`
+	RepoPath      string `json:"repoPath"`
+	OBVersion     string `json:"obVersion"`
+}
+
+func (i *jsonAPIHandler) writeAdminAuth(w http.ResponseWriter) {
+	s := i.credentials.get()
+	ret, err := json.MarshalIndent(adminAuthResponse{
+		Authenticated: s.Authenticated,
+		Username:      s.Username,
+		RepoPath:      i.node.RepoPath,
+		OBVersion:     i.node.Version,
+	}, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+// notifyAPICredentialsChanged emits the audit notification for a change
+// made through the admin API
+func (i *jsonAPIHandler) notifyAPICredentialsChanged(r *http.Request, change string, s apiAuthState) {
+	n := repo.APICredentialsNotification{
+		ID:            repo.NewNotificationID(),
+		Type:          repo.NotifierTypeAPICredentialsChanged,
+		Change:        change,
+		Username:      s.Username,
+		Authenticated: s.Authenticated,
+		RemoteAddr:    r.RemoteAddr,
+	}
+	log.Noticef("API %s changed from %s", change, r.RemoteAddr)
+	i.node.Broadcast <- n
+	if err := i.node.Datastore.Notifications().PutRecord(repo.NewNotification(n, time.Now(), false)); err != nil {
+		log.Errorf("saving API credentials notification: %s", err.Error())
+	}
+}
+
+// GETAdminAuth returns how the API is authenticated. The password hash and
+// the cookie are never returned.
+func (i *jsonAPIHandler) GETAdminAuth(w http.ResponseWriter, r *http.Request) {
+	i.writeAdminAuth(w)
+}
+
+type adminCredentialsRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// PUTAdminCredentials rotates the API username and password and turns on
+// authentication
+func (i *jsonAPIHandler) PUTAdminCredentials(w http.ResponseWriter, r *http.Request) {
+	var req adminCredentialsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	req.Username = strings.TrimSpace(req.Username)
+	if req.Username == "" || strings.ContainsAny(req.Username, ":\r\n") {
+		ErrorResponse(w, http.StatusBadRequest, "username must be set and may not contain colons or line breaks")
+		return
+	}
+	if len(req.Password) < minAPIPasswordLength {
+		ErrorResponse(w, http.StatusBadRequest, "password must be at least 8 characters")
+		return
+	}
+	h := sha256.Sum256([]byte(req.Password))
+	s, err := i.credentials.update(func(s *apiAuthState) error {
+		s.Username = req.Username
+		s.Password = hex.EncodeToString(h[:])
+		s.Authenticated = true
+		return saveAPICredentials(i.node.RepoPath, *s)
+	})
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	i.notifyAPICredentialsChanged(r, "credentials", s)
+	i.writeAdminAuth(w)
+}
+
+type adminCookieResponse struct {
+	Cookie string `json:"cookie"`
+}
+
+// POSTAdminCookie replaces the authentication cookie. The old cookie stops
+// working right away, the new one is returned once.
+func (i *jsonAPIHandler) POSTAdminCookie(w http.ResponseWriter, r *http.Request) {
+	authBytes := make([]byte, 32)
+	if _, err := rand.Read(authBytes); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	s, err := i.credentials.update(func(s *apiAuthState) error {
+		s.Cookie.Value = base58.Encode(authBytes)
+		return saveAuthCookie(i.node.RepoPath, s.Cookie)
+	})
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	i.notifyAPICredentialsChanged(r, "cookie", s)
+	ret, err := json.MarshalIndent(adminCookieResponse{Cookie: s.Cookie.Value}, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+type adminAuthenticationRequest struct {
+	Authenticated *bool `json:"authenticated"`
+}
+
+// PUTAdminAuthentication turns authentication of the API on or off
+func (i *jsonAPIHandler) PUTAdminAuthentication(w http.ResponseWriter, r *http.Request) {
+	var req adminAuthenticationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if req.Authenticated == nil {
+		ErrorResponse(w, http.StatusBadRequest, "authenticated must be set")
+		return
+	}
+	if !*req.Authenticated && i.credentials.remote {
+		ErrorResponse(w, http.StatusConflict, "authentication cannot be turned off while the API listens on a public address")
+		return
+	}
+	s, err := i.credentials.update(func(s *apiAuthState) error {
+		s.Authenticated = *req.Authenticated
+		return saveAPICredentials(i.node.RepoPath, *s)
+	})
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	i.notifyAPICredentialsChanged(r, "authentication", s)
+	i.writeAdminAuth(w)
+}
@@ -0,0 +1,133 @@
+package api
+
+import (
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/kimitzu/kimitzu-go/schema"
+	"github.com/kimitzu/kimitzu-go/test"
+)
+
+func TestAPIAuthStateValid(t *testing.T) {
+	s := apiAuthState{
+		Username: "test",
+		Password: "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08", // sha256("test")
+		Cookie:   http.Cookie{Name: "OpenBazaar_Auth_Cookie", Value: "supersecret"},
+	}
+	r := httptest.NewRequest("GET", "/ob/admin/auth", nil)
+	if s.validBasicAuth(r) || s.validCookie(r) {
+		t.Error("request without credentials should not be valid")
+	}
+	r.SetBasicAuth("test", "wrong")
+	r.AddCookie(&http.Cookie{Name: "OpenBazaar_Auth_Cookie", Value: "stale"})
+	if s.validBasicAuth(r) || s.validCookie(r) {
+		t.Error("wrong credentials should not be valid")
+	}
+
+	r = httptest.NewRequest("GET", "/ob/admin/auth", nil)
+	r.SetBasicAuth("test", "test")
+	r.AddCookie(&http.Cookie{Name: "OpenBazaar_Auth_Cookie", Value: "supersecret"})
+	if !s.validBasicAuth(r) || !s.validCookie(r) {
+		t.Error("expected the credentials to be valid")
+	}
+
+	s.Username, s.Password, s.Cookie.Value = "", "", ""
+	r = httptest.NewRequest("GET", "/ob/admin/auth", nil)
+	r.SetBasicAuth("", "")
+	r.AddCookie(&http.Cookie{Name: "OpenBazaar_Auth_Cookie", Value: ""})
+	if s.validBasicAuth(r) || s.validCookie(r) {
+		t.Error("empty credentials should never be valid")
+	}
+}
+
+func TestNewAPICredentialsRemote(t *testing.T) {
+	for _, c := range []struct {
+		addr   string
+		remote bool
+	}{
+		{"127.0.0.1:4002", false},
+		{"[::1]:4002", false},
+		{"0.0.0.0:4002", true},
+		{"192.168.1.10:4002", true},
+	} {
+		addr, err := net.ResolveTCPAddr("tcp", c.addr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if remote := newAPICredentials(http.Cookie{}, schema.APIConfig{}, addr).remote; remote != c.remote {
+			t.Errorf("%s: expected remote %t, got %t", c.addr, c.remote, remote)
+		}
+	}
+}
+
+func TestSaveAPICredentials(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ob_admin_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	config, err := ioutil.ReadFile(path.Join(test.GetRepoPath(), "config"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfgPath := path.Join(dir, "config")
+	if err := ioutil.WriteFile(cfgPath, config, 0640); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := saveAPICredentials(dir, apiAuthState{Authenticated: true, Username: "admin", Password: "hash"}); err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadFile(cfgPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	apiCfg, err := schema.GetAPIConfig(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !apiCfg.Authenticated || apiCfg.Username != "admin" || apiCfg.Password != "hash" {
+		t.Errorf("unexpected API config %+v", apiCfg)
+	}
+	info, err := os.Stat(cfgPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0640 {
+		t.Errorf("expected the file mode to be kept, got %s", info.Mode())
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Errorf("expected no temporary files to be left, found %d files", len(files))
+	}
+}
+
+func TestAdminAuth(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/admin/auth", "", 200, anyResponseJSON},
+		{"PUT", "/ob/admin/authentication", `{}`, 400, `{"success": false, "reason": "authenticated must be set"}`},
+		{"PUT", "/ob/admin/credentials", `{"username": "admin", "password": "short"}`, 400, `{"success": false, "reason": "password must be at least 8 characters"}`},
+	})
+
+	// Admin routes need the credentials even for local requests
+	req, err := http.NewRequest("GET", testURIRoot+"/ob/admin/auth", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := testHTTPClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected status 401, got %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,46 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+// apiTokenLastUsedInterval limits how often the last use of a token is
+// written to the database
+const apiTokenLastUsedInterval = time.Minute
+
+// bearerAPIToken returns the API token the request carries in its
+// Authorization header, if any. Basic auth credentials are not tokens.
+func bearerAPIToken(r *http.Request) (string, bool) {
+	auth := r.Header.Get("Authorization")
+	if len(auth) < 7 || !strings.EqualFold(auth[:7], "Bearer ") {
+		return "", false
+	}
+	token := strings.TrimSpace(auth[7:])
+	return token, strings.HasPrefix(token, repo.APITokenPrefix)
+}
+
+// authorizeAPIToken checks the token against the scope of the requested
+// route and returns its record. It writes the error response and returns
+// false if the request may not go through.
+func (i *jsonAPIHandler) authorizeAPIToken(w http.ResponseWriter, r *http.Request, token, p string) (*repo.APITokenRecord, bool) {
+	record, err := i.node.Datastore.APITokens().GetByHash(repo.HashAPIToken(token))
+	now := time.Now()
+	if err != nil || record.Expired(now) {
+		ErrorResponse(w, http.StatusUnauthorized, "invalid or expired API token")
+		return nil, false
+	}
+	if !i.router.tokenAllowed(record, r.Method, p) {
+		ErrorResponse(w, http.StatusForbidden, "API token is not allowed to use this endpoint")
+		return nil, false
+	}
+	if now.Sub(record.LastUsed) >= apiTokenLastUsedInterval {
+		if err := i.node.Datastore.APITokens().UpdateLastUsed(record.TokenID, now); err != nil {
+			log.Errorf("updating last use of API token %s: %s", record.TokenID, err.Error())
+		}
+	}
+	return record, true
+}
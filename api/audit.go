@@ -0,0 +1,208 @@
+package api
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+// auditErrorBodyLimit is how much of an error response is kept to record
+// its reason
+const auditErrorBodyLimit = 1024
+
+// auditedMethods are the methods of the state-changing calls which are
+// recorded in the audit log
+var auditedMethods = map[string]bool{
+	"POST":   true,
+	"PUT":    true,
+	"PATCH":  true,
+	"DELETE": true,
+}
+
+// actor names who made the request for the audit log
+func (s apiAuthState) actor(r *http.Request) string {
+	switch {
+	case s.validBasicAuth(r):
+		return "user:" + s.Username
+	case s.validCookie(r):
+		return "cookie"
+	case strings.HasPrefix(r.RemoteAddr, "127.0.0.1"):
+		return "local"
+	}
+	return "anonymous"
+}
+
+// auditResponseWriter keeps the status code of the response and the start
+// of error responses
+type auditResponseWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *auditResponseWriter) WriteHeader(code int) {
+	if w.status == 0 {
+		w.status = code
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *auditResponseWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	if w.status >= http.StatusBadRequest && w.body.Len() < auditErrorBodyLimit {
+		n := auditErrorBodyLimit - w.body.Len()
+		if n > len(b) {
+			n = len(b)
+		}
+		w.body.Write(b[:n])
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// result describes the outcome of the request for the audit log
+func (w *auditResponseWriter) result() (bool, string) {
+	if w.status == 0 {
+		return false, "no response"
+	}
+	result := strconv.Itoa(w.status) + " " + http.StatusText(w.status)
+	if w.status < http.StatusBadRequest {
+		return true, result
+	}
+	var apiErr struct {
+		Reason string `json:"reason"`
+	}
+	if json.Unmarshal(w.body.Bytes(), &apiErr) == nil && apiErr.Reason != "" {
+		result += ": " + apiErr.Reason
+	}
+	return false, result
+}
+
+// auditRequestHash hashes the method, path, query and body of the request.
+// The body is put back so the handler can still read it.
+func auditRequestHash(r *http.Request) (string, error) {
+	var body []byte
+	if r.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(r.Body)
+		r.Body.Close()
+		if err != nil {
+			return "", err
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	h := sha256.New()
+	fmt.Fprintf(h, "%s %s?%s\n", r.Method, r.URL.Path, r.URL.RawQuery)
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// serveAudited serves a state-changing request and appends it to the audit
+// log, also when the handler panics
+func (i *jsonAPIHandler) serveAudited(w http.ResponseWriter, r *http.Request, p, actor string) {
+	hash, err := auditRequestHash(r)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	aw := &auditResponseWriter{ResponseWriter: w}
+	defer func() {
+		record := &repo.AuditRecord{
+			Timestamp:  time.Now(),
+			Source:     repo.AuditSourceAPI,
+			Actor:      actor,
+			RemoteAddr: r.RemoteAddr,
+			Action:     r.Method + " " + p,
+			ParamsHash: hash,
+		}
+		record.Success, record.Result = aw.result()
+		if err := i.node.Datastore.AuditLog().Put(record); err != nil {
+			log.Errorf("writing %s to the audit log: %s", record.Action, err.Error())
+		}
+	}()
+	i.router.serve(i, aw, r, p)
+}
+
+// parseAuditQuery reads the audit log filters from the query string
+func parseAuditQuery(r *http.Request) (repo.AuditQuery, error) {
+	query := r.URL.Query()
+	q := repo.AuditQuery{
+		Source: repo.AuditSource(query.Get("source")),
+		Actor:  query.Get("actor"),
+		Action: query.Get("action"),
+	}
+	switch q.Source {
+	case "", repo.AuditSourceAPI, repo.AuditSourceProtocol:
+	default:
+		return q, fmt.Errorf("unknown audit source: %s", q.Source)
+	}
+	for _, t := range []struct {
+		name string
+		to   *time.Time
+	}{{"since", &q.Since}, {"until", &q.Until}} {
+		if v := query.Get(t.name); v != "" {
+			parsed, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return q, fmt.Errorf("invalid %s: must be an RFC 3339 time", t.name)
+			}
+			*t.to = parsed
+		}
+	}
+	return q, nil
+}
+
+type auditEntryResponse struct {
+	AuditID    int64         `json:"auditId"`
+	Timestamp  *repo.APITime `json:"timestamp"`
+	Source     string        `json:"source"`
+	Actor      string        `json:"actor"`
+	RemoteAddr string        `json:"remoteAddr,omitempty"`
+	Action     string        `json:"action"`
+	Target     string        `json:"target,omitempty"`
+	ParamsHash string        `json:"paramsHash"`
+	Success    bool          `json:"success"`
+	Result     string        `json:"result"`
+}
+
+func newAuditEntryResponse(record *repo.AuditRecord) *auditEntryResponse {
+	return &auditEntryResponse{
+		AuditID:    record.AuditID,
+		Timestamp:  repo.NewAPITime(record.Timestamp),
+		Source:     record.Source.String(),
+		Actor:      record.Actor,
+		RemoteAddr: record.RemoteAddr,
+		Action:     record.Action,
+		Target:     record.Target,
+		ParamsHash: record.ParamsHash,
+		Success:    record.Success,
+		Result:     record.Result,
+	}
+}
+
+// auditCSVHeader is the header row of the CSV export
+var auditCSVHeader = []string{"auditId", "timestamp", "source", "actor", "remoteAddr", "action", "target", "paramsHash", "success", "result"}
+
+func auditCSVRow(record *repo.AuditRecord) []string {
+	return []string{
+		strconv.FormatInt(record.AuditID, 10),
+		record.Timestamp.UTC().Format(time.RFC3339),
+		record.Source.String(),
+		record.Actor,
+		record.RemoteAddr,
+		record.Action,
+		record.Target,
+		record.ParamsHash,
+		strconv.FormatBool(record.Success),
+		record.Result,
+	}
+}
@@ -0,0 +1,109 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestAPIAuthStateActor(t *testing.T) {
+	s := apiAuthState{
+		Username: "test",
+		Password: "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08", // sha256("test")
+		Cookie:   http.Cookie{Name: "OpenBazaar_Auth_Cookie", Value: "supersecret"},
+	}
+	r := httptest.NewRequest("POST", "/ob/listing", nil)
+	r.RemoteAddr = "10.0.0.2:4002"
+	if actor := s.actor(r); actor != "anonymous" {
+		t.Errorf("expected anonymous, got %s", actor)
+	}
+	r.RemoteAddr = "127.0.0.1:4002"
+	if actor := s.actor(r); actor != "local" {
+		t.Errorf("expected local, got %s", actor)
+	}
+	r.AddCookie(&http.Cookie{Name: "OpenBazaar_Auth_Cookie", Value: "supersecret"})
+	if actor := s.actor(r); actor != "cookie" {
+		t.Errorf("expected cookie, got %s", actor)
+	}
+	r.SetBasicAuth("test", "test")
+	if actor := s.actor(r); actor != "user:test" {
+		t.Errorf("expected user:test, got %s", actor)
+	}
+}
+
+func TestAuditRequestHash(t *testing.T) {
+	r := httptest.NewRequest("POST", "/ob/purchase", bytes.NewBufferString(`{"items":[]}`))
+	h1, err := auditRequestHash(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(body) != `{"items":[]}` {
+		t.Errorf("expected the body to be restored, got %s", body)
+	}
+
+	r = httptest.NewRequest("POST", "/ob/purchase", bytes.NewBufferString(`{"items":[{}]}`))
+	h2, err := auditRequestHash(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h1 == h2 {
+		t.Error("expected different bodies to hash differently")
+	}
+}
+
+func TestAuditLog(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"PUT", "/ob/admin/credentials", `{"username": "admin", "password": "short"}`, 400, `{"success": false, "reason": "password must be at least 8 characters"}`},
+		{"GET", "/ob/audit?source=nowhere", "", 400, `{"success": false, "reason": "unknown audit source: nowhere"}`},
+		{"GET", "/ob/audit?since=yesterday", "", 400, `{"success": false, "reason": "invalid since: must be an RFC 3339 time"}`},
+	})
+
+	// The test database outlives the test and the audit log cannot be
+	// cleared, so only the most recent entry is ours
+	b, err := httpGet("/ob/audit?limit=1&action=" + url.QueryEscape("PUT /ob/admin/credentials"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var entries []auditEntryResponse
+	if err := json.Unmarshal(b, &entries); err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 audit entry, got %d", len(entries))
+	}
+	e := entries[0]
+	if e.Actor != "user:test" || e.Source != "api" || e.Success || e.ParamsHash == "" {
+		t.Errorf("unexpected audit entry %+v", e)
+	}
+	if e.Result != "400 Bad Request: password must be at least 8 characters" {
+		t.Errorf("unexpected result %s", e.Result)
+	}
+
+	req, err := buildRequest("GET", "/ob/audit/export", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := testHTTPClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.Header.Get("Content-Type") != "text/csv; charset=utf-8" {
+		t.Errorf("unexpected content type %s", resp.Header.Get("Content-Type"))
+	}
+	csv, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(csv, []byte("auditId,timestamp,source,actor")) || !bytes.Contains(csv, []byte("PUT /ob/admin/credentials")) {
+		t.Errorf("unexpected export %s", csv)
+	}
+}
@@ -2,267 +2,294 @@ package api
 
 import (
 	"net/http"
-	"strings"
+
+	"github.com/kimitzu/kimitzu-go/core"
+	"github.com/kimitzu/kimitzu-go/pb"
+	"github.com/kimitzu/kimitzu-go/repo"
 )
 
-func put(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request) {
-	switch {
-	case strings.HasPrefix(path, "/ob/profile"):
-		i.PUTProfile(w, r)
-	case strings.HasPrefix(path, "/ob/settings"):
-		i.PUTSettings(w, r)
-	case strings.HasPrefix(path, "/ob/moderator"):
-		i.PUTModerator(w, r)
-	case strings.HasPrefix(path, "/ob/listing"):
-		i.PUTListing(w, r)
-	case strings.HasPrefix(path, "/ob/post"):
-		i.PUTPost(w, r)
-	default:
-		ErrorResponse(w, http.StatusNotFound, "Not Found")
-	}
-}
+var (
+	useCacheParam = routeParam{Name: "usecache", Type: paramBoolean, Description: "Serve a cached copy when the peer cannot be reached"}
+	limitParam    = routeParam{Name: "limit", Type: paramInteger, Description: "Maximum number of results, -1 for all"}
+	offsetIDParam = routeParam{Name: "offsetId", Type: paramString, Description: "ID of the last result of the previous page"}
+	asyncParam    = routeParam{Name: "async", Type: paramBoolean, Description: "Return immediately and push the results over the websocket"}
+	asyncIDParam  = routeParam{Name: "asyncID", Type: paramString, Description: "ID to tag the websocket messages of an async request with"}
 
-//nolint:dupl
-func post(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request) {
-	switch {
-	case strings.HasPrefix(path, "/ob/listing"):
-		i.POSTListing(w, r)
-	case strings.HasPrefix(path, "/ob/follow"):
-		blockingStartupMiddleware(i, w, r, i.POSTFollow)
-	case strings.HasPrefix(path, "/ob/unfollow"):
-		blockingStartupMiddleware(i, w, r, i.POSTUnfollow)
-	case strings.HasPrefix(path, "/ob/profile"):
-		i.POSTProfile(w, r)
-	case strings.HasPrefix(path, "/ob/images"):
-		i.POSTImage(w, r)
-	case strings.HasPrefix(path, "/wallet/spend"):
-		i.POSTSpendCoins(w, r)
-	case strings.HasPrefix(path, "/ob/settings"):
-		i.POSTSettings(w, r)
-	case strings.HasPrefix(path, "/ob/inventory"):
-		i.POSTInventory(w, r)
-	case strings.HasPrefix(path, "/ob/avatar"):
-		i.POSTAvatar(w, r)
-	case strings.HasPrefix(path, "/ob/header"):
-		i.POSTHeader(w, r)
-	case strings.HasPrefix(path, "/ob/orderconfirmation"):
-		blockingStartupMiddleware(i, w, r, i.POSTOrderConfirmation)
-	case strings.HasPrefix(path, "/ob/ordercancel"):
-		blockingStartupMiddleware(i, w, r, i.POSTOrderCancel)
-	case strings.HasPrefix(path, "/ob/orderfulfillment"):
-		blockingStartupMiddleware(i, w, r, i.POSTOrderFulfill)
-	case strings.HasPrefix(path, "/ob/ordercompletion"):
-		blockingStartupMiddleware(i, w, r, i.POSTOrderComplete)
-	case strings.HasPrefix(path, "/ob/orderspend"):
-		blockingStartupMiddleware(i, w, r, i.POSTSpendCoinsForOrder)
-	case strings.HasPrefix(path, "/ob/refund"):
-		blockingStartupMiddleware(i, w, r, i.POSTRefund)
-	case strings.HasPrefix(path, "/wallet/resyncblockchain"):
-		i.POSTResyncBlockchain(w, r)
-	case strings.HasPrefix(path, "/wallet/bumpfee"):
-		i.POSTBumpFee(w, r)
-	case strings.HasPrefix(path, "/ob/opendispute"):
-		blockingStartupMiddleware(i, w, r, i.POSTOpenDispute)
-	case strings.HasPrefix(path, "/ob/closedispute"):
-		blockingStartupMiddleware(i, w, r, i.POSTCloseDispute)
-	case strings.HasPrefix(path, "/ob/releasefunds"):
-		blockingStartupMiddleware(i, w, r, i.POSTReleaseFunds)
-	case strings.HasPrefix(path, "/ob/releaseescrow"):
-		blockingStartupMiddleware(i, w, r, i.POSTReleaseEscrow)
-	case strings.HasPrefix(path, "/ob/chat"):
-		blockingStartupMiddleware(i, w, r, i.POSTChat)
-	case strings.HasPrefix(path, "/ob/signmessage"):
-		i.POSTSignMessage(w, r)
-	case strings.HasPrefix(path, "/ob/verifymessage"):
-		i.POSTVerifyMessage(w, r)
-	case strings.HasPrefix(path, "/ob/groupchat"):
-		blockingStartupMiddleware(i, w, r, i.POSTGroupChat)
-	case strings.HasPrefix(path, "/ob/markchatasread"):
-		blockingStartupMiddleware(i, w, r, i.POSTMarkChatAsRead)
-	case strings.HasPrefix(path, "/ob/marknotificationasread"):
-		i.POSTMarkNotificationAsRead(w, r)
-	case strings.HasPrefix(path, "/ob/marknotificationsasread"):
-		i.POSTMarkNotificationsAsRead(w, r)
-	case strings.HasPrefix(path, "/ob/fetchprofiles"):
-		i.POSTFetchProfiles(w, r)
-	case strings.HasPrefix(path, "/ob/blocknode"):
-		i.POSTBlockNode(w, r)
-	case strings.HasPrefix(path, "/ob/shutdown"):
-		i.POSTShutdown(w, r)
-	case strings.HasPrefix(path, "/ob/estimatetotal"):
-		i.POSTEstimateTotal(w, r)
-	case strings.HasPrefix(path, "/ob/fetchratings"):
-		i.POSTFetchRatings(w, r)
-	case strings.HasPrefix(path, "/ob/sales"):
-		i.POSTSales(w, r)
-	case strings.HasPrefix(path, "/ob/purchases"):
-		i.POSTPurchases(w, r)
-	case strings.HasPrefix(path, "/ob/purchase"):
-		blockingStartupMiddleware(i, w, r, i.POSTPurchase)
-	case strings.HasPrefix(path, "/ob/cases"):
-		i.POSTCases(w, r)
-	case strings.HasPrefix(path, "/ob/publish"):
-		i.POSTPublish(w, r)
-	case strings.HasPrefix(path, "/ob/importlistings"):
-		i.POSTImportListings(w, r)
-	case strings.HasPrefix(path, "/ob/purgecache"):
-		i.POSTPurgeCache(w, r)
-	case strings.HasPrefix(path, "/ob/testemailnotifications"):
-		i.POSTTestEmailNotifications(w, r)
-	case strings.HasPrefix(path, "/ob/post"):
-		i.POSTPost(w, r)
-	case strings.HasPrefix(path, "/ob/bulkupdatecurrency"):
-		i.POSTBulkUpdateCurrency(w, r)
-	case strings.HasPrefix(path, "/ob/resendordermessage"):
-		i.POSTResendOrderMessage(w, r)
-	default:
-		ErrorResponse(w, http.StatusNotFound, "Not Found")
+	orderSearchParams = []routeParam{
+		{Name: "search", Type: paramString, Description: "Text to search the orders for"},
+		{Name: "state", Type: paramString, Description: "Comma separated list of order states"},
+		{Name: "sortBy", Type: paramString, Description: "Comma separated sort terms: ascending and/or read"},
+		limitParam,
 	}
-}
 
-//nolint:dupl
-func get(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request) {
-	switch {
-	case strings.HasPrefix(path, "/ob/status"):
-		i.GETStatus(w, r)
-	case strings.HasPrefix(path, "/ob/peers"):
-		i.GETPeers(w, r)
-	case strings.HasPrefix(path, "/ob/config"):
-		i.GETConfig(w, r)
-	case strings.HasPrefix(path, "/wallet/address"):
-		i.GETAddress(w, r)
-	case strings.HasPrefix(path, "/wallet/mnemonic"):
-		i.GETMnemonic(w, r)
-	case strings.HasPrefix(path, "/wallet/balance"):
-		i.GETBalance(w, r)
-	case strings.HasPrefix(path, "/wallet/transactions"):
-		i.GETTransactions(w, r)
-	case strings.HasPrefix(path, "/ob/settings"):
-		i.GETSettings(w, r)
-	case strings.HasPrefix(path, "/ob/closestpeers"):
-		i.GETClosestPeers(w, r)
-	case strings.HasPrefix(path, "/ob/exchangerate"):
-		i.GETExchangeRate(w, r)
-	case strings.HasPrefix(path, "/ob/followers"):
-		i.GETFollowers(w, r)
-	case strings.HasPrefix(path, "/ob/following"):
-		i.GETFollowing(w, r)
-	case strings.HasPrefix(path, "/ob/inventory"):
-		i.GETInventory(w, r)
-	case strings.HasPrefix(path, "/ob/profile"):
-		i.GETProfile(w, r)
-	case strings.HasPrefix(path, "/ob/listings"):
-		i.GETListings(w, r)
-	case strings.HasPrefix(path, "/ob/listing"):
-		i.GETListing(w, r)
-	case strings.HasPrefix(path, "/ob/followsme"):
-		i.GETFollowsMe(w, r)
-	case strings.HasPrefix(path, "/ob/isfollowing"):
-		i.GETIsFollowing(w, r)
-	case strings.HasPrefix(path, "/ob/order"):
-		i.GETOrder(w, r)
-	case strings.HasPrefix(path, "/ob/moderators"):
-		i.GETModerators(w, r)
-	case strings.HasPrefix(path, "/ob/chatmessages"):
-		i.GETChatMessages(w, r)
-	case strings.HasPrefix(path, "/ob/chatconversations"):
-		i.GETChatConversations(w, r)
-	case strings.HasPrefix(path, "/ob/notifications"):
-		i.GETNotifications(w, r)
-	case strings.HasPrefix(path, "/ob/image"):
-		i.GETImage(w, r)
-	case strings.HasPrefix(path, "/ob/avatar"):
-		i.GETAvatar(w, r)
-	case strings.HasPrefix(path, "/ob/header"):
-		i.GETHeader(w, r)
-	case strings.HasPrefix(path, "/ob/purchases"):
-		i.GETPurchases(w, r)
-	case strings.HasPrefix(path, "/ob/sales"):
-		i.GETSales(w, r)
-	case strings.HasPrefix(path, "/ob/cases"):
-		i.GETCases(w, r)
-	case strings.HasPrefix(path, "/ob/case"):
-		i.GETCase(w, r)
-	case strings.HasPrefix(path, "/wallet/estimatefee"):
-		i.GETEstimateFee(w, r)
-	case strings.HasPrefix(path, "/wallet/fees"):
-		i.GETFees(w, r)
-	case strings.HasPrefix(path, "/ob/ratings"):
-		i.GETRatings(w, r)
-	case strings.HasPrefix(path, "/ob/rating"):
-		i.GETRating(w, r)
-	case strings.HasPrefix(path, "/ob/healthcheck"):
-		i.GETHealthCheck(w, r)
-	case strings.HasPrefix(path, "/wallet/status"):
-		i.GETWalletStatus(w, r)
-	case strings.HasPrefix(path, "/ob/ipns"):
-		i.GETIPNS(w, r)
-	case strings.HasPrefix(path, "/ob/resolveipns"):
-		i.GETResolveIPNS(w, r)
-	case strings.HasPrefix(path, "/ob/peerinfo"):
-		i.GETPeerInfo(w, r)
-	case strings.HasPrefix(path, "/ob/posts"):
-		i.GETPosts(w, r)
-	case strings.HasPrefix(path, "/ob/post"):
-		i.GETPost(w, r)
-	case strings.HasPrefix(path, "/ob/scanofflinemessages"):
-		i.GETScanOfflineMessages(w, r)
-	default:
-		ErrorResponse(w, http.StatusNotFound, "Not Found")
+	auditQueryParams = []routeParam{
+		{Name: "source", Type: paramString, Description: "api or protocol"},
+		{Name: "actor", Type: paramString, Description: "Who made the call, e.g. token:<id>, user:<name>, cookie or node"},
+		{Name: "action", Type: paramString, Description: "Prefix of the action, e.g. POST /ob/orderfulfillment"},
+		{Name: "since", Type: paramString, Format: "date-time", Description: "Only entries at or after this RFC 3339 time"},
+		{Name: "until", Type: paramString, Format: "date-time", Description: "Only entries before this RFC 3339 time"},
 	}
-}
+)
 
-func patch(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request) {
-	switch {
-	case strings.HasPrefix(path, "/ob/settings"):
-		i.PATCHSettings(w, r)
-	case strings.HasPrefix(path, "/ob/profile"):
-		i.PATCHProfile(w, r)
-	default:
-		ErrorResponse(w, http.StatusNotFound, "Not Found")
-	}
-}
+// apiRoutes is the route table of the JSON API. Each route is matched on its
+// full path so their order does not matter. The table is also the source of
+// the OpenAPI document served at /ob/openapi.json.
+var apiRoutes = []*route{
+	// Node
+	{Method: "GET", Pattern: "/ob/openapi.json", Handler: (*jsonAPIHandler).GETOpenAPI, Tag: "node", Summary: "OpenAPI description of this API"},
+	{Method: "GET", Pattern: "/ob/config", Handler: (*jsonAPIHandler).GETConfig, Tag: "node", Summary: "Node configuration"},
+	{Method: "GET", Pattern: "/ob/healthcheck", Handler: (*jsonAPIHandler).GETHealthCheck, Tag: "node", Summary: "Health of the database, IPFS root and peer connections"},
+	{Method: "GET", Pattern: "/ob/peers", Handler: (*jsonAPIHandler).GETPeers, Tag: "node", Summary: "Connected peers", Response: []string{}},
+	{Method: "GET", Pattern: "/ob/closestpeers/{peerId}", Handler: (*jsonAPIHandler).GETClosestPeers, Tag: "node", Summary: "Peers closest to a peer ID in the DHT", Response: []string{}},
+	{Method: "GET", Pattern: "/ob/status", Handler: (*jsonAPIHandler).GETStatus, Tag: "node", Summary: "Online status of a peer"},
+	{Method: "GET", Pattern: "/ob/status/{peerId}", Handler: (*jsonAPIHandler).GETStatus, Tag: "node", Summary: "Online status of a peer"},
+	{Method: "GET", Pattern: "/ob/peerinfo/{peerId}", Handler: (*jsonAPIHandler).GETPeerInfo, Tag: "node", Summary: "Addresses of a peer"},
+	{Method: "GET", Pattern: "/ob/ipns/{peerId}", Handler: (*jsonAPIHandler).GETIPNS, Tag: "node", Summary: "IPNS record of a peer", Gateway: true},
+	{Method: "GET", Pattern: "/ob/resolveipns/{peerId}", Handler: (*jsonAPIHandler).GETResolveIPNS, Tag: "node", Summary: "Resolve the IPNS name of a peer"},
+	{Method: "GET", Pattern: "/ob/scanofflinemessages", Handler: (*jsonAPIHandler).GETScanOfflineMessages, Tag: "node", Summary: "Scan for offline messages"},
+	{Method: "POST", Pattern: "/ob/publish", Handler: (*jsonAPIHandler).POSTPublish, Tag: "node", Summary: "Publish the node's data to IPNS"},
+	{Method: "POST", Pattern: "/ob/purgecache", Handler: (*jsonAPIHandler).POSTPurgeCache, Tag: "node", Summary: "Purge the IPNS cache"},
+	{Method: "POST", Pattern: "/ob/shutdown", Handler: (*jsonAPIHandler).POSTShutdown, Tag: "node", Summary: "Shut the node down"},
+	{Method: "POST", Pattern: "/ob/signmessage", Handler: (*jsonAPIHandler).POSTSignMessage, Tag: "node", Summary: "Sign a message with the node's identity key", Request: signMessageRequest{}},
+	{Method: "POST", Pattern: "/ob/verifymessage", Handler: (*jsonAPIHandler).POSTVerifyMessage, Tag: "node", Summary: "Verify a message signed by a peer", Request: verifyMessageRequest{}},
 
-func deleter(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request) {
-	switch {
-	case strings.HasPrefix(path, "/ob/moderator"):
-		i.DELETEModerator(w, r)
-	case strings.HasPrefix(path, "/ob/listing"):
-		i.DELETEListing(w, r)
-	case strings.HasPrefix(path, "/ob/chatmessage"):
-		i.DELETEChatMessage(w, r)
-	case strings.HasPrefix(path, "/ob/chatconversation"):
-		i.DELETEChatConversation(w, r)
-	case strings.HasPrefix(path, "/ob/notifications"):
-		i.DELETENotification(w, r)
-	case strings.HasPrefix(path, "/ob/blocknode"):
-		i.DELETEBlockNode(w, r)
-	case strings.HasPrefix(path, "/ob/post"):
-		i.DELETEPost(w, r)
-	default:
-		ErrorResponse(w, http.StatusNotFound, "Not Found")
-	}
-}
+	// Profile
+	{Method: "GET", Pattern: "/ob/profile", Handler: (*jsonAPIHandler).GETProfile, Tag: "profile", Summary: "Our profile", Response: pb.Profile{}, Gateway: true},
+	{Method: "GET", Pattern: "/ob/profile/{peerId}", Handler: (*jsonAPIHandler).GETProfile, Tag: "profile", Summary: "Profile of a peer", Query: []routeParam{useCacheParam}, Response: pb.Profile{}, Gateway: true},
+	{Method: "POST", Pattern: "/ob/profile", Handler: (*jsonAPIHandler).POSTProfile, Tag: "profile", Summary: "Create our profile", Request: pb.Profile{}, Response: pb.Profile{}},
+	{Method: "PUT", Pattern: "/ob/profile", Handler: (*jsonAPIHandler).PUTProfile, Tag: "profile", Summary: "Replace our profile", Request: pb.Profile{}},
+	{Method: "PATCH", Pattern: "/ob/profile", Handler: (*jsonAPIHandler).PATCHProfile, Tag: "profile", Summary: "Update fields of our profile", Request: pb.Profile{}},
+	{Method: "POST", Pattern: "/ob/fetchprofiles", Handler: (*jsonAPIHandler).POSTFetchProfiles, Tag: "profile", Summary: "Fetch the profiles of several peers", Query: []routeParam{asyncParam, asyncIDParam, useCacheParam}, Request: []string{}, Gateway: true, Scope: repo.APITokenScopeReadOnly},
+	{Method: "GET", Pattern: "/ob/avatar/{peerId}/{size}", Handler: (*jsonAPIHandler).GETAvatar, Tag: "profile", Summary: "Avatar image of a peer", Query: []routeParam{useCacheParam}, Gateway: true},
+	{Method: "POST", Pattern: "/ob/avatar", Handler: (*jsonAPIHandler).POSTAvatar, Tag: "profile", Summary: "Set our avatar", Request: avatarRequest{}},
+	{Method: "GET", Pattern: "/ob/header/{peerId}/{size}", Handler: (*jsonAPIHandler).GETHeader, Tag: "profile", Summary: "Header image of a peer", Query: []routeParam{useCacheParam}, Gateway: true},
+	{Method: "POST", Pattern: "/ob/header", Handler: (*jsonAPIHandler).POSTHeader, Tag: "profile", Summary: "Set our header image", Request: headerRequest{}},
+	{Method: "GET", Pattern: "/ob/image/{imageHash}", Handler: (*jsonAPIHandler).GETImage, Tag: "profile", Summary: "Image by hash", Gateway: true},
+	{Method: "GET", Pattern: "/ob/images/{imageHash}", Handler: (*jsonAPIHandler).GETImage, Tag: "profile", Summary: "Image by hash", Gateway: true},
+	{Method: "POST", Pattern: "/ob/images", Handler: (*jsonAPIHandler).POSTImage, Tag: "profile", Summary: "Add images", Request: []imageRequest{}, Scope: repo.APITokenScopeListingsWrite},
+	{Method: "PUT", Pattern: "/ob/moderator", Handler: (*jsonAPIHandler).PUTModerator, Tag: "profile", Summary: "Become a moderator", Request: pb.Moderator{}, Scope: repo.APITokenScopeModerator},
+	{Method: "DELETE", Pattern: "/ob/moderator", Handler: (*jsonAPIHandler).DELETEModerator, Tag: "profile", Summary: "Stop being a moderator", Scope: repo.APITokenScopeModerator},
+	{Method: "GET", Pattern: "/ob/moderator/stats", Handler: (*jsonAPIHandler).GETModeratorStats, Tag: "profile", Summary: "Statistics of the disputes we have moderated. Set publishStats in our moderator info to publish a summary with our profile.", Response: repo.CaseStats{}},
+	{Method: "GET", Pattern: "/ob/moderators", Handler: (*jsonAPIHandler).GETModerators, Tag: "profile", Summary: "Moderators found on the network", Query: []routeParam{asyncParam, asyncIDParam, {Name: "include", Type: paramString, Description: "Set to profile to include the moderators' profiles"}}},
 
-func gatewayAllowedPath(path, method string) bool {
-	allowedGets := []string{"/ob/followers", "/ob/following", "/ob/profile", "/ob/listing", "/ob/listings", "/ob/inventory", "/ob/image", "/ob/avatar", "/ob/header", "/ob/rating", "/ob/ratings", "/ob/posts", "/ob/post", "/ob/ipns"}
-	allowedPosts := []string{"/ob/fetchprofiles", "/ob/fetchratings"}
-	if method == "GET" {
-		for _, p := range allowedGets {
-			if strings.HasPrefix(path, p) {
-				return true
-			}
-		}
-	} else if method == "POST" {
-		for _, p := range allowedPosts {
-			if strings.HasPrefix(path, p) {
-				return true
-			}
-		}
-	}
-	return false
+	// Settings
+	{Method: "GET", Pattern: "/ob/settings", Handler: (*jsonAPIHandler).GETSettings, Tag: "settings", Summary: "Node settings", Response: repo.SettingsData{}, Private: true},
+	{Method: "POST", Pattern: "/ob/settings", Handler: (*jsonAPIHandler).POSTSettings, Tag: "settings", Summary: "Create the node settings", Request: repo.SettingsData{}},
+	{Method: "PUT", Pattern: "/ob/settings", Handler: (*jsonAPIHandler).PUTSettings, Tag: "settings", Summary: "Replace the node settings", Request: repo.SettingsData{}},
+	{Method: "PATCH", Pattern: "/ob/settings", Handler: (*jsonAPIHandler).PATCHSettings, Tag: "settings", Summary: "Update fields of the node settings", Request: repo.SettingsData{}},
+	{Method: "POST", Pattern: "/ob/blocknode/{peerId}", Handler: (*jsonAPIHandler).POSTBlockNode, Tag: "settings", Summary: "Block a peer"},
+	{Method: "DELETE", Pattern: "/ob/blocknode/{peerId}", Handler: (*jsonAPIHandler).DELETEBlockNode, Tag: "settings", Summary: "Unblock a peer"},
+
+	// Admin
+	{Method: "GET", Pattern: "/ob/admin/auth", Handler: (*jsonAPIHandler).GETAdminAuth, Tag: "admin", Summary: "How the API is authenticated", Response: adminAuthResponse{}, Admin: true},
+	{Method: "PUT", Pattern: "/ob/admin/credentials", Handler: (*jsonAPIHandler).PUTAdminCredentials, Tag: "admin", Summary: "Rotate the API username and password", Request: adminCredentialsRequest{}, Response: adminAuthResponse{}, Admin: true},
+	{Method: "POST", Pattern: "/ob/admin/cookie", Handler: (*jsonAPIHandler).POSTAdminCookie, Tag: "admin", Summary: "Regenerate the authentication cookie", Response: adminCookieResponse{}, Admin: true},
+	{Method: "PUT", Pattern: "/ob/admin/authentication", Handler: (*jsonAPIHandler).PUTAdminAuthentication, Tag: "admin", Summary: "Turn authentication of the API on or off", Request: adminAuthenticationRequest{}, Response: adminAuthResponse{}, Admin: true},
+
+	// Audit
+	{Method: "GET", Pattern: "/ob/audit", Handler: (*jsonAPIHandler).GETAudit, Tag: "audit", Summary: "Audit log of API calls and order messages, most recent first", Query: append(auditQueryParams, routeParam{Name: "offset", Type: paramInteger, Description: "Number of entries to skip"}, routeParam{Name: "limit", Type: paramInteger, Description: "Maximum number of entries"}), Response: []auditEntryResponse{}, Private: true},
+	{Method: "GET", Pattern: "/ob/audit/export", Handler: (*jsonAPIHandler).GETAuditExport, Tag: "audit", Summary: "Export the audit log as CSV or JSON", Query: append(auditQueryParams, routeParam{Name: "format", Type: paramString, Description: "csv (default) or json"}), Private: true},
+
+	// API tokens
+	{Method: "GET", Pattern: "/ob/apitokens", Handler: (*jsonAPIHandler).GETAPITokens, Tag: "settings", Summary: "Scoped API tokens", Response: []apiTokenResponse{}, Private: true},
+	{Method: "POST", Pattern: "/ob/apitokens", Handler: (*jsonAPIHandler).POSTAPIToken, Tag: "settings", Summary: "Issue a scoped API token", Request: apiTokenRequest{}, Response: apiTokenResponse{}, Private: true},
+	{Method: "DELETE", Pattern: "/ob/apitokens/{tokenId}", Handler: (*jsonAPIHandler).DELETEAPIToken, Tag: "settings", Summary: "Revoke a scoped API token", Private: true},
+
+	// Social
+	{Method: "GET", Pattern: "/ob/followers", Handler: (*jsonAPIHandler).GETFollowers, Tag: "social", Summary: "Our followers", Query: []routeParam{offsetIDParam, limitParam}, Gateway: true},
+	{Method: "GET", Pattern: "/ob/followers/{peerId}", Handler: (*jsonAPIHandler).GETFollowers, Tag: "social", Summary: "Followers of a peer", Query: []routeParam{useCacheParam}, Response: []string{}, Gateway: true},
+	{Method: "GET", Pattern: "/ob/following", Handler: (*jsonAPIHandler).GETFollowing, Tag: "social", Summary: "Peers we follow", Query: []routeParam{offsetIDParam, limitParam}, Response: []string{}, Gateway: true},
+	{Method: "GET", Pattern: "/ob/following/{peerId}", Handler: (*jsonAPIHandler).GETFollowing, Tag: "social", Summary: "Peers a peer follows", Query: []routeParam{useCacheParam}, Response: []string{}, Gateway: true},
+	{Method: "GET", Pattern: "/ob/followsme/{peerId}", Handler: (*jsonAPIHandler).GETFollowsMe, Tag: "social", Summary: "Whether a peer follows us"},
+	{Method: "GET", Pattern: "/ob/isfollowing/{peerId}", Handler: (*jsonAPIHandler).GETIsFollowing, Tag: "social", Summary: "Whether we follow a peer"},
+	{Method: "POST", Pattern: "/ob/follow", Handler: (*jsonAPIHandler).POSTFollow, Tag: "social", Summary: "Follow a peer", Request: followRequest{}, Blocking: true},
+	{Method: "POST", Pattern: "/ob/unfollow", Handler: (*jsonAPIHandler).POSTUnfollow, Tag: "social", Summary: "Unfollow a peer", Request: followRequest{}, Blocking: true},
+
+	// Listings
+	{Method: "GET", Pattern: "/ob/listings", Handler: (*jsonAPIHandler).GETListings, Tag: "listings", Summary: "Our listing index", Response: []core.ListingData{}, Gateway: true},
+	{Method: "GET", Pattern: "/ob/listings/{peerId}", Handler: (*jsonAPIHandler).GETListings, Tag: "listings", Summary: "Listing index of a peer", Query: []routeParam{useCacheParam, {Name: "max-age", Type: paramInteger, Description: "Seconds the response may be cached for"}}, Response: []core.ListingData{}, Gateway: true},
+	{Method: "GET", Pattern: "/ob/listing/{slug}", Handler: (*jsonAPIHandler).GETListing, Tag: "listings", Summary: "One of our listings", Response: pb.SignedListing{}, Gateway: true},
+	{Method: "GET", Pattern: "/ob/listing/{peerId}/{slug}", Handler: (*jsonAPIHandler).GETListing, Tag: "listings", Summary: "Listing of a peer", Query: []routeParam{useCacheParam}, Response: pb.SignedListing{}, Gateway: true},
+	{Method: "POST", Pattern: "/ob/listing", Handler: (*jsonAPIHandler).POSTListing, Tag: "listings", Summary: "Create a listing", Request: pb.Listing{}, Scope: repo.APITokenScopeListingsWrite},
+	{Method: "PUT", Pattern: "/ob/listing", Handler: (*jsonAPIHandler).PUTListing, Tag: "listings", Summary: "Update a listing", Request: pb.Listing{}, Scope: repo.APITokenScopeListingsWrite},
+	{Method: "DELETE", Pattern: "/ob/listing/{slug}", Handler: (*jsonAPIHandler).DELETEListing, Tag: "listings", Summary: "Delete a listing", Scope: repo.APITokenScopeListingsWrite},
+	{Method: "POST", Pattern: "/ob/importlistings", Handler: (*jsonAPIHandler).POSTImportListings, Tag: "listings", Summary: "Import listings from a CSV file", Scope: repo.APITokenScopeListingsWrite},
+	{Method: "POST", Pattern: "/ob/bulkupdatecurrency", Handler: (*jsonAPIHandler).POSTBulkUpdateCurrency, Tag: "listings", Summary: "Set the accepted currencies of all listings", Request: bulkUpdateCurrencyRequest{}, Scope: repo.APITokenScopeListingsWrite},
+	{Method: "GET", Pattern: "/ob/inventory", Handler: (*jsonAPIHandler).GETInventory, Tag: "listings", Summary: "Our inventory", Response: core.Inventory{}, Gateway: true},
+	{Method: "GET", Pattern: "/ob/inventory/{peerId}", Handler: (*jsonAPIHandler).GETInventory, Tag: "listings", Summary: "Inventory of a peer", Query: []routeParam{useCacheParam}, Response: core.Inventory{}, Gateway: true},
+	{Method: "GET", Pattern: "/ob/inventory/{peerId}/{slug}", Handler: (*jsonAPIHandler).GETInventory, Tag: "listings", Summary: "Inventory of a peer's listing", Query: []routeParam{useCacheParam}, Response: core.InventoryListing{}, Gateway: true},
+	{Method: "POST", Pattern: "/ob/inventory", Handler: (*jsonAPIHandler).POSTInventory, Tag: "listings", Summary: "Set the inventory of our listings", Request: []inventoryRequest{}, Scope: repo.APITokenScopeListingsWrite},
+	{Method: "GET", Pattern: "/ob/availability", Handler: (*jsonAPIHandler).GETAvailability, Tag: "listings", Summary: "Our availability calendar for appointments", Response: core.Availability{}, Gateway: true},
+	{Method: "GET", Pattern: "/ob/availability/{peerId}", Handler: (*jsonAPIHandler).GETAvailability, Tag: "listings", Summary: "Availability calendar of a peer", Query: []routeParam{useCacheParam}, Response: core.Availability{}, Gateway: true},
+	{Method: "PUT", Pattern: "/ob/availability", Handler: (*jsonAPIHandler).PUTAvailability, Tag: "listings", Summary: "Publish our availability calendar", Request: core.Availability{}, Scope: repo.APITokenScopeListingsWrite},
+
+	// Posts
+	{Method: "GET", Pattern: "/ob/posts", Handler: (*jsonAPIHandler).GETPosts, Tag: "posts", Summary: "Our post index", Gateway: true},
+	{Method: "GET", Pattern: "/ob/posts/{peerId}", Handler: (*jsonAPIHandler).GETPosts, Tag: "posts", Summary: "Post index of a peer", Query: []routeParam{useCacheParam}, Gateway: true},
+	{Method: "GET", Pattern: "/ob/post/{slug}", Handler: (*jsonAPIHandler).GETPost, Tag: "posts", Summary: "One of our posts", Response: pb.SignedPost{}, Gateway: true},
+	{Method: "GET", Pattern: "/ob/post/{peerId}/{slug}", Handler: (*jsonAPIHandler).GETPost, Tag: "posts", Summary: "Post of a peer", Query: []routeParam{useCacheParam}, Response: pb.SignedPost{}, Gateway: true},
+	{Method: "POST", Pattern: "/ob/post", Handler: (*jsonAPIHandler).POSTPost, Tag: "posts", Summary: "Create a post", Request: pb.Post{}, Scope: repo.APITokenScopeListingsWrite},
+	{Method: "PUT", Pattern: "/ob/post", Handler: (*jsonAPIHandler).PUTPost, Tag: "posts", Summary: "Update a post", Request: pb.Post{}, Scope: repo.APITokenScopeListingsWrite},
+	{Method: "DELETE", Pattern: "/ob/post/{slug}", Handler: (*jsonAPIHandler).DELETEPost, Tag: "posts", Summary: "Delete a post", Scope: repo.APITokenScopeListingsWrite},
+
+	// Search
+	{Method: "GET", Pattern: "/ob/search", Handler: (*jsonAPIHandler).GETSearch, Tag: "search", Summary: "Full text search over listings, posts and chat", Query: []routeParam{
+		{Name: "q", Type: paramString, Description: "Search terms"},
+		{Name: "type", Type: paramStringList, Description: "Document types to search: listing, post or chat"},
+		{Name: "tags", Type: paramStringList, Description: "Tags the results must have"},
+		{Name: "categories", Type: paramStringList, Description: "Categories the results must be in"},
+		{Name: "serviceClassification", Type: paramString, Description: "Service classification the results must have"},
+		{Name: "offset", Type: paramInteger, Description: "Number of results to skip"},
+		{Name: "limit", Type: paramInteger, Description: "Maximum number of results"},
+	}},
+	{Method: "GET", Pattern: "/ob/search/nearby", Handler: (*jsonAPIHandler).GETSearchNearby, Tag: "search", Summary: "Listings and profiles near a location", Query: []routeParam{
+		{Name: "lat", Type: paramString, Description: "Latitude of the center"},
+		{Name: "lng", Type: paramString, Description: "Longitude of the center"},
+		{Name: "plusCode", Type: paramString, Description: "Plus code of the center, instead of lat and lng"},
+		{Name: "radius", Type: paramNumber, Description: "Search radius in kilometers"},
+		{Name: "type", Type: paramStringList, Description: "Location types to return: listing or profile"},
+		{Name: "limit", Type: paramInteger, Description: "Maximum number of results"},
+	}},
+
+	// Orders
+	{Method: "POST", Pattern: "/ob/purchase", Handler: (*jsonAPIHandler).POSTPurchase, Tag: "orders", Summary: "Purchase a listing", Request: core.PurchaseData{}, Blocking: true, Scope: repo.APITokenScopeOrders},
+	{Method: "POST", Pattern: "/ob/estimatetotal", Handler: (*jsonAPIHandler).POSTEstimateTotal, Tag: "orders", Summary: "Estimate the total of a purchase", Request: core.PurchaseData{}, Scope: repo.APITokenScopeOrders},
+	{Method: "GET", Pattern: "/ob/order/{orderId}", Handler: (*jsonAPIHandler).GETOrder, Tag: "orders", Summary: "An order", Response: pb.OrderRespApi{}},
+	{Method: "GET", Pattern: "/ob/invoice/{orderId}", Handler: (*jsonAPIHandler).GETInvoice, Tag: "orders", Summary: "Signed invoice of a sale", Query: []routeParam{
+		{Name: "format", Type: paramString, Description: "json (default) or pdf"},
+	}, Response: invoiceResponse{}},
+	{Method: "GET", Pattern: "/ob/appointments", Handler: (*jsonAPIHandler).GETAppointments, Tag: "orders", Summary: "Time slots booked with us and by us", Query: []routeParam{
+		{Name: "from", Type: paramString, Format: "date", Description: "First day of the range, YYYY-MM-DD or an RFC 3339 time"},
+		{Name: "to", Type: paramString, Format: "date", Description: "Last day of the range, YYYY-MM-DD or an RFC 3339 time"},
+		{Name: "format", Type: paramString, Description: "json (default) or ics"},
+	}, Response: []appointmentResponse{}},
+	{Method: "GET", Pattern: "/ob/purchases", Handler: (*jsonAPIHandler).GETPurchases, Tag: "orders", Summary: "Our purchases", Query: orderSearchParams},
+	{Method: "POST", Pattern: "/ob/purchases", Handler: (*jsonAPIHandler).POSTPurchases, Tag: "orders", Summary: "Query our purchases", Request: TransactionQuery{}, Scope: repo.APITokenScopeReadOnly},
+	{Method: "GET", Pattern: "/ob/sales", Handler: (*jsonAPIHandler).GETSales, Tag: "orders", Summary: "Our sales", Query: orderSearchParams},
+	{Method: "POST", Pattern: "/ob/sales", Handler: (*jsonAPIHandler).POSTSales, Tag: "orders", Summary: "Query our sales", Request: TransactionQuery{}, Scope: repo.APITokenScopeReadOnly},
+	{Method: "POST", Pattern: "/ob/orderconfirmation", Handler: (*jsonAPIHandler).POSTOrderConfirmation, Tag: "orders", Summary: "Confirm or reject an order", Request: orderConfirmationRequest{}, Blocking: true, Scope: repo.APITokenScopeOrders},
+	{Method: "POST", Pattern: "/ob/ordercancel", Handler: (*jsonAPIHandler).POSTOrderCancel, Tag: "orders", Summary: "Cancel an order", Request: orderIDRequest{}, Blocking: true, Scope: repo.APITokenScopeOrders},
+	{Method: "POST", Pattern: "/ob/orderfulfillment", Handler: (*jsonAPIHandler).POSTOrderFulfill, Tag: "orders", Summary: "Fulfill an order", Request: pb.OrderFulfillment{}, Blocking: true, Scope: repo.APITokenScopeOrders},
+	{Method: "POST", Pattern: "/ob/ordercompletion", Handler: (*jsonAPIHandler).POSTOrderComplete, Tag: "orders", Summary: "Complete an order and rate the vendor", Request: core.OrderRatings{}, Blocking: true, Scope: repo.APITokenScopeOrders},
+	{Method: "POST", Pattern: "/ob/orderspend", Handler: (*jsonAPIHandler).POSTSpendCoinsForOrder, Tag: "orders", Summary: "Pay for an order from the wallet", Request: core.SpendRequest{}, Blocking: true, Scope: repo.APITokenScopeWalletSpend},
+	{Method: "POST", Pattern: "/ob/refund", Handler: (*jsonAPIHandler).POSTRefund, Tag: "orders", Summary: "Refund an order", Request: orderIDRequest{}, Blocking: true, Scope: repo.APITokenScopeOrders},
+	{Method: "POST", Pattern: "/ob/partialrefund", Handler: (*jsonAPIHandler).POSTPartialRefund, Tag: "orders", Summary: "Refund part of an order by amount or by item", Request: core.PartialRefundData{}, Response: pb.PartialRefund{}, Blocking: true, Scope: repo.APITokenScopeOrders},
+	{Method: "POST", Pattern: "/ob/releasemilestone", Handler: (*jsonAPIHandler).POSTReleaseMilestone, Tag: "orders", Summary: "Release the funds of a milestone", Request: milestoneReleaseRequest{}, Blocking: true, Scope: repo.APITokenScopeOrders},
+	{Method: "POST", Pattern: "/ob/releaseescrow", Handler: (*jsonAPIHandler).POSTReleaseEscrow, Tag: "orders", Summary: "Release escrowed funds after the timeout", Request: orderIDRequest{}, Blocking: true, Scope: repo.APITokenScopeOrders},
+	{Method: "POST", Pattern: "/ob/resendordermessage", Handler: (*jsonAPIHandler).POSTResendOrderMessage, Tag: "orders", Summary: "Resend an order message", Request: resendOrderMessageRequest{}, Scope: repo.APITokenScopeOrders},
+	{Method: "GET", Pattern: "/ob/timesheet/{orderId}", Handler: (*jsonAPIHandler).GETTimesheet, Tag: "orders", Summary: "Timesheet of an hourly order"},
+	{Method: "POST", Pattern: "/ob/timesheet", Handler: (*jsonAPIHandler).POSTTimesheetEntry, Tag: "orders", Summary: "Log time on an hourly order", Request: timesheetEntryRequest{}, Blocking: true, Scope: repo.APITokenScopeOrders},
+	{Method: "POST", Pattern: "/ob/timesheetreview", Handler: (*jsonAPIHandler).POSTTimesheetReview, Tag: "orders", Summary: "Approve or dispute a timesheet entry", Request: timesheetReviewRequest{}, Blocking: true, Scope: repo.APITokenScopeOrders},
+	{Method: "GET", Pattern: "/ob/orderamendments/{orderId}", Handler: (*jsonAPIHandler).GETOrderAmendments, Tag: "orders", Summary: "Amendments proposed on an order"},
+	{Method: "POST", Pattern: "/ob/orderamendment", Handler: (*jsonAPIHandler).POSTOrderAmendment, Tag: "orders", Summary: "Propose new price, quantity or delivery date for an order", Request: core.OrderAmendmentData{}, Blocking: true, Scope: repo.APITokenScopeOrders},
+	{Method: "POST", Pattern: "/ob/orderamendmentresponse", Handler: (*jsonAPIHandler).POSTOrderAmendmentResponse, Tag: "orders", Summary: "Accept or decline an order amendment", Request: core.OrderAmendmentResponseData{}, Blocking: true, Scope: repo.APITokenScopeOrders},
+	{Method: "GET", Pattern: "/ob/subscriptions", Handler: (*jsonAPIHandler).GETSubscriptions, Tag: "orders", Summary: "Our subscriptions", Query: []routeParam{{Name: "state", Type: paramStringList, Description: "Subscription states to return"}}, Response: []subscriptionResponse{}},
+	{Method: "GET", Pattern: "/ob/subscriptions/{subscriptionId}", Handler: (*jsonAPIHandler).GETSubscriptions, Tag: "orders", Summary: "A subscription", Response: subscriptionResponse{}},
+	{Method: "POST", Pattern: "/ob/subscriptions", Handler: (*jsonAPIHandler).POSTSubscription, Tag: "orders", Summary: "Subscribe to a listing", Request: core.SubscriptionData{}, Blocking: true, Scope: repo.APITokenScopeOrders},
+	{Method: "POST", Pattern: "/ob/subscriptions/{action}", Handler: (*jsonAPIHandler).POSTSubscriptionState, Tag: "orders", Summary: "Pause, resume or cancel a subscription", Request: subscriptionStateRequest{}, Blocking: true, Scope: repo.APITokenScopeOrders},
+	{Method: "GET", Pattern: "/ob/quotes", Handler: (*jsonAPIHandler).GETQuotes, Tag: "orders", Summary: "Quote requests we sent and received", Query: []routeParam{{Name: "state", Type: paramStringList, Description: "Quote states to return: requested, quoted, expired or ordered"}}, Response: []quoteResponse{}},
+	{Method: "GET", Pattern: "/ob/quotes/{requestId}", Handler: (*jsonAPIHandler).GETQuotes, Tag: "orders", Summary: "A quote request and its quotes", Response: quoteResponse{}},
+	{Method: "POST", Pattern: "/ob/quoterequest", Handler: (*jsonAPIHandler).POSTQuoteRequest, Tag: "orders", Summary: "Ask a vendor for a quote on a service listing", Request: core.QuoteRequestData{}, Blocking: true, Scope: repo.APITokenScopeOrders},
+	{Method: "POST", Pattern: "/ob/quote", Handler: (*jsonAPIHandler).POSTQuote, Tag: "orders", Summary: "Answer a quote request with a price, scope and expiry", Request: core.QuoteData{}, Blocking: true, Scope: repo.APITokenScopeOrders},
+	{Method: "POST", Pattern: "/ob/purchasequote", Handler: (*jsonAPIHandler).POSTPurchaseQuote, Tag: "orders", Summary: "Order the latest quote for a request", Request: core.QuoteAcceptanceData{}, Blocking: true, Scope: repo.APITokenScopeOrders},
+
+	// Disputes
+	{Method: "POST", Pattern: "/ob/opendispute", Handler: (*jsonAPIHandler).POSTOpenDispute, Tag: "disputes", Summary: "Open a dispute", Request: openDisputeRequest{}, Blocking: true, Scope: repo.APITokenScopeOrders},
+	{Method: "POST", Pattern: "/ob/closedispute", Handler: (*jsonAPIHandler).POSTCloseDispute, Tag: "disputes", Summary: "Resolve a dispute as moderator, or cast a vote as a member of a moderator panel", Request: closeDisputeRequest{}, Blocking: true, Scope: repo.APITokenScopeModerator},
+	{Method: "POST", Pattern: "/ob/releasefunds", Handler: (*jsonAPIHandler).POSTReleaseFunds, Tag: "disputes", Summary: "Accept a dispute resolution and release the funds", Request: orderIDRequest{}, Blocking: true, Scope: repo.APITokenScopeOrders},
+	{Method: "GET", Pattern: "/ob/case/{orderId}", Handler: (*jsonAPIHandler).GETCase, Tag: "disputes", Summary: "A dispute case", Response: pb.CaseRespApi{}},
+	{Method: "GET", Pattern: "/ob/case/{orderId}/evidence", Handler: (*jsonAPIHandler).GETDisputeEvidence, Tag: "disputes", Summary: "Files attached to a dispute", Response: []evidenceResponse{}},
+	{Method: "GET", Pattern: "/ob/case/{orderId}/evidence/{evidenceHash}", Handler: (*jsonAPIHandler).GETDisputeEvidence, Tag: "disputes", Summary: "A file attached to a dispute, decrypted. Only the moderators and the party who attached it can read it."},
+	{Method: "POST", Pattern: "/ob/case/{orderId}/evidence", Handler: (*jsonAPIHandler).POSTDisputeEvidence, Tag: "disputes", Summary: "Attach a file to an open dispute, encrypted to the moderator", Request: core.DisputeEvidenceData{}, Response: evidenceResponse{}, Blocking: true, Scope: repo.APITokenScopeOrders},
+	{Method: "GET", Pattern: "/ob/case/{orderId}/messages", Handler: (*jsonAPIHandler).GETCaseMessages, Tag: "disputes", Summary: "The threads of a dispute in the channels we can read", Response: []caseThreadResponse{}},
+	{Method: "POST", Pattern: "/ob/case/{orderId}/messages", Handler: (*jsonAPIHandler).POSTCaseMessage, Tag: "disputes", Summary: "Post a signed message to a channel of an open dispute. The CASE channel is shared by everyone, BUYER and VENDOR are private to one party and the moderators, MODERATORS to the moderators.", Request: caseMessageRequest{}, Response: caseMessageResponse{}, Blocking: true, Scope: repo.APITokenScopeOrders},
+	{Method: "GET", Pattern: "/ob/case/{orderId}/export", Handler: (*jsonAPIHandler).GETCaseFile, Tag: "disputes", Summary: "Export the case file of a dispute: contracts, resolution, evidence and signed messages", Response: pb.CaseFile{}},
+	{Method: "GET", Pattern: "/ob/cases", Handler: (*jsonAPIHandler).GETCases, Tag: "disputes", Summary: "Our dispute cases", Query: orderSearchParams},
+	{Method: "POST", Pattern: "/ob/cases", Handler: (*jsonAPIHandler).POSTCases, Tag: "disputes", Summary: "Query our dispute cases", Request: TransactionQuery{}, Scope: repo.APITokenScopeReadOnly},
+
+	// Ratings
+	{Method: "GET", Pattern: "/ob/ratings", Handler: (*jsonAPIHandler).GETRatings, Tag: "ratings", Summary: "Our ratings", Response: core.SavedRating{}, Gateway: true},
+	{Method: "GET", Pattern: "/ob/ratings/{peerId}", Handler: (*jsonAPIHandler).GETRatings, Tag: "ratings", Summary: "Ratings of a peer", Query: []routeParam{useCacheParam}, Response: core.SavedRating{}, Gateway: true},
+	{Method: "GET", Pattern: "/ob/ratings/{peerId}/{slug}", Handler: (*jsonAPIHandler).GETRatings, Tag: "ratings", Summary: "Ratings of a peer's listing", Query: []routeParam{useCacheParam}, Response: core.SavedRating{}, Gateway: true},
+	{Method: "GET", Pattern: "/ob/rating/{ratingId}", Handler: (*jsonAPIHandler).GETRating, Tag: "ratings", Summary: "A rating", Response: pb.Rating{}, Gateway: true},
+	{Method: "POST", Pattern: "/ob/fetchratings", Handler: (*jsonAPIHandler).POSTFetchRatings, Tag: "ratings", Summary: "Fetch several ratings", Query: []routeParam{asyncParam, asyncIDParam}, Request: []string{}, Response: []pb.RatingWithID{}, Gateway: true, Scope: repo.APITokenScopeReadOnly},
+	{Method: "GET", Pattern: "/ob/buyerratings", Handler: (*jsonAPIHandler).GETBuyerRatings, Tag: "ratings", Summary: "Ratings vendors gave us as a buyer", Response: core.BuyerRatingsResp{}, Gateway: true},
+	{Method: "GET", Pattern: "/ob/buyerratings/{peerId}", Handler: (*jsonAPIHandler).GETBuyerRatings, Tag: "ratings", Summary: "Ratings vendors gave a buyer", Query: []routeParam{useCacheParam}, Response: core.BuyerRatingsResp{}, Gateway: true},
+
+	// Chat
+	{Method: "GET", Pattern: "/ob/chatconversations", Handler: (*jsonAPIHandler).GETChatConversations, Tag: "chat", Summary: "Our chat conversations", Response: []repo.ChatConversation{}},
+	{Method: "GET", Pattern: "/ob/chatmessages", Handler: (*jsonAPIHandler).GETChatMessages, Tag: "chat", Summary: "Group chat messages", Query: []routeParam{limitParam, offsetIDParam, {Name: "subject", Type: paramString, Description: "Subject of the group conversation, e.g. an order ID"}}, Response: []repo.ChatMessage{}},
+	{Method: "GET", Pattern: "/ob/chatmessages/{peerId}", Handler: (*jsonAPIHandler).GETChatMessages, Tag: "chat", Summary: "Chat messages with a peer", Query: []routeParam{limitParam, offsetIDParam, {Name: "subject", Type: paramString, Description: "Subject of the conversation, e.g. an order ID"}}, Response: []repo.ChatMessage{}},
+	{Method: "POST", Pattern: "/ob/chat", Handler: (*jsonAPIHandler).POSTChat, Tag: "chat", Summary: "Send a chat message", Request: repo.ChatMessage{}, Blocking: true, Scope: repo.APITokenScopeOrders},
+	{Method: "POST", Pattern: "/ob/groupchat", Handler: (*jsonAPIHandler).POSTGroupChat, Tag: "chat", Summary: "Send a chat message to several peers", Request: repo.GroupChatMessage{}, Blocking: true, Scope: repo.APITokenScopeOrders},
+	{Method: "POST", Pattern: "/ob/markchatasread", Handler: (*jsonAPIHandler).POSTMarkChatAsRead, Tag: "chat", Summary: "Mark all chat messages as read", Blocking: true, Scope: repo.APITokenScopeOrders},
+	{Method: "POST", Pattern: "/ob/markchatasread/{peerId}", Handler: (*jsonAPIHandler).POSTMarkChatAsRead, Tag: "chat", Summary: "Mark the chat messages of a peer as read", Query: []routeParam{{Name: "subject", Type: paramString, Description: "Subject of the conversation, e.g. an order ID"}}, Blocking: true, Scope: repo.APITokenScopeOrders},
+	{Method: "DELETE", Pattern: "/ob/chatmessage/{messageId}", Handler: (*jsonAPIHandler).DELETEChatMessage, Tag: "chat", Summary: "Delete a chat message"},
+	{Method: "DELETE", Pattern: "/ob/chatconversation/{peerId}", Handler: (*jsonAPIHandler).DELETEChatConversation, Tag: "chat", Summary: "Delete a chat conversation"},
+
+	// Notifications
+	{Method: "GET", Pattern: "/ob/notifications", Handler: (*jsonAPIHandler).GETNotifications, Tag: "notifications", Summary: "Our notifications", Query: []routeParam{limitParam, offsetIDParam, {Name: "filter", Type: paramString, Description: "Comma separated notification types to return"}}},
+	{Method: "DELETE", Pattern: "/ob/notifications/{notificationId}", Handler: (*jsonAPIHandler).DELETENotification, Tag: "notifications", Summary: "Delete a notification"},
+	{Method: "POST", Pattern: "/ob/marknotificationasread/{notificationId}", Handler: (*jsonAPIHandler).POSTMarkNotificationAsRead, Tag: "notifications", Summary: "Mark a notification as read"},
+	{Method: "POST", Pattern: "/ob/marknotificationsasread", Handler: (*jsonAPIHandler).POSTMarkNotificationsAsRead, Tag: "notifications", Summary: "Mark all notifications as read"},
+	{Method: "POST", Pattern: "/ob/testemailnotifications", Handler: (*jsonAPIHandler).POSTTestEmailNotifications, Tag: "notifications", Summary: "Send a test email", Request: repo.SMTPSettings{}},
+	{Method: "POST", Pattern: "/ob/testnotifications/{channel}", Handler: (*jsonAPIHandler).POSTTestNotifications, Tag: "notifications", Summary: "Send a test notification over a channel"},
+	{Method: "GET", Pattern: "/ob/notificationdeliveries", Handler: (*jsonAPIHandler).GETNotificationDeliveries, Tag: "notifications", Summary: "Queued outbound notification deliveries", Query: []routeParam{
+		{Name: "state", Type: paramStringList, Description: "Delivery states to return: pending, delivered or dead"},
+		{Name: "channel", Type: paramString, Description: "Channel to return the deliveries of"},
+		{Name: "offset", Type: paramInteger, Description: "Number of deliveries to skip"},
+		{Name: "limit", Type: paramInteger, Description: "Maximum number of deliveries"},
+	}, Response: []notificationDeliveryResponse{}},
+	{Method: "GET", Pattern: "/ob/notificationdeliveries/{deliveryId}", Handler: (*jsonAPIHandler).GETNotificationDeliveries, Tag: "notifications", Summary: "A queued outbound notification delivery", Response: notificationDeliveryResponse{}},
+	{Method: "POST", Pattern: "/ob/notificationdeliveries", Handler: (*jsonAPIHandler).POSTNotificationDeliveries, Tag: "notifications", Summary: "Replay all dead-lettered deliveries", Query: []routeParam{{Name: "channel", Type: paramString, Description: "Channel to replay the deliveries of"}}, Response: []notificationDeliveryResponse{}},
+	{Method: "POST", Pattern: "/ob/notificationdeliveries/{deliveryId}", Handler: (*jsonAPIHandler).POSTNotificationDeliveries, Tag: "notifications", Summary: "Replay a delivery", Response: []notificationDeliveryResponse{}},
+	{Method: "DELETE", Pattern: "/ob/notificationdeliveries/{deliveryId}", Handler: (*jsonAPIHandler).DELETENotificationDelivery, Tag: "notifications", Summary: "Delete a delivery"},
+
+	// Wallet
+	{Method: "GET", Pattern: "/ob/export", Handler: (*jsonAPIHandler).GETExport, Tag: "wallet", Summary: "Accounting ledger of sales, purchases and wallet transactions", Query: []routeParam{
+		{Name: "from", Type: paramString, Format: "date", Description: "First day of the range, YYYY-MM-DD or an RFC 3339 time"},
+		{Name: "to", Type: paramString, Format: "date", Description: "Last day of the range, YYYY-MM-DD or an RFC 3339 time"},
+		{Name: "format", Type: paramString, Description: "csv (default), ofx or qif"},
+	}, Private: true},
+	{Method: "GET", Pattern: "/ob/exchangerate", Handler: (*jsonAPIHandler).GETExchangeRate, Tag: "wallet", Summary: "Exchange rates of the default wallet"},
+	{Method: "GET", Pattern: "/ob/exchangerate/history", Handler: (*jsonAPIHandler).GETExchangeRateHistory, Tag: "wallet", Summary: "Recorded exchange rate snapshots", Query: []routeParam{
+		{Name: "coin", Type: paramString, Description: "Only snapshots of this coin"},
+		{Name: "currency", Type: paramString, Description: "Return only the rate in this currency"},
+		{Name: "from", Type: paramString, Format: "date", Description: "First day of the range, YYYY-MM-DD or an RFC 3339 time"},
+		{Name: "to", Type: paramString, Format: "date", Description: "Last day of the range, YYYY-MM-DD or an RFC 3339 time"},
+		{Name: "reason", Type: paramString, Description: "periodic, funded, released or refunded"},
+		{Name: "orderId", Type: paramString, Description: "Only snapshots taken for this order"},
+		{Name: "at", Type: paramString, Format: "date-time", Description: "Return the latest snapshot of the coin taken at or before this time"},
+		{Name: "offset", Type: paramInteger, Description: "Number of snapshots to skip"},
+		{Name: "limit", Type: paramInteger, Description: "Maximum number of snapshots"},
+	}, Response: []exchangeRateSnapshotResponse{}},
+	{Method: "GET", Pattern: "/ob/exchangerate/{coin}", Handler: (*jsonAPIHandler).GETExchangeRate, Tag: "wallet", Summary: "Exchange rates of a coin"},
+	{Method: "GET", Pattern: "/ob/exchangerate/{coin}/{currency}", Handler: (*jsonAPIHandler).GETExchangeRate, Tag: "wallet", Summary: "Exchange rate of a coin in a currency"},
+	{Method: "GET", Pattern: "/ob/exchangerates", Handler: (*jsonAPIHandler).GETExchangeRate, Tag: "wallet", Summary: "Exchange rates of the default wallet"},
+	{Method: "GET", Pattern: "/ob/exchangerates/{coin}", Handler: (*jsonAPIHandler).GETExchangeRate, Tag: "wallet", Summary: "Exchange rates of a coin"},
+	{Method: "GET", Pattern: "/ob/exchangerates/{coin}/{currency}", Handler: (*jsonAPIHandler).GETExchangeRate, Tag: "wallet", Summary: "Exchange rate of a coin in a currency"},
+	{Method: "GET", Pattern: "/wallet/address", Handler: (*jsonAPIHandler).GETAddress, Tag: "wallet", Summary: "Current receiving address of every wallet"},
+	{Method: "GET", Pattern: "/wallet/address/{coin}", Handler: (*jsonAPIHandler).GETAddress, Tag: "wallet", Summary: "Current receiving address of a wallet"},
+	{Method: "GET", Pattern: "/wallet/balance", Handler: (*jsonAPIHandler).GETBalance, Tag: "wallet", Summary: "Balance of every wallet"},
+	{Method: "GET", Pattern: "/wallet/balance/{coin}", Handler: (*jsonAPIHandler).GETBalance, Tag: "wallet", Summary: "Balance of a wallet"},
+	{Method: "GET", Pattern: "/wallet/mnemonic", Handler: (*jsonAPIHandler).GETMnemonic, Tag: "wallet", Summary: "Mnemonic seed of the wallets", Private: true},
+	{Method: "GET", Pattern: "/wallet/transactions/{coin}", Handler: (*jsonAPIHandler).GETTransactions, Tag: "wallet", Summary: "Transactions of a wallet", Query: []routeParam{limitParam, offsetIDParam}},
+	{Method: "GET", Pattern: "/wallet/status", Handler: (*jsonAPIHandler).GETWalletStatus, Tag: "wallet", Summary: "Sync status of every wallet"},
+	{Method: "GET", Pattern: "/wallet/status/{coin}", Handler: (*jsonAPIHandler).GETWalletStatus, Tag: "wallet", Summary: "Sync status of a wallet"},
+	{Method: "GET", Pattern: "/wallet/fees", Handler: (*jsonAPIHandler).GETFees, Tag: "wallet", Summary: "Fee levels of every wallet"},
+	{Method: "GET", Pattern: "/wallet/fees/{coin}", Handler: (*jsonAPIHandler).GETFees, Tag: "wallet", Summary: "Fee levels of a wallet"},
+	{Method: "GET", Pattern: "/wallet/estimatefee/{coin}", Handler: (*jsonAPIHandler).GETEstimateFee, Tag: "wallet", Summary: "Estimate the fee of a spend", Query: []routeParam{
+		{Name: "feeLevel", Type: paramString, Description: "Fee level: PRIORITY, NORMAL or ECONOMIC"},
+		{Name: "amount", Type: paramInteger, Description: "Amount to spend in the coin's smallest unit"},
+	}},
+	{Method: "POST", Pattern: "/wallet/spend", Handler: (*jsonAPIHandler).POSTSpendCoins, Tag: "wallet", Summary: "Spend coins from a wallet", Request: core.SpendRequest{}, Scope: repo.APITokenScopeWalletSpend},
+	{Method: "POST", Pattern: "/wallet/bumpfee/{txid}", Handler: (*jsonAPIHandler).POSTBumpFee, Tag: "wallet", Summary: "Bump the fee of an unconfirmed transaction", Scope: repo.APITokenScopeWalletSpend},
+	{Method: "POST", Pattern: "/wallet/resyncblockchain", Handler: (*jsonAPIHandler).POSTResyncBlockchain, Tag: "wallet", Summary: "Rescan the blockchain for every wallet"},
+	{Method: "POST", Pattern: "/wallet/resyncblockchain/{coin}", Handler: (*jsonAPIHandler).POSTResyncBlockchain, Tag: "wallet", Summary: "Rescan the blockchain for a wallet"},
 }
 
+var apiRouter = newRouter(apiRoutes)
+
 func blockingStartupMiddleware(i *jsonAPIHandler, w http.ResponseWriter, r *http.Request, requestFunc func(w http.ResponseWriter, r *http.Request)) {
 	i.node.Service.WaitForReady()
 	requestFunc(w, r)
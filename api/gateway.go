@@ -25,17 +25,15 @@ func NewGateway(n *core.OpenBazaarNode, authCookie http.Cookie, l net.Listener,
 	log.SetBackend(logging.AddModuleLevel(logger))
 	topMux := http.NewServeMux()
 
-	jsonAPI := newJSONAPIHandler(n, authCookie, config)
-	wsAPI := newWSAPIHandler(n, authCookie, config)
+	credentials := newAPICredentials(authCookie, config, l.Addr())
+	jsonAPI := newJSONAPIHandler(n, credentials, config)
+	wsAPI := newWSAPIHandler(n, credentials, config)
 	n.Broadcast = manageNotifications(n, wsAPI.h.Broadcast)
 
 	topMux.Handle("/ob/", jsonAPI)
 	topMux.Handle("/wallet/", jsonAPI)
 	topMux.Handle("/ws", wsAPI)
 
-	topMux.HandleFunc("/kimitzu/info", KimitzuInfo(n, authCookie, config))
-	topMux.HandleFunc("/kimitzu/config", KimitzuConfig(n, authCookie, config))
-
 	var (
 		err error
 		mux = topMux
@@ -5,6 +5,8 @@ import (
 	"context"
 	"crypto/rand"
 	"crypto/sha256"
+	"database/sql"
+	"encoding/csv"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
@@ -49,42 +51,54 @@ import (
 )
 
 type JSONAPIConfig struct {
-	Headers       map[string]interface{}
-	Enabled       bool
-	Cors          *string
-	Authenticated bool
-	AllowedIPs    map[string]bool
-	Cookie        http.Cookie
-	Username      string
-	Password      string
+	Headers    map[string]interface{}
+	Enabled    bool
+	Cors       *string
+	AllowedIPs map[string]bool
 }
 
 type jsonAPIHandler struct {
-	config JSONAPIConfig
-	node   *core.OpenBazaarNode
+	config      JSONAPIConfig
+	credentials *apiCredentials
+	node        *core.OpenBazaarNode
+	router      *router
 }
 
 var lastManualScan time.Time
 
 const OfflineMessageScanInterval = 1 * time.Minute
 
-func newJSONAPIHandler(node *core.OpenBazaarNode, authCookie http.Cookie, config schema.APIConfig) *jsonAPIHandler {
+// DefaultNearbyRadiusKm - search radius used by /ob/search/nearby when none is given
+const DefaultNearbyRadiusKm = 25.0
+
+const (
+	// DefaultSearchLimit - page size used by /ob/search when none is given
+	DefaultSearchLimit = 20
+	// MaxSearchLimit - the largest page size accepted by /ob/search
+	MaxSearchLimit = 100
+	// DefaultNotificationDeliveryLimit - page size used by /ob/notificationdeliveries when none is given
+	DefaultNotificationDeliveryLimit = 50
+	// DefaultAuditLimit - page size used by /ob/audit when none is given
+	DefaultAuditLimit = 50
+	// DefaultExchangeRateHistoryLimit - page size used by /ob/exchangerate/history when none is given
+	DefaultExchangeRateHistoryLimit = 50
+)
+
+func newJSONAPIHandler(node *core.OpenBazaarNode, credentials *apiCredentials, config schema.APIConfig) *jsonAPIHandler {
 	allowedIPs := make(map[string]bool)
 	for _, ip := range config.AllowedIPs {
 		allowedIPs[ip] = true
 	}
 	i := &jsonAPIHandler{
 		config: JSONAPIConfig{
-			Enabled:       config.Enabled,
-			Cors:          config.CORS,
-			Headers:       config.HTTPHeaders,
-			Authenticated: config.Authenticated,
-			AllowedIPs:    allowedIPs,
-			Cookie:        authCookie,
-			Username:      config.Username,
-			Password:      config.Password,
+			Enabled:    config.Enabled,
+			Cors:       config.CORS,
+			Headers:    config.HTTPHeaders,
+			AllowedIPs: allowedIPs,
 		},
-		node: node,
+		credentials: credentials,
+		node:        node,
+		router:      apiRouter,
 	}
 	return i
 }
@@ -95,7 +109,7 @@ func (i *jsonAPIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		log.Error(err)
 		return
 	}
-	if !i.config.Enabled && !gatewayAllowedPath(u.Path, r.Method) {
+	if !i.config.Enabled && !i.router.gatewayAllowed(r.Method, u.Path) {
 		w.WriteHeader(http.StatusForbidden)
 		fmt.Fprint(w, "403 - Forbidden")
 		return
@@ -118,16 +132,27 @@ func (i *jsonAPIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		w.Header()[k] = v.([]string)
 	}
 
-	// Modified to skip authentication for local requests
-	if i.config.Authenticated && !strings.HasPrefix(r.RemoteAddr, "127.0.0.1") {
-		if i.config.Username == "" || i.config.Password == "" {
+	// Scoped API tokens are checked even for local requests so a token
+	// never grants more than its scopes
+	var actor string
+	if token, ok := bearerAPIToken(r); ok {
+		if r.Method != "OPTIONS" {
+			record, ok := i.authorizeAPIToken(w, r, token, u.Path)
+			if !ok {
+				return
+			}
+			actor = "token:" + record.TokenID
+		}
+	} else if auth := i.credentials.get(); auth.Authenticated && !strings.HasPrefix(r.RemoteAddr, "127.0.0.1") {
+		// Modified to skip authentication for local requests
+		if auth.Username == "" || auth.Password == "" {
 			cookie, err := r.Cookie("OpenBazaar_Auth_Cookie")
 			if err != nil {
 				w.WriteHeader(http.StatusForbidden)
 				fmt.Fprint(w, "403 - Forbidden")
 				return
 			}
-			if i.config.Cookie.Value != cookie.Value {
+			if auth.Cookie.Value != cookie.Value {
 				w.WriteHeader(http.StatusForbidden)
 				fmt.Fprint(w, "403 - Forbidden")
 				return
@@ -143,8 +168,8 @@ func (i *jsonAPIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			username, password, ok := r.BasicAuth()
 			h := sha256.Sum256([]byte(password))
 			password = hex.EncodeToString(h[:])
-			if !ok || username != i.config.Username || !strings.EqualFold(password, i.config.Password) {
-				fmt.Println("Invalid authentication", i.config.Username, i.config.Password, username, password)
+			if !ok || username != auth.Username || !strings.EqualFold(password, auth.Password) {
+				log.Warningf("invalid API authentication from %s", r.RemoteAddr)
 				w.WriteHeader(http.StatusForbidden)
 				fmt.Fprint(w, "403 - Forbidden")
 				return
@@ -156,6 +181,17 @@ func (i *jsonAPIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	if r.Method == "OPTIONS" {
 		return
 	}
+	// Admin routes need the node's credentials even where the checks above
+	// let requests through
+	if i.router.adminOnly(r.Method, u.Path) {
+		if auth := i.credentials.get(); !auth.validBasicAuth(r) && !auth.validCookie(r) {
+			ErrorResponse(w, http.StatusUnauthorized, "admin endpoints need the API credentials or cookie")
+			return
+		}
+	}
+	if actor == "" {
+		actor = i.credentials.get().actor(r)
+	}
 	r.Header.Del("Cookie")
 	r.Header.Del("Authorization")
 	dump, err := httputil.DumpRequest(r, false)
@@ -172,20 +208,11 @@ func (i *jsonAPIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}()
 
 	w.Header().Add("Content-Type", "application/json")
-	switch r.Method {
-	case "GET":
-		get(i, u.String(), w, r)
-	case "POST":
-		post(i, u.String(), w, r)
-	case "PUT":
-		put(i, u.String(), w, r)
-	case "DELETE":
-		deleter(i, u.String(), w, r)
-	case "PATCH":
-		patch(i, u.String(), w, r)
-	case "HEAD":
-		get(i, u.String(), w, r)
+	if auditedMethods[r.Method] {
+		i.serveAudited(w, r, u.Path, actor)
+		return
 	}
+	i.router.serve(i, w, r, u.Path)
 }
 
 func ErrorResponse(w http.ResponseWriter, errorCode int, reason string) {
@@ -269,6 +296,10 @@ func (i *jsonAPIHandler) POSTProfile(w http.ResponseWriter, r *http.Request) {
 	profile.ProfileType = "kimitzu"
 	profile.MetaTags = make(map[string]string)
 	profile.MetaTags["KimitzuVersion"] = core.KIMITZU_VERSION
+	if err := fillAddressCoordinates(profileAddresses(profile)...); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
 
 	// Save to file
 	err = i.node.UpdateProfile(profile)
@@ -329,6 +360,10 @@ func (i *jsonAPIHandler) PUTProfile(w http.ResponseWriter, r *http.Request) {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
+	if err := fillAddressCoordinates(profileAddresses(profile)...); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
 
 	// Save to file
 	err = i.node.UpdateProfile(profile)
@@ -421,12 +456,13 @@ func (i *jsonAPIHandler) PATCHProfile(w http.ResponseWriter, r *http.Request) {
 	SanitizedResponse(w, `{}`)
 }
 
+type avatarRequest struct {
+	Avatar string `json:"avatar"`
+}
+
 func (i *jsonAPIHandler) POSTAvatar(w http.ResponseWriter, r *http.Request) {
-	type ImgData struct {
-		Avatar string `json:"avatar"`
-	}
 	decoder := json.NewDecoder(r.Body)
-	data := new(ImgData)
+	data := new(avatarRequest)
 	err := decoder.Decode(&data)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -458,12 +494,13 @@ func (i *jsonAPIHandler) POSTAvatar(w http.ResponseWriter, r *http.Request) {
 	SanitizedResponse(w, string(jsonHashes))
 }
 
+type headerRequest struct {
+	Header string `json:"header"`
+}
+
 func (i *jsonAPIHandler) POSTHeader(w http.ResponseWriter, r *http.Request) {
-	type ImgData struct {
-		Header string `json:"header"`
-	}
 	decoder := json.NewDecoder(r.Body)
-	data := new(ImgData)
+	data := new(headerRequest)
 	err := decoder.Decode(&data)
 
 	if err != nil {
@@ -495,13 +532,14 @@ func (i *jsonAPIHandler) POSTHeader(w http.ResponseWriter, r *http.Request) {
 	SanitizedResponse(w, string(jsonHashes))
 }
 
+type imageRequest struct {
+	Filename string `json:"filename"`
+	Image    string `json:"image"`
+}
+
 func (i *jsonAPIHandler) POSTImage(w http.ResponseWriter, r *http.Request) {
-	type ImgData struct {
-		Filename string `json:"filename"`
-		Image    string `json:"image"`
-	}
 	decoder := json.NewDecoder(r.Body)
-	var images []ImgData
+	var images []imageRequest
 	err := decoder.Decode(&images)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -536,6 +574,10 @@ func (i *jsonAPIHandler) POSTListing(w http.ResponseWriter, r *http.Request) {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
+	if err := fillAddressCoordinates(ld.Location); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
 
 	err = i.node.CreateListing(ld)
 	if err != nil {
@@ -558,6 +600,10 @@ func (i *jsonAPIHandler) PUTListing(w http.ResponseWriter, r *http.Request) {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
+	if err := fillAddressCoordinates(ld.Location); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
 
 	err = i.node.UpdateListing(ld, true)
 	if err != nil {
@@ -650,13 +696,13 @@ func (i *jsonAPIHandler) GETPeers(w http.ResponseWriter, r *http.Request) {
 	SanitizedResponse(w, string(peerJSON))
 }
 
-func (i *jsonAPIHandler) POSTFollow(w http.ResponseWriter, r *http.Request) {
-	type PeerID struct {
-		ID string `json:"id"`
-	}
+type followRequest struct {
+	ID string `json:"id"`
+}
 
+func (i *jsonAPIHandler) POSTFollow(w http.ResponseWriter, r *http.Request) {
 	decoder := json.NewDecoder(r.Body)
-	var pid PeerID
+	var pid followRequest
 	err := decoder.Decode(&pid)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -670,11 +716,8 @@ func (i *jsonAPIHandler) POSTFollow(w http.ResponseWriter, r *http.Request) {
 }
 
 func (i *jsonAPIHandler) POSTUnfollow(w http.ResponseWriter, r *http.Request) {
-	type PeerID struct {
-		ID string `json:"id"`
-	}
 	decoder := json.NewDecoder(r.Body)
-	var pid PeerID
+	var pid followRequest
 	err := decoder.Decode(&pid)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -857,7 +900,7 @@ func (i *jsonAPIHandler) POSTSettings(w http.ResponseWriter, r *http.Request) {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	if err = validateSMTPSettings(settings); err != nil {
+	if err = validateNotifierSettings(settings); err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
@@ -919,7 +962,7 @@ func (i *jsonAPIHandler) PUTSettings(w http.ResponseWriter, r *http.Request) {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	if err = validateSMTPSettings(settings); err != nil {
+	if err = validateNotifierSettings(settings); err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
@@ -996,7 +1039,7 @@ func (i *jsonAPIHandler) PATCHSettings(w http.ResponseWriter, r *http.Request) {
 		ErrorResponse(w, http.StatusNotFound, "Settings is not yet set. Use POST.")
 		return
 	}
-	if err = validateSMTPSettings(settings); err != nil {
+	if err = validateNotifierSettings(settings); err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
@@ -1266,14 +1309,15 @@ func (i *jsonAPIHandler) GETInventory(w http.ResponseWriter, r *http.Request) {
 	SanitizedResponse(w, string(inventoryBytes))
 }
 
+type inventoryRequest struct {
+	Slug     string `json:"slug"`
+	Variant  int    `json:"variant"`
+	Quantity int64  `json:"quantity"`
+}
+
 func (i *jsonAPIHandler) POSTInventory(w http.ResponseWriter, r *http.Request) {
-	type inv struct {
-		Slug     string `json:"slug"`
-		Variant  int    `json:"variant"`
-		Quantity int64  `json:"quantity"`
-	}
 	decoder := json.NewDecoder(r.Body)
-	var invList []inv
+	var invList []inventoryRequest
 	err := decoder.Decode(&invList)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -1334,6 +1378,20 @@ func (i *jsonAPIHandler) PUTModerator(w http.ResponseWriter, r *http.Request) {
 	SanitizedResponse(w, "{}")
 }
 
+func (i *jsonAPIHandler) GETModeratorStats(w http.ResponseWriter, r *http.Request) {
+	stats, err := i.node.ModeratorStats()
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	b, err := json.MarshalIndent(stats, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(b))
+}
+
 func (i *jsonAPIHandler) DELETEModerator(w http.ResponseWriter, r *http.Request) {
 	profile, err := i.node.GetProfile()
 	if err != nil {
@@ -1478,6 +1536,11 @@ func (i *jsonAPIHandler) GETListing(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	sl.Hash = hash
+	if sl.Listing != nil && sl.Listing.VendorID != nil && sl.Listing.VendorID.PeerID == peerID {
+		if err := i.node.IndexListingLocation(sl.Listing, hash); err != nil {
+			log.Errorf("indexing location of listing %s: %s", listingID, err)
+		}
+	}
 	out, err := m.MarshalToString(sl)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
@@ -1538,13 +1601,14 @@ func (i *jsonAPIHandler) GETIsFollowing(w http.ResponseWriter, r *http.Request)
 	SanitizedResponse(w, fmt.Sprintf(`{"isFollowing": %t}`, i.node.Datastore.Following().IsFollowing(peerID)))
 }
 
+type orderConfirmationRequest struct {
+	OrderID string `json:"orderId"`
+	Reject  bool   `json:"reject"`
+}
+
 func (i *jsonAPIHandler) POSTOrderConfirmation(w http.ResponseWriter, r *http.Request) {
-	type orderConf struct {
-		OrderID string `json:"orderId"`
-		Reject  bool   `json:"reject"`
-	}
 	decoder := json.NewDecoder(r.Body)
-	var conf orderConf
+	var conf orderConfirmationRequest
 	err := decoder.Decode(&conf)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -1588,12 +1652,13 @@ func (i *jsonAPIHandler) POSTOrderConfirmation(w http.ResponseWriter, r *http.Re
 	SanitizedResponse(w, `{}`)
 }
 
+type orderIDRequest struct {
+	OrderID string `json:"orderId"`
+}
+
 func (i *jsonAPIHandler) POSTOrderCancel(w http.ResponseWriter, r *http.Request) {
-	type orderCancel struct {
-		OrderID string `json:"orderId"`
-	}
 	decoder := json.NewDecoder(r.Body)
-	var can orderCancel
+	var can orderIDRequest
 	err := decoder.Decode(&can)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -1736,11 +1801,8 @@ func (i *jsonAPIHandler) POSTShutdown(w http.ResponseWriter, r *http.Request) {
 }
 
 func (i *jsonAPIHandler) POSTRefund(w http.ResponseWriter, r *http.Request) {
-	type orderCancel struct {
-		OrderID string `json:"orderId"`
-	}
 	decoder := json.NewDecoder(r.Body)
-	var can orderCancel
+	var can orderIDRequest
 	err := decoder.Decode(&can)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -1751,8 +1813,9 @@ func (i *jsonAPIHandler) POSTRefund(w http.ResponseWriter, r *http.Request) {
 		ErrorResponse(w, http.StatusNotFound, "order not found")
 		return
 	}
-	if state != pb.OrderState_AWAITING_FULFILLMENT && state != pb.OrderState_PARTIALLY_FULFILLED {
-		ErrorResponse(w, http.StatusBadRequest, "order must be AWAITING_FULFILLMENT, or PARTIALLY_FULFILLED")
+	if state != pb.OrderState_AWAITING_FULFILLMENT && state != pb.OrderState_PARTIALLY_FULFILLED && state != pb.OrderState_PARTIALLY_REFUNDED &&
+		state != pb.OrderState_MILESTONE_FUNDED && state != pb.OrderState_MILESTONE_RELEASED {
+		ErrorResponse(w, http.StatusBadRequest, "order must be AWAITING_FULFILLMENT, PARTIALLY_FULFILLED, PARTIALLY_REFUNDED, MILESTONE_FUNDED or MILESTONE_RELEASED")
 		return
 	}
 
@@ -1772,6 +1835,55 @@ func (i *jsonAPIHandler) POSTRefund(w http.ResponseWriter, r *http.Request) {
 	SanitizedResponse(w, `{}`)
 }
 
+func (i *jsonAPIHandler) POSTPartialRefund(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var data core.PartialRefundData
+	err := decoder.Decode(&data)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	contract, state, _, records, _, paymentCoin, err := i.node.Datastore.Sales().GetByOrderId(data.OrderID)
+	if err != nil {
+		ErrorResponse(w, http.StatusNotFound, "order not found")
+		return
+	}
+	if state != pb.OrderState_AWAITING_FULFILLMENT && state != pb.OrderState_PARTIALLY_FULFILLED && state != pb.OrderState_PARTIALLY_REFUNDED {
+		ErrorResponse(w, http.StatusBadRequest, "order must be AWAITING_FULFILLMENT, PARTIALLY_FULFILLED or PARTIALLY_REFUNDED")
+		return
+	}
+	if data.Amount == 0 && len(data.Items) == 0 {
+		ErrorResponse(w, http.StatusBadRequest, "amount or items must be set")
+		return
+	}
+
+	// TODO: Remove once broken contracts are migrated
+	lookupCoin := contract.BuyerOrder.Payment.Coin
+	_, err = repo.LoadCurrencyDefinitions().Lookup(lookupCoin)
+	if err != nil {
+		log.Warningf("invalid BuyerOrder.Payment.Coin (%s) on order (%s)", lookupCoin, data.OrderID)
+		contract.BuyerOrder.Payment.Coin = paymentCoin.String()
+	}
+
+	refund, err := i.node.PartialRefundOrder(contract, records, &data)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(refund)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponseM(w, out, new(pb.PartialRefund))
+}
+
 func (i *jsonAPIHandler) GETModerators(w http.ResponseWriter, r *http.Request) {
 	query := r.URL.Query().Get("async")
 	async, _ := strconv.ParseBool(query)
@@ -1959,8 +2071,8 @@ func (i *jsonAPIHandler) POSTOrderFulfill(w http.ResponseWriter, r *http.Request
 		contract.BuyerOrder.Payment.Coin = paymentCoin.String()
 	}
 
-	if state != pb.OrderState_AWAITING_FULFILLMENT && state != pb.OrderState_PARTIALLY_FULFILLED {
-		ErrorResponse(w, http.StatusBadRequest, "order must be in state AWAITING_FULFILLMENT or PARTIALLY_FULFILLED to fulfill")
+	if state != pb.OrderState_AWAITING_FULFILLMENT && state != pb.OrderState_PARTIALLY_FULFILLED && state != pb.OrderState_PARTIALLY_REFUNDED && state != pb.OrderState_MILESTONE_FUNDED {
+		ErrorResponse(w, http.StatusBadRequest, "order must be in state AWAITING_FULFILLMENT, PARTIALLY_FULFILLED, PARTIALLY_REFUNDED or MILESTONE_FUNDED to fulfill")
 		return
 	}
 	err = i.node.FulfillOrder(&fulfill, contract, records)
@@ -1971,22 +2083,20 @@ func (i *jsonAPIHandler) POSTOrderFulfill(w http.ResponseWriter, r *http.Request
 	SanitizedResponse(w, `{}`)
 }
 
-func (i *jsonAPIHandler) POSTOrderComplete(w http.ResponseWriter, r *http.Request) {
-	checkRatingValue := func(val int) bool {
-		if val < core.RatingMin || val > core.RatingMax {
-			ErrorResponse(w, http.StatusBadRequest, "rating values must be between 1 and 5")
-			return false
-		}
-		return true
-	}
+type milestoneReleaseRequest struct {
+	OrderID string `json:"orderId"`
+	Note    string `json:"note"`
+}
+
+func (i *jsonAPIHandler) POSTReleaseMilestone(w http.ResponseWriter, r *http.Request) {
 	decoder := json.NewDecoder(r.Body)
-	var or core.OrderRatings
-	err := decoder.Decode(&or)
+	var rel milestoneReleaseRequest
+	err := decoder.Decode(&rel)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	contract, state, _, records, _, paymentCoin, err := i.node.Datastore.Purchases().GetByOrderId(or.OrderID)
+	contract, state, _, records, _, paymentCoin, err := i.node.Datastore.Purchases().GetByOrderId(rel.OrderID)
 	if err != nil {
 		ErrorResponse(w, http.StatusNotFound, "order not found")
 		return
@@ -1996,54 +2106,19 @@ func (i *jsonAPIHandler) POSTOrderComplete(w http.ResponseWriter, r *http.Reques
 	lookupCoin := contract.BuyerOrder.Payment.Coin
 	_, err = repo.LoadCurrencyDefinitions().Lookup(lookupCoin)
 	if err != nil {
-		log.Warningf("invalid BuyerOrder.Payment.Coin (%s) on order (%s)", lookupCoin, or.OrderID)
+		log.Warningf("invalid BuyerOrder.Payment.Coin (%s) on order (%s)", lookupCoin, rel.OrderID)
 		contract.BuyerOrder.Payment.Coin = paymentCoin.String()
 	}
 
-	if state != pb.OrderState_FULFILLED &&
-		state != pb.OrderState_RESOLVED &&
-		state != pb.OrderState_PAYMENT_FINALIZED {
-		errorString := fmt.Sprintf("must be one of the following states to leave a rating and complete the order: %s, %s, %s",
-			pb.OrderState_FULFILLED.String(),
-			pb.OrderState_RESOLVED.String(),
-			pb.OrderState_PAYMENT_FINALIZED.String(),
-		)
-		ErrorResponse(w, http.StatusBadRequest, errorString)
+	if !core.IsMilestoneOrder(contract) {
+		ErrorResponse(w, http.StatusBadRequest, "order does not contain milestones")
 		return
 	}
-
-	if len(contract.VendorOrderFulfillment) == 0 && contract.BuyerOrder.Payment.Method == pb.Order_Payment_MODERATED {
-		ErrorResponse(w, http.StatusBadRequest, "moderated orders can only be completed if the vendor has fulfilled the order")
+	if state != pb.OrderState_MILESTONE_FULFILLED {
+		ErrorResponse(w, http.StatusBadRequest, "order must be in state MILESTONE_FULFILLED to release a milestone")
 		return
 	}
-
-	for _, rd := range or.Ratings {
-		if rd.Slug == "" {
-			ErrorResponse(w, http.StatusBadRequest, "rating must contain the slug")
-			return
-		}
-		if !checkRatingValue(rd.Overall) {
-			return
-		}
-		if !checkRatingValue(rd.Quality) {
-			return
-		}
-		if !checkRatingValue(rd.Description) {
-			return
-		}
-		if !checkRatingValue(rd.DeliverySpeed) {
-			return
-		}
-		if !checkRatingValue(rd.CustomerService) {
-			return
-		}
-		if len(rd.Review) > core.ReviewMaxCharacters {
-			ErrorResponse(w, http.StatusBadRequest, "too many characters in review")
-			return
-		}
-	}
-
-	err = i.node.CompleteOrder(&or, contract, records)
+	err = i.node.ReleaseMilestone(contract, records, rel.Note)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
@@ -2051,63 +2126,44 @@ func (i *jsonAPIHandler) POSTOrderComplete(w http.ResponseWriter, r *http.Reques
 	SanitizedResponse(w, `{}`)
 }
 
-func (i *jsonAPIHandler) POSTOpenDispute(w http.ResponseWriter, r *http.Request) {
-	type dispute struct {
-		OrderID string `json:"orderId"`
-		Claim   string `json:"claim"`
-	}
+type timesheetEntryRequest struct {
+	OrderID     string    `json:"orderId"`
+	Start       time.Time `json:"start"`
+	End         time.Time `json:"end"`
+	Description string    `json:"description"`
+}
+
+func (i *jsonAPIHandler) POSTTimesheetEntry(w http.ResponseWriter, r *http.Request) {
 	decoder := json.NewDecoder(r.Body)
-	var d dispute
-	err := decoder.Decode(&d)
+	var entry timesheetEntryRequest
+	err := decoder.Decode(&entry)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	var (
-		isSale      bool
-		contract    *pb.RicardianContract
-		state       pb.OrderState
-		records     []*wallet.TransactionRecord
-		paymentCoin *repo.CurrencyCode
-	)
-	contract, state, _, records, _, paymentCoin, err = i.node.Datastore.Purchases().GetByOrderId(d.OrderID)
+	contract, state, _, _, _, paymentCoin, err := i.node.Datastore.Sales().GetByOrderId(entry.OrderID)
 	if err != nil {
-		contract, state, _, records, _, paymentCoin, err = i.node.Datastore.Sales().GetByOrderId(d.OrderID)
-		if err != nil {
-			ErrorResponse(w, http.StatusNotFound, "Order not found")
-			return
-		}
-		isSale = true
+		ErrorResponse(w, http.StatusNotFound, "order not found")
+		return
 	}
 
 	// TODO: Remove once broken contracts are migrated
 	lookupCoin := contract.BuyerOrder.Payment.Coin
 	_, err = repo.LoadCurrencyDefinitions().Lookup(lookupCoin)
 	if err != nil {
-		log.Warningf("invalid BuyerOrder.Payment.Coin (%s) on order (%s)", lookupCoin, d.OrderID)
+		log.Warningf("invalid BuyerOrder.Payment.Coin (%s) on order (%s)", lookupCoin, entry.OrderID)
 		contract.BuyerOrder.Payment.Coin = paymentCoin.String()
 	}
 
-	if contract.BuyerOrder.Payment.Method != pb.Order_Payment_MODERATED {
-		ErrorResponse(w, http.StatusBadRequest, "Only moderated orders can be disputed")
-		return
-	}
-
-	if isSale && (state != pb.OrderState_PARTIALLY_FULFILLED && state != pb.OrderState_FULFILLED) {
-		ErrorResponse(w, http.StatusBadRequest, "Order must be either PARTIALLY_FULFILLED or FULFILLED to start a dispute")
+	if !core.IsTimesheetOrder(contract) {
+		ErrorResponse(w, http.StatusBadRequest, core.ErrNotTimesheetOrder.Error())
 		return
 	}
-	if !isSale && !(state == pb.OrderState_AWAITING_FULFILLMENT || state == pb.OrderState_PENDING || state == pb.OrderState_PARTIALLY_FULFILLED || state == pb.OrderState_FULFILLED || state == pb.OrderState_PROCESSING_ERROR) {
-		ErrorResponse(w, http.StatusBadRequest, "Order must be either AWAITING_FULFILLMENT, PARTIALLY_FULFILLED, PENDING, PROCESSING_ERROR or FULFILLED to start a dispute")
-		return
-	}
-
-	if !isSale && state == pb.OrderState_PROCESSING_ERROR && len(records) == 0 {
-		ErrorResponse(w, http.StatusBadRequest, "Cannot dispute an unfunded order")
+	if state != pb.OrderState_AWAITING_FULFILLMENT {
+		ErrorResponse(w, http.StatusBadRequest, "order must be in state AWAITING_FULFILLMENT to log time")
 		return
 	}
-
-	err = i.node.OpenDispute(d.OrderID, contract, records, d.Claim)
+	err = i.node.SubmitTimesheetEntry(contract, state, entry.Start, entry.End, entry.Description)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
@@ -2115,994 +2171,1016 @@ func (i *jsonAPIHandler) POSTOpenDispute(w http.ResponseWriter, r *http.Request)
 	SanitizedResponse(w, `{}`)
 }
 
-func (i *jsonAPIHandler) POSTCloseDispute(w http.ResponseWriter, r *http.Request) {
-	type disputeParams struct {
-		OrderID          string  `json:"orderId"`
-		Resolution       string  `json:"resolution"`
-		BuyerPercentage  float32 `json:"buyerPercentage"`
-		VendorPercentage float32 `json:"vendorPercentage"`
-	}
+type timesheetReviewRequest struct {
+	OrderID    string `json:"orderId"`
+	EntryIndex uint32 `json:"entryIndex"`
+	Status     string `json:"status"`
+	Reason     string `json:"reason"`
+}
+
+func (i *jsonAPIHandler) POSTTimesheetReview(w http.ResponseWriter, r *http.Request) {
 	decoder := json.NewDecoder(r.Body)
-	var d disputeParams
-	err := decoder.Decode(&d)
+	var review timesheetReviewRequest
+	err := decoder.Decode(&review)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
+	status, ok := pb.TimesheetReview_Status_value[strings.ToUpper(review.Status)]
+	if !ok || status == int32(pb.TimesheetReview_PENDING) {
+		ErrorResponse(w, http.StatusBadRequest, "status must be either APPROVED or CONTESTED")
+		return
+	}
+	contract, state, _, _, _, paymentCoin, err := i.node.Datastore.Purchases().GetByOrderId(review.OrderID)
+	if err != nil {
+		ErrorResponse(w, http.StatusNotFound, "order not found")
+		return
+	}
 
-	disputeCase, err := i.node.Datastore.Cases().GetByCaseID(d.OrderID)
+	// TODO: Remove once broken contracts are migrated
+	lookupCoin := contract.BuyerOrder.Payment.Coin
+	_, err = repo.LoadCurrencyDefinitions().Lookup(lookupCoin)
 	if err != nil {
-		ErrorResponse(w, http.StatusNotFound, err.Error())
+		log.Warningf("invalid BuyerOrder.Payment.Coin (%s) on order (%s)", lookupCoin, review.OrderID)
+		contract.BuyerOrder.Payment.Coin = paymentCoin.String()
 	}
 
-	err = i.node.CloseDispute(disputeCase.CaseID, d.BuyerPercentage, d.VendorPercentage, d.Resolution, disputeCase.PaymentCoin)
+	if !core.IsTimesheetOrder(contract) {
+		ErrorResponse(w, http.StatusBadRequest, core.ErrNotTimesheetOrder.Error())
+		return
+	}
+	if state != pb.OrderState_AWAITING_FULFILLMENT {
+		ErrorResponse(w, http.StatusBadRequest, "order must be in state AWAITING_FULFILLMENT to review time")
+		return
+	}
+	err = i.node.ReviewTimesheetEntry(contract, state, review.EntryIndex, pb.TimesheetReview_Status(status), review.Reason)
 	if err != nil {
-		switch err {
-		case core.ErrCaseNotFound:
-			ErrorResponse(w, http.StatusNotFound, err.Error())
-		case core.ErrCloseFailureCaseExpired:
-			ErrorResponse(w, http.StatusBadRequest, err.Error())
-		default:
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		}
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
 	SanitizedResponse(w, `{}`)
 }
 
-func (i *jsonAPIHandler) GETCase(w http.ResponseWriter, r *http.Request) {
+func (i *jsonAPIHandler) GETTimesheet(w http.ResponseWriter, r *http.Request) {
 	_, orderID := path.Split(r.URL.Path)
-	buyerContract, vendorContract, buyerErrors, vendorErrors, state, read, date, buyerOpened, claim, resolution, err := i.node.Datastore.Cases().GetCaseMetadata(orderID)
+	contract, _, _, _, _, _, err := i.node.Datastore.Purchases().GetByOrderId(orderID)
 	if err != nil {
-		ErrorResponse(w, http.StatusNotFound, err.Error())
-		return
+		contract, _, _, _, _, _, err = i.node.Datastore.Sales().GetByOrderId(orderID)
+		if err != nil {
+			ErrorResponse(w, http.StatusNotFound, "Order not found")
+			return
+		}
 	}
-
-	resp := new(pb.CaseRespApi)
-	ts, err := ptypes.TimestampProto(date)
+	if !core.IsTimesheetOrder(contract) {
+		ErrorResponse(w, http.StatusBadRequest, core.ErrNotTimesheetOrder.Error())
+		return
+	}
+
+	type timesheetEntry struct {
+		Index       uint32    `json:"index"`
+		Start       time.Time `json:"start"`
+		End         time.Time `json:"end"`
+		Hours       float64   `json:"hours"`
+		Description string    `json:"description"`
+		Status      string    `json:"status"`
+		Reason      string    `json:"reason"`
+	}
+	type timesheet struct {
+		OrderID        string           `json:"orderId"`
+		RateMethod     string           `json:"rateMethod"`
+		UnitPrice      uint64           `json:"unitPrice"`
+		EstimatedUnits uint64           `json:"estimatedUnits"`
+		ApprovedHours  float64          `json:"approvedHours"`
+		PendingHours   float64          `json:"pendingHours"`
+		ContestedHours float64          `json:"contestedHours"`
+		ApprovedAmount uint64           `json:"approvedAmount"`
+		Closed         bool             `json:"closed"`
+		Entries        []timesheetEntry `json:"entries"`
+	}
+	ret := timesheet{
+		OrderID:        orderID,
+		RateMethod:     contract.BuyerOrder.BillingRate.RateMethod.String(),
+		UnitPrice:      contract.BuyerOrder.BillingRate.UnitPrice,
+		EstimatedUnits: contract.BuyerOrder.BillingRate.EstimatedUnits,
+		ApprovedHours:  core.TimesheetDuration(contract, pb.TimesheetReview_APPROVED).Hours(),
+		PendingHours:   core.TimesheetDuration(contract, pb.TimesheetReview_PENDING).Hours(),
+		ContestedHours: core.TimesheetDuration(contract, pb.TimesheetReview_CONTESTED).Hours(),
+		ApprovedAmount: core.ApprovedTimesheetAmount(contract),
+		Closed:         len(contract.VendorOrderFulfillment) > 0,
+		Entries:        []timesheetEntry{},
+	}
+	for _, e := range contract.VendorTimesheetEntries {
+		start, _ := ptypes.Timestamp(e.Start)
+		end, _ := ptypes.Timestamp(e.End)
+		duration, _ := core.TimesheetEntryDuration(e)
+		entry := timesheetEntry{
+			Index:       e.Index,
+			Start:       start,
+			End:         end,
+			Hours:       duration.Hours(),
+			Description: e.Description,
+			Status:      core.TimesheetEntryStatus(contract, e.Index).String(),
+		}
+		for _, rv := range contract.BuyerTimesheetReviews {
+			if rv.EntryIndex == e.Index {
+				entry.Reason = rv.Reason
+			}
+		}
+		ret.Entries = append(ret.Entries, entry)
+	}
+	b, err := json.MarshalIndent(ret, "", "    ")
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	resp.BuyerContract = buyerContract
-	resp.VendorContract = vendorContract
-	resp.BuyerOpened = buyerOpened
-	resp.BuyerContractValidationErrors = buyerErrors
-	resp.VendorContractValidationErrors = vendorErrors
-	resp.Read = read
-	resp.State = state
-	resp.Claim = claim
-	resp.Resolution = resolution
-	resp.Timestamp = ts
+	SanitizedResponse(w, string(b))
+}
 
-	unread, err := i.node.Datastore.Chat().GetUnreadCount(orderID)
+// findOrder looks the order up in our purchases, then our sales
+func (i *jsonAPIHandler) findOrder(orderID string) (*pb.RicardianContract, pb.OrderState, error) {
+	contract, state, _, _, _, paymentCoin, err := i.node.Datastore.Purchases().GetByOrderId(orderID)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return
+		contract, state, _, _, _, paymentCoin, err = i.node.Datastore.Sales().GetByOrderId(orderID)
 	}
-	resp.UnreadChatMessages = uint64(unread)
-
-	m := jsonpb.Marshaler{
-		EnumsAsInts:  false,
-		EmitDefaults: true,
-		Indent:       "    ",
-		OrigName:     false,
-	}
-	out, err := m.MarshalToString(resp)
-	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return
-	}
-
-	i.node.Datastore.Cases().MarkAsRead(orderID)
-	SanitizedResponseM(w, out, new(pb.CaseRespApi))
-}
-
-func (i *jsonAPIHandler) POSTReleaseFunds(w http.ResponseWriter, r *http.Request) {
-	type release struct {
-		OrderID string `json:"orderId"`
-	}
-	decoder := json.NewDecoder(r.Body)
-	var rel release
-	err := decoder.Decode(&rel)
-	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
-		return
-	}
-	var (
-		contract    *pb.RicardianContract
-		state       pb.OrderState
-		records     []*wallet.TransactionRecord
-		paymentCoin *repo.CurrencyCode
-	)
-	contract, state, _, records, _, paymentCoin, err = i.node.Datastore.Purchases().GetByOrderId(rel.OrderID)
-	if err != nil {
-		contract, state, _, records, _, paymentCoin, err = i.node.Datastore.Sales().GetByOrderId(rel.OrderID)
-		if err != nil {
-			ErrorResponse(w, http.StatusNotFound, "Order not found")
-			return
-		}
+	if err != nil {
+		return nil, state, err
 	}
 
 	// TODO: Remove once broken contracts are migrated
 	lookupCoin := contract.BuyerOrder.Payment.Coin
 	_, err = repo.LoadCurrencyDefinitions().Lookup(lookupCoin)
 	if err != nil {
-		log.Warningf("invalid BuyerOrder.Payment.Coin (%s) on order (%s)", lookupCoin, rel.OrderID)
+		log.Warningf("invalid BuyerOrder.Payment.Coin (%s) on order (%s)", lookupCoin, orderID)
 		contract.BuyerOrder.Payment.Coin = paymentCoin.String()
 	}
+	return contract, state, nil
+}
 
-	if state == pb.OrderState_DECIDED {
-		err = i.node.ReleaseFunds(contract, records)
-		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
-			return
-		}
-	} else {
-		ErrorResponse(w, http.StatusBadRequest, "releasefunds can only be called for decided disputes")
-		return
+func orderAmendmentErrorStatus(err error) int {
+	switch err {
+	case core.ErrOrderNotAmendable, core.ErrAmendmentFunded, core.ErrNoOpenAmendment:
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
 	}
-	SanitizedResponse(w, `{}`)
 }
 
-func (i *jsonAPIHandler) POSTReleaseEscrow(w http.ResponseWriter, r *http.Request) {
-	var (
-		rel struct {
-			OrderID string `json:"orderId"`
-		}
-		contract    *pb.RicardianContract
-		state       pb.OrderState
-		records     []*wallet.TransactionRecord
-		paymentCoin *repo.CurrencyCode
-	)
-
+func (i *jsonAPIHandler) POSTOrderAmendment(w http.ResponseWriter, r *http.Request) {
 	decoder := json.NewDecoder(r.Body)
-	err := decoder.Decode(&rel)
+	var data core.OrderAmendmentData
+	err := decoder.Decode(&data)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-
-	contract, state, _, records, _, paymentCoin, err = i.node.Datastore.Sales().GetByOrderId(rel.OrderID)
+	contract, state, err := i.findOrder(data.OrderID)
 	if err != nil {
-		ErrorResponse(w, http.StatusNotFound, "Order not found")
+		ErrorResponse(w, http.StatusNotFound, "order not found")
 		return
 	}
-
-	// TODO: Remove once broken contracts are migrated
-	lookupCoin := contract.BuyerOrder.Payment.Coin
-	_, err = repo.LoadCurrencyDefinitions().Lookup(lookupCoin)
+	amendment, err := i.node.ProposeOrderAmendment(contract, state, &data)
 	if err != nil {
-		log.Warningf("invalid BuyerOrder.Payment.Coin (%s) on order (%s)", lookupCoin, rel.OrderID)
-		contract.BuyerOrder.Payment.Coin = paymentCoin.String()
-	}
-
-	if state != pb.OrderState_PENDING && state != pb.OrderState_FULFILLED && state != pb.OrderState_DISPUTED {
-		ErrorResponse(w, http.StatusBadRequest, "Release escrow can only be called when sale is pending, fulfilled, or disputed")
+		ErrorResponse(w, orderAmendmentErrorStatus(err), err.Error())
 		return
 	}
+	SanitizedResponse(w, fmt.Sprintf(`{"index": %d}`, amendment.Index))
+}
 
-	activeDispute, err := i.node.DisputeIsActive(contract)
+func (i *jsonAPIHandler) POSTOrderAmendmentResponse(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var data core.OrderAmendmentResponseData
+	err := decoder.Decode(&data)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return
-	}
-	if activeDispute {
-		ErrorResponse(w, http.StatusBadRequest, "Release escrow can only be called after dispute has expired")
-		return
-	}
-
-	if !(&repo.SaleRecord{Contract: contract}).SupportsTimedEscrowRelease() {
-		ErrorResponse(w, http.StatusBadRequest, "Escrowed currency does not support automatic release of funds to vendor")
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-
-	err = i.node.ReleaseFundsAfterTimeout(contract, records)
+	contract, state, err := i.findOrder(data.OrderID)
 	if err != nil {
-		switch err {
-		case core.ErrPrematureReleaseOfTimedoutEscrowFunds:
-			ErrorResponse(w, http.StatusUnauthorized, err.Error())
-			return
-		case core.EscrowTimeLockedError:
-			ErrorResponse(w, http.StatusUnauthorized, err.Error())
-			return
-		default:
-			ErrorResponse(w, http.StatusBadRequest, err.Error())
-			return
-		}
+		ErrorResponse(w, http.StatusNotFound, "order not found")
+		return
 	}
-
-	err = i.node.SendFundsReleasedByVendor(contract.BuyerOrder.BuyerID.PeerID, contract.BuyerOrder.BuyerID.Pubkeys.Identity, rel.OrderID)
+	err = i.node.RespondToOrderAmendment(contract, state, data.Accept, data.Reason)
 	if err != nil {
-		log.Errorf("SendFundsReleasedByVendor error: %s", err.Error())
-		log.Errorf("SendFundsReleasedByVendor: peerID: %s orderID: %s", contract.BuyerOrder.BuyerID.PeerID, rel.OrderID)
+		ErrorResponse(w, orderAmendmentErrorStatus(err), err.Error())
+		return
 	}
-
 	SanitizedResponse(w, `{}`)
 }
 
-func (i *jsonAPIHandler) POSTSignMessage(w http.ResponseWriter, r *http.Request) {
-	type signRequest struct {
-		Content string `json:"content"`
-	}
-	var (
-		req signRequest
-		err = json.NewDecoder(r.Body).Decode(&req)
-	)
+func (i *jsonAPIHandler) GETOrderAmendments(w http.ResponseWriter, r *http.Request) {
+	_, orderID := path.Split(r.URL.Path)
+	contract, _, err := i.findOrder(orderID)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		ErrorResponse(w, http.StatusNotFound, "Order not found")
 		return
 	}
 
-	sig, pubKey, err := core.SignPayload([]byte(req.Content), i.node.IpfsNode.PrivateKey)
+	type orderAmendment struct {
+		Index        uint32     `json:"index"`
+		Proposer     string     `json:"proposer"`
+		Price        uint64     `json:"price"`
+		Quantity     uint64     `json:"quantity"`
+		DeliveryDate *time.Time `json:"deliveryDate,omitempty"`
+		PaymentAddr  string     `json:"paymentAddress"`
+		Memo         string     `json:"memo"`
+		Timestamp    time.Time  `json:"timestamp"`
+		Status       string     `json:"status"`
+		Reason       string     `json:"reason"`
+	}
+	type orderAmendments struct {
+		OrderID    string           `json:"orderId"`
+		Coin       string           `json:"coin"`
+		Price      uint64           `json:"price"`
+		Quantity   uint64           `json:"quantity"`
+		Amendments []orderAmendment `json:"amendments"`
+	}
+	ret := orderAmendments{
+		OrderID:    orderID,
+		Coin:       contract.BuyerOrder.Payment.Coin,
+		Price:      pb.OrderPayment(contract).Amount,
+		Amendments: []orderAmendment{},
+	}
+	if len(contract.VendorListings) > 0 && len(contract.BuyerOrder.Items) > 0 {
+		ret.Quantity = core.OrderItemQuantity(contract, contract.VendorListings[0], contract.BuyerOrder.Items[0])
+	}
+	for _, a := range contract.OrderAmendments {
+		ts, _ := ptypes.Timestamp(a.Timestamp)
+		amendment := orderAmendment{
+			Index:       a.Index,
+			Proposer:    a.Proposer.String(),
+			Price:       a.Payment.Amount,
+			Quantity:    a.Quantity,
+			PaymentAddr: a.Payment.Address,
+			Memo:        a.Memo,
+			Timestamp:   ts,
+			Status:      core.OrderAmendmentStatus(contract, a.Index),
+		}
+		if a.DeliveryDate != nil {
+			date, _ := ptypes.Timestamp(a.DeliveryDate)
+			amendment.DeliveryDate = &date
+		}
+		for _, rs := range contract.OrderAmendmentResponses {
+			if rs.AmendmentIndex == a.Index {
+				amendment.Reason = rs.Reason
+			}
+		}
+		ret.Amendments = append(ret.Amendments, amendment)
+	}
+	b, err := json.MarshalIndent(ret, "", "    ")
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-
-	SanitizedResponse(w, fmt.Sprintf(`{"signature": "%s","pubkey":"%s","peerId":"%s"}`,
-		hex.EncodeToString(sig),
-		hex.EncodeToString(pubKey),
-		i.node.IpfsNode.Identity.Pretty()))
+	SanitizedResponse(w, string(b))
 }
 
-func (i *jsonAPIHandler) POSTVerifyMessage(w http.ResponseWriter, r *http.Request) {
-	type ciphertext struct {
-		Content   string `json:"content"`
-		Signature string `json:"signature"`
-		Pubkey    string `json:"pubkey"`
-		PeerId    string `json:"peerId"`
-	}
-	var msg ciphertext
+func (i *jsonAPIHandler) POSTSubscription(w http.ResponseWriter, r *http.Request) {
 	decoder := json.NewDecoder(r.Body)
-	err := decoder.Decode(&msg)
+	var data core.SubscriptionData
+	err := decoder.Decode(&data)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-
-	keyBytes, err := hex.DecodeString(msg.Pubkey)
+	subscriptionID, orderID, paymentAddr, amount, online, err := i.node.Subscribe(&data)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		RenderJSONOrStringError(w, http.StatusInternalServerError, err)
 		return
 	}
-
-	sigBytes, err := hex.DecodeString(msg.Signature)
-	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
-		return
+	type subscriptionReturn struct {
+		SubscriptionID string `json:"subscriptionId"`
+		PaymentAddress string `json:"paymentAddress"`
+		Amount         uint64 `json:"amount"`
+		VendorOnline   bool   `json:"vendorOnline"`
+		OrderID        string `json:"orderId"`
 	}
-
-	peerID, err := core.VerifyPayload([]byte(msg.Content), sigBytes, keyBytes)
+	ret := subscriptionReturn{subscriptionID, paymentAddr, amount, online, orderID}
+	b, err := json.MarshalIndent(ret, "", "    ")
 	if err != nil {
-		SanitizedResponse(w, `{"error":"VERIFICATION_FAILED"}`)
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
+	SanitizedResponse(w, string(b))
+}
 
-	if peerID != msg.PeerId {
-		SanitizedResponse(w, `{"error":"PEER_ID_PUBKEY_MISMATCH"}`)
-		return
-	}
-	SanitizedResponse(w, fmt.Sprintf(`{"error":"","peerId":"%s"}`, msg.PeerId))
+type subscriptionStateRequest struct {
+	SubscriptionID string `json:"subscriptionId"`
 }
 
-func (i *jsonAPIHandler) POSTChat(w http.ResponseWriter, r *http.Request) {
+func (i *jsonAPIHandler) POSTSubscriptionState(w http.ResponseWriter, r *http.Request) {
 	decoder := json.NewDecoder(r.Body)
-	var chat repo.ChatMessage
-	err := decoder.Decode(&chat)
+	var data subscriptionStateRequest
+	err := decoder.Decode(&data)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	if len(chat.Subject) > 500 {
-		ErrorResponse(w, http.StatusBadRequest, "Subject line is too long")
+	var state pb.Subscription_State
+	_, action := path.Split(r.URL.Path)
+	switch action {
+	case "pause":
+		state = pb.Subscription_PAUSED
+	case "resume":
+		state = pb.Subscription_ACTIVE
+	case "cancel":
+		state = pb.Subscription_CANCELED
+	default:
+		ErrorResponse(w, http.StatusNotFound, "Not Found")
 		return
 	}
-	if len(chat.Message) > 20000 {
-		ErrorResponse(w, http.StatusBadRequest, "Message is too long")
+	if _, err := i.node.Datastore.Subscriptions().Get(data.SubscriptionID); err != nil {
+		ErrorResponse(w, http.StatusNotFound, "subscription not found")
 		return
 	}
-
-	t := time.Now()
-	ts, err := ptypes.TimestampProto(t)
-	if err != nil {
+	if err := i.node.UpdateSubscriptionState(data.SubscriptionID, state); err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	var flag pb.Chat_Flag
-	if chat.Message == "" {
-		flag = pb.Chat_TYPING
-	} else {
-		flag = pb.Chat_MESSAGE
+	SanitizedResponse(w, `{}`)
+}
+
+type subscriptionResponse struct {
+	SubscriptionID string          `json:"subscriptionId"`
+	Subscription   json.RawMessage `json:"subscription"`
+	IsSale         bool            `json:"isSale"`
+	PeerID         string          `json:"peerId"`
+	State          string          `json:"state"`
+	Period         uint32          `json:"period"`
+	NextRenewal    *repo.APITime   `json:"nextRenewal"`
+	LastOrderID    string          `json:"lastOrderId"`
+	Timestamp      *repo.APITime   `json:"timestamp"`
+}
+
+func newSubscriptionResponse(record *repo.SubscriptionRecord) (*subscriptionResponse, error) {
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "    ",
+		OrigName:     false,
 	}
-	h := sha256.Sum256([]byte(chat.Message + chat.Subject + ptypes.TimestampString(ts)))
-	encoded, err := mh.Encode(h[:], mh.SHA2_256)
+	out, err := m.MarshalToString(record.Subscription)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
-		return
+		return nil, err
 	}
-	msgID, err := mh.Cast(encoded)
-	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+	ret := &subscriptionResponse{
+		SubscriptionID: record.SubscriptionID,
+		Subscription:   json.RawMessage(out),
+		IsSale:         record.IsSale,
+		PeerID:         record.PeerID,
+		State:          record.State.String(),
+		Period:         record.Period,
+		LastOrderID:    record.LastOrderID,
+		Timestamp:      repo.NewAPITime(record.Timestamp),
+	}
+	if record.IsActive() {
+		ret.NextRenewal = repo.NewAPITime(record.NextRenewal)
+	}
+	return ret, nil
+}
+
+func (i *jsonAPIHandler) GETSubscriptions(w http.ResponseWriter, r *http.Request) {
+	_, subscriptionID := path.Split(r.URL.Path)
+	if subscriptionID != "" && subscriptionID != "subscriptions" {
+		record, err := i.node.Datastore.Subscriptions().Get(subscriptionID)
+		if err != nil {
+			ErrorResponse(w, http.StatusNotFound, "subscription not found")
+			return
+		}
+		ret, err := newSubscriptionResponse(record)
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		b, err := json.MarshalIndent(ret, "", "    ")
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		SanitizedResponse(w, string(b))
 		return
 	}
 
-	chatPb := &pb.Chat{
-		MessageId: msgID.B58String(),
-		Subject:   chat.Subject,
-		Message:   chat.Message,
-		Timestamp: ts,
-		Flag:      flag,
+	var stateFilter []pb.Subscription_State
+	for _, s := range r.URL.Query()["state"] {
+		state, ok := pb.Subscription_State_value[strings.ToUpper(s)]
+		if !ok {
+			ErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("unknown subscription state: %s", s))
+			return
+		}
+		stateFilter = append(stateFilter, pb.Subscription_State(state))
 	}
-	err = i.node.SendChat(chat.PeerId, chatPb)
+	records, err := i.node.Datastore.Subscriptions().GetAll(stateFilter)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	// Put to database
-	if chatPb.Flag == pb.Chat_MESSAGE {
-		err = i.node.Datastore.Chat().Put(msgID.B58String(), chat.PeerId, chat.Subject, chat.Message, t, false, true)
+	ret := []*subscriptionResponse{}
+	for _, record := range records {
+		sr, err := newSubscriptionResponse(record)
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
-			return
+			log.Errorf("failed marshaling subscription (%s): %s", record.SubscriptionID, err)
+			continue
 		}
+		ret = append(ret, sr)
 	}
-	SanitizedResponse(w, fmt.Sprintf(`{"messageId": "%s"}`, msgID.B58String()))
+	b, err := json.MarshalIndent(ret, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(b))
 }
 
-func (i *jsonAPIHandler) POSTGroupChat(w http.ResponseWriter, r *http.Request) {
+func (i *jsonAPIHandler) POSTOrderComplete(w http.ResponseWriter, r *http.Request) {
+	checkRatingValue := func(val int) bool {
+		if val < core.RatingMin || val > core.RatingMax {
+			ErrorResponse(w, http.StatusBadRequest, "rating values must be between 1 and 5")
+			return false
+		}
+		return true
+	}
 	decoder := json.NewDecoder(r.Body)
-	var chat repo.GroupChatMessage
-	err := decoder.Decode(&chat)
+	var or core.OrderRatings
+	err := decoder.Decode(&or)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	if len(chat.Subject) > 500 {
-		ErrorResponse(w, http.StatusBadRequest, "Subject line is too long")
-		return
-	}
-	if len(chat.Subject) <= 0 {
-		ErrorResponse(w, http.StatusBadRequest, "Group chats must include a unique subject to be used as the group chat ID")
-		return
-	}
-	if len(chat.Message) > 20000 {
-		ErrorResponse(w, http.StatusBadRequest, "Message is too long")
+	contract, state, _, records, _, paymentCoin, err := i.node.Datastore.Purchases().GetByOrderId(or.OrderID)
+	if err != nil {
+		ErrorResponse(w, http.StatusNotFound, "order not found")
 		return
 	}
 
-	t := time.Now()
-	ts, err := ptypes.TimestampProto(t)
+	// TODO: Remove once broken contracts are migrated
+	lookupCoin := contract.BuyerOrder.Payment.Coin
+	_, err = repo.LoadCurrencyDefinitions().Lookup(lookupCoin)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
-		return
-	}
-	var flag pb.Chat_Flag
-	if chat.Message == "" {
-		flag = pb.Chat_TYPING
-	} else {
-		flag = pb.Chat_MESSAGE
+		log.Warningf("invalid BuyerOrder.Payment.Coin (%s) on order (%s)", lookupCoin, or.OrderID)
+		contract.BuyerOrder.Payment.Coin = paymentCoin.String()
 	}
-	h := sha256.Sum256([]byte(chat.Message + chat.Subject + ptypes.TimestampString(ts)))
-	encoded, err := mh.Encode(h[:], mh.SHA2_256)
-	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+
+	if state != pb.OrderState_FULFILLED &&
+		state != pb.OrderState_RESOLVED &&
+		state != pb.OrderState_PAYMENT_FINALIZED {
+		errorString := fmt.Sprintf("must be one of the following states to leave a rating and complete the order: %s, %s, %s",
+			pb.OrderState_FULFILLED.String(),
+			pb.OrderState_RESOLVED.String(),
+			pb.OrderState_PAYMENT_FINALIZED.String(),
+		)
+		ErrorResponse(w, http.StatusBadRequest, errorString)
 		return
 	}
-	msgID, err := mh.Cast(encoded)
-	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+
+	if len(contract.VendorOrderFulfillment) == 0 && contract.BuyerOrder.Payment.Method == pb.Order_Payment_MODERATED {
+		ErrorResponse(w, http.StatusBadRequest, "moderated orders can only be completed if the vendor has fulfilled the order")
 		return
 	}
 
-	chatPb := &pb.Chat{
-		MessageId: msgID.B58String(),
-		Subject:   chat.Subject,
-		Message:   chat.Message,
-		Timestamp: ts,
-		Flag:      flag,
-	}
-	for _, pid := range chat.PeerIds {
-		err = i.node.SendChat(pid, chatPb)
-		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+	for _, rd := range or.Ratings {
+		if rd.Slug == "" {
+			ErrorResponse(w, http.StatusBadRequest, "rating must contain the slug")
 			return
 		}
-	}
-	// Put to database
-	if chatPb.Flag == pb.Chat_MESSAGE {
-		err = i.node.Datastore.Chat().Put(msgID.B58String(), "", chat.Subject, chat.Message, t, false, true)
-		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		if !checkRatingValue(rd.Overall) {
+			return
+		}
+		if !checkRatingValue(rd.Quality) {
+			return
+		}
+		if !checkRatingValue(rd.Description) {
+			return
+		}
+		if !checkRatingValue(rd.DeliverySpeed) {
+			return
+		}
+		if !checkRatingValue(rd.CustomerService) {
+			return
+		}
+		if err := core.ValidateRatingFields(rd.Fields); err != nil {
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if len(rd.Review) > core.ReviewMaxCharacters {
+			ErrorResponse(w, http.StatusBadRequest, "too many characters in review")
 			return
 		}
 	}
-	SanitizedResponse(w, fmt.Sprintf(`{"messageId": "%s"}`, msgID.B58String()))
-}
-
-func (i *jsonAPIHandler) GETChatMessages(w http.ResponseWriter, r *http.Request) {
-	_, peerID := path.Split(r.URL.Path)
-	if strings.ToLower(peerID) == "chatmessages" {
-		peerID = ""
-	}
-	limit := r.URL.Query().Get("limit")
-	if limit == "" {
-		limit = "-1"
-	}
-	l, err := strconv.Atoi(limit)
-	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return
-	}
-	offsetID := r.URL.Query().Get("offsetId")
-	messages := i.node.Datastore.Chat().GetMessages(peerID, r.URL.Query().Get("subject"), offsetID, l)
 
-	ret, err := json.MarshalIndent(messages, "", "    ")
+	err = i.node.CompleteOrder(&or, contract, records)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	if isNullJSON(ret) {
-		ret = []byte("[]")
-	}
-	SanitizedResponse(w, string(ret))
+	SanitizedResponse(w, `{}`)
 }
 
-func (i *jsonAPIHandler) GETChatConversations(w http.ResponseWriter, r *http.Request) {
-	conversations := i.node.Datastore.Chat().GetConversations()
-	ret, err := json.MarshalIndent(conversations, "", "    ")
-	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return
-	}
-	if isNullJSON(ret) {
-		ret = []byte("[]")
-	}
-	SanitizedResponse(w, string(ret))
+type openDisputeRequest struct {
+	OrderID string `json:"orderId"`
+	Claim   string `json:"claim"`
 }
 
-func (i *jsonAPIHandler) POSTMarkChatAsRead(w http.ResponseWriter, r *http.Request) {
-	_, peerID := path.Split(r.URL.Path)
-	if strings.ToLower(peerID) == "markchatasread" {
-		peerID = ""
-	}
-	subject := r.URL.Query().Get("subject")
-	lastID, updated, err := i.node.Datastore.Chat().MarkAsRead(peerID, subject, false, "")
+func (i *jsonAPIHandler) POSTOpenDispute(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var d openDisputeRequest
+	err := decoder.Decode(&d)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	if updated && peerID != "" {
-		chatPb := &pb.Chat{
-			MessageId: lastID,
-			Subject:   subject,
-			Flag:      pb.Chat_READ,
-		}
-		err = i.node.SendChat(peerID, chatPb)
+	var (
+		isSale      bool
+		contract    *pb.RicardianContract
+		state       pb.OrderState
+		records     []*wallet.TransactionRecord
+		paymentCoin *repo.CurrencyCode
+	)
+	contract, state, _, records, _, paymentCoin, err = i.node.Datastore.Purchases().GetByOrderId(d.OrderID)
+	if err != nil {
+		contract, state, _, records, _, paymentCoin, err = i.node.Datastore.Sales().GetByOrderId(d.OrderID)
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			ErrorResponse(w, http.StatusNotFound, "Order not found")
 			return
 		}
+		isSale = true
 	}
-	if subject != "" {
-		go func() {
-			i.node.Datastore.Purchases().MarkAsRead(subject)
-			i.node.Datastore.Sales().MarkAsRead(subject)
-			i.node.Datastore.Cases().MarkAsRead(subject)
-		}()
-	}
-	SanitizedResponse(w, `{}`)
-}
 
-func (i *jsonAPIHandler) DELETEChatMessage(w http.ResponseWriter, r *http.Request) {
-	_, messageID := path.Split(r.URL.Path)
-	err := i.node.Datastore.Chat().DeleteMessage(messageID)
+	// TODO: Remove once broken contracts are migrated
+	lookupCoin := contract.BuyerOrder.Payment.Coin
+	_, err = repo.LoadCurrencyDefinitions().Lookup(lookupCoin)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return
+		log.Warningf("invalid BuyerOrder.Payment.Coin (%s) on order (%s)", lookupCoin, d.OrderID)
+		contract.BuyerOrder.Payment.Coin = paymentCoin.String()
 	}
-	SanitizedResponse(w, `{}`)
-}
 
-func (i *jsonAPIHandler) DELETEChatConversation(w http.ResponseWriter, r *http.Request) {
-	_, peerID := path.Split(r.URL.Path)
-	err := i.node.Datastore.Chat().DeleteConversation(peerID)
-	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+	if contract.BuyerOrder.Payment.Method != pb.Order_Payment_MODERATED {
+		ErrorResponse(w, http.StatusBadRequest, "Only moderated orders can be disputed")
 		return
 	}
-	SanitizedResponse(w, `{}`)
-}
 
-func (i *jsonAPIHandler) GETNotifications(w http.ResponseWriter, r *http.Request) {
-	limit := r.URL.Query().Get("limit")
-	if limit == "" {
-		limit = "-1"
+	if isSale && (state != pb.OrderState_PARTIALLY_FULFILLED && state != pb.OrderState_FULFILLED && state != pb.OrderState_MILESTONE_FULFILLED) {
+		ErrorResponse(w, http.StatusBadRequest, "Order must be either PARTIALLY_FULFILLED, FULFILLED or MILESTONE_FULFILLED to start a dispute")
+		return
 	}
-	l, err := strconv.Atoi(limit)
-	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+	if !isSale && !(state == pb.OrderState_AWAITING_FULFILLMENT || state == pb.OrderState_PENDING || state == pb.OrderState_PARTIALLY_FULFILLED || state == pb.OrderState_PARTIALLY_REFUNDED || state == pb.OrderState_FULFILLED || state == pb.OrderState_PROCESSING_ERROR ||
+		state == pb.OrderState_MILESTONE_FUNDED || state == pb.OrderState_MILESTONE_FULFILLED || state == pb.OrderState_MILESTONE_RELEASED) {
+		ErrorResponse(w, http.StatusBadRequest, "Order must be either AWAITING_FULFILLMENT, PARTIALLY_FULFILLED, PARTIALLY_REFUNDED, PENDING, PROCESSING_ERROR, FULFILLED or in a milestone state to start a dispute")
 		return
 	}
-	offsetID := r.URL.Query().Get("offsetId")
-	filter := r.URL.Query().Get("filter")
 
-	types := strings.Split(filter, ",")
-	var filters []string
-	for _, t := range types {
-		if t != "" {
-			filters = append(filters, t)
-		}
+	if !isSale && state == pb.OrderState_PROCESSING_ERROR && len(records) == 0 {
+		ErrorResponse(w, http.StatusBadRequest, "Cannot dispute an unfunded order")
+		return
 	}
 
-	type notifData struct {
-		Unread        int               `json:"unread"`
-		Total         int               `json:"total"`
-		Notifications []json.RawMessage `json:"notifications"`
-	}
-	notifs, total, err := i.node.Datastore.Notifications().GetAll(offsetID, l, filters)
+	err = i.node.OpenDispute(d.OrderID, contract, records, d.Claim)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	unread, err := i.node.Datastore.Notifications().GetUnreadCount()
+	SanitizedResponse(w, `{}`)
+}
+
+type closeDisputeRequest struct {
+	OrderID          string  `json:"orderId"`
+	Resolution       string  `json:"resolution"`
+	BuyerPercentage  float32 `json:"buyerPercentage"`
+	VendorPercentage float32 `json:"vendorPercentage"`
+}
+
+func (i *jsonAPIHandler) POSTCloseDispute(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var d closeDisputeRequest
+	err := decoder.Decode(&d)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	payload := notifData{unread, total, []json.RawMessage{}}
-	for _, n := range notifs {
-		data, err := n.Data()
-		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		}
-		payload.Notifications = append(payload.Notifications, data)
+	disputeCase, err := i.node.Datastore.Cases().GetByCaseID(d.OrderID)
+	if err != nil {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
 	}
-	ret, err := json.MarshalIndent(payload, "", "    ")
+
+	err = i.node.CloseDispute(disputeCase.CaseID, d.BuyerPercentage, d.VendorPercentage, d.Resolution, disputeCase.PaymentCoin)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		switch err {
+		case core.ErrCaseNotFound:
+			ErrorResponse(w, http.StatusNotFound, err.Error())
+		case core.ErrCloseFailureCaseExpired:
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+		default:
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		}
 		return
 	}
-	retString := string(ret)
-	if strings.Contains(retString, "null") {
-		retString = strings.Replace(retString, "null", "[]", -1)
-	}
-	SanitizedResponse(w, retString)
+	SanitizedResponse(w, `{}`)
 }
 
-func (i *jsonAPIHandler) POSTMarkNotificationAsRead(w http.ResponseWriter, r *http.Request) {
-	_, notifID := path.Split(r.URL.Path)
-	err := i.node.Datastore.Notifications().MarkAsRead(notifID)
+func (i *jsonAPIHandler) GETCase(w http.ResponseWriter, r *http.Request) {
+	_, orderID := path.Split(r.URL.Path)
+	buyerContract, vendorContract, buyerErrors, vendorErrors, state, read, date, buyerOpened, claim, resolution, err := i.node.Datastore.Cases().GetCaseMetadata(orderID)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		ErrorResponse(w, http.StatusNotFound, err.Error())
 		return
 	}
-	SanitizedResponse(w, `{}`)
-}
 
-func (i *jsonAPIHandler) POSTMarkNotificationsAsRead(w http.ResponseWriter, r *http.Request) {
-	err := i.node.Datastore.Notifications().MarkAllAsRead()
+	resp := new(pb.CaseRespApi)
+	ts, err := ptypes.TimestampProto(date)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	SanitizedResponse(w, `{}`)
-}
-
-func (i *jsonAPIHandler) DELETENotification(w http.ResponseWriter, r *http.Request) {
-	_, notifID := path.Split(r.URL.Path)
-	err := i.node.Datastore.Notifications().Delete(notifID)
+	resp.BuyerContract = buyerContract
+	resp.VendorContract = vendorContract
+	resp.BuyerOpened = buyerOpened
+	resp.BuyerContractValidationErrors = buyerErrors
+	resp.VendorContractValidationErrors = vendorErrors
+	resp.Read = read
+	resp.State = state
+	resp.Claim = claim
+	resp.Resolution = resolution
+	resp.Timestamp = ts
+
+	unread, err := i.node.Datastore.Chat().GetUnreadCount(orderID)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	SanitizedResponse(w, `{}`)
-}
-
-func (i *jsonAPIHandler) GETImage(w http.ResponseWriter, r *http.Request) {
-	_, imageHash := path.Split(r.URL.Path)
-	ctx, cancel := context.WithTimeout(context.Background(), time.Minute*2)
-	defer cancel()
+	resp.UnreadChatMessages = uint64(unread)
 
-	api, err := coreapi.NewCoreAPI(i.node.IpfsNode)
+	resp.PanelVotes, err = i.node.Datastore.PanelVotes().GetAll(orderID)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	pth, err := iface.ParsePath("/ipfs/" + imageHash)
+
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(resp)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	nd, err := api.Unixfs().Get(ctx, pth)
+	i.node.Datastore.Cases().MarkAsRead(orderID)
+	SanitizedResponseM(w, out, new(pb.CaseRespApi))
+}
+
+func (i *jsonAPIHandler) POSTReleaseFunds(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var rel orderIDRequest
+	err := decoder.Decode(&rel)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	f, ok := nd.(files.File)
-	if !ok {
-		ErrorResponse(w, http.StatusInternalServerError, "Invalid type assertion")
-		return
+	var (
+		contract    *pb.RicardianContract
+		state       pb.OrderState
+		records     []*wallet.TransactionRecord
+		paymentCoin *repo.CurrencyCode
+	)
+	contract, state, _, records, _, paymentCoin, err = i.node.Datastore.Purchases().GetByOrderId(rel.OrderID)
+	if err != nil {
+		contract, state, _, records, _, paymentCoin, err = i.node.Datastore.Sales().GetByOrderId(rel.OrderID)
+		if err != nil {
+			ErrorResponse(w, http.StatusNotFound, "Order not found")
+			return
+		}
 	}
 
-	w.Header().Set("Cache-Control", "public, max-age=29030400, immutable")
-	w.Header().Del("Content-Type")
-	http.ServeContent(w, r, imageHash, time.Now(), f)
+	// TODO: Remove once broken contracts are migrated
+	lookupCoin := contract.BuyerOrder.Payment.Coin
+	_, err = repo.LoadCurrencyDefinitions().Lookup(lookupCoin)
+	if err != nil {
+		log.Warningf("invalid BuyerOrder.Payment.Coin (%s) on order (%s)", lookupCoin, rel.OrderID)
+		contract.BuyerOrder.Payment.Coin = paymentCoin.String()
+	}
+
+	if state == pb.OrderState_DECIDED {
+		err = i.node.ReleaseFunds(contract, records)
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+	} else {
+		ErrorResponse(w, http.StatusBadRequest, "releasefunds can only be called for decided disputes")
+		return
+	}
+	SanitizedResponse(w, `{}`)
 }
 
-func (i *jsonAPIHandler) GETAvatar(w http.ResponseWriter, r *http.Request) {
-	urlPath, size := path.Split(r.URL.Path)
-	_, peerID := path.Split(urlPath[:len(urlPath)-1])
+func (i *jsonAPIHandler) POSTReleaseEscrow(w http.ResponseWriter, r *http.Request) {
+	var (
+		rel         orderIDRequest
+		contract    *pb.RicardianContract
+		state       pb.OrderState
+		records     []*wallet.TransactionRecord
+		paymentCoin *repo.CurrencyCode
+	)
 
-	cacheBool := r.URL.Query().Get("usecache")
-	useCache, _ := strconv.ParseBool(cacheBool)
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&rel)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
 
-	dr, err := i.node.FetchAvatar(peerID, size, useCache)
+	contract, state, _, records, _, paymentCoin, err = i.node.Datastore.Sales().GetByOrderId(rel.OrderID)
+	if err != nil {
+		ErrorResponse(w, http.StatusNotFound, "Order not found")
+		return
+	}
+
+	// TODO: Remove once broken contracts are migrated
+	lookupCoin := contract.BuyerOrder.Payment.Coin
+	_, err = repo.LoadCurrencyDefinitions().Lookup(lookupCoin)
+	if err != nil {
+		log.Warningf("invalid BuyerOrder.Payment.Coin (%s) on order (%s)", lookupCoin, rel.OrderID)
+		contract.BuyerOrder.Payment.Coin = paymentCoin.String()
+	}
+
+	if state != pb.OrderState_PENDING && state != pb.OrderState_FULFILLED && state != pb.OrderState_DISPUTED {
+		ErrorResponse(w, http.StatusBadRequest, "Release escrow can only be called when sale is pending, fulfilled, or disputed")
+		return
+	}
+
+	activeDispute, err := i.node.DisputeIsActive(contract)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	w.Header().Set("Cache-Control", "public, max-age=600, immutable")
-	w.Header().Del("Content-Type")
-	http.ServeContent(w, r, path.Join("ipns", peerID, "images", size, "avatar"), time.Now(), dr)
+	if activeDispute {
+		ErrorResponse(w, http.StatusBadRequest, "Release escrow can only be called after dispute has expired")
+		return
+	}
+
+	if !(&repo.SaleRecord{Contract: contract}).SupportsTimedEscrowRelease() {
+		ErrorResponse(w, http.StatusBadRequest, "Escrowed currency does not support automatic release of funds to vendor")
+		return
+	}
+
+	err = i.node.ReleaseFundsAfterTimeout(contract, records)
+	if err != nil {
+		switch err {
+		case core.ErrPrematureReleaseOfTimedoutEscrowFunds:
+			ErrorResponse(w, http.StatusUnauthorized, err.Error())
+			return
+		case core.EscrowTimeLockedError:
+			ErrorResponse(w, http.StatusUnauthorized, err.Error())
+			return
+		default:
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	err = i.node.SendFundsReleasedByVendor(contract.BuyerOrder.BuyerID.PeerID, contract.BuyerOrder.BuyerID.Pubkeys.Identity, rel.OrderID)
+	if err != nil {
+		log.Errorf("SendFundsReleasedByVendor error: %s", err.Error())
+		log.Errorf("SendFundsReleasedByVendor: peerID: %s orderID: %s", contract.BuyerOrder.BuyerID.PeerID, rel.OrderID)
+	}
+
+	SanitizedResponse(w, `{}`)
 }
 
-func (i *jsonAPIHandler) GETHeader(w http.ResponseWriter, r *http.Request) {
-	urlPath, size := path.Split(r.URL.Path)
-	_, peerID := path.Split(urlPath[:len(urlPath)-1])
+type signMessageRequest struct {
+	Content string `json:"content"`
+}
 
-	cacheBool := r.URL.Query().Get("usecache")
-	useCache, _ := strconv.ParseBool(cacheBool)
+func (i *jsonAPIHandler) POSTSignMessage(w http.ResponseWriter, r *http.Request) {
+	var (
+		req signMessageRequest
+		err = json.NewDecoder(r.Body).Decode(&req)
+	)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
 
-	dr, err := i.node.FetchHeader(peerID, size, useCache)
+	sig, pubKey, err := core.SignPayload([]byte(req.Content), i.node.IpfsNode.PrivateKey)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	w.Header().Set("Cache-Control", "public, max-age=600, immutable")
-	w.Header().Del("Content-Type")
-	http.ServeContent(w, r, path.Join("ipns", peerID, "images", size, "header"), time.Now(), dr)
+
+	SanitizedResponse(w, fmt.Sprintf(`{"signature": "%s","pubkey":"%s","peerId":"%s"}`,
+		hex.EncodeToString(sig),
+		hex.EncodeToString(pubKey),
+		i.node.IpfsNode.Identity.Pretty()))
 }
 
-func (i *jsonAPIHandler) POSTFetchProfiles(w http.ResponseWriter, r *http.Request) {
-	query := r.URL.Query().Get("async")
-	async, _ := strconv.ParseBool(query)
-	cacheBool := r.URL.Query().Get("usecache")
-	useCache, _ := strconv.ParseBool(cacheBool)
+type verifyMessageRequest struct {
+	Content   string `json:"content"`
+	Signature string `json:"signature"`
+	Pubkey    string `json:"pubkey"`
+	PeerId    string `json:"peerId"`
+}
 
-	var pids []string
+func (i *jsonAPIHandler) POSTVerifyMessage(w http.ResponseWriter, r *http.Request) {
+	var msg verifyMessageRequest
 	decoder := json.NewDecoder(r.Body)
-	err := decoder.Decode(&pids)
+	err := decoder.Decode(&msg)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	if !async {
-		var wg sync.WaitGroup
-		var ret []string
-		for _, p := range pids {
-			wg.Add(1)
-			go func(pid string) {
-				pro, err := i.node.FetchProfile(pid, useCache)
-				if err != nil {
-					wg.Done()
-					return
-				}
-				obj := pb.PeerAndProfile{PeerId: pid, Profile: &pro}
-				m := jsonpb.Marshaler{
-					EnumsAsInts:  false,
-					EmitDefaults: true,
-					Indent:       "    ",
-					OrigName:     false,
-				}
-				respJSON, err := m.MarshalToString(&obj)
-				if err != nil {
-					return
-				}
-				ret = append(ret, respJSON)
-				wg.Done()
-			}(p)
-		}
-		wg.Wait()
-		resp := "[\n"
-		max := len(ret)
-		for i, r := range ret {
-			lines := strings.Split(r, "\n")
-			maxx := len(lines)
-			for x, s := range lines {
-				resp += "    "
-				resp += s
-				if x != maxx-1 {
-					resp += "\n"
-				}
-			}
-			if i != max-1 {
-				resp += ",\n"
-			}
-		}
-		resp += "\n]"
-		SanitizedResponse(w, resp)
-	} else {
-		id := r.URL.Query().Get("asyncID")
-		if id == "" {
-			idBytes := make([]byte, 16)
-			rand.Read(idBytes)
-			id = base58.Encode(idBytes)
-		}
 
-		type resp struct {
-			ID string `json:"id"`
-		}
-		response := resp{id}
-		respJSON, _ := json.MarshalIndent(response, "", "    ")
-		w.WriteHeader(http.StatusAccepted)
-		SanitizedResponse(w, string(respJSON))
-		go func() {
-			type profileError struct {
-				ID     string `json:"id"`
-				PeerID string `json:"peerID"`
-				Error  string `json:"error"`
-			}
-			for _, p := range pids {
-				go func(pid string) {
-					respondWithError := func(errorMsg string) {
-						e := profileError{id, pid, errorMsg}
-						ret, err := json.MarshalIndent(e, "", "    ")
-						if err != nil {
-							return
-						}
-						i.node.Broadcast <- repo.PremarshalledNotifier{Payload: ret}
-					}
-
-					pro, err := i.node.FetchProfile(pid, useCache)
-					if err != nil {
-						respondWithError("not found")
-						return
-					}
-					obj := pb.PeerAndProfileWithID{Id: id, PeerId: pid, Profile: &pro}
-					m := jsonpb.Marshaler{
-						EnumsAsInts:  false,
-						EmitDefaults: true,
-						Indent:       "    ",
-						OrigName:     false,
-					}
-					respJSON, err := m.MarshalToString(&obj)
-					if err != nil {
-						respondWithError("error Marshalling to JSON")
-						return
-					}
-					b, err := SanitizeProtobuf(respJSON, new(pb.PeerAndProfileWithID))
-					if err != nil {
-						respondWithError("error Marshalling to JSON")
-						return
-					}
-					i.node.Broadcast <- repo.PremarshalledNotifier{Payload: b}
-				}(p)
-			}
-		}()
+	keyBytes, err := hex.DecodeString(msg.Pubkey)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
 	}
-}
 
-func (i *jsonAPIHandler) GETTransactions(w http.ResponseWriter, r *http.Request) {
-	_, coinType := path.Split(r.URL.Path)
-	l := r.URL.Query().Get("limit")
-	if l == "" {
-		l = "-1"
-	}
-	limit, err := strconv.Atoi(l)
+	sigBytes, err := hex.DecodeString(msg.Signature)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	offsetID := r.URL.Query().Get("offsetId")
-	type Tx struct {
-		Txid          string        `json:"txid"`
-		Value         int64         `json:"value"`
-		Address       string        `json:"address"`
-		Status        string        `json:"status"`
-		ErrorMessage  string        `json:"errorMessage"`
-		Memo          string        `json:"memo"`
-		Timestamp     *repo.APITime `json:"timestamp"`
-		Confirmations int32         `json:"confirmations"`
-		Height        int32         `json:"height"`
-		OrderID       string        `json:"orderId"`
-		Thumbnail     string        `json:"thumbnail"`
-		CanBumpFee    bool          `json:"canBumpFee"`
-	}
-	wal, err := i.node.Multiwallet.WalletForCurrencyCode(coinType)
+
+	peerID, err := core.VerifyPayload([]byte(msg.Content), sigBytes, keyBytes)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, "Unknown wallet type")
+		SanitizedResponse(w, `{"error":"VERIFICATION_FAILED"}`)
 		return
 	}
-	transactions, err := wal.Transactions()
-	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+
+	if peerID != msg.PeerId {
+		SanitizedResponse(w, `{"error":"PEER_ID_PUBKEY_MISMATCH"}`)
 		return
 	}
-	metadata, err := i.node.Datastore.TxMetadata().GetAll()
+	SanitizedResponse(w, fmt.Sprintf(`{"error":"","peerId":"%s"}`, msg.PeerId))
+}
+
+func (i *jsonAPIHandler) POSTChat(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var chat repo.ChatMessage
+	err := decoder.Decode(&chat)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	var txs []Tx
-	passedOffset := false
-	for i := len(transactions) - 1; i >= 0; i-- {
-		t := transactions[i]
-		tx := Tx{
-			Txid:          t.Txid,
-			Value:         t.Value,
-			Timestamp:     repo.NewAPITime(t.Timestamp),
-			Confirmations: int32(t.Confirmations),
-			Height:        t.Height,
-			Status:        string(t.Status),
-			CanBumpFee:    true,
-			ErrorMessage:  t.ErrorMessage,
-		}
-		m, ok := metadata[t.Txid]
-		if ok {
-			tx.Address = m.Address
-			tx.Memo = m.Memo
-			tx.OrderID = m.OrderId
-			tx.Thumbnail = m.Thumbnail
-			tx.CanBumpFee = m.CanBumpFee
-		}
-		if t.Status == wallet.StatusDead {
-			tx.CanBumpFee = false
-		}
-		if offsetID == "" || passedOffset {
-			txs = append(txs, tx)
-		}
-		if t.Txid == offsetID {
-			passedOffset = true
-		}
-		if len(txs) >= limit && limit != -1 {
-			break
-		}
-	}
-	type txWithCount struct {
-		Transactions []Tx `json:"transactions"`
-		Count        int  `json:"count"`
+	if len(chat.Subject) > 500 {
+		ErrorResponse(w, http.StatusBadRequest, "Subject line is too long")
+		return
 	}
-	txns := txWithCount{txs, len(transactions)}
-	ret, err := json.MarshalIndent(txns, "", "    ")
-	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+	if len(chat.Message) > 20000 {
+		ErrorResponse(w, http.StatusBadRequest, "Message is too long")
 		return
 	}
-	SanitizedResponse(w, string(ret))
-}
 
-func (i *jsonAPIHandler) GETPurchases(w http.ResponseWriter, r *http.Request) {
-	orderStates, searchTerm, sortByAscending, sortByRead, limit, err := parseSearchTerms(r.URL.Query())
+	t := time.Now()
+	ts, err := ptypes.TimestampProto(t)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	purchases, queryCount, err := i.node.Datastore.Purchases().GetAll(orderStates, searchTerm, sortByAscending, sortByRead, limit, []string{})
+	var flag pb.Chat_Flag
+	if chat.Message == "" {
+		flag = pb.Chat_TYPING
+	} else {
+		flag = pb.Chat_MESSAGE
+	}
+	h := sha256.Sum256([]byte(chat.Message + chat.Subject + ptypes.TimestampString(ts)))
+	encoded, err := mh.Encode(h[:], mh.SHA2_256)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	for n, p := range purchases {
-		unread, err := i.node.Datastore.Chat().GetUnreadCount(p.OrderId)
-		if err != nil {
-			continue
-		}
-		purchases[n].UnreadChatMessages = unread
+	msgID, err := mh.Cast(encoded)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
 	}
-	type purchasesResponse struct {
-		QueryCount int             `json:"queryCount"`
-		Purchases  []repo.Purchase `json:"purchases"`
+
+	chatPb := &pb.Chat{
+		MessageId: msgID.B58String(),
+		Subject:   chat.Subject,
+		Message:   chat.Message,
+		Timestamp: ts,
+		Flag:      flag,
 	}
-	pr := purchasesResponse{queryCount, purchases}
-	ret, err := json.MarshalIndent(pr, "", "    ")
+	err = i.node.SendChat(chat.PeerId, chatPb)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	if isNullJSON(ret) {
-		ret = []byte("[]")
+	// Put to database
+	if chatPb.Flag == pb.Chat_MESSAGE {
+		err = i.node.Datastore.Chat().Put(msgID.B58String(), chat.PeerId, chat.Subject, chat.Message, t, false, true)
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
 	}
-	SanitizedResponse(w, string(ret))
+	SanitizedResponse(w, fmt.Sprintf(`{"messageId": "%s"}`, msgID.B58String()))
 }
 
-func (i *jsonAPIHandler) GETSales(w http.ResponseWriter, r *http.Request) {
-	orderStates, searchTerm, sortByAscending, sortByRead, limit, err := parseSearchTerms(r.URL.Query())
+func (i *jsonAPIHandler) POSTGroupChat(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var chat repo.GroupChatMessage
+	err := decoder.Decode(&chat)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	sales, queryCount, err := i.node.Datastore.Sales().GetAll(orderStates, searchTerm, sortByAscending, sortByRead, limit, []string{})
-	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+	if len(chat.Subject) > 500 {
+		ErrorResponse(w, http.StatusBadRequest, "Subject line is too long")
 		return
 	}
-	for n, s := range sales {
-		unread, err := i.node.Datastore.Chat().GetUnreadCount(s.OrderId)
-		if err != nil {
-			continue
-		}
-		sales[n].UnreadChatMessages = unread
+	if len(chat.Subject) <= 0 {
+		ErrorResponse(w, http.StatusBadRequest, "Group chats must include a unique subject to be used as the group chat ID")
+		return
 	}
-	type salesResponse struct {
-		QueryCount int         `json:"queryCount"`
-		Sales      []repo.Sale `json:"sales"`
+	if len(chat.Message) > 20000 {
+		ErrorResponse(w, http.StatusBadRequest, "Message is too long")
+		return
 	}
-	sr := salesResponse{queryCount, sales}
 
-	ret, err := json.MarshalIndent(sr, "", "    ")
+	t := time.Now()
+	ts, err := ptypes.TimestampProto(t)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	if isNullJSON(ret) {
-		ret = []byte("[]")
+	var flag pb.Chat_Flag
+	if chat.Message == "" {
+		flag = pb.Chat_TYPING
+	} else {
+		flag = pb.Chat_MESSAGE
 	}
-	SanitizedResponse(w, string(ret))
-}
-
-func (i *jsonAPIHandler) GETCases(w http.ResponseWriter, r *http.Request) {
-	orderStates, searchTerm, sortByAscending, sortByRead, limit, err := parseSearchTerms(r.URL.Query())
+	h := sha256.Sum256([]byte(chat.Message + chat.Subject + ptypes.TimestampString(ts)))
+	encoded, err := mh.Encode(h[:], mh.SHA2_256)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	cases, queryCount, err := i.node.Datastore.Cases().GetAll(orderStates, searchTerm, sortByAscending, sortByRead, limit, []string{})
+	msgID, err := mh.Cast(encoded)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	for n, c := range cases {
-		unread, err := i.node.Datastore.Chat().GetUnreadCount(c.CaseId)
+
+	chatPb := &pb.Chat{
+		MessageId: msgID.B58String(),
+		Subject:   chat.Subject,
+		Message:   chat.Message,
+		Timestamp: ts,
+		Flag:      flag,
+	}
+	for _, pid := range chat.PeerIds {
+		err = i.node.SendChat(pid, chatPb)
 		if err != nil {
-			continue
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
 		}
-		cases[n].UnreadChatMessages = unread
 	}
-	type casesResponse struct {
-		QueryCount int         `json:"queryCount"`
-		Cases      []repo.Case `json:"cases"`
-	}
-	cr := casesResponse{queryCount, cases}
-	ret, err := json.MarshalIndent(cr, "", "    ")
-	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return
-	}
-	if isNullJSON(ret) {
-		ret = []byte("[]")
+	// Put to database
+	if chatPb.Flag == pb.Chat_MESSAGE {
+		err = i.node.Datastore.Chat().Put(msgID.B58String(), "", chat.Subject, chat.Message, t, false, true)
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
 	}
-	SanitizedResponse(w, string(ret))
+	SanitizedResponse(w, fmt.Sprintf(`{"messageId": "%s"}`, msgID.B58String()))
 }
 
-func (i *jsonAPIHandler) POSTPurchases(w http.ResponseWriter, r *http.Request) {
-	decoder := json.NewDecoder(r.Body)
-	var query TransactionQuery
-	err := decoder.Decode(&query)
+func (i *jsonAPIHandler) GETChatMessages(w http.ResponseWriter, r *http.Request) {
+	_, peerID := path.Split(r.URL.Path)
+	if strings.ToLower(peerID) == "chatmessages" {
+		peerID = ""
+	}
+	limit := r.URL.Query().Get("limit")
+	if limit == "" {
+		limit = "-1"
+	}
+	l, err := strconv.Atoi(limit)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	purchases, queryCount, err := i.node.Datastore.Purchases().GetAll(convertOrderStates(query.OrderStates), query.SearchTerm, query.SortByAscending, query.SortByRead, query.Limit, query.Exclude)
+	offsetID := r.URL.Query().Get("offsetId")
+	messages := i.node.Datastore.Chat().GetMessages(peerID, r.URL.Query().Get("subject"), offsetID, l)
+
+	ret, err := json.MarshalIndent(messages, "", "    ")
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	for n, p := range purchases {
-		unread, err := i.node.Datastore.Chat().GetUnreadCount(p.OrderId)
-		if err != nil {
-			continue
-		}
-		purchases[n].UnreadChatMessages = unread
-	}
-	type purchasesResponse struct {
-		QueryCount int             `json:"queryCount"`
-		Purchases  []repo.Purchase `json:"purchases"`
+	if isNullJSON(ret) {
+		ret = []byte("[]")
 	}
-	pr := purchasesResponse{queryCount, purchases}
-	ret, err := json.MarshalIndent(pr, "", "    ")
+	SanitizedResponse(w, string(ret))
+}
+
+func (i *jsonAPIHandler) GETChatConversations(w http.ResponseWriter, r *http.Request) {
+	conversations := i.node.Datastore.Chat().GetConversations()
+	ret, err := json.MarshalIndent(conversations, "", "    ")
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
@@ -3113,1039 +3191,2747 @@ func (i *jsonAPIHandler) POSTPurchases(w http.ResponseWriter, r *http.Request) {
 	SanitizedResponse(w, string(ret))
 }
 
-func (i *jsonAPIHandler) POSTSales(w http.ResponseWriter, r *http.Request) {
-	decoder := json.NewDecoder(r.Body)
-	var query TransactionQuery
-	err := decoder.Decode(&query)
-	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
-		return
+func (i *jsonAPIHandler) POSTMarkChatAsRead(w http.ResponseWriter, r *http.Request) {
+	_, peerID := path.Split(r.URL.Path)
+	if strings.ToLower(peerID) == "markchatasread" {
+		peerID = ""
 	}
-	sales, queryCount, err := i.node.Datastore.Sales().GetAll(convertOrderStates(query.OrderStates), query.SearchTerm, query.SortByAscending, query.SortByRead, query.Limit, query.Exclude)
+	subject := r.URL.Query().Get("subject")
+	lastID, updated, err := i.node.Datastore.Chat().MarkAsRead(peerID, subject, false, "")
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	for n, s := range sales {
-		unread, err := i.node.Datastore.Chat().GetUnreadCount(s.OrderId)
+	if updated && peerID != "" {
+		chatPb := &pb.Chat{
+			MessageId: lastID,
+			Subject:   subject,
+			Flag:      pb.Chat_READ,
+		}
+		err = i.node.SendChat(peerID, chatPb)
 		if err != nil {
-			continue
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
 		}
-		sales[n].UnreadChatMessages = unread
 	}
-	type salesResponse struct {
-		QueryCount int         `json:"queryCount"`
-		Sales      []repo.Sale `json:"sales"`
+	if subject != "" {
+		go func() {
+			i.node.Datastore.Purchases().MarkAsRead(subject)
+			i.node.Datastore.Sales().MarkAsRead(subject)
+			i.node.Datastore.Cases().MarkAsRead(subject)
+		}()
 	}
-	sr := salesResponse{queryCount, sales}
+	SanitizedResponse(w, `{}`)
+}
 
-	ret, err := json.MarshalIndent(sr, "", "    ")
+func (i *jsonAPIHandler) DELETEChatMessage(w http.ResponseWriter, r *http.Request) {
+	_, messageID := path.Split(r.URL.Path)
+	err := i.node.Datastore.Chat().DeleteMessage(messageID)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	if isNullJSON(ret) {
-		ret = []byte("[]")
-	}
-	SanitizedResponse(w, string(ret))
+	SanitizedResponse(w, `{}`)
 }
 
-func (i *jsonAPIHandler) POSTCases(w http.ResponseWriter, r *http.Request) {
-	decoder := json.NewDecoder(r.Body)
-	var query TransactionQuery
-	err := decoder.Decode(&query)
+func (i *jsonAPIHandler) DELETEChatConversation(w http.ResponseWriter, r *http.Request) {
+	_, peerID := path.Split(r.URL.Path)
+	err := i.node.Datastore.Chat().DeleteConversation(peerID)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	cases, queryCount, err := i.node.Datastore.Cases().GetAll(convertOrderStates(query.OrderStates), query.SearchTerm, query.SortByAscending, query.SortByRead, query.Limit, query.Exclude)
+	SanitizedResponse(w, `{}`)
+}
+
+func (i *jsonAPIHandler) GETNotifications(w http.ResponseWriter, r *http.Request) {
+	limit := r.URL.Query().Get("limit")
+	if limit == "" {
+		limit = "-1"
+	}
+	l, err := strconv.Atoi(limit)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	for n, c := range cases {
-		unread, err := i.node.Datastore.Chat().GetUnreadCount(c.CaseId)
-		if err != nil {
-			continue
+	offsetID := r.URL.Query().Get("offsetId")
+	filter := r.URL.Query().Get("filter")
+
+	types := strings.Split(filter, ",")
+	var filters []string
+	for _, t := range types {
+		if t != "" {
+			filters = append(filters, t)
 		}
-		cases[n].UnreadChatMessages = unread
 	}
-	type casesResponse struct {
-		QueryCount int         `json:"queryCount"`
-		Cases      []repo.Case `json:"cases"`
+
+	type notifData struct {
+		Unread        int               `json:"unread"`
+		Total         int               `json:"total"`
+		Notifications []json.RawMessage `json:"notifications"`
 	}
-	cr := casesResponse{queryCount, cases}
-	ret, err := json.MarshalIndent(cr, "", "    ")
+	notifs, total, err := i.node.Datastore.Notifications().GetAll(offsetID, l, filters)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	if isNullJSON(ret) {
-		ret = []byte("[]")
-	}
-	SanitizedResponse(w, string(ret))
-}
-
-func (i *jsonAPIHandler) POSTBlockNode(w http.ResponseWriter, r *http.Request) {
-	_, peerID := path.Split(r.URL.Path)
-	settings, err := i.node.Datastore.Settings().Get()
+	unread, err := i.node.Datastore.Notifications().GetUnreadCount()
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	var nodes []string
-	if settings.BlockedNodes != nil {
-		for _, pid := range *settings.BlockedNodes {
-			if pid == peerID {
-				fmt.Fprint(w, `{}`)
-				return
-			}
-			nodes = append(nodes, pid)
+
+	payload := notifData{unread, total, []json.RawMessage{}}
+	for _, n := range notifs {
+		data, err := n.Data()
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		}
+		payload.Notifications = append(payload.Notifications, data)
 	}
-	go ipfs.RemoveAll(i.node.IpfsNode, peerID, i.node.IPNSQuorumSize)
-	nodes = append(nodes, peerID)
-	settings.BlockedNodes = &nodes
-	if err := i.node.Datastore.Settings().Put(settings); err != nil {
+	ret, err := json.MarshalIndent(payload, "", "    ")
+	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	pid, err := peer.IDB58Decode(peerID)
+	retString := string(ret)
+	if strings.Contains(retString, "null") {
+		retString = strings.Replace(retString, "null", "[]", -1)
+	}
+	SanitizedResponse(w, retString)
+}
+
+func (i *jsonAPIHandler) POSTMarkNotificationAsRead(w http.ResponseWriter, r *http.Request) {
+	_, notifID := path.Split(r.URL.Path)
+	err := i.node.Datastore.Notifications().MarkAsRead(notifID)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	i.node.BanManager.AddBlockedId(pid)
-	i.node.Service.DisconnectFromPeer(pid)
 	SanitizedResponse(w, `{}`)
 }
 
-func (i *jsonAPIHandler) DELETEBlockNode(w http.ResponseWriter, r *http.Request) {
-	_, peerID := path.Split(r.URL.Path)
-	settings, err := i.node.Datastore.Settings().Get()
+func (i *jsonAPIHandler) POSTMarkNotificationsAsRead(w http.ResponseWriter, r *http.Request) {
+	err := i.node.Datastore.Notifications().MarkAllAsRead()
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	if settings.BlockedNodes != nil {
-		var nodes []string
-		for _, pid := range *settings.BlockedNodes {
-			if pid != peerID {
-				nodes = append(nodes, pid)
-			}
-		}
-		settings.BlockedNodes = &nodes
-	}
-	if err := i.node.Datastore.Settings().Put(settings); err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return
-	}
-	pid, err := peer.IDB58Decode(peerID)
+	SanitizedResponse(w, `{}`)
+}
+
+func (i *jsonAPIHandler) DELETENotification(w http.ResponseWriter, r *http.Request) {
+	_, notifID := path.Split(r.URL.Path)
+	err := i.node.Datastore.Notifications().Delete(notifID)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	i.node.BanManager.RemoveBlockedId(pid)
 	SanitizedResponse(w, `{}`)
 }
 
-func (i *jsonAPIHandler) POSTBumpFee(w http.ResponseWriter, r *http.Request) {
-	_, txid := path.Split(r.URL.Path)
-	txHash, err := chainhash.NewHashFromStr(txid)
+func (i *jsonAPIHandler) GETImage(w http.ResponseWriter, r *http.Request) {
+	_, imageHash := path.Split(r.URL.Path)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute*2)
+	defer cancel()
+
+	api, err := coreapi.NewCoreAPI(i.node.IpfsNode)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	var wal wallet.Wallet
-	for _, w := range i.node.Multiwallet {
-		_, err := w.GetTransaction(*txHash)
-		if err == nil {
-			wal = w
-			break
-		}
-	}
-	if wal == nil {
-		ErrorResponse(w, http.StatusBadRequest, "transaction not found in any wallet")
+	pth, err := iface.ParsePath("/ipfs/" + imageHash)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	newTxid, err := wal.BumpFee(*txHash)
+
+	nd, err := api.Unixfs().Get(ctx, pth)
 	if err != nil {
-		if err == spvwallet.BumpFeeAlreadyConfirmedError {
-			ErrorResponse(w, http.StatusBadRequest, err.Error())
-		} else if err == spvwallet.BumpFeeTransactionDeadError {
-			ErrorResponse(w, http.StatusMethodNotAllowed, err.Error())
-		} else if err == spvwallet.BumpFeeNotFoundError {
-			ErrorResponse(w, http.StatusNotFound, err.Error())
-		} else {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		}
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	m, err := i.node.Datastore.TxMetadata().Get(txid)
+	f, ok := nd.(files.File)
+	if !ok {
+		ErrorResponse(w, http.StatusInternalServerError, "Invalid type assertion")
+		return
+	}
+
+	w.Header().Set("Cache-Control", "public, max-age=29030400, immutable")
+	w.Header().Del("Content-Type")
+	http.ServeContent(w, r, imageHash, time.Now(), f)
+}
+
+func (i *jsonAPIHandler) GETAvatar(w http.ResponseWriter, r *http.Request) {
+	urlPath, size := path.Split(r.URL.Path)
+	_, peerID := path.Split(urlPath[:len(urlPath)-1])
+
+	cacheBool := r.URL.Query().Get("usecache")
+	useCache, _ := strconv.ParseBool(cacheBool)
+
+	dr, err := i.node.FetchAvatar(peerID, size, useCache)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.Header().Set("Cache-Control", "public, max-age=600, immutable")
+	w.Header().Del("Content-Type")
+	http.ServeContent(w, r, path.Join("ipns", peerID, "images", size, "avatar"), time.Now(), dr)
+}
+
+func (i *jsonAPIHandler) GETHeader(w http.ResponseWriter, r *http.Request) {
+	urlPath, size := path.Split(r.URL.Path)
+	_, peerID := path.Split(urlPath[:len(urlPath)-1])
+
+	cacheBool := r.URL.Query().Get("usecache")
+	useCache, _ := strconv.ParseBool(cacheBool)
+
+	dr, err := i.node.FetchHeader(peerID, size, useCache)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.Header().Set("Cache-Control", "public, max-age=600, immutable")
+	w.Header().Del("Content-Type")
+	http.ServeContent(w, r, path.Join("ipns", peerID, "images", size, "header"), time.Now(), dr)
+}
+
+func (i *jsonAPIHandler) POSTFetchProfiles(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query().Get("async")
+	async, _ := strconv.ParseBool(query)
+	cacheBool := r.URL.Query().Get("usecache")
+	useCache, _ := strconv.ParseBool(cacheBool)
+
+	var pids []string
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&pids)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if !async {
+		var wg sync.WaitGroup
+		var ret []string
+		for _, p := range pids {
+			wg.Add(1)
+			go func(pid string) {
+				pro, err := i.node.FetchProfile(pid, useCache)
+				if err != nil {
+					wg.Done()
+					return
+				}
+				obj := pb.PeerAndProfile{PeerId: pid, Profile: &pro}
+				m := jsonpb.Marshaler{
+					EnumsAsInts:  false,
+					EmitDefaults: true,
+					Indent:       "    ",
+					OrigName:     false,
+				}
+				respJSON, err := m.MarshalToString(&obj)
+				if err != nil {
+					return
+				}
+				ret = append(ret, respJSON)
+				wg.Done()
+			}(p)
+		}
+		wg.Wait()
+		resp := "[\n"
+		max := len(ret)
+		for i, r := range ret {
+			lines := strings.Split(r, "\n")
+			maxx := len(lines)
+			for x, s := range lines {
+				resp += "    "
+				resp += s
+				if x != maxx-1 {
+					resp += "\n"
+				}
+			}
+			if i != max-1 {
+				resp += ",\n"
+			}
+		}
+		resp += "\n]"
+		SanitizedResponse(w, resp)
+	} else {
+		id := r.URL.Query().Get("asyncID")
+		if id == "" {
+			idBytes := make([]byte, 16)
+			rand.Read(idBytes)
+			id = base58.Encode(idBytes)
+		}
+
+		type resp struct {
+			ID string `json:"id"`
+		}
+		response := resp{id}
+		respJSON, _ := json.MarshalIndent(response, "", "    ")
+		w.WriteHeader(http.StatusAccepted)
+		SanitizedResponse(w, string(respJSON))
+		go func() {
+			type profileError struct {
+				ID     string `json:"id"`
+				PeerID string `json:"peerID"`
+				Error  string `json:"error"`
+			}
+			for _, p := range pids {
+				go func(pid string) {
+					respondWithError := func(errorMsg string) {
+						e := profileError{id, pid, errorMsg}
+						ret, err := json.MarshalIndent(e, "", "    ")
+						if err != nil {
+							return
+						}
+						i.node.Broadcast <- repo.PremarshalledNotifier{Payload: ret}
+					}
+
+					pro, err := i.node.FetchProfile(pid, useCache)
+					if err != nil {
+						respondWithError("not found")
+						return
+					}
+					obj := pb.PeerAndProfileWithID{Id: id, PeerId: pid, Profile: &pro}
+					m := jsonpb.Marshaler{
+						EnumsAsInts:  false,
+						EmitDefaults: true,
+						Indent:       "    ",
+						OrigName:     false,
+					}
+					respJSON, err := m.MarshalToString(&obj)
+					if err != nil {
+						respondWithError("error Marshalling to JSON")
+						return
+					}
+					b, err := SanitizeProtobuf(respJSON, new(pb.PeerAndProfileWithID))
+					if err != nil {
+						respondWithError("error Marshalling to JSON")
+						return
+					}
+					i.node.Broadcast <- repo.PremarshalledNotifier{Payload: b}
+				}(p)
+			}
+		}()
+	}
+}
+
+func (i *jsonAPIHandler) GETTransactions(w http.ResponseWriter, r *http.Request) {
+	_, coinType := path.Split(r.URL.Path)
+	l := r.URL.Query().Get("limit")
+	if l == "" {
+		l = "-1"
+	}
+	limit, err := strconv.Atoi(l)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	offsetID := r.URL.Query().Get("offsetId")
+	type Tx struct {
+		Txid          string        `json:"txid"`
+		Value         int64         `json:"value"`
+		Address       string        `json:"address"`
+		Status        string        `json:"status"`
+		ErrorMessage  string        `json:"errorMessage"`
+		Memo          string        `json:"memo"`
+		Timestamp     *repo.APITime `json:"timestamp"`
+		Confirmations int32         `json:"confirmations"`
+		Height        int32         `json:"height"`
+		OrderID       string        `json:"orderId"`
+		Thumbnail     string        `json:"thumbnail"`
+		CanBumpFee    bool          `json:"canBumpFee"`
+	}
+	wal, err := i.node.Multiwallet.WalletForCurrencyCode(coinType)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "Unknown wallet type")
+		return
+	}
+	transactions, err := wal.Transactions()
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	metadata, err := i.node.Datastore.TxMetadata().GetAll()
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	var txs []Tx
+	passedOffset := false
+	for i := len(transactions) - 1; i >= 0; i-- {
+		t := transactions[i]
+		tx := Tx{
+			Txid:          t.Txid,
+			Value:         t.Value,
+			Timestamp:     repo.NewAPITime(t.Timestamp),
+			Confirmations: int32(t.Confirmations),
+			Height:        t.Height,
+			Status:        string(t.Status),
+			CanBumpFee:    true,
+			ErrorMessage:  t.ErrorMessage,
+		}
+		m, ok := metadata[t.Txid]
+		if ok {
+			tx.Address = m.Address
+			tx.Memo = m.Memo
+			tx.OrderID = m.OrderId
+			tx.Thumbnail = m.Thumbnail
+			tx.CanBumpFee = m.CanBumpFee
+		}
+		if t.Status == wallet.StatusDead {
+			tx.CanBumpFee = false
+		}
+		if offsetID == "" || passedOffset {
+			txs = append(txs, tx)
+		}
+		if t.Txid == offsetID {
+			passedOffset = true
+		}
+		if len(txs) >= limit && limit != -1 {
+			break
+		}
+	}
+	type txWithCount struct {
+		Transactions []Tx `json:"transactions"`
+		Count        int  `json:"count"`
+	}
+	txns := txWithCount{txs, len(transactions)}
+	ret, err := json.MarshalIndent(txns, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+func (i *jsonAPIHandler) GETPurchases(w http.ResponseWriter, r *http.Request) {
+	orderStates, searchTerm, sortByAscending, sortByRead, limit, err := parseSearchTerms(r.URL.Query())
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	purchases, queryCount, err := i.node.Datastore.Purchases().GetAll(orderStates, searchTerm, sortByAscending, sortByRead, limit, []string{})
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	for n, p := range purchases {
+		unread, err := i.node.Datastore.Chat().GetUnreadCount(p.OrderId)
+		if err != nil {
+			continue
+		}
+		purchases[n].UnreadChatMessages = unread
+	}
+	type purchasesResponse struct {
+		QueryCount int             `json:"queryCount"`
+		Purchases  []repo.Purchase `json:"purchases"`
+	}
+	pr := purchasesResponse{queryCount, purchases}
+	ret, err := json.MarshalIndent(pr, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if isNullJSON(ret) {
+		ret = []byte("[]")
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+func (i *jsonAPIHandler) GETSales(w http.ResponseWriter, r *http.Request) {
+	orderStates, searchTerm, sortByAscending, sortByRead, limit, err := parseSearchTerms(r.URL.Query())
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	sales, queryCount, err := i.node.Datastore.Sales().GetAll(orderStates, searchTerm, sortByAscending, sortByRead, limit, []string{})
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	for n, s := range sales {
+		unread, err := i.node.Datastore.Chat().GetUnreadCount(s.OrderId)
+		if err != nil {
+			continue
+		}
+		sales[n].UnreadChatMessages = unread
+	}
+	type salesResponse struct {
+		QueryCount int         `json:"queryCount"`
+		Sales      []repo.Sale `json:"sales"`
+	}
+	sr := salesResponse{queryCount, sales}
+
+	ret, err := json.MarshalIndent(sr, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if isNullJSON(ret) {
+		ret = []byte("[]")
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+func (i *jsonAPIHandler) GETCases(w http.ResponseWriter, r *http.Request) {
+	orderStates, searchTerm, sortByAscending, sortByRead, limit, err := parseSearchTerms(r.URL.Query())
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	cases, queryCount, err := i.node.Datastore.Cases().GetAll(orderStates, searchTerm, sortByAscending, sortByRead, limit, []string{})
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	for n, c := range cases {
+		unread, err := i.node.Datastore.Chat().GetUnreadCount(c.CaseId)
+		if err != nil {
+			continue
+		}
+		cases[n].UnreadChatMessages = unread
+	}
+	type casesResponse struct {
+		QueryCount int         `json:"queryCount"`
+		Cases      []repo.Case `json:"cases"`
+	}
+	cr := casesResponse{queryCount, cases}
+	ret, err := json.MarshalIndent(cr, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if isNullJSON(ret) {
+		ret = []byte("[]")
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+func (i *jsonAPIHandler) POSTPurchases(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var query TransactionQuery
+	err := decoder.Decode(&query)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	purchases, queryCount, err := i.node.Datastore.Purchases().GetAll(convertOrderStates(query.OrderStates), query.SearchTerm, query.SortByAscending, query.SortByRead, query.Limit, query.Exclude)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	for n, p := range purchases {
+		unread, err := i.node.Datastore.Chat().GetUnreadCount(p.OrderId)
+		if err != nil {
+			continue
+		}
+		purchases[n].UnreadChatMessages = unread
+	}
+	type purchasesResponse struct {
+		QueryCount int             `json:"queryCount"`
+		Purchases  []repo.Purchase `json:"purchases"`
+	}
+	pr := purchasesResponse{queryCount, purchases}
+	ret, err := json.MarshalIndent(pr, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if isNullJSON(ret) {
+		ret = []byte("[]")
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+func (i *jsonAPIHandler) POSTSales(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var query TransactionQuery
+	err := decoder.Decode(&query)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	sales, queryCount, err := i.node.Datastore.Sales().GetAll(convertOrderStates(query.OrderStates), query.SearchTerm, query.SortByAscending, query.SortByRead, query.Limit, query.Exclude)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	for n, s := range sales {
+		unread, err := i.node.Datastore.Chat().GetUnreadCount(s.OrderId)
+		if err != nil {
+			continue
+		}
+		sales[n].UnreadChatMessages = unread
+	}
+	type salesResponse struct {
+		QueryCount int         `json:"queryCount"`
+		Sales      []repo.Sale `json:"sales"`
+	}
+	sr := salesResponse{queryCount, sales}
+
+	ret, err := json.MarshalIndent(sr, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if isNullJSON(ret) {
+		ret = []byte("[]")
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+func (i *jsonAPIHandler) POSTCases(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var query TransactionQuery
+	err := decoder.Decode(&query)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	cases, queryCount, err := i.node.Datastore.Cases().GetAll(convertOrderStates(query.OrderStates), query.SearchTerm, query.SortByAscending, query.SortByRead, query.Limit, query.Exclude)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	for n, c := range cases {
+		unread, err := i.node.Datastore.Chat().GetUnreadCount(c.CaseId)
+		if err != nil {
+			continue
+		}
+		cases[n].UnreadChatMessages = unread
+	}
+	type casesResponse struct {
+		QueryCount int         `json:"queryCount"`
+		Cases      []repo.Case `json:"cases"`
+	}
+	cr := casesResponse{queryCount, cases}
+	ret, err := json.MarshalIndent(cr, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if isNullJSON(ret) {
+		ret = []byte("[]")
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+func (i *jsonAPIHandler) POSTBlockNode(w http.ResponseWriter, r *http.Request) {
+	_, peerID := path.Split(r.URL.Path)
+	settings, err := i.node.Datastore.Settings().Get()
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	var nodes []string
+	if settings.BlockedNodes != nil {
+		for _, pid := range *settings.BlockedNodes {
+			if pid == peerID {
+				fmt.Fprint(w, `{}`)
+				return
+			}
+			nodes = append(nodes, pid)
+		}
+	}
+	go ipfs.RemoveAll(i.node.IpfsNode, peerID, i.node.IPNSQuorumSize)
+	nodes = append(nodes, peerID)
+	settings.BlockedNodes = &nodes
+	if err := i.node.Datastore.Settings().Put(settings); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	pid, err := peer.IDB58Decode(peerID)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	i.node.BanManager.AddBlockedId(pid)
+	i.node.Service.DisconnectFromPeer(pid)
+	SanitizedResponse(w, `{}`)
+}
+
+func (i *jsonAPIHandler) DELETEBlockNode(w http.ResponseWriter, r *http.Request) {
+	_, peerID := path.Split(r.URL.Path)
+	settings, err := i.node.Datastore.Settings().Get()
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if settings.BlockedNodes != nil {
+		var nodes []string
+		for _, pid := range *settings.BlockedNodes {
+			if pid != peerID {
+				nodes = append(nodes, pid)
+			}
+		}
+		settings.BlockedNodes = &nodes
+	}
+	if err := i.node.Datastore.Settings().Put(settings); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	pid, err := peer.IDB58Decode(peerID)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	i.node.BanManager.RemoveBlockedId(pid)
+	SanitizedResponse(w, `{}`)
+}
+
+func (i *jsonAPIHandler) POSTBumpFee(w http.ResponseWriter, r *http.Request) {
+	_, txid := path.Split(r.URL.Path)
+	txHash, err := chainhash.NewHashFromStr(txid)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	var wal wallet.Wallet
+	for _, w := range i.node.Multiwallet {
+		_, err := w.GetTransaction(*txHash)
+		if err == nil {
+			wal = w
+			break
+		}
+	}
+	if wal == nil {
+		ErrorResponse(w, http.StatusBadRequest, "transaction not found in any wallet")
+		return
+	}
+	newTxid, err := wal.BumpFee(*txHash)
+	if err != nil {
+		if err == spvwallet.BumpFeeAlreadyConfirmedError {
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+		} else if err == spvwallet.BumpFeeTransactionDeadError {
+			ErrorResponse(w, http.StatusMethodNotAllowed, err.Error())
+		} else if err == spvwallet.BumpFeeNotFoundError {
+			ErrorResponse(w, http.StatusNotFound, err.Error())
+		} else {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+	m, err := i.node.Datastore.TxMetadata().Get(txid)
+	if err != nil {
+		m = repo.Metadata{}
+	}
+	m.Txid = txid
+	m.CanBumpFee = false
+	if err := i.node.Datastore.TxMetadata().Put(m); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if err := i.node.Datastore.TxMetadata().Put(repo.Metadata{
+		Txid:       newTxid.String(),
+		Address:    "",
+		Memo:       fmt.Sprintf("Fee bump of %s", txid),
+		OrderId:    "",
+		Thumbnail:  "",
+		CanBumpFee: true,
+	}); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	type response struct {
+		Txid               string        `json:"txid"`
+		Amount             int64         `json:"amount"`
+		ConfirmedBalance   int64         `json:"confirmedBalance"`
+		UnconfirmedBalance int64         `json:"unconfirmedBalance"`
+		Timestamp          *repo.APITime `json:"timestamp"`
+		Memo               string        `json:"memo"`
+	}
+	confirmed, unconfirmed := wal.Balance()
+	txn, err := wal.GetTransaction(*newTxid)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	t := repo.NewAPITime(txn.Timestamp)
+	resp := &response{
+		Txid:               newTxid.String(),
+		ConfirmedBalance:   confirmed,
+		UnconfirmedBalance: unconfirmed,
+		Amount:             -(txn.Value),
+		Timestamp:          t,
+		Memo:               fmt.Sprintf("Fee bump of %s", txid),
+	}
+	ser, err := json.MarshalIndent(resp, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ser))
+}
+
+func (i *jsonAPIHandler) GETEstimateFee(w http.ResponseWriter, r *http.Request) {
+	_, coinType := path.Split(r.URL.Path)
+
+	fl := r.URL.Query().Get("feeLevel")
+	amt := r.URL.Query().Get("amount")
+	amount, err := strconv.Atoi(amt)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var feeLevel wallet.FeeLevel
+	switch strings.ToUpper(fl) {
+	case "PRIORITY":
+		feeLevel = wallet.PRIOIRTY
+	case "NORMAL":
+		feeLevel = wallet.NORMAL
+	case "ECONOMIC":
+		feeLevel = wallet.ECONOMIC
+	default:
+		ErrorResponse(w, http.StatusBadRequest, "Unknown feeLevel")
+		return
+	}
+
+	wal, err := i.node.Multiwallet.WalletForCurrencyCode(coinType)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "Unknown wallet type")
+		return
+	}
+
+	fee, err := wal.EstimateSpendFee(int64(amount), feeLevel)
+	if err != nil {
+		switch {
+		case err == wallet.ErrorInsuffientFunds:
+			ErrorResponse(w, http.StatusBadRequest, `ERROR_INSUFFICIENT_FUNDS`)
+			return
+		case err == wallet.ErrorDustAmount:
+			ErrorResponse(w, http.StatusBadRequest, `ERROR_DUST_AMOUNT`)
+			return
+		default:
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
+	fmt.Fprintf(w, `{"estimatedFee": %d}`, fee)
+}
+
+func (i *jsonAPIHandler) GETFees(w http.ResponseWriter, r *http.Request) {
+	_, coinType := path.Split(r.URL.Path)
+	type fees struct {
+		Priority uint64 `json:"priority"`
+		Normal   uint64 `json:"normal"`
+		Economic uint64 `json:"economic"`
+	}
+	if coinType == "fees" {
+		ret := make(map[string]interface{})
+		for ct, wal := range i.node.Multiwallet {
+			priority := wal.GetFeePerByte(wallet.PRIOIRTY)
+			normal := wal.GetFeePerByte(wallet.NORMAL)
+			economic := wal.GetFeePerByte(wallet.ECONOMIC)
+			ret[ct.CurrencyCode()] = fees{Priority: priority, Normal: normal, Economic: economic}
+		}
+		out, err := json.MarshalIndent(ret, "", "    ")
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		SanitizedResponse(w, string(out))
+		return
+	}
+	wal, err := i.node.Multiwallet.WalletForCurrencyCode(coinType)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "Unknown wallet type")
+		return
+	}
+	priority := wal.GetFeePerByte(wallet.PRIOIRTY)
+	normal := wal.GetFeePerByte(wallet.NORMAL)
+	economic := wal.GetFeePerByte(wallet.ECONOMIC)
+	f := fees{Priority: priority, Normal: normal, Economic: economic}
+	out, err := json.MarshalIndent(f, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(out))
+}
+
+func (i *jsonAPIHandler) POSTEstimateTotal(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var data core.PurchaseData
+	err := decoder.Decode(&data)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	amount, err := i.node.EstimateOrderTotal(&data)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	fmt.Fprintf(w, "%d", int(amount))
+}
+
+func (i *jsonAPIHandler) GETRatings(w http.ResponseWriter, r *http.Request) {
+	urlPath, slug := path.Split(r.URL.Path)
+	_, peerID := path.Split(urlPath[:len(urlPath)-1])
+	useCache, _ := strconv.ParseBool(r.URL.Query().Get("usecache"))
+
+	if peerID == "ratings" {
+		peerID = slug
+		slug = ""
+	}
+
+	buyerRatings := pb.EntityRatingStore{}
+
+	var indexBytes []byte
+	var buyerRatingBytes []byte
+	if peerID != i.node.IPFSIdentityString() && peerID != "ob" {
+		indexBytes, _ = ipfs.ResolveThenCat(i.node.IpfsNode, ipnspath.FromString(path.Join(peerID, "ratings.json")), time.Minute, i.node.IPNSQuorumSize, useCache)
+		buyerRatingBytes, _ = ipfs.ResolveThenCat(i.node.IpfsNode, ipnspath.FromString(path.Join(peerID, "entityratings", "buyer.json")), time.Minute, i.node.IPNSQuorumSize, useCache)
+	} else {
+		indexBytes, _ = ioutil.ReadFile(path.Join(i.node.RepoPath, "root", "ratings.json"))
+		buyerRatingBytes, _ = ioutil.ReadFile(path.Join(i.node.RepoPath, "root", "entityratings", "buyer.json"))
+	}
+	if buyerRatingBytes != nil {
+		//jsonpb.UnmarshalString(string(buyerRatingBytes), &buyerRatings)
+		json.Unmarshal(buyerRatingBytes, &buyerRatings)
+	}
+	if indexBytes == nil {
+		kimitzuresp := core.KimitzuRatingResp{}
+		kimitzuresp.BuyerRatings = buyerRatings.Ratings
+		kimitzuresp.Count = len(buyerRatings.Ratings)
+		kimitzuresp.Average = kimitzuresp.ComputeAverage()
+		rating := new(core.SavedRating)
+		rating.Kimitzu = kimitzuresp
+		rating.Ratings = []string{}
+		ret, err := json.MarshalIndent(rating, "", "    ")
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		SanitizedResponse(w, string(ret))
+		return
+	}
+
+	var ratingList []core.SavedRating
+	err := json.Unmarshal(indexBytes, &ratingList)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	kimitzuresp := core.KimitzuRatingResp{}
+	kimitzuresp.BuyerRatings = buyerRatings.Ratings
+	kimitzuresp.Count = len(buyerRatings.Ratings)
+	kimitzuresp.Average = kimitzuresp.ComputeAverage()
+
+	if slug != "" {
+		// Other peer ID Rating
+		rating := new(core.SavedRating)
+		rating.Kimitzu = kimitzuresp
+		for _, r := range ratingList {
+			if r.Slug == slug {
+				rating = &r
+				break
+			}
+		}
+		ret, err := json.MarshalIndent(rating, "", "    ")
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		SanitizedResponse(w, string(ret))
+	} else {
+		// Other peer ID without slug, basically everything
+		type resp struct {
+			Count    int                           `json:"count"`
+			Average  float32                       `json:"average"`
+			Criteria []*pb.Profile_RatingCriterion `json:"criteria,omitempty"`
+			Ratings  []string                      `json:"ratings"`
+			Kimitzu  core.KimitzuRatingResp        `json:"kimitzu"`
+		}
+		ratingRet := new(resp)
+		ratingRet.Kimitzu = kimitzuresp
+		total := float32(0)
+		count := 0
+		var criteria [][]*pb.Profile_RatingCriterion
+		for _, r := range ratingList {
+			total += r.Average * float32(r.Count)
+			count += r.Count
+			criteria = append(criteria, r.Criteria)
+			ratingRet.Ratings = append(ratingRet.Ratings, r.Ratings...)
+		}
+		ratingRet.Count = count
+		ratingRet.Average = total / float32(count)
+		ratingRet.Criteria = core.MergeRatingCriteria(criteria...)
+		ret, err := json.MarshalIndent(ratingRet, "", "    ")
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		SanitizedResponse(w, string(ret))
+	}
+}
+
+func (i *jsonAPIHandler) GETBuyerRatings(w http.ResponseWriter, r *http.Request) {
+	_, peerID := path.Split(r.URL.Path)
+	useCache, _ := strconv.ParseBool(r.URL.Query().Get("usecache"))
+	if peerID == "" || strings.ToLower(peerID) == "buyerratings" {
+		peerID = i.node.IPFSIdentityString()
+	}
+	ratings, err := i.node.GetBuyerRatings(peerID, useCache)
+	if err != nil {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	}
+	ret, err := json.MarshalIndent(ratings, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+func (i *jsonAPIHandler) GETRating(w http.ResponseWriter, r *http.Request) {
+	_, ratingID := path.Split(r.URL.Path)
+
+	ratingBytes, err := ipfs.Cat(i.node.IpfsNode, ratingID, time.Minute)
+	if err != nil {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	rating := new(pb.Rating)
+	err = jsonpb.UnmarshalString(string(ratingBytes), rating)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	valid, err := core.ValidateRating(rating)
+	if !valid || err != nil {
+		ErrorResponse(w, http.StatusExpectationFailed, err.Error())
+		return
+	}
+	ret, err := json.MarshalIndent(rating, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+func (i *jsonAPIHandler) POSTFetchRatings(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var rp []string
+	err := decoder.Decode(&rp)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	query := r.URL.Query().Get("async")
+	async, _ := strconv.ParseBool(query)
+
+	if !async {
+		var wg sync.WaitGroup
+		var ret []string
+		for _, id := range rp {
+			wg.Add(1)
+			go func(rid string) {
+				ratingBytes, err := ipfs.Cat(i.node.IpfsNode, rid, time.Minute)
+				if err != nil {
+					return
+				}
+				rating := new(pb.Rating)
+				err = jsonpb.UnmarshalString(string(ratingBytes), rating)
+				if err != nil {
+					return
+				}
+				valid, err := core.ValidateRating(rating)
+				if !valid || err != nil {
+					return
+				}
+				m := jsonpb.Marshaler{
+					EnumsAsInts:  false,
+					EmitDefaults: true,
+					Indent:       "    ",
+					OrigName:     false,
+				}
+				respJSON, err := m.MarshalToString(rating)
+				if err != nil {
+					return
+				}
+				ret = append(ret, respJSON)
+				wg.Done()
+			}(id)
+		}
+		wg.Wait()
+		resp := "[\n"
+		max := len(ret)
+		for i, r := range ret {
+			lines := strings.Split(r, "\n")
+			maxx := len(lines)
+			for x, s := range lines {
+				resp += "    "
+				resp += s
+				if x != maxx-1 {
+					resp += "\n"
+				}
+			}
+			if i != max-1 {
+				resp += ",\n"
+			}
+		}
+		resp += "\n]"
+		SanitizedResponse(w, resp)
+	} else {
+		id := r.URL.Query().Get("asyncID")
+		if id == "" {
+			idBytes := make([]byte, 16)
+			rand.Read(idBytes)
+			id = base58.Encode(idBytes)
+		}
+
+		type resp struct {
+			ID string `json:"id"`
+		}
+		response := resp{id}
+		respJSON, _ := json.MarshalIndent(response, "", "    ")
+		w.WriteHeader(http.StatusAccepted)
+		SanitizedResponse(w, string(respJSON))
+		for _, r := range rp {
+			go func(rid string) {
+				type ratingError struct {
+					ID       string `json:"id"`
+					RatingID string `json:"ratingId"`
+					Error    string `json:"error"`
+				}
+				respondWithError := func(errorMsg string) {
+					e := ratingError{id, rid, errorMsg}
+					ret, err := json.MarshalIndent(e, "", "    ")
+					if err != nil {
+						return
+					}
+					i.node.Broadcast <- repo.PremarshalledNotifier{Payload: ret}
+				}
+				ratingBytes, err := ipfs.Cat(i.node.IpfsNode, rid, time.Minute)
+				if err != nil {
+					respondWithError("not Found")
+					return
+				}
+
+				rating := new(pb.Rating)
+				err = jsonpb.UnmarshalString(string(ratingBytes), rating)
+				if err != nil {
+					respondWithError("invalid rating")
+					return
+				}
+				valid, err := core.ValidateRating(rating)
+				if !valid || err != nil {
+					respondWithError(err.Error())
+					return
+				}
+				resp := new(pb.RatingWithID)
+				resp.Id = id
+				resp.RatingId = rid
+				resp.Rating = rating
+				m := jsonpb.Marshaler{
+					EnumsAsInts:  false,
+					EmitDefaults: true,
+					Indent:       "    ",
+					OrigName:     false,
+				}
+				out, err := m.MarshalToString(resp)
+				if err != nil {
+					respondWithError("error marshalling rating")
+					return
+				}
+				b, err := SanitizeProtobuf(out, new(pb.RatingWithID))
+				if err != nil {
+					respondWithError("error marshalling rating")
+					return
+				}
+				i.node.Broadcast <- repo.PremarshalledNotifier{Payload: b}
+			}(r)
+		}
+	}
+}
+
+func (i *jsonAPIHandler) POSTImportListings(w http.ResponseWriter, r *http.Request) {
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	defer file.Close()
+
+	err = i.node.ImportListings(file)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	// Republish to IPNS
+	if err := i.node.SeedNode(); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, "{}")
+}
+
+func (i *jsonAPIHandler) GETHealthCheck(w http.ResponseWriter, r *http.Request) {
+	type resp struct {
+		Database bool `json:"database"`
+		IPFSRoot bool `json:"ipfsRoot"`
+		Peers    bool `json:"peers"`
+	}
+
+	re := resp{true, true, true}
+	pingErr := i.node.Datastore.Ping()
+	if pingErr != nil {
+		re.Database = false
+	}
+	_, ferr := os.Stat(i.node.RepoPath)
+	if ferr != nil {
+		re.IPFSRoot = false
+	}
+	peers := ipfs.ConnectedPeers(i.node.IpfsNode)
+	if len(peers) == 0 {
+		re.Peers = false
+	}
+	if pingErr != nil || ferr != nil {
+		ret, _ := json.MarshalIndent(re, "", "    ")
+		ErrorResponse(w, http.StatusNotFound, string(ret))
+		return
+	}
+	SanitizedResponse(w, "{}")
+}
+
+func (i *jsonAPIHandler) POSTPublish(w http.ResponseWriter, r *http.Request) {
+	// Republish to IPNS
+	if err := i.node.SeedNode(); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, "{}")
+}
+
+func (i *jsonAPIHandler) POSTPurgeCache(w http.ResponseWriter, r *http.Request) {
+
+	ch, err := i.node.IpfsNode.Blockstore.AllKeysChan(context.Background())
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	for id := range ch {
+		if err := i.node.IpfsNode.Blockstore.DeleteBlock(id); err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
+
+	// Republish to IPNS
+	if err := i.node.SeedNode(); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, "{}")
+}
+
+func (i *jsonAPIHandler) GETWalletStatus(w http.ResponseWriter, r *http.Request) {
+
+	_, coinType := path.Split(r.URL.Path)
+	type status struct {
+		Height   uint32 `json:"height"`
+		BestHash string `json:"bestHash"`
+	}
+	if coinType == "status" {
+		ret := make(map[string]interface{})
+		for ct, wal := range i.node.Multiwallet {
+			height, hash := wal.ChainTip()
+			ret[ct.CurrencyCode()] = status{height, hash.String()}
+		}
+		out, err := json.MarshalIndent(ret, "", "    ")
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		SanitizedResponse(w, string(out))
+		return
+	}
+	wal, err := i.node.Multiwallet.WalletForCurrencyCode(coinType)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, "Unknown wallet type")
+		return
+	}
+	height, hash := wal.ChainTip()
+	st := status{height, hash.String()}
+	out, err := json.MarshalIndent(st, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(out))
+}
+
+func (i *jsonAPIHandler) GETIPNS(w http.ResponseWriter, r *http.Request) {
+	ipfsStore := i.node.IpfsNode.Repo.Datastore()
+	_, peerID := path.Split(r.URL.Path)
+
+	pid, err := peer.IDB58Decode(peerID)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	peerIPNSRecord, err := ipfs.GetCachedIPNSRecord(ipfsStore, pid)
+	if err != nil { // No record in datastore
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	var keyBytes []byte
+	pubkey := i.node.IpfsNode.Peerstore.PubKey(pid)
+	if pubkey == nil || !pid.MatchesPublicKey(pubkey) {
+		keyval, err := ipfs.GetCachedPubkey(ipfsStore, peerID)
+		if err != nil {
+			ErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+		keyBytes = keyval
+	} else {
+		keyBytes, err = pubkey.Bytes()
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
+
+	type KeyAndRecord struct {
+		Pubkey string `json:"pubkey"`
+		Record string `json:"record"`
+	}
+	peerIPNSBytes, err := ggproto.Marshal(peerIPNSRecord)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("marshaling IPNS record: %s", err.Error()))
+		return
+	}
+
+	ret := KeyAndRecord{hex.EncodeToString(keyBytes), string(peerIPNSBytes)}
+	retBytes, err := json.MarshalIndent(ret, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	go ipfs.Resolve(i.node.IpfsNode, pid, time.Minute, i.node.IPNSQuorumSize, false)
+	fmt.Fprint(w, string(retBytes))
+}
+
+func (i *jsonAPIHandler) GETResolveIPNS(w http.ResponseWriter, r *http.Request) {
+	_, peerID := path.Split(r.URL.Path)
+	if len(peerID) == 0 || peerID == "resolveipns" {
+		peerID = i.node.IpfsNode.Identity.Pretty()
+	}
+
+	type respType struct {
+		PeerID string `json:"peerid"`
+		Record struct {
+			Hex string `json:"hex"`
+		} `json:"record"`
+	}
+	var response = respType{PeerID: peerID}
+
+	if i.node.IpfsNode.Identity.Pretty() == peerID {
+		rec, err := ipfs.GetCachedIPNSRecord(i.node.IpfsNode.Repo.Datastore(), i.node.IpfsNode.Identity)
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("retrieving self: %s", err))
+			return
+		}
+		ipnsBytes, err := proto.Marshal(rec)
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("marshaling self: %s", err))
+			return
+		}
+		response.Record.Hex = hex.EncodeToString(ipnsBytes)
+		b, err := json.MarshalIndent(response, "", "    ")
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("marshal json error: %s", err))
+			return
+		}
+
+		SanitizedResponse(w, string(b))
+		return
+	}
+
+	pid, err := peer.IDB58Decode(peerID)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*180)
+	_, err = routing.GetPublicKey(i.node.IpfsNode.Routing, ctx, pid)
+	cancel()
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second*180)
+	ipnsBytes, err := i.node.IpfsNode.Routing.GetValue(ctx, ipns.RecordKey(pid))
+	cancel()
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	response.Record.Hex = hex.EncodeToString(ipnsBytes)
+	b, err := json.MarshalIndent(response, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("marshal json error: %s", err))
+		return
+	}
+
+	SanitizedResponse(w, string(b))
+}
+
+func (i *jsonAPIHandler) POSTTestEmailNotifications(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var settings repo.SMTPSettings
+	err := decoder.Decode(&settings)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	notifier := smtpNotifier{&settings}
+	err = notifier.notify(repo.TestNotification{})
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	SanitizedResponse(w, "{}")
+}
+
+func (i *jsonAPIHandler) POSTTestNotifications(w http.ResponseWriter, r *http.Request) {
+	_, channel := path.Split(r.URL.Path)
+	plugin, ok := notifierPlugins[strings.ToLower(channel)]
+	if !ok {
+		ErrorResponse(w, http.StatusNotFound, fmt.Sprintf("unknown notification channel: %s", channel))
+		return
+	}
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	notifier, err := plugin.decode(data, notifierHTTPClient(i.node))
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	err = notifier.notify(repo.TestNotification{})
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	SanitizedResponse(w, "{}")
+}
+
+func (i *jsonAPIHandler) GETPeerInfo(w http.ResponseWriter, r *http.Request) {
+	_, idb58 := path.Split(r.URL.Path)
+	pid, err := peer.IDB58Decode(idb58)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*90)
+	defer cancel()
+
+	pi, err := i.node.IpfsNode.Routing.FindPeer(ctx, pid)
+	if err != nil {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	}
+	out, err := pi.MarshalJSON()
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(out))
+}
+
+type bulkUpdateCurrencyRequest struct {
+	Currencies []string `json:"currencies"`
+}
+
+func (i *jsonAPIHandler) POSTBulkUpdateCurrency(w http.ResponseWriter, r *http.Request) {
+	// Retrieve attribute and values to update
+
+	var bulkUpdate bulkUpdateCurrencyRequest
+	err := json.NewDecoder(r.Body).Decode(&bulkUpdate)
+	if err != nil {
+		http.Error(w, err.Error(), 400)
+		return
+	}
+
+	// Check for no currencies selected
+	if len(bulkUpdate.Currencies) == 0 {
+		SanitizedResponse(w, `{"success": "false", "reason":"No currencies specified"}`)
+		return
+	}
+
+	log.Info("Updating currencies for all listings to: ", bulkUpdate.Currencies)
+	err = i.node.SetCurrencyOnListings(bulkUpdate.Currencies)
+	if err != nil {
+		http.Error(w, err.Error(), 400)
+		return
+	}
+
+	SanitizedResponse(w, `{"success": "true"}`)
+}
+
+// POSTS
+
+// Post a post
+func (i *jsonAPIHandler) POSTPost(w http.ResponseWriter, r *http.Request) {
+	ld := new(pb.Post)
+	err := jsonpb.Unmarshal(r.Body, ld)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// If the post already exists in path, tell them to use PUT
+	postPath := path.Join(i.node.RepoPath, "root", "posts", ld.Slug+".json")
+	if ld.Slug != "" {
+		_, ferr := os.Stat(postPath)
+		if !os.IsNotExist(ferr) {
+			ErrorResponse(w, http.StatusConflict, "Post already exists. Use PUT.")
+			return
+		}
+	} else {
+		// The post isn't in the path and is new, therefore add required data (slug, timestamp)
+		// Generate a slug from the title
+		ld.Slug, err = i.node.GeneratePostSlug(ld.Status)
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
+	// Add the timestamp
+	ld.Timestamp, err = ptypes.TimestampProto(time.Now())
 	if err != nil {
-		m = repo.Metadata{}
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
 	}
-	m.Txid = txid
-	m.CanBumpFee = false
-	if err := i.node.Datastore.TxMetadata().Put(m); err != nil {
+	// Sign the post
+	signedPost, err := i.node.SignPost(ld)
+	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	if err := i.node.Datastore.TxMetadata().Put(repo.Metadata{
-		Txid:       newTxid.String(),
-		Address:    "",
-		Memo:       fmt.Sprintf("Fee bump of %s", txid),
-		OrderId:    "",
-		Thumbnail:  "",
-		CanBumpFee: true,
-	}); err != nil {
+	// Add to path
+	postPath = path.Join(i.node.RepoPath, "root", "posts", signedPost.Post.Slug+".json")
+	f, err := os.Create(postPath)
+	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	type response struct {
-		Txid               string        `json:"txid"`
-		Amount             int64         `json:"amount"`
-		ConfirmedBalance   int64         `json:"confirmedBalance"`
-		UnconfirmedBalance int64         `json:"unconfirmedBalance"`
-		Timestamp          *repo.APITime `json:"timestamp"`
-		Memo               string        `json:"memo"`
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
 	}
-	confirmed, unconfirmed := wal.Balance()
-	txn, err := wal.GetTransaction(*newTxid)
+	out, err := m.MarshalToString(signedPost)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	t := repo.NewAPITime(txn.Timestamp)
-	resp := &response{
-		Txid:               newTxid.String(),
-		ConfirmedBalance:   confirmed,
-		UnconfirmedBalance: unconfirmed,
-		Amount:             -(txn.Value),
-		Timestamp:          t,
-		Memo:               fmt.Sprintf("Fee bump of %s", txid),
+
+	if _, err := f.WriteString(out); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
 	}
-	ser, err := json.MarshalIndent(resp, "", "    ")
+	err = i.node.UpdatePostIndex(signedPost)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	SanitizedResponse(w, string(ser))
+	// Update followers/following
+	err = i.node.UpdateFollow()
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if err := i.node.SeedNode(); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, fmt.Sprintf(`{"slug": "%s"}`, signedPost.Post.Slug))
 }
 
-func (i *jsonAPIHandler) GETEstimateFee(w http.ResponseWriter, r *http.Request) {
-	_, coinType := path.Split(r.URL.Path)
-
-	fl := r.URL.Query().Get("feeLevel")
-	amt := r.URL.Query().Get("amount")
-	amount, err := strconv.Atoi(amt)
+// PUT a post
+func (i *jsonAPIHandler) PUTPost(w http.ResponseWriter, r *http.Request) {
+	ld := new(pb.Post)
+	err := jsonpb.Unmarshal(r.Body, ld)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-
-	var feeLevel wallet.FeeLevel
-	switch strings.ToUpper(fl) {
-	case "PRIORITY":
-		feeLevel = wallet.PRIOIRTY
-	case "NORMAL":
-		feeLevel = wallet.NORMAL
-	case "ECONOMIC":
-		feeLevel = wallet.ECONOMIC
-	default:
-		ErrorResponse(w, http.StatusBadRequest, "Unknown feeLevel")
+	// Check if the post exists
+	postPath := path.Join(i.node.RepoPath, "root", "posts", ld.Slug+".json")
+	_, ferr := os.Stat(postPath)
+	if os.IsNotExist(ferr) {
+		ErrorResponse(w, http.StatusNotFound, "Post not found.")
 		return
 	}
-
-	wal, err := i.node.Multiwallet.WalletForCurrencyCode(coinType)
+	// Add the timestamp
+	ld.Timestamp, err = ptypes.TimestampProto(time.Now())
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, "Unknown wallet type")
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-
-	fee, err := wal.EstimateSpendFee(int64(amount), feeLevel)
+	// Sign the post
+	signedPost, err := i.node.SignPost(ld)
 	if err != nil {
-		switch {
-		case err == wallet.ErrorInsuffientFunds:
-			ErrorResponse(w, http.StatusBadRequest, `ERROR_INSUFFICIENT_FUNDS`)
-			return
-		case err == wallet.ErrorDustAmount:
-			ErrorResponse(w, http.StatusBadRequest, `ERROR_DUST_AMOUNT`)
-			return
-		default:
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
-			return
-		}
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
 	}
-	fmt.Fprintf(w, `{"estimatedFee": %d}`, fee)
-}
-
-func (i *jsonAPIHandler) GETFees(w http.ResponseWriter, r *http.Request) {
-	_, coinType := path.Split(r.URL.Path)
-	type fees struct {
-		Priority uint64 `json:"priority"`
-		Normal   uint64 `json:"normal"`
-		Economic uint64 `json:"economic"`
+	f, err := os.Create(postPath)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
 	}
-	if coinType == "fees" {
-		ret := make(map[string]interface{})
-		for ct, wal := range i.node.Multiwallet {
-			priority := wal.GetFeePerByte(wallet.PRIOIRTY)
-			normal := wal.GetFeePerByte(wallet.NORMAL)
-			economic := wal.GetFeePerByte(wallet.ECONOMIC)
-			ret[ct.CurrencyCode()] = fees{Priority: priority, Normal: normal, Economic: economic}
-		}
-		out, err := json.MarshalIndent(ret, "", "    ")
-		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
-			return
-		}
-		SanitizedResponse(w, string(out))
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(signedPost)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	wal, err := i.node.Multiwallet.WalletForCurrencyCode(coinType)
+
+	if _, err := f.WriteString(out); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	err = i.node.UpdatePostIndex(signedPost)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, "Unknown wallet type")
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	priority := wal.GetFeePerByte(wallet.PRIOIRTY)
-	normal := wal.GetFeePerByte(wallet.NORMAL)
-	economic := wal.GetFeePerByte(wallet.ECONOMIC)
-	f := fees{Priority: priority, Normal: normal, Economic: economic}
-	out, err := json.MarshalIndent(f, "", "    ")
+
+	// Update followers/following
+	err = i.node.UpdateFollow()
 	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, "File Write Error: "+err.Error())
+		return
+	}
+	if err := i.node.SeedNode(); err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	SanitizedResponse(w, string(out))
+	SanitizedResponse(w, `{}`)
 }
 
-func (i *jsonAPIHandler) POSTEstimateTotal(w http.ResponseWriter, r *http.Request) {
-	decoder := json.NewDecoder(r.Body)
-	var data core.PurchaseData
-	err := decoder.Decode(&data)
+// DELETE a post
+func (i *jsonAPIHandler) DELETEPost(w http.ResponseWriter, r *http.Request) {
+	_, slug := path.Split(r.URL.Path)
+	postPath := path.Join(i.node.RepoPath, "root", "posts", slug+".json")
+	_, ferr := os.Stat(postPath)
+	if os.IsNotExist(ferr) {
+		ErrorResponse(w, http.StatusNotFound, "Post not found.")
+		return
+	}
+	err := i.node.DeletePost(slug)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	amount, err := i.node.EstimateOrderTotal(&data)
+	err = i.node.UpdateFollow()
 	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, "File Write Error: "+err.Error())
+		return
+	}
+	if err := i.node.SeedNode(); err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	fmt.Fprintf(w, "%d", int(amount))
+	SanitizedResponse(w, `{}`)
 }
 
-func (i *jsonAPIHandler) GETRatings(w http.ResponseWriter, r *http.Request) {
-	urlPath, slug := path.Split(r.URL.Path)
-	_, peerID := path.Split(urlPath[:len(urlPath)-1])
+// GET a list of posts (self or peer)
+func (i *jsonAPIHandler) GETPosts(w http.ResponseWriter, r *http.Request) {
+	_, peerID := path.Split(r.URL.Path)
 	useCache, _ := strconv.ParseBool(r.URL.Query().Get("usecache"))
-
-	if peerID == "ratings" {
-		peerID = slug
-		slug = ""
+	if peerID == "" || strings.ToLower(peerID) == "posts" || peerID == i.node.IPFSIdentityString() {
+		postsBytes, err := i.node.GetPosts()
+		if err != nil {
+			ErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+		SanitizedResponse(w, string(postsBytes))
+	} else {
+		postsBytes, err := ipfs.ResolveThenCat(i.node.IpfsNode, ipnspath.FromString(path.Join(peerID, "posts.json")), time.Minute, i.node.IPNSQuorumSize, useCache)
+		if err != nil {
+			ErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+		SanitizedResponse(w, string(postsBytes))
+		w.Header().Set("Cache-Control", "public, max-age=600, immutable")
 	}
+}
 
-	buyerRatings := pb.EntityRatingStore{}
+// GET a post (self or peer)
+func (i *jsonAPIHandler) GETPost(w http.ResponseWriter, r *http.Request) {
+	urlPath, postID := path.Split(r.URL.Path)
+	_, peerID := path.Split(urlPath[:len(urlPath)-1])
+	useCache, _ := strconv.ParseBool(r.URL.Query().Get("usecache"))
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	if peerID == "" || strings.ToLower(peerID) == "post" || peerID == i.node.IPFSIdentityString() {
+		var sl *pb.SignedPost
+		_, err := cid.Decode(postID)
+		if err == nil {
+			sl, err = i.node.GetPostFromHash(postID)
+			if err != nil {
+				ErrorResponse(w, http.StatusNotFound, "Post not found.")
+				return
+			}
+			sl.Hash = postID
+		} else {
+			sl, err = i.node.GetPostFromSlug(postID)
+			if err != nil {
+				ErrorResponse(w, http.StatusNotFound, "Post not found.")
+				return
+			}
+			hash, err := ipfs.GetHashOfFile(i.node.IpfsNode, path.Join(i.node.RepoPath, "root", "posts", postID+".json"))
+			if err != nil {
+				ErrorResponse(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+			sl.Hash = hash
+		}
 
-	var indexBytes []byte
-	var buyerRatingBytes []byte
-	if peerID != i.node.IPFSIdentityString() && peerID != "ob" {
-		indexBytes, _ = ipfs.ResolveThenCat(i.node.IpfsNode, ipnspath.FromString(path.Join(peerID, "ratings.json")), time.Minute, i.node.IPNSQuorumSize, useCache)
-		buyerRatingBytes, _ = ipfs.ResolveThenCat(i.node.IpfsNode, ipnspath.FromString(path.Join(peerID, "entityratings", "buyer.json")), time.Minute, i.node.IPNSQuorumSize, useCache)
-	} else {
-		indexBytes, _ = ioutil.ReadFile(path.Join(i.node.RepoPath, "root", "ratings.json"))
-		buyerRatingBytes, _ = ioutil.ReadFile(path.Join(i.node.RepoPath, "root", "entityratings", "buyer.json"))
-	}
-	if buyerRatingBytes != nil {
-		//jsonpb.UnmarshalString(string(buyerRatingBytes), &buyerRatings)
-		json.Unmarshal(buyerRatingBytes, &buyerRatings)
-	}
-	if indexBytes == nil {
-		kimitzuresp := core.KimitzuRatingResp{}
-		kimitzuresp.BuyerRatings = buyerRatings.Ratings
-		kimitzuresp.Count = len(buyerRatings.Ratings)
-		kimitzuresp.Average = kimitzuresp.ComputeAverage()
-		rating := new(core.SavedRating)
-		rating.Kimitzu = kimitzuresp
-		rating.Ratings = []string{}
-		ret, err := json.MarshalIndent(rating, "", "    ")
+		out, err := m.MarshalToString(sl)
 		if err != nil {
 			ErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
-		SanitizedResponse(w, string(ret))
-		return
-	}
-
-	var ratingList []core.SavedRating
-	err := json.Unmarshal(indexBytes, &ratingList)
-	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		SanitizedResponseM(w, out, new(pb.SignedPost))
 		return
 	}
-	kimitzuresp := core.KimitzuRatingResp{}
-	kimitzuresp.BuyerRatings = buyerRatings.Ratings
-	kimitzuresp.Count = len(buyerRatings.Ratings)
-	kimitzuresp.Average = kimitzuresp.ComputeAverage()
 
-	if slug != "" {
-		// Other peer ID Rating
-		rating := new(core.SavedRating)
-		rating.Kimitzu = kimitzuresp
-		for _, r := range ratingList {
-			if r.Slug == slug {
-				rating = &r
-				break
-			}
-		}
-		ret, err := json.MarshalIndent(rating, "", "    ")
+	var postBytes []byte
+	var hash string
+	_, err := cid.Decode(postID)
+	if err == nil {
+		postBytes, err = ipfs.Cat(i.node.IpfsNode, postID, time.Minute)
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			ErrorResponse(w, http.StatusNotFound, err.Error())
 			return
 		}
-		SanitizedResponse(w, string(ret))
+		hash = postID
+		w.Header().Set("Cache-Control", "public, max-age=29030400, immutable")
 	} else {
-		// Other peer ID without slug, basically everything
-		type resp struct {
-			Count   int                    `json:"count"`
-			Average float32                `json:"average"`
-			Ratings []string               `json:"ratings"`
-			Kimitzu core.KimitzuRatingResp `json:"kimitzu"`
-		}
-		ratingRet := new(resp)
-		ratingRet.Kimitzu = kimitzuresp
-		total := float32(0)
-		count := 0
-		for _, r := range ratingList {
-			total += r.Average * float32(r.Count)
-			count += r.Count
-			ratingRet.Ratings = append(ratingRet.Ratings, r.Ratings...)
+		postBytes, err = ipfs.ResolveThenCat(i.node.IpfsNode, ipnspath.FromString(path.Join(peerID, "posts", postID+".json")), time.Minute, i.node.IPNSQuorumSize, useCache)
+		if err != nil {
+			ErrorResponse(w, http.StatusNotFound, err.Error())
+			return
 		}
-		ratingRet.Count = count
-		ratingRet.Average = total / float32(count)
-		ret, err := json.MarshalIndent(ratingRet, "", "    ")
+		hash, err = ipfs.GetHash(i.node.IpfsNode, bytes.NewReader(postBytes))
 		if err != nil {
 			ErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
-		SanitizedResponse(w, string(ret))
-	}
-}
-
-func (i *jsonAPIHandler) GETRating(w http.ResponseWriter, r *http.Request) {
-	_, ratingID := path.Split(r.URL.Path)
-
-	ratingBytes, err := ipfs.Cat(i.node.IpfsNode, ratingID, time.Minute)
-	if err != nil {
-		ErrorResponse(w, http.StatusNotFound, err.Error())
-		return
+		w.Header().Set("Cache-Control", "public, max-age=600, immutable")
 	}
-
-	rating := new(pb.Rating)
-	err = jsonpb.UnmarshalString(string(ratingBytes), rating)
+	sl := new(pb.SignedPost)
+	err = jsonpb.UnmarshalString(string(postBytes), sl)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	valid, err := core.ValidateRating(rating)
-	if !valid || err != nil {
-		ErrorResponse(w, http.StatusExpectationFailed, err.Error())
-		return
-	}
-	ret, err := json.MarshalIndent(rating, "", "    ")
+	sl.Hash = hash
+	out, err := m.MarshalToString(sl)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	SanitizedResponse(w, string(ret))
+	SanitizedResponseM(w, out, new(pb.SignedPost))
 }
 
-func (i *jsonAPIHandler) POSTFetchRatings(w http.ResponseWriter, r *http.Request) {
+type resendOrderMessageRequest struct {
+	OrderID     string `json:"orderID"`
+	MessageType string `json:"messageType"`
+}
+
+// POSTSendOrderMessage - used to manually send an order message
+func (i *jsonAPIHandler) POSTResendOrderMessage(w http.ResponseWriter, r *http.Request) {
+	var args resendOrderMessageRequest
 	decoder := json.NewDecoder(r.Body)
-	var rp []string
-	err := decoder.Decode(&rp)
+	err := decoder.Decode(&args)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	query := r.URL.Query().Get("async")
-	async, _ := strconv.ParseBool(query)
-
-	if !async {
-		var wg sync.WaitGroup
-		var ret []string
-		for _, id := range rp {
-			wg.Add(1)
-			go func(rid string) {
-				ratingBytes, err := ipfs.Cat(i.node.IpfsNode, rid, time.Minute)
-				if err != nil {
-					return
-				}
-				rating := new(pb.Rating)
-				err = jsonpb.UnmarshalString(string(ratingBytes), rating)
-				if err != nil {
-					return
-				}
-				valid, err := core.ValidateRating(rating)
-				if !valid || err != nil {
-					return
-				}
-				m := jsonpb.Marshaler{
-					EnumsAsInts:  false,
-					EmitDefaults: true,
-					Indent:       "    ",
-					OrigName:     false,
-				}
-				respJSON, err := m.MarshalToString(rating)
-				if err != nil {
-					return
-				}
-				ret = append(ret, respJSON)
-				wg.Done()
-			}(id)
-		}
-		wg.Wait()
-		resp := "[\n"
-		max := len(ret)
-		for i, r := range ret {
-			lines := strings.Split(r, "\n")
-			maxx := len(lines)
-			for x, s := range lines {
-				resp += "    "
-				resp += s
-				if x != maxx-1 {
-					resp += "\n"
-				}
-			}
-			if i != max-1 {
-				resp += ",\n"
-			}
-		}
-		resp += "\n]"
-		SanitizedResponse(w, resp)
-	} else {
-		id := r.URL.Query().Get("asyncID")
-		if id == "" {
-			idBytes := make([]byte, 16)
-			rand.Read(idBytes)
-			id = base58.Encode(idBytes)
-		}
+	if args.MessageType == "" {
+		ErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("missing messageType argument"))
+		return
+	}
+	if args.OrderID == "" {
+		ErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("missing orderID argument"))
+		return
+	}
 
-		type resp struct {
-			ID string `json:"id"`
-		}
-		response := resp{id}
-		respJSON, _ := json.MarshalIndent(response, "", "    ")
-		w.WriteHeader(http.StatusAccepted)
-		SanitizedResponse(w, string(respJSON))
-		for _, r := range rp {
-			go func(rid string) {
-				type ratingError struct {
-					ID       string `json:"id"`
-					RatingID string `json:"ratingId"`
-					Error    string `json:"error"`
-				}
-				respondWithError := func(errorMsg string) {
-					e := ratingError{id, rid, errorMsg}
-					ret, err := json.MarshalIndent(e, "", "    ")
-					if err != nil {
-						return
-					}
-					i.node.Broadcast <- repo.PremarshalledNotifier{Payload: ret}
-				}
-				ratingBytes, err := ipfs.Cat(i.node.IpfsNode, rid, time.Minute)
-				if err != nil {
-					respondWithError("not Found")
-					return
-				}
+	msgInt, ok := pb.Message_MessageType_value[strings.ToUpper(args.MessageType)]
+	if !ok {
+		ErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("unknown messageType (%s)", args.MessageType))
+		return
+	}
 
-				rating := new(pb.Rating)
-				err = jsonpb.UnmarshalString(string(ratingBytes), rating)
-				if err != nil {
-					respondWithError("invalid rating")
-					return
-				}
-				valid, err := core.ValidateRating(rating)
-				if !valid || err != nil {
-					respondWithError(err.Error())
-					return
-				}
-				resp := new(pb.RatingWithID)
-				resp.Id = id
-				resp.RatingId = rid
-				resp.Rating = rating
-				m := jsonpb.Marshaler{
-					EnumsAsInts:  false,
-					EmitDefaults: true,
-					Indent:       "    ",
-					OrigName:     false,
-				}
-				out, err := m.MarshalToString(resp)
-				if err != nil {
-					respondWithError("error marshalling rating")
-					return
-				}
-				b, err := SanitizeProtobuf(out, new(pb.RatingWithID))
-				if err != nil {
-					respondWithError("error marshalling rating")
-					return
-				}
-				i.node.Broadcast <- repo.PremarshalledNotifier{Payload: b}
-			}(r)
+	if err := i.node.ResendCachedOrderMessage(args.OrderID, pb.Message_MessageType(msgInt)); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	SanitizedResponse(w, `{}`)
+}
+
+// GETScanOfflineMessages - used to manually trigger offline message scan
+func (i *jsonAPIHandler) GETScanOfflineMessages(w http.ResponseWriter, r *http.Request) {
+	if lastManualScan.IsZero() {
+		lastManualScan = time.Now()
+	} else {
+		if time.Since(lastManualScan) >= OfflineMessageScanInterval {
+			i.node.MessageRetriever.RunOnce()
+			lastManualScan = time.Now()
 		}
 	}
+	SanitizedResponse(w, `{}`)
 }
 
-func (i *jsonAPIHandler) POSTImportListings(w http.ResponseWriter, r *http.Request) {
-	file, _, err := r.FormFile("file")
+// GETSearchNearby - find indexed listings and profiles within a radius (in
+// kilometers) of a point given either as lat/lng or as a full plus code
+func (i *jsonAPIHandler) GETSearchNearby(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	var (
+		latitude, longitude float64
+		err                 error
+	)
+	if query.Get("plusCode") != "" {
+		latitude, longitude, err = core.AddressCoordinates(&pb.Address{PlusCode: query.Get("plusCode")})
+	} else {
+		latitude, longitude, err = core.AddressCoordinates(&pb.Address{Latitude: query.Get("lat"), Longitude: query.Get("lng")})
+	}
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	defer file.Close()
 
-	err = i.node.ImportListings(file)
+	radius := DefaultNearbyRadiusKm
+	if query.Get("radius") != "" {
+		radius, err = strconv.ParseFloat(query.Get("radius"), 64)
+		if err != nil {
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+	limit := 0
+	if query.Get("limit") != "" {
+		limit, err = strconv.Atoi(query.Get("limit"))
+		if err != nil {
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+	var typeFilter []repo.LocationType
+	for _, t := range query["type"] {
+		switch lt := repo.LocationType(strings.ToLower(t)); lt {
+		case repo.LocationTypeListing, repo.LocationTypeProfile:
+			typeFilter = append(typeFilter, lt)
+		default:
+			ErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("unknown location type: %s", t))
+			return
+		}
+	}
+
+	results, err := i.node.SearchNearby(latitude, longitude, radius, typeFilter, limit)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	// Republish to IPNS
-	if err := i.node.SeedNode(); err != nil {
+	b, err := json.MarshalIndent(results, "", "    ")
+	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	SanitizedResponse(w, "{}")
+	SanitizedResponse(w, string(b))
 }
 
-func (i *jsonAPIHandler) GETHealthCheck(w http.ResponseWriter, r *http.Request) {
-	type resp struct {
-		Database bool `json:"database"`
-		IPFSRoot bool `json:"ipfsRoot"`
-		Peers    bool `json:"peers"`
+// GETSearch - full text search over our listings and posts and our chat
+// messages. Results can be filtered by type, tags, categories and service
+// classification and are paginated with offset and limit.
+func (i *jsonAPIHandler) GETSearch(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	sq := repo.SearchQuery{
+		Query:                 query.Get("q"),
+		Tags:                  query["tags"],
+		Categories:            query["categories"],
+		ServiceClassification: query.Get("serviceClassification"),
+		Limit:                 DefaultSearchLimit,
+	}
+	for _, t := range query["type"] {
+		switch dt := repo.SearchDocumentType(strings.ToLower(t)); dt {
+		case repo.SearchDocumentTypeListing, repo.SearchDocumentTypePost, repo.SearchDocumentTypeChat:
+			sq.Types = append(sq.Types, dt)
+		default:
+			ErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("unknown search type: %s", t))
+			return
+		}
+	}
+	var err error
+	if query.Get("offset") != "" {
+		sq.Offset, err = strconv.Atoi(query.Get("offset"))
+		if err != nil || sq.Offset < 0 {
+			ErrorResponse(w, http.StatusBadRequest, "invalid offset")
+			return
+		}
+	}
+	if query.Get("limit") != "" {
+		sq.Limit, err = strconv.Atoi(query.Get("limit"))
+		if err != nil || sq.Limit < 1 || sq.Limit > MaxSearchLimit {
+			ErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("limit must be between 1 and %d", MaxSearchLimit))
+			return
+		}
 	}
 
-	re := resp{true, true, true}
-	pingErr := i.node.Datastore.Ping()
-	if pingErr != nil {
-		re.Database = false
+	results, total, err := i.node.Datastore.Search().Search(sq)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
 	}
-	_, ferr := os.Stat(i.node.RepoPath)
-	if ferr != nil {
-		re.IPFSRoot = false
+	type searchResult struct {
+		Type                  string    `json:"type"`
+		ID                    string    `json:"id"`
+		PeerID                string    `json:"peerId"`
+		Title                 string    `json:"title"`
+		Snippet               string    `json:"snippet"`
+		Tags                  []string  `json:"tags"`
+		Categories            []string  `json:"categories"`
+		ServiceClassification string    `json:"serviceClassification,omitempty"`
+		Timestamp             time.Time `json:"timestamp"`
+		Rank                  float64   `json:"rank"`
 	}
-	peers := ipfs.ConnectedPeers(i.node.IpfsNode)
-	if len(peers) == 0 {
-		re.Peers = false
+	type searchResponse struct {
+		Total   int            `json:"total"`
+		Offset  int            `json:"offset"`
+		Limit   int            `json:"limit"`
+		Results []searchResult `json:"results"`
 	}
-	if pingErr != nil || ferr != nil {
-		ret, _ := json.MarshalIndent(re, "", "    ")
-		ErrorResponse(w, http.StatusNotFound, string(ret))
-		return
+	ret := searchResponse{
+		Total:   total,
+		Offset:  sq.Offset,
+		Limit:   sq.Limit,
+		Results: []searchResult{},
 	}
-	SanitizedResponse(w, "{}")
-}
-
-func (i *jsonAPIHandler) POSTPublish(w http.ResponseWriter, r *http.Request) {
-	// Republish to IPNS
-	if err := i.node.SeedNode(); err != nil {
+	for _, res := range results {
+		ret.Results = append(ret.Results, searchResult{
+			Type:                  res.Type.String(),
+			ID:                    res.ID,
+			PeerID:                res.PeerID,
+			Title:                 res.Title,
+			Snippet:               res.Snippet,
+			Tags:                  res.Tags,
+			Categories:            res.Categories,
+			ServiceClassification: res.ServiceClassification,
+			Timestamp:             res.Timestamp,
+			Rank:                  res.Rank,
+		})
+	}
+	b, err := json.MarshalIndent(ret, "", "    ")
+	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	SanitizedResponse(w, "{}")
+	SanitizedResponse(w, string(b))
 }
 
-func (i *jsonAPIHandler) POSTPurgeCache(w http.ResponseWriter, r *http.Request) {
+type notificationDeliveryResponse struct {
+	DeliveryID     string          `json:"deliveryId"`
+	Channel        string          `json:"channel"`
+	NotificationID string          `json:"notificationId"`
+	Type           string          `json:"type"`
+	Title          string          `json:"title"`
+	Body           string          `json:"body"`
+	State          string          `json:"state"`
+	Attempts       int             `json:"attempts"`
+	LastError      string          `json:"lastError"`
+	NextAttempt    *repo.APITime   `json:"nextAttempt,omitempty"`
+	Created        *repo.APITime   `json:"created"`
+	Updated        *repo.APITime   `json:"updated"`
+	Notification   json.RawMessage `json:"notification"`
+}
 
-	ch, err := i.node.IpfsNode.Blockstore.AllKeysChan(context.Background())
+func newNotificationDeliveryResponse(record *repo.NotificationDeliveryRecord) *notificationDeliveryResponse {
+	ret := &notificationDeliveryResponse{
+		DeliveryID:     record.DeliveryID,
+		Channel:        record.Channel,
+		NotificationID: record.NotificationID,
+		Type:           record.Type.String(),
+		Title:          record.Title,
+		Body:           record.Body,
+		State:          record.State.String(),
+		Attempts:       record.Attempts,
+		LastError:      record.LastError,
+		Created:        repo.NewAPITime(record.Created),
+		Updated:        repo.NewAPITime(record.Updated),
+	}
+	if record.State == repo.NotificationDeliveryPending {
+		ret.NextAttempt = repo.NewAPITime(record.NextAttempt)
+	}
+	if json.Valid(record.Data) {
+		ret.Notification = json.RawMessage(record.Data)
+	}
+	return ret
+}
+
+// GETNotificationDeliveries lists the queued outbound notification
+// deliveries, optionally filtered by state and channel, or returns the
+// delivery whose ID is given in the path
+func (i *jsonAPIHandler) GETNotificationDeliveries(w http.ResponseWriter, r *http.Request) {
+	_, deliveryID := path.Split(r.URL.Path)
+	if deliveryID != "" && deliveryID != "notificationdeliveries" {
+		record, err := i.node.Datastore.NotificationDeliveries().Get(deliveryID)
+		if err != nil {
+			ErrorResponse(w, http.StatusNotFound, "notification delivery not found")
+			return
+		}
+		ret, err := json.MarshalIndent(newNotificationDeliveryResponse(record), "", "    ")
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		SanitizedResponse(w, string(ret))
+		return
+	}
+
+	query := r.URL.Query()
+	var stateFilter []repo.NotificationDeliveryState
+	for _, s := range query["state"] {
+		switch state := repo.NotificationDeliveryState(strings.ToLower(s)); state {
+		case repo.NotificationDeliveryPending, repo.NotificationDeliveryDelivered, repo.NotificationDeliveryDead:
+			stateFilter = append(stateFilter, state)
+		default:
+			ErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("unknown delivery state: %s", s))
+			return
+		}
+	}
+	var (
+		offset int
+		limit  = DefaultNotificationDeliveryLimit
+		err    error
+	)
+	if query.Get("offset") != "" {
+		offset, err = strconv.Atoi(query.Get("offset"))
+		if err != nil || offset < 0 {
+			ErrorResponse(w, http.StatusBadRequest, "invalid offset")
+			return
+		}
+	}
+	if query.Get("limit") != "" {
+		limit, err = strconv.Atoi(query.Get("limit"))
+		if err != nil || limit < 1 || limit > MaxSearchLimit {
+			ErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("limit must be between 1 and %d", MaxSearchLimit))
+			return
+		}
+	}
+	records, err := i.node.Datastore.NotificationDeliveries().GetAll(stateFilter, query.Get("channel"), offset, limit)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	for id := range ch {
-		if err := i.node.IpfsNode.Blockstore.DeleteBlock(id); err != nil {
+	ret := []*notificationDeliveryResponse{}
+	for _, record := range records {
+		ret = append(ret, newNotificationDeliveryResponse(record))
+	}
+	b, err := json.MarshalIndent(ret, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(b))
+}
+
+// POSTNotificationDeliveries replays the delivery whose ID is given in the
+// path, or every dead-lettered delivery when no ID is given
+func (i *jsonAPIHandler) POSTNotificationDeliveries(w http.ResponseWriter, r *http.Request) {
+	_, deliveryID := path.Split(r.URL.Path)
+	var records []*repo.NotificationDeliveryRecord
+	if deliveryID != "" && deliveryID != "notificationdeliveries" {
+		record, err := i.node.Datastore.NotificationDeliveries().Get(deliveryID)
+		if err != nil {
+			ErrorResponse(w, http.StatusNotFound, "notification delivery not found")
+			return
+		}
+		if record.State == repo.NotificationDeliveryDelivered {
+			ErrorResponse(w, http.StatusConflict, "notification was already delivered")
+			return
+		}
+		records = append(records, record)
+	} else {
+		var err error
+		records, err = i.node.Datastore.NotificationDeliveries().GetAll([]repo.NotificationDeliveryState{repo.NotificationDeliveryDead}, r.URL.Query().Get("channel"), 0, 0)
+		if err != nil {
 			ErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
 	}
+	ret := []*notificationDeliveryResponse{}
+	for _, record := range records {
+		// A failed replay is rescheduled, the response shows the outcome
+		if err := replayNotificationDelivery(i.node, record); err != nil {
+			log.Warningf("replaying notification delivery %s: %s", record.DeliveryID, err.Error())
+		}
+		ret = append(ret, newNotificationDeliveryResponse(record))
+	}
+	b, err := json.MarshalIndent(ret, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(b))
+}
 
-	// Republish to IPNS
-	if err := i.node.SeedNode(); err != nil {
+func (i *jsonAPIHandler) DELETENotificationDelivery(w http.ResponseWriter, r *http.Request) {
+	_, deliveryID := path.Split(r.URL.Path)
+	if _, err := i.node.Datastore.NotificationDeliveries().Get(deliveryID); err != nil {
+		ErrorResponse(w, http.StatusNotFound, "notification delivery not found")
+		return
+	}
+	if err := i.node.Datastore.NotificationDeliveries().Delete(deliveryID); err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	SanitizedResponse(w, "{}")
+	SanitizedResponse(w, `{}`)
 }
 
-func (i *jsonAPIHandler) GETWalletStatus(w http.ResponseWriter, r *http.Request) {
+type apiTokenResponse struct {
+	TokenID  string        `json:"tokenId"`
+	Name     string        `json:"name"`
+	Scopes   []string      `json:"scopes"`
+	Expires  *repo.APITime `json:"expires,omitempty"`
+	Created  *repo.APITime `json:"created"`
+	LastUsed *repo.APITime `json:"lastUsed,omitempty"`
+	// Token is only returned when the token is created
+	Token string `json:"token,omitempty"`
+}
 
-	_, coinType := path.Split(r.URL.Path)
-	type status struct {
-		Height   uint32 `json:"height"`
-		BestHash string `json:"bestHash"`
+func newAPITokenResponse(record *repo.APITokenRecord) *apiTokenResponse {
+	ret := &apiTokenResponse{
+		TokenID: record.TokenID,
+		Name:    record.Name,
+		Scopes:  []string{},
+		Created: repo.NewAPITime(record.Created),
 	}
-	if coinType == "status" {
-		ret := make(map[string]interface{})
-		for ct, wal := range i.node.Multiwallet {
-			height, hash := wal.ChainTip()
-			ret[ct.CurrencyCode()] = status{height, hash.String()}
+	for _, s := range record.Scopes {
+		ret.Scopes = append(ret.Scopes, s.String())
+	}
+	if !record.Expires.IsZero() {
+		ret.Expires = repo.NewAPITime(record.Expires)
+	}
+	if !record.LastUsed.IsZero() {
+		ret.LastUsed = repo.NewAPITime(record.LastUsed)
+	}
+	return ret
+}
+
+// GETAPITokens lists the scoped API tokens. The tokens themselves are not
+// stored and cannot be listed.
+func (i *jsonAPIHandler) GETAPITokens(w http.ResponseWriter, r *http.Request) {
+	records, err := i.node.Datastore.APITokens().GetAll()
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	ret := []*apiTokenResponse{}
+	for _, record := range records {
+		ret = append(ret, newAPITokenResponse(record))
+	}
+	b, err := json.MarshalIndent(ret, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(b))
+}
+
+type apiTokenRequest struct {
+	Name    string        `json:"name"`
+	Scopes  []string      `json:"scopes"`
+	Expires *repo.APITime `json:"expires"`
+}
+
+// POSTAPIToken issues a new scoped API token. The response is the only
+// place the token is ever shown.
+func (i *jsonAPIHandler) POSTAPIToken(w http.ResponseWriter, r *http.Request) {
+	var req apiTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if strings.TrimSpace(req.Name) == "" {
+		ErrorResponse(w, http.StatusBadRequest, "name is required")
+		return
+	}
+	if len(req.Scopes) == 0 {
+		ErrorResponse(w, http.StatusBadRequest, "at least one scope is required")
+		return
+	}
+	var scopes []repo.APITokenScope
+	for _, s := range req.Scopes {
+		scope := repo.APITokenScope(strings.ToLower(s))
+		if !scope.Valid() {
+			ErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("unknown scope: %s", s))
+			return
 		}
-		out, err := json.MarshalIndent(ret, "", "    ")
-		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		scopes = append(scopes, scope)
+	}
+	var expires time.Time
+	if req.Expires != nil {
+		expires = req.Expires.Time
+		if !expires.After(time.Now()) {
+			ErrorResponse(w, http.StatusBadRequest, "expiry must be in the future")
 			return
 		}
-		SanitizedResponse(w, string(out))
-		return
 	}
-	wal, err := i.node.Multiwallet.WalletForCurrencyCode(coinType)
+	token, record, err := repo.NewAPIToken(strings.TrimSpace(req.Name), scopes, expires)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, "Unknown wallet type")
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	height, hash := wal.ChainTip()
-	st := status{height, hash.String()}
-	out, err := json.MarshalIndent(st, "", "    ")
+	if err := i.node.Datastore.APITokens().Put(record); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	ret := newAPITokenResponse(record)
+	ret.Token = token
+	b, err := json.MarshalIndent(ret, "", "    ")
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	SanitizedResponse(w, string(out))
+	SanitizedResponse(w, string(b))
 }
 
-func (i *jsonAPIHandler) GETIPNS(w http.ResponseWriter, r *http.Request) {
-	ipfsStore := i.node.IpfsNode.Repo.Datastore()
-	_, peerID := path.Split(r.URL.Path)
+// DELETEAPIToken revokes a scoped API token
+func (i *jsonAPIHandler) DELETEAPIToken(w http.ResponseWriter, r *http.Request) {
+	_, tokenID := path.Split(r.URL.Path)
+	if _, err := i.node.Datastore.APITokens().Get(tokenID); err != nil {
+		ErrorResponse(w, http.StatusNotFound, "API token not found")
+		return
+	}
+	if err := i.node.Datastore.APITokens().Delete(tokenID); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
 
-	pid, err := peer.IDB58Decode(peerID)
+// GETAudit lists the audit log, most recent entries first, optionally
+// filtered by source, actor, action prefix and time range
+func (i *jsonAPIHandler) GETAudit(w http.ResponseWriter, r *http.Request) {
+	q, err := parseAuditQuery(r)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-
-	peerIPNSRecord, err := ipfs.GetCachedIPNSRecord(ipfsStore, pid)
-	if err != nil { // No record in datastore
-		ErrorResponse(w, http.StatusNotFound, err.Error())
+	query := r.URL.Query()
+	q.Limit = DefaultAuditLimit
+	if query.Get("offset") != "" {
+		q.Offset, err = strconv.Atoi(query.Get("offset"))
+		if err != nil || q.Offset < 0 {
+			ErrorResponse(w, http.StatusBadRequest, "invalid offset")
+			return
+		}
+	}
+	if query.Get("limit") != "" {
+		q.Limit, err = strconv.Atoi(query.Get("limit"))
+		if err != nil || q.Limit < 1 || q.Limit > MaxSearchLimit {
+			ErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("limit must be between 1 and %d", MaxSearchLimit))
+			return
+		}
+	}
+	total, err := i.node.Datastore.AuditLog().Count(q)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	records, err := i.node.Datastore.AuditLog().GetAll(q)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	ret := []*auditEntryResponse{}
+	for _, record := range records {
+		ret = append(ret, newAuditEntryResponse(record))
+	}
+	b, err := json.MarshalIndent(ret, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
+	w.Header().Set("X-Total-Count", strconv.Itoa(total))
+	SanitizedResponse(w, string(b))
+}
 
-	var keyBytes []byte
-	pubkey := i.node.IpfsNode.Peerstore.PubKey(pid)
-	if pubkey == nil || !pid.MatchesPublicKey(pubkey) {
-		keyval, err := ipfs.GetCachedPubkey(ipfsStore, peerID)
-		if err != nil {
-			ErrorResponse(w, http.StatusNotFound, err.Error())
-			return
+// GETAuditExport returns every audit log entry matching the filters of
+// /ob/audit as a CSV file, or as JSON with format=json
+func (i *jsonAPIHandler) GETAuditExport(w http.ResponseWriter, r *http.Request) {
+	q, err := parseAuditQuery(r)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	format := strings.ToLower(r.URL.Query().Get("format"))
+	if format != "" && format != "csv" && format != "json" {
+		ErrorResponse(w, http.StatusBadRequest, "format must be csv or json")
+		return
+	}
+	records, err := i.node.Datastore.AuditLog().GetAll(q)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	filename := "audit-" + time.Now().UTC().Format("2006-01-02")
+	if format == "json" {
+		ret := []*auditEntryResponse{}
+		for _, record := range records {
+			ret = append(ret, newAuditEntryResponse(record))
 		}
-		keyBytes = keyval
-	} else {
-		keyBytes, err = pubkey.Bytes()
+		b, err := json.MarshalIndent(ret, "", "    ")
 		if err != nil {
 			ErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
+		w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`.json"`)
+		SanitizedResponse(w, string(b))
+		return
 	}
 
-	type KeyAndRecord struct {
-		Pubkey string `json:"pubkey"`
-		Record string `json:"record"`
+	var buf bytes.Buffer
+	cw := csv.NewWriter(&buf)
+	cw.Write(auditCSVHeader)
+	for _, record := range records {
+		cw.Write(auditCSVRow(record))
 	}
-	peerIPNSBytes, err := ggproto.Marshal(peerIPNSRecord)
-	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("marshaling IPNS record: %s", err.Error()))
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`.csv"`)
+	w.Write(buf.Bytes())
+}
 
-	ret := KeyAndRecord{hex.EncodeToString(keyBytes), string(peerIPNSBytes)}
-	retBytes, err := json.MarshalIndent(ret, "", "    ")
+// GETExport exports our sales, purchases and wallet transactions over a date
+// range as a CSV, OFX or QIF ledger
+func (i *jsonAPIHandler) GETExport(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	since, until, err := core.ParseLedgerRange(query.Get("from"), query.Get("to"))
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	format := core.LedgerFormatCSV
+	if f := query.Get("format"); f != "" {
+		format = core.LedgerFormat(strings.ToLower(f))
+	}
+	switch format {
+	case core.LedgerFormatCSV, core.LedgerFormatOFX, core.LedgerFormatQIF:
+	default:
+		ErrorResponse(w, http.StatusBadRequest, "format must be csv, ofx or qif")
+		return
+	}
+	entries, err := i.node.AccountingLedger(since, until)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	go ipfs.Resolve(i.node.IpfsNode, pid, time.Minute, i.node.IPNSQuorumSize, false)
-	fmt.Fprint(w, string(retBytes))
+	var buf bytes.Buffer
+	if err := core.WriteLedger(&buf, format, entries, since, until); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", format.ContentType())
+	w.Header().Set("Content-Disposition", `attachment; filename="ledger-`+time.Now().UTC().Format("2006-01-02")+"."+string(format)+`"`)
+	w.Write(buf.Bytes())
 }
 
-func (i *jsonAPIHandler) GETResolveIPNS(w http.ResponseWriter, r *http.Request) {
-	_, peerID := path.Split(r.URL.Path)
-	if len(peerID) == 0 || peerID == "resolveipns" {
-		peerID = i.node.IpfsNode.Identity.Pretty()
-	}
+type exchangeRateSnapshotResponse struct {
+	Timestamp *repo.APITime      `json:"timestamp"`
+	Coin      string             `json:"coin"`
+	Reason    string             `json:"reason"`
+	OrderID   string             `json:"orderId,omitempty"`
+	Rate      *float64           `json:"rate,omitempty"`
+	Rates     map[string]float64 `json:"rates,omitempty"`
+}
 
-	type respType struct {
-		PeerID string `json:"peerid"`
-		Record struct {
-			Hex string `json:"hex"`
-		} `json:"record"`
+func newExchangeRateSnapshotResponse(s *repo.ExchangeRateSnapshot, currency string) *exchangeRateSnapshotResponse {
+	ret := &exchangeRateSnapshotResponse{
+		Timestamp: repo.NewAPITime(s.Timestamp),
+		Coin:      s.Coin,
+		Reason:    s.Reason.String(),
+		OrderID:   s.OrderID,
 	}
-	var response = respType{PeerID: peerID}
+	if currency == "" {
+		ret.Rates = s.Rates
+	} else if rate, ok := s.Rates[currency]; ok {
+		ret.Rate = &rate
+	}
+	return ret
+}
 
-	if i.node.IpfsNode.Identity.Pretty() == peerID {
-		rec, err := ipfs.GetCachedIPNSRecord(i.node.IpfsNode.Repo.Datastore(), i.node.IpfsNode.Identity)
+// GETExchangeRateHistory returns the recorded exchange rate snapshots, most
+// recent first. With at, it returns the snapshot of the coin which was current
+// at that time instead.
+func (i *jsonAPIHandler) GETExchangeRateHistory(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	coin := strings.ToUpper(query.Get("coin"))
+	currency := strings.ToUpper(query.Get("currency"))
+
+	if at := query.Get("at"); at != "" {
+		t, err := time.Parse(time.RFC3339, at)
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("retrieving self: %s", err))
+			ErrorResponse(w, http.StatusBadRequest, "invalid at: must be an RFC 3339 time")
 			return
 		}
-		ipnsBytes, err := proto.Marshal(rec)
-		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("marshaling self: %s", err))
+		if coin == "" {
+			ErrorResponse(w, http.StatusBadRequest, "coin is required with at")
 			return
 		}
-		response.Record.Hex = hex.EncodeToString(ipnsBytes)
-		b, err := json.MarshalIndent(response, "", "    ")
+		snapshot, err := i.node.Datastore.ExchangeRates().GetAt(coin, t)
+		if err == sql.ErrNoRows {
+			ErrorResponse(w, http.StatusNotFound, "no exchange rates recorded before "+at)
+			return
+		} else if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		b, err := json.MarshalIndent(newExchangeRateSnapshotResponse(snapshot, currency), "", "    ")
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("marshal json error: %s", err))
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
-
 		SanitizedResponse(w, string(b))
 		return
 	}
 
-	pid, err := peer.IDB58Decode(peerID)
+	since, until, err := core.ParseLedgerRange(query.Get("from"), query.Get("to"))
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*180)
-	_, err = routing.GetPublicKey(i.node.IpfsNode.Routing, ctx, pid)
-	cancel()
-	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+	q := repo.ExchangeRateQuery{
+		Coin:    coin,
+		Reason:  repo.ExchangeRateReason(strings.ToLower(query.Get("reason"))),
+		OrderID: query.Get("orderId"),
+		Since:   since,
+		Until:   until,
+		Limit:   DefaultExchangeRateHistoryLimit,
+	}
+	if q.Reason != "" && !q.Reason.Valid() {
+		ErrorResponse(w, http.StatusBadRequest, "unknown reason: "+q.Reason.String())
 		return
 	}
-
-	ctx, cancel = context.WithTimeout(context.Background(), time.Second*180)
-	ipnsBytes, err := i.node.IpfsNode.Routing.GetValue(ctx, ipns.RecordKey(pid))
-	cancel()
+	if query.Get("offset") != "" {
+		q.Offset, err = strconv.Atoi(query.Get("offset"))
+		if err != nil || q.Offset < 0 {
+			ErrorResponse(w, http.StatusBadRequest, "invalid offset")
+			return
+		}
+	}
+	if query.Get("limit") != "" {
+		q.Limit, err = strconv.Atoi(query.Get("limit"))
+		if err != nil || q.Limit < 1 || q.Limit > MaxSearchLimit {
+			ErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("limit must be between 1 and %d", MaxSearchLimit))
+			return
+		}
+	}
+	snapshots, err := i.node.Datastore.ExchangeRates().GetAll(q)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-
-	response.Record.Hex = hex.EncodeToString(ipnsBytes)
-	b, err := json.MarshalIndent(response, "", "    ")
+	ret := []*exchangeRateSnapshotResponse{}
+	for _, s := range snapshots {
+		ret = append(ret, newExchangeRateSnapshotResponse(s, currency))
+	}
+	b, err := json.MarshalIndent(ret, "", "    ")
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("marshal json error: %s", err))
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-
 	SanitizedResponse(w, string(b))
 }
 
-func (i *jsonAPIHandler) POSTTestEmailNotifications(w http.ResponseWriter, r *http.Request) {
-	decoder := json.NewDecoder(r.Body)
-	var settings repo.SMTPSettings
-	err := decoder.Decode(&settings)
-	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+// invoiceResponse carries the invoice along with the exact bytes the vendor
+// signed, since the response is reformatted on its way out
+type invoiceResponse struct {
+	Invoice       *core.Invoice `json:"invoice"`
+	SignedInvoice []byte        `json:"signedInvoice"`
+	Signature     []byte        `json:"signature"`
+}
+
+// GETInvoice returns the invoice of a sale, issuing it or a new revision of
+// it first when the order changed
+func (i *jsonAPIHandler) GETInvoice(w http.ResponseWriter, r *http.Request) {
+	_, orderID := path.Split(r.URL.Path)
+	format := strings.ToLower(r.URL.Query().Get("format"))
+	if format != "" && format != "json" && format != "pdf" {
+		ErrorResponse(w, http.StatusBadRequest, "format must be json or pdf")
 		return
 	}
-	notifier := smtpNotifier{&settings}
-	err = notifier.notify(repo.TestNotification{})
-	if err != nil {
+	record, err := i.node.UpdateInvoice(orderID)
+	if err == core.ErrInvoiceUnavailable {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	} else if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	SanitizedResponse(w, "{}")
-}
+	invoice := new(core.Invoice)
+	if err := json.Unmarshal(record.Invoice, invoice); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if format == "pdf" {
+		var buf bytes.Buffer
+		if err := core.WriteInvoicePDF(&buf, invoice, record.Signature); err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Header().Set("Content-Disposition", `attachment; filename="`+invoice.Number+`.pdf"`)
+		w.Write(buf.Bytes())
+		return
+	}
 
-func (i *jsonAPIHandler) GETPeerInfo(w http.ResponseWriter, r *http.Request) {
-	_, idb58 := path.Split(r.URL.Path)
-	pid, err := peer.IDB58Decode(idb58)
+	b, err := json.MarshalIndent(invoiceResponse{Invoice: invoice, SignedInvoice: record.Invoice, Signature: record.Signature}, "", "    ")
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*90)
-	defer cancel()
+	SanitizedResponse(w, string(b))
+}
 
-	pi, err := i.node.IpfsNode.Routing.FindPeer(ctx, pid)
+func (i *jsonAPIHandler) POSTQuoteRequest(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var data core.QuoteRequestData
+	err := decoder.Decode(&data)
 	if err != nil {
-		ErrorResponse(w, http.StatusNotFound, err.Error())
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	out, err := pi.MarshalJSON()
+	requestID, err := i.node.RequestQuote(&data)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	SanitizedResponse(w, string(out))
+	SanitizedResponse(w, fmt.Sprintf(`{"requestId": "%s"}`, requestID))
 }
 
-func (i *jsonAPIHandler) POSTBulkUpdateCurrency(w http.ResponseWriter, r *http.Request) {
-	// Retrieve attribute and values to update
-	type BulkUpdateRequest struct {
-		Currencies []string `json:"currencies"`
-	}
-
-	var bulkUpdate BulkUpdateRequest
-	err := json.NewDecoder(r.Body).Decode(&bulkUpdate)
+func (i *jsonAPIHandler) POSTQuote(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var data core.QuoteData
+	err := decoder.Decode(&data)
 	if err != nil {
-		http.Error(w, err.Error(), 400)
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-
-	// Check for no currencies selected
-	if len(bulkUpdate.Currencies) == 0 {
-		SanitizedResponse(w, `{"success": "false", "reason":"No currencies specified"}`)
+	if _, err := i.node.Datastore.Quotes().Get(data.RequestID); err != nil {
+		ErrorResponse(w, http.StatusNotFound, "quote request not found")
 		return
 	}
-
-	log.Info("Updating currencies for all listings to: ", bulkUpdate.Currencies)
-	err = i.node.SetCurrencyOnListings(bulkUpdate.Currencies)
+	quote, err := i.node.SendQuote(&data)
 	if err != nil {
-		http.Error(w, err.Error(), 400)
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-
-	SanitizedResponse(w, `{"success": "true"}`)
+	SanitizedResponse(w, fmt.Sprintf(`{"requestId": "%s", "revision": %d}`, quote.RequestID, quote.Revision))
 }
 
-// POSTS
-
-// Post a post
-func (i *jsonAPIHandler) POSTPost(w http.ResponseWriter, r *http.Request) {
-	ld := new(pb.Post)
-	err := jsonpb.Unmarshal(r.Body, ld)
+func (i *jsonAPIHandler) POSTPurchaseQuote(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var data core.QuoteAcceptanceData
+	err := decoder.Decode(&data)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-
-	// If the post already exists in path, tell them to use PUT
-	postPath := path.Join(i.node.RepoPath, "root", "posts", ld.Slug+".json")
-	if ld.Slug != "" {
-		_, ferr := os.Stat(postPath)
-		if !os.IsNotExist(ferr) {
-			ErrorResponse(w, http.StatusConflict, "Post already exists. Use PUT.")
-			return
-		}
-	} else {
-		// The post isn't in the path and is new, therefore add required data (slug, timestamp)
-		// Generate a slug from the title
-		ld.Slug, err = i.node.GeneratePostSlug(ld.Status)
-		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
-			return
-		}
-	}
-	// Add the timestamp
-	ld.Timestamp, err = ptypes.TimestampProto(time.Now())
-	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+	if _, err := i.node.Datastore.Quotes().Get(data.RequestID); err != nil {
+		ErrorResponse(w, http.StatusNotFound, "quote request not found")
 		return
 	}
-	// Sign the post
-	signedPost, err := i.node.SignPost(ld)
+	orderID, paymentAddr, amount, online, err := i.node.AcceptQuote(&data)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		switch err {
+		case core.ErrQuoteNotAnswered, core.ErrQuoteExpired, core.ErrQuoteOrdered:
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+		default:
+			RenderJSONOrStringError(w, http.StatusInternalServerError, err)
+		}
 		return
 	}
-	// Add to path
-	postPath = path.Join(i.node.RepoPath, "root", "posts", signedPost.Post.Slug+".json")
-	f, err := os.Create(postPath)
+	type purchaseReturn struct {
+		PaymentAddress string `json:"paymentAddress"`
+		Amount         uint64 `json:"amount"`
+		VendorOnline   bool   `json:"vendorOnline"`
+		OrderID        string `json:"orderId"`
+	}
+	ret := purchaseReturn{paymentAddr, amount, online, orderID}
+	b, err := json.MarshalIndent(ret, "", "    ")
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
+	SanitizedResponse(w, string(b))
+}
+
+type quoteResponse struct {
+	RequestID string          `json:"requestId"`
+	IsSale    bool            `json:"isSale"`
+	PeerID    string          `json:"peerId"`
+	State     string          `json:"state"`
+	OrderID   string          `json:"orderId"`
+	History   json.RawMessage `json:"history"`
+	Timestamp *repo.APITime   `json:"timestamp"`
+}
+
+func newQuoteResponse(record *repo.QuoteRecord, now time.Time) (*quoteResponse, error) {
 	m := jsonpb.Marshaler{
 		EnumsAsInts:  false,
-		EmitDefaults: false,
+		EmitDefaults: true,
 		Indent:       "    ",
 		OrigName:     false,
 	}
-	out, err := m.MarshalToString(signedPost)
-	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return
-	}
-
-	if _, err := f.WriteString(out); err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return
-	}
-	err = i.node.UpdatePostIndex(signedPost)
-	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return
-	}
-	// Update followers/following
-	err = i.node.UpdateFollow()
+	out, err := m.MarshalToString(record.History)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return
-	}
-	if err := i.node.SeedNode(); err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return
+		return nil, err
 	}
-	SanitizedResponse(w, fmt.Sprintf(`{"slug": "%s"}`, signedPost.Post.Slug))
+	return &quoteResponse{
+		RequestID: record.RequestID,
+		IsSale:    record.IsSale,
+		PeerID:    record.PeerID,
+		State:     string(record.State(now)),
+		OrderID:   record.OrderID,
+		History:   json.RawMessage(out),
+		Timestamp: repo.NewAPITime(record.Timestamp),
+	}, nil
 }
 
-// PUT a post
-func (i *jsonAPIHandler) PUTPost(w http.ResponseWriter, r *http.Request) {
-	ld := new(pb.Post)
-	err := jsonpb.Unmarshal(r.Body, ld)
-	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+func (i *jsonAPIHandler) GETQuotes(w http.ResponseWriter, r *http.Request) {
+	now := time.Now()
+	_, requestID := path.Split(r.URL.Path)
+	if requestID != "" && requestID != "quotes" {
+		record, err := i.node.Datastore.Quotes().Get(requestID)
+		if err != nil {
+			ErrorResponse(w, http.StatusNotFound, "quote request not found")
+			return
+		}
+		ret, err := newQuoteResponse(record, now)
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		b, err := json.MarshalIndent(ret, "", "    ")
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		SanitizedResponse(w, string(b))
 		return
 	}
-	// Check if the post exists
-	postPath := path.Join(i.node.RepoPath, "root", "posts", ld.Slug+".json")
-	_, ferr := os.Stat(postPath)
-	if os.IsNotExist(ferr) {
-		ErrorResponse(w, http.StatusNotFound, "Post not found.")
-		return
+
+	stateFilter := make(map[repo.QuoteState]bool)
+	for _, s := range r.URL.Query()["state"] {
+		state := repo.QuoteState(strings.ToUpper(s))
+		switch state {
+		case repo.QuoteStateRequested, repo.QuoteStateQuoted, repo.QuoteStateExpired, repo.QuoteStateOrdered:
+			stateFilter[state] = true
+		default:
+			ErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("unknown quote state: %s", s))
+			return
+		}
 	}
-	// Add the timestamp
-	ld.Timestamp, err = ptypes.TimestampProto(time.Now())
+	records, err := i.node.Datastore.Quotes().GetAll()
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	// Sign the post
-	signedPost, err := i.node.SignPost(ld)
+	ret := []*quoteResponse{}
+	for _, record := range records {
+		if len(stateFilter) > 0 && !stateFilter[record.State(now)] {
+			continue
+		}
+		qr, err := newQuoteResponse(record, now)
+		if err != nil {
+			log.Errorf("failed marshaling quote (%s): %s", record.RequestID, err)
+			continue
+		}
+		ret = append(ret, qr)
+	}
+	b, err := json.MarshalIndent(ret, "", "    ")
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	f, err := os.Create(postPath)
+	SanitizedResponse(w, string(b))
+}
+
+func (i *jsonAPIHandler) GETAvailability(w http.ResponseWriter, r *http.Request) {
+	_, peerID := path.Split(r.URL.Path)
+	useCache, _ := strconv.ParseBool(r.URL.Query().Get("usecache"))
+	if peerID == "" || strings.ToLower(peerID) == "availability" {
+		peerID = i.node.IPFSIdentityString()
+	}
+	availability, err := i.node.GetAvailability(peerID, useCache)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		ErrorResponse(w, http.StatusNotFound, err.Error())
 		return
 	}
-	m := jsonpb.Marshaler{
-		EnumsAsInts:  false,
-		EmitDefaults: false,
-		Indent:       "    ",
-		OrigName:     false,
-	}
-	out, err := m.MarshalToString(signedPost)
+	ret, err := json.MarshalIndent(availability, "", "    ")
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
+	SanitizedResponse(w, string(ret))
+}
 
-	if _, err := f.WriteString(out); err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+func (i *jsonAPIHandler) PUTAvailability(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var availability core.Availability
+	err := decoder.Decode(&availability)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	err = i.node.UpdatePostIndex(signedPost)
-	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+	if err := availability.Validate(); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-
-	// Update followers/following
-	err = i.node.UpdateFollow()
-	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, "File Write Error: "+err.Error())
+	if err := i.node.SetAvailability(&availability); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
+
+	// Republish to IPNS
 	if err := i.node.SeedNode(); err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
@@ -4153,184 +5939,301 @@ func (i *jsonAPIHandler) PUTPost(w http.ResponseWriter, r *http.Request) {
 	SanitizedResponse(w, `{}`)
 }
 
-// DELETE a post
-func (i *jsonAPIHandler) DELETEPost(w http.ResponseWriter, r *http.Request) {
-	_, slug := path.Split(r.URL.Path)
-	postPath := path.Join(i.node.RepoPath, "root", "posts", slug+".json")
-	_, ferr := os.Stat(postPath)
-	if os.IsNotExist(ferr) {
-		ErrorResponse(w, http.StatusNotFound, "Post not found.")
+type appointmentResponse struct {
+	OrderID  string    `json:"orderId"`
+	Slug     string    `json:"slug"`
+	Title    string    `json:"title"`
+	PeerID   string    `json:"peerId"`
+	IsSale   bool      `json:"isSale"`
+	Start    time.Time `json:"start"`
+	End      time.Time `json:"end"`
+	Timezone string    `json:"timezone"`
+}
+
+// GETAppointments returns the booked time slots as JSON or as an iCalendar
+// feed which calendar apps can import
+func (i *jsonAPIHandler) GETAppointments(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	format := strings.ToLower(query.Get("format"))
+	if format != "" && format != "json" && format != "ics" {
+		ErrorResponse(w, http.StatusBadRequest, "format must be json or ics")
 		return
 	}
-	err := i.node.DeletePost(slug)
+	from, to, err := core.ParseLedgerRange(query.Get("from"), query.Get("to"))
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	err = i.node.UpdateFollow()
+	records, err := i.node.Datastore.Appointments().GetAll(from, to)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, "File Write Error: "+err.Error())
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	if err := i.node.SeedNode(); err != nil {
+	if format == "ics" {
+		var buf bytes.Buffer
+		if err := core.WriteAppointmentsICS(&buf, records, time.Now()); err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+		w.Header().Set("Content-Disposition", `attachment; filename="appointments.ics"`)
+		w.Write(buf.Bytes())
+		return
+	}
+
+	ret := []appointmentResponse{}
+	for _, a := range records {
+		ret = append(ret, appointmentResponse{
+			OrderID:  a.OrderID,
+			Slug:     a.Slug,
+			Title:    a.Title,
+			PeerID:   a.PeerID,
+			IsSale:   a.IsSale,
+			Start:    a.Start,
+			End:      a.End,
+			Timezone: a.Timezone,
+		})
+	}
+	b, err := json.MarshalIndent(ret, "", "    ")
+	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	SanitizedResponse(w, `{}`)
+	SanitizedResponse(w, string(b))
 }
 
-// GET a list of posts (self or peer)
-func (i *jsonAPIHandler) GETPosts(w http.ResponseWriter, r *http.Request) {
-	_, peerID := path.Split(r.URL.Path)
-	useCache, _ := strconv.ParseBool(r.URL.Query().Get("usecache"))
-	if peerID == "" || strings.ToLower(peerID) == "posts" || peerID == i.node.IPFSIdentityString() {
-		postsBytes, err := i.node.GetPosts()
-		if err != nil {
-			ErrorResponse(w, http.StatusNotFound, err.Error())
-			return
-		}
-		SanitizedResponse(w, string(postsBytes))
-	} else {
-		postsBytes, err := ipfs.ResolveThenCat(i.node.IpfsNode, ipnspath.FromString(path.Join(peerID, "posts.json")), time.Minute, i.node.IPNSQuorumSize, useCache)
-		if err != nil {
-			ErrorResponse(w, http.StatusNotFound, err.Error())
-			return
-		}
-		SanitizedResponse(w, string(postsBytes))
-		w.Header().Set("Cache-Control", "public, max-age=600, immutable")
-	}
+// evidenceResponse describes a file attached to a dispute. Readable is set
+// when a copy of the file was encrypted to us.
+type evidenceResponse struct {
+	OrderID     string    `json:"orderId"`
+	Submitter   string    `json:"submitter"`
+	Filename    string    `json:"filename"`
+	MediaType   string    `json:"mediaType"`
+	Description string    `json:"description"`
+	Size        uint64    `json:"size"`
+	Hash        string    `json:"hash"`
+	Readable    bool      `json:"readable"`
+	Timestamp   time.Time `json:"timestamp"`
 }
 
-// GET a post (self or peer)
-func (i *jsonAPIHandler) GETPost(w http.ResponseWriter, r *http.Request) {
-	urlPath, postID := path.Split(r.URL.Path)
-	_, peerID := path.Split(urlPath[:len(urlPath)-1])
-	useCache, _ := strconv.ParseBool(r.URL.Query().Get("usecache"))
-	m := jsonpb.Marshaler{
-		EnumsAsInts:  false,
-		EmitDefaults: false,
-		Indent:       "    ",
-		OrigName:     false,
+func (i *jsonAPIHandler) newEvidenceResponse(e *pb.DisputeEvidence) evidenceResponse {
+	ts, _ := ptypes.Timestamp(e.Timestamp)
+	ret := evidenceResponse{
+		OrderID:     e.OrderId,
+		Submitter:   e.Submitter.String(),
+		Filename:    e.Filename,
+		MediaType:   e.MediaType,
+		Description: e.Description,
+		Size:        e.Size,
+		Hash:        e.Hash,
+		Timestamp:   ts,
 	}
-	if peerID == "" || strings.ToLower(peerID) == "post" || peerID == i.node.IPFSIdentityString() {
-		var sl *pb.SignedPost
-		_, err := cid.Decode(postID)
-		if err == nil {
-			sl, err = i.node.GetPostFromHash(postID)
-			if err != nil {
-				ErrorResponse(w, http.StatusNotFound, "Post not found.")
-				return
-			}
-			sl.Hash = postID
-		} else {
-			sl, err = i.node.GetPostFromSlug(postID)
-			if err != nil {
-				ErrorResponse(w, http.StatusNotFound, "Post not found.")
-				return
-			}
-			hash, err := ipfs.GetHashOfFile(i.node.IpfsNode, path.Join(i.node.RepoPath, "root", "posts", postID+".json"))
-			if err != nil {
-				ErrorResponse(w, http.StatusInternalServerError, err.Error())
-				return
-			}
-			sl.Hash = hash
+	for _, c := range e.Copies {
+		if c.Recipient == i.node.IpfsNode.Identity.Pretty() {
+			ret.Readable = true
 		}
+	}
+	return ret
+}
 
-		out, err := m.MarshalToString(sl)
-		if err != nil {
+// GETDisputeEvidence lists the files attached to a dispute, or returns one
+// of them decrypted when its hash is given
+func (i *jsonAPIHandler) GETDisputeEvidence(w http.ResponseWriter, r *http.Request) {
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	orderID := segments[2]
+	if len(segments) == 5 {
+		evidence, content, err := i.node.FetchDisputeEvidence(orderID, segments[4])
+		switch {
+		case err == core.ErrCaseNotFound || err == core.ErrEvidenceNotFound:
+			ErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		case err == core.ErrEvidenceNotReadable:
+			ErrorResponse(w, http.StatusForbidden, err.Error())
+			return
+		case err != nil:
 			ErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
-		SanitizedResponseM(w, out, new(pb.SignedPost))
+		w.Header().Set("Content-Type", evidence.MediaType)
+		w.Header().Set("Content-Disposition", `attachment; filename="`+strings.Replace(evidence.Filename, `"`, "", -1)+`"`)
+		w.Write(content)
 		return
 	}
 
-	var postBytes []byte
-	var hash string
-	_, err := cid.Decode(postID)
-	if err == nil {
-		postBytes, err = ipfs.Cat(i.node.IpfsNode, postID, time.Minute)
-		if err != nil {
-			ErrorResponse(w, http.StatusNotFound, err.Error())
-			return
-		}
-		hash = postID
-		w.Header().Set("Cache-Control", "public, max-age=29030400, immutable")
-	} else {
-		postBytes, err = ipfs.ResolveThenCat(i.node.IpfsNode, ipnspath.FromString(path.Join(peerID, "posts", postID+".json")), time.Minute, i.node.IPNSQuorumSize, useCache)
-		if err != nil {
-			ErrorResponse(w, http.StatusNotFound, err.Error())
-			return
-		}
-		hash, err = ipfs.GetHash(i.node.IpfsNode, bytes.NewReader(postBytes))
+	evidence, err := i.node.DisputeEvidence(orderID)
+	if err != nil {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	}
+	ret := []evidenceResponse{}
+	for _, e := range evidence {
+		ret = append(ret, i.newEvidenceResponse(e))
+	}
+	b, err := json.MarshalIndent(ret, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(b))
+}
+
+func (i *jsonAPIHandler) POSTDisputeEvidence(w http.ResponseWriter, r *http.Request) {
+	orderID := path.Base(path.Dir(r.URL.Path))
+	decoder := json.NewDecoder(r.Body)
+	var data core.DisputeEvidenceData
+	err := decoder.Decode(&data)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	contract, state, _, _, _, _, err := i.node.Datastore.Purchases().GetByOrderId(orderID)
+	if err != nil {
+		contract, state, _, _, _, _, err = i.node.Datastore.Sales().GetByOrderId(orderID)
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			ErrorResponse(w, http.StatusNotFound, "Order not found")
 			return
 		}
-		w.Header().Set("Cache-Control", "public, max-age=600, immutable")
 	}
-	sl := new(pb.SignedPost)
-	err = jsonpb.UnmarshalString(string(postBytes), sl)
+	evidence, err := i.node.AttachDisputeEvidence(contract, state, &data)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	sl.Hash = hash
-	out, err := m.MarshalToString(sl)
+	b, err := json.MarshalIndent(i.newEvidenceResponse(evidence), "", "    ")
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	SanitizedResponseM(w, out, new(pb.SignedPost))
+	SanitizedResponse(w, string(b))
 }
 
-// POSTSendOrderMessage - used to manually send an order message
-func (i *jsonAPIHandler) POSTResendOrderMessage(w http.ResponseWriter, r *http.Request) {
-	type sendRequest struct {
-		OrderID     string `json:"orderID"`
-		MessageType string `json:"messageType"`
+type caseMessageRequest struct {
+	Channel  string `json:"channel"`
+	ThreadID string `json:"threadId"`
+	Message  string `json:"message"`
+}
+
+type caseMessageResponse struct {
+	MessageID string    `json:"messageId"`
+	ThreadID  string    `json:"threadId"`
+	Channel   string    `json:"channel"`
+	SenderID  string    `json:"senderId"`
+	Role      string    `json:"role"`
+	Message   string    `json:"message"`
+	Outgoing  bool      `json:"outgoing"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+type caseThreadResponse struct {
+	ThreadID string                `json:"threadId"`
+	Channel  string                `json:"channel"`
+	Messages []caseMessageResponse `json:"messages"`
+}
+
+func (i *jsonAPIHandler) newCaseMessageResponse(m *pb.SignedCaseMessage, participants map[string]core.CaseRole) caseMessageResponse {
+	ts, _ := ptypes.Timestamp(m.Message.Timestamp)
+	return caseMessageResponse{
+		MessageID: m.Message.MessageId,
+		ThreadID:  m.Message.ThreadId,
+		Channel:   m.Message.Channel.String(),
+		SenderID:  m.SenderID,
+		Role:      string(participants[m.SenderID]),
+		Message:   m.Message.Message,
+		Outgoing:  m.SenderID == i.node.IpfsNode.Identity.Pretty(),
+		Timestamp: ts,
 	}
+}
 
-	var args sendRequest
-	decoder := json.NewDecoder(r.Body)
-	err := decoder.Decode(&args)
+// GETCaseMessages returns the threads of a dispute, grouped by channel and
+// thread, in the channels we can read
+func (i *jsonAPIHandler) GETCaseMessages(w http.ResponseWriter, r *http.Request) {
+	orderID := path.Base(path.Dir(r.URL.Path))
+	messages, participants, err := i.node.CaseMessages(orderID)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	}
+	ret := []caseThreadResponse{}
+	for _, t := range core.CaseThreads(messages) {
+		thread := caseThreadResponse{ThreadID: t.ID, Channel: t.Channel.String()}
+		for _, m := range t.Messages {
+			thread.Messages = append(thread.Messages, i.newCaseMessageResponse(m, participants))
+		}
+		ret = append(ret, thread)
+	}
+	b, err := json.MarshalIndent(ret, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
+	SanitizedResponse(w, string(b))
+}
 
-	if args.MessageType == "" {
-		ErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("missing messageType argument"))
+func (i *jsonAPIHandler) POSTCaseMessage(w http.ResponseWriter, r *http.Request) {
+	orderID := path.Base(path.Dir(r.URL.Path))
+	decoder := json.NewDecoder(r.Body)
+	var req caseMessageRequest
+	err := decoder.Decode(&req)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	if args.OrderID == "" {
-		ErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("missing orderID argument"))
+	channel := pb.CaseMessage_CASE
+	if req.Channel != "" {
+		c, ok := pb.CaseMessage_Channel_value[strings.ToUpper(req.Channel)]
+		if !ok {
+			ErrorResponse(w, http.StatusBadRequest, "Unknown channel")
+			return
+		}
+		channel = pb.CaseMessage_Channel(c)
+	}
+	message, err := i.node.PostCaseMessage(orderID, channel, req.ThreadID, req.Message)
+	switch {
+	case err == core.ErrCaseNotFound:
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	case err == core.ErrCaseChannel:
+		ErrorResponse(w, http.StatusForbidden, err.Error())
+		return
+	case err != nil:
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-
-	msgInt, ok := pb.Message_MessageType_value[strings.ToUpper(args.MessageType)]
-	if !ok {
-		ErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("unknown messageType (%s)", args.MessageType))
+	_, participants, err := i.node.CaseMessages(orderID)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-
-	if err := i.node.ResendCachedOrderMessage(args.OrderID, pb.Message_MessageType(msgInt)); err != nil {
+	b, err := json.MarshalIndent(i.newCaseMessageResponse(message, participants), "", "    ")
+	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-
-	SanitizedResponse(w, `{}`)
+	SanitizedResponse(w, string(b))
 }
 
-// GETScanOfflineMessages - used to manually trigger offline message scan
-func (i *jsonAPIHandler) GETScanOfflineMessages(w http.ResponseWriter, r *http.Request) {
-	if lastManualScan.IsZero() {
-		lastManualScan = time.Now()
-	} else {
-		if time.Since(lastManualScan) >= OfflineMessageScanInterval {
-			i.node.MessageRetriever.RunOnce()
-			lastManualScan = time.Now()
-		}
+// GETCaseFile exports the record of a dispute we take part in
+func (i *jsonAPIHandler) GETCaseFile(w http.ResponseWriter, r *http.Request) {
+	orderID := path.Base(path.Dir(r.URL.Path))
+	file, err := i.node.CaseFile(orderID)
+	if err == core.ErrCaseNotFound {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
 	}
-	SanitizedResponse(w, `{}`)
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(file)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.Header().Set("Content-Disposition", `attachment; filename="case-`+orderID+`.json"`)
+	SanitizedResponseM(w, out, new(pb.CaseFile))
 }
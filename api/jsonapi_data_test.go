@@ -219,6 +219,19 @@ const profileUpdatedJSON = `{
     "bitcoinPubkey": "0314e6def3bd71e2806d87ae06ec88ca175701b34ae308f81c16266f69ddc98053"
 }`
 
+const profileMismatchedAddressJSON = `{
+    "handle": "satoshi",
+    "name": "Satoshi Nakamoto",
+    "extLocation": {
+        "addresses": [{"latitude": "14.5995", "longitude": "120.9842", "plusCode": "8FVC9G8F+6X"}]
+    }
+}`
+
+const profileMismatchedAddressJSONResponse = `{
+    "success": false,
+    "reason": "invalid address: plus code does not match the coordinates"
+}`
+
 //
 // Images
 //
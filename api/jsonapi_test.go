@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -71,6 +72,8 @@ func TestProfile(t *testing.T) {
 		{"POST", "/ob/profile", profileJSON, 409, AlreadyExistsUsePUTJSON("Profile")},
 		{"PUT", "/ob/profile", profileUpdateJSON, 200, anyResponseJSON},
 		{"PUT", "/ob/profile", profileUpdatedJSON, 200, anyResponseJSON},
+		{"PUT", "/ob/profile", profileMismatchedAddressJSON, 500, profileMismatchedAddressJSONResponse},
+		{"PATCH", "/ob/profile", profileMismatchedAddressJSON, 500, profileMismatchedAddressJSONResponse},
 	})
 }
 
@@ -137,6 +140,12 @@ func TestModerator(t *testing.T) {
 	})
 }
 
+func TestModeratorStats(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/moderator/stats", "", 200, anyResponseJSON},
+	})
+}
+
 func TestMessageSignVerify(t *testing.T) {
 	const (
 		signMessageJSON = `{
@@ -860,6 +869,110 @@ func TestResendOrderMessage(t *testing.T) {
 	})
 }
 
+func TestExport(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/export?format=xls", "", http.StatusBadRequest, errorResponseJSON(fmt.Errorf("format must be csv, ofx or qif"))},
+		{"GET", "/ob/export?from=2026-02-01&to=2026-01-01", "", http.StatusBadRequest, errorResponseJSON(fmt.Errorf("from must be before to"))},
+	})
+
+	req, err := buildRequest("GET", "/ob/export?from=2026-01-01&to=2026-12-31", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := testHTTPClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "text/csv; charset=utf-8" {
+		t.Fatalf("unexpected response %d %s", resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(b), "date,type,orderId,txid") {
+		t.Errorf("unexpected ledger %s", b)
+	}
+}
+
+func TestInvoice(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/invoice/QmUnknownOrder?format=xls", "", http.StatusBadRequest, errorResponseJSON(fmt.Errorf("format must be json or pdf"))},
+		{"GET", "/ob/invoice/QmUnknownOrder", "", http.StatusNotFound, errorResponseJSON(core.ErrInvoiceUnavailable)},
+	})
+}
+
+func TestExchangeRateHistory(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/exchangerate/history?reason=weekly", "", http.StatusBadRequest, errorResponseJSON(fmt.Errorf("unknown reason: weekly"))},
+		{"GET", "/ob/exchangerate/history?limit=0", "", http.StatusBadRequest, errorResponseJSON(fmt.Errorf("limit must be between 1 and 100"))},
+		{"GET", "/ob/exchangerate/history?at=2026-01-01T00:00:00Z", "", http.StatusBadRequest, errorResponseJSON(fmt.Errorf("coin is required with at"))},
+		{"GET", "/ob/exchangerate/history?coin=BTC&at=yesterday", "", http.StatusBadRequest, errorResponseJSON(fmt.Errorf("invalid at: must be an RFC 3339 time"))},
+		{"GET", "/ob/exchangerate/history?coin=BTC&at=2000-01-01T00:00:00Z", "", http.StatusNotFound, errorResponseJSON(fmt.Errorf("no exchange rates recorded before 2000-01-01T00:00:00Z"))},
+		{"GET", "/ob/exchangerate/history?coin=BTC&orderId=unknown", "", http.StatusOK, `[]`},
+	})
+}
+
+func TestQuotes(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/quotes", "", http.StatusOK, `[]`},
+		{"GET", "/ob/quotes?state=pending", "", http.StatusBadRequest, errorResponseJSON(fmt.Errorf("unknown quote state: pending"))},
+		{"GET", "/ob/quotes/QmUnknownRequest", "", http.StatusNotFound, errorResponseJSON(fmt.Errorf("quote request not found"))},
+		{"POST", "/ob/quote", `{"requestId": "QmUnknownRequest", "price": 100}`, http.StatusNotFound, errorResponseJSON(fmt.Errorf("quote request not found"))},
+		{"POST", "/ob/purchasequote", `{"requestId": "QmUnknownRequest"}`, http.StatusNotFound, errorResponseJSON(fmt.Errorf("quote request not found"))},
+	})
+}
+
+func TestPartialRefund(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"POST", "/ob/partialrefund", `{"orderId": "QmUnknownOrder", "amount": 1000}`, http.StatusNotFound, errorResponseJSON(fmt.Errorf("order not found"))},
+	})
+}
+
+func TestOrderAmendments(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/orderamendments/QmUnknownOrder", "", http.StatusNotFound, errorResponseJSON(fmt.Errorf("Order not found"))},
+		{"POST", "/ob/orderamendment", `{"orderId": "QmUnknownOrder", "price": 100}`, http.StatusNotFound, errorResponseJSON(fmt.Errorf("order not found"))},
+		{"POST", "/ob/orderamendmentresponse", `{"orderId": "QmUnknownOrder", "accept": true}`, http.StatusNotFound, errorResponseJSON(fmt.Errorf("order not found"))},
+	})
+}
+
+func TestAvailability(t *testing.T) {
+	availability := `{"timezone": "Europe/Berlin", "weekly": [{"day": "monday", "start": "09:00", "end": "17:00"}], "blackouts": ["2026-12-28"]}`
+	runAPITests(t, apiTests{
+		{"GET", "/ob/availability", "", http.StatusNotFound, errorResponseJSON(core.ErrNoAvailability)},
+		{"PUT", "/ob/availability", `{"timezone": "Mars/Olympus"}`, http.StatusBadRequest, errorResponseJSON(fmt.Errorf("invalid timezone: Mars/Olympus"))},
+		{"PUT", "/ob/availability", availability, http.StatusOK, `{}`},
+		{"GET", "/ob/availability", "", http.StatusOK, availability},
+	})
+}
+
+func TestAppointments(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/appointments", "", http.StatusOK, `[]`},
+		{"GET", "/ob/appointments?format=xml", "", http.StatusBadRequest, errorResponseJSON(fmt.Errorf("format must be json or ics"))},
+		{"GET", "/ob/appointments?from=2026-12-01&to=2026-11-01", "", http.StatusBadRequest, errorResponseJSON(fmt.Errorf("from must be before to"))},
+	})
+}
+
+func TestDisputeEvidence(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/case/QmUnknownOrder/evidence", "", http.StatusNotFound, errorResponseJSON(core.ErrCaseNotFound)},
+		{"GET", "/ob/case/QmUnknownOrder/evidence/00", "", http.StatusNotFound, errorResponseJSON(core.ErrCaseNotFound)},
+		{"POST", "/ob/case/QmUnknownOrder/evidence", `{"filename": "a.txt", "content": "aGVsbG8="}`, http.StatusNotFound, errorResponseJSON(fmt.Errorf("Order not found"))},
+	})
+}
+
+func TestCaseMessages(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/case/QmUnknownOrder/messages", "", http.StatusNotFound, errorResponseJSON(core.ErrCaseNotFound)},
+		{"POST", "/ob/case/QmUnknownOrder/messages", `{"channel": "BUYER", "message": "hello"}`, http.StatusNotFound, errorResponseJSON(core.ErrCaseNotFound)},
+		{"POST", "/ob/case/QmUnknownOrder/messages", `{"channel": "EVERYONE", "message": "hello"}`, http.StatusBadRequest, errorResponseJSON(fmt.Errorf("Unknown channel"))},
+		{"GET", "/ob/case/QmUnknownOrder/export", "", http.StatusNotFound, errorResponseJSON(core.ErrCaseNotFound)},
+	})
+}
+
 // TODO: Make NewDisputeCaseRecord return a valid fixture for this valid case to work
 //func TestCloseDisputeReturnsOK(t *testing.T) {
 //dbSetup := func(testRepo *test.Repository) error {
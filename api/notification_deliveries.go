@@ -0,0 +1,161 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kimitzu/kimitzu-go/core"
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+const (
+	// notificationRetryBase is the delay before the first retry, it doubles
+	// with every failed attempt up to notificationRetryMaxDelay
+	notificationRetryBase     = time.Minute
+	notificationRetryMaxDelay = time.Hour * 6
+	// maxNotificationDeliveryAttempts failed attempts dead-letter a delivery
+	maxNotificationDeliveryAttempts = 10
+	notificationRetryInterval       = time.Second * 30
+	// delivered records are kept this long so they can be inspected
+	notificationDeliveryRetention = time.Hour * 24 * 7
+)
+
+// queuedNotification replays a notification from its delivery record
+type queuedNotification struct {
+	record *repo.NotificationDeliveryRecord
+}
+
+func (q queuedNotification) GetID() string                  { return q.record.NotificationID }
+func (q queuedNotification) GetType() repo.NotificationType { return q.record.Type }
+func (q queuedNotification) GetSMTPTitleAndBody() (string, string, bool) {
+	return q.record.Title, q.record.Body, q.record.Title != ""
+}
+func (q queuedNotification) Data() ([]byte, error)          { return q.record.Data, nil }
+func (q queuedNotification) WebsocketData() ([]byte, error) { return q.record.Data, nil }
+
+// newNotificationDelivery renders a notification into a pending delivery for
+// the channel. The first retry is scheduled up front so the retry loop does
+// not pick the delivery up while its first attempt is still running.
+func newNotificationDelivery(channel string, n repo.Notifier) (*repo.NotificationDeliveryRecord, error) {
+	data, err := n.Data()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	record := &repo.NotificationDeliveryRecord{
+		DeliveryID:     repo.NewNotificationID(),
+		Channel:        channel,
+		NotificationID: n.GetID(),
+		Type:           n.GetType(),
+		Data:           data,
+		State:          repo.NotificationDeliveryPending,
+		NextAttempt:    now.Add(notificationRetryDelay(1)),
+		Created:        now,
+		Updated:        now,
+	}
+	if head, body, ok := n.GetSMTPTitleAndBody(); ok {
+		record.Title, record.Body = head, body
+	}
+	return record, nil
+}
+
+// notificationRetryDelay returns the backoff after the given number of attempts
+func notificationRetryDelay(attempts int) time.Duration {
+	delay := notificationRetryBase
+	for i := 1; i < attempts && delay < notificationRetryMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > notificationRetryMaxDelay {
+		delay = notificationRetryMaxDelay
+	}
+	return delay
+}
+
+// attemptNotificationDelivery makes one delivery attempt and saves the
+// outcome. A failed attempt is rescheduled with exponential backoff until
+// the delivery runs out of attempts and is dead-lettered.
+func attemptNotificationDelivery(node *core.OpenBazaarNode, n notifier, record *repo.NotificationDeliveryRecord) error {
+	var err error
+	if n == nil {
+		err = fmt.Errorf("%s notifications are turned off", record.Channel)
+	} else {
+		err = n.notify(queuedNotification{record})
+	}
+	now := time.Now()
+	record.Attempts++
+	record.Updated = now
+	if err == nil {
+		record.State = repo.NotificationDeliveryDelivered
+		record.LastError = ""
+	} else {
+		record.LastError = err.Error()
+		if record.Attempts >= maxNotificationDeliveryAttempts {
+			record.State = repo.NotificationDeliveryDead
+		} else {
+			record.NextAttempt = now.Add(notificationRetryDelay(record.Attempts))
+		}
+	}
+	if perr := node.Datastore.NotificationDeliveries().Put(record); perr != nil {
+		return perr
+	}
+	return err
+}
+
+// replayNotificationDelivery resets a delivery so it gets a full set of
+// attempts again and makes the first one right away
+func replayNotificationDelivery(node *core.OpenBazaarNode, record *repo.NotificationDeliveryRecord) error {
+	if record.State == repo.NotificationDeliveryDelivered {
+		return errors.New("notification was already delivered")
+	}
+	settings, err := node.Datastore.Settings().Get()
+	if err != nil {
+		return err
+	}
+	plugin, ok := notifierPlugins[record.Channel]
+	if !ok {
+		return fmt.Errorf("unknown notification channel: %s", record.Channel)
+	}
+	n, _, _ := plugin.enabled(settings, notifierHTTPClient(node))
+	record.State = repo.NotificationDeliveryPending
+	record.Attempts = 0
+	record.NextAttempt = time.Now().Add(notificationRetryDelay(1))
+	if err := node.Datastore.NotificationDeliveries().Put(record); err != nil {
+		return err
+	}
+	return attemptNotificationDelivery(node, n, record)
+}
+
+// retryNotificationDeliveries periodically retries the pending deliveries
+// which are due and purges old delivered ones
+func (m *notificationManager) retryNotificationDeliveries() {
+	t := time.NewTicker(notificationRetryInterval)
+	defer t.Stop()
+	for range t.C {
+		deliveries := m.node.Datastore.NotificationDeliveries()
+		due, err := deliveries.GetDue(time.Now())
+		if err != nil {
+			log.Errorf("loading due notification deliveries: %s", err.Error())
+			continue
+		}
+		if len(due) > 0 {
+			settings, err := m.node.Datastore.Settings().Get()
+			if err != nil {
+				continue
+			}
+			client := notifierHTTPClient(m.node)
+			for _, record := range due {
+				var n notifier
+				if plugin, ok := notifierPlugins[record.Channel]; ok {
+					n, _, _ = plugin.enabled(settings, client)
+				}
+				if err := attemptNotificationDelivery(m.node, n, record); err != nil {
+					log.Warningf("retrying notification delivery %s over %s: %s", record.DeliveryID, record.Channel, err.Error())
+				}
+			}
+		}
+		if err := deliveries.DeleteDeliveredBefore(time.Now().Add(-notificationDeliveryRetention)); err != nil {
+			log.Errorf("purging notification deliveries: %s", err.Error())
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+func TestNotificationRetryDelay(t *testing.T) {
+	for attempts, expected := range map[int]time.Duration{
+		1:  time.Minute,
+		2:  time.Minute * 2,
+		4:  time.Minute * 8,
+		9:  time.Minute * 256,
+		10: notificationRetryMaxDelay,
+		50: notificationRetryMaxDelay,
+	} {
+		if d := notificationRetryDelay(attempts); d != expected {
+			t.Errorf("expected a delay of %s after %d attempts, got %s", expected, attempts, d)
+		}
+	}
+}
+
+func TestNewNotificationDelivery(t *testing.T) {
+	record, err := newNotificationDelivery("webhook", repo.TestNotification{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record.State != repo.NotificationDeliveryPending || record.Channel != "webhook" || record.DeliveryID == "" {
+		t.Errorf("unexpected delivery %+v", record)
+	}
+	if !record.NextAttempt.After(record.Created) {
+		t.Error("expected the first retry to be scheduled after creation")
+	}
+
+	n := queuedNotification{record}
+	head, body, ok := n.GetSMTPTitleAndBody()
+	if !ok || head != "Test Notification Head" || body != "Test Notification Body" {
+		t.Errorf("unexpected replayed content %s %s", head, body)
+	}
+	if n.GetType() != repo.NotifierTypeTestNotification {
+		t.Errorf("unexpected replayed type %s", n.GetType())
+	}
+}
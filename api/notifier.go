@@ -1,10 +1,22 @@
 package api
 
 import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
 	"net/smtp"
+	"net/url"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/kimitzu/kimitzu-go/core"
 	"github.com/kimitzu/kimitzu-go/repo"
@@ -18,16 +30,24 @@ type notificationManager struct {
 	node *core.OpenBazaarNode
 }
 
+const (
+	notifierHTTPTimeout    = time.Second * 30
+	defaultPushServerURL   = "https://ntfy.sh"
+	webhookEventHeader     = "X-Kimitzu-Event"
+	webhookSignatureHeader = "X-Kimitzu-Signature"
+)
+
 func manageNotifications(node *core.OpenBazaarNode, out chan []byte) chan repo.Notifier {
 	manager := &notificationManager{node: node}
 	nodeBroadcast := make(chan repo.Notifier)
+	go manager.retryNotificationDeliveries()
 	go func() {
 		for {
 			n := <-nodeBroadcast
 			// Fixme: right now this assumes that n is a notification but it should be agnostic
 			// enough to let us send any data to the websocket. You can technically do that by
 			// sending over a []byte as the serialize function ignores []bytes but it's kind of hacky.
-			manager.sendNotification(n)
+			go manager.sendNotification(n)
 			data, err := n.WebsocketData()
 			if err != nil {
 				log.Error("marshal notification:", err)
@@ -48,30 +68,199 @@ type notifier interface {
 	notify(n repo.Notifier) error
 }
 
-// Send notification via all supported notifier mechanisms
-func (m *notificationManager) sendNotification(n repo.Notifier) {
-	for _, notifier := range m.getNotifiers() {
-		if err := notifier.notify(n); err != nil {
-			log.Errorf("Notification failed: %s", err.Error())
+// notifierPlugin is an outbound notification channel. enabled returns the
+// channel's notifier and type filter when it is turned on in the settings,
+// decode builds the notifier from channel settings posted to the test
+// endpoint and validate checks the channel settings before they are saved.
+type notifierPlugin struct {
+	enabled  func(s repo.SettingsData, client *http.Client) (notifier, []repo.NotificationType, bool)
+	decode   func(data []byte, client *http.Client) (notifier, error)
+	validate func(s repo.SettingsData) error
+}
+
+// notifierPlugins are the available channels keyed by the name used in
+// /ob/testnotifications/{channel}
+var notifierPlugins = map[string]notifierPlugin{
+	"email": {
+		enabled: func(s repo.SettingsData, _ *http.Client) (notifier, []repo.NotificationType, bool) {
+			conf := s.SMTPSettings
+			if conf == nil || !conf.Notifications {
+				return nil, nil, false
+			}
+			return &smtpNotifier{settings: conf}, conf.NotificationTypes, true
+		},
+		decode: func(data []byte, _ *http.Client) (notifier, error) {
+			conf := new(repo.SMTPSettings)
+			if err := json.Unmarshal(data, conf); err != nil {
+				return nil, err
+			}
+			return &smtpNotifier{settings: conf}, nil
+		},
+		validate: validateSMTPSettings,
+	},
+	"webhook": {
+		enabled: func(s repo.SettingsData, client *http.Client) (notifier, []repo.NotificationType, bool) {
+			conf := s.WebhookSettings
+			if conf == nil || !conf.Notifications {
+				return nil, nil, false
+			}
+			return &webhookNotifier{settings: conf, client: client}, conf.NotificationTypes, true
+		},
+		decode: func(data []byte, client *http.Client) (notifier, error) {
+			conf := new(repo.WebhookSettings)
+			if err := json.Unmarshal(data, conf); err != nil {
+				return nil, err
+			}
+			if err := checkWebhookSettings(conf); err != nil {
+				return nil, err
+			}
+			return &webhookNotifier{settings: conf, client: client}, nil
+		},
+		validate: func(s repo.SettingsData) error {
+			if s.WebhookSettings != nil && s.WebhookSettings.Notifications {
+				return checkWebhookSettings(s.WebhookSettings)
+			}
+			return nil
+		},
+	},
+	"matrix": {
+		enabled: func(s repo.SettingsData, client *http.Client) (notifier, []repo.NotificationType, bool) {
+			conf := s.MatrixSettings
+			if conf == nil || !conf.Notifications {
+				return nil, nil, false
+			}
+			return &matrixNotifier{settings: conf, client: client}, conf.NotificationTypes, true
+		},
+		decode: func(data []byte, client *http.Client) (notifier, error) {
+			conf := new(repo.MatrixSettings)
+			if err := json.Unmarshal(data, conf); err != nil {
+				return nil, err
+			}
+			if err := checkMatrixSettings(conf); err != nil {
+				return nil, err
+			}
+			return &matrixNotifier{settings: conf, client: client}, nil
+		},
+		validate: func(s repo.SettingsData) error {
+			if s.MatrixSettings != nil && s.MatrixSettings.Notifications {
+				return checkMatrixSettings(s.MatrixSettings)
+			}
+			return nil
+		},
+	},
+	"push": {
+		enabled: func(s repo.SettingsData, client *http.Client) (notifier, []repo.NotificationType, bool) {
+			conf := s.PushSettings
+			if conf == nil || !conf.Notifications {
+				return nil, nil, false
+			}
+			return &pushNotifier{settings: conf, client: client}, conf.NotificationTypes, true
+		},
+		decode: func(data []byte, client *http.Client) (notifier, error) {
+			conf := new(repo.PushSettings)
+			if err := json.Unmarshal(data, conf); err != nil {
+				return nil, err
+			}
+			if err := checkPushSettings(conf); err != nil {
+				return nil, err
+			}
+			return &pushNotifier{settings: conf, client: client}, nil
+		},
+		validate: func(s repo.SettingsData) error {
+			if s.PushSettings != nil && s.PushSettings.Notifications {
+				return checkPushSettings(s.PushSettings)
+			}
+			return nil
+		},
+	},
+}
+
+// notifierAccepts reports whether a notification passes a channel's type
+// filter. Without a filter only the notifications which carry a title and
+// body are sent, as they always have been over SMTP.
+func notifierAccepts(n repo.Notifier, types []repo.NotificationType) bool {
+	if len(types) == 0 {
+		_, _, ok := n.GetSMTPTitleAndBody()
+		return ok
+	}
+	for _, t := range types {
+		if t == n.GetType() {
+			return true
 		}
 	}
+	return false
 }
 
-// Create list of notifiers based on settings data (currently only SMTP is available)
-// TODO: should be extended to include new notifiers in the list
-func (m *notificationManager) getNotifiers() []notifier {
+// Send notification via all supported notifier mechanisms. Each delivery is
+// queued before the first attempt so a failed one is retried later.
+func (m *notificationManager) sendNotification(n repo.Notifier) {
 	settings, err := m.node.Datastore.Settings().Get()
-	var notifiers []notifier
 	if err != nil {
-		return notifiers
+		return
 	}
+	client := notifierHTTPClient(m.node)
+	for _, name := range notifierNames() {
+		notifier, types, ok := notifierPlugins[name].enabled(settings, client)
+		if !ok || !notifierAccepts(n, types) {
+			continue
+		}
+		delivery, err := newNotificationDelivery(name, n)
+		if err != nil {
+			log.Errorf("Notification failed: %s", err.Error())
+			continue
+		}
+		if err := m.node.Datastore.NotificationDeliveries().Put(delivery); err != nil {
+			log.Errorf("Queueing notification failed: %s", err.Error())
+			continue
+		}
+		if err := attemptNotificationDelivery(m.node, notifier, delivery); err != nil {
+			log.Errorf("Notification failed: %s", err.Error())
+		}
+	}
+}
 
-	// SMTP notifier
-	conf := settings.SMTPSettings
-	if conf != nil && conf.Notifications {
-		notifiers = append(notifiers, &smtpNotifier{settings: conf})
+func notifierNames() []string {
+	var names []string
+	for name := range notifierPlugins {
+		names = append(names, name)
 	}
-	return notifiers
+	sort.Strings(names)
+	return names
+}
+
+// notifierHTTPClient returns the client used by the HTTP based channels,
+// which goes through Tor when the node does
+func notifierHTTPClient(node *core.OpenBazaarNode) *http.Client {
+	dial := net.Dial
+	if node.TorDialer != nil {
+		dial = node.TorDialer.Dial
+	}
+	return &http.Client{Transport: &http.Transport{Dial: dial}, Timeout: notifierHTTPTimeout}
+}
+
+// notificationText returns the title and body sent by the text based
+// channels, falling back to the type for notifications without SMTP content
+func notificationText(n repo.Notifier) (string, string) {
+	head, body, ok := n.GetSMTPTitleAndBody()
+	if !ok {
+		return n.GetType().String(), ""
+	}
+	return head, body
+}
+
+// postNotification sends a request built by a channel and checks that the
+// server accepted it
+func postNotification(client *http.Client, req *http.Request) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 4096))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%s %s returned status %d", req.Method, req.URL.Host, resp.StatusCode)
+	}
+	return nil
 }
 
 // Notifier implementations
@@ -116,3 +305,162 @@ func validateSMTPSettings(s repo.SettingsData) error {
 	}
 	return nil
 }
+
+func validateNotifierSettings(s repo.SettingsData) error {
+	for _, name := range notifierNames() {
+		if err := notifierPlugins[name].validate(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkHTTPURL makes sure a channel URL can be posted to
+func checkHTTPURL(rawurl string) error {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%s is not an http or https URL", rawurl)
+	}
+	return nil
+}
+
+type webhookNotifier struct {
+	settings *repo.WebhookSettings
+	client   *http.Client
+}
+
+type webhookPayload struct {
+	Type         repo.NotificationType `json:"type"`
+	Title        string                `json:"title"`
+	Body         string                `json:"body"`
+	Timestamp    int64                 `json:"timestamp"`
+	Notification json.RawMessage       `json:"notification"`
+}
+
+func (notifier *webhookNotifier) notify(n repo.Notifier) error {
+	data, err := n.Data()
+	if err != nil {
+		return err
+	}
+	head, body := notificationText(n)
+	payload, err := json.Marshal(webhookPayload{
+		Type:         n.GetType(),
+		Title:        head,
+		Body:         body,
+		Timestamp:    time.Now().Unix(),
+		Notification: data,
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", notifier.settings.URL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhookEventHeader, n.GetType().String())
+	if notifier.settings.Secret != "" {
+		req.Header.Set(webhookSignatureHeader, signWebhookPayload(notifier.settings.Secret, payload))
+	}
+	return postNotification(notifier.client, req)
+}
+
+// signWebhookPayload returns the signature header value receivers compare
+// against the HMAC-SHA256 of the raw body keyed with the shared secret
+func signWebhookPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func checkWebhookSettings(conf *repo.WebhookSettings) error {
+	if conf.URL == "" {
+		return errors.New("webhook URL must be set if notifications are turned on")
+	}
+	return checkHTTPURL(conf.URL)
+}
+
+type matrixNotifier struct {
+	settings *repo.MatrixSettings
+	client   *http.Client
+}
+
+func (notifier *matrixNotifier) notify(n repo.Notifier) error {
+	head, body := notificationText(n)
+	text := head
+	if body != "" {
+		text += "\n\n" + body
+	}
+	content, err := json.Marshal(map[string]string{
+		"msgtype": "m.text",
+		"body":    text,
+	})
+	if err != nil {
+		return err
+	}
+	// The transaction ID makes retries of the same request idempotent so queued
+	// deliveries reuse their delivery ID across attempts
+	txnID := repo.NewNotificationID()
+	if q, ok := n.(queuedNotification); ok {
+		txnID = q.record.DeliveryID
+	}
+	endpoint := fmt.Sprintf("%s/_matrix/client/r0/rooms/%s/send/m.room.message/%s",
+		strings.TrimRight(notifier.settings.HomeserverURL, "/"),
+		url.PathEscape(notifier.settings.RoomID),
+		url.PathEscape(txnID),
+	)
+	req, err := http.NewRequest("PUT", endpoint, bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+notifier.settings.AccessToken)
+	return postNotification(notifier.client, req)
+}
+
+func checkMatrixSettings(conf *repo.MatrixSettings) error {
+	if conf.HomeserverURL == "" || conf.AccessToken == "" || conf.RoomID == "" {
+		return errors.New("matrix fields must be set if notifications are turned on")
+	}
+	return checkHTTPURL(conf.HomeserverURL)
+}
+
+type pushNotifier struct {
+	settings *repo.PushSettings
+	client   *http.Client
+}
+
+func (notifier *pushNotifier) notify(n repo.Notifier) error {
+	head, body := notificationText(n)
+	if body == "" {
+		body = head
+	}
+	server := notifier.settings.ServerURL
+	if server == "" {
+		server = defaultPushServerURL
+	}
+	endpoint := strings.TrimRight(server, "/") + "/" + url.PathEscape(notifier.settings.Topic)
+	req, err := http.NewRequest("POST", endpoint, strings.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Title", head)
+	req.Header.Set("Tags", n.GetType().String())
+	if notifier.settings.AccessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+notifier.settings.AccessToken)
+	}
+	return postNotification(notifier.client, req)
+}
+
+func checkPushSettings(conf *repo.PushSettings) error {
+	if conf.Topic == "" {
+		return errors.New("push topic must be set if notifications are turned on")
+	}
+	if conf.ServerURL != "" {
+		return checkHTTPURL(conf.ServerURL)
+	}
+	return nil
+}
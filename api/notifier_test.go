@@ -0,0 +1,167 @@
+package api
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+func TestNotifierAccepts(t *testing.T) {
+	if !notifierAccepts(repo.TestNotification{}, nil) {
+		t.Error("expected notifications with SMTP content to pass an empty filter")
+	}
+	if notifierAccepts(repo.ChatMessageNotification{}, nil) {
+		t.Error("expected notifications without SMTP content to be dropped by an empty filter")
+	}
+	filter := []repo.NotificationType{repo.NotifierTypeChatMessage}
+	if !notifierAccepts(repo.ChatMessageNotification{}, filter) {
+		t.Error("expected a listed type to pass the filter")
+	}
+	if notifierAccepts(repo.TestNotification{}, filter) {
+		t.Error("expected an unlisted type to be dropped by the filter")
+	}
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	var (
+		body      []byte
+		signature string
+		event     string
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = ioutil.ReadAll(r.Body)
+		signature = r.Header.Get(webhookSignatureHeader)
+		event = r.Header.Get(webhookEventHeader)
+	}))
+	defer ts.Close()
+
+	n := &webhookNotifier{
+		settings: &repo.WebhookSettings{URL: ts.URL, Secret: "secret"},
+		client:   http.DefaultClient,
+	}
+	if err := n.notify(repo.TestNotification{}); err != nil {
+		t.Fatal(err)
+	}
+	if signature != signWebhookPayload("secret", body) {
+		t.Errorf("signature %s does not match the body", signature)
+	}
+	if event != repo.NotifierTypeTestNotification.String() {
+		t.Errorf("unexpected event header %s", event)
+	}
+	var payload webhookPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		t.Fatal(err)
+	}
+	if payload.Type != repo.NotifierTypeTestNotification || payload.Title != "Test Notification Head" || len(payload.Notification) == 0 {
+		t.Errorf("unexpected payload %+v", payload)
+	}
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+	n.settings.URL = failing.URL
+	if err := n.notify(repo.TestNotification{}); err == nil {
+		t.Error("expected an error for a failed delivery")
+	}
+}
+
+func TestMatrixAndPushNotifiers(t *testing.T) {
+	var requests []*http.Request
+	var bodies []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, r)
+		bodies = append(bodies, string(b))
+	}))
+	defer ts.Close()
+
+	matrix := &matrixNotifier{
+		settings: &repo.MatrixSettings{HomeserverURL: ts.URL + "/", AccessToken: "token", RoomID: "!room:example.org"},
+		client:   http.DefaultClient,
+	}
+	if err := matrix.notify(repo.TestNotification{}); err != nil {
+		t.Fatal(err)
+	}
+	push := &pushNotifier{
+		settings: &repo.PushSettings{ServerURL: ts.URL, Topic: "orders"},
+		client:   http.DefaultClient,
+	}
+	if err := push.notify(repo.TestNotification{}); err != nil {
+		t.Fatal(err)
+	}
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(requests))
+	}
+
+	if requests[0].Method != "PUT" || requests[0].Header.Get("Authorization") != "Bearer token" {
+		t.Errorf("unexpected matrix request %s %s", requests[0].Method, requests[0].Header)
+	}
+	var content map[string]string
+	if err := json.Unmarshal([]byte(bodies[0]), &content); err != nil {
+		t.Fatal(err)
+	}
+	if content["msgtype"] != "m.text" || content["body"] != "Test Notification Head\n\nTest Notification Body" {
+		t.Errorf("unexpected matrix message %v", content)
+	}
+
+	if requests[1].URL.Path != "/orders" || requests[1].Header.Get("Title") != "Test Notification Head" || bodies[1] != "Test Notification Body" {
+		t.Errorf("unexpected push request %s %s %s", requests[1].URL.Path, requests[1].Header, bodies[1])
+	}
+}
+
+func TestValidateNotifierSettings(t *testing.T) {
+	valid := repo.SettingsData{
+		WebhookSettings: &repo.WebhookSettings{Notifications: true, URL: "https://example.org/hook"},
+		MatrixSettings:  &repo.MatrixSettings{Notifications: true, HomeserverURL: "https://matrix.org", AccessToken: "token", RoomID: "!room:matrix.org"},
+		PushSettings:    &repo.PushSettings{Notifications: true, Topic: "orders"},
+	}
+	if err := validateNotifierSettings(valid); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	for _, s := range []repo.SettingsData{
+		{WebhookSettings: &repo.WebhookSettings{Notifications: true}},
+		{WebhookSettings: &repo.WebhookSettings{Notifications: true, URL: "ftp://example.org"}},
+		{MatrixSettings: &repo.MatrixSettings{Notifications: true, HomeserverURL: "https://matrix.org"}},
+		{PushSettings: &repo.PushSettings{Notifications: true}},
+		{SMTPSettings: &repo.SMTPSettings{Notifications: true}},
+	} {
+		if err := validateNotifierSettings(s); err == nil {
+			t.Errorf("expected an error for %+v", s)
+		}
+	}
+	if err := validateNotifierSettings(repo.SettingsData{WebhookSettings: &repo.WebhookSettings{}}); err != nil {
+		t.Errorf("expected disabled channels not to be validated: %s", err)
+	}
+}
+
+func TestMatrixNotifierRetriesReuseTransactionID(t *testing.T) {
+	var paths []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer ts.Close()
+
+	matrix := &matrixNotifier{
+		settings: &repo.MatrixSettings{HomeserverURL: ts.URL, AccessToken: "token", RoomID: "!room:example.org"},
+		client:   http.DefaultClient,
+	}
+	record, err := newNotificationDelivery("matrix", repo.TestNotification{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := matrix.notify(queuedNotification{record}); err == nil {
+			t.Fatal("expected an error for a failed delivery")
+		}
+	}
+	expected := "/_matrix/client/r0/rooms/!room:example.org/send/m.room.message/" + record.DeliveryID
+	if len(paths) != 2 || paths[0] != expected || paths[1] != expected {
+		t.Errorf("expected both attempts to use %s, got %v", expected, paths)
+	}
+}
@@ -0,0 +1,367 @@
+package api
+
+import (
+	"encoding"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/kimitzu/kimitzu-go/core"
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+const openAPIVersion = "3.0.3"
+
+type openAPIDocument struct {
+	OpenAPI    string                                  `json:"openapi"`
+	Info       openAPIInfo                             `json:"info"`
+	Tags       []openAPITag                            `json:"tags"`
+	Paths      map[string]map[string]*openAPIOperation `json:"paths"`
+	Components openAPIComponents                       `json:"components"`
+}
+
+type openAPIInfo struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Version     string `json:"version"`
+}
+
+type openAPITag struct {
+	Name string `json:"name"`
+}
+
+type openAPIComponents struct {
+	Schemas map[string]*openAPISchema `json:"schemas"`
+}
+
+type openAPIOperation struct {
+	OperationID string                      `json:"operationId"`
+	Summary     string                      `json:"summary,omitempty"`
+	Tags        []string                    `json:"tags,omitempty"`
+	Parameters  []openAPIParameter          `json:"parameters,omitempty"`
+	RequestBody *openAPIRequestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*openAPIResponse `json:"responses"`
+}
+
+type openAPIParameter struct {
+	Name        string         `json:"name"`
+	In          string         `json:"in"`
+	Description string         `json:"description,omitempty"`
+	Required    bool           `json:"required"`
+	Schema      *openAPISchema `json:"schema"`
+}
+
+type openAPIRequestBody struct {
+	Required bool                        `json:"required"`
+	Content  map[string]openAPIMediaType `json:"content"`
+}
+
+type openAPIResponse struct {
+	Description string                      `json:"description"`
+	Content     map[string]openAPIMediaType `json:"content,omitempty"`
+}
+
+type openAPIMediaType struct {
+	Schema *openAPISchema `json:"schema"`
+}
+
+type openAPISchema struct {
+	Ref                  string                    `json:"$ref,omitempty"`
+	Type                 string                    `json:"type,omitempty"`
+	Format               string                    `json:"format,omitempty"`
+	Enum                 []string                  `json:"enum,omitempty"`
+	Items                *openAPISchema            `json:"items,omitempty"`
+	Properties           map[string]*openAPISchema `json:"properties,omitempty"`
+	AdditionalProperties *openAPISchema            `json:"additionalProperties,omitempty"`
+}
+
+var (
+	openAPIOnce sync.Once
+	openAPIJSON []byte
+	openAPIErr  error
+)
+
+// GETOpenAPI serves the OpenAPI document generated from the route table
+func (i *jsonAPIHandler) GETOpenAPI(w http.ResponseWriter, r *http.Request) {
+	openAPIOnce.Do(func() {
+		openAPIJSON, openAPIErr = json.MarshalIndent(newOpenAPIDocument(i.router.routes), "", "    ")
+	})
+	if openAPIErr != nil {
+		ErrorResponse(w, http.StatusInternalServerError, openAPIErr.Error())
+		return
+	}
+	w.Write(openAPIJSON)
+}
+
+// newOpenAPIDocument describes the routes as an OpenAPI 3 document. Request
+// and response schemas are derived from the Go types of the routes.
+func newOpenAPIDocument(routes []*route) *openAPIDocument {
+	g := &schemaGenerator{components: make(map[string]*openAPISchema)}
+	g.components["Error"] = &openAPISchema{
+		Type: "object",
+		Properties: map[string]*openAPISchema{
+			"success": {Type: "boolean"},
+			"reason":  {Type: "string"},
+		},
+	}
+	doc := &openAPIDocument{
+		OpenAPI: openAPIVersion,
+		Info: openAPIInfo{
+			Title:       "Kimitzu API",
+			Description: "JSON API of the Kimitzu node",
+			Version:     core.KIMITZU_VERSION,
+		},
+		Paths:      make(map[string]map[string]*openAPIOperation),
+		Components: openAPIComponents{Schemas: g.components},
+	}
+
+	operationIDs := make(map[string]int)
+	tags := make(map[string]bool)
+	for _, rt := range routes {
+		op := &openAPIOperation{
+			OperationID: routeOperationID(rt, operationIDs),
+			Summary:     rt.Summary,
+			Responses: map[string]*openAPIResponse{
+				"200": {Description: "OK"},
+				"default": {
+					Description: "Error",
+					Content:     map[string]openAPIMediaType{"application/json": {Schema: &openAPISchema{Ref: "#/components/schemas/Error"}}},
+				},
+			},
+		}
+		if rt.Tag != "" {
+			op.Tags = []string{rt.Tag}
+			tags[rt.Tag] = true
+		}
+		for _, p := range rt.params() {
+			op.Parameters = append(op.Parameters, newOpenAPIParameter(p, "path"))
+		}
+		for _, p := range rt.Query {
+			op.Parameters = append(op.Parameters, newOpenAPIParameter(p, "query"))
+		}
+		if rt.Request != nil {
+			op.RequestBody = &openAPIRequestBody{
+				Required: true,
+				Content:  map[string]openAPIMediaType{"application/json": {Schema: g.schema(reflect.TypeOf(rt.Request), false)}},
+			}
+		}
+		if rt.Response != nil {
+			op.Responses["200"].Content = map[string]openAPIMediaType{"application/json": {Schema: g.schema(reflect.TypeOf(rt.Response), false)}}
+		}
+		if doc.Paths[rt.Pattern] == nil {
+			doc.Paths[rt.Pattern] = make(map[string]*openAPIOperation)
+		}
+		doc.Paths[rt.Pattern][strings.ToLower(rt.Method)] = op
+	}
+	for tag := range tags {
+		doc.Tags = append(doc.Tags, openAPITag{Name: tag})
+	}
+	sort.Slice(doc.Tags, func(a, b int) bool { return doc.Tags[a].Name < doc.Tags[b].Name })
+	return doc
+}
+
+// routeOperationID names the operation after its handler. Handlers serving
+// several patterns get the pattern's parameters appended to stay unique.
+func routeOperationID(rt *route, seen map[string]int) string {
+	name := runtime.FuncForPC(reflect.ValueOf(rt.Handler).Pointer()).Name()
+	name = name[strings.LastIndex(name, ".")+1:]
+	id := name
+	for _, p := range rt.params() {
+		id += "_" + p.Name
+	}
+	seen[id]++
+	if seen[id] > 1 {
+		id += strconv.Itoa(seen[id])
+	}
+	return id
+}
+
+func newOpenAPIParameter(p routeParam, in string) openAPIParameter {
+	s := &openAPISchema{Type: string(p.Type), Format: p.Format}
+	if p.Type == paramStringList {
+		s = &openAPISchema{Type: "array", Items: &openAPISchema{Type: "string"}}
+	}
+	return openAPIParameter{
+		Name:        p.Name,
+		In:          in,
+		Description: p.Description,
+		Required:    p.Required,
+		Schema:      s,
+	}
+}
+
+var (
+	timeType           = reflect.TypeOf(time.Time{})
+	apiTimeType        = reflect.TypeOf(repo.APITime{})
+	timestampType      = reflect.TypeOf(timestamp.Timestamp{})
+	rawMessageType     = reflect.TypeOf(json.RawMessage{})
+	jsonMarshalerType  = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType  = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	protoEnumType      = reflect.TypeOf((*interface{ EnumDescriptor() ([]byte, []int) })(nil)).Elem()
+	protoOneofsType    = reflect.TypeOf((*interface{ XXX_OneofWrappers() []interface{} })(nil)).Elem()
+	maxProtoEnumValues = 64
+)
+
+// schemaGenerator derives JSON schemas from Go types the way encoding/json
+// and jsonpb serialize them. Named structs become components so recursive
+// types terminate.
+type schemaGenerator struct {
+	components map[string]*openAPISchema
+}
+
+// schema returns the schema of the type. Protobuf fields are serialized by
+// jsonpb which writes 64 bit integers as strings.
+func (g *schemaGenerator) schema(t reflect.Type, proto bool) *openAPISchema {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t {
+	case timeType, apiTimeType, timestampType:
+		return &openAPISchema{Type: "string", Format: "date-time"}
+	case rawMessageType:
+		return &openAPISchema{}
+	}
+	if t.Implements(protoEnumType) {
+		return &openAPISchema{Type: "string", Enum: protoEnumNames(t)}
+	}
+	if t.Implements(jsonMarshalerType) || reflect.PtrTo(t).Implements(jsonMarshalerType) {
+		return &openAPISchema{}
+	}
+	if t.Implements(textMarshalerType) || reflect.PtrTo(t).Implements(textMarshalerType) {
+		return &openAPISchema{Type: "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &openAPISchema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &openAPISchema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64:
+		if proto {
+			return &openAPISchema{Type: "string", Format: "int64"}
+		}
+		return &openAPISchema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &openAPISchema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &openAPISchema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &openAPISchema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &openAPISchema{Type: "string", Format: "byte"}
+		}
+		return &openAPISchema{Type: "array", Items: g.schema(t.Elem(), proto)}
+	case reflect.Map:
+		return &openAPISchema{Type: "object", AdditionalProperties: g.schema(t.Elem(), proto)}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return g.structSchema(t)
+		}
+		name := t.Name()
+		if pkg := t.PkgPath(); pkg != "" {
+			name = pkg[strings.LastIndex(pkg, "/")+1:] + "." + name
+		}
+		if _, ok := g.components[name]; !ok {
+			// Reserve the name before descending so recursive types refer back to it
+			g.components[name] = &openAPISchema{}
+			*g.components[name] = *g.structSchema(t)
+		}
+		return &openAPISchema{Ref: "#/components/schemas/" + name}
+	}
+	return &openAPISchema{}
+}
+
+func (g *schemaGenerator) structSchema(t reflect.Type) *openAPISchema {
+	s := &openAPISchema{Type: "object", Properties: make(map[string]*openAPISchema)}
+	for n := 0; n < t.NumField(); n++ {
+		f := t.Field(n)
+		if f.Tag.Get("protobuf_oneof") != "" {
+			g.addOneofProperties(s, t)
+			continue
+		}
+		jsonTag := f.Tag.Get("json")
+		if jsonTag == "-" || strings.HasPrefix(f.Name, "XXX_") {
+			continue
+		}
+		name := strings.Split(jsonTag, ",")[0]
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				for k, v := range g.structSchema(ft).Properties {
+					s.Properties[k] = v
+				}
+				continue
+			}
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+		if tag := f.Tag.Get("protobuf"); tag != "" {
+			s.Properties[protoFieldName(tag)] = g.schema(f.Type, true)
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		s.Properties[name] = g.schema(f.Type, false)
+	}
+	return s
+}
+
+// addOneofProperties adds the fields of every oneof choice, jsonpb writes the
+// chosen one inline with the other fields of the message
+func (g *schemaGenerator) addOneofProperties(s *openAPISchema, t reflect.Type) {
+	if !reflect.PtrTo(t).Implements(protoOneofsType) {
+		return
+	}
+	wrappers := reflect.New(t).Interface().(interface{ XXX_OneofWrappers() []interface{} }).XXX_OneofWrappers()
+	for _, w := range wrappers {
+		wt := reflect.TypeOf(w).Elem()
+		for n := 0; n < wt.NumField(); n++ {
+			f := wt.Field(n)
+			if tag := f.Tag.Get("protobuf"); tag != "" {
+				s.Properties[protoFieldName(tag)] = g.schema(f.Type, true)
+			}
+		}
+	}
+}
+
+// protoFieldName returns the JSON name jsonpb uses for a protobuf field tag
+func protoFieldName(tag string) string {
+	var name string
+	for _, part := range strings.Split(tag, ",") {
+		switch {
+		case strings.HasPrefix(part, "json="):
+			return strings.TrimPrefix(part, "json=")
+		case strings.HasPrefix(part, "name="):
+			name = strings.TrimPrefix(part, "name=")
+		}
+	}
+	return name
+}
+
+// protoEnumNames lists the names of a protobuf enum. Unknown values stringify
+// as their number which is how the end of the enum is found.
+func protoEnumNames(t reflect.Type) []string {
+	var names []string
+	for n := 0; n < maxProtoEnumValues; n++ {
+		v := reflect.New(t).Elem()
+		v.SetInt(int64(n))
+		name := v.Interface().(interface{ String() string }).String()
+		if name != strconv.Itoa(n) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,89 @@
+package api
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kimitzu/kimitzu-go/pb"
+)
+
+func TestNewOpenAPIDocument(t *testing.T) {
+	doc := newOpenAPIDocument(apiRouter.routes)
+
+	operationIDs := make(map[string]bool)
+	for p, ops := range doc.Paths {
+		for method, op := range ops {
+			if operationIDs[op.OperationID] {
+				t.Errorf("duplicate operation ID %s", op.OperationID)
+			}
+			operationIDs[op.OperationID] = true
+			for _, param := range op.Parameters {
+				if param.In == "path" && !strings.Contains(p, "{"+param.Name+"}") {
+					t.Errorf("%s %s documents path parameter %s which is not in the path", method, p, param.Name)
+				}
+			}
+		}
+	}
+	if len(operationIDs) != len(apiRoutes) {
+		t.Errorf("expected %d operations, got %d", len(apiRoutes), len(operationIDs))
+	}
+
+	op := doc.Paths["/ob/listing/{peerId}/{slug}"]["get"]
+	if op == nil || op.OperationID != "GETListing_peerId_slug" || len(op.Parameters) != 3 {
+		t.Fatalf("unexpected listing operation %+v", op)
+	}
+
+	// Every reference has to resolve to a component
+	b, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, part := range strings.Split(string(b), `"$ref":"#/components/schemas/`)[1:] {
+		name := part[:strings.Index(part, `"`)]
+		if _, ok := doc.Components.Schemas[name]; !ok {
+			t.Errorf("unresolved reference to %s", name)
+		}
+	}
+}
+
+func TestSchemaGenerator(t *testing.T) {
+	g := &schemaGenerator{components: make(map[string]*openAPISchema)}
+	g.schema(reflect.TypeOf(notificationDeliveryResponse{}), false)
+	s := g.components["api.notificationDeliveryResponse"]
+	if s == nil {
+		t.Fatal("expected a component for the response type")
+	}
+	if s.Properties["deliveryId"].Type != "string" || s.Properties["attempts"].Type != "integer" {
+		t.Errorf("unexpected properties %+v", s.Properties)
+	}
+	if s.Properties["created"].Format != "date-time" {
+		t.Errorf("expected times to be date-time strings, got %+v", s.Properties["created"])
+	}
+
+	g.schema(reflect.TypeOf(pb.Listing{}), false)
+	if g.components["pb.Listing"].Properties["slug"].Type != "string" {
+		t.Error("expected the listing to be described by its jsonpb field names")
+	}
+	metadata := g.components["pb.Listing_Metadata"]
+	if metadata == nil {
+		t.Fatal("expected nested messages to become components")
+	}
+	if ct := metadata.Properties["contractType"]; ct.Type != "string" || len(ct.Enum) == 0 || ct.Enum[0] != "PHYSICAL_GOOD" {
+		t.Errorf("expected enums to be described by their names, got %+v", ct)
+	}
+	if metadata.Properties["expiry"].Format != "date-time" {
+		t.Error("expected timestamps to be date-time strings")
+	}
+	coupon := g.components["pb.Listing_Coupon"]
+	if coupon.Properties["percentDiscount"] == nil || coupon.Properties["priceDiscount"].Type != "string" {
+		t.Errorf("expected oneof choices inline with 64 bit integers as strings, got %+v", coupon.Properties)
+	}
+}
+
+func TestOpenAPIEndpoint(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/openapi.json", "", 200, anyResponseJSON},
+	})
+}
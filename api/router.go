@@ -0,0 +1,283 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+// paramType is the type of a path or query parameter. Parameters are checked
+// against their type before the request reaches its handler.
+type paramType string
+
+const (
+	paramString     paramType = "string"
+	paramInteger    paramType = "integer"
+	paramNumber     paramType = "number"
+	paramBoolean    paramType = "boolean"
+	paramStringList paramType = "array"
+)
+
+// valid reports whether the raw parameter value parses as the type
+func (t paramType) valid(v string) bool {
+	var err error
+	switch t {
+	case paramInteger:
+		_, err = strconv.ParseInt(v, 10, 64)
+	case paramNumber:
+		_, err = strconv.ParseFloat(v, 64)
+	case paramBoolean:
+		_, err = strconv.ParseBool(v)
+	}
+	return err == nil
+}
+
+// routeParam describes a path or query parameter of a route
+type routeParam struct {
+	Name        string
+	Type        paramType
+	Format      string
+	Description string
+	Required    bool
+}
+
+// pathParams holds the path parameters routes can use in their patterns.
+// Keeping them in one place gives every route the same type and
+// description for the same parameter.
+var pathParams = map[string]routeParam{
+	"peerId":         {Type: paramString, Format: "peer-id", Description: "Base58 encoded peer ID of the node"},
+	"slug":           {Type: paramString, Description: "Slug or IPFS hash of the listing or post"},
+	"coin":           {Type: paramString, Description: "Currency code of the wallet, e.g. BTC"},
+	"currency":       {Type: paramString, Description: "Currency code to quote the exchange rate in, e.g. USD"},
+	"orderId":        {Type: paramString, Description: "ID of the order or dispute case"},
+	"txid":           {Type: paramString, Description: "Hash of the transaction"},
+	"imageHash":      {Type: paramString, Description: "IPFS hash of the image"},
+	"size":           {Type: paramString, Description: "Image size: tiny, small, medium, large or original"},
+	"ratingId":       {Type: paramString, Description: "IPFS hash of the rating"},
+	"messageId":      {Type: paramString, Description: "ID of the chat message"},
+	"notificationId": {Type: paramString, Description: "ID of the notification"},
+	"subscriptionId": {Type: paramString, Description: "ID of the subscription"},
+	"requestId":      {Type: paramString, Description: "ID of the quote request"},
+	"action":         {Type: paramString, Description: "State change to apply: pause, resume or cancel"},
+	"channel":        {Type: paramString, Description: "Notification channel: email, webhook, matrix or push"},
+	"deliveryId":     {Type: paramString, Description: "ID of the notification delivery"},
+	"tokenId":        {Type: paramString, Description: "ID of the API token"},
+	"evidenceHash":   {Type: paramString, Description: "Hex SHA-256 hash of the evidence file"},
+}
+
+// route maps a method and path pattern to a handler. Pattern segments in
+// braces name a parameter from pathParams, every other segment must match
+// literally.
+type route struct {
+	Method  string
+	Pattern string
+	Handler func(*jsonAPIHandler, http.ResponseWriter, *http.Request)
+	Summary string
+	Tag     string
+	Query   []routeParam
+	// Request and Response are zero values of the JSON bodies, used to
+	// describe the route in the OpenAPI document
+	Request  interface{}
+	Response interface{}
+	// Blocking routes wait for the node to finish starting up
+	Blocking bool
+	// Gateway routes are served even when the API is disabled
+	Gateway bool
+	// Scope is the API token scope needed for the route and defaults to
+	// read-only for GET routes. Private routes and routes without a scope
+	// cannot be used with an API token at all.
+	Scope   repo.APITokenScope
+	Private bool
+	// Admin routes always need the node's credentials or cookie, even for
+	// local requests and with authentication turned off
+	Admin bool
+
+	segments []string
+}
+
+// params returns the path parameters of the route in pattern order
+func (rt *route) params() []routeParam {
+	var ret []routeParam
+	for _, s := range rt.segments {
+		if isParamSegment(s) {
+			p := pathParams[s[1:len(s)-1]]
+			p.Name = s[1 : len(s)-1]
+			p.Required = true
+			ret = append(ret, p)
+		}
+	}
+	return ret
+}
+
+// match reports whether the route handles the given path, returning the
+// values of its path parameters
+func (rt *route) match(segments []string) (map[string]string, bool) {
+	if len(segments) != len(rt.segments) {
+		return nil, false
+	}
+	var values map[string]string
+	for n, s := range rt.segments {
+		if !isParamSegment(s) {
+			if s != segments[n] {
+				return nil, false
+			}
+			continue
+		}
+		if values == nil {
+			values = make(map[string]string)
+		}
+		values[s[1:len(s)-1]] = segments[n]
+	}
+	return values, true
+}
+
+func isParamSegment(s string) bool {
+	return strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}")
+}
+
+func splitPath(p string) []string {
+	p = strings.Trim(p, "/")
+	if p == "" {
+		return nil
+	}
+	return strings.Split(p, "/")
+}
+
+// router dispatches requests to the routes of the API
+type router struct {
+	routes []*route
+}
+
+// newRouter checks the route table and prepares it for matching. Two routes
+// which would match the same requests are a programming error and panic.
+func newRouter(routes []*route) *router {
+	seen := make(map[string]string)
+	for _, rt := range routes {
+		rt.segments = splitPath(rt.Pattern)
+		if rt.Private || rt.Admin {
+			rt.Private = true
+			rt.Scope = ""
+		} else if rt.Scope == "" && rt.Method == "GET" {
+			rt.Scope = repo.APITokenScopeReadOnly
+		}
+		var shape []string
+		for _, s := range rt.segments {
+			if isParamSegment(s) {
+				if _, ok := pathParams[s[1:len(s)-1]]; !ok {
+					panic(fmt.Sprintf("route %s %s: unknown path parameter %s", rt.Method, rt.Pattern, s))
+				}
+				s = "{}"
+			}
+			shape = append(shape, s)
+		}
+		key := rt.Method + " /" + strings.Join(shape, "/")
+		if other, ok := seen[key]; ok {
+			panic(fmt.Sprintf("route %s %s is ambiguous with %s", rt.Method, rt.Pattern, other))
+		}
+		seen[key] = rt.Pattern
+	}
+	// Literal segments take precedence over parameters so /ob/listing/index
+	// style routes do not get swallowed by /ob/listing/{slug}
+	sorted := make([]*route, len(routes))
+	copy(sorted, routes)
+	sort.SliceStable(sorted, func(a, b int) bool {
+		return routeSpecificity(sorted[a]) > routeSpecificity(sorted[b])
+	})
+	return &router{routes: sorted}
+}
+
+func routeSpecificity(rt *route) int {
+	n := 0
+	for _, s := range rt.segments {
+		if !isParamSegment(s) {
+			n++
+		}
+	}
+	return n
+}
+
+// find returns the route for the method and path. If the path is known but
+// not for the method the allowed methods are returned instead.
+func (rr *router) find(method, p string) (*route, map[string]string, []string) {
+	if method == "HEAD" {
+		method = "GET"
+	}
+	segments := splitPath(p)
+	var allowed []string
+	for _, rt := range rr.routes {
+		values, ok := rt.match(segments)
+		if !ok {
+			continue
+		}
+		if rt.Method == method {
+			return rt, values, nil
+		}
+		allowed = append(allowed, rt.Method)
+	}
+	return nil, nil, allowed
+}
+
+// serve dispatches the request, checking its typed parameters first
+func (rr *router) serve(i *jsonAPIHandler, w http.ResponseWriter, r *http.Request, p string) {
+	rt, values, allowed := rr.find(r.Method, p)
+	if rt == nil {
+		if len(allowed) > 0 {
+			w.Header().Set("Allow", strings.Join(allowed, ", "))
+			ErrorResponse(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+			return
+		}
+		ErrorResponse(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	for _, param := range rt.params() {
+		if !param.Type.valid(values[param.Name]) {
+			ErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid %s: must be of type %s", param.Name, param.Type))
+			return
+		}
+	}
+	query := r.URL.Query()
+	for _, param := range rt.Query {
+		if param.Required && query.Get(param.Name) == "" {
+			ErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("missing query parameter %s", param.Name))
+			return
+		}
+		for _, v := range query[param.Name] {
+			if v != "" && !param.Type.valid(v) {
+				ErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid %s: must be of type %s", param.Name, param.Type))
+				return
+			}
+		}
+	}
+	if rt.Blocking {
+		blockingStartupMiddleware(i, w, r, func(w http.ResponseWriter, r *http.Request) { rt.Handler(i, w, r) })
+		return
+	}
+	rt.Handler(i, w, r)
+}
+
+// gatewayAllowed reports whether the request may be served by the public
+// gateway while the rest of the API is disabled
+func (rr *router) gatewayAllowed(method, p string) bool {
+	rt, _, _ := rr.find(method, p)
+	return rt != nil && rt.Gateway
+}
+
+// tokenAllowed reports whether an API token may make the request. Unknown
+// paths are let through so they get the usual not found response.
+func (rr *router) tokenAllowed(token *repo.APITokenRecord, method, p string) bool {
+	rt, _, _ := rr.find(method, p)
+	if rt == nil {
+		return true
+	}
+	return rt.Scope != "" && token.HasScope(rt.Scope)
+}
+
+// adminOnly reports whether the request is for an admin route
+func (rr *router) adminOnly(method, p string) bool {
+	rt, _, _ := rr.find(method, p)
+	return rt != nil && rt.Admin
+}
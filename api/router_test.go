@@ -0,0 +1,174 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+func TestRouterFind(t *testing.T) {
+	for _, c := range []struct {
+		method, path, pattern string
+	}{
+		{"GET", "/ob/purchases", "/ob/purchases"},
+		{"POST", "/ob/purchase", "/ob/purchase"},
+		{"POST", "/ob/purchases", "/ob/purchases"},
+		{"GET", "/ob/posts", "/ob/posts"},
+		{"GET", "/ob/post/test1", "/ob/post/{slug}"},
+		{"GET", "/ob/post/QmPeer/test1", "/ob/post/{peerId}/{slug}"},
+		{"GET", "/ob/profile/", "/ob/profile"},
+		{"HEAD", "/ob/profile/QmPeer", "/ob/profile/{peerId}"},
+		{"GET", "/ob/search/nearby", "/ob/search/nearby"},
+		{"POST", "/ob/subscriptions/pause", "/ob/subscriptions/{action}"},
+		{"GET", "/ob/exchangerates/BTC/USD", "/ob/exchangerates/{coin}/{currency}"},
+		{"GET", "/ob/chatmessages", "/ob/chatmessages"},
+		{"GET", "/ob/chatmessages/QmPeer", "/ob/chatmessages/{peerId}"},
+	} {
+		rt, _, _ := apiRouter.find(c.method, c.path)
+		if rt == nil {
+			t.Errorf("%s %s: no route found", c.method, c.path)
+			continue
+		}
+		if rt.Pattern != c.pattern {
+			t.Errorf("%s %s: expected %s, got %s", c.method, c.path, c.pattern, rt.Pattern)
+		}
+	}
+
+	rt, values, _ := apiRouter.find("GET", "/ob/listing/QmPeer/my-listing")
+	if rt == nil || values["peerId"] != "QmPeer" || values["slug"] != "my-listing" {
+		t.Errorf("unexpected path parameters %v", values)
+	}
+
+	if rt, _, allowed := apiRouter.find("PATCH", "/ob/listing"); rt != nil || len(allowed) != 2 {
+		t.Errorf("expected POST and PUT to be allowed for /ob/listing, got %v", allowed)
+	}
+	if rt, _, allowed := apiRouter.find("GET", "/ob/listings/QmPeer/extra"); rt != nil || len(allowed) != 0 {
+		t.Error("expected no route for unknown path")
+	}
+}
+
+func TestRouterServe(t *testing.T) {
+	var called string
+	handler := func(name string) func(*jsonAPIHandler, http.ResponseWriter, *http.Request) {
+		return func(*jsonAPIHandler, http.ResponseWriter, *http.Request) { called = name }
+	}
+	rr := newRouter([]*route{
+		{Method: "GET", Pattern: "/ob/things", Handler: handler("list"), Query: []routeParam{{Name: "limit", Type: paramInteger}, {Name: "q", Type: paramString, Required: true}}},
+		{Method: "GET", Pattern: "/ob/things/{slug}", Handler: handler("get")},
+		{Method: "GET", Pattern: "/ob/things/mine", Handler: handler("mine")},
+	})
+
+	for _, c := range []struct {
+		method, url string
+		code        int
+		called      string
+	}{
+		{"GET", "/ob/things?q=a&limit=5", http.StatusOK, "list"},
+		{"GET", "/ob/things?q=a&limit=five", http.StatusBadRequest, ""},
+		{"GET", "/ob/things", http.StatusBadRequest, ""},
+		{"GET", "/ob/things/mine", http.StatusOK, "mine"},
+		{"GET", "/ob/things/other", http.StatusOK, "get"},
+		{"DELETE", "/ob/things/other", http.StatusMethodNotAllowed, ""},
+		{"GET", "/ob/other", http.StatusNotFound, ""},
+	} {
+		called = ""
+		r := httptest.NewRequest(c.method, c.url, nil)
+		w := httptest.NewRecorder()
+		rr.serve(nil, w, r, r.URL.Path)
+		if w.Code != c.code || called != c.called {
+			t.Errorf("%s %s: expected %d calling %q, got %d calling %q", c.method, c.url, c.code, c.called, w.Code, called)
+		}
+	}
+}
+
+func TestNewRouterRejectsBadRoutes(t *testing.T) {
+	noop := func(*jsonAPIHandler, http.ResponseWriter, *http.Request) {}
+	for _, routes := range [][]*route{
+		{{Method: "GET", Pattern: "/ob/things/{unknown}", Handler: noop}},
+		{{Method: "GET", Pattern: "/ob/things/{slug}", Handler: noop}, {Method: "GET", Pattern: "/ob/things/{peerId}", Handler: noop}},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected a panic for %s", routes[len(routes)-1].Pattern)
+				}
+			}()
+			newRouter(routes)
+		}()
+	}
+}
+
+func TestGatewayAllowed(t *testing.T) {
+	for path, allowed := range map[string]bool{
+		"/ob/profile/QmPeer":        true,
+		"/ob/listing/QmPeer/slug":   true,
+		"/ob/ratings/QmPeer":        true,
+		"/ob/settings":              false,
+		"/wallet/mnemonic":          false,
+		"/ob/profile/QmPeer/extra":  false,
+		"/ob/listings/QmPeer/extra": false,
+	} {
+		if apiRouter.gatewayAllowed("GET", path) != allowed {
+			t.Errorf("expected gateway access to GET %s to be %t", path, allowed)
+		}
+	}
+	if !apiRouter.gatewayAllowed("POST", "/ob/fetchprofiles") || apiRouter.gatewayAllowed("POST", "/ob/profile") {
+		t.Error("unexpected gateway access for POST routes")
+	}
+	for _, rt := range apiRoutes {
+		if rt.Gateway && rt.Method != "GET" && !strings.HasPrefix(rt.Pattern, "/ob/fetch") {
+			t.Errorf("%s %s should not be served by the gateway", rt.Method, rt.Pattern)
+		}
+	}
+}
+
+func TestTokenAllowed(t *testing.T) {
+	token := &repo.APITokenRecord{Scopes: []repo.APITokenScope{repo.APITokenScopeReadOnly, repo.APITokenScopeListingsWrite}}
+	for _, c := range []struct {
+		method, path string
+		allowed      bool
+	}{
+		{"GET", "/ob/profile", true},
+		{"POST", "/ob/listing", true},
+		{"DELETE", "/ob/listing/my-listing", true},
+		{"POST", "/ob/sales", true},
+		{"POST", "/ob/purchase", false},
+		{"POST", "/wallet/spend", false},
+		{"POST", "/ob/closedispute", false},
+		{"PUT", "/ob/profile", false},
+		{"GET", "/wallet/mnemonic", false},
+		{"GET", "/ob/settings", false},
+		{"GET", "/ob/apitokens", false},
+		{"POST", "/ob/apitokens", false},
+	} {
+		if allowed := apiRouter.tokenAllowed(token, c.method, c.path); allowed != c.allowed {
+			t.Errorf("%s %s: expected allowed %t, got %t", c.method, c.path, c.allowed, allowed)
+		}
+	}
+
+	spender := &repo.APITokenRecord{Scopes: []repo.APITokenScope{repo.APITokenScopeWalletSpend}}
+	if !apiRouter.tokenAllowed(spender, "POST", "/wallet/spend") {
+		t.Error("wallet-spend token should be allowed to spend")
+	}
+	if apiRouter.tokenAllowed(spender, "GET", "/wallet/balance") {
+		t.Error("wallet-spend token should not be allowed to read")
+	}
+}
+
+func TestBearerAPIToken(t *testing.T) {
+	r := httptest.NewRequest("GET", "/ob/profile", nil)
+	if _, ok := bearerAPIToken(r); ok {
+		t.Error("request without Authorization header has no token")
+	}
+	r.SetBasicAuth("user", "pass")
+	if _, ok := bearerAPIToken(r); ok {
+		t.Error("basic auth credentials are not a token")
+	}
+	r.Header.Set("Authorization", "Bearer kmz_abc")
+	if token, ok := bearerAPIToken(r); !ok || token != "kmz_abc" {
+		t.Errorf("expected token kmz_abc, got %q", token)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/kimitzu/kimitzu-go/core"
 	"github.com/kimitzu/kimitzu-go/pb"
 )
 
@@ -87,3 +88,21 @@ func extractModeratorChanges(newModList []string, currentModList *[]string) (toA
 
 	return toAdd, toDelete
 }
+
+// fillAddressCoordinates derives the plus code or the coordinates of each
+// address which only carries one of them so the published data is consistent
+func fillAddressCoordinates(addresses ...*pb.Address) error {
+	for _, address := range addresses {
+		if err := core.FillAddressCoordinates(address); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func profileAddresses(profile *pb.Profile) []*pb.Address {
+	if profile.ExtLocation == nil {
+		return nil
+	}
+	return profile.ExtLocation.Addresses
+}
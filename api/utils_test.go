@@ -2,6 +2,8 @@ package api
 
 import (
 	"testing"
+
+	"github.com/kimitzu/kimitzu-go/pb"
 )
 
 func Test_extractModeratorChanges(t *testing.T) {
@@ -23,3 +25,13 @@ func Test_extractModeratorChanges(t *testing.T) {
 		t.Errorf("Returned incorrect deletion: expected b got %s", toDelete[0])
 	}
 }
+
+func Test_fillAddressCoordinates(t *testing.T) {
+	address := &pb.Address{Latitude: "47.3655625", Longitude: "8.5249375"}
+	if err := fillAddressCoordinates(nil, address); err != nil {
+		t.Fatal(err)
+	}
+	if address.PlusCode != "8FVC9G8F+6X" {
+		t.Errorf("Returned incorrect plus code: expected 8FVC9G8F+6X got %s", address.PlusCode)
+	}
+}
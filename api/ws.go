@@ -62,15 +62,12 @@ type wsHandler struct {
 	h             *hub
 	path          string
 	enabled       bool
-	authenticated bool
 	allowedIPs    map[string]bool
-	cookie        http.Cookie
-	username      string
-	password      string
+	credentials   *apiCredentials
 	logger        *logging.Logger
 }
 
-func newWSAPIHandler(node *core.OpenBazaarNode, authCookie http.Cookie, config schema.APIConfig) *wsHandler {
+func newWSAPIHandler(node *core.OpenBazaarNode, credentials *apiCredentials, config schema.APIConfig) *wsHandler {
 	hub := newHub()
 	go hub.run()
 	allowedIps := make(map[string]bool)
@@ -81,11 +78,8 @@ func newWSAPIHandler(node *core.OpenBazaarNode, authCookie http.Cookie, config s
 		h:             hub,
 		path:          node.RepoPath,
 		enabled:       config.Enabled,
-		authenticated: config.Authenticated,
 		allowedIPs:    allowedIps,
-		cookie:        authCookie,
-		username:      config.Username,
-		password:      config.Password,
+		credentials:   credentials,
 		logger:        logging.MustGetLogger("api"),
 	}
 	return &handler
@@ -107,12 +101,12 @@ func (wsh wsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	if wsh.authenticated {
+	if auth := wsh.credentials.get(); auth.Authenticated {
 		username, password, ok := r.BasicAuth()
 		if username != "" && password != "" {
 			h := sha256.Sum256([]byte(password))
 			password = hex.EncodeToString(h[:])
-			if !ok || username != wsh.username || !strings.EqualFold(password, wsh.password) {
+			if !ok || username != auth.Username || !strings.EqualFold(password, auth.Password) {
 				wsh.logger.Error("refused websocket connection: invalid username and/or password")
 				w.WriteHeader(http.StatusForbidden)
 				fmt.Fprint(w, "403 - Forbidden - Invalid Credentials")
@@ -126,7 +120,7 @@ func (wsh wsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				fmt.Fprint(w, "403 - Forbidden - No cookie present")
 				return
 			}
-			if wsh.cookie.Value != cookie.Value || cookie.Value == "" {
+			if auth.Cookie.Value != cookie.Value || cookie.Value == "" {
 				wsh.logger.Error("refused websocket connection: invalid cookie")
 				w.WriteHeader(http.StatusForbidden)
 				fmt.Fprint(w, "403 - Forbidden - Invalid Cookie")
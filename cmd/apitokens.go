@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/kimitzu/kimitzu-go/repo"
+	"github.com/kimitzu/kimitzu-go/repo/db"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+type APITokens struct {
+	DataDir string        `short:"d" long:"datadir" description:"specify the data directory to be used"`
+	Testnet bool          `short:"t" long:"testnet" description:"use the testnet database"`
+	Create  string        `short:"c" long:"create" description:"issue a token with the given name"`
+	Scopes  []string      `short:"s" long:"scope" description:"scope of the new token: read-only, listings-write, orders, wallet-spend or moderator. May be repeated"`
+	Expires time.Duration `short:"e" long:"expires" description:"lifetime of the new token, e.g. 720h. Tokens do not expire by default"`
+	List    bool          `short:"l" long:"list" description:"list the issued tokens"`
+	Revoke  string        `short:"r" long:"revoke" description:"revoke the token with the given ID"`
+}
+
+func (x *APITokens) Execute(args []string) error {
+	sqliteDB, err := openDatastore(x.DataDir, x.Testnet)
+	if err != nil {
+		return err
+	}
+	defer sqliteDB.Close()
+	tokens := sqliteDB.APITokens()
+
+	switch {
+	case x.Create != "":
+		if len(x.Scopes) == 0 {
+			return errors.New("at least one --scope is required")
+		}
+		var scopes []repo.APITokenScope
+		for _, s := range x.Scopes {
+			scope := repo.APITokenScope(strings.ToLower(s))
+			if !scope.Valid() {
+				return fmt.Errorf("unknown scope: %s", s)
+			}
+			scopes = append(scopes, scope)
+		}
+		var expires time.Time
+		if x.Expires > 0 {
+			expires = time.Now().Add(x.Expires)
+		}
+		token, record, err := repo.NewAPIToken(x.Create, scopes, expires)
+		if err != nil {
+			return err
+		}
+		if err := tokens.Put(record); err != nil {
+			return err
+		}
+		fmt.Printf("Token ID: %s\n", record.TokenID)
+		fmt.Printf("Token: %s\n", token)
+		fmt.Println("Save the token now, it cannot be shown again.")
+	case x.Revoke != "":
+		if _, err := tokens.Get(x.Revoke); err != nil {
+			return fmt.Errorf("no token with ID %s", x.Revoke)
+		}
+		if err := tokens.Delete(x.Revoke); err != nil {
+			return err
+		}
+		fmt.Printf("Revoked %s\n", x.Revoke)
+	case x.List:
+		records, err := tokens.GetAll()
+		if err != nil {
+			return err
+		}
+		for _, r := range records {
+			var scopes []string
+			for _, s := range r.Scopes {
+				scopes = append(scopes, s.String())
+			}
+			expires := "never"
+			if !r.Expires.IsZero() {
+				expires = r.Expires.Format(time.RFC3339)
+			}
+			fmt.Printf("%s  %-20s  %-40s  expires %s\n", r.TokenID, r.Name, strings.Join(scopes, ","), expires)
+		}
+	default:
+		return errors.New("one of --create, --list or --revoke is required")
+	}
+	return nil
+}
+
+// openDatastore opens the node's database for commands which run while the
+// node is stopped, asking for the password if the database is encrypted
+func openDatastore(dataDir string, testnet bool) (*db.SQLiteDatastore, error) {
+	repoPath, err := repo.GetRepoPath(testnet)
+	if err != nil {
+		return nil, err
+	}
+	if dataDir != "" {
+		repoPath = dataDir
+	}
+	filename := "mainnet.db"
+	if testnet {
+		filename = "testnet.db"
+	}
+	if _, err := os.Stat(path.Join(repoPath, "datastore", filename)); os.IsNotExist(err) {
+		return nil, errors.New("database does not exist, you may need to run the node at least once to initialize it")
+	}
+	sqliteDB, err := db.Create(repoPath, "", testnet, wallet.Bitcoin)
+	if err != nil {
+		return nil, err
+	}
+	if sqliteDB.Config().IsEncrypted() {
+		sqliteDB.Close()
+		fmt.Fprint(os.Stderr, "Database is encrypted, enter your password: ")
+		// nolint:unconvert
+		bytePassword, _ := terminal.ReadPassword(int(syscall.Stdin))
+		fmt.Fprintln(os.Stderr, "")
+		pw := strings.Replace(string(bytePassword), "'", "''", -1)
+		sqliteDB, err = db.Create(repoPath, pw, testnet, wallet.Bitcoin)
+		if err != nil || sqliteDB.Config().IsEncrypted() {
+			return nil, errors.New("invalid password")
+		}
+	}
+	return sqliteDB, nil
+}
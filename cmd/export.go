@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/kimitzu/kimitzu-go/core"
+	"github.com/kimitzu/kimitzu-go/repo/db"
+)
+
+type Export struct {
+	DataDir string `short:"d" long:"datadir" description:"specify the data directory to be used"`
+	Testnet bool   `short:"t" long:"testnet" description:"use the testnet database"`
+	From    string `short:"f" long:"from" description:"first day of the range, YYYY-MM-DD or an RFC 3339 time"`
+	To      string `long:"to" description:"last day of the range, YYYY-MM-DD or an RFC 3339 time"`
+	Format  string `long:"format" description:"ledger format: csv, ofx or qif" default:"csv"`
+	Output  string `short:"o" long:"output" description:"file to write the ledger to instead of stdout"`
+}
+
+func (x *Export) Execute(args []string) error {
+	since, until, err := core.ParseLedgerRange(x.From, x.To)
+	if err != nil {
+		return err
+	}
+	format := core.LedgerFormat(strings.ToLower(x.Format))
+	switch format {
+	case core.LedgerFormatCSV, core.LedgerFormatOFX, core.LedgerFormatQIF:
+	default:
+		return fmt.Errorf("unknown format: %s", x.Format)
+	}
+
+	sqliteDB, err := openDatastore(x.DataDir, x.Testnet)
+	if err != nil {
+		return err
+	}
+	defer sqliteDB.Close()
+
+	// The wallets are not running, so read their transactions straight
+	// from the database
+	coins := []wallet.CoinType{wallet.Bitcoin, wallet.BitcoinCash, wallet.Zcash, wallet.Litecoin, wallet.Ethereum}
+	if x.Testnet {
+		coins = []wallet.CoinType{wallet.TestnetBitcoin, wallet.TestnetBitcoinCash, wallet.TestnetZcash, wallet.TestnetLitecoin, wallet.TestnetEthereum}
+	}
+	walletTxns := make(map[string][]wallet.Txn)
+	conn := sqliteDB.DB()
+	for _, ct := range coins {
+		txns, err := db.NewTransactionStore(conn.SqlDB, conn.Lock, ct).GetAll(true)
+		if err != nil {
+			return err
+		}
+		if len(txns) > 0 {
+			walletTxns[ct.CurrencyCode()] = txns
+		}
+	}
+	entries, err := core.BuildLedger(sqliteDB, walletTxns, since, until)
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer = os.Stdout
+	if x.Output != "" {
+		f, err := os.Create(x.Output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	if err := core.WriteLedger(out, format, entries, since, until); err != nil {
+		return err
+	}
+	if x.Output != "" {
+		fmt.Fprintf(os.Stderr, "Wrote %d ledger entries to %s\n", len(entries), x.Output)
+	}
+	return nil
+}
@@ -688,6 +688,8 @@ func (x *Start) Execute(args []string) error {
 		core.Node.StartMessageRetriever()
 		core.Node.StartPointerRepublisher()
 		core.Node.StartRecordAgingNotifier()
+		core.Node.StartSubscriptionRenewer()
+		core.Node.StartExchangeRateRecorder()
 
 		core.Node.PublishLock.Unlock()
 		err = core.Node.UpdateFollow()
@@ -0,0 +1,393 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/kimitzu/kimitzu-go/pb"
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+// LedgerEntryType is the kind of money movement a ledger entry records
+type LedgerEntryType string
+
+const (
+	// LedgerEntrySale is a payment made to us for a sale
+	LedgerEntrySale LedgerEntryType = "sale"
+	// LedgerEntryPurchase is a payment we made for a purchase
+	LedgerEntryPurchase LedgerEntryType = "purchase"
+	// LedgerEntryRefund is a refund of an order, to us or from us
+	LedgerEntryRefund LedgerEntryType = "refund"
+	// LedgerEntryDisputePayout adjusts an order for the payout of a dispute
+	LedgerEntryDisputePayout LedgerEntryType = "dispute-payout"
+	// LedgerEntryNetworkFee is the fee paid to release funds held for an order
+	LedgerEntryNetworkFee LedgerEntryType = "network-fee"
+	// LedgerEntryWallet is a wallet transaction which is not part of an order
+	LedgerEntryWallet LedgerEntryType = "wallet"
+)
+
+// LedgerEntry is one line of the accounting export. Amount is in the
+// smallest unit of Coin and positive when money comes in. The fees are
+// costs and never negative. The fiat amounts are in the smallest unit of
+// FiatCurrency and only set when FiatCurrency is.
+type LedgerEntry struct {
+	Timestamp    time.Time
+	Type         LedgerEntryType
+	OrderID      string
+	Txid         string
+	Counterparty string
+	Description  string
+	Coin         string
+	Amount       int64
+	NetworkFee   int64
+	ModeratorFee int64
+
+	FiatCurrency     string
+	FiatAmount       int64
+	FiatNetworkFee   int64
+	FiatModeratorFee int64
+}
+
+// AccountingLedger returns the ledger of our sales, purchases and wallet
+// transactions from since up to but not including until. Zero times leave
+// the range open.
+func (n *OpenBazaarNode) AccountingLedger(since, until time.Time) ([]*LedgerEntry, error) {
+	walletTxns := make(map[string][]wallet.Txn)
+	for _, wal := range n.Multiwallet {
+		txns, err := wal.Transactions()
+		if err != nil {
+			return nil, err
+		}
+		walletTxns[wal.CurrencyCode()] = txns
+	}
+	return BuildLedger(n.Datastore, walletTxns, since, until)
+}
+
+// BuildLedger builds the accounting ledger from the orders in the datastore
+// and the wallet transactions, keyed by currency code. Order payments are
+// valued in fiat at the prices the order was placed at.
+func BuildLedger(ds repo.Datastore, walletTxns map[string][]wallet.Txn, since, until time.Time) ([]*LedgerEntry, error) {
+	var (
+		entries    []*LedgerEntry
+		orderTxids = make(map[string]bool)
+	)
+	sales, _, err := ds.Sales().GetAll(nil, "", true, false, -1, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range sales {
+		contract, _, _, records, _, _, err := ds.Sales().GetByOrderId(s.OrderId)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, orderLedgerEntries(s.OrderId, contract, records, true, walletTxns, orderTxids)...)
+	}
+	purchases, _, err := ds.Purchases().GetAll(nil, "", true, false, -1, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range purchases {
+		contract, _, _, records, _, _, err := ds.Purchases().GetByOrderId(p.OrderId)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, orderLedgerEntries(p.OrderId, contract, records, false, walletTxns, orderTxids)...)
+	}
+
+	metadata, err := ds.TxMetadata().GetAll()
+	if err != nil {
+		return nil, err
+	}
+	for coin, txns := range walletTxns {
+		for _, t := range txns {
+			if t.WatchOnly || t.Status == wallet.StatusDead || orderTxids[t.Txid] {
+				continue
+			}
+			e := &LedgerEntry{
+				Timestamp: t.Timestamp,
+				Type:      LedgerEntryWallet,
+				Txid:      t.Txid,
+				Coin:      coin,
+				Amount:    t.Value,
+			}
+			if m, ok := metadata[t.Txid]; ok {
+				e.OrderID = m.OrderId
+				e.Description = m.Memo
+				e.Counterparty = m.Address
+			}
+			entries = append(entries, e)
+		}
+	}
+
+	var ret []*LedgerEntry
+	for _, e := range entries {
+		if (!since.IsZero() && e.Timestamp.Before(since)) || (!until.IsZero() && !e.Timestamp.Before(until)) {
+			continue
+		}
+		ret = append(ret, e)
+	}
+	sort.SliceStable(ret, func(a, b int) bool {
+		return ret[a].Timestamp.Before(ret[b].Timestamp)
+	})
+	return ret, nil
+}
+
+// orderLedgerEntries turns the payment records of an order into ledger
+// entries, from the vendor's side when sale is set and from the buyer's
+// otherwise. The transactions it accounts for are added to orderTxids.
+func orderLedgerEntries(orderID string, contract *pb.RicardianContract, records []*wallet.TransactionRecord, sale bool, walletTxns map[string][]wallet.Txn, orderTxids map[string]bool) []*LedgerEntry {
+	if contract == nil || contract.BuyerOrder == nil || contract.BuyerOrder.Payment == nil {
+		return nil
+	}
+	var (
+		coin         = contract.BuyerOrder.Payment.Coin
+		walletValues = make(map[string]int64)
+		received     = make(map[string]int64)
+		spent        = make(map[string]int64)
+		timestamps   = make(map[string]time.Time)
+		txids        []string
+		entries      []*LedgerEntry
+	)
+	for _, t := range walletTxns[coin] {
+		walletValues[t.Txid] = t.Value
+	}
+	for _, r := range records {
+		if _, ok := timestamps[r.Txid]; !ok {
+			txids = append(txids, r.Txid)
+			timestamps[r.Txid] = r.Timestamp
+		}
+		if r.Value > 0 {
+			received[r.Txid] += r.Value
+		} else {
+			spent[r.Txid] -= r.Value
+		}
+		orderTxids[r.Txid] = true
+	}
+
+	fiatTotal, fiatCurrency, fiatOK := contractFiatTotal(contract)
+	newEntry := func(t time.Time, typ LedgerEntryType, txid string, amount int64) *LedgerEntry {
+		e := &LedgerEntry{
+			Timestamp:   t,
+			Type:        typ,
+			OrderID:     orderID,
+			Txid:        txid,
+			Description: contractTitles(contract),
+			Coin:        coin,
+			Amount:      amount,
+		}
+		if sale {
+			if contract.BuyerOrder.BuyerID != nil {
+				e.Counterparty = contract.BuyerOrder.BuyerID.PeerID
+			}
+		} else if len(contract.VendorListings) > 0 && contract.VendorListings[0].VendorID != nil {
+			e.Counterparty = contract.VendorListings[0].VendorID.PeerID
+		}
+		if fiatOK {
+			e.FiatCurrency = fiatCurrency
+		}
+		return e
+	}
+	valueInFiat := func(e *LedgerEntry) {
+		if e.FiatCurrency == "" {
+			return
+		}
+		e.FiatAmount = scaleAmount(e.Amount, fiatTotal, contract.BuyerOrder.Payment.Amount)
+		e.FiatNetworkFee = scaleAmount(e.NetworkFee, fiatTotal, contract.BuyerOrder.Payment.Amount)
+		e.FiatModeratorFee = scaleAmount(e.ModeratorFee, fiatTotal, contract.BuyerOrder.Payment.Amount)
+	}
+
+	var refundTxid string
+	if contract.Refund != nil && contract.Refund.RefundTransaction != nil {
+		refundTxid = contract.Refund.RefundTransaction.Txid
+	}
+	disputed := contract.DisputeResolution != nil && contract.DisputeResolution.Payout != nil
+
+	var paid int64
+	for _, txid := range txids {
+		// Escrowed funds a partial refund or milestone release sent back to
+		// the order address are not a new payment
+		if v := received[txid]; v > 0 && spent[txid] == 0 {
+			paid += v
+			if sale {
+				entries = append(entries, newEntry(timestamps[txid], LedgerEntrySale, txid, v))
+			} else {
+				e := newEntry(timestamps[txid], LedgerEntryPurchase, txid, -v)
+				// Paid from our own wallet, which spent the fee on top
+				if w, ok := walletValues[txid]; ok && -w > v {
+					e.NetworkFee = -w - v
+				}
+				entries = append(entries, e)
+			}
+		}
+		// Funds released from the order address into our wallet outside of
+		// a refund or dispute lost only the network fee on the way
+		if s := spent[txid]; s > 0 && sale && !disputed && txid != refundTxid {
+			if w, ok := walletValues[txid]; ok && w > 0 && w < s {
+				e := newEntry(timestamps[txid], LedgerEntryNetworkFee, txid, w-s)
+				e.NetworkFee = s - w
+				entries = append(entries, e)
+			}
+		}
+	}
+
+	if refundTxid != "" {
+		orderTxids[refundTxid] = true
+		value := int64(contract.Refund.RefundTransaction.Value)
+		if sale {
+			value = -value
+		}
+		entries = append(entries, newEntry(protoTime(contract.Refund.Timestamp), LedgerEntryRefund, refundTxid, value))
+	}
+	for _, r := range contract.PartialRefunds {
+		var txid string
+		if r.RefundTransaction != nil {
+			txid = r.RefundTransaction.Txid
+			orderTxids[txid] = true
+		}
+		value := int64(r.Amount)
+		if sale {
+			value = -value
+		}
+		entries = append(entries, newEntry(protoTime(r.Timestamp), LedgerEntryRefund, txid, value))
+	}
+
+	if disputed {
+		payout := contract.DisputeResolution.Payout
+		var ours int64
+		if sale && payout.VendorOutput != nil {
+			ours = int64(payout.VendorOutput.Amount)
+		} else if !sale && payout.BuyerOutput != nil {
+			ours = int64(payout.BuyerOutput.Amount)
+		}
+		// The vendor booked the whole payment as a sale and gives up what
+		// the payout does not award them. The buyer gets their share back.
+		amount := ours
+		if sale {
+			amount = ours - paid
+		}
+		e := newEntry(protoTime(contract.DisputeResolution.Timestamp), LedgerEntryDisputePayout, "", amount)
+		if payout.ModeratorOutput != nil {
+			e.ModeratorFee = int64(payout.ModeratorOutput.Amount)
+		}
+		entries = append(entries, e)
+	}
+
+	for _, e := range entries {
+		valueInFiat(e)
+	}
+	return entries
+}
+
+// contractFiatTotal returns the order total in the fiat currency the
+// listings are priced in. It fails when the listings are priced in
+// different currencies, in a cryptocurrency or at market price.
+func contractFiatTotal(contract *pb.RicardianContract) (uint64, string, bool) {
+	var pricingCurrency string
+	for _, l := range contract.VendorListings {
+		if l.Metadata == nil {
+			return 0, "", false
+		}
+		if pricingCurrency == "" {
+			pricingCurrency = l.Metadata.PricingCurrency
+		} else if !strings.EqualFold(pricingCurrency, l.Metadata.PricingCurrency) {
+			return 0, "", false
+		}
+	}
+	def, err := repo.LoadCurrencyDefinitions().Lookup(pricingCurrency)
+	if err != nil || def.CurrencyType != repo.Fiat {
+		return 0, "", false
+	}
+	total, err := calculateOrderTotal(contract,
+		func(currencyCode string, amount uint64) (uint64, error) {
+			if !strings.EqualFold(currencyCode, pricingCurrency) {
+				return 0, fmt.Errorf("listing priced in %s", currencyCode)
+			}
+			return amount, nil
+		},
+		func(string, uint64) (uint64, error) {
+			return 0, errors.New("market priced listings have no fiat price")
+		})
+	if err != nil {
+		return 0, "", false
+	}
+	return total, def.Code.String(), true
+}
+
+// contractTitles joins the titles of the listings in the contract
+func contractTitles(contract *pb.RicardianContract) string {
+	var titles []string
+	for _, l := range contract.VendorListings {
+		if l.Item != nil && l.Item.Title != "" {
+			titles = append(titles, l.Item.Title)
+		}
+	}
+	return strings.Join(titles, "; ")
+}
+
+// scaleAmount returns amount * numerator / denominator rounded to the
+// nearest unit without overflowing
+func scaleAmount(amount int64, numerator, denominator uint64) int64 {
+	if denominator == 0 || amount == 0 {
+		return 0
+	}
+	r := new(big.Rat).SetFrac(
+		new(big.Int).Mul(big.NewInt(amount), new(big.Int).SetUint64(numerator)),
+		new(big.Int).SetUint64(denominator),
+	)
+	f, _ := r.Float64()
+	if f < 0 {
+		return int64(f - 0.5)
+	}
+	return int64(f + 0.5)
+}
+
+func protoTime(ts *timestamp.Timestamp) time.Time {
+	t, err := ptypes.Timestamp(ts)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// ParseLedgerRange parses the start and end of a ledger date range given as
+// RFC 3339 times or as YYYY-MM-DD dates. An end date includes the whole day.
+// Empty values leave the range open.
+func ParseLedgerRange(from, to string) (time.Time, time.Time, error) {
+	var since, until time.Time
+	if from != "" {
+		t, _, err := parseLedgerDate(from)
+		if err != nil {
+			return since, until, fmt.Errorf("invalid from date: %s", from)
+		}
+		since = t
+	}
+	if to != "" {
+		t, dateOnly, err := parseLedgerDate(to)
+		if err != nil {
+			return since, until, fmt.Errorf("invalid to date: %s", to)
+		}
+		if dateOnly {
+			t = t.AddDate(0, 0, 1)
+		}
+		until = t
+	}
+	if !since.IsZero() && !until.IsZero() && !since.Before(until) {
+		return since, until, errors.New("from must be before to")
+	}
+	return since, until, nil
+}
+
+func parseLedgerDate(s string) (time.Time, bool, error) {
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return t, true, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	return t, false, err
+}
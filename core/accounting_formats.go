@@ -0,0 +1,295 @@
+package core
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+// LedgerFormat is a file format the accounting ledger can be exported in
+type LedgerFormat string
+
+const (
+	LedgerFormatCSV LedgerFormat = "csv"
+	LedgerFormatOFX LedgerFormat = "ofx"
+	LedgerFormatQIF LedgerFormat = "qif"
+)
+
+// ContentType returns the MIME type of the format
+func (f LedgerFormat) ContentType() string {
+	switch f {
+	case LedgerFormatOFX:
+		return "application/x-ofx"
+	case LedgerFormatQIF:
+		return "application/qif"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// WriteLedger writes the entries to w in the given format
+func WriteLedger(w io.Writer, format LedgerFormat, entries []*LedgerEntry, since, until time.Time) error {
+	switch format {
+	case LedgerFormatCSV:
+		return writeLedgerCSV(w, entries)
+	case LedgerFormatOFX:
+		return writeLedgerOFX(w, entries, since, until)
+	case LedgerFormatQIF:
+		return writeLedgerQIF(w, entries)
+	}
+	return fmt.Errorf("unknown ledger format: %s", format)
+}
+
+var ledgerCSVHeader = []string{
+	"date", "type", "orderId", "txid", "counterparty", "description",
+	"coin", "amount", "networkFee", "moderatorFee",
+	"fiatCurrency", "fiatAmount", "fiatNetworkFee", "fiatModeratorFee",
+}
+
+func writeLedgerCSV(w io.Writer, entries []*LedgerEntry) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(ledgerCSVHeader); err != nil {
+		return err
+	}
+	for _, e := range entries {
+		row := []string{
+			e.Timestamp.UTC().Format(time.RFC3339),
+			string(e.Type),
+			e.OrderID,
+			e.Txid,
+			e.Counterparty,
+			e.Description,
+			e.Coin,
+			formatLedgerAmount(e.Amount, e.Coin),
+			formatLedgerAmount(e.NetworkFee, e.Coin),
+			formatLedgerAmount(e.ModeratorFee, e.Coin),
+			e.FiatCurrency,
+		}
+		if e.FiatCurrency != "" {
+			row = append(row,
+				formatLedgerAmount(e.FiatAmount, e.FiatCurrency),
+				formatLedgerAmount(e.FiatNetworkFee, e.FiatCurrency),
+				formatLedgerAmount(e.FiatModeratorFee, e.FiatCurrency),
+			)
+		} else {
+			row = append(row, "", "", "")
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// OFX 2 statement structure. Each coin gets a statement of its own since an
+// OFX statement has a single currency.
+type (
+	ofxDocument struct {
+		XMLName xml.Name       `xml:"OFX"`
+		SignOn  ofxSignOn      `xml:"SIGNONMSGSRSV1>SONRS"`
+		Bank    []ofxStatement `xml:"BANKMSGSRSV1>STMTTRNRS"`
+	}
+	ofxStatus struct {
+		Code     int    `xml:"CODE"`
+		Severity string `xml:"SEVERITY"`
+	}
+	ofxSignOn struct {
+		Status   ofxStatus `xml:"STATUS"`
+		DTServer string    `xml:"DTSERVER"`
+		Language string    `xml:"LANGUAGE"`
+	}
+	ofxStatement struct {
+		TrnUID       int              `xml:"TRNUID"`
+		Status       ofxStatus        `xml:"STATUS"`
+		Currency     string           `xml:"STMTRS>CURDEF"`
+		BankID       string           `xml:"STMTRS>BANKACCTFROM>BANKID"`
+		AccountID    string           `xml:"STMTRS>BANKACCTFROM>ACCTID"`
+		AccountType  string           `xml:"STMTRS>BANKACCTFROM>ACCTTYPE"`
+		Start        string           `xml:"STMTRS>BANKTRANLIST>DTSTART"`
+		End          string           `xml:"STMTRS>BANKTRANLIST>DTEND"`
+		Transactions []ofxTransaction `xml:"STMTRS>BANKTRANLIST>STMTTRN"`
+		Balance      string           `xml:"STMTRS>LEDGERBAL>BALAMT"`
+		BalanceDate  string           `xml:"STMTRS>LEDGERBAL>DTASOF"`
+	}
+	ofxTransaction struct {
+		Type   string `xml:"TRNTYPE"`
+		Posted string `xml:"DTPOSTED"`
+		Amount string `xml:"TRNAMT"`
+		FitID  string `xml:"FITID"`
+		Name   string `xml:"NAME,omitempty"`
+		Memo   string `xml:"MEMO,omitempty"`
+	}
+)
+
+const ofxTimeFormat = "20060102150405"
+
+func writeLedgerOFX(w io.Writer, entries []*LedgerEntry, since, until time.Time) error {
+	now := time.Now().UTC()
+	doc := ofxDocument{
+		SignOn: ofxSignOn{
+			Status:   ofxStatus{Severity: "INFO"},
+			DTServer: now.Format(ofxTimeFormat),
+			Language: "ENG",
+		},
+	}
+	byCoin, coins := ledgerEntriesByCoin(entries)
+	for n, coin := range coins {
+		st := ofxStatement{
+			TrnUID:      n + 1,
+			Status:      ofxStatus{Severity: "INFO"},
+			Currency:    coin,
+			BankID:      "kimitzu",
+			AccountID:   coin,
+			AccountType: "CHECKING",
+			BalanceDate: now.Format(ofxTimeFormat),
+		}
+		start, end := since, until
+		var balance int64
+		for _, e := range byCoin[coin] {
+			if start.IsZero() || e.Timestamp.Before(start) {
+				start = e.Timestamp
+			}
+			if end.IsZero() || e.Timestamp.After(end) {
+				end = e.Timestamp
+			}
+			balance += e.Amount
+			typ := "CREDIT"
+			switch {
+			case e.Type == LedgerEntryNetworkFee:
+				typ = "FEE"
+			case e.Amount < 0:
+				typ = "DEBIT"
+			}
+			st.Transactions = append(st.Transactions, ofxTransaction{
+				Type:   typ,
+				Posted: e.Timestamp.UTC().Format(ofxTimeFormat),
+				Amount: formatLedgerAmount(e.Amount, e.Coin),
+				FitID:  ledgerEntryID(e),
+				Name:   truncateString(e.Counterparty, 32),
+				Memo:   ledgerEntryMemo(e),
+			})
+		}
+		st.Start = start.UTC().Format(ofxTimeFormat)
+		st.End = end.UTC().Format(ofxTimeFormat)
+		st.Balance = formatLedgerAmount(balance, coin)
+		doc.Bank = append(doc.Bank, st)
+	}
+
+	if _, err := io.WriteString(w, xml.Header+`<?OFX OFXHEADER="200" VERSION="220" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>`+"\n"); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func writeLedgerQIF(w io.Writer, entries []*LedgerEntry) error {
+	byCoin, coins := ledgerEntriesByCoin(entries)
+	var b strings.Builder
+	for _, coin := range coins {
+		fmt.Fprintf(&b, "!Account\nN%s wallet\nTBank\n^\n!Type:Bank\n", coin)
+		for _, e := range byCoin[coin] {
+			fmt.Fprintf(&b, "D%s\n", e.Timestamp.UTC().Format("01/02/2006"))
+			fmt.Fprintf(&b, "T%s\n", formatLedgerAmount(e.Amount, e.Coin))
+			if e.Counterparty != "" {
+				fmt.Fprintf(&b, "P%s\n", qifLine(e.Counterparty))
+			}
+			fmt.Fprintf(&b, "M%s\n", qifLine(ledgerEntryMemo(e)))
+			fmt.Fprintf(&b, "L%s\n", e.Type)
+			if e.Txid != "" {
+				fmt.Fprintf(&b, "N%s\n", e.Txid)
+			}
+			b.WriteString("^\n")
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// ledgerEntriesByCoin groups the entries by coin, returning the coins in
+// alphabetical order
+func ledgerEntriesByCoin(entries []*LedgerEntry) (map[string][]*LedgerEntry, []string) {
+	byCoin := make(map[string][]*LedgerEntry)
+	var coins []string
+	for _, e := range entries {
+		if _, ok := byCoin[e.Coin]; !ok {
+			coins = append(coins, e.Coin)
+		}
+		byCoin[e.Coin] = append(byCoin[e.Coin], e)
+	}
+	sort.Strings(coins)
+	return byCoin, coins
+}
+
+// ledgerEntryID identifies the entry across exports so importing an
+// overlapping range does not duplicate transactions
+func ledgerEntryID(e *LedgerEntry) string {
+	return strings.Join([]string{string(e.Type), e.OrderID, e.Txid, strconv.FormatInt(e.Timestamp.Unix(), 10)}, ":")
+}
+
+// ledgerEntryMemo describes the entry in the free text field of OFX and QIF,
+// which have no columns for fees and fiat values
+func ledgerEntryMemo(e *LedgerEntry) string {
+	var parts []string
+	if e.OrderID != "" {
+		parts = append(parts, "order "+e.OrderID)
+	}
+	if e.Description != "" {
+		parts = append(parts, e.Description)
+	}
+	if e.NetworkFee != 0 {
+		parts = append(parts, "network fee "+formatLedgerAmount(e.NetworkFee, e.Coin))
+	}
+	if e.ModeratorFee != 0 {
+		parts = append(parts, "moderator fee "+formatLedgerAmount(e.ModeratorFee, e.Coin))
+	}
+	if e.FiatCurrency != "" {
+		parts = append(parts, formatLedgerAmount(e.FiatAmount, e.FiatCurrency)+" "+e.FiatCurrency)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// formatLedgerAmount formats an amount in the smallest unit of the currency
+// as a decimal number
+func formatLedgerAmount(amount int64, currencyCode string) string {
+	divisibility := uint(8)
+	if def, err := repo.LoadCurrencyDefinitions().Lookup(currencyCode); err == nil {
+		divisibility = def.Divisibility
+	}
+	sign := ""
+	abs := uint64(amount)
+	if amount < 0 {
+		sign = "-"
+		abs = uint64(-amount)
+	}
+	if divisibility == 0 {
+		return sign + strconv.FormatUint(abs, 10)
+	}
+	unit := uint64(1)
+	for i := uint(0); i < divisibility; i++ {
+		unit *= 10
+	}
+	return fmt.Sprintf("%s%d.%0*d", sign, abs/unit, int(divisibility), abs%unit)
+}
+
+func qifLine(s string) string {
+	return strings.NewReplacer("\r", " ", "\n", " ").Replace(s)
+}
+
+func truncateString(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
@@ -0,0 +1,198 @@
+package core
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/kimitzu/kimitzu-go/pb"
+)
+
+func newLedgerTestContract(t *testing.T, pricingCurrency string) *pb.RicardianContract {
+	listing := &pb.Listing{
+		VendorID: &pb.ID{PeerID: "QmVendor"},
+		Metadata: &pb.Listing_Metadata{
+			ContractType:    pb.Listing_Metadata_PHYSICAL_GOOD,
+			Format:          pb.Listing_Metadata_FIXED_PRICE,
+			PricingCurrency: pricingCurrency,
+			Version:         2,
+		},
+		Item: &pb.Listing_Item{Title: "Widget", Price: 1000},
+		ShippingOptions: []*pb.Listing_ShippingOption{{
+			Name:     "Post",
+			Regions:  []pb.CountryCode{pb.CountryCode_ALL},
+			Type:     pb.Listing_ShippingOption_FIXED_PRICE,
+			Services: []*pb.Listing_ShippingOption_Service{{Name: "Standard", Price: 250}},
+		}},
+	}
+	ser, err := proto.Marshal(listing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	listingID, err := EncodeCID(ser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &pb.RicardianContract{
+		VendorListings: []*pb.Listing{listing},
+		BuyerOrder: &pb.Order{
+			BuyerID: &pb.ID{PeerID: "QmBuyer"},
+			Items: []*pb.Order_Item{{
+				ListingHash:    listingID.String(),
+				Quantity:       2,
+				ShippingOption: &pb.Order_Item_ShippingOption{Name: "Post", Service: "Standard"},
+			}},
+			Shipping: &pb.Order_Shipping{Country: pb.CountryCode_UNITED_STATES},
+			Payment:  &pb.Order_Payment{Coin: "BTC", Amount: 100000},
+		},
+	}
+}
+
+func TestContractFiatTotal(t *testing.T) {
+	total, currency, ok := contractFiatTotal(newLedgerTestContract(t, "usd"))
+	if !ok || currency != "USD" || total != 2250 {
+		t.Errorf("expected 2250 USD, got %d %s (%v)", total, currency, ok)
+	}
+	if _, _, ok := contractFiatTotal(newLedgerTestContract(t, "BTC")); ok {
+		t.Error("expected no fiat total for a listing priced in BTC")
+	}
+}
+
+func TestOrderLedgerEntries(t *testing.T) {
+	contract := newLedgerTestContract(t, "USD")
+	paid := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	refunded := paid.Add(48 * time.Hour)
+	contract.Refund = &pb.Refund{
+		RefundTransaction: &pb.Refund_TransactionInfo{Txid: "refund", Value: 40000},
+	}
+	contract.Refund.Timestamp, _ = ptypes.TimestampProto(refunded)
+	records := []*wallet.TransactionRecord{
+		{Txid: "payment", Value: 100000, Timestamp: paid},
+	}
+	// The buyer paid 100000 plus a 500 fee from their wallet
+	walletTxns := map[string][]wallet.Txn{
+		"BTC": {{Txid: "payment", Value: -100500}, {Txid: "refund", Value: 40000}},
+	}
+
+	txids := make(map[string]bool)
+	purchase := orderLedgerEntries("order1", contract, records, false, walletTxns, txids)
+	if len(purchase) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(purchase))
+	}
+	p := purchase[0]
+	if p.Type != LedgerEntryPurchase || p.Amount != -100000 || p.NetworkFee != 500 || p.Counterparty != "QmVendor" || p.Description != "Widget" {
+		t.Errorf("unexpected purchase entry %+v", p)
+	}
+	if p.FiatCurrency != "USD" || p.FiatAmount != -2250 || p.FiatNetworkFee != 11 {
+		t.Errorf("unexpected fiat values %+v", p)
+	}
+	r := purchase[1]
+	if r.Type != LedgerEntryRefund || r.Amount != 40000 || r.FiatAmount != 900 || !r.Timestamp.Equal(refunded) {
+		t.Errorf("unexpected refund entry %+v", r)
+	}
+	if !txids["payment"] || !txids["refund"] {
+		t.Error("expected the order transactions to be marked")
+	}
+
+	sale := orderLedgerEntries("order1", contract, records, true, nil, make(map[string]bool))
+	if len(sale) != 2 || sale[0].Type != LedgerEntrySale || sale[0].Amount != 100000 || sale[0].Counterparty != "QmBuyer" || sale[1].Amount != -40000 {
+		t.Errorf("unexpected sale entries %+v %+v", sale[0], sale[1])
+	}
+}
+
+func TestOrderLedgerEntriesDispute(t *testing.T) {
+	contract := newLedgerTestContract(t, "USD")
+	contract.DisputeResolution = &pb.DisputeResolution{
+		Timestamp: ptypes.TimestampNow(),
+		Payout: &pb.DisputeResolution_Payout{
+			BuyerOutput:     &pb.DisputeResolution_Payout_Output{Amount: 30000},
+			VendorOutput:    &pb.DisputeResolution_Payout_Output{Amount: 60000},
+			ModeratorOutput: &pb.DisputeResolution_Payout_Output{Amount: 5000},
+		},
+	}
+	records := []*wallet.TransactionRecord{
+		{Txid: "payment", Value: 100000, Timestamp: time.Now()},
+		{Txid: "payout", Value: -100000, Timestamp: time.Now()},
+	}
+	sale := orderLedgerEntries("order1", contract, records, true, nil, make(map[string]bool))
+	if len(sale) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(sale))
+	}
+	d := sale[1]
+	if d.Type != LedgerEntryDisputePayout || d.Amount != -40000 || d.ModeratorFee != 5000 || d.FiatModeratorFee != 113 {
+		t.Errorf("unexpected dispute entry %+v", d)
+	}
+
+	purchase := orderLedgerEntries("order1", contract, records, false, nil, make(map[string]bool))
+	if len(purchase) != 2 || purchase[1].Amount != 30000 {
+		t.Errorf("unexpected purchase entries %+v", purchase)
+	}
+}
+
+func TestParseLedgerRange(t *testing.T) {
+	since, until, err := ParseLedgerRange("2026-01-01", "2026-12-31")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !since.Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)) || !until.Equal(time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected range %s - %s", since, until)
+	}
+	if _, _, err := ParseLedgerRange("2026-02-01", "2026-01-01"); err == nil {
+		t.Error("expected an inverted range to fail")
+	}
+	if _, _, err := ParseLedgerRange("January", ""); err == nil {
+		t.Error("expected an invalid date to fail")
+	}
+}
+
+func TestFormatLedgerAmount(t *testing.T) {
+	for _, c := range []struct {
+		amount   int64
+		currency string
+		expected string
+	}{
+		{100500, "BTC", "0.00100500"},
+		{-2250, "USD", "-22.50"},
+		{5, "TBTC", "0.00000005"},
+		{0, "USD", "0.00"},
+	} {
+		if s := formatLedgerAmount(c.amount, c.currency); s != c.expected {
+			t.Errorf("expected %s, got %s", c.expected, s)
+		}
+	}
+}
+
+func TestWriteLedger(t *testing.T) {
+	entries := []*LedgerEntry{
+		{Timestamp: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC), Type: LedgerEntrySale, OrderID: "order1", Txid: "payment", Coin: "BTC", Amount: 100000, FiatCurrency: "USD", FiatAmount: 2250},
+		{Timestamp: time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC), Type: LedgerEntryWallet, Txid: "send", Coin: "BTC", Amount: -5000},
+	}
+	var buf bytes.Buffer
+	if err := WriteLedger(&buf, LedgerFormatCSV, entries, time.Time{}, time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 || lines[1] != "2026-03-01T12:00:00Z,sale,order1,payment,,,BTC,0.00100000,0.00000000,0.00000000,USD,22.50,0.00,0.00" {
+		t.Errorf("unexpected CSV %s", buf.String())
+	}
+
+	buf.Reset()
+	if err := WriteLedger(&buf, LedgerFormatOFX, entries, time.Time{}, time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "<TRNAMT>-0.00005000</TRNAMT>") || !strings.Contains(buf.String(), "<BALAMT>0.00095000</BALAMT>") {
+		t.Errorf("unexpected OFX %s", buf.String())
+	}
+
+	buf.Reset()
+	if err := WriteLedger(&buf, LedgerFormatQIF, entries, time.Time{}, time.Time{}); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(buf.String(), "!Account\nNBTC wallet\nTBank\n^\n!Type:Bank\nD03/01/2026\nT0.00100000\n") {
+		t.Errorf("unexpected QIF %s", buf.String())
+	}
+}
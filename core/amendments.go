@@ -0,0 +1,592 @@
+package core
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	libp2p "gx/ipfs/QmTW4SdgBWq9GjsBsHeUx8WuGxzhgzAf88UMH2w62PC8yK/go-libp2p-crypto"
+
+	"github.com/OpenBazaar/wallet-interface"
+	btc "github.com/btcsuite/btcutil"
+	hd "github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/kimitzu/kimitzu-go/pb"
+)
+
+const (
+	// MaxOrderAmendments - max number of amendments either party can propose on a single order
+	MaxOrderAmendments = 20
+)
+
+var (
+	// ErrOrderNotAmendable - the order has moved past the point where its terms can change
+	ErrOrderNotAmendable = errors.New("orders can only be amended while pending or awaiting payment")
+	// ErrAmendmentFunded - the buyer has already paid the order total
+	ErrAmendmentFunded = errors.New("the price of a funded order cannot be amended")
+	// ErrNoOpenAmendment - there is no amendment waiting for our response
+	ErrNoOpenAmendment = errors.New("the order has no amendment awaiting a response")
+)
+
+// OrderAmendmentData - the terms one party proposes for the order. Zero values
+// keep the current price and quantity. Price is the order total in the
+// payment coin.
+type OrderAmendmentData struct {
+	OrderID      string     `json:"orderId"`
+	Price        uint64     `json:"price"`
+	Quantity     uint64     `json:"quantity"`
+	DeliveryDate *time.Time `json:"deliveryDate"`
+	Memo         string     `json:"memo"`
+}
+
+// OrderAmendmentResponseData - accept or decline the open amendment of an order
+type OrderAmendmentResponseData struct {
+	OrderID string `json:"orderId"`
+	Accept  bool   `json:"accept"`
+	Reason  string `json:"reason"`
+}
+
+// OrderAmendmentStatus returns whether the amendment was accepted, declined,
+// superseded by a counter-offer or is still waiting for a response
+func OrderAmendmentStatus(contract *pb.RicardianContract, index uint32) string {
+	for _, r := range contract.OrderAmendmentResponses {
+		if r.AmendmentIndex == index {
+			if r.Accepted {
+				return "ACCEPTED"
+			}
+			return "DECLINED"
+		}
+	}
+	if int(index) < len(contract.OrderAmendments)-1 {
+		return "SUPERSEDED"
+	}
+	return "PENDING"
+}
+
+// OrderItemQuantity returns the quantity of the item, which an accepted
+// amendment may have changed
+func OrderItemQuantity(contract *pb.RicardianContract, l *pb.Listing, item *pb.Order_Item) uint64 {
+	if a := pb.AcceptedOrderAmendment(contract); a != nil {
+		return a.Quantity
+	}
+	return GetOrderQuantity(l, item)
+}
+
+// openOrderAmendment returns the latest amendment if the other party has not
+// responded to it yet
+func openOrderAmendment(contract *pb.RicardianContract) *pb.OrderAmendment {
+	if len(contract.OrderAmendments) == 0 {
+		return nil
+	}
+	a := contract.OrderAmendments[len(contract.OrderAmendments)-1]
+	if OrderAmendmentStatus(contract, a.Index) != "PENDING" {
+		return nil
+	}
+	return a
+}
+
+// agreedDeliveryDate returns the delivery date both parties agreed on, if any
+func agreedDeliveryDate(contract *pb.RicardianContract) *timestamp.Timestamp {
+	if a := pb.AcceptedOrderAmendment(contract); a != nil {
+		return a.DeliveryDate
+	}
+	return nil
+}
+
+// checkOrderAmendable returns an error if the terms of the order can no longer change
+func checkOrderAmendable(contract *pb.RicardianContract, state pb.OrderState) error {
+	if state != pb.OrderState_PENDING && state != pb.OrderState_AWAITING_PAYMENT {
+		return ErrOrderNotAmendable
+	}
+	if IsMilestoneOrder(contract) || IsTimesheetOrder(contract) || contract.BuyerOrder.SubscriptionPeriod != nil {
+		return errors.New("orders paid per milestone, timesheet or subscription period cannot be amended")
+	}
+	if len(contract.BuyerOrder.Items) != 1 || len(contract.VendorListings) != 1 {
+		return errors.New("only orders of a single item can be amended")
+	}
+	return nil
+}
+
+func validateOrderAmendment(contract *pb.RicardianContract, state pb.OrderState, amendment *pb.OrderAmendment) error {
+	if err := checkOrderAmendable(contract, state); err != nil {
+		return err
+	}
+	if len(contract.OrderAmendments) >= MaxOrderAmendments {
+		return fmt.Errorf("number of amendments is greater than the max of %d", MaxOrderAmendments)
+	}
+	if int(amendment.Index) != len(contract.OrderAmendments) {
+		return errors.New("amendment index is out of order")
+	}
+	if len(amendment.Memo) > DescriptionMaxCharacters {
+		return fmt.Errorf("amendment memo is longer than the max of %d characters", DescriptionMaxCharacters)
+	}
+	if amendment.Quantity == 0 {
+		return errors.New("amended quantity must be greater than zero")
+	}
+	if amendment.DeliveryDate != nil {
+		if _, err := ptypes.Timestamp(amendment.DeliveryDate); err != nil {
+			return fmt.Errorf("invalid delivery date: %s", err)
+		}
+	}
+
+	current, payment := pb.OrderPayment(contract), amendment.Payment
+	if payment == nil || payment.Amount == 0 {
+		return errors.New("amended price must be greater than zero")
+	}
+	if payment.Method != current.Method || payment.Moderator != current.Moderator || payment.Coin != current.Coin {
+		return errors.New("an amendment cannot change how the order is paid")
+	}
+	if (payment.ModeratorPanel == nil) != (current.ModeratorPanel == nil) ||
+		(current.ModeratorPanel != nil && (!equalStrings(payment.ModeratorPanel.Moderators, current.ModeratorPanel.Moderators) ||
+			payment.ModeratorPanel.Threshold != current.ModeratorPanel.Threshold)) {
+		return errors.New("an amendment cannot change the moderator panel")
+	}
+	if payment.Amount != current.Amount {
+		if state != pb.OrderState_AWAITING_PAYMENT {
+			return ErrAmendmentFunded
+		}
+		// A moderated order whose total changes is paid to a new escrow address,
+		// so a payment made for the previous total never funds the amended one.
+		// The address itself is checked by ValidateOrderAmendment.
+		if current.Method == pb.Order_Payment_MODERATED && payment.Chaincode == current.Chaincode {
+			return errors.New("amended moderated payment must use a new escrow address")
+		}
+	}
+	if payment.Amount == current.Amount || current.Method != pb.Order_Payment_MODERATED {
+		if payment.Address != current.Address || payment.RedeemScript != current.RedeemScript ||
+			payment.Chaincode != current.Chaincode || !bytes.Equal(payment.ModeratorKey, current.ModeratorKey) ||
+			!proto.Equal(payment.ModeratorPanel, current.ModeratorPanel) {
+			return errors.New("amendment changes the payment address")
+		}
+	}
+
+	l := contract.VendorListings[0]
+	if payment.Amount == current.Amount &&
+		amendment.Quantity == OrderItemQuantity(contract, l, contract.BuyerOrder.Items[0]) &&
+		proto.Equal(amendment.DeliveryDate, agreedDeliveryDate(contract)) {
+		return errors.New("amendment does not change the order")
+	}
+	return nil
+}
+
+// ourOrderParty returns whether we are the buyer or the vendor of the order
+func (n *OpenBazaarNode) ourOrderParty(contract *pb.RicardianContract) pb.OrderAmendment_Party {
+	if contract.VendorListings[0].VendorID.PeerID == n.IpfsNode.Identity.Pretty() {
+		return pb.OrderAmendment_VENDOR
+	}
+	return pb.OrderAmendment_BUYER
+}
+
+// counterparty returns the ID of the other party to the order
+func counterparty(contract *pb.RicardianContract, us pb.OrderAmendment_Party) *pb.ID {
+	if us == pb.OrderAmendment_VENDOR {
+		return contract.BuyerOrder.BuyerID
+	}
+	return contract.VendorListings[0].VendorID
+}
+
+// moderatedEscrowAddress derives the escrow address of a moderated order from
+// the bitcoin keys of the buyer, the vendor and the moderators and the
+// chaincode. It returns the moderators' escrow keys in the order of the panel.
+func (n *OpenBazaarNode) moderatedEscrowAddress(contract *pb.RicardianContract, wal wallet.Wallet, chaincode []byte) (btc.Address, []byte, [][]byte, error) {
+	vendorKey, err := wal.ChildKey(contract.VendorListings[0].VendorID.Pubkeys.Bitcoin, chaincode, false)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	buyerKey, err := wal.ChildKey(contract.BuyerOrder.BuyerID.Pubkeys.Bitcoin, chaincode, false)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	escrowKeys := []hd.ExtendedKey{*buyerKey, *vendorKey}
+	var moderatorKeys [][]byte
+	moderators, _ := pb.PaymentModerators(contract.BuyerOrder.Payment)
+	for _, moderator := range moderators {
+		profile, err := n.FetchProfile(moderator, true)
+		if err != nil {
+			return nil, nil, nil, errors.New("moderator could not be found")
+		}
+		moderatorKeyBytes, err := hex.DecodeString(profile.BitcoinPubkey)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		moderatorKey, err := wal.ChildKey(moderatorKeyBytes, chaincode, false)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		modPub, err := moderatorKey.ECPubKey()
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		escrowKeys = append(escrowKeys, *moderatorKey)
+		moderatorKeys = append(moderatorKeys, modPub.SerializeCompressed())
+	}
+	timeout, err := time.ParseDuration(strconv.Itoa(int(contract.VendorListings[0].Metadata.EscrowTimeoutHours)) + "h")
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	addr, redeemScript, err := generateEscrowScript(wal, contract.BuyerOrder.Payment, escrowKeys, timeout)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return addr, redeemScript, moderatorKeys, nil
+}
+
+// amendedPayment returns the payment terms of the order for the new total
+func (n *OpenBazaarNode) amendedPayment(contract *pb.RicardianContract, amount uint64) (*pb.Order_Payment, error) {
+	payment := proto.Clone(pb.OrderPayment(contract)).(*pb.Order_Payment)
+	if amount == 0 || amount == payment.Amount {
+		return payment, nil
+	}
+	payment.Amount = amount
+	if payment.Method != pb.Order_Payment_MODERATED {
+		return payment, nil
+	}
+
+	wal, err := n.Multiwallet.WalletForCurrencyCode(payment.Coin)
+	if err != nil {
+		return nil, err
+	}
+	if wal.GetFeePerByte(wallet.NORMAL)*EscrowReleaseSize > amount/4 {
+		return nil, errors.New("transaction fee too high for moderated payment")
+	}
+	chaincode := make([]byte, 32)
+	if _, err := rand.Read(chaincode); err != nil {
+		return nil, err
+	}
+	addr, redeemScript, moderatorKeys, err := n.moderatedEscrowAddress(contract, wal, chaincode)
+	if err != nil {
+		return nil, err
+	}
+	payment.Address = addr.EncodeAddress()
+	payment.RedeemScript = hex.EncodeToString(redeemScript)
+	payment.Chaincode = hex.EncodeToString(chaincode)
+	payment.ModeratorKey = moderatorKeys[0]
+	if payment.ModeratorPanel != nil {
+		payment.ModeratorPanel.ModeratorKeys = moderatorKeys
+	}
+	return payment, nil
+}
+
+// WatchAmendedPayment - adds the escrow address of an accepted amendment to the wallet
+func (n *OpenBazaarNode) WatchAmendedPayment(contract *pb.RicardianContract) error {
+	payment := pb.OrderPayment(contract)
+	if payment.Method != pb.Order_Payment_MODERATED || payment == contract.BuyerOrder.Payment {
+		return nil
+	}
+	wal, err := n.Multiwallet.WalletForCurrencyCode(payment.Coin)
+	if err != nil {
+		return err
+	}
+	addr, err := wal.DecodeAddress(payment.Address)
+	if err != nil {
+		return err
+	}
+	return wal.AddWatchedAddress(addr)
+}
+
+// ProposeOrderAmendment - propose new terms for the order, or counter the
+// other party's proposal, and send the signed amendment to them
+func (n *OpenBazaarNode) ProposeOrderAmendment(contract *pb.RicardianContract, state pb.OrderState, data *OrderAmendmentData) (*pb.OrderAmendment, error) {
+	if err := checkOrderAmendable(contract, state); err != nil {
+		return nil, err
+	}
+	orderID, err := n.CalcOrderID(contract.BuyerOrder)
+	if err != nil {
+		return nil, err
+	}
+	us := n.ourOrderParty(contract)
+	amendment := new(pb.OrderAmendment)
+	amendment.OrderId = orderID
+	amendment.Index = uint32(len(contract.OrderAmendments))
+	amendment.Proposer = us
+	amendment.Memo = data.Memo
+	amendment.Quantity = data.Quantity
+	if amendment.Quantity == 0 {
+		amendment.Quantity = OrderItemQuantity(contract, contract.VendorListings[0], contract.BuyerOrder.Items[0])
+	}
+	if data.DeliveryDate != nil {
+		amendment.DeliveryDate, err = ptypes.TimestampProto(*data.DeliveryDate)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		amendment.DeliveryDate = agreedDeliveryDate(contract)
+	}
+	amendment.Payment, err = n.amendedPayment(contract, data.Price)
+	if err != nil {
+		return nil, err
+	}
+	amendment.Timestamp, err = ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := validateOrderAmendment(contract, state, amendment); err != nil {
+		return nil, err
+	}
+
+	rc := new(pb.RicardianContract)
+	rc.OrderAmendments = []*pb.OrderAmendment{amendment}
+	rc, err = n.SignOrderAmendment(rc)
+	if err != nil {
+		return nil, err
+	}
+	other := counterparty(contract, us)
+	k, err := libp2p.UnmarshalPublicKey(other.Pubkeys.Identity)
+	if err != nil {
+		return nil, err
+	}
+	if err := n.SendOrderAmendment(other.PeerID, &k, rc); err != nil {
+		return nil, err
+	}
+
+	contract.OrderAmendments = append(contract.OrderAmendments, amendment)
+	for _, sig := range rc.Signatures {
+		if sig.Section == pb.Signature_ORDER_AMENDMENT {
+			contract.Signatures = append(contract.Signatures, sig)
+		}
+	}
+	return amendment, n.putOrder(contract, orderID, state, us)
+}
+
+// RespondToOrderAmendment - accept or decline the amendment the other party proposed
+func (n *OpenBazaarNode) RespondToOrderAmendment(contract *pb.RicardianContract, state pb.OrderState, accept bool, reason string) error {
+	us := n.ourOrderParty(contract)
+	amendment := openOrderAmendment(contract)
+	if amendment == nil || amendment.Proposer == us {
+		return ErrNoOpenAmendment
+	}
+	response := new(pb.OrderAmendmentResponse)
+	response.OrderId = amendment.OrderId
+	response.AmendmentIndex = amendment.Index
+	response.Accepted = accept
+	response.Reason = reason
+	var err error
+	response.Timestamp, err = ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return err
+	}
+	if err := validateOrderAmendmentResponse(contract, state, response); err != nil {
+		return err
+	}
+
+	rc := new(pb.RicardianContract)
+	rc.OrderAmendmentResponses = []*pb.OrderAmendmentResponse{response}
+	rc, err = n.SignOrderAmendmentResponse(rc)
+	if err != nil {
+		return err
+	}
+	other := counterparty(contract, us)
+	k, err := libp2p.UnmarshalPublicKey(other.Pubkeys.Identity)
+	if err != nil {
+		return err
+	}
+	if err := n.SendOrderAmendmentResponse(other.PeerID, &k, rc); err != nil {
+		return err
+	}
+
+	contract.OrderAmendmentResponses = append(contract.OrderAmendmentResponses, response)
+	for _, sig := range rc.Signatures {
+		if sig.Section == pb.Signature_ORDER_AMENDMENT_RESPONSE {
+			contract.Signatures = append(contract.Signatures, sig)
+		}
+	}
+	if accept {
+		if err := n.WatchAmendedPayment(contract); err != nil {
+			return err
+		}
+	}
+	return n.putOrder(contract, response.OrderId, state, us)
+}
+
+// putOrder saves the contract as a sale or a purchase depending on our side of the order
+func (n *OpenBazaarNode) putOrder(contract *pb.RicardianContract, orderID string, state pb.OrderState, us pb.OrderAmendment_Party) error {
+	if us == pb.OrderAmendment_VENDOR {
+		return n.Datastore.Sales().Put(orderID, *contract, state, true)
+	}
+	return n.Datastore.Purchases().Put(orderID, *contract, state, true)
+}
+
+func validateOrderAmendmentResponse(contract *pb.RicardianContract, state pb.OrderState, response *pb.OrderAmendmentResponse) error {
+	amendment := openOrderAmendment(contract)
+	if amendment == nil || amendment.Index != response.AmendmentIndex {
+		return ErrNoOpenAmendment
+	}
+	if len(response.Reason) > DescriptionMaxCharacters {
+		return fmt.Errorf("amendment response reason is longer than the max of %d characters", DescriptionMaxCharacters)
+	}
+	if !response.Accepted {
+		return nil
+	}
+	// The order may have been funded since the amendment was proposed
+	if err := checkOrderAmendable(contract, state); err != nil {
+		return err
+	}
+	if amendment.Payment.Amount != pb.OrderPayment(contract).Amount && state != pb.OrderState_AWAITING_PAYMENT {
+		return ErrAmendmentFunded
+	}
+	return nil
+}
+
+// SignOrderAmendment - add signature to order amendment
+func (n *OpenBazaarNode) SignOrderAmendment(contract *pb.RicardianContract) (*pb.RicardianContract, error) {
+	serializedAmendment, err := proto.Marshal(contract.OrderAmendments[0])
+	if err != nil {
+		return contract, err
+	}
+	s := new(pb.Signature)
+	s.Section = pb.Signature_ORDER_AMENDMENT
+	guidSig, err := n.IpfsNode.PrivateKey.Sign(serializedAmendment)
+	if err != nil {
+		return contract, err
+	}
+	s.SignatureBytes = guidSig
+	contract.Signatures = append(contract.Signatures, s)
+	return contract, nil
+}
+
+// SignOrderAmendmentResponse - add signature to order amendment response
+func (n *OpenBazaarNode) SignOrderAmendmentResponse(contract *pb.RicardianContract) (*pb.RicardianContract, error) {
+	serializedResponse, err := proto.Marshal(contract.OrderAmendmentResponses[0])
+	if err != nil {
+		return contract, err
+	}
+	s := new(pb.Signature)
+	s.Section = pb.Signature_ORDER_AMENDMENT_RESPONSE
+	guidSig, err := n.IpfsNode.PrivateKey.Sign(serializedResponse)
+	if err != nil {
+		return contract, err
+	}
+	s.SignatureBytes = guidSig
+	contract.Signatures = append(contract.Signatures, s)
+	return contract, nil
+}
+
+// ValidateOrderAmendment - validate an amendment received from the other party
+// against our copy of the contract. sigs must be the signatures which
+// accompanied the amendment.
+func (n *OpenBazaarNode) ValidateOrderAmendment(contract *pb.RicardianContract, state pb.OrderState, amendment *pb.OrderAmendment, sigs []*pb.Signature) error {
+	if amendment.Proposer == n.ourOrderParty(contract) {
+		return errors.New("amendment was proposed by our side of the order")
+	}
+	if err := validateOrderAmendment(contract, state, amendment); err != nil {
+		return err
+	}
+	proposer := counterparty(contract, n.ourOrderParty(contract))
+	if err := verifyMessageSignature(amendment, proposer.Pubkeys.Identity, sigs, pb.Signature_ORDER_AMENDMENT, proposer.PeerID); err != nil {
+		switch err.(type) {
+		case noSigError:
+			return errors.New("contract does not contain a signature for the order amendment")
+		case invalidSigError:
+			return errors.New("guid signature on order amendment failed to verify")
+		case matchKeyError:
+			return errors.New("public key in contract does not match the proposer of the amendment")
+		default:
+			return err
+		}
+	}
+
+	payment := amendment.Payment
+	if payment.Method != pb.Order_Payment_MODERATED || payment.Chaincode == pb.OrderPayment(contract).Chaincode {
+		return nil
+	}
+	wal, err := n.Multiwallet.WalletForCurrencyCode(payment.Coin)
+	if err != nil {
+		return err
+	}
+	chaincode, err := hex.DecodeString(payment.Chaincode)
+	if err != nil {
+		return err
+	}
+	addr, redeemScript, moderatorKeys, err := n.moderatedEscrowAddress(contract, wal, chaincode)
+	if err != nil {
+		return err
+	}
+	if payment.Address != addr.EncodeAddress() {
+		return errors.New("invalid payment address")
+	}
+	if payment.RedeemScript != hex.EncodeToString(redeemScript) {
+		return errors.New("invalid redeem script")
+	}
+	if _, keys := pb.PaymentModerators(payment); !equalKeys(keys, moderatorKeys) {
+		return errors.New("invalid moderator key")
+	}
+	return nil
+}
+
+// ValidateOrderAmendmentResponse - validate a response to our amendment
+// against our copy of the contract. sigs must be the signatures which
+// accompanied the response.
+func (n *OpenBazaarNode) ValidateOrderAmendmentResponse(contract *pb.RicardianContract, state pb.OrderState, response *pb.OrderAmendmentResponse, sigs []*pb.Signature) error {
+	if err := validateOrderAmendmentResponse(contract, state, response); err != nil {
+		return err
+	}
+	us := n.ourOrderParty(contract)
+	if contract.OrderAmendments[response.AmendmentIndex].Proposer != us {
+		return errors.New("response is for an amendment we did not propose")
+	}
+	responder := counterparty(contract, us)
+	if err := verifyMessageSignature(response, responder.Pubkeys.Identity, sigs, pb.Signature_ORDER_AMENDMENT_RESPONSE, responder.PeerID); err != nil {
+		switch err.(type) {
+		case noSigError:
+			return errors.New("contract does not contain a signature for the amendment response")
+		case invalidSigError:
+			return errors.New("guid signature on amendment response failed to verify")
+		case matchKeyError:
+			return errors.New("public key in contract does not match the sender of the amendment response")
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+// verifyOrderAmendmentSignatures checks every amendment and response in the
+// contract is signed by the party which sent it. Used by moderators before
+// trusting the amended escrow address in a dispute.
+func verifyOrderAmendmentSignatures(contract *pb.RicardianContract) []string {
+	var validationErrors []string
+	var amendmentSigs, responseSigs []*pb.Signature
+	for _, sig := range contract.Signatures {
+		switch sig.Section {
+		case pb.Signature_ORDER_AMENDMENT:
+			amendmentSigs = append(amendmentSigs, sig)
+		case pb.Signature_ORDER_AMENDMENT_RESPONSE:
+			responseSigs = append(responseSigs, sig)
+		}
+	}
+	if len(amendmentSigs) < len(contract.OrderAmendments) || len(responseSigs) < len(contract.OrderAmendmentResponses) {
+		validationErrors = append(validationErrors, "Not all order amendments are signed")
+		return validationErrors
+	}
+	parties := map[pb.OrderAmendment_Party]*pb.ID{
+		pb.OrderAmendment_BUYER:  contract.BuyerOrder.BuyerID,
+		pb.OrderAmendment_VENDOR: contract.VendorListings[0].VendorID,
+	}
+	for i, a := range contract.OrderAmendments {
+		id := parties[a.Proposer]
+		if err := verifyMessageSignature(a, id.Pubkeys.Identity, []*pb.Signature{amendmentSigs[i]}, pb.Signature_ORDER_AMENDMENT, id.PeerID); err != nil {
+			validationErrors = append(validationErrors, fmt.Sprintf("Invalid signature on order amendment %d", i))
+		}
+	}
+	for i, r := range contract.OrderAmendmentResponses {
+		if int(r.AmendmentIndex) >= len(contract.OrderAmendments) {
+			validationErrors = append(validationErrors, fmt.Sprintf("Order amendment response %d is for an unknown amendment", i))
+			continue
+		}
+		// The response comes from the party which did not propose the amendment
+		id := parties[pb.OrderAmendment_BUYER]
+		if contract.OrderAmendments[r.AmendmentIndex].Proposer == pb.OrderAmendment_BUYER {
+			id = parties[pb.OrderAmendment_VENDOR]
+		}
+		if err := verifyMessageSignature(r, id.Pubkeys.Identity, []*pb.Signature{responseSigs[i]}, pb.Signature_ORDER_AMENDMENT_RESPONSE, id.PeerID); err != nil {
+			validationErrors = append(validationErrors, fmt.Sprintf("Invalid signature on order amendment response %d", i))
+		}
+	}
+	return validationErrors
+}
@@ -0,0 +1,130 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/kimitzu/kimitzu-go/pb"
+)
+
+// newAmendmentTestContract returns an unmoderated order of 2 widgets for 100000
+func newAmendmentTestContract(t *testing.T) *pb.RicardianContract {
+	contract := newLedgerTestContract(t, "USD")
+	contract.BuyerOrder.Payment.Method = pb.Order_Payment_DIRECT
+	contract.BuyerOrder.Payment.Address = "paymentAddress"
+	return contract
+}
+
+func newTestAmendment(contract *pb.RicardianContract, amount, quantity uint64) *pb.OrderAmendment {
+	payment := proto.Clone(pb.OrderPayment(contract)).(*pb.Order_Payment)
+	payment.Amount = amount
+	return &pb.OrderAmendment{
+		Index:     uint32(len(contract.OrderAmendments)),
+		Proposer:  pb.OrderAmendment_VENDOR,
+		Quantity:  quantity,
+		Payment:   payment,
+		Timestamp: ptypes.TimestampNow(),
+	}
+}
+
+func TestValidateOrderAmendment(t *testing.T) {
+	contract := newAmendmentTestContract(t)
+	if err := validateOrderAmendment(contract, pb.OrderState_AWAITING_PAYMENT, newTestAmendment(contract, 90000, 2)); err != nil {
+		t.Fatal(err)
+	}
+	if err := validateOrderAmendment(contract, pb.OrderState_PENDING, newTestAmendment(contract, 100000, 3)); err != nil {
+		t.Error(err)
+	}
+	if err := validateOrderAmendment(contract, pb.OrderState_PENDING, newTestAmendment(contract, 90000, 2)); err != ErrAmendmentFunded {
+		t.Errorf("expected %s, got %v", ErrAmendmentFunded, err)
+	}
+	if err := validateOrderAmendment(contract, pb.OrderState_AWAITING_FULFILLMENT, newTestAmendment(contract, 90000, 2)); err != ErrOrderNotAmendable {
+		t.Errorf("expected %s, got %v", ErrOrderNotAmendable, err)
+	}
+	if err := validateOrderAmendment(contract, pb.OrderState_AWAITING_PAYMENT, newTestAmendment(contract, 100000, 2)); err == nil {
+		t.Error("expected an amendment without changes to fail")
+	}
+
+	amendment := newTestAmendment(contract, 90000, 2)
+	amendment.Payment.Address = "otherAddress"
+	if err := validateOrderAmendment(contract, pb.OrderState_AWAITING_PAYMENT, amendment); err == nil {
+		t.Error("expected a changed payment address to fail")
+	}
+	amendment = newTestAmendment(contract, 90000, 2)
+	amendment.Index = 1
+	if err := validateOrderAmendment(contract, pb.OrderState_AWAITING_PAYMENT, amendment); err == nil {
+		t.Error("expected an out of order index to fail")
+	}
+
+	contract.BuyerOrder.Payment.Method = pb.Order_Payment_MODERATED
+	contract.BuyerOrder.Payment.Moderator = "QmModerator"
+	if err := validateOrderAmendment(contract, pb.OrderState_AWAITING_PAYMENT, newTestAmendment(contract, 90000, 2)); err == nil {
+		t.Error("expected a moderated amendment reusing the escrow address to fail")
+	}
+}
+
+func TestOrderAmendmentStatus(t *testing.T) {
+	contract := newAmendmentTestContract(t)
+	l, item := contract.VendorListings[0], contract.BuyerOrder.Items[0]
+
+	contract.OrderAmendments = append(contract.OrderAmendments, newTestAmendment(contract, 90000, 3))
+	if status := OrderAmendmentStatus(contract, 0); status != "PENDING" {
+		t.Errorf("expected PENDING, got %s", status)
+	}
+	// A counter-offer supersedes the open amendment
+	counter := newTestAmendment(contract, 95000, 3)
+	counter.Proposer = pb.OrderAmendment_BUYER
+	contract.OrderAmendments = append(contract.OrderAmendments, counter)
+	if status := OrderAmendmentStatus(contract, 0); status != "SUPERSEDED" {
+		t.Errorf("expected SUPERSEDED, got %s", status)
+	}
+	if pb.OrderPayment(contract).Amount != 100000 || OrderItemQuantity(contract, l, item) != 2 {
+		t.Error("expected the original terms until an amendment is accepted")
+	}
+
+	contract.OrderAmendmentResponses = append(contract.OrderAmendmentResponses, &pb.OrderAmendmentResponse{AmendmentIndex: 1, Accepted: true})
+	if status := OrderAmendmentStatus(contract, 1); status != "ACCEPTED" {
+		t.Errorf("expected ACCEPTED, got %s", status)
+	}
+	if openOrderAmendment(contract) != nil {
+		t.Error("expected no open amendment")
+	}
+	if pb.OrderPayment(contract).Amount != 95000 || OrderItemQuantity(contract, l, item) != 3 {
+		t.Error("expected the terms of the accepted amendment")
+	}
+
+	contract.OrderAmendments = append(contract.OrderAmendments, newTestAmendment(contract, 80000, 3))
+	contract.OrderAmendmentResponses = append(contract.OrderAmendmentResponses, &pb.OrderAmendmentResponse{AmendmentIndex: 2})
+	if status := OrderAmendmentStatus(contract, 2); status != "DECLINED" {
+		t.Errorf("expected DECLINED, got %s", status)
+	}
+	if pb.OrderPayment(contract).Amount != 95000 {
+		t.Error("expected a declined amendment to keep the accepted terms")
+	}
+}
+
+func TestVerifyOrderAmendmentSignatures(t *testing.T) {
+	contract := newAmendmentTestContract(t)
+	buyerKey, buyerID := newQuoteTestID(t)
+	vendorKey, vendorID := newQuoteTestID(t)
+	contract.BuyerOrder.BuyerID = buyerID
+	contract.VendorListings[0].VendorID = vendorID
+
+	amendment := newTestAmendment(contract, 90000, 2)
+	response := &pb.OrderAmendmentResponse{AmendmentIndex: 0, Accepted: true}
+	contract.OrderAmendments = []*pb.OrderAmendment{amendment}
+	contract.OrderAmendmentResponses = []*pb.OrderAmendmentResponse{response}
+	contract.Signatures = []*pb.Signature{
+		{Section: pb.Signature_ORDER_AMENDMENT, SignatureBytes: signQuoteTestMessage(t, vendorKey, amendment)},
+		{Section: pb.Signature_ORDER_AMENDMENT_RESPONSE, SignatureBytes: signQuoteTestMessage(t, buyerKey, response)},
+	}
+	if errs := verifyOrderAmendmentSignatures(contract); len(errs) > 0 {
+		t.Fatal(errs)
+	}
+
+	amendment.Payment.Amount = 1
+	if errs := verifyOrderAmendmentSignatures(contract); len(errs) != 1 {
+		t.Errorf("expected a tampered amendment to fail, got %v", errs)
+	}
+}
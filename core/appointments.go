@@ -0,0 +1,357 @@
+package core
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	ipnspath "gx/ipfs/QmQAgv6Gaoe2tQpcabqwKXKChp2MZ7i3UXv9DqTTaxCaTR/go-path"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/kimitzu/kimitzu-go/ipfs"
+	"github.com/kimitzu/kimitzu-go/pb"
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+const (
+	// AvailabilityFile - the availability calendar published in the vendor's root
+	AvailabilityFile = "availability.json"
+	// MaxAvailabilityHours - max number of weekly opening hours in a calendar
+	MaxAvailabilityHours = 7 * 8
+	// MaxAvailabilityBlackouts - max number of blackout dates in a calendar
+	MaxAvailabilityBlackouts = 366
+
+	blackoutDateFormat = "2006-01-02"
+)
+
+var (
+	// ErrNoAvailability - the vendor has not published an availability calendar
+	ErrNoAvailability = errors.New("vendor has not published an availability calendar")
+	// ErrSlotUnavailable - the slot is outside the vendor's opening hours or on a blackout date
+	ErrSlotUnavailable = errors.New("the selected time slot is outside the vendor's availability")
+)
+
+// Availability - the vendor's calendar of weekly opening hours in which
+// buyers can book appointments, except on the blackout dates
+type Availability struct {
+	Timezone  string              `json:"timezone"`
+	Weekly    []AvailabilityHours `json:"weekly"`
+	Blackouts []string            `json:"blackouts"`
+}
+
+// AvailabilityHours - the opening hours on a day of the week as HH:MM in the
+// calendar's timezone. An end of 24:00 runs until midnight.
+type AvailabilityHours struct {
+	Day   string `json:"day"`
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// AppointmentData - the time slot a buyer books with the order
+type AppointmentData struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// parseClock returns the minutes since midnight of an HH:MM time
+func parseClock(s string) (int, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 || len(parts[0]) != 2 || len(parts[1]) != 2 {
+		return 0, fmt.Errorf("invalid time %s, expected HH:MM", s)
+	}
+	h, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %s, expected HH:MM", s)
+	}
+	m, err := strconv.Atoi(parts[1])
+	if err != nil || h < 0 || m < 0 || m > 59 || h*60+m > 24*60 {
+		return 0, fmt.Errorf("invalid time %s, expected HH:MM", s)
+	}
+	return h*60 + m, nil
+}
+
+func parseWeekday(s string) (time.Weekday, error) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(d.String(), s) {
+			return d, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid day: %s", s)
+}
+
+// Validate returns an error if the calendar cannot be published
+func (a *Availability) Validate() error {
+	if _, err := time.LoadLocation(a.Timezone); err != nil || a.Timezone == "" {
+		return fmt.Errorf("invalid timezone: %s", a.Timezone)
+	}
+	if len(a.Weekly) > MaxAvailabilityHours {
+		return fmt.Errorf("number of opening hours is greater than the max of %d", MaxAvailabilityHours)
+	}
+	if len(a.Blackouts) > MaxAvailabilityBlackouts {
+		return fmt.Errorf("number of blackout dates is greater than the max of %d", MaxAvailabilityBlackouts)
+	}
+	for _, h := range a.Weekly {
+		if _, err := parseWeekday(h.Day); err != nil {
+			return err
+		}
+		start, err := parseClock(h.Start)
+		if err != nil {
+			return err
+		}
+		end, err := parseClock(h.End)
+		if err != nil {
+			return err
+		}
+		if end <= start {
+			return fmt.Errorf("opening hours on %s end before they start", h.Day)
+		}
+	}
+	for _, d := range a.Blackouts {
+		if _, err := time.Parse(blackoutDateFormat, d); err != nil {
+			return fmt.Errorf("invalid blackout date %s, expected YYYY-MM-DD", d)
+		}
+	}
+	return nil
+}
+
+// Allows returns an error unless [start, end) falls within a single block of
+// opening hours and not on a blackout date
+func (a *Availability) Allows(start, end time.Time) error {
+	if !end.After(start) {
+		return errors.New("appointment must end after it starts")
+	}
+	loc, err := time.LoadLocation(a.Timezone)
+	if err != nil {
+		return err
+	}
+	start, end = start.In(loc), end.In(loc)
+	date := start.Format(blackoutDateFormat)
+	for _, d := range a.Blackouts {
+		if d == date {
+			return ErrSlotUnavailable
+		}
+	}
+
+	// Compare wall clock minutes so opening hours hold across DST changes
+	from := start.Hour()*60 + start.Minute()
+	to := end.Hour()*60 + end.Minute()
+	if end.Format(blackoutDateFormat) != date {
+		midnight := time.Date(start.Year(), start.Month(), start.Day()+1, 0, 0, 0, 0, loc)
+		if !end.Equal(midnight) {
+			return ErrSlotUnavailable
+		}
+		to = 24 * 60
+	}
+	for _, h := range a.Weekly {
+		day, _ := parseWeekday(h.Day)
+		opens, err1 := parseClock(h.Start)
+		closes, err2 := parseClock(h.End)
+		if day == start.Weekday() && err1 == nil && err2 == nil && from >= opens && to <= closes {
+			return nil
+		}
+	}
+	return ErrSlotUnavailable
+}
+
+// GetAvailability returns the availability calendar published by the peer
+func (n *OpenBazaarNode) GetAvailability(peerID string, useCache bool) (*Availability, error) {
+	var (
+		b   []byte
+		err error
+	)
+	if peerID == n.IPFSIdentityString() {
+		b, err = ioutil.ReadFile(path.Join(n.RepoPath, "root", AvailabilityFile))
+		if os.IsNotExist(err) {
+			return nil, ErrNoAvailability
+		}
+	} else {
+		b, err = ipfs.ResolveThenCat(n.IpfsNode, ipnspath.FromString(path.Join(peerID, AvailabilityFile)), time.Minute, n.IPNSQuorumSize, useCache)
+		if err != nil {
+			return nil, ErrNoAvailability
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	availability := new(Availability)
+	if err := json.Unmarshal(b, availability); err != nil {
+		return nil, err
+	}
+	return availability, nil
+}
+
+// SetAvailability validates the calendar and writes it to our root. The node
+// must be seeded afterwards to publish it.
+func (n *OpenBazaarNode) SetAvailability(availability *Availability) error {
+	if err := availability.Validate(); err != nil {
+		return err
+	}
+	out, err := json.MarshalIndent(availability, "", "    ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path.Join(n.RepoPath, "root", AvailabilityFile), out, os.ModePerm)
+}
+
+// addOrderAppointment checks the slot against the vendor's published calendar
+// and adds it to the order
+func (n *OpenBazaarNode) addOrderAppointment(contract *pb.RicardianContract, data *AppointmentData) error {
+	for _, l := range contract.VendorListings {
+		if l.Metadata.ContractType != pb.Listing_Metadata_SERVICE {
+			return errors.New("only service listings can be booked for a time slot")
+		}
+	}
+	if !data.Start.After(time.Now()) {
+		return errors.New("appointment must start in the future")
+	}
+	availability, err := n.GetAvailability(contract.VendorListings[0].VendorID.PeerID, false)
+	if err != nil {
+		return err
+	}
+	if err := availability.Allows(data.Start, data.End); err != nil {
+		return err
+	}
+	appointment := &pb.Order_Appointment{Timezone: availability.Timezone}
+	if appointment.Start, err = ptypes.TimestampProto(data.Start); err != nil {
+		return err
+	}
+	if appointment.End, err = ptypes.TimestampProto(data.End); err != nil {
+		return err
+	}
+	contract.BuyerOrder.Appointment = appointment
+	return nil
+}
+
+// validateAppointment checks the slot booked by an order we received against
+// our calendar and, like the inventory check, against the slots already booked
+func (n *OpenBazaarNode) validateAppointment(contract *pb.RicardianContract, checkBookings bool) error {
+	appointment := contract.BuyerOrder.Appointment
+	if appointment == nil {
+		return nil
+	}
+	for _, l := range contract.VendorListings {
+		if l.Metadata.ContractType != pb.Listing_Metadata_SERVICE {
+			return errors.New("only service listings can be booked for a time slot")
+		}
+	}
+	start, err := ptypes.Timestamp(appointment.Start)
+	if err != nil {
+		return fmt.Errorf("invalid appointment start: %s", err)
+	}
+	end, err := ptypes.Timestamp(appointment.End)
+	if err != nil {
+		return fmt.Errorf("invalid appointment end: %s", err)
+	}
+	if !checkBookings {
+		// Offline orders are accepted as placed, the vendor can still decline them
+		return nil
+	}
+	if !start.After(time.Now()) {
+		return errors.New("appointment must start in the future")
+	}
+	availability, err := n.GetAvailability(n.IPFSIdentityString(), false)
+	if err != nil {
+		return err
+	}
+	if err := availability.Allows(start, end); err != nil {
+		return err
+	}
+	orderID, err := n.CalcOrderID(contract.BuyerOrder)
+	if err != nil {
+		return err
+	}
+	booked, err := n.Datastore.Appointments().GetAll(start, end)
+	if err != nil {
+		return err
+	}
+	for _, b := range booked {
+		if b.IsSale && b.OrderID != orderID {
+			return repo.ErrAppointmentConflict
+		}
+	}
+	return nil
+}
+
+// BookAppointment records the slot booked by the order. Booking a sale fails
+// with repo.ErrAppointmentConflict if another sale holds an overlapping slot.
+func (n *OpenBazaarNode) BookAppointment(contract *pb.RicardianContract, orderID string, isSale bool) error {
+	appointment := contract.BuyerOrder.Appointment
+	if appointment == nil {
+		return nil
+	}
+	start, err := ptypes.Timestamp(appointment.Start)
+	if err != nil {
+		return err
+	}
+	end, err := ptypes.Timestamp(appointment.End)
+	if err != nil {
+		return err
+	}
+	l := contract.VendorListings[0]
+	record := &repo.AppointmentRecord{
+		OrderID:  orderID,
+		Slug:     l.Slug,
+		Title:    l.Item.Title,
+		PeerID:   l.VendorID.PeerID,
+		IsSale:   isSale,
+		Start:    start,
+		End:      end,
+		Timezone: appointment.Timezone,
+	}
+	if isSale {
+		record.PeerID = contract.BuyerOrder.BuyerID.PeerID
+	}
+	return n.Datastore.Appointments().Put(record)
+}
+
+// WriteAppointmentsICS writes the appointments as an iCalendar (RFC 5545) feed
+func WriteAppointmentsICS(w io.Writer, appointments []*repo.AppointmentRecord, now time.Time) error {
+	const stamp = "20060102T150405Z"
+	bw := bufio.NewWriter(w)
+	line := func(s string) {
+		// Content lines are folded at 75 octets without splitting a UTF-8 sequence
+		for len(s) > 75 {
+			i := 75
+			for i > 0 && s[i]&0xc0 == 0x80 {
+				i--
+			}
+			bw.WriteString(s[:i] + "\r\n ")
+			s = s[i:]
+		}
+		bw.WriteString(s + "\r\n")
+	}
+
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:-//Kimitzu//Appointments//EN")
+	line("CALSCALE:GREGORIAN")
+	for _, a := range appointments {
+		party := "Vendor"
+		if a.IsSale {
+			party = "Buyer"
+		}
+		line("BEGIN:VEVENT")
+		line("UID:" + a.OrderID + "@kimitzu")
+		line("DTSTAMP:" + now.UTC().Format(stamp))
+		line("DTSTART:" + a.Start.UTC().Format(stamp))
+		line("DTEND:" + a.End.UTC().Format(stamp))
+		line("SUMMARY:" + icsEscape(a.Title))
+		line("DESCRIPTION:" + icsEscape(fmt.Sprintf("Order %s\n%s: %s", a.OrderID, party, a.PeerID)))
+		line("STATUS:CONFIRMED")
+		line("END:VEVENT")
+	}
+	line("END:VCALENDAR")
+	return bw.Flush()
+}
+
+func icsEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
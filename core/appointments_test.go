@@ -0,0 +1,114 @@
+package core
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+func newTestAvailability() *Availability {
+	return &Availability{
+		Timezone: "Europe/Berlin",
+		Weekly: []AvailabilityHours{
+			{Day: "monday", Start: "09:00", End: "12:00"},
+			{Day: "monday", Start: "13:00", End: "17:00"},
+			{Day: "Saturday", Start: "20:00", End: "24:00"},
+		},
+		Blackouts: []string{"2026-12-28"},
+	}
+}
+
+func TestAvailabilityValidate(t *testing.T) {
+	if err := newTestAvailability().Validate(); err != nil {
+		t.Fatal(err)
+	}
+	for _, a := range []*Availability{
+		{Timezone: "Mars/Olympus"},
+		{Timezone: "UTC", Weekly: []AvailabilityHours{{Day: "funday", Start: "09:00", End: "10:00"}}},
+		{Timezone: "UTC", Weekly: []AvailabilityHours{{Day: "monday", Start: "9:00", End: "10:00"}}},
+		{Timezone: "UTC", Weekly: []AvailabilityHours{{Day: "monday", Start: "10:00", End: "09:00"}}},
+		{Timezone: "UTC", Weekly: []AvailabilityHours{{Day: "monday", Start: "09:00", End: "24:30"}}},
+		{Timezone: "UTC", Blackouts: []string{"28/12/2026"}},
+	} {
+		if err := a.Validate(); err == nil {
+			t.Errorf("expected %+v to be invalid", a)
+		}
+	}
+}
+
+func TestAvailabilityAllows(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("timezone database not available")
+	}
+	slot := func(year int, month time.Month, day, hour, minute int, d time.Duration) [2]time.Time {
+		start := time.Date(year, month, day, hour, minute, 0, 0, berlin)
+		return [2]time.Time{start, start.Add(d)}
+	}
+	a := newTestAvailability()
+
+	tests := []struct {
+		name     string
+		slot     [2]time.Time
+		expected error
+	}{
+		{"within the morning hours", slot(2026, 11, 2, 10, 0, time.Hour), nil},
+		{"across the lunch break", slot(2026, 11, 2, 11, 30, time.Hour), ErrSlotUnavailable},
+		{"on a closed day", slot(2026, 11, 3, 10, 0, time.Hour), ErrSlotUnavailable},
+		{"on a blackout date", slot(2026, 12, 28, 10, 0, time.Hour), ErrSlotUnavailable},
+		{"until midnight", slot(2026, 11, 7, 23, 0, time.Hour), nil},
+		{"past midnight", slot(2026, 11, 7, 23, 30, time.Hour), ErrSlotUnavailable},
+		// Clocks went back on 2026-10-25, opening hours are wall clock times
+		{"after the DST change", slot(2026, 10, 26, 9, 0, 3*time.Hour), nil},
+	}
+	for _, test := range tests {
+		// The slot is given in UTC as the buyer's client would send it
+		if err := a.Allows(test.slot[0].UTC(), test.slot[1].UTC()); err != test.expected {
+			t.Errorf("%s: expected %v, got %v", test.name, test.expected, err)
+		}
+	}
+
+	empty := slot(2026, 11, 2, 10, 0, 0)
+	if err := a.Allows(empty[0], empty[1]); err == nil {
+		t.Error("expected an empty slot to fail")
+	}
+}
+
+func TestWriteAppointmentsICS(t *testing.T) {
+	start := time.Date(2026, 11, 2, 9, 0, 0, 0, time.UTC)
+	appointments := []*repo.AppointmentRecord{{
+		OrderID: "QmOrder",
+		Title:   "Consultation, one hour; " + strings.Repeat("x", 80),
+		PeerID:  "QmBuyer",
+		IsSale:  true,
+		Start:   start,
+		End:     start.Add(time.Hour),
+	}}
+	var buf bytes.Buffer
+	if err := WriteAppointmentsICS(&buf, appointments, start.Add(-24*time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, expected := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"UID:QmOrder@kimitzu\r\n",
+		"DTSTAMP:20261101T090000Z\r\n",
+		"DTSTART:20261102T090000Z\r\n",
+		"DTEND:20261102T100000Z\r\n",
+		`SUMMARY:Consultation\, one hour\; xxx`,
+		`DESCRIPTION:Order QmOrder\nBuyer: QmBuyer` + "\r\n",
+		"END:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("expected %q in\n%s", expected, out)
+		}
+	}
+	for _, line := range strings.Split(out, "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("expected lines to be folded at 75 octets, got %d: %s", len(line), line)
+		}
+	}
+}
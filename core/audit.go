@@ -0,0 +1,73 @@
+package core
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/kimitzu/kimitzu-go/pb"
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+// auditedMessageTypes are the order lifecycle messages recorded in the
+// audit log when we send them
+var auditedMessageTypes = map[pb.Message_MessageType]bool{
+	pb.Message_ORDER:                    true,
+	pb.Message_ORDER_REJECT:             true,
+	pb.Message_ORDER_CANCEL:             true,
+	pb.Message_ORDER_CONFIRMATION:       true,
+	pb.Message_ORDER_FULFILLMENT:        true,
+	pb.Message_ORDER_COMPLETION:         true,
+	pb.Message_DISPUTE_OPEN:             true,
+	pb.Message_DISPUTE_UPDATE:           true,
+	pb.Message_DISPUTE_PANEL_VOTE:       true,
+	pb.Message_DISPUTE_PANEL_PAYOUT:     true,
+	pb.Message_DISPUTE_EVIDENCE:         true,
+	pb.Message_CASE_MESSAGE:             true,
+	pb.Message_DISPUTE_CLOSE:            true,
+	pb.Message_REFUND:                   true,
+	pb.Message_VENDOR_FINALIZED_PAYMENT: true,
+	pb.Message_ORDER_PAYMENT:            true,
+	pb.Message_MILESTONE_RELEASE:        true,
+	pb.Message_PARTIAL_REFUND:           true,
+	pb.Message_TIMESHEET_ENTRY:          true,
+	pb.Message_TIMESHEET_REVIEW:         true,
+	pb.Message_SUBSCRIPTION_UPDATE:      true,
+	pb.Message_QUOTE_REQUEST:            true,
+	pb.Message_QUOTE:                    true,
+	pb.Message_ORDER_AMENDMENT:          true,
+	pb.Message_ORDER_AMENDMENT_RESPONSE: true,
+}
+
+// auditMessage appends an outgoing order lifecycle message to the audit
+// log. delivered is false when the peer could not be reached and the
+// message was handed to the offline message system instead.
+func (n *OpenBazaarNode) auditMessage(peerID string, m *pb.Message, delivered bool, sendErr error) {
+	if !auditedMessageTypes[m.MessageType] {
+		return
+	}
+	record := &repo.AuditRecord{
+		Timestamp: time.Now(),
+		Source:    repo.AuditSourceProtocol,
+		Actor:     "node",
+		Action:    m.MessageType.String(),
+		Target:    peerID,
+		Success:   sendErr == nil,
+	}
+	if b, err := proto.Marshal(m); err == nil {
+		h := sha256.Sum256(b)
+		record.ParamsHash = hex.EncodeToString(h[:])
+	}
+	switch {
+	case sendErr != nil:
+		record.Result = sendErr.Error()
+	case delivered:
+		record.Result = "delivered"
+	default:
+		record.Result = "queued offline"
+	}
+	if err := n.Datastore.AuditLog().Put(record); err != nil {
+		log.Errorf("writing %s message to the audit log: %s", m.MessageType, err.Error())
+	}
+}
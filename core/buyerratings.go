@@ -0,0 +1,197 @@
+package core
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path"
+	"time"
+
+	ipnspath "gx/ipfs/QmQAgv6Gaoe2tQpcabqwKXKChp2MZ7i3UXv9DqTTaxCaTR/go-path"
+	crypto "gx/ipfs/QmTW4SdgBWq9GjsBsHeUx8WuGxzhgzAf88UMH2w62PC8yK/go-libp2p-crypto"
+	peer "gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/kimitzu/kimitzu-go/ipfs"
+	"github.com/kimitzu/kimitzu-go/pb"
+)
+
+// BuyerRatingsIndexFile - the buyer rating index published in the buyer's root
+const BuyerRatingsIndexFile = "buyerratings.json"
+
+// BuyerRatingsResp - the verified ratings of a buyer and their aggregates
+type BuyerRatingsResp struct {
+	PeerID   string                        `json:"peerId"`
+	Count    int                           `json:"count"`
+	Average  int                           `json:"average"`
+	Criteria []*pb.Profile_RatingCriterion `json:"criteria"`
+	Ratings  []*pb.BuyerRating             `json:"ratings"`
+}
+
+// signBuyerRating binds the vendor's rating of the buyer to the order and
+// signs it so the buyer can publish it in its rating index
+func (n *OpenBazaarNode) signBuyerRating(rating *pb.EntityRating, contract *pb.RicardianContract, orderID string, listing *pb.Listing) (*pb.BuyerRating, error) {
+	ts, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	data := &pb.BuyerRating_BuyerRatingData{
+		VendorID:    listing.VendorID,
+		BuyerID:     contract.BuyerOrder.BuyerID,
+		OrderID:     orderID,
+		ListingSlug: listing.Slug,
+		Timestamp:   ts,
+		Rating:      rating,
+	}
+	ser, err := proto.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := n.IpfsNode.PrivateKey.Sign(ser)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.BuyerRating{RatingData: data, Signature: sig}, nil
+}
+
+// ValidateBuyerRating - validates the vendor's signature on a buyer rating
+func ValidateBuyerRating(rating *pb.BuyerRating) (bool, error) {
+	if rating.RatingData == nil || rating.RatingData.Rating == nil || rating.RatingData.VendorID == nil || rating.RatingData.VendorID.Pubkeys == nil || rating.RatingData.BuyerID == nil {
+		return false, errors.New("missing rating data")
+	}
+
+	// Validate the vendor's signature on the rating data
+	vendorKey, err := crypto.UnmarshalPublicKey(rating.RatingData.VendorID.Pubkeys.Identity)
+	if err != nil {
+		return false, err
+	}
+	ser, err := proto.Marshal(rating.RatingData)
+	if err != nil {
+		return false, err
+	}
+	valid, err := vendorKey.Verify(ser, rating.Signature)
+	if !valid || err != nil {
+		return false, errors.New("invalid vendor signature")
+	}
+
+	// Validate vendor peerID matches pubkey
+	id, err := peer.IDB58Decode(rating.RatingData.VendorID.PeerID)
+	if err != nil {
+		return false, err
+	}
+	if !id.MatchesPublicKey(vendorKey) {
+		return false, errors.New("vendor ID does not match public key")
+	}
+
+	if _, err := peer.IDB58Decode(rating.RatingData.BuyerID.PeerID); err != nil {
+		return false, err
+	}
+	if err := ValidateRatingFields(rating.RatingData.Rating.Fields); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// addSignedBuyerRating adds a rating we received from a vendor to our buyer
+// rating index. A rating for an order which is already indexed replaces it.
+func (n *OpenBazaarNode) addSignedBuyerRating(rating *pb.BuyerRating) error {
+	valid, err := ValidateBuyerRating(rating)
+	if !valid || err != nil {
+		return err
+	}
+	if rating.RatingData.BuyerID.PeerID != n.IPFSIdentityString() {
+		return errors.New("buyer rating is not for this node")
+	}
+
+	indexPath := path.Join(n.RepoPath, "root", BuyerRatingsIndexFile)
+	index := new(pb.BuyerRatingIndex)
+	if _, ferr := os.Stat(indexPath); !os.IsNotExist(ferr) {
+		file, err := ioutil.ReadFile(indexPath)
+		if err != nil {
+			return err
+		}
+		if err := jsonpb.UnmarshalString(string(file), index); err != nil {
+			return err
+		}
+	}
+
+	exists := false
+	for i, r := range index.Ratings {
+		if r.RatingData.OrderID == rating.RatingData.OrderID && r.RatingData.VendorID.PeerID == rating.RatingData.VendorID.PeerID {
+			index.Ratings[i] = rating
+			exists = true
+			break
+		}
+	}
+	if !exists {
+		index.Ratings = append(index.Ratings, rating)
+	}
+
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(index)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(indexPath, []byte(out), os.ModePerm)
+}
+
+// GetBuyerRatings fetches the buyer rating index of a peer and returns the
+// ratings which carry a valid vendor signature for that peer
+func (n *OpenBazaarNode) GetBuyerRatings(peerID string, useCache bool) (*BuyerRatingsResp, error) {
+	var (
+		indexBytes []byte
+		err        error
+	)
+	if peerID == n.IPFSIdentityString() {
+		indexBytes, err = ioutil.ReadFile(path.Join(n.RepoPath, "root", BuyerRatingsIndexFile))
+		if os.IsNotExist(err) {
+			indexBytes, err = []byte("{}"), nil
+		}
+	} else {
+		indexBytes, err = ipfs.ResolveThenCat(n.IpfsNode, ipnspath.FromString(path.Join(peerID, BuyerRatingsIndexFile)), time.Minute, n.IPNSQuorumSize, useCache)
+	}
+	if err != nil {
+		return nil, err
+	}
+	index := new(pb.BuyerRatingIndex)
+	if err := jsonpb.UnmarshalString(string(indexBytes), index); err != nil {
+		return nil, err
+	}
+
+	resp := &BuyerRatingsResp{
+		PeerID:   peerID,
+		Criteria: []*pb.Profile_RatingCriterion{},
+		Ratings:  []*pb.BuyerRating{},
+	}
+	var entityRatings []*pb.EntityRating
+	seen := make(map[string]bool)
+	for _, rating := range index.Ratings {
+		valid, err := ValidateBuyerRating(rating)
+		if !valid || err != nil {
+			log.Warningf("skipping invalid buyer rating of %s: %s", peerID, err)
+			continue
+		}
+		if rating.RatingData.BuyerID.PeerID != peerID {
+			continue
+		}
+		// The buyer must not be able to count a rating twice
+		key := rating.RatingData.VendorID.PeerID + rating.RatingData.OrderID
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		resp.Ratings = append(resp.Ratings, rating)
+		entityRatings = append(entityRatings, rating.RatingData.Rating)
+		resp.Criteria = AddRatingToCriteria(resp.Criteria, &pb.Rating_RatingData{Fields: rating.RatingData.Rating.Fields})
+	}
+	resp.Count = len(resp.Ratings)
+	resp.Average = (&KimitzuRatingResp{BuyerRatings: entityRatings}).ComputeAverage()
+	return resp, nil
+}
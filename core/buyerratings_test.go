@@ -0,0 +1,131 @@
+package core_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	crypto "gx/ipfs/QmTW4SdgBWq9GjsBsHeUx8WuGxzhgzAf88UMH2w62PC8yK/go-libp2p-crypto"
+	peer "gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/kimitzu/kimitzu-go/core"
+	"github.com/kimitzu/kimitzu-go/pb"
+	"github.com/kimitzu/kimitzu-go/test"
+)
+
+func newSignedBuyerRating(t *testing.T, vendorKey crypto.PrivKey, buyerID, orderID string, score uint64) *pb.BuyerRating {
+	pubkey, err := crypto.MarshalPublicKey(vendorKey.GetPublic())
+	if err != nil {
+		t.Fatal(err)
+	}
+	vendorID, err := peer.IDFromPublicKey(vendorKey.GetPublic())
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := &pb.BuyerRating_BuyerRatingData{
+		VendorID: &pb.ID{PeerID: vendorID.Pretty(), Pubkeys: &pb.ID_Pubkeys{Identity: pubkey}},
+		BuyerID:  &pb.ID{PeerID: buyerID},
+		OrderID:  orderID,
+		Rating: &pb.EntityRating{
+			Fields: []*pb.EntityRating_RatingFields{
+				{Type: pb.EntityRating_RatingFields_FAST_RESPONSE, Score: score, Weight: 1},
+			},
+		},
+	}
+	ser, err := proto.Marshal(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := vendorKey.Sign(ser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &pb.BuyerRating{RatingData: data, Signature: sig}
+}
+
+func TestValidateBuyerRating(t *testing.T) {
+	vendorKey, _, err := crypto.GenerateKeyPair(crypto.Ed25519, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buyerID := "QmVm8NEnoZnxDBWhyqBKUL6YeWmzeJdZZuBR4Xwa4GQLFL"
+	rating := newSignedBuyerRating(t, vendorKey, buyerID, "order1", 4)
+	if valid, err := core.ValidateBuyerRating(rating); !valid || err != nil {
+		t.Fatalf("expected a valid rating, got %v", err)
+	}
+
+	rating.RatingData.Rating.Fields[0].Score = 5
+	if valid, _ := core.ValidateBuyerRating(rating); valid {
+		t.Error("expected a modified rating to be invalid")
+	}
+
+	otherKey, _, err := crypto.GenerateKeyPair(crypto.Ed25519, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rating = newSignedBuyerRating(t, vendorKey, buyerID, "order1", 4)
+	otherPubkey, err := crypto.MarshalPublicKey(otherKey.GetPublic())
+	if err != nil {
+		t.Fatal(err)
+	}
+	rating.RatingData.VendorID.Pubkeys.Identity = otherPubkey
+	if valid, _ := core.ValidateBuyerRating(rating); valid {
+		t.Error("expected a rating with a substituted vendor key to be invalid")
+	}
+}
+
+func TestOpenBazaarNode_GetBuyerRatings(t *testing.T) {
+	node, err := test.NewNode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	vendorKey, _, err := crypto.GenerateKeyPair(crypto.Ed25519, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buyerID := node.IPFSIdentityString()
+
+	ratings, err := node.GetBuyerRatings(buyerID, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ratings.Count != 0 || len(ratings.Ratings) != 0 {
+		t.Fatalf("expected no ratings, got %+v", ratings)
+	}
+
+	forged := newSignedBuyerRating(t, vendorKey, buyerID, "order3", 5)
+	forged.RatingData.Rating.Fields[0].Score = 1
+	index := &pb.BuyerRatingIndex{
+		Ratings: []*pb.BuyerRating{
+			newSignedBuyerRating(t, vendorKey, buyerID, "order1", 5),
+			newSignedBuyerRating(t, vendorKey, buyerID, "order2", 3),
+			newSignedBuyerRating(t, vendorKey, buyerID, "order2", 3),
+			newSignedBuyerRating(t, vendorKey, "QmVm8NEnoZnxDBWhyqBKUL6YeWmzeJdZZuBR4Xwa4GQLFL", "order4", 1),
+			forged,
+		},
+	}
+	out, err := new(jsonpb.Marshaler).MarshalToString(index)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path.Join(node.RepoPath, "root", core.BuyerRatingsIndexFile), []byte(out), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	ratings, err = node.GetBuyerRatings(buyerID, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ratings.Count != 2 || len(ratings.Ratings) != 2 {
+		t.Fatalf("expected only the two distinct valid ratings, got %d", ratings.Count)
+	}
+	if ratings.Average != 80 {
+		t.Errorf("expected an average of 80, got %d", ratings.Average)
+	}
+	if len(ratings.Criteria) == 0 || ratings.Criteria[0].Criterion != pb.EntityRating_RatingFields_FAST_RESPONSE.String() || ratings.Criteria[0].Average != 4 {
+		t.Errorf("unexpected criteria: %+v", ratings.Criteria)
+	}
+}
@@ -0,0 +1,429 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	libp2p "gx/ipfs/QmTW4SdgBWq9GjsBsHeUx8WuGxzhgzAf88UMH2w62PC8yK/go-libp2p-crypto"
+	mh "gx/ipfs/QmerPMzPk1mJVowm8KgmoknWa4yCYvvugMPsgWmDNUvDLW/go-multihash"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/kimitzu/kimitzu-go/pb"
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+// CaseMessageMaxCharacters - limit for a message posted to a dispute
+const CaseMessageMaxCharacters = ChatMessageMaxCharacters
+
+var (
+	// ErrCaseClosed - messages can only be posted while the dispute is open
+	ErrCaseClosed = errors.New("messages can only be posted to an open dispute")
+	// ErrCaseChannel - the peer is not a member of the channel
+	ErrCaseChannel = errors.New("not a member of the case channel")
+	// ErrCaseThreadNotFound - no thread with the ID was started in the channel
+	ErrCaseThreadNotFound = errors.New("thread not found")
+)
+
+// CaseRole - the part a peer plays in a dispute
+type CaseRole string
+
+const (
+	// CaseRoleBuyer - the buyer of the disputed order
+	CaseRoleBuyer CaseRole = "BUYER"
+	// CaseRoleVendor - the vendor of the disputed order
+	CaseRoleVendor CaseRole = "VENDOR"
+	// CaseRoleModerator - the moderator, or a member of the moderator panel, of the disputed order
+	CaseRoleModerator CaseRole = "MODERATOR"
+)
+
+// CaseThread - the messages of a thread in a channel of a dispute. The
+// thread is identified by the ID of its first message.
+type CaseThread struct {
+	ID       string
+	Channel  pb.CaseMessage_Channel
+	Messages []*pb.SignedCaseMessage
+}
+
+// caseRole returns the role the peer plays in the dispute of the order
+func caseRole(contract *pb.RicardianContract, peerID string) (CaseRole, bool) {
+	switch {
+	case contract.BuyerOrder.BuyerID.PeerID == peerID:
+		return CaseRoleBuyer, true
+	case contract.VendorListings[0].VendorID.PeerID == peerID:
+		return CaseRoleVendor, true
+	case pb.IsPaymentModerator(pb.OrderPayment(contract), peerID):
+		return CaseRoleModerator, true
+	}
+	return "", false
+}
+
+// inCaseChannel reports whether a participant with the role can read and
+// post to the channel. The moderators see every channel while the buyer and
+// the vendor only see the shared channel and their own private one.
+func inCaseChannel(role CaseRole, channel pb.CaseMessage_Channel) bool {
+	switch channel {
+	case pb.CaseMessage_CASE:
+		return true
+	case pb.CaseMessage_BUYER:
+		return role == CaseRoleBuyer || role == CaseRoleModerator
+	case pb.CaseMessage_VENDOR:
+		return role == CaseRoleVendor || role == CaseRoleModerator
+	case pb.CaseMessage_MODERATORS:
+		return role == CaseRoleModerator
+	}
+	return false
+}
+
+// caseChannelMembers returns the IDs of the participants in the channel
+func caseChannelMembers(contract *pb.RicardianContract, channel pb.CaseMessage_Channel) []*pb.ID {
+	var members []*pb.ID
+	if inCaseChannel(CaseRoleBuyer, channel) {
+		members = append(members, contract.BuyerOrder.BuyerID)
+	}
+	if inCaseChannel(CaseRoleVendor, channel) {
+		members = append(members, contract.VendorListings[0].VendorID)
+	}
+	moderators, _ := pb.PaymentModerators(pb.OrderPayment(contract))
+	for _, m := range moderators {
+		members = append(members, &pb.ID{PeerID: m})
+	}
+	return members
+}
+
+// caseMessageID returns the ID of the message, the multihash of the message
+// serialized without its ID
+func caseMessageID(message *pb.CaseMessage) (string, error) {
+	m := proto.Clone(message).(*pb.CaseMessage)
+	m.MessageId = ""
+	ser, err := proto.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+	h, err := mh.Sum(ser, mh.SHA2_256, -1)
+	if err != nil {
+		return "", err
+	}
+	return h.B58String(), nil
+}
+
+// validateCaseMessage checks a message posted to the dispute of the order
+// and the signature of its sender
+func validateCaseMessage(contract *pb.RicardianContract, signed *pb.SignedCaseMessage) error {
+	message := signed.Message
+	if message == nil {
+		return errors.New("case message is empty")
+	}
+	if message.OrderId == "" {
+		return errors.New("case message is missing the order ID")
+	}
+	if _, ok := pb.CaseMessage_Channel_name[int32(message.Channel)]; !ok {
+		return errors.New("case message channel is invalid")
+	}
+	if message.Message == "" {
+		return errors.New("case message is empty")
+	}
+	if len(message.Message) > CaseMessageMaxCharacters {
+		return fmt.Errorf("case message is longer than the max of %d characters", CaseMessageMaxCharacters)
+	}
+	if message.Timestamp == nil {
+		return errors.New("case message is missing a timestamp")
+	}
+	if id, err := caseMessageID(message); err != nil || id != message.MessageId {
+		return errors.New("case message ID does not match its content")
+	}
+
+	role, ok := caseRole(contract, signed.SenderID)
+	if !ok {
+		return errors.New("case message sender is not a participant in the dispute")
+	}
+	if !inCaseChannel(role, message.Channel) {
+		return ErrCaseChannel
+	}
+	if err := verifySignature(message, signed.SenderPubkey, signed.Signature, signed.SenderID); err != nil {
+		switch err.(type) {
+		case invalidSigError:
+			return errors.New("sender's guid signature on case message failed to verify")
+		case matchKeyError:
+			return errors.New("public key in case message does not match the sender")
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+// disputeContract returns our copy of the contract of a disputed order, the
+// state of the dispute and the role we play in it
+func (n *OpenBazaarNode) disputeContract(orderID string) (*pb.RicardianContract, pb.OrderState, CaseRole, error) {
+	if dispute, err := n.Datastore.Cases().GetByCaseID(orderID); err == nil {
+		contract := dispute.BuyerContract
+		if contract == nil {
+			contract = dispute.VendorContract
+		}
+		if contract == nil {
+			return nil, dispute.OrderState, CaseRoleModerator, ErrCaseNotFound
+		}
+		return contract, dispute.OrderState, CaseRoleModerator, nil
+	}
+	if contract, state, _, _, _, _, err := n.Datastore.Purchases().GetByOrderId(orderID); err == nil && contract.Dispute != nil {
+		return contract, state, CaseRoleBuyer, nil
+	}
+	if contract, state, _, _, _, _, err := n.Datastore.Sales().GetByOrderId(orderID); err == nil && contract.Dispute != nil {
+		return contract, state, CaseRoleVendor, nil
+	}
+	return nil, pb.OrderState_PENDING, "", ErrCaseNotFound
+}
+
+// PostCaseMessage - sign a message, send it to the other participants in the
+// channel of the dispute and save it with the case. A message starts a new
+// thread unless threadID is the ID of the first message of a thread in the
+// channel.
+func (n *OpenBazaarNode) PostCaseMessage(orderID string, channel pb.CaseMessage_Channel, threadID, text string) (*pb.SignedCaseMessage, error) {
+	contract, state, role, err := n.disputeContract(orderID)
+	if err != nil {
+		return nil, err
+	}
+	if state != pb.OrderState_DISPUTED {
+		return nil, ErrCaseClosed
+	}
+	if !inCaseChannel(role, channel) {
+		return nil, ErrCaseChannel
+	}
+	if threadID != "" {
+		messages, err := n.Datastore.Cases().GetMessages(orderID)
+		if err != nil {
+			return nil, err
+		}
+		found := false
+		for _, m := range messages {
+			if m.Message.MessageId == threadID && m.Message.Channel == channel && m.Message.ThreadId == "" {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, ErrCaseThreadNotFound
+		}
+	}
+
+	message := &pb.CaseMessage{
+		OrderId:   orderID,
+		Channel:   channel,
+		ThreadId:  threadID,
+		Message:   text,
+		Timestamp: ptypes.TimestampNow(),
+	}
+	message.MessageId, err = caseMessageID(message)
+	if err != nil {
+		return nil, err
+	}
+	ser, err := proto.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := n.IpfsNode.PrivateKey.Sign(ser)
+	if err != nil {
+		return nil, err
+	}
+	pubkey, err := libp2p.MarshalPublicKey(n.IpfsNode.PrivateKey.GetPublic())
+	if err != nil {
+		return nil, err
+	}
+	signed := &pb.SignedCaseMessage{
+		Message:      message,
+		SenderID:     n.IpfsNode.Identity.Pretty(),
+		SenderPubkey: pubkey,
+		Signature:    sig,
+	}
+	if err := validateCaseMessage(contract, signed); err != nil {
+		return nil, err
+	}
+
+	for _, member := range caseChannelMembers(contract, channel) {
+		if member.PeerID == signed.SenderID {
+			continue
+		}
+		var k *libp2p.PubKey
+		if member.Pubkeys != nil {
+			key, err := libp2p.UnmarshalPublicKey(member.Pubkeys.Identity)
+			if err != nil {
+				return nil, err
+			}
+			k = &key
+		}
+		if err := n.SendCaseMessage(member.PeerID, k, signed); err != nil {
+			log.Errorf("failed sending case message for order (%s) to %s: %s", orderID, member.PeerID, err)
+		}
+	}
+	return signed, n.Datastore.Cases().PutMessage(orderID, signed)
+}
+
+// ProcessCaseMessage saves a message another participant posted to a
+// channel of a dispute we take part in
+func (n *OpenBazaarNode) ProcessCaseMessage(signed *pb.SignedCaseMessage, peerID string) error {
+	if signed.Message == nil {
+		return errors.New("received CASE_MESSAGE message with no CaseMessage object")
+	}
+	if signed.SenderID != peerID {
+		return errors.New("case message was not posted by the peer who sent it")
+	}
+	message := signed.Message
+	// The message may have overtaken the dispute itself
+	contract, _, role, err := n.disputeContract(message.OrderId)
+	if err != nil {
+		return err
+	}
+	if err := validateCaseMessage(contract, signed); err != nil {
+		return err
+	}
+	if !inCaseChannel(role, message.Channel) {
+		return ErrCaseChannel
+	}
+	if err := n.Datastore.Cases().PutMessage(message.OrderId, signed); err != nil {
+		return err
+	}
+
+	switch role {
+	case CaseRoleModerator:
+		n.Datastore.Cases().MarkAsUnread(message.OrderId)
+	case CaseRoleBuyer:
+		n.Datastore.Purchases().MarkAsUnread(message.OrderId)
+	case CaseRoleVendor:
+		n.Datastore.Sales().MarkAsUnread(message.OrderId)
+	}
+
+	senderRole, _ := caseRole(contract, signed.SenderID)
+	var handle string
+	if senderRole == CaseRoleBuyer {
+		handle = contract.BuyerOrder.BuyerID.Handle
+	} else if senderRole == CaseRoleVendor {
+		handle = contract.VendorListings[0].VendorID.Handle
+	}
+	var thumbnail repo.Thumbnail
+	if len(contract.VendorListings) > 0 && contract.VendorListings[0].Item != nil && len(contract.VendorListings[0].Item.Images) > 0 {
+		thumbnail = repo.Thumbnail{Tiny: contract.VendorListings[0].Item.Images[0].Tiny, Small: contract.VendorListings[0].Item.Images[0].Small}
+	}
+	notif := repo.CaseMessageNotification{
+		ID:         repo.NewNotificationID(),
+		Type:       repo.NotifierTypeCaseMessageNotification,
+		OrderId:    message.OrderId,
+		MessageId:  message.MessageId,
+		ThreadId:   message.ThreadId,
+		Channel:    message.Channel.String(),
+		PeerID:     signed.SenderID,
+		PeerHandle: handle,
+		Role:       string(senderRole),
+		Message:    message.Message,
+		Thumbnail:  thumbnail,
+	}
+	n.Broadcast <- notif
+	n.Datastore.Notifications().PutRecord(repo.NewNotification(notif, time.Now(), false))
+	return nil
+}
+
+// CaseMessages returns the messages posted to the channels of the dispute we
+// can read, in the order they were sent, along with the role of every
+// participant in the dispute by peer ID
+func (n *OpenBazaarNode) CaseMessages(orderID string) ([]*pb.SignedCaseMessage, map[string]CaseRole, error) {
+	contract, _, role, err := n.disputeContract(orderID)
+	if err != nil {
+		return nil, nil, err
+	}
+	participants := make(map[string]CaseRole)
+	for _, member := range caseChannelMembers(contract, pb.CaseMessage_CASE) {
+		participants[member.PeerID], _ = caseRole(contract, member.PeerID)
+	}
+	messages, err := n.Datastore.Cases().GetMessages(orderID)
+	if err != nil {
+		return nil, nil, err
+	}
+	var ret []*pb.SignedCaseMessage
+	for _, m := range messages {
+		if inCaseChannel(role, m.Message.Channel) {
+			ret = append(ret, m)
+		}
+	}
+	return ret, participants, nil
+}
+
+// CaseThreads groups messages into their threads. Threads are ordered by
+// their first message and replies to a thread we do not have the first
+// message of are kept in a thread of their own.
+func CaseThreads(messages []*pb.SignedCaseMessage) []*CaseThread {
+	var threads []*CaseThread
+	byID := make(map[string]*CaseThread)
+	for _, m := range messages {
+		id := m.Message.ThreadId
+		if id == "" {
+			id = m.Message.MessageId
+		}
+		key := m.Message.Channel.String() + id
+		thread, ok := byID[key]
+		if !ok {
+			thread = &CaseThread{ID: id, Channel: m.Message.Channel}
+			byID[key] = thread
+			threads = append(threads, thread)
+		}
+		thread.Messages = append(thread.Messages, m)
+	}
+	for _, t := range threads {
+		sort.SliceStable(t.Messages, func(i, j int) bool {
+			// The first message of the thread stays first
+			if t.Messages[j].Message.MessageId == t.ID {
+				return false
+			}
+			if t.Messages[i].Message.MessageId == t.ID {
+				return true
+			}
+			ti, _ := ptypes.Timestamp(t.Messages[i].Message.Timestamp)
+			tj, _ := ptypes.Timestamp(t.Messages[j].Message.Timestamp)
+			return ti.Before(tj)
+		})
+	}
+	return threads
+}
+
+// CaseFile returns the exportable record of a dispute we take part in: the
+// contracts we hold, the resolution, the evidence and the signed messages
+// we can read
+func (n *OpenBazaarNode) CaseFile(orderID string) (*pb.CaseFile, error) {
+	contract, state, role, err := n.disputeContract(orderID)
+	if err != nil {
+		return nil, err
+	}
+	file := &pb.CaseFile{
+		OrderId:   orderID,
+		Role:      string(role),
+		State:     state,
+		Timestamp: ptypes.TimestampNow(),
+	}
+	switch role {
+	case CaseRoleModerator:
+		buyerContract, vendorContract, _, _, _, _, _, _, claim, resolution, err := n.Datastore.Cases().GetCaseMetadata(orderID)
+		if err != nil {
+			return nil, err
+		}
+		file.BuyerContract, file.VendorContract = buyerContract, vendorContract
+		file.Claim, file.Resolution = claim, resolution
+	case CaseRoleBuyer:
+		file.BuyerContract = contract
+	case CaseRoleVendor:
+		file.VendorContract = contract
+	}
+	if file.Claim == "" && contract.Dispute != nil {
+		file.Claim = contract.Dispute.Claim
+	}
+	if file.Resolution == nil {
+		file.Resolution = contract.DisputeResolution
+	}
+	if file.Evidence, err = n.DisputeEvidence(orderID); err != nil {
+		return nil, err
+	}
+	if file.Messages, _, err = n.CaseMessages(orderID); err != nil {
+		return nil, err
+	}
+	return file, nil
+}
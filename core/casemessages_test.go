@@ -0,0 +1,158 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	crypto "gx/ipfs/QmTW4SdgBWq9GjsBsHeUx8WuGxzhgzAf88UMH2w62PC8yK/go-libp2p-crypto"
+	peer "gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/kimitzu/kimitzu-go/pb"
+)
+
+// newCaseTestContract returns an order moderated by a panel of 2 along with
+// the keys of the buyer, the vendor and the panel members
+func newCaseTestContract(t *testing.T) (*pb.RicardianContract, crypto.PrivKey, crypto.PrivKey, []crypto.PrivKey) {
+	contract := newLedgerTestContract(t, "USD")
+	buyerKey, buyerID := newQuoteTestID(t)
+	vendorKey, vendorID := newQuoteTestID(t)
+	contract.BuyerOrder.BuyerID = buyerID
+	contract.VendorListings[0].VendorID = vendorID
+	payment, moderatorKeys := newTestModeratorPanel(t, 2, 2)
+	contract.BuyerOrder.Payment = payment
+	return contract, buyerKey, vendorKey, moderatorKeys
+}
+
+func newTestCaseMessage(t *testing.T, key crypto.PrivKey, channel pb.CaseMessage_Channel, threadID string, sent time.Time) *pb.SignedCaseMessage {
+	ts, err := ptypes.TimestampProto(sent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	message := &pb.CaseMessage{
+		OrderId:   "QmOrder",
+		Channel:   channel,
+		ThreadId:  threadID,
+		Message:   "message sent at " + sent.String(),
+		Timestamp: ts,
+	}
+	if message.MessageId, err = caseMessageID(message); err != nil {
+		t.Fatal(err)
+	}
+	pubkey, err := crypto.MarshalPublicKey(key.GetPublic())
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := peer.IDFromPublicKey(key.GetPublic())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &pb.SignedCaseMessage{
+		Message:      message,
+		SenderID:     id.Pretty(),
+		SenderPubkey: pubkey,
+		Signature:    signQuoteTestMessage(t, key, message),
+	}
+}
+
+func TestCaseChannelMembers(t *testing.T) {
+	contract, _, _, _ := newCaseTestContract(t)
+	buyer := contract.BuyerOrder.BuyerID.PeerID
+	vendor := contract.VendorListings[0].VendorID.PeerID
+	moderators := contract.BuyerOrder.Payment.ModeratorPanel.Moderators
+
+	expected := map[pb.CaseMessage_Channel][]string{
+		pb.CaseMessage_CASE:       {buyer, vendor, moderators[0], moderators[1]},
+		pb.CaseMessage_BUYER:      {buyer, moderators[0], moderators[1]},
+		pb.CaseMessage_VENDOR:     {vendor, moderators[0], moderators[1]},
+		pb.CaseMessage_MODERATORS: {moderators[0], moderators[1]},
+	}
+	for channel, peers := range expected {
+		var members []string
+		for _, m := range caseChannelMembers(contract, channel) {
+			members = append(members, m.PeerID)
+		}
+		if !equalStrings(members, peers) {
+			t.Errorf("expected %s channel members %v, got %v", channel, peers, members)
+		}
+	}
+	if _, ok := caseRole(contract, "QmOutsider"); ok {
+		t.Error("expected an outsider to have no role in the dispute")
+	}
+}
+
+func TestValidateCaseMessage(t *testing.T) {
+	contract, buyerKey, vendorKey, moderatorKeys := newCaseTestContract(t)
+	now := time.Now()
+
+	for _, m := range []*pb.SignedCaseMessage{
+		newTestCaseMessage(t, buyerKey, pb.CaseMessage_CASE, "", now),
+		newTestCaseMessage(t, buyerKey, pb.CaseMessage_BUYER, "", now),
+		newTestCaseMessage(t, vendorKey, pb.CaseMessage_VENDOR, "", now),
+		newTestCaseMessage(t, moderatorKeys[1], pb.CaseMessage_BUYER, "", now),
+		newTestCaseMessage(t, moderatorKeys[0], pb.CaseMessage_MODERATORS, "", now),
+	} {
+		if err := validateCaseMessage(contract, m); err != nil {
+			t.Errorf("expected a message to the %s channel to be valid, got %s", m.Message.Channel, err)
+		}
+	}
+
+	if err := validateCaseMessage(contract, newTestCaseMessage(t, vendorKey, pb.CaseMessage_BUYER, "", now)); err != ErrCaseChannel {
+		t.Errorf("expected %s, got %v", ErrCaseChannel, err)
+	}
+	if err := validateCaseMessage(contract, newTestCaseMessage(t, buyerKey, pb.CaseMessage_MODERATORS, "", now)); err != ErrCaseChannel {
+		t.Errorf("expected %s, got %v", ErrCaseChannel, err)
+	}
+	outsiderKey, _ := newQuoteTestID(t)
+	if err := validateCaseMessage(contract, newTestCaseMessage(t, outsiderKey, pb.CaseMessage_CASE, "", now)); err == nil {
+		t.Error("expected a message from outside the dispute to fail")
+	}
+
+	m := newTestCaseMessage(t, buyerKey, pb.CaseMessage_CASE, "", now)
+	m.Message.Message = "tampered"
+	if err := validateCaseMessage(contract, m); err == nil {
+		t.Error("expected a tampered message to fail")
+	}
+	m = newTestCaseMessage(t, buyerKey, pb.CaseMessage_CASE, "", now)
+	m.SenderID = contract.VendorListings[0].VendorID.PeerID
+	if err := validateCaseMessage(contract, m); err == nil {
+		t.Error("expected a message claiming another sender to fail")
+	}
+	m = newTestCaseMessage(t, buyerKey, pb.CaseMessage_CASE, "", now)
+	m.Signature = signQuoteTestMessage(t, vendorKey, m.Message)
+	if err := validateCaseMessage(contract, m); err == nil {
+		t.Error("expected a message signed by another participant to fail")
+	}
+}
+
+func TestCaseThreads(t *testing.T) {
+	contract, buyerKey, vendorKey, moderatorKeys := newCaseTestContract(t)
+	now := time.Now()
+	root := newTestCaseMessage(t, moderatorKeys[0], pb.CaseMessage_CASE, "", now)
+	reply := newTestCaseMessage(t, buyerKey, pb.CaseMessage_CASE, root.Message.MessageId, now.Add(-time.Second))
+	private := newTestCaseMessage(t, vendorKey, pb.CaseMessage_VENDOR, "", now.Add(time.Minute))
+	orphan := newTestCaseMessage(t, vendorKey, pb.CaseMessage_CASE, "QmMissingRoot", now.Add(2*time.Minute))
+	lateReply := newTestCaseMessage(t, vendorKey, pb.CaseMessage_CASE, root.Message.MessageId, now.Add(3*time.Minute))
+	for _, m := range []*pb.SignedCaseMessage{root, reply, private, lateReply} {
+		if err := validateCaseMessage(contract, m); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	threads := CaseThreads([]*pb.SignedCaseMessage{reply, root, private, orphan, lateReply})
+	if len(threads) != 3 {
+		t.Fatalf("expected 3 threads, got %d", len(threads))
+	}
+	if threads[0].ID != root.Message.MessageId || threads[0].Channel != pb.CaseMessage_CASE {
+		t.Errorf("expected the first thread to be started by the moderator's message")
+	}
+	if len(threads[0].Messages) != 3 || threads[0].Messages[0] != root || threads[0].Messages[1] != reply || threads[0].Messages[2] != lateReply {
+		t.Error("expected the first message of the thread followed by the replies in the order they were sent")
+	}
+	if threads[1].ID != private.Message.MessageId || threads[1].Channel != pb.CaseMessage_VENDOR {
+		t.Error("expected the vendor's private message to start a thread")
+	}
+	if threads[2].ID != "QmMissingRoot" || len(threads[2].Messages) != 1 {
+		t.Error("expected a reply to a missing thread to be kept in a thread of its own")
+	}
+}
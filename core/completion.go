@@ -31,6 +31,16 @@ const (
 	ReviewMaxCharacters = 3000
 )
 
+// Rating criteria aggregated alongside the rating field types
+const (
+	RatingCriterionQuality         = "QUALITY"
+	RatingCriterionDescription     = "DESCRIPTION"
+	RatingCriterionDeliverySpeed   = "DELIVERY_SPEED"
+	RatingCriterionCustomerService = "CUSTOMER_SERVICE"
+	// RatingCriterionWeighted - the weighted score of the rating fields
+	RatingCriterionWeighted = "WEIGHTED"
+)
+
 // OrderRatings - record ratings for an order
 type OrderRatings struct {
 	OrderID string       `json:"orderId"`
@@ -47,6 +57,8 @@ type RatingData struct {
 	CustomerService int    `json:"customerService"`
 	Review          string `json:"review"`
 	Anonymous       bool   `json:"anonymous"`
+
+	Fields []*pb.EntityRating_RatingFields `json:"fields"`
 }
 
 // KimitzuRatingResp - additional ratings
@@ -98,11 +110,12 @@ func (r *KimitzuRatingResp) ComputeAverage() int {
 
 // SavedRating - represent saved rating
 type SavedRating struct {
-	Slug    string          `json:"slug"`
-	Count   int             `json:"count"`
-	Average float32         `json:"average"`
-	Ratings []string        `json:"ratings"`
-	Kimitzu   KimitzuRatingResp `json:"kimitzu"`
+	Slug     string                        `json:"slug"`
+	Count    int                           `json:"count"`
+	Average  float32                       `json:"average"`
+	Criteria []*pb.Profile_RatingCriterion `json:"criteria,omitempty"`
+	Ratings  []string                      `json:"ratings"`
+	Kimitzu  KimitzuRatingResp             `json:"kimitzu"`
 }
 
 // CompleteOrder - complete the order
@@ -115,9 +128,11 @@ func (n *OpenBazaarNode) CompleteOrder(orderRatings *OrderRatings, contract *pb.
 
 	vofCount := len(contract.VendorOrderFulfillment)
 	bRating := new(pb.EntityRating)
+	var signedBRating *pb.BuyerRating
 	hasBuyerRating := false
 	if vofCount != 0 {
 		bRating = contract.VendorOrderFulfillment[vofCount-1].BuyerRating
+		signedBRating = contract.VendorOrderFulfillment[vofCount-1].SignedBuyerRating
 		if bRating != nil {
 			hasBuyerRating = true
 		}
@@ -190,6 +205,7 @@ func (n *OpenBazaarNode) CompleteOrder(orderRatings *OrderRatings, contract *pb.
 		rd.CustomerService = uint32(r.CustomerService)
 		rd.DeliverySpeed = uint32(r.DeliverySpeed)
 		rd.Review = r.Review
+		rd.Fields = r.Fields
 
 		ts, err := ptypes.TimestampProto(time.Now())
 		if err != nil {
@@ -250,16 +266,17 @@ func (n *OpenBazaarNode) CompleteOrder(orderRatings *OrderRatings, contract *pb.
 			}
 		}
 
-		payoutAddress, err := wal.DecodeAddress(contract.VendorOrderFulfillment[0].Payout.PayoutAddress)
+		payout := PayoutFulfillment(contract).Payout
+		payoutAddress, err := wal.DecodeAddress(payout.PayoutAddress)
 		if err != nil {
 			return err
 		}
-		var output = wallet.TransactionOutput{
-			Address: payoutAddress,
-			Value:   outValue,
+		outputs, err := PayoutOutputs(wal, contract, outValue, payoutAddress, payout.PayoutFeePerByte)
+		if err != nil {
+			return err
 		}
 
-		chaincode, err := hex.DecodeString(contract.BuyerOrder.Payment.Chaincode)
+		chaincode, err := hex.DecodeString(pb.OrderPayment(contract).Chaincode)
 		if err != nil {
 			return err
 		}
@@ -271,12 +288,12 @@ func (n *OpenBazaarNode) CompleteOrder(orderRatings *OrderRatings, contract *pb.
 		if err != nil {
 			return err
 		}
-		redeemScript, err := hex.DecodeString(contract.BuyerOrder.Payment.RedeemScript)
+		redeemScript, err := hex.DecodeString(pb.OrderPayment(contract).RedeemScript)
 		if err != nil {
 			return err
 		}
 
-		buyerSignatures, err := wal.CreateMultisigSignature(ins, []wallet.TransactionOutput{output}, buyerKey, redeemScript, contract.VendorOrderFulfillment[0].Payout.PayoutFeePerByte)
+		buyerSignatures, err := wal.CreateMultisigSignature(ins, outputs, buyerKey, redeemScript, payout.PayoutFeePerByte)
 		if err != nil {
 			return err
 		}
@@ -289,11 +306,11 @@ func (n *OpenBazaarNode) CompleteOrder(orderRatings *OrderRatings, contract *pb.
 		}
 		oc.PayoutSigs = pbSigs
 		var vendorSignatures []wallet.Signature
-		for _, s := range contract.VendorOrderFulfillment[0].Payout.Sigs {
+		for _, s := range payout.Sigs {
 			sig := wallet.Signature{InputIndex: s.InputIndex, Signature: s.Signature}
 			vendorSignatures = append(vendorSignatures, sig)
 		}
-		_, err = wal.Multisign(ins, []wallet.TransactionOutput{output}, buyerSignatures, vendorSignatures, redeemScript, contract.VendorOrderFulfillment[0].Payout.PayoutFeePerByte, true)
+		err = MultisignEscrow(wal, pb.OrderPayment(contract), ins, outputs, buyerSignatures, vendorSignatures, redeemScript, payout.PayoutFeePerByte)
 		if err != nil {
 			return err
 		}
@@ -303,6 +320,13 @@ func (n *OpenBazaarNode) CompleteOrder(orderRatings *OrderRatings, contract *pb.
 	rc.BuyerOrderCompletion = oc
 
 	if hasBuyerRating {
+		// Vendors running an older version do not sign the rating, the
+		// signed index is published by the seed in addBuyerRating
+		if signedBRating != nil {
+			if err := n.addSignedBuyerRating(signedBRating); err != nil {
+				log.Errorf("failed to index signed buyer rating for order %s: %s", orderID, err)
+			}
+		}
 		err = n.addBuyerRating(bRating)
 		if err != nil {
 			fmt.Println("Buyer rating error!")
@@ -333,6 +357,7 @@ func (n *OpenBazaarNode) CompleteOrder(orderRatings *OrderRatings, contract *pb.
 		fmt.Println("Failed to put putchases in datastore")
 		return err
 	}
+	RecordExchangeRates(n.Datastore, n.Multiwallet, contract.BuyerOrder.Payment.Coin, repo.ExchangeRateReasonReleased, orderID)
 
 	return nil
 }
@@ -369,6 +394,9 @@ func (n *OpenBazaarNode) DisputeIsActive(contract *pb.RicardianContract) (bool,
 
 // ReleaseFundsAfterTimeout - release funds
 func (n *OpenBazaarNode) ReleaseFundsAfterTimeout(contract *pb.RicardianContract, records []*wallet.TransactionRecord) error {
+	if pb.OrderPayment(contract).ModeratorPanel != nil {
+		return ErrPanelEscrowNoTimeout
+	}
 	if active, err := n.DisputeIsActive(contract); err != nil {
 		return err
 	} else if active {
@@ -416,7 +444,7 @@ func (n *OpenBazaarNode) ReleaseFundsAfterTimeout(contract *pb.RicardianContract
 		}
 	}
 
-	chaincode, err := hex.DecodeString(contract.BuyerOrder.Payment.Chaincode)
+	chaincode, err := hex.DecodeString(pb.OrderPayment(contract).Chaincode)
 	if err != nil {
 		return err
 	}
@@ -428,7 +456,7 @@ func (n *OpenBazaarNode) ReleaseFundsAfterTimeout(contract *pb.RicardianContract
 	if err != nil {
 		return err
 	}
-	redeemScript, err := hex.DecodeString(contract.BuyerOrder.Payment.RedeemScript)
+	redeemScript, err := hex.DecodeString(pb.OrderPayment(contract).RedeemScript)
 	if err != nil {
 		return err
 	}
@@ -446,6 +474,8 @@ func (n *OpenBazaarNode) ReleaseFundsAfterTimeout(contract *pb.RicardianContract
 	if err != nil {
 		return err
 	}
+	RecordExchangeRates(n.Datastore, n.Multiwallet, contract.BuyerOrder.Payment.Coin, repo.ExchangeRateReasonReleased, orderID)
+	n.RefreshInvoice(orderID)
 	return nil
 }
 
@@ -506,6 +536,10 @@ func (n *OpenBazaarNode) ValidateAndSaveRating(contract *pb.RicardianContract) (
 			retErr = err
 			continue
 		}
+		if err := ValidateRatingFields(rating.RatingData.Fields); err != nil {
+			retErr = err
+			continue
+		}
 
 		m := jsonpb.Marshaler{
 			EnumsAsInts:  false,
@@ -657,6 +691,7 @@ func (n *OpenBazaarNode) updateRatingIndex(rating *pb.Rating, ratingPath string)
 			total += float32(rating.RatingData.Overall)
 			index[i].Count++
 			index[i].Average = total / float32(index[i].Count)
+			index[i].Criteria = AddRatingToCriteria(index[i].Criteria, rating.RatingData)
 			exists = true
 			break
 		}
@@ -665,10 +700,11 @@ func (n *OpenBazaarNode) updateRatingIndex(rating *pb.Rating, ratingPath string)
 	// If it doesn't exist create a new one
 	if !exists {
 		rs := SavedRating{
-			Slug:    rating.RatingData.VendorSig.Metadata.ListingSlug,
-			Average: float32(rating.RatingData.Overall),
-			Count:   1,
-			Ratings: []string{ratingHash},
+			Slug:     rating.RatingData.VendorSig.Metadata.ListingSlug,
+			Average:  float32(rating.RatingData.Overall),
+			Count:    1,
+			Criteria: AddRatingToCriteria(nil, rating.RatingData),
+			Ratings:  []string{ratingHash},
 		}
 		index = append(index, rs)
 	}
@@ -46,7 +46,7 @@ func (n *OpenBazaarNode) NewOrderConfirmation(contract *pb.RicardianContract, ad
 		for _, listing := range contract.VendorListings {
 			metadata := new(pb.RatingSignature_TransactionMetadata)
 			metadata.ListingSlug = listing.Slug
-			metadata.ModeratorKey = contract.BuyerOrder.Payment.ModeratorKey
+			metadata.ModeratorKey = pb.OrderPayment(contract).ModeratorKey
 
 			if contract.BuyerOrder.Version > 0 {
 				metadata.ListingTitle = listing.Item.Title
@@ -76,7 +76,7 @@ func (n *OpenBazaarNode) NewOrderConfirmation(contract *pb.RicardianContract, ad
 
 			oc.RatingSignatures = append(oc.RatingSignatures, rs)
 		}
-		oc.PaymentAddress = contract.BuyerOrder.Payment.Address
+		oc.PaymentAddress = pb.OrderPayment(contract).Address
 	}
 
 	if calculateNewTotal {
@@ -85,7 +85,7 @@ func (n *OpenBazaarNode) NewOrderConfirmation(contract *pb.RicardianContract, ad
 			return nil, err
 		}
 	} else {
-		oc.RequestedAmount = contract.BuyerOrder.Payment.Amount
+		oc.RequestedAmount = pb.OrderPayment(contract).Amount
 	}
 	contract.VendorOrderConfirmation = oc
 	contract, err = n.SignOrderConfirmation(contract)
@@ -132,7 +132,7 @@ func (n *OpenBazaarNode) ConfirmOfflineOrder(contract *pb.RicardianContract, rec
 			return errors.New("no unspent transactions found to fund order")
 		}
 
-		chaincode, err := hex.DecodeString(contract.BuyerOrder.Payment.Chaincode)
+		chaincode, err := hex.DecodeString(pb.OrderPayment(contract).Chaincode)
 		if err != nil {
 			return err
 		}
@@ -144,7 +144,7 @@ func (n *OpenBazaarNode) ConfirmOfflineOrder(contract *pb.RicardianContract, rec
 		if err != nil {
 			return err
 		}
-		redeemScript, err := hex.DecodeString(contract.BuyerOrder.Payment.RedeemScript)
+		redeemScript, err := hex.DecodeString(pb.OrderPayment(contract).RedeemScript)
 		if err != nil {
 			return err
 		}
@@ -158,6 +158,7 @@ func (n *OpenBazaarNode) ConfirmOfflineOrder(contract *pb.RicardianContract, rec
 		return err
 	}
 	n.Datastore.Sales().Put(contract.VendorOrderConfirmation.OrderID, *contract, pb.OrderState_AWAITING_FULFILLMENT, false)
+	n.RefreshInvoice(contract.VendorOrderConfirmation.OrderID)
 	return nil
 }
 
@@ -211,7 +212,7 @@ func (n *OpenBazaarNode) RejectOfflineOrder(contract *pb.RicardianContract, reco
 			Value:   outValue,
 		}
 
-		chaincode, err := hex.DecodeString(contract.BuyerOrder.Payment.Chaincode)
+		chaincode, err := hex.DecodeString(pb.OrderPayment(contract).Chaincode)
 		if err != nil {
 			return fmt.Errorf("decode buyer chaincode: %s", err.Error())
 		}
@@ -223,7 +224,7 @@ func (n *OpenBazaarNode) RejectOfflineOrder(contract *pb.RicardianContract, reco
 		if err != nil {
 			return fmt.Errorf("generate child key: %s", err.Error())
 		}
-		redeemScript, err := hex.DecodeString(contract.BuyerOrder.Payment.RedeemScript)
+		redeemScript, err := hex.DecodeString(pb.OrderPayment(contract).RedeemScript)
 		if err != nil {
 			return fmt.Errorf("generate child key: %s", err.Error())
 		}
@@ -245,6 +246,9 @@ func (n *OpenBazaarNode) RejectOfflineOrder(contract *pb.RicardianContract, reco
 	if err := n.Datastore.Sales().Put(orderID, *contract, pb.OrderState_DECLINED, true); err != nil {
 		return fmt.Errorf("updating sale state: %s", err.Error())
 	}
+	if err := n.Datastore.Appointments().Delete(orderID); err != nil {
+		return fmt.Errorf("releasing appointment: %s", err.Error())
+	}
 	return nil
 }
 
@@ -257,7 +261,7 @@ func (n *OpenBazaarNode) ValidateOrderConfirmation(contract *pb.RicardianContrac
 	if contract.VendorOrderConfirmation.OrderID != orderID {
 		return errors.New("vendor's response contained invalid order ID")
 	}
-	if contract.VendorOrderConfirmation.RequestedAmount != contract.BuyerOrder.Payment.Amount {
+	if contract.VendorOrderConfirmation.RequestedAmount != pb.OrderPayment(contract).Amount {
 		return errors.New("vendor requested an amount different from what we calculated")
 	}
 	wal, err := n.Multiwallet.WalletForCurrencyCode(contract.BuyerOrder.Payment.Coin)
@@ -281,7 +285,7 @@ func (n *OpenBazaarNode) ValidateOrderConfirmation(contract *pb.RicardianContrac
 				return err
 			}
 
-			if !bytes.Equal(sig.Metadata.ModeratorKey, contract.BuyerOrder.Payment.ModeratorKey) {
+			if !bytes.Equal(sig.Metadata.ModeratorKey, pb.OrderPayment(contract).ModeratorKey) {
 				return errors.New("rating signature does not contain moderatory key")
 			}
 			ser, err := proto.Marshal(sig.Metadata)
@@ -169,6 +169,14 @@ type OpenBazaarNode struct {
 	// notify the user as disputes age past certain thresholds
 	RecordAgingNotifier *recordAgingNotifier
 
+	// SubscriptionRenewer is a worker that places the order for each new
+	// period of our active subscriptions
+	SubscriptionRenewer *subscriptionRenewer
+
+	// ExchangeRateRecorder is a worker that periodically saves the
+	// exchange rates of our coins to the exchange rate history
+	ExchangeRateRecorder *exchangeRateRecorder
+
 	// Generic pubsub interface
 	Pubsub ipfs.Pubsub
 
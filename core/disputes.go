@@ -102,10 +102,13 @@ func (n *OpenBazaarNode) OpenDispute(orderID string, contract *pb.RicardianContr
 	contract.Dispute = dispute
 	contract.Signatures = append(contract.Signatures, rc.Signatures[0])
 
-	// Send to moderator
-	err = n.SendDisputeOpen(contract.BuyerOrder.Payment.Moderator, nil, rc)
-	if err != nil {
-		return err
+	// Send to moderator, or to every member of the moderator panel
+	moderators, _ := pb.PaymentModerators(contract.BuyerOrder.Payment)
+	for _, moderator := range moderators {
+		err = n.SendDisputeOpen(moderator, nil, rc)
+		if err != nil {
+			return err
+		}
 	}
 
 	// Send to counterparty
@@ -139,6 +142,10 @@ func (n *OpenBazaarNode) OpenDispute(orderID string, contract *pb.RicardianContr
 }
 
 func (n *OpenBazaarNode) verifyEscrowFundsAreDisputeable(contract *pb.RicardianContract, records []*wallet.TransactionRecord) bool {
+	if pb.OrderPayment(contract).ModeratorPanel != nil {
+		// The escrow of a panel never times out
+		return true
+	}
 	confirmationsForTimeout := contract.VendorListings[0].Metadata.EscrowTimeoutHours * ConfirmationsPerHour
 	wal, err := n.Multiwallet.WalletForCurrencyCode(contract.BuyerOrder.Payment.Coin)
 	if err != nil {
@@ -265,7 +272,7 @@ func (n *OpenBazaarNode) ProcessDisputeOpen(rc *pb.RicardianContract, peerID str
 	var DisputerHandle string
 	var DisputeeID string
 	var DisputeeHandle string
-	if contract.BuyerOrder.Payment.Moderator == n.IpfsNode.Identity.Pretty() { // Moderator
+	if pb.IsPaymentModerator(contract.BuyerOrder.Payment, n.IpfsNode.Identity.Pretty()) { // Moderator
 		validationErrors := n.ValidateCaseContract(contract)
 		var err error
 		if contract.VendorListings[0].VendorID.PeerID == peerID {
@@ -300,6 +307,9 @@ func (n *OpenBazaarNode) ProcessDisputeOpen(rc *pb.RicardianContract, peerID str
 		if err != nil {
 			return err
 		}
+		if err := n.updateProfileCounts(); err != nil {
+			log.Errorf("failed updating moderator stats in profile: %s", err)
+		}
 	} else if contract.VendorListings[0].VendorID.PeerID == n.IpfsNode.Identity.Pretty() { // Vendor
 		DisputerID = contract.BuyerOrder.BuyerID.PeerID
 		DisputerHandle = contract.BuyerOrder.BuyerID.Handle
@@ -339,9 +349,12 @@ func (n *OpenBazaarNode) ProcessDisputeOpen(rc *pb.RicardianContract, peerID str
 		update.Outpoints = outpoints
 
 		// Send the message
-		err = n.SendDisputeUpdate(myContract.BuyerOrder.Payment.Moderator, update)
-		if err != nil {
-			return err
+		moderators, _ := pb.PaymentModerators(myContract.BuyerOrder.Payment)
+		for _, moderator := range moderators {
+			err = n.SendDisputeUpdate(moderator, update)
+			if err != nil {
+				return err
+			}
 		}
 
 		// Append the dispute and signature
@@ -367,7 +380,7 @@ func (n *OpenBazaarNode) ProcessDisputeOpen(rc *pb.RicardianContract, peerID str
 		if err != nil {
 			return err
 		}
-		if state == pb.OrderState_AWAITING_PAYMENT || state == pb.OrderState_AWAITING_FULFILLMENT || state == pb.OrderState_PARTIALLY_FULFILLED || state == pb.OrderState_PENDING {
+		if state == pb.OrderState_AWAITING_PAYMENT || state == pb.OrderState_AWAITING_FULFILLMENT || state == pb.OrderState_PARTIALLY_FULFILLED || state == pb.OrderState_PARTIALLY_REFUNDED || state == pb.OrderState_PENDING {
 			if err := n.SendProcessingError(DisputerID, orderID, pb.Message_DISPUTE_OPEN, myContract); err != nil {
 				log.Errorf("failed sending ORDER_PROCESSING_FAILURE to peer (%s): %s", DisputerID, err)
 			}
@@ -399,9 +412,12 @@ func (n *OpenBazaarNode) ProcessDisputeOpen(rc *pb.RicardianContract, peerID str
 		update.Outpoints = outpoints
 
 		// Send the message
-		err = n.SendDisputeUpdate(myContract.BuyerOrder.Payment.Moderator, update)
-		if err != nil {
-			return err
+		moderators, _ := pb.PaymentModerators(myContract.BuyerOrder.Payment)
+		for _, moderator := range moderators {
+			err = n.SendDisputeUpdate(moderator, update)
+			if err != nil {
+				return err
+			}
 		}
 
 		// Append the dispute and signature
@@ -480,6 +496,23 @@ func (n *OpenBazaarNode) CloseDispute(orderID string, buyerPercentage, vendorPer
 		preferredContract.BuyerOrder.Payment.Coin = paymentCoinHint.String()
 	}
 
+	if pb.OrderPayment(preferredContract).ModeratorPanel != nil {
+		return n.voteOnDispute(dispute, preferredContract, payDivision, resolution)
+	}
+	return n.resolveDispute(dispute, preferredContract, payDivision, resolution, nil)
+}
+
+// resolveDispute builds and signs the payout of a dispute and sends the
+// resolution to both parties. votes are the panel votes agreeing on the
+// payout of a panel moderated order, whose members share the fee.
+func (n *OpenBazaarNode) resolveDispute(dispute *repo.DisputeCaseRecord, preferredContract *pb.RicardianContract, payDivision repo.PayoutRatio, resolution string, votes []*pb.DisputeResolution_PanelVote) error {
+	var (
+		orderID          = dispute.CaseID
+		outpoints        = dispute.ResolutionPaymentOutpoints(payDivision)
+		buyerPercentage  = payDivision.Buyer
+		vendorPercentage = payDivision.Vendor
+	)
+
 	var d = new(pb.DisputeResolution)
 
 	// Add timestamp
@@ -497,19 +530,9 @@ func (n *OpenBazaarNode) CloseDispute(orderID string, buyerPercentage, vendorPer
 
 	// Set resolution
 	d.Resolution = resolution
-
-	var (
-		vendorID = preferredContract.VendorListings[0].VendorID.PeerID
-		buyerID  = preferredContract.BuyerOrder.BuyerID.PeerID
-	)
-	buyerKey, err := libp2p.UnmarshalPublicKey(preferredContract.BuyerOrder.BuyerID.Pubkeys.Identity)
-	if err != nil {
-		return err
-	}
-	vendorKey, err := libp2p.UnmarshalPublicKey(preferredContract.VendorListings[0].VendorID.Pubkeys.Identity)
-	if err != nil {
-		return err
-	}
+	d.BuyerPercentage = buyerPercentage
+	d.VendorPercentage = vendorPercentage
+	d.PanelVotes = votes
 
 	// Calculate total out value
 	var totalOut uint64
@@ -527,18 +550,38 @@ func (n *OpenBazaarNode) CloseDispute(orderID string, buyerPercentage, vendorPer
 	var outputs []wallet.TransactionOutput
 	var modAddr btcutil.Address
 	var modValue uint64
-	modAddr = wal.CurrentAddress(wallet.EXTERNAL)
-	modValue, err = n.GetModeratorFee(totalOut, preferredContract.BuyerOrder.Payment.Coin, wal.CurrencyCode())
-	if err != nil {
-		return err
+	if len(votes) == 0 {
+		modAddr = wal.CurrentAddress(wallet.EXTERNAL)
+		modValue, err = n.GetModeratorFee(totalOut, preferredContract.BuyerOrder.Payment.Coin, wal.CurrencyCode())
+		if err != nil {
+			return err
+		}
+		if modValue > 0 {
+			out := wallet.TransactionOutput{
+				Address: modAddr,
+				Value:   int64(modValue),
+			}
+			outputs = append(outputs, out)
+			outMap["moderator"] = out
+		}
 	}
-	if modValue > 0 {
+	// Each voting panel member is paid their share of the fee they voted for
+	for _, v := range votes {
+		fee := panelVoteFee(v, len(votes))
+		if fee == 0 {
+			continue
+		}
+		addr, err := pb.DisputeResolutionPayoutOutputToAddress(wal, v.Vote.Payout.ModeratorOutput)
+		if err != nil {
+			return err
+		}
 		out := wallet.TransactionOutput{
-			Address: modAddr,
-			Value:   int64(modValue),
+			Address: addr,
+			Value:   int64(fee),
 		}
 		outputs = append(outputs, out)
-		outMap["moderator"] = out
+		outMap["panel:"+v.Vote.ProposedBy] = out
+		modValue += fee
 	}
 
 	var buyerAddr btcutil.Address
@@ -618,38 +661,26 @@ func (n *OpenBazaarNode) CloseDispute(orderID string, buyerPercentage, vendorPer
 	}
 
 	// Create moderator key
-	chaincode := preferredContract.BuyerOrder.Payment.Chaincode
-	chaincodeBytes, err := hex.DecodeString(chaincode)
-	if err != nil {
-		return err
-	}
-	mECKey, err := n.MasterPrivateKey.ECPrivKey()
+	moderatorKey, err := n.moderatorEscrowKey(preferredContract, wal)
 	if err != nil {
 		return err
 	}
-	moderatorKey, err := wal.ChildKey(mECKey.Serialize(), chaincodeBytes, true)
+
+	// Sign buyer rating key
+	d.ModeratorRatingSigs, err = signRatingKeys(moderatorKey, dispute.BuyerContract)
 	if err != nil {
 		return err
 	}
-
-	// Sign buyer rating key
-	if dispute.BuyerContract != nil {
-		ecPriv, err := moderatorKey.ECPrivKey()
-		if err != nil {
-			return err
-		}
-		for _, key := range dispute.BuyerContract.BuyerOrder.RatingKeys {
-			hashed := sha256.Sum256(key)
-			sig, err := ecPriv.Sign(hashed[:])
-			if err != nil {
-				return err
-			}
-			d.ModeratorRatingSigs = append(d.ModeratorRatingSigs, sig.Serialize())
+	// Ratings check the moderator's signature against the order's moderator
+	// key, which belongs to the first member of a panel
+	for _, v := range votes {
+		if v.Vote.ProposedBy == preferredContract.BuyerOrder.Payment.Moderator {
+			d.ModeratorRatingSigs = v.Vote.ModeratorRatingSigs
 		}
 	}
 
 	// Create signatures
-	redeemScript := preferredContract.BuyerOrder.Payment.RedeemScript
+	redeemScript := pb.OrderPayment(preferredContract).RedeemScript
 	redeemScriptBytes, err := hex.DecodeString(redeemScript)
 	if err != nil {
 		return err
@@ -694,9 +725,46 @@ func (n *OpenBazaarNode) CloseDispute(orderID string, buyerPercentage, vendorPer
 		}
 		payout.ModeratorOutput = &pb.DisputeResolution_Payout_Output{ScriptOrAddress: &pb.DisputeResolution_Payout_Output_Address{modAddr.String()}, Amount: uint64(amt)}
 	}
+	for _, v := range votes {
+		if out, ok := outMap["panel:"+v.Vote.ProposedBy]; ok {
+			outputShareOfFee := (float64(out.Value) / float64(totalOut)) * float64(txFee)
+			amt := out.Value - int64(outputShareOfFee)
+			if amt < 0 {
+				amt = 0
+			}
+			payout.PanelOutputs = append(payout.PanelOutputs, &pb.DisputeResolution_Payout_Output{ScriptOrAddress: &pb.DisputeResolution_Payout_Output_Address{out.Address.String()}, Amount: uint64(amt)})
+		}
+	}
 
 	d.Payout = payout
 
+	if len(votes) > 0 {
+		// Threshold members sign the payout before the parties are sent the
+		// resolution. Closing the case stops later votes proposing another.
+		if err := n.signPanelPayout(preferredContract, d); err != nil {
+			return err
+		}
+		return n.Datastore.Cases().MarkAsClosed(orderID, d)
+	}
+	return n.sendDisputeResolution(preferredContract, d)
+}
+
+// sendDisputeResolution signs the resolution of a dispute, sends it to both
+// parties and closes the case
+func (n *OpenBazaarNode) sendDisputeResolution(contract *pb.RicardianContract, d *pb.DisputeResolution) error {
+	var (
+		vendorID = contract.VendorListings[0].VendorID.PeerID
+		buyerID  = contract.BuyerOrder.BuyerID.PeerID
+	)
+	buyerKey, err := libp2p.UnmarshalPublicKey(contract.BuyerOrder.BuyerID.Pubkeys.Identity)
+	if err != nil {
+		return err
+	}
+	vendorKey, err := libp2p.UnmarshalPublicKey(contract.VendorListings[0].VendorID.Pubkeys.Identity)
+	if err != nil {
+		return err
+	}
+
 	rc := new(pb.RicardianContract)
 	rc.DisputeResolution = d
 	rc, err = n.SignDisputeResolution(rc)
@@ -713,13 +781,50 @@ func (n *OpenBazaarNode) CloseDispute(orderID string, buyerPercentage, vendorPer
 		return err
 	}
 
-	err = n.Datastore.Cases().MarkAsClosed(orderID, d)
+	err = n.Datastore.Cases().MarkAsClosed(d.OrderId, d)
 	if err != nil {
 		return err
 	}
+	if err := n.updateProfileCounts(); err != nil {
+		log.Errorf("failed updating moderator stats in profile: %s", err)
+	}
 	return nil
 }
 
+// moderatorEscrowKey derives our private escrow key for the contract
+func (n *OpenBazaarNode) moderatorEscrowKey(contract *pb.RicardianContract, wal wallet.Wallet) (*hd.ExtendedKey, error) {
+	chaincodeBytes, err := hex.DecodeString(pb.OrderPayment(contract).Chaincode)
+	if err != nil {
+		return nil, err
+	}
+	mECKey, err := n.MasterPrivateKey.ECPrivKey()
+	if err != nil {
+		return nil, err
+	}
+	return wal.ChildKey(mECKey.Serialize(), chaincodeBytes, true)
+}
+
+// signRatingKeys signs the buyer's rating keys with the moderator's escrow key
+func signRatingKeys(moderatorKey *hd.ExtendedKey, buyerContract *pb.RicardianContract) ([][]byte, error) {
+	if buyerContract == nil {
+		return nil, nil
+	}
+	ecPriv, err := moderatorKey.ECPrivKey()
+	if err != nil {
+		return nil, err
+	}
+	var sigs [][]byte
+	for _, key := range buyerContract.BuyerOrder.RatingKeys {
+		hashed := sha256.Sum256(key)
+		sig, err := ecPriv.Sign(hashed[:])
+		if err != nil {
+			return nil, err
+		}
+		sigs = append(sigs, sig.Serialize())
+	}
+	return sigs, nil
+}
+
 // SignDisputeResolution - add signature to DisputeResolution
 func (n *OpenBazaarNode) SignDisputeResolution(contract *pb.RicardianContract) (*pb.RicardianContract, error) {
 	serializedDR, err := proto.Marshal(contract.DisputeResolution)
@@ -850,6 +955,12 @@ func (n *OpenBazaarNode) ValidateCaseContract(contract *pb.RicardianContract) []
 		}
 	}
 
+	// Verify the signatures on the timesheet entries and reviews
+	validationErrors = append(validationErrors, verifyTimesheetSignatures(contract)...)
+	validationErrors = append(validationErrors, verifyOrderAmendmentSignatures(contract)...)
+	validationErrors = append(validationErrors, verifyPartialRefundSignatures(contract)...)
+	validationErrors = append(validationErrors, verifyDisputeEvidenceSignatures(contract)...)
+
 	// Verify the buyer's bitcoin signature on his guid
 	if err := verifyBitcoinSignature(
 		contract.BuyerOrder.BuyerID.Pubkeys.Bitcoin,
@@ -875,7 +986,7 @@ func (n *OpenBazaarNode) ValidateCaseContract(contract *pb.RicardianContract) []
 			validationErrors = append(validationErrors, "Contract uses a coin not found in wallet")
 			return validationErrors
 		}
-		chaincode, err := hex.DecodeString(contract.BuyerOrder.Payment.Chaincode)
+		chaincode, err := hex.DecodeString(pb.OrderPayment(contract).Chaincode)
 		if err != nil {
 			validationErrors = append(validationErrors, "Error validating bitcoin address and redeem script")
 			return validationErrors
@@ -900,18 +1011,30 @@ func (n *OpenBazaarNode) ValidateCaseContract(contract *pb.RicardianContract) []
 			validationErrors = append(validationErrors, "Error validating bitcoin address and redeem script")
 			return validationErrors
 		}
+		escrowKeys := []hd.ExtendedKey{*buyerKey, *vendorKey, *moderatorKey}
+		if payment := pb.OrderPayment(contract); payment.ModeratorPanel != nil {
+			if err := validateModeratorPanel(payment, buyerGUID, vendorGUID); err != nil {
+				validationErrors = append(validationErrors, "Invalid moderator panel: "+err.Error())
+				return validationErrors
+			}
+			escrowKeys, err = panelEscrowKeys(payment, n.IpfsNode.Identity.Pretty(), moderatorKey, buyerKey, vendorKey)
+			if err != nil {
+				validationErrors = append(validationErrors, "Invalid moderator panel: "+err.Error())
+				return validationErrors
+			}
+		}
 		timeout, _ := time.ParseDuration(strconv.Itoa(int(contract.VendorListings[0].Metadata.EscrowTimeoutHours)) + "h")
-		addr, redeemScript, err := wal.GenerateMultisigScript([]hd.ExtendedKey{*buyerKey, *vendorKey, *moderatorKey}, 2, timeout, vendorKey)
+		addr, redeemScript, err := generateEscrowScript(wal, pb.OrderPayment(contract), escrowKeys, timeout)
 		if err != nil {
 			validationErrors = append(validationErrors, "Error generating multisig script")
 			return validationErrors
 		}
 
-		if contract.BuyerOrder.Payment.Address != addr.EncodeAddress() {
+		if pb.OrderPayment(contract).Address != addr.EncodeAddress() {
 			validationErrors = append(validationErrors, "The calculated bitcoin address doesn't match the address in the order")
 		}
 
-		if hex.EncodeToString(redeemScript) != contract.BuyerOrder.Payment.RedeemScript {
+		if hex.EncodeToString(redeemScript) != pb.OrderPayment(contract).RedeemScript {
 			validationErrors = append(validationErrors, "The calculated redeem script doesn't match the redeem script in the order")
 		}
 	}
@@ -921,6 +1044,14 @@ func (n *OpenBazaarNode) ValidateCaseContract(contract *pb.RicardianContract) []
 
 // ValidateDisputeResolution - validate dispute resolution
 func (n *OpenBazaarNode) ValidateDisputeResolution(contract *pb.RicardianContract) error {
+	if pb.OrderPayment(contract).ModeratorPanel != nil {
+		if err := validatePanelResolution(contract); err != nil {
+			return err
+		}
+		if err := validatePanelSignatures(contract); err != nil {
+			return err
+		}
+	}
 	err := n.verifySignatureOnDisputeResolution(contract)
 	if err != nil {
 		return err
@@ -954,8 +1085,12 @@ func (n *OpenBazaarNode) verifyPaymentDestinationIsInWallet(output *pb.DisputeRe
 }
 
 func (n *OpenBazaarNode) verifySignatureOnDisputeResolution(contract *pb.RicardianContract) error {
-
-	moderatorID, err := peer.IDB58Decode(contract.BuyerOrder.Payment.Moderator)
+	moderator := contract.BuyerOrder.Payment.Moderator
+	if pb.OrderPayment(contract).ModeratorPanel != nil {
+		// Any panel member who voted for the payout may propose it
+		moderator = contract.DisputeResolution.ProposedBy
+	}
+	moderatorID, err := peer.IDB58Decode(moderator)
 	if err != nil {
 		return err
 	}
@@ -992,14 +1127,15 @@ func (n *OpenBazaarNode) verifySignatureOnDisputeResolution(contract *pb.Ricardi
 	return nil
 }
 
-// ReleaseFunds - release funds
-func (n *OpenBazaarNode) ReleaseFunds(contract *pb.RicardianContract, records []*wallet.TransactionRecord) error {
+// disputePayoutTransaction returns the inputs and outputs of the payout of a
+// dispute resolution
+func disputePayoutTransaction(wal wallet.Wallet, payout *pb.DisputeResolution_Payout) ([]wallet.TransactionInput, []wallet.TransactionOutput, error) {
 	// Create inputs
 	var inputs []wallet.TransactionInput
-	for _, o := range contract.DisputeResolution.Payout.Inputs {
+	for _, o := range payout.Inputs {
 		decodedHash, err := hex.DecodeString(o.Hash)
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
 		input := wallet.TransactionInput{
 			OutpointHash:  decodedHash,
@@ -1010,51 +1146,42 @@ func (n *OpenBazaarNode) ReleaseFunds(contract *pb.RicardianContract, records []
 	}
 
 	if len(inputs) == 0 {
-		return errors.New("transaction has no inputs")
-	}
-	wal, err := n.Multiwallet.WalletForCurrencyCode(contract.BuyerOrder.Payment.Coin)
-	if err != nil {
-		return err
+		return nil, nil, errors.New("transaction has no inputs")
 	}
 
 	// Create outputs
+	payoutOutputs := []*pb.DisputeResolution_Payout_Output{payout.BuyerOutput, payout.VendorOutput, payout.ModeratorOutput}
 	var outputs []wallet.TransactionOutput
-	if contract.DisputeResolution.Payout.BuyerOutput != nil {
-		addr, err := pb.DisputeResolutionPayoutOutputToAddress(wal, contract.DisputeResolution.Payout.BuyerOutput)
-		if err != nil {
-			return err
-		}
-		output := wallet.TransactionOutput{
-			Address: addr,
-			Value:   int64(contract.DisputeResolution.Payout.BuyerOutput.Amount),
+	for _, o := range append(payoutOutputs, payout.PanelOutputs...) {
+		if o == nil {
+			continue
 		}
-		outputs = append(outputs, output)
-	}
-	if contract.DisputeResolution.Payout.VendorOutput != nil {
-		addr, err := pb.DisputeResolutionPayoutOutputToAddress(wal, contract.DisputeResolution.Payout.VendorOutput)
+		addr, err := pb.DisputeResolutionPayoutOutputToAddress(wal, o)
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
 		output := wallet.TransactionOutput{
 			Address: addr,
-			Value:   int64(contract.DisputeResolution.Payout.VendorOutput.Amount),
+			Value:   int64(o.Amount),
 		}
 		outputs = append(outputs, output)
 	}
-	if contract.DisputeResolution.Payout.ModeratorOutput != nil {
-		addr, err := pb.DisputeResolutionPayoutOutputToAddress(wal, contract.DisputeResolution.Payout.ModeratorOutput)
-		if err != nil {
-			return err
-		}
-		output := wallet.TransactionOutput{
-			Address: addr,
-			Value:   int64(contract.DisputeResolution.Payout.ModeratorOutput.Amount),
-		}
-		outputs = append(outputs, output)
+	return inputs, outputs, nil
+}
+
+// ReleaseFunds - release funds
+func (n *OpenBazaarNode) ReleaseFunds(contract *pb.RicardianContract, records []*wallet.TransactionRecord) error {
+	wal, err := n.Multiwallet.WalletForCurrencyCode(contract.BuyerOrder.Payment.Coin)
+	if err != nil {
+		return err
+	}
+	inputs, outputs, err := disputePayoutTransaction(wal, contract.DisputeResolution.Payout)
+	if err != nil {
+		return err
 	}
 
 	// Create signing key
-	chaincodeBytes, err := hex.DecodeString(contract.BuyerOrder.Payment.Chaincode)
+	chaincodeBytes, err := hex.DecodeString(pb.OrderPayment(contract).Chaincode)
 	if err != nil {
 		return err
 	}
@@ -1068,7 +1195,7 @@ func (n *OpenBazaarNode) ReleaseFunds(contract *pb.RicardianContract, records []
 	}
 
 	// Create signatures
-	redeemScriptBytes, err := hex.DecodeString(contract.BuyerOrder.Payment.RedeemScript)
+	redeemScriptBytes, err := hex.DecodeString(pb.OrderPayment(contract).RedeemScript)
 	if err != nil {
 		return err
 	}
@@ -1112,10 +1239,21 @@ func (n *OpenBazaarNode) ReleaseFunds(contract *pb.RicardianContract, records []
 	}
 
 	// Build, sign, and broadcast transaction
-	_, err = wal.Multisign(inputs, outputs, mySigs, moderatorSigs, redeemScriptBytes, 0, true)
+	if panel := pb.OrderPayment(contract).ModeratorPanel; panel != nil {
+		// Our signatures count for every copy of our key in the escrow and
+		// the panel's follow in the order of the members' keys
+		var sigs [][]wallet.Signature
+		for i := 0; i < int(panel.Threshold); i++ {
+			sigs = append(sigs, mySigs)
+		}
+		err = broadcastPanelSpend(wal, inputs, outputs, append(sigs, panelSignatureSets(panel, contract.DisputeResolution)...), redeemScriptBytes, 0)
+	} else {
+		_, err = wal.Multisign(inputs, outputs, mySigs, moderatorSigs, redeemScriptBytes, 0, true)
+	}
 	if err != nil {
 		return err
 	}
+	RecordExchangeRates(n.Datastore, n.Multiwallet, contract.BuyerOrder.Payment.Coin, repo.ExchangeRateReasonReleased, orderID)
 
 	return nil
 }
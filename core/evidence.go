@@ -0,0 +1,451 @@
+package core
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	libp2p "gx/ipfs/QmTW4SdgBWq9GjsBsHeUx8WuGxzhgzAf88UMH2w62PC8yK/go-libp2p-crypto"
+	cid "gx/ipfs/QmTbxNB1NwDesLmKTscr4udL2tVP7MaxvXnD1D9yX7g3PN/go-cid"
+	peer "gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
+	blocks "gx/ipfs/QmYYLnAzR28nAQ4U5MFniLprnktu6eTFKibeNt96V21EZK/go-block-format"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/kimitzu/kimitzu-go/net"
+	"github.com/kimitzu/kimitzu-go/pb"
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+const (
+	// MaxEvidenceSize - max size in bytes of an evidence file. Every encrypted
+	// copy of the file is stored as a single IPFS block.
+	MaxEvidenceSize = 1 << 20
+	// MaxDisputeEvidence - max number of files either party can attach to a dispute
+	MaxDisputeEvidence = 20
+
+	evidenceFetchTimeout = time.Minute
+)
+
+var (
+	// ErrDisputeNotOpen - evidence can only be attached while the dispute is open
+	ErrDisputeNotOpen = errors.New("evidence can only be attached to an open dispute")
+	// ErrEvidenceNotFound - no file with the hash is attached to the dispute
+	ErrEvidenceNotFound = errors.New("evidence not found")
+	// ErrEvidenceNotReadable - the file was not encrypted to us
+	ErrEvidenceNotReadable = errors.New("evidence is only readable by the moderator and the party who attached it")
+	// ErrDuplicateEvidence - the party already attached the file
+	ErrDuplicateEvidence = errors.New("the file is already attached to the dispute")
+)
+
+// DisputeEvidenceData - a file to attach to a dispute. Content is base64
+// encoded in JSON.
+type DisputeEvidenceData struct {
+	Filename    string `json:"filename"`
+	Description string `json:"description"`
+	Content     []byte `json:"content"`
+}
+
+// validateDisputeEvidence checks evidence attached to the disputed order
+// against the contract it is recorded in
+func validateDisputeEvidence(contract *pb.RicardianContract, evidence *pb.DisputeEvidence) error {
+	if evidence.OrderId == "" {
+		return errors.New("evidence is missing the order ID")
+	}
+	if evidence.Submitter != pb.OrderAmendment_BUYER && evidence.Submitter != pb.OrderAmendment_VENDOR {
+		return errors.New("evidence can only be attached by the buyer or the vendor")
+	}
+	if evidence.Filename == "" || strings.ContainsAny(evidence.Filename, `/\`) {
+		return errors.New("evidence filename is invalid")
+	}
+	if len(evidence.Filename) > FilenameMaxCharacters {
+		return fmt.Errorf("evidence filename is longer than the max of %d characters", FilenameMaxCharacters)
+	}
+	if len(evidence.Description) > DescriptionMaxCharacters {
+		return fmt.Errorf("evidence description is longer than the max of %d characters", DescriptionMaxCharacters)
+	}
+	if evidence.Size == 0 || evidence.Size > MaxEvidenceSize {
+		return fmt.Errorf("evidence files must be between 1 and %d bytes", MaxEvidenceSize)
+	}
+	if h, err := hex.DecodeString(evidence.Hash); err != nil || len(h) != sha256.Size {
+		return errors.New("evidence hash is not a SHA-256 hash")
+	}
+	if evidence.Timestamp == nil {
+		return errors.New("evidence is missing a timestamp")
+	}
+
+	attached := 0
+	for _, e := range contract.DisputeEvidence {
+		if e.Submitter != evidence.Submitter {
+			continue
+		}
+		if e.Hash == evidence.Hash {
+			return ErrDuplicateEvidence
+		}
+		attached++
+	}
+	if attached >= MaxDisputeEvidence {
+		return fmt.Errorf("a party can attach at most %d files to a dispute", MaxDisputeEvidence)
+	}
+	return nil
+}
+
+// validateEvidenceCopies checks that the evidence can be read by every
+// moderator of the order
+func validateEvidenceCopies(contract *pb.RicardianContract, evidence *pb.DisputeEvidence) error {
+	recipients := make(map[string]bool)
+	for _, c := range evidence.Copies {
+		if recipients[c.Recipient] {
+			return fmt.Errorf("evidence has more than one copy for %s", c.Recipient)
+		}
+		if _, err := cid.Decode(c.Cid); err != nil {
+			return errors.New("evidence copy has an invalid CID")
+		}
+		recipients[c.Recipient] = true
+	}
+	moderators, _ := pb.PaymentModerators(pb.OrderPayment(contract))
+	for _, m := range moderators {
+		if !recipients[m] {
+			return fmt.Errorf("evidence is not encrypted to moderator %s", m)
+		}
+	}
+	return nil
+}
+
+// verifyDisputeEvidenceSignatures checks that every file attached to the
+// dispute is signed by the party who attached it. Signatures are stored in
+// the order the evidence was attached.
+func verifyDisputeEvidenceSignatures(contract *pb.RicardianContract) []string {
+	var validationErrors []string
+	var sigs []*pb.Signature
+	for _, sig := range contract.Signatures {
+		if sig.Section == pb.Signature_DISPUTE_EVIDENCE {
+			sigs = append(sigs, sig)
+		}
+	}
+	if len(sigs) < len(contract.DisputeEvidence) {
+		validationErrors = append(validationErrors, "Not all dispute evidence is signed")
+		return validationErrors
+	}
+	for i, e := range contract.DisputeEvidence {
+		id := partyID(contract, e.Submitter)
+		if err := verifyMessageSignature(e, id.Pubkeys.Identity, []*pb.Signature{sigs[i]}, pb.Signature_DISPUTE_EVIDENCE, id.PeerID); err != nil {
+			validationErrors = append(validationErrors, fmt.Sprintf("Invalid signature on dispute evidence %d", i))
+		}
+	}
+	return validationErrors
+}
+
+// partyID returns the ID of the buyer or the vendor of the order
+func partyID(contract *pb.RicardianContract, party pb.OrderAmendment_Party) *pb.ID {
+	if party == pb.OrderAmendment_VENDOR {
+		return contract.VendorListings[0].VendorID
+	}
+	return contract.BuyerOrder.BuyerID
+}
+
+// otherParty returns the party on the other side of the order
+func otherParty(party pb.OrderAmendment_Party) pb.OrderAmendment_Party {
+	if party == pb.OrderAmendment_VENDOR {
+		return pb.OrderAmendment_BUYER
+	}
+	return pb.OrderAmendment_VENDOR
+}
+
+// AttachDisputeEvidence - encrypt a file to the moderators of a disputed order
+// and to ourselves, store the copies as IPFS blocks and send the signed
+// reference to the moderators and the other party
+func (n *OpenBazaarNode) AttachDisputeEvidence(contract *pb.RicardianContract, state pb.OrderState, data *DisputeEvidenceData) (*pb.DisputeEvidence, error) {
+	if state != pb.OrderState_DISPUTED || contract.Dispute == nil {
+		return nil, ErrDisputeNotOpen
+	}
+	orderID, err := n.CalcOrderID(contract.BuyerOrder)
+	if err != nil {
+		return nil, err
+	}
+	us := n.ourOrderParty(contract)
+	sum := sha256.Sum256(data.Content)
+	evidence := &pb.DisputeEvidence{
+		OrderId:     orderID,
+		Submitter:   us,
+		Filename:    data.Filename,
+		MediaType:   http.DetectContentType(data.Content),
+		Description: data.Description,
+		Size:        uint64(len(data.Content)),
+		Hash:        hex.EncodeToString(sum[:]),
+		Timestamp:   ptypes.TimestampNow(),
+	}
+	if err := validateDisputeEvidence(contract, evidence); err != nil {
+		return nil, err
+	}
+
+	moderators, _ := pb.PaymentModerators(pb.OrderPayment(contract))
+	recipients := append([]string{n.IpfsNode.Identity.Pretty()}, moderators...)
+	ourKey := n.IpfsNode.PrivateKey.GetPublic()
+	for _, recipient := range recipients {
+		id, err := peer.IDB58Decode(recipient)
+		if err != nil {
+			return nil, err
+		}
+		var k *libp2p.PubKey
+		if id == n.IpfsNode.Identity {
+			k = &ourKey
+		}
+		ciphertext, err := n.EncryptMessage(id, k, data.Content)
+		if err != nil {
+			return nil, err
+		}
+		block := blocks.NewBlock(ciphertext)
+		if err := n.IpfsNode.Blocks.AddBlock(block); err != nil {
+			return nil, err
+		}
+		evidence.Copies = append(evidence.Copies, &pb.DisputeEvidence_Copy{Recipient: recipient, Cid: block.Cid().String()})
+	}
+
+	rc := new(pb.RicardianContract)
+	rc.DisputeEvidence = []*pb.DisputeEvidence{evidence}
+	rc, err = n.SignDisputeEvidence(rc)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range moderators {
+		if err := n.SendDisputeEvidence(m, nil, rc); err != nil {
+			log.Errorf("failed sending dispute evidence for order (%s) to %s: %s", orderID, m, err)
+		}
+	}
+	other := counterparty(contract, us)
+	k, err := libp2p.UnmarshalPublicKey(other.Pubkeys.Identity)
+	if err != nil {
+		return nil, err
+	}
+	if err := n.SendDisputeEvidence(other.PeerID, &k, rc); err != nil {
+		log.Errorf("failed sending dispute evidence for order (%s) to %s: %s", orderID, other.PeerID, err)
+	}
+
+	contract.DisputeEvidence = append(contract.DisputeEvidence, evidence)
+	contract.Signatures = append(contract.Signatures, rc.Signatures...)
+	return evidence, n.putOrder(contract, orderID, state, us)
+}
+
+// SignDisputeEvidence - add signature to dispute evidence
+func (n *OpenBazaarNode) SignDisputeEvidence(contract *pb.RicardianContract) (*pb.RicardianContract, error) {
+	serializedEvidence, err := proto.Marshal(contract.DisputeEvidence[0])
+	if err != nil {
+		return contract, err
+	}
+	s := new(pb.Signature)
+	s.Section = pb.Signature_DISPUTE_EVIDENCE
+	guidSig, err := n.IpfsNode.PrivateKey.Sign(serializedEvidence)
+	if err != nil {
+		return contract, err
+	}
+	s.SignatureBytes = guidSig
+	contract.Signatures = append(contract.Signatures, s)
+	return contract, nil
+}
+
+// ProcessDisputeEvidence records evidence the other party, or a party to a
+// dispute we moderate, attached to the dispute
+func (n *OpenBazaarNode) ProcessDisputeEvidence(rc *pb.RicardianContract, peerID string) error {
+	if len(rc.DisputeEvidence) == 0 {
+		return errors.New("received DISPUTE_EVIDENCE message with no DisputeEvidence objects")
+	}
+	evidence := rc.DisputeEvidence[0]
+
+	var (
+		contract *pb.RicardianContract
+		state    pb.OrderState
+		dispute  *repo.DisputeCaseRecord
+		err      error
+	)
+	dispute, err = n.Datastore.Cases().GetByCaseID(evidence.OrderId)
+	if err == nil {
+		// We moderate the dispute and keep the evidence in the submitter's
+		// copy of the contract
+		contract, state = dispute.BuyerContract, dispute.OrderState
+		if evidence.Submitter == pb.OrderAmendment_VENDOR {
+			contract = dispute.VendorContract
+		}
+	} else {
+		dispute = nil
+		// Vendors attach evidence to our purchases and buyers to our sales
+		if evidence.Submitter == pb.OrderAmendment_VENDOR {
+			contract, state, _, _, _, _, err = n.Datastore.Purchases().GetByOrderId(evidence.OrderId)
+		} else {
+			contract, state, _, _, _, _, err = n.Datastore.Sales().GetByOrderId(evidence.OrderId)
+		}
+		if err != nil {
+			contract = nil
+		}
+	}
+	if contract == nil || (dispute == nil && contract.Dispute == nil) {
+		// The evidence may have overtaken the dispute itself
+		return ErrCaseNotFound
+	}
+	if state != pb.OrderState_DISPUTED {
+		return ErrDisputeNotOpen
+	}
+
+	submitter := partyID(contract, evidence.Submitter)
+	if submitter.PeerID != peerID {
+		return errors.New("evidence was not attached by the peer who sent it")
+	}
+	if err := validateDisputeEvidence(contract, evidence); err != nil {
+		return err
+	}
+	if err := validateEvidenceCopies(contract, evidence); err != nil {
+		return err
+	}
+	if err := verifyMessageSignature(evidence, submitter.Pubkeys.Identity, rc.Signatures, pb.Signature_DISPUTE_EVIDENCE, submitter.PeerID); err != nil {
+		switch err.(type) {
+		case noSigError:
+			return errors.New("contract does not contain a signature for the dispute evidence")
+		case invalidSigError:
+			return errors.New("guid signature on dispute evidence failed to verify")
+		case matchKeyError:
+			return errors.New("public key in contract does not match the submitter of the evidence")
+		default:
+			return err
+		}
+	}
+
+	contract.DisputeEvidence = append(contract.DisputeEvidence, evidence)
+	for _, sig := range rc.Signatures {
+		if sig.Section == pb.Signature_DISPUTE_EVIDENCE {
+			contract.Signatures = append(contract.Signatures, sig)
+		}
+	}
+	if dispute != nil {
+		if err := n.putCaseContract(dispute, evidence.Submitter, contract); err != nil {
+			return err
+		}
+		// Fetch our copy while the submitter is still online
+		go func() {
+			if _, _, err := n.FetchDisputeEvidence(evidence.OrderId, evidence.Hash); err != nil {
+				log.Errorf("failed fetching dispute evidence %s for case (%s): %s", evidence.Hash, evidence.OrderId, err)
+			}
+		}()
+	} else if err := n.putOrder(contract, evidence.OrderId, state, otherParty(evidence.Submitter)); err != nil {
+		return err
+	}
+
+	var thumbnail repo.Thumbnail
+	if len(contract.VendorListings) > 0 && contract.VendorListings[0].Item != nil && len(contract.VendorListings[0].Item.Images) > 0 {
+		thumbnail = repo.Thumbnail{Tiny: contract.VendorListings[0].Item.Images[0].Tiny, Small: contract.VendorListings[0].Item.Images[0].Small}
+	}
+	notif := repo.DisputeEvidenceNotification{
+		ID:         repo.NewNotificationID(),
+		Type:       repo.NotifierTypeDisputeEvidenceNotification,
+		OrderId:    evidence.OrderId,
+		PeerID:     submitter.PeerID,
+		PeerHandle: submitter.Handle,
+		Filename:   evidence.Filename,
+		Hash:       evidence.Hash,
+		Thumbnail:  thumbnail,
+	}
+	n.Broadcast <- notif
+	n.Datastore.Notifications().PutRecord(repo.NewNotification(notif, time.Now(), false))
+	return nil
+}
+
+// putCaseContract saves the contract of one party to a dispute we moderate
+func (n *OpenBazaarNode) putCaseContract(dispute *repo.DisputeCaseRecord, party pb.OrderAmendment_Party, contract *pb.RicardianContract) error {
+	_, _, buyerErrors, vendorErrors, _, _, _, _, _, _, err := n.Datastore.Cases().GetCaseMetadata(dispute.CaseID)
+	if err != nil {
+		return err
+	}
+	if party == pb.OrderAmendment_VENDOR {
+		return n.Datastore.Cases().UpdateVendorInfo(dispute.CaseID, contract, vendorErrors, dispute.VendorPayoutAddress, dispute.VendorOutpoints)
+	}
+	return n.Datastore.Cases().UpdateBuyerInfo(dispute.CaseID, contract, buyerErrors, dispute.BuyerPayoutAddress, dispute.BuyerOutpoints)
+}
+
+// DisputeEvidence returns the files attached to the dispute of an order, or
+// of a case we moderate, in the order they were attached
+func (n *OpenBazaarNode) DisputeEvidence(orderID string) ([]*pb.DisputeEvidence, error) {
+	var contracts []*pb.RicardianContract
+	if dispute, err := n.Datastore.Cases().GetByCaseID(orderID); err == nil {
+		contracts = append(contracts, dispute.BuyerContract, dispute.VendorContract)
+	} else if contract, _, _, _, _, _, err := n.Datastore.Purchases().GetByOrderId(orderID); err == nil {
+		contracts = append(contracts, contract)
+	} else if contract, _, _, _, _, _, err := n.Datastore.Sales().GetByOrderId(orderID); err == nil {
+		contracts = append(contracts, contract)
+	} else {
+		return nil, ErrCaseNotFound
+	}
+
+	// Each party's copy of the contract may hold the other's evidence too
+	var ret []*pb.DisputeEvidence
+	seen := make(map[string]bool)
+	for _, c := range contracts {
+		if c == nil {
+			continue
+		}
+		for _, e := range c.DisputeEvidence {
+			key := e.Submitter.String() + e.Hash
+			if !seen[key] {
+				seen[key] = true
+				ret = append(ret, e)
+			}
+		}
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		ti, _ := ptypes.Timestamp(ret[i].Timestamp)
+		tj, _ := ptypes.Timestamp(ret[j].Timestamp)
+		return ti.Before(tj)
+	})
+	return ret, nil
+}
+
+// FetchDisputeEvidence - fetch and decrypt our copy of a file attached to a
+// dispute. The file is checked against the hash its submitter signed.
+func (n *OpenBazaarNode) FetchDisputeEvidence(orderID, hash string) (*pb.DisputeEvidence, []byte, error) {
+	evidence, err := n.DisputeEvidence(orderID)
+	if err != nil {
+		return nil, nil, err
+	}
+	var e *pb.DisputeEvidence
+	for _, ev := range evidence {
+		if ev.Hash == hash {
+			e = ev
+			break
+		}
+	}
+	if e == nil {
+		return nil, nil, ErrEvidenceNotFound
+	}
+	var copyCid string
+	for _, c := range e.Copies {
+		if c.Recipient == n.IpfsNode.Identity.Pretty() {
+			copyCid = c.Cid
+		}
+	}
+	if copyCid == "" {
+		return nil, nil, ErrEvidenceNotReadable
+	}
+	id, err := cid.Decode(copyCid)
+	if err != nil {
+		return nil, nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), evidenceFetchTimeout)
+	defer cancel()
+	block, err := n.IpfsNode.Blocks.GetBlock(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	content, err := net.Decrypt(n.IpfsNode.PrivateKey, block.RawData())
+	if err != nil {
+		return nil, nil, err
+	}
+	sum := sha256.Sum256(content)
+	if hex.EncodeToString(sum[:]) != e.Hash || uint64(len(content)) != e.Size {
+		return nil, nil, errors.New("evidence does not match the hash it was attached with")
+	}
+	return e, content, nil
+}
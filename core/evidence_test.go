@@ -0,0 +1,107 @@
+package core
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/kimitzu/kimitzu-go/pb"
+)
+
+func newTestDisputeEvidence(content string) *pb.DisputeEvidence {
+	sum := sha256.Sum256([]byte(content))
+	return &pb.DisputeEvidence{
+		OrderId:   "QmOrder",
+		Submitter: pb.OrderAmendment_BUYER,
+		Filename:  "screenshot.png",
+		MediaType: "image/png",
+		Size:      uint64(len(content)),
+		Hash:      hex.EncodeToString(sum[:]),
+		Copies: []*pb.DisputeEvidence_Copy{
+			{Recipient: "QmModerator", Cid: "zb2rhe5P4gXftAwvA4eXQ5HJwsER2owDyS9sKaQRRVQPn93bA"},
+		},
+		Timestamp: ptypes.TimestampNow(),
+	}
+}
+
+func TestValidateDisputeEvidence(t *testing.T) {
+	contract := newLedgerTestContract(t, "USD")
+	contract.BuyerOrder.Payment.Method = pb.Order_Payment_MODERATED
+	contract.BuyerOrder.Payment.Moderator = "QmModerator"
+	evidence := newTestDisputeEvidence("receipt")
+	if err := validateDisputeEvidence(contract, evidence); err != nil {
+		t.Fatal(err)
+	}
+	if err := validateEvidenceCopies(contract, evidence); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, filename := range []string{"", "../escrow.db", `c:\evidence.txt`} {
+		evidence.Filename = filename
+		if err := validateDisputeEvidence(contract, evidence); err == nil {
+			t.Errorf("expected filename %s to fail", filename)
+		}
+	}
+	evidence = newTestDisputeEvidence("receipt")
+	evidence.Size = MaxEvidenceSize + 1
+	if err := validateDisputeEvidence(contract, evidence); err == nil {
+		t.Error("expected an oversized file to fail")
+	}
+	evidence = newTestDisputeEvidence("receipt")
+	evidence.Hash = "receipt"
+	if err := validateDisputeEvidence(contract, evidence); err == nil {
+		t.Error("expected an invalid hash to fail")
+	}
+
+	evidence = newTestDisputeEvidence("receipt")
+	contract.DisputeEvidence = append(contract.DisputeEvidence, evidence)
+	if err := validateDisputeEvidence(contract, newTestDisputeEvidence("receipt")); err != ErrDuplicateEvidence {
+		t.Errorf("expected %s, got %v", ErrDuplicateEvidence, err)
+	}
+	other := newTestDisputeEvidence("receipt")
+	other.Submitter = pb.OrderAmendment_VENDOR
+	if err := validateDisputeEvidence(contract, other); err != nil {
+		t.Errorf("expected the other party to attach the same file, got %s", err)
+	}
+
+	evidence = newTestDisputeEvidence("invoice")
+	evidence.Copies[0].Recipient = "QmSomeoneElse"
+	if err := validateEvidenceCopies(contract, evidence); err == nil {
+		t.Error("expected evidence the moderator cannot read to fail")
+	}
+	evidence = newTestDisputeEvidence("invoice")
+	evidence.Copies[0].Cid = "notacid"
+	if err := validateEvidenceCopies(contract, evidence); err == nil {
+		t.Error("expected an invalid CID to fail")
+	}
+}
+
+func TestVerifyDisputeEvidenceSignatures(t *testing.T) {
+	contract := newLedgerTestContract(t, "USD")
+	buyerKey, buyerID := newQuoteTestID(t)
+	vendorKey, vendorID := newQuoteTestID(t)
+	contract.BuyerOrder.BuyerID = buyerID
+	contract.VendorListings[0].VendorID = vendorID
+
+	buyerEvidence := newTestDisputeEvidence("receipt")
+	vendorEvidence := newTestDisputeEvidence("tracking")
+	vendorEvidence.Submitter = pb.OrderAmendment_VENDOR
+	contract.DisputeEvidence = []*pb.DisputeEvidence{buyerEvidence, vendorEvidence}
+	contract.Signatures = []*pb.Signature{
+		{Section: pb.Signature_DISPUTE_EVIDENCE, SignatureBytes: signQuoteTestMessage(t, buyerKey, buyerEvidence)},
+		{Section: pb.Signature_DISPUTE_EVIDENCE, SignatureBytes: signQuoteTestMessage(t, vendorKey, vendorEvidence)},
+	}
+	if errs := verifyDisputeEvidenceSignatures(contract); len(errs) > 0 {
+		t.Fatal(errs)
+	}
+
+	vendorEvidence.Hash = buyerEvidence.Hash
+	if errs := verifyDisputeEvidenceSignatures(contract); len(errs) != 1 {
+		t.Errorf("expected tampered evidence to fail, got %v", errs)
+	}
+	contract.Signatures = contract.Signatures[:1]
+	if errs := verifyDisputeEvidenceSignatures(contract); len(errs) != 1 {
+		t.Errorf("expected unsigned evidence to fail, got %v", errs)
+	}
+}
@@ -0,0 +1,98 @@
+package core
+
+import (
+	"time"
+
+	"github.com/OpenBazaar/multiwallet"
+	"github.com/kimitzu/kimitzu-go/repo"
+	"github.com/op/go-logging"
+)
+
+// ExchangeRateSnapshotInterval is how often the rates of every coin in the
+// wallet are recorded regardless of order activity
+const ExchangeRateSnapshotInterval = time.Duration(6) * time.Hour
+
+// RecordExchangeRates saves the current rates of the coin to the exchange rate
+// history. Failures are logged rather than returned since a missing snapshot
+// must never hold up the order flow which triggered it.
+func RecordExchangeRates(ds repo.Datastore, mw multiwallet.MultiWallet, coin string, reason repo.ExchangeRateReason, orderID string) {
+	wal, err := mw.WalletForCurrencyCode(coin)
+	if err != nil {
+		log.Warningf("recording exchange rates for %s: %s", coin, err)
+		return
+	}
+	if wal.ExchangeRates() == nil {
+		return
+	}
+	rates, err := wal.ExchangeRates().GetAllRates(true)
+	if err != nil {
+		log.Warningf("recording exchange rates for %s: %s", coin, err)
+		return
+	}
+	if len(rates) == 0 {
+		return
+	}
+	err = ds.ExchangeRates().Put(&repo.ExchangeRateSnapshot{
+		Timestamp: time.Now(),
+		Coin:      wal.CurrencyCode(),
+		Reason:    reason,
+		OrderID:   orderID,
+		Rates:     rates,
+	})
+	if err != nil {
+		log.Errorf("saving exchange rates for %s: %s", coin, err)
+	}
+}
+
+type exchangeRateRecorder struct {
+	// PerformTask dependencies
+	datastore   repo.Datastore
+	multiwallet multiwallet.MultiWallet
+
+	// Worker-handling dependencies
+	intervalDelay time.Duration
+	logger        *logging.Logger
+	watchdogTimer *time.Ticker
+	stopWorker    chan bool
+}
+
+// StartExchangeRateRecorder - start the worker which periodically records the
+// exchange rates of every coin in the wallet
+func (n *OpenBazaarNode) StartExchangeRateRecorder() {
+	n.ExchangeRateRecorder = &exchangeRateRecorder{
+		datastore:     n.Datastore,
+		multiwallet:   n.Multiwallet,
+		intervalDelay: ExchangeRateSnapshotInterval,
+		logger:        logging.MustGetLogger("exchangeRateRecorder"),
+	}
+	go n.ExchangeRateRecorder.Run()
+}
+
+func (recorder *exchangeRateRecorder) Run() {
+	recorder.watchdogTimer = time.NewTicker(recorder.intervalDelay)
+	recorder.stopWorker = make(chan bool)
+
+	// Run once on start, then wait for watchdog
+	recorder.PerformTask()
+	for {
+		select {
+		case <-recorder.watchdogTimer.C:
+			recorder.PerformTask()
+		case <-recorder.stopWorker:
+			recorder.watchdogTimer.Stop()
+			return
+		}
+	}
+}
+
+func (recorder *exchangeRateRecorder) Stop() {
+	recorder.stopWorker <- true
+	close(recorder.stopWorker)
+}
+
+func (recorder *exchangeRateRecorder) PerformTask() {
+	for _, wal := range recorder.multiwallet {
+		RecordExchangeRates(recorder.datastore, recorder.multiwallet, wal.CurrencyCode(), repo.ExchangeRateReasonPeriodic, "")
+	}
+	recorder.logger.Debugf("recorded exchange rates of %d coins", len(recorder.multiwallet))
+}
@@ -28,6 +28,22 @@ func (n *OpenBazaarNode) FulfillOrder(fulfillment *pb.OrderFulfillment, contract
 		return errors.New("slug must be specified when an order contains multiple items")
 	}
 
+	finalMilestone := true
+	if IsMilestoneOrder(contract) {
+		milestone, err := CurrentMilestone(contract)
+		if err != nil {
+			return err
+		}
+		if milestoneFulfillment(contract, milestone.Index) != nil {
+			return errors.New("the current milestone has already been fulfilled")
+		}
+		fulfillment.MilestoneIndex = milestone.Index
+		finalMilestone = IsFinalMilestone(contract, milestone.Index)
+	}
+	if IsTimesheetOrder(contract) && TimesheetDuration(contract, pb.TimesheetReview_PENDING) > 0 {
+		return ErrTimesheetEntriesPending
+	}
+
 	rc := new(pb.RicardianContract)
 	if contract.BuyerOrder.Payment.Method == pb.Order_Payment_MODERATED {
 		payout := new(pb.OrderFulfillment_Payout)
@@ -49,14 +65,20 @@ func (n *OpenBazaarNode) FulfillOrder(fulfillment *pb.OrderFulfillment, contract
 				outValue += r.Value
 				in := wallet.TransactionInput{OutpointIndex: r.Index, OutpointHash: outpointHash, Value: r.Value}
 				ins = append(ins, in)
+				payout.Inputs = append(payout.Inputs, &pb.Outpoint{Hash: r.Txid, Index: r.Index, Value: uint64(r.Value)})
 			}
 		}
 
-		var output = wallet.TransactionOutput{
-			Address: currentAddress,
-			Value:   outValue,
+		var outputs []wallet.TransactionOutput
+		if !finalMilestone {
+			outputs, err = milestonePayoutOutputs(wal, contract, outValue, currentAddress, payout.PayoutFeePerByte)
+		} else {
+			outputs, err = PayoutOutputs(wal, contract, outValue, currentAddress, payout.PayoutFeePerByte)
 		}
-		chaincode, err := hex.DecodeString(contract.BuyerOrder.Payment.Chaincode)
+		if err != nil {
+			return err
+		}
+		chaincode, err := hex.DecodeString(pb.OrderPayment(contract).Chaincode)
 		if err != nil {
 			return err
 		}
@@ -68,12 +90,12 @@ func (n *OpenBazaarNode) FulfillOrder(fulfillment *pb.OrderFulfillment, contract
 		if err != nil {
 			return err
 		}
-		redeemScript, err := hex.DecodeString(contract.BuyerOrder.Payment.RedeemScript)
+		redeemScript, err := hex.DecodeString(pb.OrderPayment(contract).RedeemScript)
 		if err != nil {
 			return err
 		}
 
-		signatures, err := wal.CreateMultisigSignature(ins, []wallet.TransactionOutput{output}, vendorKey, redeemScript, payout.PayoutFeePerByte)
+		signatures, err := wal.CreateMultisigSignature(ins, outputs, vendorKey, redeemScript, payout.PayoutFeePerByte)
 		if err != nil {
 			return err
 		}
@@ -138,6 +160,11 @@ func (n *OpenBazaarNode) FulfillOrder(fulfillment *pb.OrderFulfillment, contract
 	}
 	fulfillment.BuyerRatingSignature = brmSignature
 
+	fulfillment.SignedBuyerRating, err = n.signBuyerRating(fulfillment.BuyerRating, contract, fulfillment.OrderId, listing)
+	if err != nil {
+		return err
+	}
+
 	ser, err := proto.Marshal(metadata)
 	if err != nil {
 		return err
@@ -172,7 +199,9 @@ func (n *OpenBazaarNode) FulfillOrder(fulfillment *pb.OrderFulfillment, contract
 			contract.Signatures = append(contract.Signatures, sig)
 		}
 	}
-	if n.IsFulfilled(rc) {
+	if !finalMilestone {
+		n.Datastore.Sales().Put(contract.VendorOrderConfirmation.OrderID, *contract, pb.OrderState_MILESTONE_FULFILLED, false)
+	} else if n.IsFulfilled(rc) {
 		n.Datastore.Sales().Put(contract.VendorOrderConfirmation.OrderID, *contract, pb.OrderState_FULFILLED, false)
 	} else {
 		n.Datastore.Sales().Put(contract.VendorOrderConfirmation.OrderID, *contract, pb.OrderState_PARTIALLY_FULFILLED, false)
@@ -245,6 +274,16 @@ func (n *OpenBazaarNode) ValidateOrderFulfillment(fulfillment *pb.OrderFulfillme
 		return errors.New("failed to verify signature on rating keys")
 	}
 
+	if IsMilestoneOrder(contract) {
+		milestone, err := CurrentMilestone(contract)
+		if err != nil {
+			return err
+		}
+		if fulfillment.MilestoneIndex != milestone.Index {
+			return errors.New("fulfillment is not for the current milestone")
+		}
+	}
+
 	if contract.BuyerOrder.Payment.Method == pb.Order_Payment_MODERATED {
 		wal, err := n.Multiwallet.WalletForCurrencyCode(contract.BuyerOrder.Payment.Coin)
 		if err != nil {
@@ -328,5 +367,8 @@ func validateCryptocurrencyFulfillment(fulfillment *pb.OrderFulfillment) error {
 
 // IsFulfilled - check is order is fulfilled
 func (n *OpenBazaarNode) IsFulfilled(contract *pb.RicardianContract) bool {
+	if IsMilestoneOrder(contract) {
+		return milestoneFulfillment(contract, uint32(len(contract.BuyerOrder.Milestones)-1)) != nil
+	}
 	return len(contract.VendorOrderFulfillment) >= len(contract.VendorListings)
 }
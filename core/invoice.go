@@ -0,0 +1,422 @@
+package core
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/kimitzu/kimitzu-go/pb"
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+// ErrInvoiceUnavailable - the order is not a sale which can be invoiced yet
+var ErrInvoiceUnavailable = errors.New("invoices are only issued for confirmed sales which have been funded")
+
+// invoiceLock serializes invoice updates so two new invoices cannot be given
+// the same number
+var invoiceLock sync.Mutex
+
+// Invoice is the document issued to the buyer of a sale. Amounts are in the
+// smallest unit of Currency, or of the payment coin for Payment, Milestones
+// and Refunds. When the listings of the order are not all priced in one
+// currency at a fixed price the lines cannot be itemized; they then only
+// carry their quantity and the totals are those of the payment.
+type Invoice struct {
+	Number    string    `json:"number"`
+	Revision  uint32    `json:"revision"`
+	OrderID   string    `json:"orderId"`
+	OrderDate time.Time `json:"orderDate"`
+	Issued    time.Time `json:"issued"`
+	Updated   time.Time `json:"updated"`
+
+	Vendor InvoiceParty `json:"vendor"`
+	Buyer  InvoiceParty `json:"buyer"`
+
+	Currency string        `json:"currency"`
+	Itemized bool          `json:"itemized"`
+	Lines    []InvoiceLine `json:"lines"`
+	Subtotal uint64        `json:"subtotal"`
+	Discount uint64        `json:"discount"`
+	Tax      uint64        `json:"tax"`
+	Shipping uint64        `json:"shipping"`
+	Total    uint64        `json:"total"`
+
+	Payment    InvoicePayment     `json:"payment"`
+	Milestones []InvoiceMilestone `json:"milestones,omitempty"`
+	Refunds    []InvoiceRefund    `json:"refunds,omitempty"`
+}
+
+// InvoiceParty identifies the vendor or the buyer on an invoice
+type InvoiceParty struct {
+	PeerID  string   `json:"peerId"`
+	Handle  string   `json:"handle,omitempty"`
+	Name    string   `json:"name,omitempty"`
+	Address []string `json:"address,omitempty"`
+	Email   string   `json:"email,omitempty"`
+	Phone   string   `json:"phone,omitempty"`
+	Website string   `json:"website,omitempty"`
+}
+
+// InvoiceLine is an item of the order
+type InvoiceLine struct {
+	ListingSlug string       `json:"listingSlug"`
+	Title       string       `json:"title"`
+	Quantity    uint64       `json:"quantity"`
+	Subtotal    uint64       `json:"subtotal"`
+	Discount    uint64       `json:"discount"`
+	Taxes       []InvoiceTax `json:"taxes,omitempty"`
+	Tax         uint64       `json:"tax"`
+	Total       uint64       `json:"total"`
+}
+
+// InvoiceTax is a listing tax which applies to the shipping country
+type InvoiceTax struct {
+	Type       string  `json:"type"`
+	Percentage float32 `json:"percentage"`
+}
+
+// InvoicePayment describes how the order is paid
+type InvoicePayment struct {
+	Coin       string `json:"coin"`
+	Method     string `json:"method"`
+	Address    string `json:"address,omitempty"`
+	Amount     uint64 `json:"amount"`
+	Paid       uint64 `json:"paid"`
+	BalanceDue uint64 `json:"balanceDue"`
+}
+
+// InvoiceMilestone is a milestone of the order and whether its payment has
+// been released to the vendor
+type InvoiceMilestone struct {
+	Index      uint32     `json:"index"`
+	Title      string     `json:"title"`
+	Amount     uint64     `json:"amount"`
+	Released   bool       `json:"released"`
+	ReleasedAt *time.Time `json:"releasedAt,omitempty"`
+}
+
+// InvoiceRefund is a refund of the order. Amount is zero when the refund was
+// paid from escrow and its value is not recorded in the contract.
+type InvoiceRefund struct {
+	Timestamp time.Time `json:"timestamp"`
+	Txid      string    `json:"txid,omitempty"`
+	Amount    uint64    `json:"amount"`
+	Memo      string    `json:"memo,omitempty"`
+}
+
+// FormatInvoiceNumber formats a sequential invoice number for display
+func FormatInvoiceNumber(number uint64) string {
+	return fmt.Sprintf("INV-%06d", number)
+}
+
+// UpdateInvoice issues the invoice of a sale, or a new revision of it when
+// the amounts, payments, milestones or refunds of the order changed since the
+// last one. The invoice keeps its number across revisions. ErrInvoiceUnavailable is returned for orders which are not
+// confirmed and funded sales.
+func (n *OpenBazaarNode) UpdateInvoice(orderID string) (*repo.InvoiceRecord, error) {
+	contract, state, funded, records, _, _, err := n.Datastore.Sales().GetByOrderId(orderID)
+	if err != nil {
+		return nil, ErrInvoiceUnavailable
+	}
+	if contract.VendorOrderConfirmation == nil || !(funded || state == pb.OrderState_COMPLETED) {
+		return nil, ErrInvoiceUnavailable
+	}
+
+	var vendorName string
+	if profile, err := n.GetProfile(); err == nil {
+		vendorName = profile.Name
+	}
+
+	invoiceLock.Lock()
+	defer invoiceLock.Unlock()
+
+	now := time.Now()
+	record := &repo.InvoiceRecord{OrderID: orderID, Revision: 1, Issued: now, Updated: now}
+	prev, err := n.Datastore.Invoices().Get(orderID)
+	switch {
+	case err == sql.ErrNoRows:
+		record.InvoiceNumber, err = n.Datastore.Invoices().NextInvoiceNumber()
+		if err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	default:
+		// Rebuild the last revision to see whether anything has changed
+		unchanged, err := buildInvoice(orderID, contract, records, vendorName, prev)
+		if err != nil {
+			return nil, err
+		}
+		ser, err := json.Marshal(unchanged)
+		if err != nil {
+			return nil, err
+		}
+		if bytes.Equal(ser, prev.Invoice) {
+			return prev, nil
+		}
+		record.InvoiceNumber = prev.InvoiceNumber
+		record.Revision = prev.Revision + 1
+		record.Issued = prev.Issued
+	}
+
+	invoice, err := buildInvoice(orderID, contract, records, vendorName, record)
+	if err != nil {
+		return nil, err
+	}
+	record.Invoice, err = json.Marshal(invoice)
+	if err != nil {
+		return nil, err
+	}
+	record.Signature, err = n.IpfsNode.PrivateKey.Sign(record.Invoice)
+	if err != nil {
+		return nil, err
+	}
+	if err := n.Datastore.Invoices().Put(record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// RefreshInvoice updates the invoice of a sale after the order changed. It
+// logs rather than returns failures since invoicing must not hold up the
+// order flow.
+func (n *OpenBazaarNode) RefreshInvoice(orderID string) {
+	if _, err := n.UpdateInvoice(orderID); err != nil && err != ErrInvoiceUnavailable {
+		log.Errorf("updating invoice of order %s: %s", orderID, err)
+	}
+}
+
+// buildInvoice renders the contract as an invoice with the number, revision
+// and dates of the record
+func buildInvoice(orderID string, contract *pb.RicardianContract, records []*wallet.TransactionRecord, vendorName string, record *repo.InvoiceRecord) (*Invoice, error) {
+	order := contract.BuyerOrder
+	if order == nil || order.Payment == nil || len(contract.VendorListings) == 0 {
+		return nil, errors.New("contract is missing the order")
+	}
+	invoice := &Invoice{
+		Number:    FormatInvoiceNumber(record.InvoiceNumber),
+		Revision:  record.Revision,
+		OrderID:   orderID,
+		OrderDate: protoTime(order.Timestamp).UTC(),
+		Issued:    record.Issued.UTC(),
+		Updated:   record.Updated.UTC(),
+		Vendor:    invoiceVendor(contract.VendorListings[0]),
+		Buyer:     invoiceBuyer(order),
+	}
+	invoice.Vendor.Name = vendorName
+	// Times are stored with second precision, match them so rebuilding a
+	// stored revision gives the same document
+	invoice.OrderDate = invoice.OrderDate.Truncate(time.Second)
+	invoice.Issued = invoice.Issued.Truncate(time.Second)
+	invoice.Updated = invoice.Updated.Truncate(time.Second)
+
+	if err := invoiceLines(invoice, contract); err != nil {
+		return nil, err
+	}
+	invoicePayment(invoice, contract, records)
+	invoiceMilestones(invoice, contract)
+	for _, r := range contract.PartialRefunds {
+		refund := InvoiceRefund{Timestamp: protoTime(r.Timestamp).UTC(), Amount: r.Amount, Memo: r.Memo}
+		if r.RefundTransaction != nil {
+			refund.Txid = r.RefundTransaction.Txid
+		}
+		invoice.Refunds = append(invoice.Refunds, refund)
+	}
+	if r := contract.Refund; r != nil {
+		refund := InvoiceRefund{Timestamp: protoTime(r.Timestamp).UTC(), Memo: r.Memo}
+		if r.RefundTransaction != nil {
+			refund.Txid = r.RefundTransaction.Txid
+			refund.Amount = r.RefundTransaction.Value
+		}
+		invoice.Refunds = append(invoice.Refunds, refund)
+	}
+	return invoice, nil
+}
+
+func invoiceVendor(listing *pb.Listing) InvoiceParty {
+	var party InvoiceParty
+	if listing.VendorID != nil {
+		party.PeerID = listing.VendorID.PeerID
+		party.Handle = listing.VendorID.Handle
+	}
+	if c := listing.Contact; c != nil {
+		party.Email = c.Email
+		party.Phone = c.PhoneNumber
+		party.Website = c.Website
+	}
+	return party
+}
+
+func invoiceBuyer(order *pb.Order) InvoiceParty {
+	var party InvoiceParty
+	if order.BuyerID != nil {
+		party.PeerID = order.BuyerID.PeerID
+		party.Handle = order.BuyerID.Handle
+	}
+	if s := order.Shipping; s != nil {
+		party.Name = s.ShipTo
+		for _, line := range []string{
+			s.Address,
+			strings.TrimSpace(strings.Join([]string{s.City, s.State, s.PostalCode}, " ")),
+			countryName(s.Country),
+		} {
+			if line != "" {
+				party.Address = append(party.Address, line)
+			}
+		}
+	}
+	return party
+}
+
+func countryName(c pb.CountryCode) string {
+	if c == pb.CountryCode_NA || c == pb.CountryCode_ALL {
+		return ""
+	}
+	return strings.Replace(c.String(), "_", " ", -1)
+}
+
+// invoiceLines itemizes the order in the currency the listings are priced
+// in. Orders mixing currencies, market priced listings and amended orders
+// fall back to lines without prices and the payment amount as the total.
+func invoiceLines(invoice *Invoice, contract *pb.RicardianContract) error {
+	var pricingCurrency string
+	itemized := pb.AcceptedOrderAmendment(contract) == nil
+	for _, l := range contract.VendorListings {
+		if l.Metadata == nil || l.Metadata.Format == pb.Listing_Metadata_MARKET_PRICE {
+			itemized = false
+			break
+		}
+		if pricingCurrency == "" {
+			pricingCurrency = l.Metadata.PricingCurrency
+		} else if !strings.EqualFold(pricingCurrency, l.Metadata.PricingCurrency) {
+			itemized = false
+			break
+		}
+	}
+	price := func(currencyCode string, amount uint64) (uint64, error) {
+		if !strings.EqualFold(currencyCode, pricingCurrency) {
+			return 0, fmt.Errorf("listing priced in %s", currencyCode)
+		}
+		return amount, nil
+	}
+	marketPrice := func(string, uint64) (uint64, error) {
+		return 0, errors.New("market priced listings cannot be itemized")
+	}
+
+	var items []*orderItemPrice
+	if itemized {
+		var err error
+		if items, err = calculateOrderItems(contract, price, marketPrice); err != nil {
+			itemized = false
+		}
+	}
+	if !itemized {
+		invoice.Currency = contract.BuyerOrder.Payment.Coin
+		for _, item := range contract.BuyerOrder.Items {
+			l, err := ParseContractForListing(item.ListingHash, contract)
+			if err != nil {
+				return err
+			}
+			invoice.Lines = append(invoice.Lines, InvoiceLine{
+				ListingSlug: l.Slug,
+				Title:       l.Item.Title,
+				Quantity:    OrderItemQuantity(contract, l, item),
+			})
+		}
+		invoice.Total = pb.OrderPayment(contract).Amount
+		return nil
+	}
+
+	invoice.Currency = strings.ToUpper(pricingCurrency)
+	invoice.Itemized = true
+	physicalGoods := make(map[string]*pb.Listing)
+	for _, item := range items {
+		line := InvoiceLine{
+			ListingSlug: item.Listing.Slug,
+			Title:       item.Listing.Item.Title,
+			Quantity:    item.Quantity,
+			Subtotal:    item.Subtotal,
+			Discount:    item.Discount,
+			Tax:         item.Tax,
+			Total:       item.Total,
+		}
+		for _, tax := range item.Listing.Taxes {
+			for _, region := range tax.TaxRegions {
+				if contract.BuyerOrder.Shipping != nil && contract.BuyerOrder.Shipping.Country == region {
+					line.Taxes = append(line.Taxes, InvoiceTax{Type: tax.TaxType, Percentage: tax.Percentage})
+					break
+				}
+			}
+		}
+		invoice.Lines = append(invoice.Lines, line)
+		invoice.Subtotal += item.Subtotal
+		invoice.Discount += item.Discount
+		invoice.Tax += item.Tax
+		invoice.Total += item.Total
+		if item.Listing.Metadata.ContractType == pb.Listing_Metadata_PHYSICAL_GOOD {
+			physicalGoods[item.ListingHash] = item.Listing
+		}
+	}
+	shipping, err := calculateShippingTotalForListings(contract, physicalGoods, price)
+	if err != nil {
+		return err
+	}
+	invoice.Shipping = shipping
+	invoice.Total += shipping
+	return nil
+}
+
+// invoicePayment adds up what the buyer has paid. Outputs of transactions
+// which spend from the payment address, such as the change of a milestone
+// release, are not payments.
+func invoicePayment(invoice *Invoice, contract *pb.RicardianContract, records []*wallet.TransactionRecord) {
+	payment := pb.OrderPayment(contract)
+	invoice.Payment = InvoicePayment{
+		Coin:    payment.Coin,
+		Method:  payment.Method.String(),
+		Address: payment.Address,
+		Amount:  payment.Amount,
+	}
+	spends := make(map[string]bool)
+	for _, r := range records {
+		if r.Value < 0 {
+			spends[r.Txid] = true
+		}
+	}
+	for _, r := range records {
+		if r.Value > 0 && !spends[r.Txid] {
+			invoice.Payment.Paid += uint64(r.Value)
+		}
+	}
+	if invoice.Payment.Paid < invoice.Payment.Amount {
+		invoice.Payment.BalanceDue = invoice.Payment.Amount - invoice.Payment.Paid
+	}
+}
+
+func invoiceMilestones(invoice *Invoice, contract *pb.RicardianContract) {
+	released := make(map[uint32]time.Time)
+	for _, r := range contract.BuyerMilestoneReleases {
+		released[r.MilestoneIndex] = protoTime(r.Timestamp).UTC()
+	}
+	// The final milestone is released by completing the order
+	if c := contract.BuyerOrderCompletion; c != nil && IsMilestoneOrder(contract) {
+		last := uint32(len(contract.BuyerOrder.Milestones) - 1)
+		if _, ok := released[last]; !ok {
+			released[last] = protoTime(c.Timestamp).UTC()
+		}
+	}
+	for _, m := range contract.BuyerOrder.Milestones {
+		im := InvoiceMilestone{Index: m.Index, Title: m.Title, Amount: m.Amount}
+		if t, ok := released[m.Index]; ok {
+			im.Released = true
+			im.ReleasedAt = &t
+		}
+		invoice.Milestones = append(invoice.Milestones, im)
+	}
+}
@@ -0,0 +1,311 @@
+package core
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// A minimal PDF writer for invoices. It only needs the standard fonts, so
+// there is nothing to embed: Helvetica for text and Courier for amounts,
+// which can be right aligned since every Courier glyph is 0.6em wide.
+const (
+	pdfPageWidth  = 595.0 // A4 in points
+	pdfPageHeight = 842.0
+	pdfMargin     = 50.0
+
+	pdfFontRegular = "F1"
+	pdfFontBold    = "F2"
+	pdfFontMono    = "F3"
+)
+
+type pdfDocument struct {
+	pages []*bytes.Buffer
+	page  *bytes.Buffer
+	y     float64
+}
+
+func newPDFDocument() *pdfDocument {
+	d := &pdfDocument{}
+	d.newPage()
+	return d
+}
+
+func (d *pdfDocument) newPage() {
+	d.page = new(bytes.Buffer)
+	d.pages = append(d.pages, d.page)
+	d.y = pdfPageHeight - pdfMargin
+}
+
+// space moves the cursor down, starting a new page when the next h points
+// do not fit on this one
+func (d *pdfDocument) space(h float64) {
+	if d.y-h < pdfMargin {
+		d.newPage()
+		return
+	}
+	d.y -= h
+}
+
+func (d *pdfDocument) text(x float64, font string, size float64, s string) {
+	fmt.Fprintf(d.page, "BT /%s %s Tf %s %s Td (%s) Tj ET\n", font, pdfNumber(size), pdfNumber(x), pdfNumber(d.y), pdfEscape(s))
+}
+
+// textRight writes s in Courier ending at x
+func (d *pdfDocument) textRight(x, size float64, s string) {
+	d.text(x-float64(len(s))*0.6*size, pdfFontMono, size, s)
+}
+
+func (d *pdfDocument) rule() {
+	fmt.Fprintf(d.page, "0.5 w %s %s m %s %s l S\n", pdfNumber(pdfMargin), pdfNumber(d.y), pdfNumber(pdfPageWidth-pdfMargin), pdfNumber(d.y))
+}
+
+func (d *pdfDocument) WriteTo(w io.Writer) (int64, error) {
+	var (
+		buf     bytes.Buffer
+		offsets []int
+	)
+	object := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+	buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// Objects 1-5 are the catalog, the page tree and the fonts, then each
+	// page is followed by its content stream
+	var kids []string
+	for i := range d.pages {
+		kids = append(kids, fmt.Sprintf("%d 0 R", 6+2*i))
+	}
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	for _, font := range []string{"Helvetica", "Helvetica-Bold", "Courier"} {
+		object("<< /Type /Font /Subtype /Type1 /BaseFont /" + font + " /Encoding /WinAnsiEncoding >>")
+	}
+	for i, page := range d.pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << /Font << /F1 3 0 R /F2 4 0 R /F3 5 0 R >> >> /Contents %d 0 R >>",
+			pdfNumber(pdfPageWidth), pdfNumber(pdfPageHeight), 7+2*i))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", page.Len(), page.String()))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, o := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", o)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+	return buf.WriteTo(w)
+}
+
+func pdfNumber(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// pdfEscape encodes s as a PDF string in WinAnsiEncoding. Characters outside
+// Latin-1 cannot be shown with the standard fonts and become '?'.
+func pdfEscape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r < 0x20:
+			b.WriteByte(' ')
+		case r < 0x80:
+			b.WriteRune(r)
+		case r >= 0xa0 && r <= 0xff:
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
+
+// WriteInvoicePDF renders the invoice and the vendor's signature covering its
+// JSON serialization as a PDF document
+func WriteInvoicePDF(w io.Writer, invoice *Invoice, signature []byte) error {
+	const (
+		right  = pdfPageWidth - pdfMargin
+		column = pdfPageWidth / 2
+	)
+	d := newPDFDocument()
+	amount := func(v uint64, currency string) string {
+		return formatLedgerAmount(int64(v), currency) + " " + currency
+	}
+
+	d.text(pdfMargin, pdfFontBold, 22, "INVOICE")
+	d.text(column, pdfFontBold, 12, invoice.Number)
+	header := [][2]string{
+		{"Issued", invoice.Issued.Format("2006-01-02")},
+		{"Order date", invoice.OrderDate.Format("2006-01-02")},
+		{"Order", invoice.OrderID},
+	}
+	if invoice.Revision > 1 {
+		header = append([][2]string{
+			{"Revision", fmt.Sprintf("%d, %s", invoice.Revision, invoice.Updated.Format("2006-01-02"))},
+		}, header...)
+	}
+	for _, h := range header {
+		d.space(13)
+		d.text(column, pdfFontRegular, 8, h[0])
+		d.text(column+60, pdfFontRegular, 8, h[1])
+	}
+
+	// Vendor and buyer side by side
+	d.space(30)
+	d.text(pdfMargin, pdfFontBold, 10, "From")
+	d.text(column, pdfFontBold, 10, "Bill to")
+	vendor, buyer := invoicePartyLines(invoice.Vendor), invoicePartyLines(invoice.Buyer)
+	for i := 0; i < len(vendor) || i < len(buyer); i++ {
+		d.space(12)
+		if i < len(vendor) {
+			d.text(pdfMargin, pdfFontRegular, 9, truncateString(vendor[i], 48))
+		}
+		if i < len(buyer) {
+			d.text(column, pdfFontRegular, 9, truncateString(buyer[i], 48))
+		}
+	}
+
+	// Lines
+	d.space(30)
+	d.text(pdfMargin, pdfFontBold, 9, "Item")
+	d.textRight(300, 9, "Qty")
+	if invoice.Itemized {
+		d.textRight(360, 9, "Price")
+		d.textRight(420, 9, "Discount")
+		d.textRight(480, 9, "Tax")
+		d.textRight(right, 9, "Total")
+	}
+	d.space(5)
+	d.rule()
+	for _, line := range invoice.Lines {
+		d.space(14)
+		d.text(pdfMargin, pdfFontRegular, 9, truncateString(line.Title, 42))
+		d.textRight(300, 9, strconv.FormatUint(line.Quantity, 10))
+		if invoice.Itemized {
+			d.textRight(360, 9, formatLedgerAmount(int64(line.Subtotal), invoice.Currency))
+			d.textRight(420, 9, formatLedgerAmount(int64(line.Discount), invoice.Currency))
+			d.textRight(480, 9, formatLedgerAmount(int64(line.Tax), invoice.Currency))
+			d.textRight(right, 9, formatLedgerAmount(int64(line.Total), invoice.Currency))
+		}
+		for _, tax := range line.Taxes {
+			d.space(10)
+			d.text(pdfMargin+10, pdfFontRegular, 7, fmt.Sprintf("%s %s%%", tax.Type, strconv.FormatFloat(float64(tax.Percentage), 'f', -1, 32)))
+		}
+	}
+	d.space(6)
+	d.rule()
+
+	totals := [][2]string{}
+	if invoice.Itemized {
+		totals = append(totals,
+			[2]string{"Subtotal", amount(invoice.Subtotal, invoice.Currency)},
+			[2]string{"Discount", amount(invoice.Discount, invoice.Currency)},
+			[2]string{"Tax", amount(invoice.Tax, invoice.Currency)},
+			[2]string{"Shipping", amount(invoice.Shipping, invoice.Currency)},
+		)
+	}
+	totals = append(totals, [2]string{"Total", amount(invoice.Total, invoice.Currency)})
+	for _, t := range totals {
+		d.space(13)
+		font := pdfFontRegular
+		if t[0] == "Total" {
+			font = pdfFontBold
+		}
+		d.text(360, font, 9, t[0])
+		d.textRight(right, 9, t[1])
+	}
+
+	// Payment
+	p := invoice.Payment
+	d.space(30)
+	d.text(pdfMargin, pdfFontBold, 10, "Payment")
+	payment := [][2]string{{"Method", strings.ToLower(p.Method)}}
+	if p.Address != "" {
+		payment = append(payment, [2]string{"Address", p.Address})
+	}
+	payment = append(payment,
+		[2]string{"Amount", amount(p.Amount, p.Coin)},
+		[2]string{"Paid", amount(p.Paid, p.Coin)},
+		[2]string{"Balance due", amount(p.BalanceDue, p.Coin)},
+	)
+	for _, row := range payment {
+		d.space(12)
+		d.text(pdfMargin, pdfFontRegular, 9, row[0])
+		d.text(pdfMargin+80, pdfFontMono, 9, row[1])
+	}
+
+	if len(invoice.Milestones) > 0 {
+		d.space(24)
+		d.text(pdfMargin, pdfFontBold, 10, "Milestones")
+		for _, m := range invoice.Milestones {
+			d.space(12)
+			status := "pending"
+			if m.Released && m.ReleasedAt != nil {
+				status = "released " + m.ReleasedAt.Format("2006-01-02")
+			}
+			d.text(pdfMargin, pdfFontRegular, 9, truncateString(fmt.Sprintf("%d. %s", m.Index+1, m.Title), 48))
+			d.text(320, pdfFontRegular, 9, status)
+			d.textRight(right, 9, amount(m.Amount, p.Coin))
+		}
+	}
+
+	if len(invoice.Refunds) > 0 {
+		d.space(24)
+		d.text(pdfMargin, pdfFontBold, 10, "Refunds")
+		for _, r := range invoice.Refunds {
+			d.space(12)
+			desc := r.Timestamp.Format("2006-01-02")
+			if r.Txid != "" {
+				desc += " " + r.Txid
+			}
+			d.text(pdfMargin, pdfFontRegular, 9, truncateString(desc, 80))
+			if r.Amount > 0 {
+				d.textRight(right, 9, amount(r.Amount, p.Coin))
+			}
+			if r.Memo != "" {
+				d.space(10)
+				d.text(pdfMargin+10, pdfFontRegular, 8, truncateString(r.Memo, 90))
+			}
+		}
+	}
+
+	// Signature
+	d.space(30)
+	d.text(pdfMargin, pdfFontRegular, 7, "Signed by "+invoice.Vendor.PeerID+" with its identity key. The signature covers the JSON invoice:")
+	sig := hex.EncodeToString(signature)
+	for len(sig) > 0 {
+		n := 96
+		if len(sig) < n {
+			n = len(sig)
+		}
+		d.space(9)
+		d.text(pdfMargin, pdfFontMono, 7, sig[:n])
+		sig = sig[n:]
+	}
+
+	_, err := d.WriteTo(w)
+	return err
+}
+
+func invoicePartyLines(p InvoiceParty) []string {
+	var lines []string
+	if p.Name != "" {
+		lines = append(lines, p.Name)
+	}
+	if p.Handle != "" {
+		lines = append(lines, p.Handle)
+	}
+	lines = append(lines, p.Address...)
+	for _, s := range []string{p.Email, p.Phone, p.Website, p.PeerID} {
+		if s != "" {
+			lines = append(lines, s)
+		}
+	}
+	return lines
+}
@@ -0,0 +1,150 @@
+package core
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/kimitzu/kimitzu-go/pb"
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+// rehashListing updates the order to refer to the listing after a test has
+// changed it
+func rehashListing(t *testing.T, contract *pb.RicardianContract) {
+	ser, err := proto.Marshal(contract.VendorListings[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := EncodeCID(ser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	contract.BuyerOrder.Items[0].ListingHash = id.String()
+}
+
+func TestBuildInvoice(t *testing.T) {
+	contract := newLedgerTestContract(t, "USD")
+	contract.VendorListings[0].Taxes = []*pb.Listing_Tax{{
+		TaxType:    "Sales tax",
+		TaxRegions: []pb.CountryCode{pb.CountryCode_UNITED_STATES},
+		Percentage: 10,
+	}}
+	rehashListing(t, contract)
+	contract.BuyerOrder.Shipping.ShipTo = "Jane Doe"
+	contract.BuyerOrder.Shipping.City = "Springfield"
+	contract.Refund = &pb.Refund{RefundTransaction: &pb.Refund_TransactionInfo{Txid: "refund", Value: 40000}, Memo: "out of stock"}
+	contract.Refund.Timestamp, _ = ptypes.TimestampProto(time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC))
+	records := []*wallet.TransactionRecord{{Txid: "payment", Value: 60000}}
+	issued := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	invoice, err := buildInvoice("order1", contract, records, "Widget Co", &repo.InvoiceRecord{InvoiceNumber: 7, Revision: 2, Issued: issued, Updated: issued})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if invoice.Number != "INV-000007" || invoice.Revision != 2 || invoice.Vendor.Name != "Widget Co" || invoice.Buyer.Name != "Jane Doe" {
+		t.Errorf("unexpected invoice header %+v", invoice)
+	}
+	if !invoice.Itemized || invoice.Currency != "USD" || len(invoice.Lines) != 1 {
+		t.Fatalf("expected one itemized USD line, got %+v", invoice)
+	}
+	line := invoice.Lines[0]
+	if line.Quantity != 2 || line.Subtotal != 2000 || line.Tax != 200 || line.Total != 2200 || len(line.Taxes) != 1 || line.Taxes[0].Type != "Sales tax" {
+		t.Errorf("unexpected line %+v", line)
+	}
+	if invoice.Shipping != 250 || invoice.Total != 2450 {
+		t.Errorf("expected 250 shipping and a 2450 total, got %d and %d", invoice.Shipping, invoice.Total)
+	}
+	if invoice.Payment.Paid != 60000 || invoice.Payment.BalanceDue != 40000 {
+		t.Errorf("unexpected payment %+v", invoice.Payment)
+	}
+	if len(invoice.Refunds) != 1 || invoice.Refunds[0].Amount != 40000 || invoice.Refunds[0].Memo != "out of stock" {
+		t.Errorf("unexpected refunds %+v", invoice.Refunds)
+	}
+}
+
+func TestBuildInvoiceNotItemized(t *testing.T) {
+	contract := newLedgerTestContract(t, "USD")
+	contract.VendorListings[0].Metadata.Format = pb.Listing_Metadata_MARKET_PRICE
+	rehashListing(t, contract)
+	invoice, err := buildInvoice("order1", contract, nil, "", &repo.InvoiceRecord{InvoiceNumber: 1, Revision: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if invoice.Itemized || invoice.Currency != "BTC" || invoice.Total != 100000 || len(invoice.Lines) != 1 || invoice.Lines[0].Quantity != 2 {
+		t.Errorf("unexpected invoice %+v", invoice)
+	}
+}
+
+func TestBuildInvoiceMilestones(t *testing.T) {
+	contract := newLedgerTestContract(t, "USD")
+	contract.BuyerOrder.Milestones = []*pb.Order_Milestone{
+		{Index: 0, Title: "Design", Amount: 40000},
+		{Index: 1, Title: "Build", Amount: 60000},
+	}
+	released := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	ts, _ := ptypes.TimestampProto(released)
+	contract.BuyerMilestoneReleases = []*pb.MilestoneRelease{{MilestoneIndex: 0, Timestamp: ts}}
+
+	invoice, err := buildInvoice("order1", contract, nil, "", &repo.InvoiceRecord{InvoiceNumber: 1, Revision: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(invoice.Milestones) != 2 {
+		t.Fatalf("expected 2 milestones, got %d", len(invoice.Milestones))
+	}
+	if m := invoice.Milestones[0]; !m.Released || m.ReleasedAt == nil || !m.ReleasedAt.Equal(released) {
+		t.Errorf("expected the first milestone to be released, got %+v", m)
+	}
+	if invoice.Milestones[1].Released {
+		t.Error("expected the second milestone to be pending")
+	}
+
+	contract.BuyerOrderCompletion = &pb.OrderCompletion{Timestamp: ts}
+	invoice, err = buildInvoice("order1", contract, nil, "", &repo.InvoiceRecord{InvoiceNumber: 1, Revision: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !invoice.Milestones[1].Released {
+		t.Error("expected completing the order to release the final milestone")
+	}
+}
+
+func TestWriteInvoicePDF(t *testing.T) {
+	contract := newLedgerTestContract(t, "USD")
+	contract.VendorListings[0].Item.Title = "Café (large)"
+	rehashListing(t, contract)
+	invoice, err := buildInvoice("order1", contract, nil, "", &repo.InvoiceRecord{InvoiceNumber: 3, Revision: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := WriteInvoicePDF(&buf, invoice, []byte{0xca, 0xfe}); err != nil {
+		t.Fatal(err)
+	}
+	pdf := buf.String()
+	if !strings.HasPrefix(pdf, "%PDF-1.4") || !strings.HasSuffix(pdf, "%%EOF\n") {
+		t.Error("expected a complete PDF document")
+	}
+	for _, s := range []string{"(INVOICE)", "(INV-000003)", `(Caf\351 \(large\))`, "(22.50 USD)", "(cafe)"} {
+		if !strings.Contains(pdf, s) {
+			t.Errorf("expected the PDF to contain %s", s)
+		}
+	}
+	// The cross-reference table must point at the objects
+	xref := strings.Index(pdf, "xref\n")
+	for n, line := range strings.Split(pdf[xref:], "\n")[3:8] {
+		offset, err := strconv.Atoi(line[:10])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasPrefix(pdf[offset:], strconv.Itoa(n+1)+" 0 obj") {
+			t.Errorf("object %d is not at offset %d", n+1, offset)
+		}
+	}
+}
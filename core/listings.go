@@ -199,6 +199,12 @@ func (n *OpenBazaarNode) SignListing(listing *pb.Listing) (*pb.SignedListing, er
 		return sl, err
 	}
 
+	// Our own listings must carry a consistent location. Listings fetched from
+	// other nodes are only checked with validateListing and used as published.
+	if err := ValidateAddress(listing.Location); err != nil {
+		return sl, fmt.Errorf("invalid location: %s", err)
+	}
+
 	// Set listing version
 	listing.Metadata.Version = ListingVersion
 
@@ -423,6 +429,11 @@ func (n *OpenBazaarNode) saveListing(listing *pb.Listing, publish bool) error {
 		return err
 	}
 
+	err = n.IndexListingSearch(listing)
+	if err != nil {
+		return err
+	}
+
 	// Update followers/following
 	err = n.UpdateFollow()
 	if err != nil {
@@ -468,6 +479,9 @@ func (n *OpenBazaarNode) updateListingIndex(listing *pb.SignedListing) error {
 	if err != nil {
 		return err
 	}
+	if err := n.IndexListingLocation(listing.Listing, ld.Hash); err != nil {
+		return err
+	}
 	return n.updateListingOnDisk(index, ld, false)
 }
 
@@ -806,6 +820,14 @@ func (n *OpenBazaarNode) DeleteListing(slug string) error {
 	if err != nil {
 		return err
 	}
+	err = n.Datastore.Locations().Delete(n.IpfsNode.Identity.Pretty(), slug)
+	if err != nil {
+		return err
+	}
+	err = n.Datastore.Search().Delete(repo.SearchDocumentTypeListing, slug)
+	if err != nil {
+		return err
+	}
 	err = n.PublishInventory()
 	if err != nil {
 		return err
@@ -1216,6 +1238,14 @@ func (n *OpenBazaarNode) validateListing(listing *pb.Listing, testnet bool) (err
 		}
 	}
 
+	// Milestones
+	if len(listing.Milestones) > 0 {
+		err := validateListingMilestones(listing)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Format-specific validations
 	if listing.Metadata.Format == pb.Listing_Metadata_MARKET_PRICE {
 		err := validateMarketPriceListing(listing)
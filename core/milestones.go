@@ -0,0 +1,450 @@
+package core
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	libp2p "gx/ipfs/QmTW4SdgBWq9GjsBsHeUx8WuGxzhgzAf88UMH2w62PC8yK/go-libp2p-crypto"
+
+	"github.com/OpenBazaar/wallet-interface"
+	btc "github.com/btcsuite/btcutil"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/kimitzu/kimitzu-go/pb"
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+const (
+	// MaxMilestones - max number of milestones in a listing
+	MaxMilestones = 20
+)
+
+var (
+	// ErrMilestonesReleased - all milestones of the order have been released
+	ErrMilestonesReleased = errors.New("all milestones for this order have been released")
+	// ErrMilestoneNotFunded - the current milestone has not been funded
+	ErrMilestoneNotFunded = errors.New("the current milestone has not been funded")
+	// ErrFinalMilestoneRelease - the final milestone is released through order completion
+	ErrFinalMilestoneRelease = errors.New("the final milestone is released by completing the order")
+)
+
+// IsMilestoneOrder returns true if the order is funded and released in milestones
+func IsMilestoneOrder(contract *pb.RicardianContract) bool {
+	return contract.BuyerOrder != nil && len(contract.BuyerOrder.Milestones) > 0
+}
+
+// CurrentMilestone returns the first milestone of the order which has not yet been released
+func CurrentMilestone(contract *pb.RicardianContract) (*pb.Order_Milestone, error) {
+	released := len(contract.BuyerMilestoneReleases)
+	if released >= len(contract.BuyerOrder.Milestones) {
+		return nil, ErrMilestonesReleased
+	}
+	return contract.BuyerOrder.Milestones[released], nil
+}
+
+// IsFinalMilestone returns true if the index refers to the last milestone of the order
+func IsFinalMilestone(contract *pb.RicardianContract, index uint32) bool {
+	return int(index) == len(contract.BuyerOrder.Milestones)-1
+}
+
+// MilestoneFunded returns true if the funds remaining in escrow cover the current milestone.
+// Earlier milestones have already been paid out of escrow so only the unspent balance counts.
+func MilestoneFunded(contract *pb.RicardianContract, records []*wallet.TransactionRecord) bool {
+	milestone, err := CurrentMilestone(contract)
+	if err != nil {
+		return false
+	}
+	return EscrowBalance(records) >= int64(milestone.Amount)
+}
+
+// EscrowBalance returns the value still held at the payment address. Spends are recorded
+// as negative values so the sum of the records is the unspent balance.
+func EscrowBalance(records []*wallet.TransactionRecord) int64 {
+	var balance int64
+	for _, r := range records {
+		balance += r.Value
+	}
+	return balance
+}
+
+// PayoutFulfillment returns the fulfillment holding the vendor's signatures for the
+// final escrow payout. For milestone orders this is the fulfillment of the last milestone.
+func PayoutFulfillment(contract *pb.RicardianContract) *pb.OrderFulfillment {
+	if len(contract.VendorOrderFulfillment) == 0 {
+		return nil
+	}
+	if IsMilestoneOrder(contract) {
+		return contract.VendorOrderFulfillment[len(contract.VendorOrderFulfillment)-1]
+	}
+	return contract.VendorOrderFulfillment[0]
+}
+
+func milestoneFulfillment(contract *pb.RicardianContract, index uint32) *pb.OrderFulfillment {
+	for _, f := range contract.VendorOrderFulfillment {
+		if f.MilestoneIndex == index {
+			return f
+		}
+	}
+	return nil
+}
+
+// buildOrderMilestones splits the order total across the listing's milestones in
+// proportion to each milestone's price. Any rounding remainder goes to the last milestone.
+func buildOrderMilestones(listing *pb.Listing, total uint64) []*pb.Order_Milestone {
+	var sum uint64
+	for _, m := range listing.Milestones {
+		sum += m.Price
+	}
+	var (
+		milestones []*pb.Order_Milestone
+		allocated  uint64
+	)
+	for i, m := range listing.Milestones {
+		amount := total - allocated
+		if i < len(listing.Milestones)-1 && sum > 0 {
+			amount = uint64(float64(total) * (float64(m.Price) / float64(sum)))
+		}
+		allocated += amount
+		milestones = append(milestones, &pb.Order_Milestone{
+			Index:  uint32(i),
+			Title:  m.Title,
+			Amount: amount,
+		})
+	}
+	return milestones
+}
+
+// addOrderMilestones attaches the milestone schedule to an order for PER_MILESTONE listings
+func addOrderMilestones(contract *pb.RicardianContract) error {
+	if len(contract.VendorListings) == 0 || len(contract.VendorListings[0].Milestones) == 0 {
+		return nil
+	}
+	if len(contract.BuyerOrder.Items) > 1 || len(contract.VendorListings) > 1 {
+		return errors.New("milestone listings must be purchased on their own")
+	}
+	contract.BuyerOrder.Milestones = buildOrderMilestones(contract.VendorListings[0], contract.BuyerOrder.Payment.Amount)
+	return nil
+}
+
+// paymentAmountDue returns the amount the buyer should send to the payment address now
+func paymentAmountDue(contract *pb.RicardianContract) uint64 {
+	if IsMilestoneOrder(contract) {
+		return contract.BuyerOrder.Milestones[0].Amount
+	}
+	return contract.BuyerOrder.Payment.Amount
+}
+
+func validateListingMilestones(listing *pb.Listing) error {
+	if listing.Metadata.ContractType != pb.Listing_Metadata_SERVICE {
+		return errors.New("milestones are only allowed on service listings")
+	}
+	if listing.Metadata.ServiceRateMethod != pb.Listing_Metadata_PER_MILESTONE {
+		return errors.New("milestones require the PER_MILESTONE service rate method")
+	}
+	if listing.Metadata.Format != pb.Listing_Metadata_FIXED_PRICE {
+		return errors.New("milestones are only allowed on fixed price listings")
+	}
+	if len(listing.Milestones) > MaxMilestones {
+		return fmt.Errorf("number of milestones is greater than the max of %d", MaxMilestones)
+	}
+	var sum uint64
+	for _, m := range listing.Milestones {
+		if m.Title == "" {
+			return errors.New("milestone title must not be empty")
+		}
+		if len(m.Title) > TitleMaxCharacters {
+			return fmt.Errorf("milestone title is longer than the max of %d characters", TitleMaxCharacters)
+		}
+		if len(m.Description) > DescriptionMaxCharacters {
+			return fmt.Errorf("milestone description is longer than the max of %d characters", DescriptionMaxCharacters)
+		}
+		if len(m.ProcessingTime) > SentenceMaxCharacters {
+			return fmt.Errorf("milestone processing time length must be less than the max of %d", SentenceMaxCharacters)
+		}
+		if m.Price == 0 {
+			return errors.New("milestone price must be greater than zero")
+		}
+		sum += m.Price
+	}
+	if sum != listing.Item.Price {
+		return errors.New("sum of milestone prices must equal the item price")
+	}
+	return nil
+}
+
+// validateOrderMilestones checks the buyer split the order total the same way we would
+func validateOrderMilestones(contract *pb.RicardianContract) error {
+	if len(contract.VendorListings[0].Milestones) == 0 {
+		if IsMilestoneOrder(contract) {
+			return errors.New("order contains milestones but the listing does not")
+		}
+		return nil
+	}
+	if len(contract.BuyerOrder.Items) > 1 || len(contract.VendorListings) > 1 {
+		return errors.New("milestone listings must be purchased on their own")
+	}
+	expected := buildOrderMilestones(contract.VendorListings[0], contract.BuyerOrder.Payment.Amount)
+	if len(expected) != len(contract.BuyerOrder.Milestones) {
+		return errors.New("order milestones do not match the listing")
+	}
+	for i, m := range contract.BuyerOrder.Milestones {
+		if !proto.Equal(m, expected[i]) {
+			return errors.New("order milestones do not match the listing")
+		}
+	}
+	return nil
+}
+
+// milestoneReleaseInputs returns the escrow outpoints the vendor signed when fulfilling the
+// current milestone. Funds which reached the escrow after the fulfillment are not covered by
+// the vendor's signatures and stay in escrow for the following milestones.
+func milestoneReleaseInputs(wal wallet.Wallet, payout *pb.OrderFulfillment_Payout, records []*wallet.TransactionRecord) ([]wallet.TransactionInput, int64, error) {
+	if len(payout.Inputs) == 0 {
+		return nil, 0, errors.New("fulfillment payout does not list the outpoints it spends")
+	}
+	unspent := make(map[string]*wallet.TransactionRecord)
+	for _, r := range records {
+		if !r.Spent && r.Value > 0 {
+			unspent[fmt.Sprintf("%s:%d", r.Txid, r.Index)] = r
+		}
+	}
+	var ins []wallet.TransactionInput
+	var outValue int64
+	for _, op := range payout.Inputs {
+		r, ok := unspent[fmt.Sprintf("%s:%d", op.Hash, op.Index)]
+		if !ok || uint64(r.Value) != op.Value {
+			return nil, 0, fmt.Errorf("escrow outpoint %s:%d signed by the vendor is not available", op.Hash, op.Index)
+		}
+		addr, err := wal.DecodeAddress(r.Address)
+		if err != nil {
+			return nil, 0, err
+		}
+		outpointHash, err := hex.DecodeString(r.Txid)
+		if err != nil {
+			return nil, 0, fmt.Errorf("decoding transaction hash: %s", err.Error())
+		}
+		outValue += r.Value
+		ins = append(ins, wallet.TransactionInput{
+			LinkedAddress: addr,
+			OutpointIndex: r.Index,
+			OutpointHash:  outpointHash,
+			Value:         r.Value,
+		})
+	}
+	return ins, outValue, nil
+}
+
+// milestonePayoutOutputs returns the outputs of the escrow transaction which releases the
+// current milestone to the vendor. Funds in escrow beyond the milestone amount are sent back
+// to the escrow address so they remain available for the following milestones. The wallet
+// splits the fee evenly across the outputs so the change is raised by its share and the
+// vendor output lowered by the same amount, leaving the vendor to pay the whole fee.
+func milestonePayoutOutputs(wal wallet.Wallet, contract *pb.RicardianContract, outValue int64, payoutAddress btc.Address, feePerByte uint64) ([]wallet.TransactionOutput, error) {
+	milestone, err := CurrentMilestone(contract)
+	if err != nil {
+		return nil, err
+	}
+	if outValue < int64(milestone.Amount) {
+		return nil, ErrMilestoneNotFunded
+	}
+	outputs := []wallet.TransactionOutput{{Address: payoutAddress, Value: int64(milestone.Amount)}}
+
+	// Leftovers too small to cover the fee go to the vendor
+	fee := int64(feePerByte * EscrowReleaseSize)
+	change := outValue - int64(milestone.Amount)
+	if change <= fee {
+		outputs[0].Value = outValue
+		return outputs, nil
+	}
+	escrowAddress, err := wal.DecodeAddress(contract.BuyerOrder.Payment.Address)
+	if err != nil {
+		return nil, err
+	}
+	share := fee / 2
+	if outputs[0].Value <= share {
+		return nil, errors.New("milestone amount does not cover the release fee")
+	}
+	outputs[0].Value -= share
+	return append(outputs, wallet.TransactionOutput{Address: escrowAddress, Value: change + share}), nil
+}
+
+// ReleaseMilestone - release the escrowed funds for the current milestone to the vendor
+func (n *OpenBazaarNode) ReleaseMilestone(contract *pb.RicardianContract, records []*wallet.TransactionRecord, note string) error {
+	orderID, err := n.CalcOrderID(contract.BuyerOrder)
+	if err != nil {
+		return err
+	}
+	milestone, err := CurrentMilestone(contract)
+	if err != nil {
+		return err
+	}
+	if IsFinalMilestone(contract, milestone.Index) {
+		return ErrFinalMilestoneRelease
+	}
+	fulfillment := milestoneFulfillment(contract, milestone.Index)
+	if fulfillment == nil {
+		return errors.New("vendor has not fulfilled the current milestone")
+	}
+
+	release := new(pb.MilestoneRelease)
+	release.OrderId = orderID
+	release.MilestoneIndex = milestone.Index
+	release.Note = note
+	ts, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return err
+	}
+	release.Timestamp = ts
+
+	if contract.BuyerOrder.Payment.Method == pb.Order_Payment_MODERATED {
+		wal, err := n.Multiwallet.WalletForCurrencyCode(contract.BuyerOrder.Payment.Coin)
+		if err != nil {
+			return err
+		}
+		if fulfillment.Payout == nil {
+			return errors.New("payout object for multisig is nil")
+		}
+		ins, outValue, err := milestoneReleaseInputs(wal, fulfillment.Payout, records)
+		if err != nil {
+			return err
+		}
+		payoutAddress, err := wal.DecodeAddress(fulfillment.Payout.PayoutAddress)
+		if err != nil {
+			return err
+		}
+		outputs, err := milestonePayoutOutputs(wal, contract, outValue, payoutAddress, fulfillment.Payout.PayoutFeePerByte)
+		if err != nil {
+			return err
+		}
+
+		chaincode, err := hex.DecodeString(contract.BuyerOrder.Payment.Chaincode)
+		if err != nil {
+			return err
+		}
+		mECKey, err := n.MasterPrivateKey.ECPrivKey()
+		if err != nil {
+			return err
+		}
+		buyerKey, err := wal.ChildKey(mECKey.Serialize(), chaincode, true)
+		if err != nil {
+			return err
+		}
+		redeemScript, err := hex.DecodeString(contract.BuyerOrder.Payment.RedeemScript)
+		if err != nil {
+			return err
+		}
+
+		buyerSignatures, err := wal.CreateMultisigSignature(ins, outputs, buyerKey, redeemScript, fulfillment.Payout.PayoutFeePerByte)
+		if err != nil {
+			return err
+		}
+		for _, s := range buyerSignatures {
+			release.PayoutSigs = append(release.PayoutSigs, &pb.BitcoinSignature{InputIndex: s.InputIndex, Signature: s.Signature})
+		}
+		var vendorSignatures []wallet.Signature
+		for _, s := range fulfillment.Payout.Sigs {
+			vendorSignatures = append(vendorSignatures, wallet.Signature{InputIndex: s.InputIndex, Signature: s.Signature})
+		}
+		err = MultisignEscrow(wal, contract.BuyerOrder.Payment, ins, outputs, buyerSignatures, vendorSignatures, redeemScript, fulfillment.Payout.PayoutFeePerByte)
+		if err != nil {
+			return err
+		}
+	}
+
+	rc := new(pb.RicardianContract)
+	rc.BuyerMilestoneReleases = []*pb.MilestoneRelease{release}
+	rc, err = n.SignMilestoneRelease(rc)
+	if err != nil {
+		return err
+	}
+	vendorkey, err := libp2p.UnmarshalPublicKey(contract.VendorListings[0].VendorID.Pubkeys.Identity)
+	if err != nil {
+		return err
+	}
+	err = n.SendMilestoneRelease(contract.VendorListings[0].VendorID.PeerID, &vendorkey, rc)
+	if err != nil {
+		return err
+	}
+
+	contract.BuyerMilestoneReleases = append(contract.BuyerMilestoneReleases, release)
+	for _, sig := range rc.Signatures {
+		if sig.Section == pb.Signature_MILESTONE_RELEASE {
+			contract.Signatures = append(contract.Signatures, sig)
+		}
+	}
+
+	// Reload the records as the wallet may have already seen the release transaction
+	if _, _, _, latest, _, _, err := n.Datastore.Purchases().GetByOrderId(orderID); err == nil {
+		records = latest
+	}
+	funded := MilestoneFunded(contract, records)
+	state := pb.OrderState_MILESTONE_RELEASED
+	if funded {
+		state = pb.OrderState_MILESTONE_FUNDED
+	}
+	if err := n.Datastore.Purchases().Put(orderID, *contract, state, true); err != nil {
+		return err
+	}
+	RecordExchangeRates(n.Datastore, n.Multiwallet, contract.BuyerOrder.Payment.Coin, repo.ExchangeRateReasonReleased, orderID)
+	return n.Datastore.Purchases().UpdateFunding(orderID, funded, records)
+}
+
+// SignMilestoneRelease - add signature to milestone release
+func (n *OpenBazaarNode) SignMilestoneRelease(contract *pb.RicardianContract) (*pb.RicardianContract, error) {
+	serializedRelease, err := proto.Marshal(contract.BuyerMilestoneReleases[0])
+	if err != nil {
+		return contract, err
+	}
+	s := new(pb.Signature)
+	s.Section = pb.Signature_MILESTONE_RELEASE
+	guidSig, err := n.IpfsNode.PrivateKey.Sign(serializedRelease)
+	if err != nil {
+		return contract, err
+	}
+	s.SignatureBytes = guidSig
+	contract.Signatures = append(contract.Signatures, s)
+	return contract, nil
+}
+
+// ValidateMilestoneRelease - validate a milestone release message received from the buyer.
+// The contract must be our copy of the order, release must be the release being applied and
+// sigs the signatures which accompanied it.
+func (n *OpenBazaarNode) ValidateMilestoneRelease(contract *pb.RicardianContract, release *pb.MilestoneRelease, sigs []*pb.Signature) error {
+	if !IsMilestoneOrder(contract) {
+		return errors.New("order does not contain milestones")
+	}
+	milestone, err := CurrentMilestone(contract)
+	if err != nil {
+		return err
+	}
+	if release.MilestoneIndex != milestone.Index {
+		return errors.New("release is not for the current milestone")
+	}
+	if IsFinalMilestone(contract, release.MilestoneIndex) {
+		return ErrFinalMilestoneRelease
+	}
+	if err := verifyMessageSignature(
+		release,
+		contract.BuyerOrder.BuyerID.Pubkeys.Identity,
+		sigs,
+		pb.Signature_MILESTONE_RELEASE,
+		contract.BuyerOrder.BuyerID.PeerID,
+	); err != nil {
+		switch err.(type) {
+		case noSigError:
+			return errors.New("contract does not contain a signature for the milestone release")
+		case invalidSigError:
+			return errors.New("buyer's guid signature on milestone release failed to verify")
+		case matchKeyError:
+			return errors.New("public key in order does not match reported buyer ID")
+		default:
+			return err
+		}
+	}
+	if contract.BuyerOrder.Payment.Method == pb.Order_Payment_MODERATED && len(release.PayoutSigs) == 0 {
+		return errors.New("milestone release for a moderated order is missing payout signatures")
+	}
+	return nil
+}
@@ -0,0 +1,264 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/btcsuite/btcd/chaincfg"
+	btc "github.com/btcsuite/btcutil"
+	"github.com/kimitzu/kimitzu-go/pb"
+)
+
+// milestoneTestWallet only decodes addresses, which is all the payout outputs need
+type milestoneTestWallet struct {
+	wallet.Wallet
+}
+
+func (w *milestoneTestWallet) DecodeAddress(addr string) (btc.Address, error) {
+	return btc.DecodeAddress(addr, &chaincfg.RegressionNetParams)
+}
+
+func newMilestoneTestAddress(t *testing.T, script string) btc.Address {
+	addr, err := btc.NewAddressScriptHash([]byte(script), &chaincfg.RegressionNetParams)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return addr
+}
+
+func newMilestoneTestListing() *pb.Listing {
+	return &pb.Listing{
+		Metadata: &pb.Listing_Metadata{
+			ContractType:      pb.Listing_Metadata_SERVICE,
+			ServiceRateMethod: pb.Listing_Metadata_PER_MILESTONE,
+			Format:            pb.Listing_Metadata_FIXED_PRICE,
+		},
+		Item: &pb.Listing_Item{Price: 300},
+		Milestones: []*pb.Listing_Milestone{
+			{Title: "Design", Price: 100},
+			{Title: "Build", Price: 100},
+			{Title: "Launch", Price: 100},
+		},
+	}
+}
+
+func newMilestoneTestContract(t *testing.T, amounts ...uint64) *pb.RicardianContract {
+	contract := &pb.RicardianContract{
+		VendorListings: []*pb.Listing{newMilestoneTestListing()},
+		BuyerOrder: &pb.Order{
+			Items: []*pb.Order_Item{{}},
+			Payment: &pb.Order_Payment{
+				Method:  pb.Order_Payment_MODERATED,
+				Address: newMilestoneTestAddress(t, "escrow").String(),
+			},
+		},
+	}
+	for i, amount := range amounts {
+		contract.BuyerOrder.Payment.Amount += amount
+		contract.BuyerOrder.Milestones = append(contract.BuyerOrder.Milestones, &pb.Order_Milestone{Index: uint32(i), Amount: amount})
+	}
+	return contract
+}
+
+func TestBuildOrderMilestones(t *testing.T) {
+	milestones := buildOrderMilestones(newMilestoneTestListing(), 100)
+	if len(milestones) != 3 {
+		t.Fatalf("expected 3 milestones, got %d", len(milestones))
+	}
+	var total uint64
+	for i, m := range milestones {
+		if m.Index != uint32(i) {
+			t.Errorf("expected milestone %d to have index %d, got %d", i, i, m.Index)
+		}
+		total += m.Amount
+	}
+	if milestones[0].Amount != 33 || milestones[1].Amount != 33 || milestones[2].Amount != 34 {
+		t.Errorf("expected the rounding remainder to go to the last milestone, got %v", milestones)
+	}
+	if total != 100 {
+		t.Errorf("expected milestones to add up to 100, got %d", total)
+	}
+}
+
+func TestValidateListingMilestones(t *testing.T) {
+	if err := validateListingMilestones(newMilestoneTestListing()); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]func(l *pb.Listing){
+		"physical good":     func(l *pb.Listing) { l.Metadata.ContractType = pb.Listing_Metadata_PHYSICAL_GOOD },
+		"hourly rate":       func(l *pb.Listing) { l.Metadata.ServiceRateMethod = pb.Listing_Metadata_PER_HOUR },
+		"market price":      func(l *pb.Listing) { l.Metadata.Format = pb.Listing_Metadata_MARKET_PRICE },
+		"empty title":       func(l *pb.Listing) { l.Milestones[0].Title = "" },
+		"zero price":        func(l *pb.Listing) { l.Milestones[0].Price = 0 },
+		"price mismatch":    func(l *pb.Listing) { l.Item.Price = 301 },
+		"too many":          func(l *pb.Listing) { l.Milestones = make([]*pb.Listing_Milestone, MaxMilestones+1) },
+		"long description":  func(l *pb.Listing) { l.Milestones[1].Description = string(make([]byte, DescriptionMaxCharacters+1)) },
+		"long process time": func(l *pb.Listing) { l.Milestones[2].ProcessingTime = string(make([]byte, SentenceMaxCharacters+1)) },
+	}
+	for name, modify := range tests {
+		listing := newMilestoneTestListing()
+		modify(listing)
+		if err := validateListingMilestones(listing); err == nil {
+			t.Errorf("expected listing with %s to fail validation", name)
+		}
+	}
+}
+
+func TestValidateOrderMilestones(t *testing.T) {
+	contract := newMilestoneTestContract(t, 33, 33, 34)
+	for i, m := range buildOrderMilestones(contract.VendorListings[0], 100) {
+		contract.BuyerOrder.Milestones[i].Title = m.Title
+	}
+	if err := validateOrderMilestones(contract); err != nil {
+		t.Fatal(err)
+	}
+
+	contract.BuyerOrder.Milestones[2].Amount = 35
+	if err := validateOrderMilestones(contract); err == nil {
+		t.Error("expected milestones which do not match the listing to fail")
+	}
+	contract.BuyerOrder.Milestones = contract.BuyerOrder.Milestones[:2]
+	if err := validateOrderMilestones(contract); err == nil {
+		t.Error("expected missing milestones to fail")
+	}
+	contract.BuyerOrder.Items = append(contract.BuyerOrder.Items, &pb.Order_Item{})
+	if err := validateOrderMilestones(contract); err == nil {
+		t.Error("expected a milestone order with several items to fail")
+	}
+
+	contract = newMilestoneTestContract(t, 100)
+	contract.VendorListings[0].Milestones = nil
+	if err := validateOrderMilestones(contract); err == nil {
+		t.Error("expected milestones on a listing without milestones to fail")
+	}
+	contract.BuyerOrder.Milestones = nil
+	if err := validateOrderMilestones(contract); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMilestoneFunded(t *testing.T) {
+	contract := newMilestoneTestContract(t, 30000, 70000)
+	records := []*wallet.TransactionRecord{{Txid: "payment", Value: 20000}}
+	if MilestoneFunded(contract, records) {
+		t.Error("expected a partly paid milestone not to be funded")
+	}
+	records = append(records, &wallet.TransactionRecord{Txid: "topup", Value: 10000})
+	if !MilestoneFunded(contract, records) {
+		t.Error("expected the first milestone to be funded")
+	}
+
+	// The first release spends both receipts and nothing is left for the second milestone
+	contract.BuyerMilestoneReleases = []*pb.MilestoneRelease{{MilestoneIndex: 0}}
+	records = append(records,
+		&wallet.TransactionRecord{Txid: "release", Value: -20000},
+		&wallet.TransactionRecord{Txid: "release", Value: -10000},
+	)
+	if MilestoneFunded(contract, records) {
+		t.Error("expected the second milestone not to be funded after the release")
+	}
+	records = append(records, &wallet.TransactionRecord{Txid: "second", Value: 70000})
+	if !MilestoneFunded(contract, records) {
+		t.Error("expected the second milestone to be funded")
+	}
+
+	// A prepaid order only counts the change actually returned to escrow
+	contract = newMilestoneTestContract(t, 30000, 70000)
+	contract.BuyerMilestoneReleases = []*pb.MilestoneRelease{{MilestoneIndex: 0}}
+	records = []*wallet.TransactionRecord{
+		{Txid: "payment", Value: 100000},
+		{Txid: "release", Value: -100000},
+		{Txid: "release", Value: 69000},
+	}
+	if MilestoneFunded(contract, records) {
+		t.Error("expected a milestone short of the fee lost from escrow not to be funded")
+	}
+	records[2].Value = 70000
+	if !MilestoneFunded(contract, records) {
+		t.Error("expected the prepaid milestone to be funded")
+	}
+
+	contract.BuyerMilestoneReleases = append(contract.BuyerMilestoneReleases, &pb.MilestoneRelease{MilestoneIndex: 1})
+	if MilestoneFunded(contract, records) {
+		t.Error("expected an order with every milestone released not to be funded")
+	}
+}
+
+func TestMilestonePayoutOutputs(t *testing.T) {
+	wal := &milestoneTestWallet{}
+	contract := newMilestoneTestContract(t, 30000, 70000)
+	payoutAddress := newMilestoneTestAddress(t, "vendor")
+
+	// The change carries half of the 3370 fee so the wallet's even split leaves 70000 in escrow
+	outputs, err := milestonePayoutOutputs(wal, contract, 100000, payoutAddress, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(outputs) != 2 {
+		t.Fatalf("expected 2 outputs, got %d", len(outputs))
+	}
+	if outputs[0].Address.String() != payoutAddress.String() || outputs[0].Value != 28315 {
+		t.Errorf("expected 28315 paid to the vendor, got %d to %s", outputs[0].Value, outputs[0].Address)
+	}
+	if outputs[1].Address.String() != contract.BuyerOrder.Payment.Address || outputs[1].Value != 71685 {
+		t.Errorf("expected 71685 returned to escrow, got %d to %s", outputs[1].Value, outputs[1].Address)
+	}
+	if outputs[0].Value+outputs[1].Value != 100000 {
+		t.Error("expected the outputs to spend the whole escrow balance")
+	}
+
+	// Change which would not cover the fee goes to the vendor
+	outputs, err = milestonePayoutOutputs(wal, contract, 33000, payoutAddress, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(outputs) != 1 || outputs[0].Value != 33000 {
+		t.Errorf("expected a single output of 33000, got %v", outputs)
+	}
+
+	if _, err := milestonePayoutOutputs(wal, contract, 29999, payoutAddress, 10); err != ErrMilestoneNotFunded {
+		t.Errorf("expected %s, got %v", ErrMilestoneNotFunded, err)
+	}
+
+	contract.BuyerMilestoneReleases = []*pb.MilestoneRelease{{}, {}}
+	if _, err := milestonePayoutOutputs(wal, contract, 100000, payoutAddress, 10); err != ErrMilestonesReleased {
+		t.Errorf("expected %s, got %v", ErrMilestonesReleased, err)
+	}
+}
+
+func TestMilestoneReleaseInputs(t *testing.T) {
+	wal := &milestoneTestWallet{}
+	escrow := newMilestoneTestAddress(t, "escrow").String()
+	records := []*wallet.TransactionRecord{
+		{Txid: "aa01", Index: 0, Value: 20000, Address: escrow},
+		{Txid: "aa02", Index: 1, Value: 10000, Address: escrow},
+	}
+	payout := &pb.OrderFulfillment_Payout{Inputs: []*pb.Outpoint{
+		{Hash: "aa01", Index: 0, Value: 20000},
+		{Hash: "aa02", Index: 1, Value: 10000},
+	}}
+
+	// The buyer funds the next milestone after the vendor signed the fulfillment
+	records = append(records, &wallet.TransactionRecord{Txid: "bb01", Index: 0, Value: 70000, Address: escrow})
+	ins, outValue, err := milestoneReleaseInputs(wal, payout, records)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ins) != 2 || outValue != 30000 {
+		t.Fatalf("expected the 2 signed outpoints worth 30000, got %d worth %d", len(ins), outValue)
+	}
+	for i, in := range ins {
+		if in.OutpointIndex != payout.Inputs[i].Index || in.Value != int64(payout.Inputs[i].Value) {
+			t.Errorf("expected input %d to spend %s:%d, got %x:%d", i, payout.Inputs[i].Hash, payout.Inputs[i].Index, in.OutpointHash, in.OutpointIndex)
+		}
+	}
+
+	records[0].Spent = true
+	if _, _, err := milestoneReleaseInputs(wal, payout, records); err == nil {
+		t.Error("expected a signed outpoint which has been spent to fail")
+	}
+	if _, _, err := milestoneReleaseInputs(wal, &pb.OrderFulfillment_Payout{}, records); err == nil {
+		t.Error("expected a payout without outpoints to fail")
+	}
+}
@@ -12,8 +12,10 @@ import (
 	"path/filepath"
 
 	"github.com/OpenBazaar/jsonpb"
+	"github.com/golang/protobuf/proto"
 	"github.com/kimitzu/kimitzu-go/ipfs"
 	"github.com/kimitzu/kimitzu-go/pb"
+	"github.com/kimitzu/kimitzu-go/repo"
 	"golang.org/x/net/context"
 )
 
@@ -77,6 +79,7 @@ func (n *OpenBazaarNode) SetSelfAsModerator(moderator *pb.Moderator) error {
 
 		profile.Moderator = true
 		profile.ModeratorInfo = moderator
+		n.setModeratorStats(moderator)
 		err = n.UpdateProfile(&profile)
 		if err != nil {
 			return err
@@ -107,6 +110,43 @@ func (n *OpenBazaarNode) SetSelfAsModerator(moderator *pb.Moderator) error {
 	return nil
 }
 
+// ModeratorStats - aggregate metrics of the disputes we have moderated
+func (n *OpenBazaarNode) ModeratorStats() (*repo.CaseStats, error) {
+	return n.Datastore.Cases().GetStats(n.IpfsNode.Identity.Pretty())
+}
+
+// moderatorStatsSummary returns the part of our statistics we publish with
+// our profile. Fees earned are kept private.
+func moderatorStatsSummary(stats *repo.CaseStats) *pb.Moderator_Stats {
+	return &pb.Moderator_Stats{
+		OpenCases:               uint32(stats.Open),
+		ClosedCases:             uint32(stats.Closed),
+		MedianResolutionSeconds: stats.MedianResolutionSeconds,
+		BuyerWinRatio:           float32(stats.BuyerWinRatio),
+		VendorWinRatio:          float32(stats.VendorWinRatio),
+	}
+}
+
+// setModeratorStats sets the published summary of our statistics on our
+// moderator info, or clears it if we do not publish it. Returns whether
+// the summary changed.
+func (n *OpenBazaarNode) setModeratorStats(moderator *pb.Moderator) bool {
+	var summary *pb.Moderator_Stats
+	if moderator.PublishStats {
+		stats, err := n.ModeratorStats()
+		if err != nil {
+			log.Errorf("failed computing moderator stats: %s", err)
+			return false
+		}
+		summary = moderatorStatsSummary(stats)
+	}
+	if proto.Equal(summary, moderator.Stats) {
+		return false
+	}
+	moderator.Stats = summary
+	return true
+}
+
 // RemoveSelfAsModerator - relinquish moderatorship
 func (n *OpenBazaarNode) RemoveSelfAsModerator() error {
 	// Update profile
@@ -0,0 +1,288 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kimitzu/kimitzu-go/pb"
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+const (
+	// EarthRadiusKm - mean radius of the earth used for distance calculations
+	EarthRadiusKm = 6371.0088
+	// MaxNearbyRadiusKm - the largest radius accepted by SearchNearby
+	MaxNearbyRadiusKm   = 20000
+	kmPerDegreeLatitude = 111.32
+	// plusCodeTolerance absorbs rounding of coordinates on the edge of a code area
+	plusCodeTolerance = 1e-9
+)
+
+// ErrNoCoordinates - the address has neither valid coordinates nor a full plus code
+var ErrNoCoordinates = errors.New("address has no coordinates")
+
+// NearbyResult - a listing or profile found by SearchNearby
+type NearbyResult struct {
+	Type       string  `json:"type"`
+	PeerID     string  `json:"peerId"`
+	Slug       string  `json:"slug,omitempty"`
+	Hash       string  `json:"hash,omitempty"`
+	Title      string  `json:"title"`
+	Thumbnail  string  `json:"thumbnail,omitempty"`
+	Latitude   float64 `json:"latitude"`
+	Longitude  float64 `json:"longitude"`
+	PlusCode   string  `json:"plusCode,omitempty"`
+	DistanceKm float64 `json:"distanceKm"`
+}
+
+// AddressCoordinates returns the coordinates of an address, decoding its plus
+// code when the latitude and longitude are not set
+func AddressCoordinates(address *pb.Address) (latitude, longitude float64, err error) {
+	if address == nil {
+		return 0, 0, ErrNoCoordinates
+	}
+	if address.Latitude != "" && address.Longitude != "" {
+		latitude, err = strconv.ParseFloat(strings.TrimSpace(address.Latitude), 64)
+		if err != nil {
+			return 0, 0, err
+		}
+		longitude, err = strconv.ParseFloat(strings.TrimSpace(address.Longitude), 64)
+		if err != nil {
+			return 0, 0, err
+		}
+		if math.Abs(latitude) > plusCodeLatitudeMax || math.Abs(longitude) > plusCodeLongitudeMax {
+			return 0, 0, errors.New("coordinates are out of range")
+		}
+		return latitude, longitude, nil
+	}
+	if address.PlusCode != "" {
+		area, err := DecodePlusCode(address.PlusCode)
+		if err != nil {
+			return 0, 0, err
+		}
+		latitude, longitude = area.Center()
+		return latitude, longitude, nil
+	}
+	return 0, 0, ErrNoCoordinates
+}
+
+// ValidateAddress checks that the coordinates and plus code of an address
+// describe the same location. A short plus code is only accepted together
+// with coordinates it can be recovered against.
+func ValidateAddress(address *pb.Address) error {
+	if address == nil {
+		return nil
+	}
+	if (address.Latitude == "") != (address.Longitude == "") {
+		return errors.New("latitude and longitude must be set together")
+	}
+	if len(address.PlusCode) > WordMaxCharacters {
+		return fmt.Errorf("plus code character length is greater than the max of %d", WordMaxCharacters)
+	}
+	hasCoordinates := address.Latitude != ""
+	var latitude, longitude float64
+	if hasCoordinates {
+		var err error
+		latitude, longitude, err = AddressCoordinates(&pb.Address{Latitude: address.Latitude, Longitude: address.Longitude})
+		if err != nil {
+			return fmt.Errorf("invalid coordinates: %s", err)
+		}
+	}
+	if address.PlusCode == "" {
+		return nil
+	}
+	if !IsValidPlusCode(address.PlusCode) {
+		return ErrInvalidPlusCode
+	}
+	if !hasCoordinates {
+		if !IsFullPlusCode(address.PlusCode) {
+			return ErrShortPlusCode
+		}
+		return nil
+	}
+	code, err := RecoverPlusCode(address.PlusCode, latitude, longitude)
+	if err != nil {
+		return err
+	}
+	area, err := DecodePlusCode(code)
+	if err != nil {
+		return err
+	}
+	if latitude < area.LatitudeLo-plusCodeTolerance || latitude > area.LatitudeHi+plusCodeTolerance ||
+		longitude < area.LongitudeLo-plusCodeTolerance || longitude > area.LongitudeHi+plusCodeTolerance {
+		return errors.New("plus code does not match the coordinates")
+	}
+	return nil
+}
+
+// FillAddressCoordinates completes an address which only has coordinates or
+// only has a full plus code by deriving the missing representation
+func FillAddressCoordinates(address *pb.Address) error {
+	if address == nil {
+		return nil
+	}
+	switch {
+	case address.Latitude != "" && address.Longitude != "" && address.PlusCode == "":
+		latitude, longitude, err := AddressCoordinates(address)
+		if err != nil {
+			return err
+		}
+		address.PlusCode, err = EncodePlusCode(latitude, longitude, PlusCodeDefaultLength)
+		return err
+	case address.Latitude == "" && address.Longitude == "" && address.PlusCode != "":
+		latitude, longitude, err := AddressCoordinates(address)
+		if err != nil {
+			return err
+		}
+		address.Latitude = strconv.FormatFloat(latitude, 'f', -1, 64)
+		address.Longitude = strconv.FormatFloat(longitude, 'f', -1, 64)
+	}
+	return nil
+}
+
+// primaryAddress returns the primary address of a profile's extended location
+func primaryAddress(profile *pb.Profile) *pb.Address {
+	if profile.ExtLocation == nil || len(profile.ExtLocation.Addresses) == 0 {
+		return nil
+	}
+	primary := int(profile.ExtLocation.Primary)
+	if primary < 0 || primary >= len(profile.ExtLocation.Addresses) {
+		primary = 0
+	}
+	return profile.ExtLocation.Addresses[primary]
+}
+
+// DistanceKm returns the great-circle distance between two points
+func DistanceKm(lat1, lng1, lat2, lng2 float64) float64 {
+	toRad := func(d float64) float64 { return d * math.Pi / 180 }
+	dLat := toRad(lat2 - lat1)
+	dLng := toRad(lng2 - lng1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return EarthRadiusKm * 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
+
+// boundingBox returns a box which contains every point within radiusKm of the
+// given point. West is greater than east when the box crosses the antimeridian.
+func boundingBox(latitude, longitude, radiusKm float64) (south, west, north, east float64) {
+	dLat := radiusKm / kmPerDegreeLatitude
+	south, north = latitude-dLat, latitude+dLat
+	if south <= -plusCodeLatitudeMax || north >= plusCodeLatitudeMax {
+		// The circle covers a pole so every longitude is in range
+		return math.Max(south, -plusCodeLatitudeMax), -plusCodeLongitudeMax, math.Min(north, plusCodeLatitudeMax), plusCodeLongitudeMax
+	}
+	dLng := radiusKm / (kmPerDegreeLatitude * math.Cos(latitude*math.Pi/180))
+	if dLng >= plusCodeLongitudeMax {
+		return south, -plusCodeLongitudeMax, north, plusCodeLongitudeMax
+	}
+	west, east = longitude-dLng, longitude+dLng
+	if west < -plusCodeLongitudeMax {
+		west += 360
+	}
+	if east > plusCodeLongitudeMax {
+		east -= 360
+	}
+	return south, west, north, east
+}
+
+// IndexListingLocation adds the listing to the spatial index, or removes it
+// if it no longer carries a location
+func (n *OpenBazaarNode) IndexListingLocation(listing *pb.Listing, hash string) error {
+	if listing == nil || listing.VendorID == nil {
+		return errors.New("listing is missing the vendor ID")
+	}
+	latitude, longitude, err := AddressCoordinates(listing.Location)
+	if err != nil {
+		return n.Datastore.Locations().Delete(listing.VendorID.PeerID, listing.Slug)
+	}
+	record := &repo.LocationRecord{
+		PeerID:    listing.VendorID.PeerID,
+		Slug:      listing.Slug,
+		Type:      repo.LocationTypeListing,
+		Hash:      hash,
+		Latitude:  latitude,
+		Longitude: longitude,
+		PlusCode:  listing.Location.PlusCode,
+		Timestamp: time.Now(),
+	}
+	if listing.Item != nil {
+		record.Title = listing.Item.Title
+		if len(listing.Item.Images) > 0 {
+			record.Thumbnail = listing.Item.Images[0].Tiny
+		}
+	}
+	return n.Datastore.Locations().Put(record)
+}
+
+// IndexProfileLocation adds the profile's primary address to the spatial
+// index, or removes it if the profile no longer carries one
+func (n *OpenBazaarNode) IndexProfileLocation(profile *pb.Profile) error {
+	if profile == nil || profile.PeerID == "" {
+		return errors.New("profile is missing the peer ID")
+	}
+	address := primaryAddress(profile)
+	latitude, longitude, err := AddressCoordinates(address)
+	if err != nil {
+		return n.Datastore.Locations().Delete(profile.PeerID, "")
+	}
+	record := &repo.LocationRecord{
+		PeerID:    profile.PeerID,
+		Type:      repo.LocationTypeProfile,
+		Title:     profile.Name,
+		Latitude:  latitude,
+		Longitude: longitude,
+		PlusCode:  address.PlusCode,
+		Timestamp: time.Now(),
+	}
+	if profile.AvatarHashes != nil {
+		record.Thumbnail = profile.AvatarHashes.Tiny
+	}
+	return n.Datastore.Locations().Put(record)
+}
+
+// SearchNearby returns the indexed listings and profiles within radiusKm of
+// the given point, nearest first. A limit of zero returns all results.
+func (n *OpenBazaarNode) SearchNearby(latitude, longitude, radiusKm float64, typeFilter []repo.LocationType, limit int) ([]NearbyResult, error) {
+	if math.Abs(latitude) > plusCodeLatitudeMax || math.Abs(longitude) > plusCodeLongitudeMax {
+		return nil, errors.New("coordinates are out of range")
+	}
+	if radiusKm <= 0 || radiusKm > MaxNearbyRadiusKm {
+		return nil, errors.New("radius is out of range")
+	}
+	south, west, north, east := boundingBox(latitude, longitude, radiusKm)
+	records, err := n.Datastore.Locations().GetWithin(south, west, north, east, typeFilter)
+	if err != nil {
+		return nil, err
+	}
+	results := []NearbyResult{}
+	for _, r := range records {
+		distance := DistanceKm(latitude, longitude, r.Latitude, r.Longitude)
+		if distance > radiusKm {
+			continue
+		}
+		results = append(results, NearbyResult{
+			Type:       r.Type.String(),
+			PeerID:     r.PeerID,
+			Slug:       r.Slug,
+			Hash:       r.Hash,
+			Title:      r.Title,
+			Thumbnail:  r.Thumbnail,
+			Latitude:   r.Latitude,
+			Longitude:  r.Longitude,
+			PlusCode:   r.PlusCode,
+			DistanceKm: distance,
+		})
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].DistanceKm < results[j].DistanceKm
+	})
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return results, nil
+}
@@ -0,0 +1,103 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/kimitzu/kimitzu-go/core"
+	"github.com/kimitzu/kimitzu-go/pb"
+	"github.com/kimitzu/kimitzu-go/repo"
+	"github.com/kimitzu/kimitzu-go/test"
+)
+
+func TestDistanceKm(t *testing.T) {
+	// Manila to Cebu City
+	d := core.DistanceKm(14.5995, 120.9842, 10.3157, 123.8854)
+	if d < 565 || d > 575 {
+		t.Errorf("unexpected distance between Manila and Cebu: %f", d)
+	}
+	if d := core.DistanceKm(-17.7, 179.9, -17.7, -179.9); d > 25 {
+		t.Errorf("unexpected distance across the antimeridian: %f", d)
+	}
+}
+
+func TestOpenBazaarNode_SearchNearby(t *testing.T) {
+	node, err := test.NewNode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	listing := func(slug, lat, lng, plusCode string) *pb.Listing {
+		return &pb.Listing{
+			Slug:     slug,
+			VendorID: &pb.ID{PeerID: "QmVendor"},
+			Item:     &pb.Listing_Item{Title: slug},
+			Location: &pb.Address{Latitude: lat, Longitude: lng, PlusCode: plusCode},
+		}
+	}
+	for _, l := range []*pb.Listing{
+		listing("makati", "14.5547", "121.0244", ""),
+		listing("manila", "14.5995", "120.9842", ""),
+		listing("laguna", "", "", "7Q6389M9+"),
+		listing("nowhere", "", "", ""),
+	} {
+		if err := node.IndexListingLocation(l, "Qm"+l.Slug); err != nil {
+			t.Fatal(err)
+		}
+	}
+	profile := &pb.Profile{
+		PeerID: "QmProfile",
+		Name:   "Quezon City",
+		ExtLocation: &pb.ExtLocation{
+			Addresses: []*pb.Address{{Latitude: "14.6760", Longitude: "121.0437"}},
+		},
+	}
+	if err := node.IndexProfileLocation(profile); err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := node.SearchNearby(14.5995, 120.9842, 25, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	if results[0].Slug != "manila" || results[1].Slug != "makati" || results[2].PeerID != "QmProfile" {
+		t.Errorf("results are not ordered by distance: %+v", results)
+	}
+	for i := 1; i < len(results); i++ {
+		if results[i].DistanceKm < results[i-1].DistanceKm {
+			t.Errorf("results are not ordered by distance: %+v", results)
+		}
+	}
+
+	results, err = node.SearchNearby(14.5995, 120.9842, 25, []repo.LocationType{repo.LocationTypeProfile}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].Type != repo.LocationTypeProfile.String() {
+		t.Errorf("expected only the profile, got %+v", results)
+	}
+
+	results, err = node.SearchNearby(14.5995, 120.9842, 1000, nil, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 {
+		t.Errorf("expected the limit to be applied, got %d results", len(results))
+	}
+
+	results, err = node.SearchNearby(14.3337, 121.3687, 10, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].Slug != "laguna" {
+		t.Errorf("expected the listing located by plus code, got %+v", results)
+	}
+
+	if _, err := node.SearchNearby(14.5995, 120.9842, 0, nil, 0); err == nil {
+		t.Error("expected an error for a zero radius")
+	}
+	if _, err := node.SearchNearby(91, 120.9842, 10, nil, 0); err == nil {
+		t.Error("expected an error for an out of range latitude")
+	}
+}
@@ -37,11 +37,13 @@ func (n *OpenBazaarNode) sendMessage(peerID string, k *libp2p.PubKey, message pb
 	p, err := peer.IDB58Decode(peerID)
 	if err != nil {
 		log.Errorf("failed to decode peerID: %v", err)
+		n.auditMessage(peerID, &message, false, err)
 		return err
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), n.OfflineMessageFailoverTimeout)
 	defer cancel()
 	err = n.Service.SendMessage(ctx, p, &message)
+	n.auditMessage(peerID, &message, err == nil, nil)
 	if err != nil {
 		go func() {
 			if err := n.SendOfflineMessage(p, k, &message); err != nil {
@@ -284,7 +286,9 @@ func (n *OpenBazaarNode) ResendCachedOrderMessage(orderID string, msgType pb.Mes
 	ctx, cancel := context.WithTimeout(context.Background(), n.OfflineMessageFailoverTimeout)
 	defer cancel()
 
-	if err = n.Service.SendMessage(ctx, p, &msg.Msg); err != nil {
+	err = n.Service.SendMessage(ctx, p, &msg.Msg)
+	n.auditMessage(peerID, &msg.Msg, err == nil, nil)
+	if err != nil {
 		go func() {
 			if err := n.SendOfflineMessage(p, nil, &msg.Msg); err != nil {
 				log.Errorf("error resending offline message for order id (%s) and message type (%+v): %s", orderID, msgType, err.Error())
@@ -325,6 +329,7 @@ func (n *OpenBazaarNode) SendOrder(peerID string, contract *pb.RicardianContract
 		}
 	}
 	resp, err = n.Service.SendRequest(ctx, p, &m)
+	n.auditMessage(peerID, &m, err == nil, err)
 	if err != nil {
 		log.Errorf("failed to send order request: %v", err)
 		return resp, err
@@ -525,6 +530,62 @@ func (n *OpenBazaarNode) SendDisputeUpdate(peerID string, updateMessage *pb.Disp
 	return n.sendMessage(peerID, nil, m)
 }
 
+// SendDisputePanelVote - send a moderator panel vote to another panel member
+func (n *OpenBazaarNode) SendDisputePanelVote(peerID string, vote *pb.DisputeResolution_PanelVote) error {
+	a, err := ptypes.MarshalAny(vote)
+	if err != nil {
+		log.Errorf("failed to marshal the panel vote: %v", err)
+		return err
+	}
+	m := pb.Message{
+		MessageType: pb.Message_DISPUTE_PANEL_VOTE,
+		Payload:     a,
+	}
+	return n.sendMessage(peerID, nil, m)
+}
+
+// SendDisputeEvidence - send evidence attached to a dispute to peer
+func (n *OpenBazaarNode) SendDisputeEvidence(peerID string, k *libp2p.PubKey, evidenceMessage *pb.RicardianContract) error {
+	a, err := ptypes.MarshalAny(evidenceMessage)
+	if err != nil {
+		log.Errorf("failed to marshal the contract: %v", err)
+		return err
+	}
+	m := pb.Message{
+		MessageType: pb.Message_DISPUTE_EVIDENCE,
+		Payload:     a,
+	}
+	return n.sendMessage(peerID, k, m)
+}
+
+// SendCaseMessage - send a message posted to a dispute to another participant
+func (n *OpenBazaarNode) SendCaseMessage(peerID string, k *libp2p.PubKey, caseMessage *pb.SignedCaseMessage) error {
+	a, err := ptypes.MarshalAny(caseMessage)
+	if err != nil {
+		log.Errorf("failed to marshal the case message: %v", err)
+		return err
+	}
+	m := pb.Message{
+		MessageType: pb.Message_CASE_MESSAGE,
+		Payload:     a,
+	}
+	return n.sendMessage(peerID, k, m)
+}
+
+// SendDisputePanelPayout - send the payout of a panel resolution to the next panel member to sign it
+func (n *OpenBazaarNode) SendDisputePanelPayout(peerID string, resolution *pb.DisputeResolution) error {
+	a, err := ptypes.MarshalAny(resolution)
+	if err != nil {
+		log.Errorf("failed to marshal the panel payout: %v", err)
+		return err
+	}
+	m := pb.Message{
+		MessageType: pb.Message_DISPUTE_PANEL_PAYOUT,
+		Payload:     a,
+	}
+	return n.sendMessage(peerID, nil, m)
+}
+
 // SendDisputeClose - send dispute closed msg to peer
 func (n *OpenBazaarNode) SendDisputeClose(peerID string, k *libp2p.PubKey, resolutionMessage *pb.RicardianContract) error {
 	a, err := ptypes.MarshalAny(resolutionMessage)
@@ -800,9 +861,198 @@ func (n *OpenBazaarNode) SendOrderPayment(peerID string, paymentMessage *pb.Orde
 	err = n.Service.SendMessage(ctx, p, &m)
 	cancel()
 	if err != nil {
-		if err := n.SendOfflineMessage(p, nil, &m); err != nil {
-			return err
-		}
+		err = n.SendOfflineMessage(p, nil, &m)
+		n.auditMessage(peerID, &m, false, err)
+		return err
 	}
+	n.auditMessage(peerID, &m, true, nil)
 	return nil
 }
+
+// SendMilestoneRelease - send milestone release msg to peer
+func (n *OpenBazaarNode) SendMilestoneRelease(peerID string, k *libp2p.PubKey, releaseMessage *pb.RicardianContract) error {
+	a, err := ptypes.MarshalAny(releaseMessage)
+	if err != nil {
+		log.Errorf("failed to marshal the contract: %v", err)
+		return err
+	}
+	m := pb.Message{
+		MessageType: pb.Message_MILESTONE_RELEASE,
+		Payload:     a,
+	}
+	release := releaseMessage.BuyerMilestoneReleases[0]
+	if release.OrderId == "" {
+		log.Errorf("failed fetching orderID")
+	} else {
+		err = n.Datastore.Messages().Put(
+			fmt.Sprintf("%s-%d-%d", release.OrderId, int(pb.Message_MILESTONE_RELEASE), release.MilestoneIndex),
+			release.OrderId, pb.Message_MILESTONE_RELEASE, peerID, repo.Message{Msg: m})
+		if err != nil {
+			log.Errorf("failed putting message (%s-%d-%d): %v", release.OrderId, int(pb.Message_MILESTONE_RELEASE), release.MilestoneIndex, err)
+		}
+	}
+	return n.sendMessage(peerID, k, m)
+}
+
+// SendPartialRefund - send partial refund msg to peer
+func (n *OpenBazaarNode) SendPartialRefund(peerID string, k *libp2p.PubKey, refundMessage *pb.RicardianContract) error {
+	a, err := ptypes.MarshalAny(refundMessage)
+	if err != nil {
+		log.Errorf("failed to marshal the contract: %v", err)
+		return err
+	}
+	m := pb.Message{
+		MessageType: pb.Message_PARTIAL_REFUND,
+		Payload:     a,
+	}
+	refund := refundMessage.PartialRefunds[0]
+	err = n.Datastore.Messages().Put(
+		fmt.Sprintf("%s-%d-%d", refund.OrderID, int(pb.Message_PARTIAL_REFUND), refund.Index),
+		refund.OrderID, pb.Message_PARTIAL_REFUND, peerID, repo.Message{Msg: m})
+	if err != nil {
+		log.Errorf("failed putting message (%s-%d-%d): %v", refund.OrderID, int(pb.Message_PARTIAL_REFUND), refund.Index, err)
+	}
+	return n.sendMessage(peerID, k, m)
+}
+
+// SendTimesheetEntry - send timesheet entry msg to peer
+func (n *OpenBazaarNode) SendTimesheetEntry(peerID string, k *libp2p.PubKey, entryMessage *pb.RicardianContract) error {
+	a, err := ptypes.MarshalAny(entryMessage)
+	if err != nil {
+		log.Errorf("failed to marshal the contract: %v", err)
+		return err
+	}
+	m := pb.Message{
+		MessageType: pb.Message_TIMESHEET_ENTRY,
+		Payload:     a,
+	}
+	entry := entryMessage.VendorTimesheetEntries[0]
+	if entry.OrderId == "" {
+		log.Errorf("failed fetching orderID")
+	} else {
+		err = n.Datastore.Messages().Put(
+			fmt.Sprintf("%s-%d-%d", entry.OrderId, int(pb.Message_TIMESHEET_ENTRY), entry.Index),
+			entry.OrderId, pb.Message_TIMESHEET_ENTRY, peerID, repo.Message{Msg: m})
+		if err != nil {
+			log.Errorf("failed putting message (%s-%d-%d): %v", entry.OrderId, int(pb.Message_TIMESHEET_ENTRY), entry.Index, err)
+		}
+	}
+	return n.sendMessage(peerID, k, m)
+}
+
+// SendTimesheetReview - send timesheet review msg to peer
+func (n *OpenBazaarNode) SendTimesheetReview(peerID string, k *libp2p.PubKey, reviewMessage *pb.RicardianContract) error {
+	a, err := ptypes.MarshalAny(reviewMessage)
+	if err != nil {
+		log.Errorf("failed to marshal the contract: %v", err)
+		return err
+	}
+	m := pb.Message{
+		MessageType: pb.Message_TIMESHEET_REVIEW,
+		Payload:     a,
+	}
+	review := reviewMessage.BuyerTimesheetReviews[0]
+	if review.OrderId == "" {
+		log.Errorf("failed fetching orderID")
+	} else {
+		err = n.Datastore.Messages().Put(
+			fmt.Sprintf("%s-%d-%d", review.OrderId, int(pb.Message_TIMESHEET_REVIEW), review.EntryIndex),
+			review.OrderId, pb.Message_TIMESHEET_REVIEW, peerID, repo.Message{Msg: m})
+		if err != nil {
+			log.Errorf("failed putting message (%s-%d-%d): %v", review.OrderId, int(pb.Message_TIMESHEET_REVIEW), review.EntryIndex, err)
+		}
+	}
+	return n.sendMessage(peerID, k, m)
+}
+
+// SendOrderAmendment - send order amendment msg to peer
+func (n *OpenBazaarNode) SendOrderAmendment(peerID string, k *libp2p.PubKey, amendmentMessage *pb.RicardianContract) error {
+	a, err := ptypes.MarshalAny(amendmentMessage)
+	if err != nil {
+		log.Errorf("failed to marshal the contract: %v", err)
+		return err
+	}
+	m := pb.Message{
+		MessageType: pb.Message_ORDER_AMENDMENT,
+		Payload:     a,
+	}
+	amendment := amendmentMessage.OrderAmendments[0]
+	if amendment.OrderId == "" {
+		log.Errorf("failed fetching orderID")
+	} else {
+		err = n.Datastore.Messages().Put(
+			fmt.Sprintf("%s-%d-%d", amendment.OrderId, int(pb.Message_ORDER_AMENDMENT), amendment.Index),
+			amendment.OrderId, pb.Message_ORDER_AMENDMENT, peerID, repo.Message{Msg: m})
+		if err != nil {
+			log.Errorf("failed putting message (%s-%d-%d): %v", amendment.OrderId, int(pb.Message_ORDER_AMENDMENT), amendment.Index, err)
+		}
+	}
+	return n.sendMessage(peerID, k, m)
+}
+
+// SendOrderAmendmentResponse - send order amendment response msg to peer
+func (n *OpenBazaarNode) SendOrderAmendmentResponse(peerID string, k *libp2p.PubKey, responseMessage *pb.RicardianContract) error {
+	a, err := ptypes.MarshalAny(responseMessage)
+	if err != nil {
+		log.Errorf("failed to marshal the contract: %v", err)
+		return err
+	}
+	m := pb.Message{
+		MessageType: pb.Message_ORDER_AMENDMENT_RESPONSE,
+		Payload:     a,
+	}
+	response := responseMessage.OrderAmendmentResponses[0]
+	if response.OrderId == "" {
+		log.Errorf("failed fetching orderID")
+	} else {
+		err = n.Datastore.Messages().Put(
+			fmt.Sprintf("%s-%d-%d", response.OrderId, int(pb.Message_ORDER_AMENDMENT_RESPONSE), response.AmendmentIndex),
+			response.OrderId, pb.Message_ORDER_AMENDMENT_RESPONSE, peerID, repo.Message{Msg: m})
+		if err != nil {
+			log.Errorf("failed putting message (%s-%d-%d): %v", response.OrderId, int(pb.Message_ORDER_AMENDMENT_RESPONSE), response.AmendmentIndex, err)
+		}
+	}
+	return n.sendMessage(peerID, k, m)
+}
+
+// SendSubscriptionUpdate - send subscription update msg to peer
+func (n *OpenBazaarNode) SendSubscriptionUpdate(peerID string, update *pb.SubscriptionUpdate) error {
+	a, err := ptypes.MarshalAny(update)
+	if err != nil {
+		log.Errorf("failed to marshal the subscription update: %v", err)
+		return err
+	}
+	m := pb.Message{
+		MessageType: pb.Message_SUBSCRIPTION_UPDATE,
+		Payload:     a,
+	}
+	return n.sendMessage(peerID, nil, m)
+}
+
+// SendQuoteRequest - send quote request msg to peer
+func (n *OpenBazaarNode) SendQuoteRequest(peerID string, request *pb.SignedQuoteRequest) error {
+	a, err := ptypes.MarshalAny(request)
+	if err != nil {
+		log.Errorf("failed to marshal the quote request: %v", err)
+		return err
+	}
+	m := pb.Message{
+		MessageType: pb.Message_QUOTE_REQUEST,
+		Payload:     a,
+	}
+	return n.sendMessage(peerID, nil, m)
+}
+
+// SendQuoteMessage - send quote msg to peer
+func (n *OpenBazaarNode) SendQuoteMessage(peerID string, k *libp2p.PubKey, quote *pb.SignedQuote) error {
+	a, err := ptypes.MarshalAny(quote)
+	if err != nil {
+		log.Errorf("failed to marshal the quote: %v", err)
+		return err
+	}
+	m := pb.Message{
+		MessageType: pb.Message_QUOTE,
+		Payload:     a,
+	}
+	return n.sendMessage(peerID, k, m)
+}
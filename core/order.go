@@ -64,6 +64,18 @@ type PurchaseData struct {
 	AlternateContactInfo string  `json:"alternateContactInfo"`
 	RefundAddress        *string `json:"refundAddress"` //optional, can be left out of json
 	PaymentCoin          string  `json:"paymentCoin"`
+	// Optional panel of moderators who resolve a dispute by a vote of
+	// PanelThreshold members, who must also sign the payout. The first
+	// member must be Moderator if set.
+	ModeratorPanel []string `json:"moderatorPanel"`
+	PanelThreshold uint32   `json:"panelThreshold"`
+	// Optional time slot to book with a service listing
+	Appointment *AppointmentData `json:"appointment"`
+
+	// Set when the order is generated by a subscription
+	subscriptionPeriod *pb.Order_SubscriptionPeriod
+	// Set when the order accepts a vendor's quote
+	quoteHistory *pb.QuoteHistory
 }
 
 const (
@@ -88,9 +100,16 @@ func (n *OpenBazaarNode) Purchase(data *PurchaseData) (orderID string, paymentAd
 	if err != nil {
 		return "", "", 0, false, err
 	}
+	defer func() {
+		if err == nil {
+			if berr := n.BookAppointment(contract, orderID, false); berr != nil {
+				log.Errorf("failed recording appointment for order (%s): %s", orderID, berr)
+			}
+		}
+	}()
 
 	// Add payment data and send to vendor
-	if data.Moderator != "" { // Moderated payment
+	if data.Moderator != "" || len(data.ModeratorPanel) > 0 { // Moderated payment
 
 		contract, err := prepareModeratedOrderContract(data, n, contract, wal)
 		if err != nil {
@@ -123,6 +142,10 @@ func (n *OpenBazaarNode) Purchase(data *PurchaseData) (orderID string, paymentAd
 		return "", "", 0, false, err
 	}
 	payment.Amount = total
+	if err := addOrderMilestones(contract); err != nil {
+		return "", "", 0, false, err
+	}
+	addOrderBillingRate(contract)
 
 	contract, err = n.SignOrder(contract)
 	if err != nil {
@@ -138,31 +161,42 @@ func (n *OpenBazaarNode) Purchase(data *PurchaseData) (orderID string, paymentAd
 }
 
 func prepareModeratedOrderContract(data *PurchaseData, n *OpenBazaarNode, contract *pb.RicardianContract, wal wallet.Wallet) (*pb.RicardianContract, error) {
-	if data.Moderator == n.IpfsNode.Identity.Pretty() {
-		return nil, errors.New("cannot select self as moderator")
-	}
-	if data.Moderator == contract.VendorListings[0].VendorID.PeerID {
-		return nil, errors.New("cannot select vendor as moderator")
+	moderators := []string{data.Moderator}
+	if len(data.ModeratorPanel) > 0 {
+		if data.Moderator != "" && data.Moderator != data.ModeratorPanel[0] {
+			return nil, errors.New("moderator must be the first member of the moderator panel")
+		}
+		moderators = data.ModeratorPanel
 	}
 	payment := new(pb.Order_Payment)
 	payment.Method = pb.Order_Payment_MODERATED
-	payment.Moderator = data.Moderator
+	payment.Moderator = moderators[0]
 	payment.Coin = NormalizeCurrencyCode(data.PaymentCoin)
 
-	profile, err := n.FetchProfile(data.Moderator, true)
-	if err != nil {
-		return nil, errors.New("moderator could not be found")
-	}
-	moderatorKeyBytes, err := hex.DecodeString(profile.BitcoinPubkey)
-	if err != nil {
-		return nil, err
-	}
-	if !profile.Moderator || profile.ModeratorInfo == nil || len(profile.ModeratorInfo.AcceptedCurrencies) == 0 {
-		return nil, errors.New("moderator is not capable of moderating this transaction")
-	}
+	var moderatorKeysBytes [][]byte
+	for _, moderator := range moderators {
+		if moderator == n.IpfsNode.Identity.Pretty() {
+			return nil, errors.New("cannot select self as moderator")
+		}
+		if moderator == contract.VendorListings[0].VendorID.PeerID {
+			return nil, errors.New("cannot select vendor as moderator")
+		}
+		profile, err := n.FetchProfile(moderator, true)
+		if err != nil {
+			return nil, errors.New("moderator could not be found")
+		}
+		moderatorKeyBytes, err := hex.DecodeString(profile.BitcoinPubkey)
+		if err != nil {
+			return nil, err
+		}
+		if !profile.Moderator || profile.ModeratorInfo == nil || len(profile.ModeratorInfo.AcceptedCurrencies) == 0 {
+			return nil, errors.New("moderator is not capable of moderating this transaction")
+		}
 
-	if !currencyInAcceptedCurrenciesList(data.PaymentCoin, profile.ModeratorInfo.AcceptedCurrencies) {
-		return nil, errors.New("moderator does not accept our currency")
+		if !currencyInAcceptedCurrenciesList(data.PaymentCoin, profile.ModeratorInfo.AcceptedCurrencies) {
+			return nil, errors.New("moderator does not accept our currency")
+		}
+		moderatorKeysBytes = append(moderatorKeysBytes, moderatorKeyBytes)
 	}
 	contract.BuyerOrder.Payment = payment
 	total, err := n.CalculateOrderTotal(contract)
@@ -170,13 +204,17 @@ func prepareModeratedOrderContract(data *PurchaseData, n *OpenBazaarNode, contra
 		return nil, err
 	}
 	payment.Amount = total
+	if err := addOrderMilestones(contract); err != nil {
+		return nil, err
+	}
+	addOrderBillingRate(contract)
 	fpb := wal.GetFeePerByte(wallet.NORMAL)
 	if (fpb * EscrowReleaseSize) > (payment.Amount / 4) {
 		return nil, errors.New("transaction fee too high for moderated payment")
 	}
 
 	/* Generate a payment address using the first child key derived from the buyers's,
-	   vendors's and moderator's masterPubKey and a random chaincode. */
+	   vendors's and moderators' masterPubKey and a random chaincode. */
 	chaincode := make([]byte, 32)
 	_, err = rand.Read(chaincode)
 	if err != nil {
@@ -190,21 +228,37 @@ func prepareModeratedOrderContract(data *PurchaseData, n *OpenBazaarNode, contra
 	if err != nil {
 		return nil, err
 	}
-	moderatorKey, err := wal.ChildKey(moderatorKeyBytes, chaincode, false)
-	if err != nil {
-		return nil, err
+	escrowKeys := []hd.ExtendedKey{*buyerKey, *vendorKey}
+	var moderatorKeys [][]byte
+	for _, moderatorKeyBytes := range moderatorKeysBytes {
+		moderatorKey, err := wal.ChildKey(moderatorKeyBytes, chaincode, false)
+		if err != nil {
+			return nil, err
+		}
+		modPub, err := moderatorKey.ECPubKey()
+		if err != nil {
+			return nil, err
+		}
+		escrowKeys = append(escrowKeys, *moderatorKey)
+		moderatorKeys = append(moderatorKeys, modPub.SerializeCompressed())
 	}
-	modPub, err := moderatorKey.ECPubKey()
-	if err != nil {
-		return nil, err
+	payment.ModeratorKey = moderatorKeys[0]
+	if len(data.ModeratorPanel) > 0 {
+		payment.ModeratorPanel = &pb.Order_Payment_ModeratorPanel{
+			Moderators:    moderators,
+			ModeratorKeys: moderatorKeys,
+			Threshold:     data.PanelThreshold,
+		}
+		if err := validateModeratorPanel(payment, n.IpfsNode.Identity.Pretty(), contract.VendorListings[0].VendorID.PeerID); err != nil {
+			return nil, err
+		}
 	}
-	payment.ModeratorKey = modPub.SerializeCompressed()
 
 	timeout, err := time.ParseDuration(strconv.Itoa(int(contract.VendorListings[0].Metadata.EscrowTimeoutHours)) + "h")
 	if err != nil {
 		return nil, err
 	}
-	addr, redeemScript, err := wal.GenerateMultisigScript([]hd.ExtendedKey{*buyerKey, *vendorKey, *moderatorKey}, 2, timeout, vendorKey)
+	addr, redeemScript, err := generateEscrowScript(wal, payment, escrowKeys, timeout)
 	if err != nil {
 		return nil, err
 	}
@@ -262,7 +316,7 @@ func processOnlineDirectOrder(resp *pb.Message, n *OpenBazaarNode, wal wallet.Wa
 	if err != nil {
 		return "", "", 0, false, err
 	}
-	return orderID, contract.VendorOrderConfirmation.PaymentAddress, contract.BuyerOrder.Payment.Amount, true, nil
+	return orderID, contract.VendorOrderConfirmation.PaymentAddress, paymentAmountDue(contract), true, nil
 }
 
 func processOfflineDirectOrder(n *OpenBazaarNode, wal wallet.Wallet, contract *pb.RicardianContract, payment *pb.Order_Payment) (string, string, uint64, bool, error) {
@@ -329,6 +383,7 @@ func processOfflineDirectOrder(n *OpenBazaarNode, wal wallet.Wallet, contract *p
 		return "", "", 0, false, err
 	}
 	err = n.SendOfflineMessage(peerID, &k, &m)
+	n.auditMessage(peerID.Pretty(), &m, false, err)
 	if err != nil {
 		return "", "", 0, false, err
 	}
@@ -340,7 +395,7 @@ func processOfflineDirectOrder(n *OpenBazaarNode, wal wallet.Wallet, contract *p
 	if err != nil {
 		return "", "", 0, false, err
 	}
-	return orderID, contract.BuyerOrder.Payment.Address, contract.BuyerOrder.Payment.Amount, false, err
+	return orderID, contract.BuyerOrder.Payment.Address, paymentAmountDue(contract), false, err
 }
 
 func processOnlineModeratedOrder(resp *pb.Message, n *OpenBazaarNode, contract *pb.RicardianContract) (string, string, uint64, bool, error) {
@@ -377,7 +432,7 @@ func processOnlineModeratedOrder(resp *pb.Message, n *OpenBazaarNode, contract *
 	if err != nil {
 		return "", "", 0, false, err
 	}
-	return orderID, contract.VendorOrderConfirmation.PaymentAddress, contract.BuyerOrder.Payment.Amount, true, nil
+	return orderID, contract.VendorOrderConfirmation.PaymentAddress, paymentAmountDue(contract), true, nil
 }
 
 func processOfflineModeratedOrder(n *OpenBazaarNode, contract *pb.RicardianContract) (string, string, uint64, bool, error) {
@@ -401,6 +456,7 @@ func processOfflineModeratedOrder(n *OpenBazaarNode, contract *pb.RicardianContr
 		return "", "", 0, false, err
 	}
 	err = n.SendOfflineMessage(peerID, &k, &m)
+	n.auditMessage(peerID.Pretty(), &m, false, err)
 	if err != nil {
 		return "", "", 0, false, err
 	}
@@ -409,7 +465,7 @@ func processOfflineModeratedOrder(n *OpenBazaarNode, contract *pb.RicardianContr
 		return "", "", 0, false, err
 	}
 	n.Datastore.Purchases().Put(orderID, *contract, pb.OrderState_AWAITING_PAYMENT, false)
-	return orderID, contract.BuyerOrder.Payment.Address, contract.BuyerOrder.Payment.Amount, false, err
+	return orderID, contract.BuyerOrder.Payment.Address, paymentAmountDue(contract), false, err
 }
 
 func extractErrorMessage(m *pb.Message) error {
@@ -452,6 +508,8 @@ func (n *OpenBazaarNode) createContractWithOrder(data *PurchaseData) (*pb.Ricard
 	order.Version = 2
 	order.Shipping = shipping
 	order.AlternateContactInfo = data.AlternateContactInfo
+	order.SubscriptionPeriod = data.subscriptionPeriod
+	order.QuoteHistory = data.quoteHistory
 
 	if data.RefundAddress != nil {
 		order.RefundAddress = *(data.RefundAddress)
@@ -555,6 +613,12 @@ func (n *OpenBazaarNode) createContractWithOrder(data *PurchaseData) (*pb.Ricard
 		order.Items = append(order.Items, i)
 	}
 
+	if data.Appointment != nil {
+		if err := n.addOrderAppointment(contract, data.Appointment); err != nil {
+			return nil, err
+		}
+	}
+
 	if containsPhysicalGood(addedListings) && !(n.TestNetworkEnabled() || n.RegressionNetworkEnabled()) {
 		err := validatePhysicalPurchaseOrder(contract)
 		if err != nil {
@@ -787,7 +851,7 @@ func (n *OpenBazaarNode) CancelOfflineOrder(contract *pb.RicardianContract, reco
 		return errors.New("cannot cancel order because utxo has already been spent")
 	}
 
-	chaincode, err := hex.DecodeString(contract.BuyerOrder.Payment.Chaincode)
+	chaincode, err := hex.DecodeString(pb.OrderPayment(contract).Chaincode)
 	if err != nil {
 		return err
 	}
@@ -799,7 +863,7 @@ func (n *OpenBazaarNode) CancelOfflineOrder(contract *pb.RicardianContract, reco
 	if err != nil {
 		return err
 	}
-	redeemScript, err := hex.DecodeString(contract.BuyerOrder.Payment.RedeemScript)
+	redeemScript, err := hex.DecodeString(pb.OrderPayment(contract).RedeemScript)
 	if err != nil {
 		return err
 	}
@@ -816,6 +880,7 @@ func (n *OpenBazaarNode) CancelOfflineOrder(contract *pb.RicardianContract, reco
 		return err
 	}
 	n.Datastore.Purchases().Put(orderID, *contract, pb.OrderState_CANCELED, true)
+	n.Datastore.Appointments().Delete(orderID)
 	return nil
 }
 
@@ -832,6 +897,10 @@ func (n *OpenBazaarNode) CalcOrderID(order *pb.Order) (string, error) {
 	return id.B58String(), nil
 }
 
+// priceConverter converts an amount priced in the given currency into the
+// currency an order total is calculated in
+type priceConverter func(currencyCode string, amount uint64) (uint64, error)
+
 // CalculateOrderTotal - calculate the total in satoshi/wei
 func (n *OpenBazaarNode) CalculateOrderTotal(contract *pb.RicardianContract) (uint64, error) {
 	wal, err := n.Multiwallet.WalletForCurrencyCode(contract.BuyerOrder.Payment.Coin)
@@ -842,8 +911,59 @@ func (n *OpenBazaarNode) CalculateOrderTotal(contract *pb.RicardianContract) (ui
 		wal.ExchangeRates().GetLatestRate("") // Refresh the exchange rates
 	}
 
+	paymentCoin := contract.BuyerOrder.Payment.Coin
+	return calculateOrderTotal(contract,
+		func(currencyCode string, amount uint64) (uint64, error) {
+			return n.getPriceInSatoshi(paymentCoin, currencyCode, amount)
+		},
+		func(coinType string, quantity uint64) (uint64, error) {
+			return n.getMarketPriceInSatoshis(paymentCoin, coinType, quantity)
+		})
+}
+
+// calculateOrderTotal adds up the items, variants, coupons, taxes and
+// shipping of the order. Listing prices are converted with price and market
+// priced listings with marketPrice.
+func calculateOrderTotal(contract *pb.RicardianContract, price, marketPrice priceConverter) (uint64, error) {
+	items, err := calculateOrderItems(contract, price, marketPrice)
+	if err != nil {
+		return 0, err
+	}
 	var total uint64
 	physicalGoods := make(map[string]*pb.Listing)
+	for _, item := range items {
+		total += item.Total
+		if item.Listing.Metadata.ContractType == pb.Listing_Metadata_PHYSICAL_GOOD {
+			physicalGoods[item.ListingHash] = item.Listing
+		}
+	}
+
+	shippingTotal, err := calculateShippingTotalForListings(contract, physicalGoods, price)
+	if err != nil {
+		return 0, err
+	}
+	total += shippingTotal
+
+	return total, nil
+}
+
+// orderItemPrice breaks down what an item of the order costs. Subtotal
+// includes the variant surcharge, Discount the coupons and Tax the listing
+// taxes for the shipping country, each for the whole quantity.
+type orderItemPrice struct {
+	ListingHash string
+	Listing     *pb.Listing
+	Quantity    uint64
+	Subtotal    uint64
+	Discount    uint64
+	Tax         uint64
+	Total       uint64
+}
+
+// calculateOrderItems prices each item of the order the way
+// calculateOrderTotal does, without shipping
+func calculateOrderItems(contract *pb.RicardianContract, price, marketPrice priceConverter) ([]*orderItemPrice, error) {
+	var items []*orderItemPrice
 
 	// Calculate the price of each item
 	for _, item := range contract.BuyerOrder.Items {
@@ -855,30 +975,35 @@ func (n *OpenBazaarNode) CalculateOrderTotal(contract *pb.RicardianContract) (ui
 
 		l, err := ParseContractForListing(item.ListingHash, contract)
 		if err != nil {
-			return 0, fmt.Errorf("listing not found in contract for item %s", item.ListingHash)
+			return nil, fmt.Errorf("listing not found in contract for item %s", item.ListingHash)
 		}
 
 		// Continue using the old 32-bit quantity field for all listings less than version 3
 		itemQuantity = GetOrderQuantity(l, item)
+		ip := &orderItemPrice{ListingHash: item.ListingHash, Listing: l, Quantity: itemQuantity}
 
-		if l.Metadata.ContractType == pb.Listing_Metadata_PHYSICAL_GOOD {
-			physicalGoods[item.ListingHash] = l
+		if quote := acceptedQuote(contract); quote != nil {
+			if err := priceQuotedItem(ip, quote, contract.BuyerOrder.Shipping, price); err != nil {
+				return nil, err
+			}
+			items = append(items, ip)
+			continue
 		}
 
 		if l.Metadata.Format == pb.Listing_Metadata_MARKET_PRICE {
-			satoshis, err = n.getMarketPriceInSatoshis(contract.BuyerOrder.Payment.Coin, l.Metadata.CoinType, itemQuantity)
+			satoshis, err = marketPrice(l.Metadata.CoinType, itemQuantity)
 			satoshis += uint64(float32(satoshis) * l.Metadata.PriceModifier / 100.0)
 			itemQuantity = 1
 		} else {
-			satoshis, err = n.getPriceInSatoshi(contract.BuyerOrder.Payment.Coin, l.Metadata.PricingCurrency, l.Item.Price)
+			satoshis, err = price(l.Metadata.PricingCurrency, l.Item.Price)
 		}
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
 		itemTotal += satoshis
 		selectedSku, err := GetSelectedSku(l, item.Options)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
 		var skuExists bool
 		for i, sku := range l.Item.Skus {
@@ -889,9 +1014,9 @@ func (n *OpenBazaarNode) CalculateOrderTotal(contract *pb.RicardianContract) (ui
 					if sku.Surcharge < 0 {
 						surcharge = uint64(-sku.Surcharge)
 					}
-					satoshis, err := n.getPriceInSatoshi(contract.BuyerOrder.Payment.Coin, l.Metadata.PricingCurrency, surcharge)
+					satoshis, err := price(l.Metadata.PricingCurrency, surcharge)
 					if err != nil {
-						return 0, err
+						return nil, err
 					}
 					if sku.Surcharge < 0 {
 						itemTotal -= satoshis
@@ -900,23 +1025,24 @@ func (n *OpenBazaarNode) CalculateOrderTotal(contract *pb.RicardianContract) (ui
 					}
 				}
 				if !skuExists {
-					return 0, errors.New("selected variant not found in listing")
+					return nil, errors.New("selected variant not found in listing")
 				}
 				break
 			}
 		}
+		subtotal := itemTotal
 		// Subtract any coupons
 		for _, couponCode := range item.CouponCodes {
 			for _, vendorCoupon := range l.Coupons {
 				id, err := EncodeMultihash([]byte(couponCode))
 				if err != nil {
-					return 0, err
+					return nil, err
 				}
 				if id.B58String() == vendorCoupon.GetHash() {
 					if discount := vendorCoupon.GetPriceDiscount(); discount > 0 {
-						satoshis, err := n.getPriceInSatoshi(contract.BuyerOrder.Payment.Coin, l.Metadata.PricingCurrency, discount)
+						satoshis, err := price(l.Metadata.PricingCurrency, discount)
 						if err != nil {
-							return 0, err
+							return nil, err
 						}
 						itemTotal -= satoshis
 					} else if discount := vendorCoupon.GetPercentDiscount(); discount > 0 {
@@ -925,6 +1051,7 @@ func (n *OpenBazaarNode) CalculateOrderTotal(contract *pb.RicardianContract) (ui
 				}
 			}
 		}
+		discounted := itemTotal
 		// Apply tax
 		for _, tax := range l.Taxes {
 			for _, taxRegion := range tax.TaxRegions {
@@ -934,20 +1061,39 @@ func (n *OpenBazaarNode) CalculateOrderTotal(contract *pb.RicardianContract) (ui
 				}
 			}
 		}
-		itemTotal *= itemQuantity
-		total += itemTotal
+		ip.Subtotal = subtotal * itemQuantity
+		ip.Discount = (subtotal - discounted) * itemQuantity
+		ip.Tax = (itemTotal - discounted) * itemQuantity
+		ip.Total = itemTotal * itemQuantity
+		items = append(items, ip)
 	}
+	return items, nil
+}
 
-	shippingTotal, err := n.calculateShippingTotalForListings(contract, physicalGoods)
+// priceQuotedItem prices an item at the vendor's quote, which covers the
+// whole quantity and replaces the variant surcharges and coupons. The listing
+// taxes still apply.
+func priceQuotedItem(ip *orderItemPrice, quote *pb.Quote, shipping *pb.Order_Shipping, price priceConverter) error {
+	subtotal, err := price(ip.Listing.Metadata.PricingCurrency, quote.Price)
 	if err != nil {
-		return 0, err
+		return err
 	}
-	total += shippingTotal
-
-	return total, nil
+	total := subtotal
+	for _, tax := range ip.Listing.Taxes {
+		for _, taxRegion := range tax.TaxRegions {
+			if shipping != nil && shipping.Country == taxRegion {
+				total += uint64(float32(total) * (tax.Percentage / 100))
+				break
+			}
+		}
+	}
+	ip.Subtotal = subtotal
+	ip.Tax = total - subtotal
+	ip.Total = total
+	return nil
 }
 
-func (n *OpenBazaarNode) calculateShippingTotalForListings(contract *pb.RicardianContract, listings map[string]*pb.Listing) (uint64, error) {
+func calculateShippingTotalForListings(contract *pb.RicardianContract, listings map[string]*pb.Listing, price priceConverter) (uint64, error) {
 	type itemShipping struct {
 		primary               uint64
 		secondary             uint64
@@ -1001,14 +1147,14 @@ func (n *OpenBazaarNode) calculateShippingTotalForListings(contract *pb.Ricardia
 		if !ok {
 			return 0, errors.New("shipping service not found in listing")
 		}
-		shippingSatoshi, err := n.getPriceInSatoshi(contract.BuyerOrder.Payment.Coin, listing.Metadata.PricingCurrency, service.Price)
+		shippingSatoshi, err := price(listing.Metadata.PricingCurrency, service.Price)
 		if err != nil {
 			return 0, err
 		}
 
 		var secondarySatoshi uint64
 		if service.AdditionalItemPrice > 0 {
-			secondarySatoshi, err = n.getPriceInSatoshi(contract.BuyerOrder.Payment.Coin, listing.Metadata.PricingCurrency, service.AdditionalItemPrice)
+			secondarySatoshi, err = price(listing.Metadata.PricingCurrency, service.AdditionalItemPrice)
 			if err != nil {
 				return 0, err
 			}
@@ -1231,23 +1377,31 @@ func (n *OpenBazaarNode) ValidateOrder(contract *pb.RicardianContract, checkInve
 		return errors.New("order is missing a timestamp")
 	}
 	if contract.BuyerOrder.Payment.Method == pb.Order_Payment_MODERATED {
-		_, err := mh.FromB58String(contract.BuyerOrder.Payment.Moderator)
-		if err != nil {
-			return errors.New("invalid moderator")
-		}
 		var availableMods []string
 		for _, listing := range contract.VendorListings {
 			availableMods = append(availableMods, listing.Moderators...)
 		}
-		validMod := false
-		for _, mod := range availableMods {
-			if mod == contract.BuyerOrder.Payment.Moderator {
-				validMod = true
-				break
+		moderators, _ := pb.PaymentModerators(contract.BuyerOrder.Payment)
+		for _, moderator := range moderators {
+			_, err := mh.FromB58String(moderator)
+			if err != nil {
+				return errors.New("invalid moderator")
+			}
+			validMod := false
+			for _, mod := range availableMods {
+				if mod == moderator {
+					validMod = true
+					break
+				}
+			}
+			if !validMod {
+				return errors.New("invalid moderator")
 			}
 		}
-		if !validMod {
-			return errors.New("invalid moderator")
+		if contract.BuyerOrder.Payment.ModeratorPanel != nil {
+			if err := validateModeratorPanel(contract.BuyerOrder.Payment, contract.BuyerOrder.BuyerID.PeerID, contract.VendorListings[0].VendorID.PeerID); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -1406,6 +1560,9 @@ collectListings:
 			}
 		}
 	}
+	if err := n.validateAppointment(contract, checkInventory); err != nil {
+		return err
+	}
 
 	// Validate shipping
 	containsPhysicalGood := false
@@ -1427,6 +1584,26 @@ collectListings:
 		}
 	}
 
+	// Validate milestones
+	if err := validateOrderMilestones(contract); err != nil {
+		return err
+	}
+
+	// Validate the billing rate for timesheet orders
+	if err := validateOrderBillingRate(contract); err != nil {
+		return err
+	}
+
+	// Validate the buyer's signed subscription for subscription orders
+	if err := n.validateOrderSubscription(contract); err != nil {
+		return err
+	}
+
+	// Validate the quote history for orders placed against a vendor's quote
+	if err := n.validateOrderQuote(contract); err != nil {
+		return err
+	}
+
 	// Validate the buyers's signature on the order
 	err := verifySignaturesOnOrder(contract)
 	if err != nil {
@@ -1490,21 +1667,6 @@ func (n *OpenBazaarNode) ValidateModeratedPaymentAddress(order *pb.Order, timeou
 	if err != nil {
 		return err
 	}
-	ipnsPath := ipfspath.FromString(order.Payment.Moderator + "/profile.json")
-	profileBytes, err := ipfs.ResolveThenCat(n.IpfsNode, ipnsPath, time.Minute, n.IPNSQuorumSize, true)
-	if err != nil {
-		return err
-	}
-	profile := new(pb.Profile)
-	err = jsonpb.UnmarshalString(string(profileBytes), profile)
-	if err != nil {
-		return err
-	}
-	moderatorBytes, err := hex.DecodeString(profile.BitcoinPubkey)
-	if err != nil {
-		return err
-	}
-
 	chaincode, err := hex.DecodeString(order.Payment.Chaincode)
 	if err != nil {
 		return err
@@ -1521,18 +1683,37 @@ func (n *OpenBazaarNode) ValidateModeratedPaymentAddress(order *pb.Order, timeou
 	if err != nil {
 		return err
 	}
-	moderatorKey, err := wal.ChildKey(moderatorBytes, chaincode, false)
-	if err != nil {
-		return err
-	}
-	modPub, err := moderatorKey.ECPubKey()
-	if err != nil {
-		return err
-	}
-	if !bytes.Equal(order.Payment.ModeratorKey, modPub.SerializeCompressed()) {
-		return errors.New("invalid moderator key")
+	escrowKeys := []hd.ExtendedKey{*buyerKey, *vendorKey}
+	moderators, moderatorKeys := pb.PaymentModerators(order.Payment)
+	for i, moderator := range moderators {
+		ipnsPath := ipfspath.FromString(moderator + "/profile.json")
+		profileBytes, err := ipfs.ResolveThenCat(n.IpfsNode, ipnsPath, time.Minute, n.IPNSQuorumSize, true)
+		if err != nil {
+			return err
+		}
+		profile := new(pb.Profile)
+		err = jsonpb.UnmarshalString(string(profileBytes), profile)
+		if err != nil {
+			return err
+		}
+		moderatorBytes, err := hex.DecodeString(profile.BitcoinPubkey)
+		if err != nil {
+			return err
+		}
+		moderatorKey, err := wal.ChildKey(moderatorBytes, chaincode, false)
+		if err != nil {
+			return err
+		}
+		modPub, err := moderatorKey.ECPubKey()
+		if err != nil {
+			return err
+		}
+		if i >= len(moderatorKeys) || !bytes.Equal(moderatorKeys[i], modPub.SerializeCompressed()) {
+			return errors.New("invalid moderator key")
+		}
+		escrowKeys = append(escrowKeys, *moderatorKey)
 	}
-	addr, redeemScript, err := wal.GenerateMultisigScript([]hd.ExtendedKey{*buyerKey, *vendorKey, *moderatorKey}, 2, timeout, vendorKey)
+	addr, redeemScript, err := generateEscrowScript(wal, order.Payment, escrowKeys, timeout)
 	if err != nil {
 		return err
 	}
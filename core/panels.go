@@ -0,0 +1,777 @@
+package core
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	btc "github.com/btcsuite/btcutil"
+	hd "github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/kimitzu/kimitzu-go/pb"
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+// MaxPanelSize is the largest moderator panel an order can name. The escrow
+// of a panel must also keep within the keys a multisig script allows.
+const MaxPanelSize = 7
+
+var (
+	// ErrNotPanelModerated - the order names a single moderator
+	ErrNotPanelModerated = errors.New("order is not moderated by a panel")
+
+	// ErrPanelEscrowUnsupported - the coin's wallet cannot hold a panel escrow
+	ErrPanelEscrowUnsupported = errors.New("moderator panels are only supported for segwit coins")
+
+	// ErrPanelEscrowNoTimeout - the escrow of a panel moderated order never times out
+	ErrPanelEscrowNoTimeout = errors.New("the escrow of a panel moderated order has no timeout")
+)
+
+/*
+	A panel moderated order is paid to a segwit escrow holding the buyer's and
+	the vendor's keys threshold times each followed by the key of every panel
+	member, and needing twice the threshold signatures. Signatures are matched
+	to the keys in order, so a party's signature counts once for every copy of
+	its key and a member's counts once. The funds therefore move with the
+	signatures of both parties, or of one party and threshold members. Members
+	on their own never get there as the threshold is a majority of the panel.
+	The escrow has no timeout since the wallets only sweep escrows laid out
+	for a single moderator.
+
+	A dispute is resolved once threshold members have signed votes for the
+	same split of the payout. The member who builds the payout passes it on to
+	the other voters to sign in turn, and sends the resolution to both parties
+	once threshold members have signed it. A party adds its own signatures to
+	release the funds.
+*/
+
+// validateModeratorPanel checks the panel named by a moderated payment
+func validateModeratorPanel(payment *pb.Order_Payment, buyerID, vendorID string) error {
+	panel := payment.ModeratorPanel
+	if panel == nil {
+		return ErrNotPanelModerated
+	}
+	size := len(panel.Moderators)
+	if size < 2 {
+		return errors.New("a moderator panel needs at least two members")
+	}
+	if size > MaxPanelSize {
+		return fmt.Errorf("a moderator panel can have at most %d members", MaxPanelSize)
+	}
+	if panel.Threshold == 0 || int(panel.Threshold) > size || int(panel.Threshold)*2 <= size {
+		return fmt.Errorf("the panel threshold must be a majority of its %d members", size)
+	}
+	if 2*int(panel.Threshold)+size > txscript.MaxPubKeysPerMultiSig {
+		return fmt.Errorf("a panel of %d members with a threshold of %d needs too many escrow keys", size, panel.Threshold)
+	}
+	if len(panel.ModeratorKeys) != size {
+		return errors.New("the moderator panel is missing escrow keys")
+	}
+	if panel.Moderators[0] != payment.Moderator || !bytes.Equal(panel.ModeratorKeys[0], payment.ModeratorKey) {
+		return errors.New("the first panel member must be the order's moderator")
+	}
+	seen := make(map[string]bool)
+	for _, m := range panel.Moderators {
+		if m == buyerID || m == vendorID {
+			return errors.New("the buyer and vendor cannot sit on the moderator panel")
+		}
+		if seen[m] {
+			return fmt.Errorf("%s is on the moderator panel more than once", m)
+		}
+		seen[m] = true
+	}
+	return nil
+}
+
+// isPanelMember returns whether the peer sits on the panel
+func isPanelMember(panel *pb.Order_Payment_ModeratorPanel, peerID string) bool {
+	for _, m := range panel.Moderators {
+		if m == peerID {
+			return true
+		}
+	}
+	return false
+}
+
+// equalStrings returns whether both lists hold the same strings in order
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// equalKeys returns whether both lists hold the same keys in order
+func equalKeys(a, b [][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !bytes.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// panelEscrowKeys returns the keys of the escrow of a panel moderated payment
+// in the order they appear in the redeem script. ourKey is our own escrow key
+// and must be the one the panel lists for us.
+func panelEscrowKeys(payment *pb.Order_Payment, ourID string, ourKey, buyerKey, vendorKey *hd.ExtendedKey) ([]hd.ExtendedKey, error) {
+	panel := payment.ModeratorPanel
+	ourPub, err := ourKey.ECPubKey()
+	if err != nil {
+		return nil, err
+	}
+	keys := []hd.ExtendedKey{*buyerKey, *vendorKey}
+	for i, m := range panel.Moderators {
+		if i >= len(panel.ModeratorKeys) {
+			return nil, errors.New("the moderator panel is missing escrow keys")
+		}
+		key := panel.ModeratorKeys[i]
+		if m == ourID && !bytes.Equal(key, ourPub.SerializeCompressed()) {
+			return nil, errors.New("our escrow key on the moderator panel is invalid")
+		}
+		keys = append(keys, *hd.NewExtendedKey(chaincfg.MainNetParams.HDPublicKeyID[:], key, nil, []byte{0, 0, 0, 0}, 0, 0, false))
+	}
+	return keys, nil
+}
+
+// weightPanelEscrowKeys returns the keys and the threshold of the escrow of a
+// panel from the buyer's, the vendor's and the members' keys in that order
+func weightPanelEscrowKeys(keys []hd.ExtendedKey, threshold int) ([]hd.ExtendedKey, int) {
+	var weighted []hd.ExtendedKey
+	for _, party := range keys[:2] {
+		for i := 0; i < threshold; i++ {
+			weighted = append(weighted, party)
+		}
+	}
+	return append(weighted, keys[2:]...), 2 * threshold
+}
+
+// escrowBroadcaster is implemented by wallets which broadcast a transaction
+// assembled outside of the wallet
+type escrowBroadcaster interface {
+	Broadcast(tx *wire.MsgTx) error
+}
+
+// generateEscrowScript generates the escrow of a moderated payment over the
+// buyer's, the vendor's and the moderators' keys in that order. The vendor
+// can sweep the escrow of a single moderator once it times out.
+func generateEscrowScript(wal wallet.Wallet, payment *pb.Order_Payment, keys []hd.ExtendedKey, timeout time.Duration) (btc.Address, []byte, error) {
+	if payment.ModeratorPanel == nil {
+		return wal.GenerateMultisigScript(keys, 2, timeout, &keys[1])
+	}
+	if len(keys) != len(payment.ModeratorPanel.Moderators)+2 {
+		return nil, nil, errors.New("the moderator panel is missing escrow keys")
+	}
+	if _, ok := wal.(escrowBroadcaster); !ok {
+		return nil, nil, ErrPanelEscrowUnsupported
+	}
+	weighted, threshold := weightPanelEscrowKeys(keys, int(payment.ModeratorPanel.Threshold))
+	addr, redeemScript, err := wal.GenerateMultisigScript(weighted, threshold, 0, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	// A witness script is the only one with room for the panel's signatures
+	if len(addr.ScriptAddress()) != sha256.Size {
+		return nil, nil, ErrPanelEscrowUnsupported
+	}
+	return addr, redeemScript, nil
+}
+
+// MultisignEscrow combines the buyer's and the vendor's signatures on a spend
+// from the escrow of a moderated payment and broadcasts it
+func MultisignEscrow(wal wallet.Wallet, payment *pb.Order_Payment, ins []wallet.TransactionInput, outs []wallet.TransactionOutput, buyerSigs, vendorSigs []wallet.Signature, redeemScript []byte, feePerByte uint64) error {
+	if payment.ModeratorPanel == nil {
+		_, err := wal.Multisign(ins, outs, buyerSigs, vendorSigs, redeemScript, feePerByte, true)
+		return err
+	}
+	var sigs [][]wallet.Signature
+	for _, party := range [][]wallet.Signature{buyerSigs, vendorSigs} {
+		for i := 0; i < int(payment.ModeratorPanel.Threshold); i++ {
+			sigs = append(sigs, party)
+		}
+	}
+	return broadcastPanelSpend(wal, ins, outs, sigs, redeemScript, feePerByte)
+}
+
+// broadcastPanelSpend broadcasts a spend from a panel escrow. sigs holds a
+// set of signatures for each key they are checked against, in the order of
+// the escrow's keys.
+func broadcastPanelSpend(wal wallet.Wallet, ins []wallet.TransactionInput, outs []wallet.TransactionOutput, sigs [][]wallet.Signature, redeemScript []byte, feePerByte uint64) error {
+	b, ok := wal.(escrowBroadcaster)
+	if !ok {
+		return ErrPanelEscrowUnsupported
+	}
+	// The wallet builds and sorts the transaction the signatures commit to
+	// but only knows how to witness two of them
+	raw, err := wal.Multisign(ins, outs, nil, nil, redeemScript, feePerByte, false)
+	if err != nil {
+		return err
+	}
+	tx := new(wire.MsgTx)
+	if err := tx.BtcDecode(bytes.NewReader(raw), wire.ProtocolVersion, wire.WitnessEncoding); err != nil {
+		return err
+	}
+	if err := setPanelWitnesses(tx, sigs, redeemScript); err != nil {
+		return err
+	}
+	return b.Broadcast(tx)
+}
+
+// setPanelWitnesses sets the witness of every input of a spend from a panel
+// escrow to the given signatures followed by the escrow's script
+func setPanelWitnesses(tx *wire.MsgTx, sigs [][]wallet.Signature, redeemScript []byte) error {
+	for i, in := range tx.TxIn {
+		witness := wire.TxWitness{[]byte{}}
+		for _, set := range sigs {
+			var sig []byte
+			for _, s := range set {
+				if int(s.InputIndex) == i {
+					sig = s.Signature
+					break
+				}
+			}
+			if len(sig) == 0 {
+				return fmt.Errorf("escrow signature for input %d is missing", i)
+			}
+			witness = append(witness, sig)
+		}
+		in.Witness = append(witness, redeemScript)
+	}
+	return nil
+}
+
+// signPanelVote signs our vote on a dispute with our identity key
+func (n *OpenBazaarNode) signPanelVote(vote *pb.DisputeResolution) (*pb.DisputeResolution_PanelVote, error) {
+	ser, err := proto.Marshal(vote)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := n.IpfsNode.PrivateKey.Sign(ser)
+	if err != nil {
+		return nil, err
+	}
+	pubkey, err := n.IpfsNode.PrivateKey.GetPublic().Bytes()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DisputeResolution_PanelVote{Vote: vote, Pubkey: pubkey, Signature: sig}, nil
+}
+
+// verifyPanelVote checks that a vote is well formed and signed by the member
+// of the panel who cast it
+func verifyPanelVote(panel *pb.Order_Payment_ModeratorPanel, vote *pb.DisputeResolution_PanelVote) error {
+	if vote == nil || vote.Vote == nil {
+		return errors.New("panel vote is empty")
+	}
+	if !isPanelMember(panel, vote.Vote.ProposedBy) {
+		return fmt.Errorf("%s is not a member of the moderator panel", vote.Vote.ProposedBy)
+	}
+	if err := verifySignature(vote.Vote, vote.Pubkey, vote.Signature, vote.Vote.ProposedBy); err != nil {
+		switch err.(type) {
+		case invalidSigError:
+			return errors.New("signature on panel vote failed to verify")
+		case matchKeyError:
+			return errors.New("public key in panel vote does not match the voter")
+		default:
+			return err
+		}
+	}
+	ratio := repo.PayoutRatio{Buyer: vote.Vote.BuyerPercentage, Vendor: vote.Vote.VendorPercentage}
+	if err := ratio.Validate(); err != nil {
+		return err
+	}
+	if p := vote.Vote.Payout; p != nil && (len(p.Sigs) > 0 || p.BuyerOutput != nil || p.VendorOutput != nil) {
+		return errors.New("a panel vote may only name the member's fee")
+	}
+	return nil
+}
+
+// tallyPanelVotes returns the largest group of votes on the case which agree
+// on the split of the payout, and whether it reaches the panel's threshold.
+// Ties go to the split voted for first.
+func tallyPanelVotes(panel *pb.Order_Payment_ModeratorPanel, caseID string, votes []*pb.DisputeResolution_PanelVote) ([]*pb.DisputeResolution_PanelVote, bool) {
+	var (
+		groups [][]*pb.DisputeResolution_PanelVote
+		seen   = make(map[string]bool)
+	)
+	for _, v := range votes {
+		if v.Vote == nil || v.Vote.OrderId != caseID || !isPanelMember(panel, v.Vote.ProposedBy) || seen[v.Vote.ProposedBy] {
+			continue
+		}
+		seen[v.Vote.ProposedBy] = true
+		matched := false
+		for i, g := range groups {
+			if g[0].Vote.BuyerPercentage == v.Vote.BuyerPercentage && g[0].Vote.VendorPercentage == v.Vote.VendorPercentage {
+				groups[i] = append(g, v)
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			groups = append(groups, []*pb.DisputeResolution_PanelVote{v})
+		}
+	}
+	var largest []*pb.DisputeResolution_PanelVote
+	for _, g := range groups {
+		if len(g) > len(largest) {
+			largest = g
+		}
+	}
+	return largest, len(largest) >= int(panel.Threshold)
+}
+
+// panelVoteFee returns the share of the fee a voting member is paid when the
+// fee is split between the given number of voters
+func panelVoteFee(vote *pb.DisputeResolution_PanelVote, voters int) uint64 {
+	return vote.Vote.GetPayout().GetModeratorOutput().GetAmount() / uint64(voters)
+}
+
+// validatePanelResolution checks that the resolution of a panel moderated
+// dispute carries enough valid votes for its split of the payout and pays
+// the panel no more than the fees its members voted for
+func validatePanelResolution(contract *pb.RicardianContract) error {
+	panel := pb.OrderPayment(contract).ModeratorPanel
+	if panel == nil {
+		return ErrNotPanelModerated
+	}
+	d := contract.DisputeResolution
+	if d == nil || d.Payout == nil {
+		return errors.New("DisputeResolution contains invalid payout")
+	}
+	if !isPanelMember(panel, d.ProposedBy) {
+		return fmt.Errorf("%s is not a member of the moderator panel", d.ProposedBy)
+	}
+
+	voted := make(map[string]bool)
+	for _, v := range d.PanelVotes {
+		if err := verifyPanelVote(panel, v); err != nil {
+			return err
+		}
+		if v.Vote.OrderId != d.OrderId {
+			return errors.New("panel vote is for another order")
+		}
+		if voted[v.Vote.ProposedBy] {
+			return fmt.Errorf("%s voted more than once", v.Vote.ProposedBy)
+		}
+		if v.Vote.BuyerPercentage != d.BuyerPercentage || v.Vote.VendorPercentage != d.VendorPercentage {
+			return errors.New("panel vote does not match the resolution's payout")
+		}
+		voted[v.Vote.ProposedBy] = true
+	}
+	if len(voted) < int(panel.Threshold) {
+		return fmt.Errorf("resolution carries %d of the %d panel votes needed", len(voted), panel.Threshold)
+	}
+	if !voted[d.ProposedBy] {
+		return errors.New("resolution was proposed by a panel member who did not vote for it")
+	}
+
+	payout := d.Payout
+	if payout.ModeratorOutput != nil {
+		return errors.New("a panel resolution must pay the panel through its panel outputs")
+	}
+	if payout.BuyerOutput != nil && d.BuyerPercentage == 0 {
+		return errors.New("payout pays the buyer against the panel's votes")
+	}
+	if payout.VendorOutput != nil && d.VendorPercentage == 0 {
+		return errors.New("payout pays the vendor against the panel's votes")
+	}
+	if payout.BuyerOutput != nil && payout.VendorOutput != nil {
+		total := float64(payout.BuyerOutput.Amount + payout.VendorOutput.Amount)
+		if total > 0 && math.Abs(float64(payout.BuyerOutput.Amount)/total*100-float64(d.BuyerPercentage)) > 1 {
+			return errors.New("payout does not split the funds as the panel voted")
+		}
+	}
+
+	// Each panel output pays a different voter at most their share of the fee
+	paid := make(map[int]bool)
+	for _, o := range payout.PanelOutputs {
+		matched := false
+		for i, v := range d.PanelVotes {
+			fee := v.Vote.GetPayout().GetModeratorOutput()
+			if paid[i] || fee == nil || fee.GetAddress() == "" || fee.GetAddress() != o.GetAddress() {
+				continue
+			}
+			if o.Amount > panelVoteFee(v, len(d.PanelVotes)) {
+				return errors.New("panel output exceeds the fee the member voted for")
+			}
+			paid[i], matched = true, true
+			break
+		}
+		if !matched {
+			return errors.New("panel output does not pay a voting member")
+		}
+	}
+	return nil
+}
+
+// voteOnDispute casts our vote on the dispute of a panel moderated order and
+// sends it to the rest of the panel. The dispute is resolved as soon as
+// enough members agree on the payout.
+func (n *OpenBazaarNode) voteOnDispute(dispute *repo.DisputeCaseRecord, contract *pb.RicardianContract, payDivision repo.PayoutRatio, resolution string) error {
+	payment := pb.OrderPayment(contract)
+	wal, err := n.Multiwallet.WalletForCurrencyCode(payment.Coin)
+	if err != nil {
+		return err
+	}
+	var totalOut uint64
+	for _, o := range dispute.ResolutionPaymentOutpoints(payDivision) {
+		totalOut += o.Value
+	}
+	fee, err := n.GetModeratorFee(totalOut, payment.Coin, wal.CurrencyCode())
+	if err != nil {
+		return err
+	}
+
+	vote := &pb.DisputeResolution{
+		Timestamp:        ptypes.TimestampNow(),
+		OrderId:          dispute.CaseID,
+		ProposedBy:       n.IpfsNode.Identity.Pretty(),
+		Resolution:       resolution,
+		BuyerPercentage:  payDivision.Buyer,
+		VendorPercentage: payDivision.Vendor,
+	}
+	if fee > 0 {
+		vote.Payout = &pb.DisputeResolution_Payout{
+			ModeratorOutput: &pb.DisputeResolution_Payout_Output{
+				ScriptOrAddress: &pb.DisputeResolution_Payout_Output_Address{Address: wal.CurrentAddress(wallet.EXTERNAL).String()},
+				Amount:          fee,
+			},
+		}
+	}
+	moderatorKey, err := n.moderatorEscrowKey(contract, wal)
+	if err != nil {
+		return err
+	}
+	vote.ModeratorRatingSigs, err = signRatingKeys(moderatorKey, dispute.BuyerContract)
+	if err != nil {
+		return err
+	}
+	signed, err := n.signPanelVote(vote)
+	if err != nil {
+		return err
+	}
+	if err := n.Datastore.PanelVotes().Put(dispute.CaseID, signed); err != nil {
+		return err
+	}
+
+	moderators, _ := pb.PaymentModerators(payment)
+	for _, m := range moderators {
+		if m == vote.ProposedBy {
+			continue
+		}
+		if err := n.SendDisputePanelVote(m, signed); err != nil {
+			log.Errorf("failed sending panel vote on case (%s) to %s: %s", dispute.CaseID, m, err)
+		}
+	}
+	return n.resolvePanelDispute(dispute)
+}
+
+// resolvePanelDispute resolves a panel moderated dispute once the votes cast
+// on it reach the panel's threshold. Only a member who voted for the winning
+// split builds the payout.
+func (n *OpenBazaarNode) resolvePanelDispute(dispute *repo.DisputeCaseRecord) error {
+	if dispute.BuyerContract == nil {
+		dispute.BuyerContract = dispute.VendorContract
+	}
+	panel := pb.OrderPayment(dispute.BuyerContract).ModeratorPanel
+	if panel == nil {
+		return ErrNotPanelModerated
+	}
+	votes, err := n.Datastore.PanelVotes().GetAll(dispute.CaseID)
+	if err != nil {
+		return err
+	}
+	agreeing, ok := tallyPanelVotes(panel, dispute.CaseID, votes)
+	if !ok {
+		return nil
+	}
+	var ours *pb.DisputeResolution
+	for _, v := range agreeing {
+		if v.Vote.ProposedBy == n.IpfsNode.Identity.Pretty() {
+			ours = v.Vote
+		}
+	}
+	if ours == nil {
+		return nil
+	}
+
+	payDivision := repo.PayoutRatio{Buyer: ours.BuyerPercentage, Vendor: ours.VendorPercentage}
+	if dispute.ResolutionPaymentOutpoints(payDivision) == nil {
+		return ErrCloseFailureNoOutpoints
+	}
+	if dispute.VendorContract == nil && payDivision.VendorAny() {
+		return errors.New("vendor must provide his copy of the contract before you can release funds to the vendor")
+	}
+	return n.resolveDispute(dispute, dispute.ResolutionPaymentContract(payDivision), payDivision, ours.Resolution, agreeing)
+}
+
+// ProcessPanelVote records a vote another member of the moderator panel cast
+// on a dispute we moderate with them, and resolves the dispute if the vote
+// completes the panel's threshold
+func (n *OpenBazaarNode) ProcessPanelVote(vote *pb.DisputeResolution_PanelVote, peerID string) error {
+	if vote.Vote == nil {
+		return errors.New("panel vote is empty")
+	}
+	if vote.Vote.ProposedBy != peerID {
+		return errors.New("panel vote was not cast by the peer who sent it")
+	}
+	dispute, err := n.Datastore.Cases().GetByCaseID(vote.Vote.OrderId)
+	if err != nil {
+		return ErrCaseNotFound
+	}
+	contract := dispute.Contract()
+	if contract == nil {
+		return ErrCaseNotFound
+	}
+	panel := pb.OrderPayment(contract).ModeratorPanel
+	if panel == nil {
+		return ErrNotPanelModerated
+	}
+	if err := verifyPanelVote(panel, vote); err != nil {
+		return err
+	}
+	if err := n.Datastore.PanelVotes().Put(dispute.CaseID, vote); err != nil {
+		return err
+	}
+
+	votes, err := n.Datastore.PanelVotes().GetAll(dispute.CaseID)
+	if err != nil {
+		return err
+	}
+	agreeing := 0
+	for _, v := range votes {
+		if v.Vote.BuyerPercentage == vote.Vote.BuyerPercentage && v.Vote.VendorPercentage == vote.Vote.VendorPercentage {
+			agreeing++
+		}
+	}
+	var thumbnail repo.Thumbnail
+	if len(contract.VendorListings) > 0 && contract.VendorListings[0].Item != nil && len(contract.VendorListings[0].Item.Images) > 0 {
+		thumbnail = repo.Thumbnail{Tiny: contract.VendorListings[0].Item.Images[0].Tiny, Small: contract.VendorListings[0].Item.Images[0].Small}
+	}
+	notif := repo.DisputePanelVoteNotification{
+		ID:               repo.NewNotificationID(),
+		Type:             repo.NotifierTypeDisputePanelVoteNotification,
+		OrderId:          dispute.CaseID,
+		ModeratorID:      peerID,
+		BuyerPercentage:  vote.Vote.BuyerPercentage,
+		VendorPercentage: vote.Vote.VendorPercentage,
+		Votes:            agreeing,
+		Threshold:        panel.Threshold,
+		Thumbnail:        thumbnail,
+	}
+	n.Broadcast <- notif
+	n.Datastore.Notifications().PutRecord(repo.NewNotification(notif, time.Now(), false))
+
+	if dispute.OrderState != pb.OrderState_DISPUTED || dispute.IsExpiredNow() {
+		return nil
+	}
+	return n.resolvePanelDispute(dispute)
+}
+
+// validatePanelSignatures checks that threshold members who voted for the
+// resolution of a panel moderated dispute have signed every input of its payout
+func validatePanelSignatures(contract *pb.RicardianContract) error {
+	panel := pb.OrderPayment(contract).ModeratorPanel
+	if panel == nil {
+		return ErrNotPanelModerated
+	}
+	d := contract.DisputeResolution
+	voted := make(map[string]bool)
+	for _, v := range d.PanelVotes {
+		voted[v.GetVote().GetProposedBy()] = true
+	}
+	signed := make(map[string]bool)
+	for _, s := range d.GetPayout().GetPanelSigs() {
+		if !voted[s.Moderator] {
+			return fmt.Errorf("%s signed the payout without voting for it", s.Moderator)
+		}
+		if signed[s.Moderator] {
+			return fmt.Errorf("%s signed the payout more than once", s.Moderator)
+		}
+		if len(s.Sigs) < len(d.Payout.Inputs) {
+			return fmt.Errorf("%s did not sign every input of the payout", s.Moderator)
+		}
+		signed[s.Moderator] = true
+	}
+	if len(signed) < int(panel.Threshold) {
+		return fmt.Errorf("payout carries the signatures of %d of the %d panel members needed", len(signed), panel.Threshold)
+	}
+	return nil
+}
+
+// panelSignatureSets returns the payout signatures of the first threshold
+// members of the panel who signed the resolution, in the order of the panel
+func panelSignatureSets(panel *pb.Order_Payment_ModeratorPanel, d *pb.DisputeResolution) [][]wallet.Signature {
+	var sets [][]wallet.Signature
+	for _, m := range panel.Moderators {
+		if len(sets) == int(panel.Threshold) {
+			break
+		}
+		for _, s := range d.Payout.PanelSigs {
+			if s.Moderator != m {
+				continue
+			}
+			var sigs []wallet.Signature
+			for _, sig := range s.Sigs {
+				sigs = append(sigs, wallet.Signature{InputIndex: sig.InputIndex, Signature: sig.Signature})
+			}
+			sets = append(sets, sigs)
+			break
+		}
+	}
+	return sets
+}
+
+// validatePanelPayout checks that the payout of a panel resolution spends the
+// disputed escrow and pays the parties at the addresses they gave the panel
+func validatePanelPayout(dispute *repo.DisputeCaseRecord, d *pb.DisputeResolution) error {
+	ratio := repo.PayoutRatio{Buyer: d.BuyerPercentage, Vendor: d.VendorPercentage}
+	outpoints := make(map[string]uint64)
+	for _, o := range dispute.ResolutionPaymentOutpoints(ratio) {
+		outpoints[fmt.Sprintf("%s:%d", o.Hash, o.Index)] = o.Value
+	}
+	if len(d.Payout.Inputs) != len(outpoints) {
+		return errors.New("payout does not spend the disputed escrow")
+	}
+	for _, in := range d.Payout.Inputs {
+		if value, ok := outpoints[fmt.Sprintf("%s:%d", in.Hash, in.Index)]; !ok || value != in.Value {
+			return errors.New("payout does not spend the disputed escrow")
+		}
+	}
+	if o := d.Payout.BuyerOutput; o != nil && o.GetAddress() != dispute.BuyerPayoutAddress {
+		return errors.New("payout does not pay the buyer's payout address")
+	}
+	if o := d.Payout.VendorOutput; o != nil && o.GetAddress() != dispute.VendorPayoutAddress {
+		return errors.New("payout does not pay the vendor's payout address")
+	}
+	return nil
+}
+
+// nextPanelSigner returns who the payout of a panel resolution goes to once
+// signer has signed it: the member who proposed it if threshold members have
+// signed, or else the next voter after signer who has not
+func nextPanelSigner(d *pb.DisputeResolution, signer string, threshold int) string {
+	signed := make(map[string]bool)
+	for _, s := range d.Payout.PanelSigs {
+		signed[s.Moderator] = true
+	}
+	if len(signed) >= threshold {
+		return d.ProposedBy
+	}
+	start := -1
+	for i, v := range d.PanelVotes {
+		if v.Vote.ProposedBy == signer {
+			start = i
+		}
+	}
+	for i := 1; i <= len(d.PanelVotes); i++ {
+		if voter := d.PanelVotes[(start+i)%len(d.PanelVotes)].Vote.ProposedBy; !signed[voter] {
+			return voter
+		}
+	}
+	return ""
+}
+
+// signPanelPayout adds our signatures to the payout of a panel resolution and
+// passes it on to the next member to sign it. The member who proposed the
+// payout sends the resolution to both parties once threshold members signed.
+func (n *OpenBazaarNode) signPanelPayout(contract *pb.RicardianContract, d *pb.DisputeResolution) error {
+	payment := pb.OrderPayment(contract)
+	wal, err := n.Multiwallet.WalletForCurrencyCode(payment.Coin)
+	if err != nil {
+		return err
+	}
+	inputs, outputs, err := disputePayoutTransaction(wal, d.Payout)
+	if err != nil {
+		return err
+	}
+	moderatorKey, err := n.moderatorEscrowKey(contract, wal)
+	if err != nil {
+		return err
+	}
+	redeemScript, err := hex.DecodeString(payment.RedeemScript)
+	if err != nil {
+		return err
+	}
+	sigs, err := wal.CreateMultisigSignature(inputs, outputs, moderatorKey, redeemScript, 0)
+	if err != nil {
+		return err
+	}
+	var bitcoinSigs []*pb.BitcoinSignature
+	for _, sig := range sigs {
+		bitcoinSigs = append(bitcoinSigs, &pb.BitcoinSignature{InputIndex: sig.InputIndex, Signature: sig.Signature})
+	}
+
+	ourID := n.IpfsNode.Identity.Pretty()
+	var panelSigs []*pb.DisputeResolution_Payout_PanelSignatures
+	for _, s := range d.Payout.PanelSigs {
+		if s.Moderator != ourID {
+			panelSigs = append(panelSigs, s)
+		}
+	}
+	d.Payout.PanelSigs = append(panelSigs, &pb.DisputeResolution_Payout_PanelSignatures{Moderator: ourID, Sigs: bitcoinSigs})
+	if d.ProposedBy == ourID {
+		d.Payout.Sigs = bitcoinSigs
+	}
+
+	next := nextPanelSigner(d, ourID, int(payment.ModeratorPanel.Threshold))
+	switch next {
+	case "":
+		return errors.New("no panel member is left to sign the payout")
+	case ourID:
+		return n.sendDisputeResolution(contract, d)
+	}
+	return n.SendDisputePanelPayout(next, d)
+}
+
+// ProcessPanelPayout checks the payout of a panel resolution which another
+// member passed on to us, and signs it if we voted for it
+func (n *OpenBazaarNode) ProcessPanelPayout(d *pb.DisputeResolution, peerID string) error {
+	dispute, err := n.Datastore.Cases().GetByCaseID(d.OrderId)
+	if err != nil {
+		return ErrCaseNotFound
+	}
+	contract := dispute.Contract()
+	if contract == nil {
+		return ErrCaseNotFound
+	}
+	if pb.OrderPayment(contract).ModeratorPanel == nil {
+		return ErrNotPanelModerated
+	}
+	rc := proto.Clone(contract).(*pb.RicardianContract)
+	rc.DisputeResolution = d
+	if err := validatePanelResolution(rc); err != nil {
+		return err
+	}
+	voted := false
+	for _, v := range d.PanelVotes {
+		if v.Vote.ProposedBy == n.IpfsNode.Identity.Pretty() {
+			voted = true
+		}
+	}
+	if !voted {
+		return errors.New("panel payout is for a resolution we did not vote for")
+	}
+	if err := validatePanelPayout(dispute, d); err != nil {
+		return err
+	}
+	log.Debugf("signing panel payout on case (%s) passed on by %s", d.OrderId, peerID)
+	return n.signPanelPayout(contract, d)
+}
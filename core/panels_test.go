@@ -0,0 +1,415 @@
+package core
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	crypto "gx/ipfs/QmTW4SdgBWq9GjsBsHeUx8WuGxzhgzAf88UMH2w62PC8yK/go-libp2p-crypto"
+
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	btc "github.com/btcsuite/btcutil"
+	hd "github.com/btcsuite/btcutil/hdkeychain"
+	"github.com/golang/protobuf/proto"
+	"github.com/kimitzu/kimitzu-go/pb"
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+func newTestModeratorPanel(t *testing.T, size int, threshold uint32) (*pb.Order_Payment, []crypto.PrivKey) {
+	panel := &pb.Order_Payment_ModeratorPanel{Threshold: threshold}
+	var keys []crypto.PrivKey
+	for i := 0; i < size; i++ {
+		key, id := newQuoteTestID(t)
+		keys = append(keys, key)
+		panel.Moderators = append(panel.Moderators, id.PeerID)
+		panel.ModeratorKeys = append(panel.ModeratorKeys, []byte{byte(i)})
+	}
+	return &pb.Order_Payment{
+		Method:         pb.Order_Payment_MODERATED,
+		Moderator:      panel.Moderators[0],
+		ModeratorKey:   panel.ModeratorKeys[0],
+		ModeratorPanel: panel,
+	}, keys
+}
+
+func newTestPanelVote(t *testing.T, payment *pb.Order_Payment, keys []crypto.PrivKey, member int, buyer, vendor float32, fee uint64) *pb.DisputeResolution_PanelVote {
+	vote := &pb.DisputeResolution{
+		OrderId:          "QmOrder",
+		ProposedBy:       payment.ModeratorPanel.Moderators[member],
+		BuyerPercentage:  buyer,
+		VendorPercentage: vendor,
+	}
+	if fee > 0 {
+		vote.Payout = &pb.DisputeResolution_Payout{
+			ModeratorOutput: &pb.DisputeResolution_Payout_Output{
+				ScriptOrAddress: &pb.DisputeResolution_Payout_Output_Address{Address: "modAddress" + vote.ProposedBy},
+				Amount:          fee,
+			},
+		}
+	}
+	pubkey, err := crypto.MarshalPublicKey(keys[member].GetPublic())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &pb.DisputeResolution_PanelVote{Vote: vote, Pubkey: pubkey, Signature: signQuoteTestMessage(t, keys[member], vote)}
+}
+
+func TestValidateModeratorPanel(t *testing.T) {
+	payment, _ := newTestModeratorPanel(t, 3, 2)
+	if err := validateModeratorPanel(payment, "QmBuyer", "QmVendor"); err != nil {
+		t.Fatal(err)
+	}
+	if err := validateModeratorPanel(&pb.Order_Payment{Moderator: "QmModerator"}, "QmBuyer", "QmVendor"); err != ErrNotPanelModerated {
+		t.Errorf("expected %s, got %v", ErrNotPanelModerated, err)
+	}
+
+	for _, threshold := range []uint32{0, 1, 4} {
+		payment.ModeratorPanel.Threshold = threshold
+		if err := validateModeratorPanel(payment, "QmBuyer", "QmVendor"); err == nil {
+			t.Errorf("expected a threshold of %d of 3 to fail", threshold)
+		}
+	}
+	payment.ModeratorPanel.Threshold = 2
+
+	if err := validateModeratorPanel(payment, "QmBuyer", payment.ModeratorPanel.Moderators[1]); err == nil {
+		t.Error("expected the vendor on the panel to fail")
+	}
+	payment.Moderator = payment.ModeratorPanel.Moderators[1]
+	if err := validateModeratorPanel(payment, "QmBuyer", "QmVendor"); err == nil {
+		t.Error("expected a moderator who is not the first member to fail")
+	}
+	payment.Moderator = payment.ModeratorPanel.Moderators[0]
+	payment.ModeratorPanel.Moderators[2] = payment.ModeratorPanel.Moderators[1]
+	if err := validateModeratorPanel(payment, "QmBuyer", "QmVendor"); err == nil {
+		t.Error("expected a duplicate member to fail")
+	}
+
+	payment, _ = newTestModeratorPanel(t, MaxPanelSize+1, MaxPanelSize)
+	if err := validateModeratorPanel(payment, "QmBuyer", "QmVendor"); err == nil {
+		t.Error("expected an oversized panel to fail")
+	}
+	payment, _ = newTestModeratorPanel(t, MaxPanelSize, MaxPanelSize)
+	if err := validateModeratorPanel(payment, "QmBuyer", "QmVendor"); err == nil {
+		t.Error("expected a panel whose escrow needs more than 20 keys to fail")
+	}
+}
+
+func TestPanelEscrowSpends(t *testing.T) {
+	// The buyer, the vendor and a panel of three with a threshold of two
+	var (
+		privs []*btcec.PrivateKey
+		keys  []hd.ExtendedKey
+	)
+	for i := 0; i < 5; i++ {
+		priv, err := btcec.NewPrivateKey(btcec.S256())
+		if err != nil {
+			t.Fatal(err)
+		}
+		privs = append(privs, priv)
+		keys = append(keys, *hd.NewExtendedKey(chaincfg.MainNetParams.HDPublicKeyID[:], priv.PubKey().SerializeCompressed(), nil, []byte{0, 0, 0, 0}, 0, 0, false))
+	}
+	weighted, threshold := weightPanelEscrowKeys(keys, 2)
+	if len(weighted) != 7 || threshold != 4 {
+		t.Fatalf("expected 4 of 7 escrow keys, got %d of %d", threshold, len(weighted))
+	}
+	var pubkeys []*btc.AddressPubKey
+	for _, key := range weighted {
+		pub, err := key.ECPubKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		addr, err := btc.NewAddressPubKey(pub.SerializeCompressed(), &chaincfg.MainNetParams)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pubkeys = append(pubkeys, addr)
+	}
+	redeemScript, err := txscript.MultiSigScript(pubkeys, threshold)
+	if err != nil {
+		t.Fatal(err)
+	}
+	witnessProgram := sha256.Sum256(redeemScript)
+	pkScript, err := txscript.NewScriptBuilder().AddOp(txscript.OP_0).AddData(witnessProgram[:]).Script()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const amount = 100000
+	spend := func(signers ...int) error {
+		tx := wire.NewMsgTx(1)
+		tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, nil, nil))
+		tx.AddTxOut(wire.NewTxOut(amount-1000, pkScript))
+		hashes := txscript.NewTxSigHashes(tx)
+		var sigs [][]wallet.Signature
+		for _, signer := range signers {
+			sig, err := txscript.RawTxInWitnessSignature(tx, hashes, 0, amount, redeemScript, txscript.SigHashAll, privs[signer])
+			if err != nil {
+				t.Fatal(err)
+			}
+			sigs = append(sigs, []wallet.Signature{{InputIndex: 0, Signature: sig}})
+		}
+		if err := setPanelWitnesses(tx, sigs, redeemScript); err != nil {
+			t.Fatal(err)
+		}
+		engine, err := txscript.NewEngine(pkScript, tx, 0, txscript.StandardVerifyFlags, nil, hashes, amount)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return engine.Execute()
+	}
+
+	for name, signers := range map[string][]int{
+		"both parties":            {0, 0, 1, 1},
+		"the buyer and a quorum":  {0, 0, 2, 4},
+		"the vendor and a quorum": {1, 1, 3, 4},
+	} {
+		if err := spend(signers...); err != nil {
+			t.Errorf("expected a spend signed by %s to succeed: %s", name, err)
+		}
+	}
+	for name, signers := range map[string][]int{
+		"the buyer alone":             {0, 0, 0, 0},
+		"the buyer and one member":    {0, 0, 2, 2},
+		"the vendor and one member":   {1, 1, 3, 3},
+		"the whole panel":             {2, 3, 4, 4},
+		"the buyer once and a quorum": {0, 2, 3, 3},
+	} {
+		if err := spend(signers...); err == nil {
+			t.Errorf("expected a spend signed by %s to fail", name)
+		}
+	}
+}
+
+func TestGenerateEscrowScriptUnsupported(t *testing.T) {
+	payment, _ := newTestModeratorPanel(t, 3, 2)
+	keys := make([]hd.ExtendedKey, 5)
+	if _, _, err := generateEscrowScript(&milestoneTestWallet{}, payment, keys, 0); err != ErrPanelEscrowUnsupported {
+		t.Errorf("expected %s, got %v", ErrPanelEscrowUnsupported, err)
+	}
+	if _, _, err := generateEscrowScript(&milestoneTestWallet{}, payment, keys[:4], 0); err == nil {
+		t.Error("expected a panel escrow missing a member's key to fail")
+	}
+}
+
+func TestVerifyPanelVote(t *testing.T) {
+	payment, keys := newTestModeratorPanel(t, 3, 2)
+	panel := payment.ModeratorPanel
+	if err := verifyPanelVote(panel, newTestPanelVote(t, payment, keys, 1, 50, 50, 100)); err != nil {
+		t.Fatal(err)
+	}
+
+	vote := newTestPanelVote(t, payment, keys, 1, 50, 50, 100)
+	vote.Vote.BuyerPercentage = 100
+	vote.Vote.VendorPercentage = 0
+	if err := verifyPanelVote(panel, vote); err == nil {
+		t.Error("expected a tampered vote to fail")
+	}
+	vote = newTestPanelVote(t, payment, keys, 1, 50, 50, 100)
+	vote.Pubkey, _ = crypto.MarshalPublicKey(keys[2].GetPublic())
+	if err := verifyPanelVote(panel, vote); err == nil {
+		t.Error("expected a vote signed by another member to fail")
+	}
+	if err := verifyPanelVote(panel, newTestPanelVote(t, payment, keys, 1, 60, 60, 100)); err == nil {
+		t.Error("expected an invalid split to fail")
+	}
+
+	outsider, _ := newTestModeratorPanel(t, 2, 2)
+	if err := verifyPanelVote(outsider.ModeratorPanel, newTestPanelVote(t, payment, keys, 1, 50, 50, 100)); err == nil {
+		t.Error("expected a vote from outside the panel to fail")
+	}
+}
+
+func TestTallyPanelVotes(t *testing.T) {
+	payment, keys := newTestModeratorPanel(t, 3, 2)
+	panel := payment.ModeratorPanel
+	a := newTestPanelVote(t, payment, keys, 0, 100, 0, 0)
+	b := newTestPanelVote(t, payment, keys, 1, 50, 50, 0)
+	c := newTestPanelVote(t, payment, keys, 2, 50, 50, 0)
+
+	if agreeing, ok := tallyPanelVotes(panel, "QmOrder", []*pb.DisputeResolution_PanelVote{a, b}); ok || len(agreeing) != 1 || agreeing[0] != a {
+		t.Errorf("expected the first split to lead without a decision, got %d votes", len(agreeing))
+	}
+	agreeing, ok := tallyPanelVotes(panel, "QmOrder", []*pb.DisputeResolution_PanelVote{a, b, c})
+	if !ok || len(agreeing) != 2 || agreeing[0] != b || agreeing[1] != c {
+		t.Errorf("expected the 50/50 split to reach the threshold, got %d votes", len(agreeing))
+	}
+	if _, ok := tallyPanelVotes(panel, "QmOrder", []*pb.DisputeResolution_PanelVote{b, b}); ok {
+		t.Error("expected a member's vote to count once")
+	}
+	if _, ok := tallyPanelVotes(panel, "QmOtherOrder", []*pb.DisputeResolution_PanelVote{b, c}); ok {
+		t.Error("expected votes on another case to be ignored")
+	}
+}
+
+func TestValidatePanelResolution(t *testing.T) {
+	payment, keys := newTestModeratorPanel(t, 3, 2)
+	contract := newLedgerTestContract(t, "USD")
+	contract.BuyerOrder.Payment = payment
+	b := newTestPanelVote(t, payment, keys, 1, 50, 50, 100)
+	c := newTestPanelVote(t, payment, keys, 2, 50, 50, 100)
+
+	newResolution := func() *pb.DisputeResolution {
+		return &pb.DisputeResolution{
+			OrderId:          "QmOrder",
+			ProposedBy:       payment.ModeratorPanel.Moderators[1],
+			BuyerPercentage:  50,
+			VendorPercentage: 50,
+			PanelVotes:       []*pb.DisputeResolution_PanelVote{b, c},
+			Payout: &pb.DisputeResolution_Payout{
+				BuyerOutput:  &pb.DisputeResolution_Payout_Output{Amount: 45000},
+				VendorOutput: &pb.DisputeResolution_Payout_Output{Amount: 45000},
+				PanelOutputs: []*pb.DisputeResolution_Payout_Output{
+					proto.Clone(b.Vote.Payout.ModeratorOutput).(*pb.DisputeResolution_Payout_Output),
+					proto.Clone(c.Vote.Payout.ModeratorOutput).(*pb.DisputeResolution_Payout_Output),
+				},
+			},
+		}
+	}
+	contract.DisputeResolution = newResolution()
+	for _, o := range contract.DisputeResolution.Payout.PanelOutputs {
+		o.Amount = 50
+	}
+	if err := validatePanelResolution(contract); err != nil {
+		t.Fatal(err)
+	}
+
+	contract.DisputeResolution = newResolution()
+	if err := validatePanelResolution(contract); err == nil {
+		t.Error("expected panel outputs above each voter's share of the fee to fail")
+	}
+
+	contract.DisputeResolution = newResolution()
+	contract.DisputeResolution.Payout.PanelOutputs = nil
+	contract.DisputeResolution.PanelVotes = []*pb.DisputeResolution_PanelVote{b}
+	if err := validatePanelResolution(contract); err == nil {
+		t.Error("expected a resolution below the threshold to fail")
+	}
+
+	contract.DisputeResolution = newResolution()
+	contract.DisputeResolution.Payout.PanelOutputs = nil
+	contract.DisputeResolution.ProposedBy = payment.ModeratorPanel.Moderators[0]
+	if err := validatePanelResolution(contract); err == nil {
+		t.Error("expected a resolution proposed by a member who did not vote to fail")
+	}
+
+	contract.DisputeResolution = newResolution()
+	contract.DisputeResolution.Payout.PanelOutputs = nil
+	contract.DisputeResolution.Payout.BuyerOutput.Amount = 90000
+	if err := validatePanelResolution(contract); err == nil {
+		t.Error("expected a payout against the panel's split to fail")
+	}
+}
+
+func TestValidatePanelSignatures(t *testing.T) {
+	payment, keys := newTestModeratorPanel(t, 3, 2)
+	contract := newLedgerTestContract(t, "USD")
+	contract.BuyerOrder.Payment = payment
+	members := payment.ModeratorPanel.Moderators
+	sigs := func(moderator string) *pb.DisputeResolution_Payout_PanelSignatures {
+		return &pb.DisputeResolution_Payout_PanelSignatures{Moderator: moderator, Sigs: []*pb.BitcoinSignature{{Signature: []byte(moderator)}}}
+	}
+	newResolution := func(signed ...string) *pb.DisputeResolution {
+		d := &pb.DisputeResolution{
+			ProposedBy: members[1],
+			PanelVotes: []*pb.DisputeResolution_PanelVote{
+				newTestPanelVote(t, payment, keys, 1, 50, 50, 0),
+				newTestPanelVote(t, payment, keys, 2, 50, 50, 0),
+			},
+			Payout: &pb.DisputeResolution_Payout{Inputs: []*pb.Outpoint{{Hash: "aa01"}}},
+		}
+		for _, m := range signed {
+			d.Payout.PanelSigs = append(d.Payout.PanelSigs, sigs(m))
+		}
+		return d
+	}
+
+	contract.DisputeResolution = newResolution(members[2], members[1])
+	if err := validatePanelSignatures(contract); err != nil {
+		t.Fatal(err)
+	}
+	sets := panelSignatureSets(payment.ModeratorPanel, contract.DisputeResolution)
+	if len(sets) != 2 || string(sets[0][0].Signature) != members[1] || string(sets[1][0].Signature) != members[2] {
+		t.Error("expected the panel's signatures in the order of its members")
+	}
+
+	for name, signed := range map[string][]string{
+		"below the threshold":    {members[1]},
+		"signed twice by member": {members[1], members[1]},
+		"signed by a non voter":  {members[0], members[1]},
+	} {
+		contract.DisputeResolution = newResolution(signed...)
+		if err := validatePanelSignatures(contract); err == nil {
+			t.Errorf("expected a payout %s to fail", name)
+		}
+	}
+	contract.DisputeResolution = newResolution(members[1], members[2])
+	contract.DisputeResolution.Payout.Inputs = append(contract.DisputeResolution.Payout.Inputs, &pb.Outpoint{Hash: "aa02"})
+	if err := validatePanelSignatures(contract); err == nil {
+		t.Error("expected a payout with unsigned inputs to fail")
+	}
+}
+
+func TestNextPanelSigner(t *testing.T) {
+	payment, keys := newTestModeratorPanel(t, 5, 3)
+	members := payment.ModeratorPanel.Moderators
+	d := &pb.DisputeResolution{ProposedBy: members[2], Payout: &pb.DisputeResolution_Payout{}}
+	for i := 0; i < 4; i++ {
+		d.PanelVotes = append(d.PanelVotes, newTestPanelVote(t, payment, keys, i, 100, 0, 0))
+	}
+	for _, step := range []struct {
+		signer, next string
+	}{
+		{members[2], members[3]},
+		{members[3], members[0]},
+		{members[0], members[2]},
+	} {
+		d.Payout.PanelSigs = append(d.Payout.PanelSigs, &pb.DisputeResolution_Payout_PanelSignatures{Moderator: step.signer})
+		if next := nextPanelSigner(d, step.signer, 3); next != step.next {
+			t.Errorf("expected the payout to go from %s to %s, got %s", step.signer, step.next, next)
+		}
+	}
+}
+
+func TestValidatePanelPayout(t *testing.T) {
+	dispute := &repo.DisputeCaseRecord{
+		BuyerOutpoints:      []*pb.Outpoint{{Hash: "aa01", Index: 0, Value: 60000}, {Hash: "aa02", Index: 1, Value: 40000}},
+		BuyerPayoutAddress:  "buyerAddress",
+		VendorPayoutAddress: "vendorAddress",
+	}
+	newResolution := func() *pb.DisputeResolution {
+		return &pb.DisputeResolution{
+			BuyerPercentage:  50,
+			VendorPercentage: 50,
+			Payout: &pb.DisputeResolution_Payout{
+				Inputs:       []*pb.Outpoint{{Hash: "aa02", Index: 1, Value: 40000}, {Hash: "aa01", Index: 0, Value: 60000}},
+				BuyerOutput:  &pb.DisputeResolution_Payout_Output{ScriptOrAddress: &pb.DisputeResolution_Payout_Output_Address{Address: "buyerAddress"}},
+				VendorOutput: &pb.DisputeResolution_Payout_Output{ScriptOrAddress: &pb.DisputeResolution_Payout_Output_Address{Address: "vendorAddress"}},
+			},
+		}
+	}
+	if err := validatePanelPayout(dispute, newResolution()); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]func(d *pb.DisputeResolution){
+		"a missing input":     func(d *pb.DisputeResolution) { d.Payout.Inputs = d.Payout.Inputs[:1] },
+		"another input":       func(d *pb.DisputeResolution) { d.Payout.Inputs[0].Hash = "bb01" },
+		"another input value": func(d *pb.DisputeResolution) { d.Payout.Inputs[0].Value++ },
+		"another buyer address": func(d *pb.DisputeResolution) {
+			d.Payout.BuyerOutput.ScriptOrAddress = &pb.DisputeResolution_Payout_Output_Address{Address: "otherAddress"}
+		},
+		"a vendor script": func(d *pb.DisputeResolution) {
+			d.Payout.VendorOutput.ScriptOrAddress = &pb.DisputeResolution_Payout_Output_Script{Script: "vendorAddress"}
+		},
+	}
+	for name, modify := range tests {
+		d := newResolution()
+		modify(d)
+		if err := validatePanelPayout(dispute, d); err == nil {
+			t.Errorf("expected a payout with %s to fail", name)
+		}
+	}
+}
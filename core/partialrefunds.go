@@ -0,0 +1,421 @@
+package core
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	libp2p "gx/ipfs/QmTW4SdgBWq9GjsBsHeUx8WuGxzhgzAf88UMH2w62PC8yK/go-libp2p-crypto"
+
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/kimitzu/kimitzu-go/pb"
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+var (
+	// ErrPartialRefundAmount - the refund is zero or would leave nothing of the payment to the vendor
+	ErrPartialRefundAmount = errors.New("partial refund must be greater than zero and less than what is left of the payment")
+	// ErrPartialRefundFulfilled - the vendor's payout signatures would no longer match the escrow
+	ErrPartialRefundFulfilled = errors.New("moderated orders can only be partially refunded before they are fulfilled")
+	// ErrPartialRefundItems - the items of the order cannot be priced from the contract
+	ErrPartialRefundItems = errors.New("the items of this order cannot be priced, refund an amount instead")
+)
+
+// PartialRefundData - what the vendor refunds. Either Amount, in the payment
+// coin, or Items must be set.
+type PartialRefundData struct {
+	OrderID string              `json:"orderId"`
+	Amount  uint64              `json:"amount"`
+	Items   []PartialRefundItem `json:"items"`
+	Memo    string              `json:"memo"`
+}
+
+// PartialRefundItem - refund Quantity units of the item at Index in the order
+type PartialRefundItem struct {
+	Index    uint32 `json:"index"`
+	Quantity uint64 `json:"quantity"`
+}
+
+// RefundedAmount returns how much of the payment the partial refunds of the
+// order have returned to the buyer
+func RefundedAmount(contract *pb.RicardianContract) uint64 {
+	var refunded uint64
+	for _, r := range contract.PartialRefunds {
+		refunded += r.Amount
+	}
+	return refunded
+}
+
+func refundedItemQuantity(contract *pb.RicardianContract, index uint32) uint64 {
+	var quantity uint64
+	for _, r := range contract.PartialRefunds {
+		for _, item := range r.Items {
+			if item.Index == index {
+				quantity += item.Quantity
+			}
+		}
+	}
+	return quantity
+}
+
+// partialRefundItemsAmount returns the share of the payment covering the
+// items. Items are priced in the currency the listings are priced in, the way
+// the invoice itemizes them, so both parties arrive at the same amount
+// regardless of exchange rates.
+func partialRefundItemsAmount(contract *pb.RicardianContract, items []*pb.PartialRefund_Item) (uint64, error) {
+	if pb.AcceptedOrderAmendment(contract) != nil {
+		return 0, ErrPartialRefundItems
+	}
+	var pricingCurrency string
+	for _, l := range contract.VendorListings {
+		if l.Metadata == nil || l.Metadata.Format == pb.Listing_Metadata_MARKET_PRICE {
+			return 0, ErrPartialRefundItems
+		}
+		if pricingCurrency == "" {
+			pricingCurrency = l.Metadata.PricingCurrency
+		} else if !strings.EqualFold(pricingCurrency, l.Metadata.PricingCurrency) {
+			return 0, ErrPartialRefundItems
+		}
+	}
+	price := func(currencyCode string, amount uint64) (uint64, error) {
+		if !strings.EqualFold(currencyCode, pricingCurrency) {
+			return 0, fmt.Errorf("listing priced in %s", currencyCode)
+		}
+		return amount, nil
+	}
+	marketPrice := func(string, uint64) (uint64, error) {
+		return 0, errors.New("market priced listings cannot be itemized")
+	}
+	total, err := calculateOrderTotal(contract, price, marketPrice)
+	if err != nil || total == 0 {
+		return 0, ErrPartialRefundItems
+	}
+	prices, err := calculateOrderItems(contract, price, marketPrice)
+	if err != nil {
+		return 0, ErrPartialRefundItems
+	}
+
+	paid := int64(pb.OrderPayment(contract).Amount)
+	seen := make(map[uint32]bool)
+	var amount int64
+	for _, item := range items {
+		if int(item.Index) >= len(prices) {
+			return 0, fmt.Errorf("order has no item %d", item.Index)
+		}
+		if seen[item.Index] {
+			return 0, fmt.Errorf("item %d is listed more than once", item.Index)
+		}
+		seen[item.Index] = true
+		p := prices[item.Index]
+		left := p.Quantity - refundedItemQuantity(contract, item.Index)
+		if item.Quantity == 0 || item.Quantity > left {
+			return 0, fmt.Errorf("between 1 and %d of item %d can be refunded", left, item.Index)
+		}
+		amount += scaleAmount(paid, p.Total*item.Quantity, total*p.Quantity)
+	}
+	return uint64(amount), nil
+}
+
+// validatePartialRefund checks the refund follows the ones already in the
+// contract and leaves part of the payment to the vendor
+func validatePartialRefund(contract *pb.RicardianContract, refund *pb.PartialRefund) error {
+	if IsMilestoneOrder(contract) {
+		return errors.New("milestone orders are refunded in full or by releasing milestones")
+	}
+	if int(refund.Index) != len(contract.PartialRefunds) {
+		return fmt.Errorf("expected partial refund %d, got %d", len(contract.PartialRefunds), refund.Index)
+	}
+	if contract.BuyerOrder.Payment.Method == pb.Order_Payment_MODERATED && len(contract.VendorOrderFulfillment) > 0 {
+		return ErrPartialRefundFulfilled
+	}
+	if len(refund.Items) > 0 {
+		amount, err := partialRefundItemsAmount(contract, refund.Items)
+		if err != nil {
+			return err
+		}
+		if amount != refund.Amount {
+			return errors.New("partial refund amount does not match the refunded items")
+		}
+	}
+	if refund.Amount == 0 || RefundedAmount(contract)+refund.Amount >= pb.OrderPayment(contract).Amount {
+		return ErrPartialRefundAmount
+	}
+	if len(refund.Memo) > DescriptionMaxCharacters {
+		return fmt.Errorf("memo is longer than the max of %d characters", DescriptionMaxCharacters)
+	}
+	return nil
+}
+
+// partialRefundTransaction returns the inputs and outputs of the escrow
+// transaction which pays the refund to the buyer. The rest of the escrowed
+// funds goes back to the escrow address so it can still be released to the
+// vendor. The wallet splits the fee evenly across the outputs so the refund
+// is raised by its share and the change lowered by the same amount, leaving
+// the whole fee to come out of the escrowed remainder.
+func partialRefundTransaction(wal wallet.Wallet, contract *pb.RicardianContract, records []*wallet.TransactionRecord, amount uint64) ([]wallet.TransactionInput, []wallet.TransactionOutput, error) {
+	var ins []wallet.TransactionInput
+	var outValue int64
+	for _, r := range records {
+		if !r.Spent && r.Value > 0 {
+			addr, err := wal.DecodeAddress(r.Address)
+			if err != nil {
+				return nil, nil, err
+			}
+			outpointHash, err := hex.DecodeString(r.Txid)
+			if err != nil {
+				return nil, nil, fmt.Errorf("decoding transaction hash: %s", err.Error())
+			}
+			outValue += r.Value
+			ins = append(ins, wallet.TransactionInput{
+				LinkedAddress: addr,
+				OutpointIndex: r.Index,
+				OutpointHash:  outpointHash,
+				Value:         r.Value,
+			})
+		}
+	}
+	refundAddress, err := wal.DecodeAddress(contract.BuyerOrder.RefundAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+	escrowAddress, err := wal.DecodeAddress(pb.OrderPayment(contract).Address)
+	if err != nil {
+		return nil, nil, err
+	}
+	fee := int64(contract.BuyerOrder.RefundFee * EscrowReleaseSize)
+	change := outValue - int64(amount)
+	if change <= fee {
+		return nil, nil, errors.New("escrow would be left with too little to release, refund the whole order instead")
+	}
+	share := fee / 2
+	return ins, []wallet.TransactionOutput{
+		{Address: refundAddress, Value: int64(amount) + share},
+		{Address: escrowAddress, Value: change - share},
+	}, nil
+}
+
+// PartialRefundOrder - refund part of the order to the buyer. Direct orders are
+// refunded from our wallet. For moderated orders we sign the escrow transaction
+// and the buyer broadcasts it.
+func (n *OpenBazaarNode) PartialRefundOrder(contract *pb.RicardianContract, records []*wallet.TransactionRecord, data *PartialRefundData) (*pb.PartialRefund, error) {
+	orderID, err := n.CalcOrderID(contract.BuyerOrder)
+	if err != nil {
+		return nil, err
+	}
+	refund := &pb.PartialRefund{
+		OrderID: orderID,
+		Index:   uint32(len(contract.PartialRefunds)),
+		Amount:  data.Amount,
+		Memo:    data.Memo,
+	}
+	if len(data.Items) > 0 {
+		if data.Amount > 0 {
+			return nil, errors.New("refund either an amount or items, not both")
+		}
+		for _, item := range data.Items {
+			refund.Items = append(refund.Items, &pb.PartialRefund_Item{Index: item.Index, Quantity: item.Quantity})
+		}
+		if refund.Amount, err = partialRefundItemsAmount(contract, refund.Items); err != nil {
+			return nil, err
+		}
+	}
+	ts, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	refund.Timestamp = ts
+	if err := validatePartialRefund(contract, refund); err != nil {
+		return nil, err
+	}
+
+	wal, err := n.Multiwallet.WalletForCurrencyCode(contract.BuyerOrder.Payment.Coin)
+	if err != nil {
+		return nil, err
+	}
+	if contract.BuyerOrder.Payment.Method == pb.Order_Payment_MODERATED {
+		ins, outputs, err := partialRefundTransaction(wal, contract, records, refund.Amount)
+		if err != nil {
+			return nil, err
+		}
+		chaincode, err := hex.DecodeString(pb.OrderPayment(contract).Chaincode)
+		if err != nil {
+			return nil, err
+		}
+		mECKey, err := n.MasterPrivateKey.ECPrivKey()
+		if err != nil {
+			return nil, err
+		}
+		vendorKey, err := wal.ChildKey(mECKey.Serialize(), chaincode, true)
+		if err != nil {
+			return nil, err
+		}
+		redeemScript, err := hex.DecodeString(pb.OrderPayment(contract).RedeemScript)
+		if err != nil {
+			return nil, err
+		}
+		signatures, err := wal.CreateMultisigSignature(ins, outputs, vendorKey, redeemScript, contract.BuyerOrder.RefundFee)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range signatures {
+			refund.Sigs = append(refund.Sigs, &pb.BitcoinSignature{Signature: s.Signature, InputIndex: s.InputIndex})
+		}
+	} else {
+		refundAddr, err := wal.DecodeAddress(contract.BuyerOrder.RefundAddress)
+		if err != nil {
+			return nil, err
+		}
+		txid, err := wal.Spend(int64(refund.Amount), refundAddr, wallet.NORMAL, orderID, false)
+		if err != nil {
+			return nil, err
+		}
+		refund.RefundTransaction = &pb.Refund_TransactionInfo{Txid: txid.String(), Value: refund.Amount}
+	}
+
+	rc := new(pb.RicardianContract)
+	rc.PartialRefunds = []*pb.PartialRefund{refund}
+	rc, err = n.SignPartialRefund(rc)
+	if err != nil {
+		return nil, err
+	}
+	buyerKey, err := libp2p.UnmarshalPublicKey(contract.BuyerOrder.BuyerID.Pubkeys.Identity)
+	if err != nil {
+		return nil, err
+	}
+	if err := n.SendPartialRefund(contract.BuyerOrder.BuyerID.PeerID, &buyerKey, rc); err != nil {
+		return nil, err
+	}
+
+	contract.PartialRefunds = append(contract.PartialRefunds, refund)
+	for _, sig := range rc.Signatures {
+		if sig.Section == pb.Signature_PARTIAL_REFUND {
+			contract.Signatures = append(contract.Signatures, sig)
+		}
+	}
+	if err := n.Datastore.Sales().Put(orderID, *contract, pb.OrderState_PARTIALLY_REFUNDED, true); err != nil {
+		return nil, err
+	}
+	RecordExchangeRates(n.Datastore, n.Multiwallet, contract.BuyerOrder.Payment.Coin, repo.ExchangeRateReasonRefunded, orderID)
+	n.RefreshInvoice(orderID)
+	return refund, nil
+}
+
+// SignPartialRefund - add signature to partial refund
+func (n *OpenBazaarNode) SignPartialRefund(contract *pb.RicardianContract) (*pb.RicardianContract, error) {
+	serializedRefund, err := proto.Marshal(contract.PartialRefunds[0])
+	if err != nil {
+		return contract, err
+	}
+	s := new(pb.Signature)
+	s.Section = pb.Signature_PARTIAL_REFUND
+	guidSig, err := n.IpfsNode.PrivateKey.Sign(serializedRefund)
+	if err != nil {
+		return contract, err
+	}
+	s.SignatureBytes = guidSig
+	contract.Signatures = append(contract.Signatures, s)
+	return contract, nil
+}
+
+// ValidatePartialRefund - validate a partial refund received from the vendor.
+// The contract must be our copy of the order, refund the refund being applied
+// and sigs the signatures which accompanied it.
+func (n *OpenBazaarNode) ValidatePartialRefund(contract *pb.RicardianContract, refund *pb.PartialRefund, sigs []*pb.Signature) error {
+	if err := verifyMessageSignature(
+		refund,
+		contract.VendorListings[0].VendorID.Pubkeys.Identity,
+		sigs,
+		pb.Signature_PARTIAL_REFUND,
+		contract.VendorListings[0].VendorID.PeerID,
+	); err != nil {
+		switch err.(type) {
+		case noSigError:
+			return errors.New("contract does not contain a signature for the partial refund")
+		case invalidSigError:
+			return errors.New("vendor's guid signature on partial refund failed to verify")
+		case matchKeyError:
+			return errors.New("public key in order does not match reported vendor ID")
+		default:
+			return err
+		}
+	}
+	if err := validatePartialRefund(contract, refund); err != nil {
+		return err
+	}
+	if contract.BuyerOrder.Payment.Method == pb.Order_Payment_MODERATED {
+		if len(refund.Sigs) == 0 {
+			return errors.New("partial refund for a moderated order is missing signatures")
+		}
+	} else if refund.RefundTransaction == nil {
+		return errors.New("partial refund for a direct order is missing the refund transaction")
+	}
+	return nil
+}
+
+// AcceptPartialRefund - add our signatures to the vendor's on the escrow
+// transaction of a moderated order and broadcast it. Direct orders were
+// already refunded by the vendor.
+func (n *OpenBazaarNode) AcceptPartialRefund(contract *pb.RicardianContract, records []*wallet.TransactionRecord, refund *pb.PartialRefund) error {
+	if contract.BuyerOrder.Payment.Method != pb.Order_Payment_MODERATED {
+		return nil
+	}
+	wal, err := n.Multiwallet.WalletForCurrencyCode(contract.BuyerOrder.Payment.Coin)
+	if err != nil {
+		return err
+	}
+	ins, outputs, err := partialRefundTransaction(wal, contract, records, refund.Amount)
+	if err != nil {
+		return err
+	}
+	chaincode, err := hex.DecodeString(pb.OrderPayment(contract).Chaincode)
+	if err != nil {
+		return err
+	}
+	mECKey, err := n.MasterPrivateKey.ECPrivKey()
+	if err != nil {
+		return err
+	}
+	buyerKey, err := wal.ChildKey(mECKey.Serialize(), chaincode, true)
+	if err != nil {
+		return err
+	}
+	redeemScript, err := hex.DecodeString(pb.OrderPayment(contract).RedeemScript)
+	if err != nil {
+		return err
+	}
+	buyerSignatures, err := wal.CreateMultisigSignature(ins, outputs, buyerKey, redeemScript, contract.BuyerOrder.RefundFee)
+	if err != nil {
+		return err
+	}
+	var vendorSignatures []wallet.Signature
+	for _, s := range refund.Sigs {
+		vendorSignatures = append(vendorSignatures, wallet.Signature{InputIndex: s.InputIndex, Signature: s.Signature})
+	}
+	return MultisignEscrow(wal, pb.OrderPayment(contract), ins, outputs, buyerSignatures, vendorSignatures, redeemScript, contract.BuyerOrder.RefundFee)
+}
+
+// verifyPartialRefundSignatures checks every partial refund in the contract
+// was signed by the vendor
+func verifyPartialRefundSignatures(contract *pb.RicardianContract) []string {
+	var validationErrors []string
+	var sigs []*pb.Signature
+	for _, sig := range contract.Signatures {
+		if sig.Section == pb.Signature_PARTIAL_REFUND {
+			sigs = append(sigs, sig)
+		}
+	}
+	if len(sigs) < len(contract.PartialRefunds) {
+		return append(validationErrors, "Not all partial refunds are signed by the vendor")
+	}
+	vendorID := contract.VendorListings[0].VendorID
+	for i, r := range contract.PartialRefunds {
+		if err := verifyMessageSignature(r, vendorID.Pubkeys.Identity, []*pb.Signature{sigs[i]}, pb.Signature_PARTIAL_REFUND, vendorID.PeerID); err != nil {
+			validationErrors = append(validationErrors, fmt.Sprintf("Invalid vendor signature on partial refund %d", i))
+		}
+	}
+	return validationErrors
+}
@@ -0,0 +1,142 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/kimitzu/kimitzu-go/pb"
+)
+
+func TestPartialRefundItemsAmount(t *testing.T) {
+	contract := newLedgerTestContract(t, "USD")
+
+	// One of the two widgets is 1000 of the 2250 USD total
+	amount, err := partialRefundItemsAmount(contract, []*pb.PartialRefund_Item{{Index: 0, Quantity: 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if amount != 44444 {
+		t.Errorf("expected 44444, got %d", amount)
+	}
+
+	for _, items := range [][]*pb.PartialRefund_Item{
+		{{Index: 0, Quantity: 0}},
+		{{Index: 0, Quantity: 3}},
+		{{Index: 1, Quantity: 1}},
+		{{Index: 0, Quantity: 1}, {Index: 0, Quantity: 1}},
+	} {
+		if _, err := partialRefundItemsAmount(contract, items); err == nil {
+			t.Errorf("expected refunding %v to fail", items)
+		}
+	}
+
+	contract.PartialRefunds = []*pb.PartialRefund{{Amount: amount, Items: []*pb.PartialRefund_Item{{Index: 0, Quantity: 1}}}}
+	if _, err := partialRefundItemsAmount(contract, []*pb.PartialRefund_Item{{Index: 0, Quantity: 2}}); err == nil {
+		t.Error("expected refunding an item which was already refunded to fail")
+	}
+
+	contract = newLedgerTestContract(t, "USD")
+	contract.VendorListings[0].Metadata.Format = pb.Listing_Metadata_MARKET_PRICE
+	if _, err := partialRefundItemsAmount(contract, []*pb.PartialRefund_Item{{Index: 0, Quantity: 1}}); err != ErrPartialRefundItems {
+		t.Errorf("expected %s, got %v", ErrPartialRefundItems, err)
+	}
+}
+
+func TestValidatePartialRefund(t *testing.T) {
+	contract := newLedgerTestContract(t, "USD")
+	if err := validatePartialRefund(contract, &pb.PartialRefund{Amount: 50000}); err != nil {
+		t.Fatal(err)
+	}
+	if err := validatePartialRefund(contract, &pb.PartialRefund{Amount: 44444, Items: []*pb.PartialRefund_Item{{Index: 0, Quantity: 1}}}); err != nil {
+		t.Error(err)
+	}
+	if err := validatePartialRefund(contract, &pb.PartialRefund{Amount: 50000, Items: []*pb.PartialRefund_Item{{Index: 0, Quantity: 1}}}); err == nil {
+		t.Error("expected an amount which does not match the items to fail")
+	}
+	for _, amount := range []uint64{0, 100000} {
+		if err := validatePartialRefund(contract, &pb.PartialRefund{Amount: amount}); err != ErrPartialRefundAmount {
+			t.Errorf("expected refunding %d to fail with %s, got %v", amount, ErrPartialRefundAmount, err)
+		}
+	}
+
+	contract.PartialRefunds = []*pb.PartialRefund{{Amount: 60000}}
+	if err := validatePartialRefund(contract, &pb.PartialRefund{Index: 1, Amount: 40000}); err != ErrPartialRefundAmount {
+		t.Errorf("expected refunding the rest of the payment to fail with %s, got %v", ErrPartialRefundAmount, err)
+	}
+	if err := validatePartialRefund(contract, &pb.PartialRefund{Index: 0, Amount: 10000}); err == nil {
+		t.Error("expected a refund out of order to fail")
+	}
+	if err := validatePartialRefund(contract, &pb.PartialRefund{Index: 1, Amount: 10000}); err != nil {
+		t.Error(err)
+	}
+
+	contract.BuyerOrder.Payment.Method = pb.Order_Payment_MODERATED
+	contract.VendorOrderFulfillment = []*pb.OrderFulfillment{{}}
+	if err := validatePartialRefund(contract, &pb.PartialRefund{Index: 1, Amount: 10000}); err != ErrPartialRefundFulfilled {
+		t.Errorf("expected %s, got %v", ErrPartialRefundFulfilled, err)
+	}
+}
+
+func TestPartialRefundTransaction(t *testing.T) {
+	wal := &milestoneTestWallet{}
+	contract := newLedgerTestContract(t, "USD")
+	contract.BuyerOrder.RefundAddress = newMilestoneTestAddress(t, "buyer").String()
+	contract.BuyerOrder.RefundFee = 10
+	contract.BuyerOrder.Payment.Address = newMilestoneTestAddress(t, "escrow").String()
+	records := []*wallet.TransactionRecord{
+		{Txid: "aa", Value: 60000, Address: contract.BuyerOrder.Payment.Address},
+		{Txid: "bb", Value: 40000, Address: contract.BuyerOrder.Payment.Address},
+		{Txid: "cc", Value: 5000, Address: contract.BuyerOrder.Payment.Address, Spent: true},
+	}
+
+	// The refund carries half of the 3370 fee so the wallet's even split pays the buyer 20000
+	ins, outs, err := partialRefundTransaction(wal, contract, records, 20000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ins) != 2 || len(outs) != 2 {
+		t.Fatalf("expected 2 inputs and 2 outputs, got %d and %d", len(ins), len(outs))
+	}
+	if outs[0].Address.String() != contract.BuyerOrder.RefundAddress || outs[0].Value != 21685 {
+		t.Errorf("expected 21685 paid to the buyer, got %d to %s", outs[0].Value, outs[0].Address)
+	}
+	if outs[1].Address.String() != contract.BuyerOrder.Payment.Address || outs[1].Value != 78315 {
+		t.Errorf("expected 78315 returned to escrow, got %d to %s", outs[1].Value, outs[1].Address)
+	}
+	share := int64(contract.BuyerOrder.RefundFee*EscrowReleaseSize) / int64(len(outs))
+	if outs[0].Value-share != 20000 {
+		t.Errorf("expected the buyer to receive 20000 after fees, got %d", outs[0].Value-share)
+	}
+
+	if _, _, err := partialRefundTransaction(wal, contract, records, 97000); err == nil {
+		t.Error("expected a refund leaving too little in escrow to fail")
+	}
+}
+
+func TestVerifyPartialRefundSignatures(t *testing.T) {
+	contract := newLedgerTestContract(t, "USD")
+	vendorKey, vendorID := newQuoteTestID(t)
+	otherKey, _ := newQuoteTestID(t)
+	contract.VendorListings[0].VendorID = vendorID
+
+	first := &pb.PartialRefund{OrderID: "QmOrder", Amount: 10000, Timestamp: ptypes.TimestampNow()}
+	second := &pb.PartialRefund{OrderID: "QmOrder", Index: 1, Amount: 20000, Timestamp: ptypes.TimestampNow()}
+	contract.PartialRefunds = []*pb.PartialRefund{first, second}
+	contract.Signatures = []*pb.Signature{
+		{Section: pb.Signature_PARTIAL_REFUND, SignatureBytes: signQuoteTestMessage(t, vendorKey, first)},
+		{Section: pb.Signature_PARTIAL_REFUND, SignatureBytes: signQuoteTestMessage(t, vendorKey, second)},
+	}
+	if errs := verifyPartialRefundSignatures(contract); len(errs) > 0 {
+		t.Fatal(errs)
+	}
+
+	contract.Signatures[1].SignatureBytes = signQuoteTestMessage(t, otherKey, second)
+	if errs := verifyPartialRefundSignatures(contract); len(errs) != 1 {
+		t.Errorf("expected a refund signed by someone else to fail, got %v", errs)
+	}
+	contract.Signatures = contract.Signatures[:1]
+	if errs := verifyPartialRefundSignatures(contract); len(errs) != 1 {
+		t.Errorf("expected an unsigned refund to fail, got %v", errs)
+	}
+}
@@ -0,0 +1,308 @@
+package core
+
+import (
+	"errors"
+	"math"
+	"strings"
+)
+
+// Open Location Code (plus code) support, following the reference
+// specification at https://github.com/google/open-location-code
+
+const (
+	plusCodeSeparator          = '+'
+	plusCodeSeparatorPosition  = 8
+	plusCodePadding            = '0'
+	plusCodeAlphabet           = "23456789CFGHJMPQRVWX"
+	plusCodeEncodingBase       = len(plusCodeAlphabet)
+	plusCodePairCodeLength     = 10
+	plusCodeMaxDigitCount      = 15
+	plusCodeGridColumns        = 4
+	plusCodeGridRows           = 5
+	plusCodeMinTrimmableLength = 6
+	plusCodeLatitudeMax        = 90
+	plusCodeLongitudeMax       = 180
+
+	// Integer precisions used when encoding, the product of the pair and
+	// grid precisions for the maximum code length
+	plusCodePairPrecision     = 8000
+	plusCodeGridLatPrecision  = 3125
+	plusCodeGridLngPrecision  = 1024
+	plusCodeFinalLatPrecision = plusCodePairPrecision * plusCodeGridLatPrecision
+	plusCodeFinalLngPrecision = plusCodePairPrecision * plusCodeGridLngPrecision
+
+	// PlusCodeDefaultLength - the code length used when encoding addresses, an area of about 14x14 meters
+	PlusCodeDefaultLength = 10
+)
+
+var (
+	// ErrInvalidPlusCode - the plus code is malformed
+	ErrInvalidPlusCode = errors.New("invalid plus code")
+	// ErrShortPlusCode - the plus code has to be recovered against a reference location before decoding
+	ErrShortPlusCode = errors.New("plus code is not a full code")
+	// ErrInvalidPlusCodeLength - plus codes have 2, 4, 6, 8 or between 10 and 15 digits
+	ErrInvalidPlusCodeLength = errors.New("invalid plus code length")
+)
+
+// CodeArea is the area covered by a decoded plus code
+type CodeArea struct {
+	LatitudeLo  float64 `json:"latitudeLo"`
+	LongitudeLo float64 `json:"longitudeLo"`
+	LatitudeHi  float64 `json:"latitudeHi"`
+	LongitudeHi float64 `json:"longitudeHi"`
+	CodeLength  int     `json:"codeLength"`
+}
+
+// Center returns the coordinates of the center of the area
+func (area CodeArea) Center() (latitude, longitude float64) {
+	latitude = (area.LatitudeLo + area.LatitudeHi) / 2
+	if latitude > plusCodeLatitudeMax {
+		latitude = plusCodeLatitudeMax
+	}
+	longitude = (area.LongitudeLo + area.LongitudeHi) / 2
+	if longitude > plusCodeLongitudeMax {
+		longitude = plusCodeLongitudeMax
+	}
+	return latitude, longitude
+}
+
+// IsValidPlusCode returns whether the code is a valid full or short plus code
+func IsValidPlusCode(code string) bool {
+	code = strings.ToUpper(code)
+	sep := strings.IndexRune(code, plusCodeSeparator)
+	if sep < 0 || sep != strings.LastIndexByte(code, plusCodeSeparator) {
+		return false
+	}
+	if len(code) == 1 || sep > plusCodeSeparatorPosition || sep%2 == 1 {
+		return false
+	}
+	if pad := strings.IndexByte(code, plusCodePadding); pad >= 0 {
+		// Padding is only allowed in full codes and must end at the separator
+		if sep < plusCodeSeparatorPosition || pad == 0 || pad%2 == 1 {
+			return false
+		}
+		padding := code[pad:sep]
+		if strings.Trim(padding, string(plusCodePadding)) != "" || len(padding)%2 == 1 {
+			return false
+		}
+		if len(code) > sep+1 {
+			return false
+		}
+	}
+	// A single digit after the separator is not allowed
+	if len(code)-sep-1 == 1 {
+		return false
+	}
+	for _, c := range code {
+		if c == plusCodeSeparator || c == plusCodePadding {
+			continue
+		}
+		if !strings.ContainsRune(plusCodeAlphabet, c) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsShortPlusCode returns whether the code is a valid short plus code
+func IsShortPlusCode(code string) bool {
+	if !IsValidPlusCode(code) {
+		return false
+	}
+	sep := strings.IndexRune(code, plusCodeSeparator)
+	return sep >= 0 && sep < plusCodeSeparatorPosition
+}
+
+// IsFullPlusCode returns whether the code is a valid full plus code
+func IsFullPlusCode(code string) bool {
+	if !IsValidPlusCode(code) || IsShortPlusCode(code) {
+		return false
+	}
+	code = strings.ToUpper(code)
+	if strings.IndexByte(plusCodeAlphabet, code[0])*plusCodeEncodingBase >= plusCodeLatitudeMax*2 {
+		return false
+	}
+	if len(code) > 1 && strings.IndexByte(plusCodeAlphabet, code[1])*plusCodeEncodingBase >= plusCodeLongitudeMax*2 {
+		return false
+	}
+	return true
+}
+
+// DecodePlusCode returns the area covered by a full plus code
+func DecodePlusCode(code string) (CodeArea, error) {
+	if !IsValidPlusCode(code) {
+		return CodeArea{}, ErrInvalidPlusCode
+	}
+	if !IsFullPlusCode(code) {
+		return CodeArea{}, ErrShortPlusCode
+	}
+	code = strings.ToUpper(code)
+	code = strings.Replace(code, string(plusCodeSeparator), "", -1)
+	code = strings.TrimRight(code, string(plusCodePadding))
+	if len(code) > plusCodeMaxDigitCount {
+		code = code[:plusCodeMaxDigitCount]
+	}
+
+	latitude := -float64(plusCodeLatitudeMax)
+	longitude := -float64(plusCodeLongitudeMax)
+	resolution := float64(plusCodeEncodingBase * plusCodeEncodingBase)
+	i := 0
+	for ; i < len(code) && i < plusCodePairCodeLength; i += 2 {
+		resolution /= float64(plusCodeEncodingBase)
+		latitude += float64(strings.IndexByte(plusCodeAlphabet, code[i])) * resolution
+		longitude += float64(strings.IndexByte(plusCodeAlphabet, code[i+1])) * resolution
+	}
+	latResolution, lngResolution := resolution, resolution
+	for ; i < len(code); i++ {
+		latResolution /= plusCodeGridRows
+		lngResolution /= plusCodeGridColumns
+		digit := strings.IndexByte(plusCodeAlphabet, code[i])
+		latitude += float64(digit/plusCodeGridColumns) * latResolution
+		longitude += float64(digit%plusCodeGridColumns) * lngResolution
+	}
+	return CodeArea{
+		LatitudeLo:  latitude,
+		LongitudeLo: longitude,
+		LatitudeHi:  latitude + latResolution,
+		LongitudeHi: longitude + lngResolution,
+		CodeLength:  len(code),
+	}, nil
+}
+
+// EncodePlusCode returns the full plus code of the given length for a location
+func EncodePlusCode(latitude, longitude float64, codeLength int) (string, error) {
+	if codeLength < 2 || (codeLength < plusCodePairCodeLength && codeLength%2 == 1) {
+		return "", ErrInvalidPlusCodeLength
+	}
+	if codeLength > plusCodeMaxDigitCount {
+		codeLength = plusCodeMaxDigitCount
+	}
+	latitude = clipLatitude(latitude)
+	longitude = normalizeLongitude(longitude)
+	if latitude == plusCodeLatitudeMax {
+		// Move the point into the code area below the pole
+		latitude -= latitudePrecision(codeLength)
+	}
+
+	latVal := int64(math.Round(latitude*plusCodeFinalLatPrecision)) + plusCodeLatitudeMax*plusCodeFinalLatPrecision
+	lngVal := int64(math.Round(longitude*plusCodeFinalLngPrecision)) + plusCodeLongitudeMax*plusCodeFinalLngPrecision
+	if latVal >= 2*plusCodeLatitudeMax*plusCodeFinalLatPrecision {
+		latVal = 2*plusCodeLatitudeMax*plusCodeFinalLatPrecision - 1
+	}
+	if lngVal >= 2*plusCodeLongitudeMax*plusCodeFinalLngPrecision {
+		lngVal -= 2 * plusCodeLongitudeMax * plusCodeFinalLngPrecision
+	}
+
+	digits := make([]byte, plusCodeMaxDigitCount)
+	for i := plusCodeMaxDigitCount - 1; i >= plusCodePairCodeLength; i-- {
+		latDigit := latVal % plusCodeGridRows
+		lngDigit := lngVal % plusCodeGridColumns
+		digits[i] = plusCodeAlphabet[latDigit*plusCodeGridColumns+lngDigit]
+		latVal /= plusCodeGridRows
+		lngVal /= plusCodeGridColumns
+	}
+	for i := plusCodePairCodeLength - 2; i >= 0; i -= 2 {
+		digits[i] = plusCodeAlphabet[latVal%int64(plusCodeEncodingBase)]
+		digits[i+1] = plusCodeAlphabet[lngVal%int64(plusCodeEncodingBase)]
+		latVal /= int64(plusCodeEncodingBase)
+		lngVal /= int64(plusCodeEncodingBase)
+	}
+
+	code := string(digits[:codeLength])
+	if codeLength < plusCodeSeparatorPosition {
+		code += strings.Repeat(string(plusCodePadding), plusCodeSeparatorPosition-codeLength)
+	}
+	return code[:plusCodeSeparatorPosition] + string(plusCodeSeparator) + code[plusCodeSeparatorPosition:], nil
+}
+
+// ShortenPlusCode removes as many leading digits from a full plus code as can
+// be recovered from a reference location, which should be within a few
+// kilometers of the code
+func ShortenPlusCode(code string, latitude, longitude float64) (string, error) {
+	area, err := DecodePlusCode(code)
+	if err != nil {
+		return "", err
+	}
+	if strings.IndexByte(code, plusCodePadding) >= 0 || area.CodeLength < plusCodeMinTrimmableLength {
+		return "", errors.New("plus code cannot be shortened")
+	}
+	code = strings.ToUpper(code)
+	centerLat, centerLng := area.Center()
+	distance := math.Max(math.Abs(centerLat-clipLatitude(latitude)), math.Abs(centerLng-normalizeLongitude(longitude)))
+	for i := plusCodePairCodeLength/2 - 2; i >= 1; i-- {
+		// Leave a margin so the code can be recovered from a nearby location
+		if distance < pairResolution(i)*0.3 {
+			return code[(i+1)*2:], nil
+		}
+	}
+	return code, nil
+}
+
+// RecoverPlusCode returns the full plus code nearest to the reference
+// location which matches a short plus code. Full codes are returned as is.
+func RecoverPlusCode(code string, latitude, longitude float64) (string, error) {
+	if !IsShortPlusCode(code) {
+		if IsFullPlusCode(code) {
+			return strings.ToUpper(code), nil
+		}
+		return "", ErrInvalidPlusCode
+	}
+	latitude = clipLatitude(latitude)
+	longitude = normalizeLongitude(longitude)
+	code = strings.ToUpper(code)
+
+	paddingLength := plusCodeSeparatorPosition - strings.IndexRune(code, plusCodeSeparator)
+	resolution := math.Pow(float64(plusCodeEncodingBase), float64(2-paddingLength/2))
+	halfResolution := resolution / 2
+	prefix, err := EncodePlusCode(latitude, longitude, plusCodePairCodeLength)
+	if err != nil {
+		return "", err
+	}
+	area, err := DecodePlusCode(prefix[:paddingLength] + code)
+	if err != nil {
+		return "", err
+	}
+
+	// The reference location may be closer to a neighbouring area than to
+	// the one sharing its prefix
+	centerLat, centerLng := area.Center()
+	if latitude+halfResolution < centerLat && centerLat-resolution >= -plusCodeLatitudeMax {
+		centerLat -= resolution
+	} else if latitude-halfResolution > centerLat && centerLat+resolution <= plusCodeLatitudeMax {
+		centerLat += resolution
+	}
+	if longitude+halfResolution < centerLng {
+		centerLng -= resolution
+	} else if longitude-halfResolution > centerLng {
+		centerLng += resolution
+	}
+	return EncodePlusCode(centerLat, centerLng, area.CodeLength)
+}
+
+func clipLatitude(latitude float64) float64 {
+	return math.Min(plusCodeLatitudeMax, math.Max(-plusCodeLatitudeMax, latitude))
+}
+
+func normalizeLongitude(longitude float64) float64 {
+	for longitude < -plusCodeLongitudeMax {
+		longitude += 2 * plusCodeLongitudeMax
+	}
+	for longitude >= plusCodeLongitudeMax {
+		longitude -= 2 * plusCodeLongitudeMax
+	}
+	return longitude
+}
+
+// pairResolution returns the size in degrees of the area covered by the
+// given pair of digits, starting at zero
+func pairResolution(pair int) float64 {
+	return math.Pow(float64(plusCodeEncodingBase), float64(1-pair))
+}
+
+// latitudePrecision returns the height in degrees of a code area of the given length
+func latitudePrecision(codeLength int) float64 {
+	if codeLength <= plusCodePairCodeLength {
+		return math.Pow(float64(plusCodeEncodingBase), float64(2-codeLength/2))
+	}
+	return math.Pow(float64(plusCodeEncodingBase), -3) / math.Pow(plusCodeGridRows, float64(codeLength-plusCodePairCodeLength))
+}
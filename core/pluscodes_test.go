@@ -0,0 +1,169 @@
+package core_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/kimitzu/kimitzu-go/core"
+	"github.com/kimitzu/kimitzu-go/pb"
+)
+
+func TestDecodePlusCode(t *testing.T) {
+	tests := []struct {
+		code        string
+		latitude    float64
+		longitude   float64
+		codeLength  int
+		expectedErr error
+	}{
+		{"8FVC9G8F+6X", 47.3655625, 8.5249375, 10, nil},
+		{"7FG49Q00+", 20.375, 2.775, 6, nil},
+		{"8fvc9g8f+6x", 47.3655625, 8.5249375, 10, nil},
+		{"8FVC9G8F+6XQ", 47.3655875, 8.524984375, 11, nil},
+		{"9G8F+6X", 0, 0, 0, core.ErrShortPlusCode},
+		{"8FVC9G8F6X", 0, 0, 0, core.ErrInvalidPlusCode},
+		{"8FVC9G8F+6", 0, 0, 0, core.ErrInvalidPlusCode},
+		{"8FVC00+", 0, 0, 0, core.ErrInvalidPlusCode},
+		{"WC2345G6+", 0, 0, 0, core.ErrShortPlusCode},
+	}
+	for _, tc := range tests {
+		area, err := core.DecodePlusCode(tc.code)
+		if err != tc.expectedErr {
+			t.Errorf("expected error %v decoding %s, got %v", tc.expectedErr, tc.code, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		latitude, longitude := area.Center()
+		if math.Abs(latitude-tc.latitude) > 1e-6 || math.Abs(longitude-tc.longitude) > 1e-6 {
+			t.Errorf("decoded %s to %f,%f, expected %f,%f", tc.code, latitude, longitude, tc.latitude, tc.longitude)
+		}
+		if area.CodeLength != tc.codeLength {
+			t.Errorf("expected code length %d for %s, got %d", tc.codeLength, tc.code, area.CodeLength)
+		}
+	}
+}
+
+func TestEncodePlusCode(t *testing.T) {
+	tests := []struct {
+		latitude   float64
+		longitude  float64
+		codeLength int
+		code       string
+	}{
+		{47.3655625, 8.5249375, 10, "8FVC9G8F+6X"},
+		{20.375, 2.775, 6, "7FG49Q00+"},
+		{47.3655875, 8.524984375, 11, "8FVC9G8F+6XQ"},
+		{14.33375, 121.36875, 8, "7Q6389M9+"},
+		{90, 1, 4, "CFX30000+"},
+		{1, 180, 4, "62H20000+"},
+		{-41.2730625, 174.7859375, 10, "4VCPPQGP+Q9"},
+	}
+	for _, tc := range tests {
+		code, err := core.EncodePlusCode(tc.latitude, tc.longitude, tc.codeLength)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if code != tc.code {
+			t.Errorf("encoded %f,%f to %s, expected %s", tc.latitude, tc.longitude, code, tc.code)
+		}
+	}
+	if _, err := core.EncodePlusCode(1, 1, 7); err != core.ErrInvalidPlusCodeLength {
+		t.Errorf("expected an invalid length error, got %v", err)
+	}
+}
+
+func TestShortenAndRecoverPlusCode(t *testing.T) {
+	tests := []struct {
+		code      string
+		latitude  float64
+		longitude float64
+		short     string
+	}{
+		{"9C3W9QCJ+2VX", 51.3701125, -1.217765625, "+2VX"},
+		{"9C3W9QCJ+2VX", 51.3708675, -1.217765625, "CJ+2VX"},
+		{"9C3W9QCJ+2VX", 51.37, -1.2, "9QCJ+2VX"},
+		{"9C3W9QCJ+2VX", 51.5, -1.2, "9QCJ+2VX"},
+		{"9C3W9QCJ+2VX", 62.5, -1.2, "9C3W9QCJ+2VX"},
+	}
+	for _, tc := range tests {
+		short, err := core.ShortenPlusCode(tc.code, tc.latitude, tc.longitude)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if short != tc.short {
+			t.Errorf("shortened %s near %f,%f to %s, expected %s", tc.code, tc.latitude, tc.longitude, short, tc.short)
+		}
+		full, err := core.RecoverPlusCode(short, tc.latitude, tc.longitude)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if full != tc.code {
+			t.Errorf("recovered %s near %f,%f to %s, expected %s", short, tc.latitude, tc.longitude, full, tc.code)
+		}
+	}
+	if _, err := core.ShortenPlusCode("7FG49Q00+", 20.3, 2.7); err == nil {
+		t.Error("expected an error shortening a padded code")
+	}
+}
+
+func TestValidateAddress(t *testing.T) {
+	tests := []struct {
+		address *pb.Address
+		valid   bool
+	}{
+		{nil, true},
+		{&pb.Address{City: "Manila"}, true},
+		{&pb.Address{Latitude: "47.3655625", Longitude: "8.5249375"}, true},
+		{&pb.Address{PlusCode: "8FVC9G8F+6X"}, true},
+		{&pb.Address{Latitude: "47.3655625", Longitude: "8.5249375", PlusCode: "8FVC9G8F+6X"}, true},
+		{&pb.Address{Latitude: "47.3655625", Longitude: "8.5249375", PlusCode: "9G8F+6X"}, true},
+		{&pb.Address{Latitude: "47.3655625", Longitude: "8.5249375", PlusCode: "8FVC9G00+"}, true},
+		{&pb.Address{Latitude: "47.3655625"}, false},
+		{&pb.Address{Latitude: "north", Longitude: "8.5249375"}, false},
+		{&pb.Address{Latitude: "91", Longitude: "8.5249375"}, false},
+		{&pb.Address{PlusCode: "9G8F+6X"}, false},
+		{&pb.Address{PlusCode: "8FVC9G8F+6"}, false},
+		{&pb.Address{Latitude: "14.5995", Longitude: "120.9842", PlusCode: "8FVC9G8F+6X"}, false},
+		{&pb.Address{Latitude: "47.3655625", Longitude: "8.5262", PlusCode: "8FVC9G8F+6X"}, false},
+	}
+	for i, tc := range tests {
+		err := core.ValidateAddress(tc.address)
+		if tc.valid && err != nil {
+			t.Errorf("expected address %d to be valid, got %s", i, err)
+		}
+		if !tc.valid && err == nil {
+			t.Errorf("expected address %d to be invalid", i)
+		}
+	}
+}
+
+func TestFillAddressCoordinates(t *testing.T) {
+	address := &pb.Address{Latitude: "47.3655625", Longitude: "8.5249375"}
+	if err := core.FillAddressCoordinates(address); err != nil {
+		t.Fatal(err)
+	}
+	if address.PlusCode != "8FVC9G8F+6X" {
+		t.Errorf("expected plus code 8FVC9G8F+6X, got %s", address.PlusCode)
+	}
+
+	address = &pb.Address{PlusCode: "7FG49Q00+"}
+	if err := core.FillAddressCoordinates(address); err != nil {
+		t.Fatal(err)
+	}
+	if address.Latitude != "20.375" || address.Longitude != "2.775" {
+		t.Errorf("expected coordinates 20.375,2.775, got %s,%s", address.Latitude, address.Longitude)
+	}
+	if err := core.ValidateAddress(address); err != nil {
+		t.Error(err)
+	}
+
+	address = &pb.Address{PlusCode: "9G8F+6X"}
+	if err := core.FillAddressCoordinates(address); err == nil {
+		t.Error("expected an error filling coordinates from a short plus code")
+	}
+}
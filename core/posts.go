@@ -16,6 +16,7 @@ import (
 	"github.com/OpenBazaar/jsonpb"
 	"github.com/kimitzu/kimitzu-go/ipfs"
 	"github.com/kimitzu/kimitzu-go/pb"
+	"github.com/kimitzu/kimitzu-go/repo"
 	"github.com/golang/protobuf/proto"
 )
 
@@ -215,6 +216,9 @@ func (n *OpenBazaarNode) UpdatePostIndex(post *pb.SignedPost) error {
 	if err != nil {
 		return err
 	}
+	if err := n.IndexPostSearch(post.Post); err != nil {
+		return err
+	}
 	return n.updatePostOnDisk(index, ld)
 }
 
@@ -452,6 +456,11 @@ func (n *OpenBazaarNode) DeletePost(slug string) error {
 		return werr
 	}
 
+	err = n.Datastore.Search().Delete(repo.SearchDocumentTypePost, slug)
+	if err != nil {
+		return err
+	}
+
 	return n.updateProfileCounts()
 }
 
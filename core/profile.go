@@ -53,6 +53,11 @@ func (n *OpenBazaarNode) FetchProfile(peerID string, useCache bool) (pb.Profile,
 	if err != nil {
 		return pro, err
 	}
+	if pro.PeerID == peerID {
+		if err := n.IndexProfileLocation(&pro); err != nil {
+			log.Errorf("indexing location of profile %s: %s", peerID, err)
+		}
+	}
 	return pro, nil
 }
 
@@ -66,6 +71,13 @@ func (n *OpenBazaarNode) UpdateProfile(profile *pb.Profile) error {
 	if err := ValidateProfile(profile); err != nil {
 		return err
 	}
+	if profile.ExtLocation != nil {
+		for _, address := range profile.ExtLocation.Addresses {
+			if err := ValidateAddress(address); err != nil {
+				return fmt.Errorf("invalid address: %s", err)
+			}
+		}
+	}
 
 	profile.BitcoinPubkey = hex.EncodeToString(mPubkey.SerializeCompressed())
 	m := jsonpb.Marshaler{
@@ -112,7 +124,7 @@ func (n *OpenBazaarNode) UpdateProfile(profile *pb.Profile) error {
 	if _, err := f.WriteString(out); err != nil {
 		return err
 	}
-	return nil
+	return n.IndexProfileLocation(profile)
 }
 
 // PatchProfile - patch user profile
@@ -207,6 +219,14 @@ func (n *OpenBazaarNode) appendCountsToProfile(profile *pb.Profile) (*pb.Profile
 		profile.Stats.AverageRating = averageRating
 		changed = true
 	}
+	ratingCriteria, err := n.GetRatingCriteria()
+	if err == nil && !ratingCriteriaEqual(ratingCriteria, profile.Stats.RatingCriteria) {
+		profile.Stats.RatingCriteria = ratingCriteria
+		changed = true
+	}
+	if profile.ModeratorInfo != nil && n.setModeratorStats(profile.ModeratorInfo) {
+		changed = true
+	}
 	return profile, changed
 }
 
@@ -257,6 +277,7 @@ func (n *OpenBazaarNode) updateProfileRatings(newRating *pb.Rating) error {
 		total += float32(newRating.RatingData.Overall)
 		profile.Stats.RatingCount++ // += 1
 		profile.Stats.AverageRating = total / float32(profile.Stats.RatingCount)
+		profile.Stats.RatingCriteria = AddRatingToCriteria(profile.Stats.RatingCriteria, newRating.RatingData)
 	}
 	newPro, _ := n.appendCountsToProfile(profile)
 
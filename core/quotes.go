@@ -0,0 +1,493 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	libp2p "gx/ipfs/QmTW4SdgBWq9GjsBsHeUx8WuGxzhgzAf88UMH2w62PC8yK/go-libp2p-crypto"
+	peer "gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/kimitzu/kimitzu-go/pb"
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+const (
+	// MaxQuoteRevisions - max number of quotes a vendor can send for one request
+	MaxQuoteRevisions = 20
+)
+
+var (
+	// ErrNotQuotableListing - quotes can only be requested for fixed price services
+	ErrNotQuotableListing = errors.New("quotes are only available for fixed price service listings")
+	// ErrQuoteNotAnswered - the vendor has not sent a quote for the request yet
+	ErrQuoteNotAnswered = errors.New("the vendor has not answered the quote request yet")
+	// ErrQuoteExpired - the quote can no longer be ordered
+	ErrQuoteExpired = errors.New("quote has expired")
+	// ErrQuoteOrdered - an order was already placed against the quote
+	ErrQuoteOrdered = errors.New("quote has already been ordered")
+)
+
+// QuoteRequestData - data required to ask a vendor for a quote
+type QuoteRequestData struct {
+	VendorID string   `json:"vendorId"`
+	Slug     string   `json:"slug"`
+	Quantity uint64   `json:"quantity"`
+	Options  []option `json:"options"`
+	Scope    string   `json:"scope"`
+}
+
+// QuoteData - the vendor's answer to a quote request. Price is for the whole
+// quantity in the pricing currency of the listing and excludes taxes.
+type QuoteData struct {
+	RequestID string    `json:"requestId"`
+	Price     uint64    `json:"price"`
+	Scope     string    `json:"scope"`
+	Expiry    time.Time `json:"expiry"`
+}
+
+// QuoteAcceptanceData - data required to order a quote
+type QuoteAcceptanceData struct {
+	RequestID            string  `json:"requestId"`
+	Moderator            string  `json:"moderator"`
+	PaymentCoin          string  `json:"paymentCoin"`
+	AlternateContactInfo string  `json:"alternateContactInfo"`
+	RefundAddress        *string `json:"refundAddress"`
+}
+
+// QuoteRequestID returns the ID of the quote request, which is the CID of the serialized request
+func QuoteRequestID(request *pb.QuoteRequest) (string, error) {
+	ser, err := proto.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+	id, err := EncodeCID(ser)
+	if err != nil {
+		return "", err
+	}
+	return id.String(), nil
+}
+
+func validateQuotableListing(listing *pb.Listing) error {
+	if listing.Metadata.ContractType != pb.Listing_Metadata_SERVICE || listing.Metadata.Format != pb.Listing_Metadata_FIXED_PRICE {
+		return ErrNotQuotableListing
+	}
+	if listing.Metadata.ServiceRateMethod == pb.Listing_Metadata_PER_MONTH {
+		return errors.New("subscription listings cannot be quoted")
+	}
+	return nil
+}
+
+// RequestQuote - sign a quote request for one of the vendor's listings and send it to them
+func (n *OpenBazaarNode) RequestQuote(data *QuoteRequestData) (string, error) {
+	if _, err := peer.IDB58Decode(data.VendorID); err != nil {
+		return "", fmt.Errorf("invalid vendor ID: %s", err)
+	}
+	if data.VendorID == n.IpfsNode.Identity.Pretty() {
+		return "", errors.New("cannot request a quote from ourselves")
+	}
+	if data.Slug == "" {
+		return "", errors.New("listing slug must not be empty")
+	}
+	if len(data.Scope) > DescriptionMaxCharacters {
+		return "", fmt.Errorf("scope is longer than the max of %d characters", DescriptionMaxCharacters)
+	}
+	if data.Quantity == 0 {
+		data.Quantity = 1
+	}
+
+	buyerID, err := getContractIdentity(n)
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	ts, err := ptypes.TimestampProto(now)
+	if err != nil {
+		return "", err
+	}
+	request := &pb.QuoteRequest{
+		BuyerID:     buyerID,
+		VendorID:    data.VendorID,
+		ListingSlug: data.Slug,
+		Quantity:    data.Quantity,
+		Scope:       data.Scope,
+		Timestamp:   ts,
+	}
+	for _, o := range data.Options {
+		request.Options = append(request.Options, &pb.Order_Item_Option{Name: o.Name, Value: o.Value})
+	}
+
+	ser, err := proto.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+	signature, err := n.IpfsNode.PrivateKey.Sign(ser)
+	if err != nil {
+		return "", err
+	}
+	requestID, err := QuoteRequestID(request)
+	if err != nil {
+		return "", err
+	}
+	signed := &pb.SignedQuoteRequest{Request: request, Signature: signature}
+	record := &repo.QuoteRecord{
+		RequestID: requestID,
+		History:   &pb.QuoteHistory{Request: signed},
+		PeerID:    data.VendorID,
+		Timestamp: now,
+	}
+	if err := n.Datastore.Quotes().Put(record); err != nil {
+		return "", err
+	}
+	if err := n.SendQuoteRequest(data.VendorID, signed); err != nil {
+		return "", err
+	}
+	return requestID, nil
+}
+
+// ValidateQuoteRequest checks a quote request we received was signed by the
+// peer which sent it and is for one of our quotable listings
+func (n *OpenBazaarNode) ValidateQuoteRequest(sender string, signed *pb.SignedQuoteRequest) error {
+	request := signed.Request
+	if request == nil || request.BuyerID == nil || request.BuyerID.Pubkeys == nil {
+		return errors.New("quote request is missing the buyer ID")
+	}
+	if request.BuyerID.PeerID != sender {
+		return errors.New("quote request was not sent by the buyer")
+	}
+	if request.VendorID != n.IpfsNode.Identity.Pretty() {
+		return errors.New("quote request is for a different vendor")
+	}
+	if err := verifySignature(request, request.BuyerID.Pubkeys.Identity, signed.Signature, request.BuyerID.PeerID); err != nil {
+		return errors.New("buyer's signature on the quote request failed to verify")
+	}
+	if request.Quantity == 0 {
+		return errors.New("quote request quantity must be greater than zero")
+	}
+	if len(request.Scope) > DescriptionMaxCharacters {
+		return fmt.Errorf("quote request scope is longer than the max of %d characters", DescriptionMaxCharacters)
+	}
+	sl, err := n.GetListingFromSlug(request.ListingSlug)
+	if err != nil {
+		return fmt.Errorf("listing not found: %s", request.ListingSlug)
+	}
+	return validateQuotableListing(sl.Listing)
+}
+
+// SendQuote - answer a quote request we received with a signed quote. Sending
+// another quote for the same request supersedes the previous one.
+func (n *OpenBazaarNode) SendQuote(data *QuoteData) (*pb.Quote, error) {
+	record, err := n.Datastore.Quotes().Get(data.RequestID)
+	if err != nil {
+		return nil, err
+	}
+	if !record.IsSale {
+		return nil, errors.New("only the vendor can answer a quote request")
+	}
+	if record.OrderID != "" {
+		return nil, ErrQuoteOrdered
+	}
+	if len(record.History.Quotes) >= MaxQuoteRevisions {
+		return nil, fmt.Errorf("number of quotes is greater than the max of %d", MaxQuoteRevisions)
+	}
+	if data.Price == 0 {
+		return nil, errors.New("quote price must be greater than zero")
+	}
+	if len(data.Scope) > DescriptionMaxCharacters {
+		return nil, fmt.Errorf("quote scope is longer than the max of %d characters", DescriptionMaxCharacters)
+	}
+	now := time.Now()
+	if !data.Expiry.After(now) {
+		return nil, errors.New("quote expiry must be in the future")
+	}
+
+	request := record.Request()
+	sl, err := n.GetListingFromSlug(request.ListingSlug)
+	if err != nil {
+		return nil, fmt.Errorf("listing not found: %s", request.ListingSlug)
+	}
+	if err := validateQuotableListing(sl.Listing); err != nil {
+		return nil, err
+	}
+	index, err := n.getListingIndex()
+	if err != nil {
+		return nil, err
+	}
+	var listingHash string
+	for _, l := range index {
+		if l.Slug == request.ListingSlug {
+			listingHash = l.Hash
+			break
+		}
+	}
+	if listingHash == "" {
+		return nil, fmt.Errorf("listing not found: %s", request.ListingSlug)
+	}
+
+	vendorID, err := getContractIdentity(n)
+	if err != nil {
+		return nil, err
+	}
+	ts, err := ptypes.TimestampProto(now)
+	if err != nil {
+		return nil, err
+	}
+	expiry, err := ptypes.TimestampProto(data.Expiry)
+	if err != nil {
+		return nil, err
+	}
+	quote := &pb.Quote{
+		RequestID:       data.RequestID,
+		Revision:        uint32(len(record.History.Quotes)),
+		VendorID:        vendorID,
+		ListingHash:     listingHash,
+		Price:           data.Price,
+		PricingCurrency: sl.Listing.Metadata.PricingCurrency,
+		Scope:           data.Scope,
+		Expiry:          expiry,
+		Timestamp:       ts,
+	}
+	ser, err := proto.Marshal(quote)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := n.IpfsNode.PrivateKey.Sign(ser)
+	if err != nil {
+		return nil, err
+	}
+	signed := &pb.SignedQuote{Quote: quote, Signature: signature}
+	record.History.Quotes = append(record.History.Quotes, signed)
+	record.Timestamp = now
+	if err := n.Datastore.Quotes().Put(record); err != nil {
+		return nil, err
+	}
+
+	k, err := libp2p.UnmarshalPublicKey(request.BuyerID.Pubkeys.Identity)
+	if err != nil {
+		return nil, err
+	}
+	if err := n.SendQuoteMessage(record.PeerID, &k, signed); err != nil {
+		return nil, err
+	}
+	return quote, nil
+}
+
+// ValidateQuote checks a quote we received answers one of our requests, was
+// signed by the vendor which sent it and follows the previous revision
+func ValidateQuote(record *repo.QuoteRecord, sender string, signed *pb.SignedQuote) error {
+	quote := signed.Quote
+	if quote == nil || quote.VendorID == nil || quote.VendorID.Pubkeys == nil {
+		return errors.New("quote is missing the vendor ID")
+	}
+	if record.IsSale {
+		return errors.New("quote is for a request we received")
+	}
+	if sender != record.PeerID || quote.VendorID.PeerID != record.PeerID {
+		return errors.New("quote was not sent by the vendor")
+	}
+	if int(quote.Revision) != len(record.History.Quotes) {
+		return errors.New("quote revision is out of order")
+	}
+	if err := verifySignature(quote, quote.VendorID.Pubkeys.Identity, signed.Signature, quote.VendorID.PeerID); err != nil {
+		return errors.New("vendor's signature on the quote failed to verify")
+	}
+	if quote.Price == 0 {
+		return errors.New("quote price must be greater than zero")
+	}
+	if _, err := ptypes.Timestamp(quote.Expiry); err != nil {
+		return errors.New("quote is missing an expiry")
+	}
+	return nil
+}
+
+// AcceptQuote - place an order for the latest quote the vendor sent for one of our requests
+func (n *OpenBazaarNode) AcceptQuote(data *QuoteAcceptanceData) (orderID, paymentAddress string, paymentAmount uint64, vendorOnline bool, err error) {
+	record, err := n.Datastore.Quotes().Get(data.RequestID)
+	if err != nil {
+		return "", "", 0, false, err
+	}
+	if record.IsSale {
+		return "", "", 0, false, errors.New("only the buyer can accept a quote")
+	}
+	switch record.State(time.Now()) {
+	case repo.QuoteStateRequested:
+		return "", "", 0, false, ErrQuoteNotAnswered
+	case repo.QuoteStateExpired:
+		return "", "", 0, false, ErrQuoteExpired
+	case repo.QuoteStateOrdered:
+		return "", "", 0, false, ErrQuoteOrdered
+	}
+
+	request, quote := record.Request(), record.LatestQuote()
+	purchase := &PurchaseData{
+		Moderator:            data.Moderator,
+		PaymentCoin:          data.PaymentCoin,
+		AlternateContactInfo: data.AlternateContactInfo,
+		RefundAddress:        data.RefundAddress,
+		quoteHistory:         record.History,
+	}
+	orderItem := item{ListingHash: quote.ListingHash, Quantity: request.Quantity}
+	for _, o := range request.Options {
+		orderItem.Options = append(orderItem.Options, option{Name: o.Name, Value: o.Value})
+	}
+	purchase.Items = []item{orderItem}
+
+	orderID, paymentAddress, paymentAmount, vendorOnline, err = n.Purchase(purchase)
+	if err != nil {
+		return "", "", 0, false, err
+	}
+	record.OrderID = orderID
+	record.Timestamp = time.Now()
+	if err := n.Datastore.Quotes().Put(record); err != nil {
+		return "", "", 0, false, err
+	}
+	return orderID, paymentAddress, paymentAmount, vendorOnline, nil
+}
+
+// acceptedQuote returns the quote an order was placed against, or nil if the
+// order is priced from the listing
+func acceptedQuote(contract *pb.RicardianContract) *pb.Quote {
+	qh := contract.BuyerOrder.QuoteHistory
+	if qh == nil || len(qh.Quotes) == 0 {
+		return nil
+	}
+	return qh.Quotes[len(qh.Quotes)-1].Quote
+}
+
+// validateOrderQuote checks the quote history of a quoted order. As the
+// vendor we also know whether the quote was revised or ordered since.
+func (n *OpenBazaarNode) validateOrderQuote(contract *pb.RicardianContract) error {
+	qh := contract.BuyerOrder.QuoteHistory
+	if qh == nil {
+		return nil
+	}
+	if err := validateQuoteHistory(contract); err != nil {
+		return err
+	}
+	requestID, err := QuoteRequestID(qh.Request.Request)
+	if err != nil {
+		return err
+	}
+	record, err := n.Datastore.Quotes().Get(requestID)
+	if err != nil || !record.IsSale {
+		return nil
+	}
+	if len(record.History.Quotes) > len(qh.Quotes) {
+		return errors.New("quote has been superseded by a later revision")
+	}
+	if record.OrderID != "" {
+		orderID, err := n.CalcOrderID(contract.BuyerOrder)
+		if err != nil {
+			return err
+		}
+		if orderID != record.OrderID {
+			return ErrQuoteOrdered
+		}
+	}
+	return nil
+}
+
+// validateQuoteHistory checks a quoted order carries the buyer's signed
+// request and every quote the vendor signed for it, and that the order
+// matches the latest quote before it expired
+func validateQuoteHistory(contract *pb.RicardianContract) error {
+	qh := contract.BuyerOrder.QuoteHistory
+	if qh.Request == nil || qh.Request.Request == nil || len(qh.Quotes) == 0 {
+		return errors.New("quote history is incomplete")
+	}
+	if len(contract.BuyerOrder.Items) != 1 || len(contract.VendorListings) != 1 {
+		return errors.New("quoted orders must contain a single item")
+	}
+	if contract.BuyerOrder.SubscriptionPeriod != nil {
+		return errors.New("subscription orders cannot be quoted")
+	}
+	listing := contract.VendorListings[0]
+	if err := validateQuotableListing(listing); err != nil {
+		return err
+	}
+
+	request := qh.Request.Request
+	buyerID := contract.BuyerOrder.BuyerID
+	if request.ListingSlug != listing.Slug || request.VendorID != listing.VendorID.PeerID {
+		return errors.New("quote request does not match the listing in the order")
+	}
+	if request.BuyerID == nil || request.BuyerID.PeerID != buyerID.PeerID {
+		return errors.New("quote request belongs to a different buyer")
+	}
+	if err := verifySignature(request, buyerID.Pubkeys.Identity, qh.Request.Signature, buyerID.PeerID); err != nil {
+		return errors.New("buyer's signature on the quote request failed to verify")
+	}
+	requestID, err := QuoteRequestID(request)
+	if err != nil {
+		return err
+	}
+	for i, sq := range qh.Quotes {
+		if sq.Quote == nil || sq.Quote.RequestID != requestID || int(sq.Quote.Revision) != i {
+			return errors.New("quote history is out of order")
+		}
+		if err := verifySignature(sq.Quote, listing.VendorID.Pubkeys.Identity, sq.Signature, listing.VendorID.PeerID); err != nil {
+			return fmt.Errorf("vendor's signature on quote revision %d failed to verify", i)
+		}
+	}
+
+	quote := acceptedQuote(contract)
+	if quote.Price == 0 {
+		return errors.New("quote price must be greater than zero")
+	}
+	if NormalizeCurrencyCode(quote.PricingCurrency) != NormalizeCurrencyCode(listing.Metadata.PricingCurrency) {
+		return errors.New("quote is not priced in the pricing currency of the listing")
+	}
+	orderItem := contract.BuyerOrder.Items[0]
+	if GetOrderQuantity(listing, orderItem) != request.Quantity {
+		return errors.New("order quantity does not match the quote request")
+	}
+	if len(orderItem.Options) != len(request.Options) {
+		return errors.New("order options do not match the quote request")
+	}
+	for i, o := range request.Options {
+		if !proto.Equal(o, orderItem.Options[i]) {
+			return errors.New("order options do not match the quote request")
+		}
+	}
+	if len(orderItem.CouponCodes) > 0 {
+		return errors.New("coupons cannot be applied to a quoted order")
+	}
+	ordered, err := ptypes.Timestamp(contract.BuyerOrder.Timestamp)
+	if err != nil {
+		return err
+	}
+	expiry, err := ptypes.Timestamp(quote.Expiry)
+	if err != nil {
+		return err
+	}
+	if !ordered.Before(expiry) {
+		return ErrQuoteExpired
+	}
+	return nil
+}
+
+// RecordQuoteSale - mark the quote of a validated order we received as the vendor as ordered
+func (n *OpenBazaarNode) RecordQuoteSale(contract *pb.RicardianContract, orderID string) error {
+	qh := contract.BuyerOrder.QuoteHistory
+	if qh == nil || qh.Request == nil {
+		return nil
+	}
+	requestID, err := QuoteRequestID(qh.Request.Request)
+	if err != nil {
+		return err
+	}
+	record, err := n.Datastore.Quotes().Get(requestID)
+	if err != nil {
+		record = &repo.QuoteRecord{
+			RequestID: requestID,
+			IsSale:    true,
+			PeerID:    contract.BuyerOrder.BuyerID.PeerID,
+		}
+	}
+	record.History = qh
+	record.OrderID = orderID
+	record.Timestamp = time.Now()
+	return n.Datastore.Quotes().Put(record)
+}
@@ -0,0 +1,155 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	crypto "gx/ipfs/QmTW4SdgBWq9GjsBsHeUx8WuGxzhgzAf88UMH2w62PC8yK/go-libp2p-crypto"
+	peer "gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/kimitzu/kimitzu-go/pb"
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+func newQuoteTestID(t *testing.T) (crypto.PrivKey, *pb.ID) {
+	key, _, err := crypto.GenerateKeyPair(crypto.Ed25519, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubkey, err := crypto.MarshalPublicKey(key.GetPublic())
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := peer.IDFromPublicKey(key.GetPublic())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key, &pb.ID{PeerID: id.Pretty(), Pubkeys: &pb.ID_Pubkeys{Identity: pubkey}}
+}
+
+func signQuoteTestMessage(t *testing.T, key crypto.PrivKey, msg proto.Message) []byte {
+	ser, err := proto.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := key.Sign(ser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sig
+}
+
+// newQuotedTestContract returns an order for a service listing placed against
+// a single quote of 5000 for the whole quantity
+func newQuotedTestContract(t *testing.T) (*pb.RicardianContract, crypto.PrivKey) {
+	buyerKey, buyerID := newQuoteTestID(t)
+	vendorKey, vendorID := newQuoteTestID(t)
+
+	contract := newLedgerTestContract(t, "USD")
+	listing := contract.VendorListings[0]
+	listing.Slug = "consulting"
+	listing.VendorID = vendorID
+	listing.Metadata.ContractType = pb.Listing_Metadata_SERVICE
+	listing.Metadata.ServiceRateMethod = pb.Listing_Metadata_PER_HOUR
+	listing.ShippingOptions = nil
+	listing.Taxes = []*pb.Listing_Tax{{
+		TaxType:    "Sales tax",
+		TaxRegions: []pb.CountryCode{pb.CountryCode_UNITED_STATES},
+		Percentage: 10,
+	}}
+	rehashListing(t, contract)
+	order := contract.BuyerOrder
+	order.BuyerID = buyerID
+	order.Items[0].ShippingOption = nil
+	order.Timestamp = ptypes.TimestampNow()
+
+	request := &pb.QuoteRequest{
+		BuyerID:     buyerID,
+		VendorID:    vendorID.PeerID,
+		ListingSlug: "consulting",
+		Quantity:    2,
+		Timestamp:   ptypes.TimestampNow(),
+	}
+	requestID, err := QuoteRequestID(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expiry, _ := ptypes.TimestampProto(time.Now().Add(time.Hour))
+	quote := &pb.Quote{
+		RequestID:       requestID,
+		VendorID:        vendorID,
+		ListingHash:     order.Items[0].ListingHash,
+		Price:           5000,
+		PricingCurrency: "USD",
+		Expiry:          expiry,
+		Timestamp:       ptypes.TimestampNow(),
+	}
+	order.QuoteHistory = &pb.QuoteHistory{
+		Request: &pb.SignedQuoteRequest{Request: request, Signature: signQuoteTestMessage(t, buyerKey, request)},
+		Quotes:  []*pb.SignedQuote{{Quote: quote, Signature: signQuoteTestMessage(t, vendorKey, quote)}},
+	}
+	return contract, vendorKey
+}
+
+func TestValidateQuoteHistory(t *testing.T) {
+	contract, _ := newQuotedTestContract(t)
+	if err := validateQuoteHistory(contract); err != nil {
+		t.Fatal(err)
+	}
+
+	contract, _ = newQuotedTestContract(t)
+	contract.BuyerOrder.Items[0].Quantity = 3
+	if err := validateQuoteHistory(contract); err == nil {
+		t.Error("expected a different quantity to fail")
+	}
+
+	contract, _ = newQuotedTestContract(t)
+	contract.BuyerOrder.QuoteHistory.Quotes[0].Quote.Price = 1
+	if err := validateQuoteHistory(contract); err == nil {
+		t.Error("expected a tampered quote to fail")
+	}
+
+	contract, vendorKey := newQuotedTestContract(t)
+	quote := contract.BuyerOrder.QuoteHistory.Quotes[0].Quote
+	quote.Expiry, _ = ptypes.TimestampProto(time.Now().Add(-time.Minute))
+	contract.BuyerOrder.QuoteHistory.Quotes[0].Signature = signQuoteTestMessage(t, vendorKey, quote)
+	if err := validateQuoteHistory(contract); err != ErrQuoteExpired {
+		t.Errorf("expected %s, got %v", ErrQuoteExpired, err)
+	}
+
+	contract, _ = newQuotedTestContract(t)
+	contract.VendorListings[0].Metadata.ContractType = pb.Listing_Metadata_PHYSICAL_GOOD
+	if err := validateQuoteHistory(contract); err != ErrNotQuotableListing {
+		t.Errorf("expected %s, got %v", ErrNotQuotableListing, err)
+	}
+}
+
+func TestCalculateOrderTotalQuoted(t *testing.T) {
+	contract, _ := newQuotedTestContract(t)
+	// The quote replaces the listing price of 2 x 1000 and the tax applies on top
+	total, currency, ok := contractFiatTotal(contract)
+	if !ok || currency != "USD" || total != 5500 {
+		t.Errorf("expected 5500 USD, got %d %s (%v)", total, currency, ok)
+	}
+}
+
+func TestValidateQuote(t *testing.T) {
+	vendorKey, vendorID := newQuoteTestID(t)
+	expiry, _ := ptypes.TimestampProto(time.Now().Add(time.Hour))
+	quote := &pb.Quote{RequestID: "request1", VendorID: vendorID, Price: 5000, Expiry: expiry}
+	signed := &pb.SignedQuote{Quote: quote, Signature: signQuoteTestMessage(t, vendorKey, quote)}
+	record := &repo.QuoteRecord{RequestID: "request1", History: &pb.QuoteHistory{}, PeerID: vendorID.PeerID}
+
+	if err := ValidateQuote(record, vendorID.PeerID, signed); err != nil {
+		t.Fatal(err)
+	}
+	if err := ValidateQuote(record, "QmOther", signed); err == nil {
+		t.Error("expected a quote relayed by another peer to fail")
+	}
+	quote.Revision = 1
+	if err := ValidateQuote(record, vendorID.PeerID, signed); err == nil {
+		t.Error("expected an out of order revision to fail")
+	}
+}
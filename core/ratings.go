@@ -5,6 +5,8 @@ import (
 	"crypto/sha256"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"math"
 
 	crypto "gx/ipfs/QmTW4SdgBWq9GjsBsHeUx8WuGxzhgzAf88UMH2w62PC8yK/go-libp2p-crypto"
 	peer "gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
@@ -119,23 +121,9 @@ func ValidateRating(rating *pb.Rating) (bool, error) {
 
 // GetRatingCounts - fetch rating count
 func (n *OpenBazaarNode) GetRatingCounts() (uint32, float32, error) {
-	indexPath := path.Join(n.RepoPath, "root", "ratings.json")
-
-	var index []SavedRating
-
-	_, ferr := os.Stat(indexPath)
-	if !os.IsNotExist(ferr) {
-		// Read existing file
-		file, err := ioutil.ReadFile(indexPath)
-		if err != nil {
-			return 0, 0, err
-		}
-		err = json.Unmarshal(file, &index)
-		if err != nil {
-			return 0, 0, err
-		}
-	} else {
-		return 0, 0, nil
+	index, err := n.readRatingIndex()
+	if err != nil || index == nil {
+		return 0, 0, err
 	}
 	var ratingCount uint32
 	var totalRating float32
@@ -146,3 +134,185 @@ func (n *OpenBazaarNode) GetRatingCounts() (uint32, float32, error) {
 	averageRating := totalRating / float32(ratingCount)
 	return ratingCount, averageRating, nil
 }
+
+// GetRatingCriteria - fetch the per criterion aggregates of all listings
+func (n *OpenBazaarNode) GetRatingCriteria() ([]*pb.Profile_RatingCriterion, error) {
+	index, err := n.readRatingIndex()
+	if err != nil {
+		return nil, err
+	}
+	var criteria [][]*pb.Profile_RatingCriterion
+	for _, i := range index {
+		criteria = append(criteria, i.Criteria)
+	}
+	return MergeRatingCriteria(criteria...), nil
+}
+
+// readRatingIndex returns the saved ratings index, or nil if there is none yet
+func (n *OpenBazaarNode) readRatingIndex() ([]SavedRating, error) {
+	indexPath := path.Join(n.RepoPath, "root", "ratings.json")
+
+	var index []SavedRating
+
+	_, ferr := os.Stat(indexPath)
+	if os.IsNotExist(ferr) {
+		return nil, nil
+	}
+	file, err := ioutil.ReadFile(indexPath)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(file, &index)
+	if err != nil {
+		return nil, err
+	}
+	return index, nil
+}
+
+// ValidateRatingFields - validates the per criterion scores of a rating
+func ValidateRatingFields(fields []*pb.EntityRating_RatingFields) error {
+	seen := make(map[pb.EntityRating_RatingFields_RatingType]bool)
+	for _, field := range fields {
+		if field == nil {
+			return errors.New("rating field is empty")
+		}
+		if _, ok := pb.EntityRating_RatingFields_RatingType_name[int32(field.Type)]; !ok {
+			return fmt.Errorf("unknown rating field type %d", field.Type)
+		}
+		if seen[field.Type] {
+			return fmt.Errorf("duplicate rating field %s", field.Type)
+		}
+		seen[field.Type] = true
+		if field.Score < RatingMin || field.Score > ratingFieldMax(field) {
+			return fmt.Errorf("rating field %s must be between %d and %d", field.Type, RatingMin, ratingFieldMax(field))
+		}
+	}
+	return nil
+}
+
+// ratingFieldMax returns the highest score of a field, which defaults to
+// RatingMax when the rater left it unset
+func ratingFieldMax(field *pb.EntityRating_RatingFields) uint64 {
+	if field.Max == 0 {
+		return RatingMax
+	}
+	return field.Max
+}
+
+type ratingCriterionScore struct {
+	criterion string
+	score     float64
+}
+
+// ratingCriterionScores returns the scores of every criterion present in
+// the rating, normalized to the RatingMax point scale
+func ratingCriterionScores(data *pb.Rating_RatingData) []ratingCriterionScore {
+	var scores []ratingCriterionScore
+	for _, s := range []struct {
+		criterion string
+		value     uint32
+	}{
+		{RatingCriterionQuality, data.Quality},
+		{RatingCriterionDescription, data.Description},
+		{RatingCriterionDeliverySpeed, data.DeliverySpeed},
+		{RatingCriterionCustomerService, data.CustomerService},
+	} {
+		if s.value != 0 {
+			scores = append(scores, ratingCriterionScore{s.criterion, float64(s.value)})
+		}
+	}
+	var sumWeight uint64
+	for _, field := range data.Fields {
+		max := ratingFieldMax(field)
+		if field.Score > max {
+			continue
+		}
+		sumWeight += field.Weight
+		scores = append(scores, ratingCriterionScore{field.Type.String(), float64(field.Score) / float64(max) * RatingMax})
+	}
+	if sumWeight > 0 {
+		// computeFields scores out of 100
+		weighted := new(KimitzuRatingResp).computeFields(&pb.EntityRating{Fields: data.Fields})
+		scores = append(scores, ratingCriterionScore{RatingCriterionWeighted, weighted / 100 * RatingMax})
+	}
+	return scores
+}
+
+// AddRatingToCriteria folds the scores of a new rating into the criterion
+// aggregates, adding the criteria which are rated for the first time
+func AddRatingToCriteria(criteria []*pb.Profile_RatingCriterion, data *pb.Rating_RatingData) []*pb.Profile_RatingCriterion {
+	if data == nil {
+		return criteria
+	}
+	for _, s := range ratingCriterionScores(data) {
+		var c *pb.Profile_RatingCriterion
+		for _, existing := range criteria {
+			if existing.Criterion == s.criterion {
+				c = existing
+				break
+			}
+		}
+		if c == nil {
+			c = &pb.Profile_RatingCriterion{Criterion: s.criterion}
+			criteria = append(criteria, c)
+		}
+		for len(c.Histogram) < RatingMax {
+			c.Histogram = append(c.Histogram, 0)
+		}
+		total := float64(c.Average) * float64(c.Count)
+		c.Count++
+		c.Average = float32((total + s.score) / float64(c.Count))
+		bucket := int(math.Floor(s.score + 0.5))
+		if bucket < RatingMin {
+			bucket = RatingMin
+		}
+		if bucket > RatingMax {
+			bucket = RatingMax
+		}
+		c.Histogram[bucket-1]++
+	}
+	return criteria
+}
+
+// MergeRatingCriteria combines the criterion aggregates of several rating
+// sets, such as the per listing aggregates of a vendor
+func MergeRatingCriteria(sets ...[]*pb.Profile_RatingCriterion) []*pb.Profile_RatingCriterion {
+	var merged []*pb.Profile_RatingCriterion
+	for _, set := range sets {
+		for _, c := range set {
+			if c == nil || c.Count == 0 {
+				continue
+			}
+			var m *pb.Profile_RatingCriterion
+			for _, existing := range merged {
+				if existing.Criterion == c.Criterion {
+					m = existing
+					break
+				}
+			}
+			if m == nil {
+				m = &pb.Profile_RatingCriterion{Criterion: c.Criterion, Histogram: make([]uint32, RatingMax)}
+				merged = append(merged, m)
+			}
+			total := float64(m.Average)*float64(m.Count) + float64(c.Average)*float64(c.Count)
+			m.Count += c.Count
+			m.Average = float32(total / float64(m.Count))
+			for i := 0; i < len(c.Histogram) && i < len(m.Histogram); i++ {
+				m.Histogram[i] += c.Histogram[i]
+			}
+		}
+	}
+	return merged
+}
+
+func ratingCriteriaEqual(a, b []*pb.Profile_RatingCriterion) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !proto.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
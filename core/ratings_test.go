@@ -0,0 +1,114 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/kimitzu/kimitzu-go/core"
+	"github.com/kimitzu/kimitzu-go/pb"
+)
+
+func findRatingCriterion(criteria []*pb.Profile_RatingCriterion, name string) *pb.Profile_RatingCriterion {
+	for _, c := range criteria {
+		if c.Criterion == name {
+			return c
+		}
+	}
+	return nil
+}
+
+func TestAddRatingToCriteria(t *testing.T) {
+	first := &pb.Rating_RatingData{
+		Overall:         5,
+		Quality:         5,
+		Description:     4,
+		DeliverySpeed:   5,
+		CustomerService: 5,
+		Fields: []*pb.EntityRating_RatingFields{
+			{Type: pb.EntityRating_RatingFields_FAST_RESPONSE, Score: 5, Weight: 3},
+			{Type: pb.EntityRating_RatingFields_SCOPE_ACCURATE, Score: 6, Max: 10, Weight: 1},
+		},
+	}
+	second := &pb.Rating_RatingData{
+		Overall:         3,
+		Quality:         2,
+		Description:     4,
+		DeliverySpeed:   3,
+		CustomerService: 4,
+		Fields: []*pb.EntityRating_RatingFields{
+			{Type: pb.EntityRating_RatingFields_FAST_RESPONSE, Score: 4, Weight: 1},
+		},
+	}
+	criteria := core.AddRatingToCriteria(nil, first)
+	criteria = core.AddRatingToCriteria(criteria, second)
+
+	quality := findRatingCriterion(criteria, core.RatingCriterionQuality)
+	if quality == nil || quality.Count != 2 || quality.Average != 3.5 {
+		t.Fatalf("unexpected quality aggregate: %+v", quality)
+	}
+	if len(quality.Histogram) != core.RatingMax || quality.Histogram[1] != 1 || quality.Histogram[4] != 1 {
+		t.Errorf("unexpected quality histogram: %v", quality.Histogram)
+	}
+
+	fast := findRatingCriterion(criteria, pb.EntityRating_RatingFields_FAST_RESPONSE.String())
+	if fast == nil || fast.Count != 2 || fast.Average != 4.5 {
+		t.Errorf("unexpected fast response aggregate: %+v", fast)
+	}
+	scope := findRatingCriterion(criteria, pb.EntityRating_RatingFields_SCOPE_ACCURATE.String())
+	if scope == nil || scope.Count != 1 || scope.Average != 3 || scope.Histogram[2] != 1 {
+		t.Errorf("expected the scope score to be normalized to five points: %+v", scope)
+	}
+
+	// (3/4 * 5/5 + 1/4 * 6/10) * 5 = 4.5 and 4/5 * 5 = 4
+	weighted := findRatingCriterion(criteria, core.RatingCriterionWeighted)
+	if weighted == nil || weighted.Count != 2 || weighted.Average < 4.249 || weighted.Average > 4.251 {
+		t.Errorf("unexpected weighted aggregate: %+v", weighted)
+	}
+
+	if c := core.AddRatingToCriteria(nil, &pb.Rating_RatingData{Overall: 5}); len(c) != 0 {
+		t.Errorf("expected no criteria for a rating without criterion scores, got %+v", c)
+	}
+}
+
+func TestMergeRatingCriteria(t *testing.T) {
+	a := core.AddRatingToCriteria(nil, &pb.Rating_RatingData{Quality: 5})
+	b := core.AddRatingToCriteria(nil, &pb.Rating_RatingData{Quality: 2, Description: 3})
+	b = core.AddRatingToCriteria(b, &pb.Rating_RatingData{Quality: 2})
+
+	merged := core.MergeRatingCriteria(a, b)
+	if len(merged) != 2 {
+		t.Fatalf("expected 2 criteria, got %d", len(merged))
+	}
+	quality := findRatingCriterion(merged, core.RatingCriterionQuality)
+	if quality == nil || quality.Count != 3 || quality.Average != 3 {
+		t.Fatalf("unexpected merged quality: %+v", quality)
+	}
+	if quality.Histogram[1] != 2 || quality.Histogram[4] != 1 {
+		t.Errorf("unexpected merged histogram: %v", quality.Histogram)
+	}
+	if a[0].Count != 1 {
+		t.Error("merging modified its input")
+	}
+}
+
+func TestValidateRatingFields(t *testing.T) {
+	valid := []*pb.EntityRating_RatingFields{
+		{Type: pb.EntityRating_RatingFields_FAST_RESPONSE, Score: 5},
+		{Type: pb.EntityRating_RatingFields_SCOPE_ACCURATE, Score: 9, Max: 10},
+	}
+	if err := core.ValidateRatingFields(valid); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	for _, fields := range [][]*pb.EntityRating_RatingFields{
+		{{Type: pb.EntityRating_RatingFields_FAST_RESPONSE, Score: 6}},
+		{{Type: pb.EntityRating_RatingFields_FAST_RESPONSE, Score: 0}},
+		{{Type: pb.EntityRating_RatingFields_RatingType(42), Score: 3}},
+		{
+			{Type: pb.EntityRating_RatingFields_FAST_RESPONSE, Score: 3},
+			{Type: pb.EntityRating_RatingFields_FAST_RESPONSE, Score: 4},
+		},
+	} {
+		if err := core.ValidateRatingFields(fields); err == nil {
+			t.Errorf("expected an error for %+v", fields)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/kimitzu/kimitzu-go/pb"
+	"github.com/kimitzu/kimitzu-go/repo"
 	"github.com/OpenBazaar/wallet-interface"
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
@@ -52,7 +53,7 @@ func (n *OpenBazaarNode) RefundOrder(contract *pb.RicardianContract, records []*
 			Value:   outValue,
 		}
 
-		chaincode, err := hex.DecodeString(contract.BuyerOrder.Payment.Chaincode)
+		chaincode, err := hex.DecodeString(pb.OrderPayment(contract).Chaincode)
 		if err != nil {
 			return err
 		}
@@ -64,7 +65,7 @@ func (n *OpenBazaarNode) RefundOrder(contract *pb.RicardianContract, records []*
 		if err != nil {
 			return err
 		}
-		redeemScript, err := hex.DecodeString(contract.BuyerOrder.Payment.RedeemScript)
+		redeemScript, err := hex.DecodeString(pb.OrderPayment(contract).RedeemScript)
 		if err != nil {
 			return err
 		}
@@ -86,6 +87,8 @@ func (n *OpenBazaarNode) RefundOrder(contract *pb.RicardianContract, records []*
 				outValue += r.Value
 			}
 		}
+		// Partial refunds were already paid from our wallet
+		outValue -= int64(RefundedAmount(contract))
 		refundAddr, err := wal.DecodeAddress(contract.BuyerOrder.RefundAddress)
 		if err != nil {
 			return err
@@ -106,6 +109,9 @@ func (n *OpenBazaarNode) RefundOrder(contract *pb.RicardianContract, records []*
 	}
 	n.SendRefund(contract.BuyerOrder.BuyerID.PeerID, contract)
 	n.Datastore.Sales().Put(orderID, *contract, pb.OrderState_REFUNDED, true)
+	n.Datastore.Appointments().Delete(orderID)
+	RecordExchangeRates(n.Datastore, n.Multiwallet, contract.BuyerOrder.Payment.Coin, repo.ExchangeRateReasonRefunded, orderID)
+	n.RefreshInvoice(orderID)
 	return nil
 }
 
@@ -0,0 +1,48 @@
+package core
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/kimitzu/kimitzu-go/pb"
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+// IndexListingSearch adds our listing to the full text search index
+func (n *OpenBazaarNode) IndexListingSearch(listing *pb.Listing) error {
+	doc := &repo.SearchDocument{
+		Type:      repo.SearchDocumentTypeListing,
+		ID:        listing.Slug,
+		PeerID:    n.IpfsNode.Identity.Pretty(),
+		Timestamp: time.Now(),
+	}
+	if listing.Item != nil {
+		doc.Title = listing.Item.Title
+		doc.Body = listing.Item.Description
+		doc.Tags = listing.Item.Tags
+		doc.Categories = listing.Item.Categories
+	}
+	if listing.Metadata != nil {
+		doc.ServiceClassification = listing.Metadata.ServiceClassification
+	}
+	return n.Datastore.Search().Put(doc)
+}
+
+// IndexPostSearch adds our post to the full text search index
+func (n *OpenBazaarNode) IndexPostSearch(post *pb.Post) error {
+	doc := &repo.SearchDocument{
+		Type:      repo.SearchDocumentTypePost,
+		ID:        post.Slug,
+		PeerID:    n.IpfsNode.Identity.Pretty(),
+		Title:     post.Status,
+		Body:      post.LongForm,
+		Tags:      post.Tags,
+		Timestamp: time.Now(),
+	}
+	if post.Timestamp != nil {
+		if ts, err := ptypes.Timestamp(post.Timestamp); err == nil {
+			doc.Timestamp = ts
+		}
+	}
+	return n.Datastore.Search().Put(doc)
+}
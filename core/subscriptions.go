@@ -0,0 +1,446 @@
+package core
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path"
+	"time"
+
+	ipnspath "gx/ipfs/QmQAgv6Gaoe2tQpcabqwKXKChp2MZ7i3UXv9DqTTaxCaTR/go-path"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/kimitzu/kimitzu-go/ipfs"
+	"github.com/kimitzu/kimitzu-go/pb"
+	"github.com/kimitzu/kimitzu-go/repo"
+	"github.com/op/go-logging"
+)
+
+const (
+	// SubscriptionRenewalReminder - how long before a renewal the buyer is reminded of it
+	SubscriptionRenewalReminder = time.Hour * 72
+)
+
+var (
+	// ErrNotSubscriptionListing - the listing cannot be subscribed to
+	ErrNotSubscriptionListing = errors.New("subscriptions are only available for PER_MONTH service listings")
+	// ErrSubscriptionClosed - the subscription has been canceled or has completed
+	ErrSubscriptionClosed = errors.New("subscription has been canceled or has completed")
+	// ErrSubscriptionPaused - the buyer has paused the subscription
+	ErrSubscriptionPaused = errors.New("subscription has been paused")
+)
+
+// SubscriptionData - data required to start a subscription
+type SubscriptionData struct {
+	ListingHash          string   `json:"listingHash"`
+	Quantity             uint64   `json:"quantity"`
+	Options              []option `json:"options"`
+	Moderator            string   `json:"moderator"`
+	PaymentCoin          string   `json:"paymentCoin"`
+	Periods              uint32   `json:"periods"`
+	AlternateContactInfo string   `json:"alternateContactInfo"`
+}
+
+// SubscriptionID returns the ID of the subscription, which is the CID of the serialized subscription
+func SubscriptionID(subscription *pb.Subscription) (string, error) {
+	ser, err := proto.Marshal(subscription)
+	if err != nil {
+		return "", err
+	}
+	id, err := EncodeCID(ser)
+	if err != nil {
+		return "", err
+	}
+	return id.String(), nil
+}
+
+func validateSubscriptionListing(listing *pb.Listing) error {
+	if listing.Metadata.ContractType != pb.Listing_Metadata_SERVICE || listing.Metadata.ServiceRateMethod != pb.Listing_Metadata_PER_MONTH {
+		return ErrNotSubscriptionListing
+	}
+	return nil
+}
+
+// Subscribe - sign a new subscription and place the order for its first period
+func (n *OpenBazaarNode) Subscribe(data *SubscriptionData) (subscriptionID, orderID, paymentAddress string, paymentAmount uint64, vendorOnline bool, err error) {
+	listing, err := getSignedListing(n, new(pb.RicardianContract), item{ListingHash: data.ListingHash})
+	if err != nil {
+		return "", "", "", 0, false, err
+	}
+	if err := validateSubscriptionListing(listing); err != nil {
+		return "", "", "", 0, false, err
+	}
+	if data.Quantity == 0 {
+		data.Quantity = 1
+	}
+
+	buyerID, err := getContractIdentity(n)
+	if err != nil {
+		return "", "", "", 0, false, err
+	}
+	now := time.Now()
+	start, err := ptypes.TimestampProto(now)
+	if err != nil {
+		return "", "", "", 0, false, err
+	}
+	subscription := &pb.Subscription{
+		BuyerID:     buyerID,
+		VendorID:    listing.VendorID.PeerID,
+		ListingSlug: listing.Slug,
+		ListingHash: data.ListingHash,
+		Quantity:    data.Quantity,
+		PaymentCoin: data.PaymentCoin,
+		Moderator:   data.Moderator,
+		Periods:     data.Periods,
+		Start:       start,
+	}
+	for _, o := range data.Options {
+		subscription.Options = append(subscription.Options, &pb.Order_Item_Option{Name: o.Name, Value: o.Value})
+	}
+
+	ser, err := proto.Marshal(subscription)
+	if err != nil {
+		return "", "", "", 0, false, err
+	}
+	signature, err := n.IpfsNode.PrivateKey.Sign(ser)
+	if err != nil {
+		return "", "", "", 0, false, err
+	}
+	subscriptionID, err = SubscriptionID(subscription)
+	if err != nil {
+		return "", "", "", 0, false, err
+	}
+
+	record := &repo.SubscriptionRecord{
+		SubscriptionID: subscriptionID,
+		Subscription:   subscription,
+		Signature:      signature,
+		PeerID:         listing.VendorID.PeerID,
+		State:          pb.Subscription_ACTIVE,
+		NextRenewal:    now,
+		Timestamp:      now,
+	}
+	orderID, paymentAddress, paymentAmount, vendorOnline, err = n.RenewSubscription(record, data.AlternateContactInfo)
+	if err != nil {
+		return "", "", "", 0, false, err
+	}
+	return subscriptionID, orderID, paymentAddress, paymentAmount, vendorOnline, nil
+}
+
+// currentListingHash returns the hash of the listing the vendor currently publishes under the slug
+func (n *OpenBazaarNode) currentListingHash(peerID, slug string) (string, error) {
+	b, err := ipfs.ResolveThenCat(n.IpfsNode, ipnspath.FromString(path.Join(peerID, "listings", slug+".json")), time.Minute, n.IPNSQuorumSize, true)
+	if err != nil {
+		return "", err
+	}
+	return ipfs.GetHash(n.IpfsNode, bytes.NewReader(b))
+}
+
+// RenewSubscription - place the order for the next period of the subscription. The first
+// period uses the listing the buyer subscribed to while renewals use the vendor's current
+// version of the listing so edits to it do not end the subscription.
+func (n *OpenBazaarNode) RenewSubscription(record *repo.SubscriptionRecord, alternateContactInfo string) (orderID, paymentAddress string, paymentAmount uint64, vendorOnline bool, err error) {
+	if !record.IsActive() {
+		return "", "", 0, false, fmt.Errorf("subscription is %s", record.State)
+	}
+	subscription := record.Subscription
+	listingHash := subscription.ListingHash
+	if record.Period > 0 {
+		listingHash, err = n.currentListingHash(subscription.VendorID, subscription.ListingSlug)
+		if err != nil {
+			return "", "", 0, false, fmt.Errorf("fetching listing %s: %s", subscription.ListingSlug, err)
+		}
+	}
+	purchase := &PurchaseData{
+		Moderator:            subscription.Moderator,
+		PaymentCoin:          subscription.PaymentCoin,
+		AlternateContactInfo: alternateContactInfo,
+		subscriptionPeriod: &pb.Order_SubscriptionPeriod{
+			Subscription: subscription,
+			Signature:    record.Signature,
+			Period:       record.Period,
+		},
+	}
+	orderItem := item{ListingHash: listingHash, Quantity: subscription.Quantity}
+	for _, o := range subscription.Options {
+		orderItem.Options = append(orderItem.Options, option{Name: o.Name, Value: o.Value})
+	}
+	purchase.Items = []item{orderItem}
+
+	orderID, paymentAddress, paymentAmount, vendorOnline, err = n.Purchase(purchase)
+	if err != nil {
+		return "", "", 0, false, err
+	}
+
+	record.Period++
+	record.LastOrderID = orderID
+	record.NextRenewal = record.NextRenewal.AddDate(0, 1, 0)
+	if record.NextRenewal.Before(time.Now()) {
+		record.NextRenewal = time.Now().AddDate(0, 1, 0)
+	}
+	if record.IsFinalPeriod() {
+		record.State = pb.Subscription_COMPLETED
+	}
+	if err := n.Datastore.Subscriptions().Put(record); err != nil {
+		return "", "", 0, false, err
+	}
+	return orderID, paymentAddress, paymentAmount, vendorOnline, nil
+}
+
+// UpdateSubscriptionState - pause, resume or cancel one of our subscriptions and let the vendor know
+func (n *OpenBazaarNode) UpdateSubscriptionState(subscriptionID string, state pb.Subscription_State) error {
+	record, err := n.Datastore.Subscriptions().Get(subscriptionID)
+	if err != nil {
+		return err
+	}
+	if record.IsSale {
+		return errors.New("only the buyer can change the state of a subscription")
+	}
+	if record.State == pb.Subscription_CANCELED || record.State == pb.Subscription_COMPLETED {
+		return ErrSubscriptionClosed
+	}
+	switch state {
+	case pb.Subscription_PAUSED:
+		if record.State != pb.Subscription_ACTIVE {
+			return errors.New("only active subscriptions can be paused")
+		}
+	case pb.Subscription_ACTIVE:
+		if record.State != pb.Subscription_PAUSED {
+			return errors.New("only paused subscriptions can be resumed")
+		}
+		// Periods missed while paused are skipped rather than billed on resume
+		if record.NextRenewal.Before(time.Now()) {
+			record.NextRenewal = time.Now()
+		}
+	case pb.Subscription_CANCELED:
+	default:
+		return fmt.Errorf("subscription cannot be set to %s", state)
+	}
+	record.State = state
+	if err := n.Datastore.Subscriptions().Put(record); err != nil {
+		return err
+	}
+	return n.sendSubscriptionState(record)
+}
+
+// sendSubscriptionState lets the vendor know the buyer changed the state of a subscription
+func (n *OpenBazaarNode) sendSubscriptionState(record *repo.SubscriptionRecord) error {
+	ts, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return err
+	}
+	update := &pb.SubscriptionUpdate{
+		SubscriptionID: record.SubscriptionID,
+		State:          record.State,
+		Timestamp:      ts,
+	}
+	return n.SendSubscriptionUpdate(record.PeerID, update)
+}
+
+// validateOrderSubscription checks a subscription order was authorized by the buyer's
+// signed subscription and that the subscription is still open. The order may be for a
+// newer version of the listing than the one subscribed to as long as the slug matches.
+func (n *OpenBazaarNode) validateOrderSubscription(contract *pb.RicardianContract) error {
+	sp := contract.BuyerOrder.SubscriptionPeriod
+	if sp == nil {
+		return nil
+	}
+	subscription := sp.Subscription
+	if subscription == nil {
+		return errors.New("subscription order is missing the subscription")
+	}
+	if len(contract.BuyerOrder.Items) != 1 || len(contract.VendorListings) != 1 {
+		return errors.New("subscription orders must contain a single item")
+	}
+	listing := contract.VendorListings[0]
+	if err := validateSubscriptionListing(listing); err != nil {
+		return err
+	}
+	orderItem := contract.BuyerOrder.Items[0]
+	if subscription.ListingSlug != listing.Slug || subscription.VendorID != listing.VendorID.PeerID {
+		return errors.New("subscription does not match the listing in the order")
+	}
+	if GetOrderQuantity(listing, orderItem) != subscription.Quantity {
+		return errors.New("order quantity does not match the subscription")
+	}
+	if subscription.Periods > 0 && sp.Period >= subscription.Periods {
+		return errors.New("subscription has no periods remaining")
+	}
+	buyerID := contract.BuyerOrder.BuyerID
+	if subscription.BuyerID == nil || subscription.BuyerID.PeerID != buyerID.PeerID {
+		return errors.New("subscription belongs to a different buyer")
+	}
+	if err := verifySignature(subscription, buyerID.Pubkeys.Identity, sp.Signature, buyerID.PeerID); err != nil {
+		return errors.New("buyer's signature on the subscription failed to verify")
+	}
+
+	subscriptionID, err := SubscriptionID(subscription)
+	if err != nil {
+		return err
+	}
+	if record, err := n.Datastore.Subscriptions().Get(subscriptionID); err == nil {
+		switch record.State {
+		case pb.Subscription_CANCELED:
+			return ErrSubscriptionClosed
+		case pb.Subscription_PAUSED:
+			return ErrSubscriptionPaused
+		}
+	}
+	return nil
+}
+
+// RecordSubscriptionSale - track the subscription of a validated order we received as the vendor
+func (n *OpenBazaarNode) RecordSubscriptionSale(contract *pb.RicardianContract, orderID string) error {
+	sp := contract.BuyerOrder.SubscriptionPeriod
+	if sp == nil {
+		return nil
+	}
+	subscriptionID, err := SubscriptionID(sp.Subscription)
+	if err != nil {
+		return err
+	}
+	record, err := n.Datastore.Subscriptions().Get(subscriptionID)
+	if err != nil {
+		record = &repo.SubscriptionRecord{
+			SubscriptionID: subscriptionID,
+			Subscription:   sp.Subscription,
+			Signature:      sp.Signature,
+			IsSale:         true,
+			PeerID:         contract.BuyerOrder.BuyerID.PeerID,
+			State:          pb.Subscription_ACTIVE,
+			Timestamp:      time.Now(),
+		}
+	}
+	if sp.Period+1 > record.Period {
+		record.Period = sp.Period + 1
+		record.LastOrderID = orderID
+		record.NextRenewal = time.Now().AddDate(0, 1, 0)
+	}
+	if record.IsFinalPeriod() {
+		record.State = pb.Subscription_COMPLETED
+	}
+	return n.Datastore.Subscriptions().Put(record)
+}
+
+type subscriptionRenewer struct {
+	// PerformTask dependencies
+	node      *OpenBazaarNode
+	datastore repo.Datastore
+	broadcast chan repo.Notifier
+
+	// Worker-handling dependencies
+	intervalDelay time.Duration
+	logger        *logging.Logger
+	watchdogTimer *time.Ticker
+	stopWorker    chan bool
+}
+
+// StartSubscriptionRenewer - start the worker which reminds the buyer of upcoming
+// renewals and places the order for each new subscription period
+func (n *OpenBazaarNode) StartSubscriptionRenewer() {
+	n.SubscriptionRenewer = &subscriptionRenewer{
+		node:          n,
+		datastore:     n.Datastore,
+		broadcast:     n.Broadcast,
+		intervalDelay: n.intervalDelay(),
+		logger:        logging.MustGetLogger("subscriptionRenewer"),
+	}
+	go n.SubscriptionRenewer.Run()
+}
+
+func (renewer *subscriptionRenewer) Run() {
+	renewer.watchdogTimer = time.NewTicker(renewer.intervalDelay)
+	renewer.stopWorker = make(chan bool)
+
+	// Run once on start, then wait for watchdog
+	renewer.PerformTask()
+	for {
+		select {
+		case <-renewer.watchdogTimer.C:
+			renewer.PerformTask()
+		case <-renewer.stopWorker:
+			renewer.watchdogTimer.Stop()
+			return
+		}
+	}
+}
+
+func (renewer *subscriptionRenewer) Stop() {
+	renewer.stopWorker <- true
+	close(renewer.stopWorker)
+}
+
+func (renewer *subscriptionRenewer) PerformTask() {
+	now := time.Now()
+	records, err := renewer.datastore.Subscriptions().GetDueBefore(now.Add(SubscriptionRenewalReminder))
+	if err != nil {
+		renewer.logger.Errorf("fetching subscriptions due for renewal: %s", err)
+		return
+	}
+	var reminded, renewed int
+	for _, record := range records {
+		if record.NextRenewal.After(now) {
+			if record.RemindedPeriod >= record.Period {
+				continue
+			}
+			if err := renewer.remind(record); err != nil {
+				renewer.logger.Errorf("reminding renewal of subscription (%s): %s", record.SubscriptionID, err)
+				continue
+			}
+			reminded++
+			continue
+		}
+		if _, _, _, _, err := renewer.node.RenewSubscription(record, ""); err != nil {
+			renewer.logger.Errorf("renewing subscription (%s): %s", record.SubscriptionID, err)
+			if err := renewer.fail(record, err); err != nil {
+				renewer.logger.Errorf("pausing subscription (%s): %s", record.SubscriptionID, err)
+			}
+			continue
+		}
+		renewed++
+	}
+	renewer.logger.Debugf("subscriptions reminded/renewed: %d/%d", reminded, renewed)
+}
+
+func (renewer *subscriptionRenewer) remind(record *repo.SubscriptionRecord) error {
+	n := repo.SubscriptionRenewalReminderNotification{
+		ID:             repo.NewNotificationID(),
+		Type:           repo.NotifierTypeSubscriptionRenewalReminder,
+		SubscriptionID: record.SubscriptionID,
+		Slug:           record.Subscription.ListingSlug,
+		VendorID:       record.PeerID,
+		Period:         record.Period,
+		RenewsAt:       repo.NewAPITime(record.NextRenewal),
+	}
+	record.RemindedPeriod = record.Period
+	if err := renewer.datastore.Subscriptions().Put(record); err != nil {
+		return err
+	}
+	renewer.broadcast <- n
+	return renewer.datastore.Notifications().PutRecord(repo.NewNotification(n, time.Now(), false))
+}
+
+// fail pauses a subscription whose renewal could not be placed so it is not retried
+// on every run and lets both the buyer and the vendor know. Resuming the subscription
+// renews it right away.
+func (renewer *subscriptionRenewer) fail(record *repo.SubscriptionRecord, reason error) error {
+	n := repo.SubscriptionRenewalFailedNotification{
+		ID:             repo.NewNotificationID(),
+		Type:           repo.NotifierTypeSubscriptionRenewalFailed,
+		SubscriptionID: record.SubscriptionID,
+		Slug:           record.Subscription.ListingSlug,
+		VendorID:       record.PeerID,
+		Period:         record.Period,
+		Reason:         reason.Error(),
+	}
+	record.State = pb.Subscription_PAUSED
+	if err := renewer.datastore.Subscriptions().Put(record); err != nil {
+		return err
+	}
+	renewer.broadcast <- n
+	if err := renewer.datastore.Notifications().PutRecord(repo.NewNotification(n, time.Now(), false)); err != nil {
+		return err
+	}
+	return renewer.node.sendSubscriptionState(record)
+}
@@ -0,0 +1,219 @@
+package core
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	wi "github.com/OpenBazaar/wallet-interface"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/kimitzu/kimitzu-go/net"
+	"github.com/kimitzu/kimitzu-go/pb"
+	"github.com/kimitzu/kimitzu-go/repo"
+	"github.com/kimitzu/kimitzu-go/repo/db"
+	"github.com/kimitzu/kimitzu-go/schema"
+	"github.com/op/go-logging"
+	peer "gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
+)
+
+func newSubscriptionTestDatastore(t *testing.T) (repo.Datastore, func()) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		t.Fatal(err)
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		appSchema.DestroySchemaDirectories()
+		t.Fatal(err)
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		appSchema.DestroySchemaDirectories()
+		t.Fatal(err)
+	}
+	return db.NewSQLiteDatastore(database, new(sync.Mutex), wi.Bitcoin), appSchema.DestroySchemaDirectories
+}
+
+// newSubscriptionTestContract returns the order for the second period of a monthly
+// subscription placed against a newer version of the listing than the one subscribed to
+func newSubscriptionTestContract(t *testing.T) *pb.RicardianContract {
+	buyerKey, buyerID := newQuoteTestID(t)
+	_, vendorID := newQuoteTestID(t)
+
+	contract := newLedgerTestContract(t, "USD")
+	listing := contract.VendorListings[0]
+	listing.Slug = "monthly-retainer"
+	listing.VendorID = vendorID
+	listing.Metadata.ContractType = pb.Listing_Metadata_SERVICE
+	listing.Metadata.ServiceRateMethod = pb.Listing_Metadata_PER_MONTH
+	contract.BuyerOrder.BuyerID = buyerID
+
+	start, err := ptypes.TimestampProto(time.Now().AddDate(0, -1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	subscription := &pb.Subscription{
+		BuyerID:     buyerID,
+		VendorID:    vendorID.PeerID,
+		ListingSlug: listing.Slug,
+		ListingHash: "QmOriginalListing",
+		Quantity:    GetOrderQuantity(listing, contract.BuyerOrder.Items[0]),
+		Periods:     12,
+		Start:       start,
+	}
+	contract.BuyerOrder.SubscriptionPeriod = &pb.Order_SubscriptionPeriod{
+		Subscription: subscription,
+		Signature:    signQuoteTestMessage(t, buyerKey, subscription),
+		Period:       1,
+	}
+	return contract
+}
+
+// subscriptionTestService records the messages sent to peers instead of delivering them
+type subscriptionTestService struct {
+	net.NetworkService
+	sent map[peer.ID][]*pb.Message
+}
+
+func (s *subscriptionTestService) SendMessage(ctx context.Context, p peer.ID, pmes *pb.Message) error {
+	s.sent[p] = append(s.sent[p], pmes)
+	return nil
+}
+
+func TestValidateOrderSubscription(t *testing.T) {
+	datastore, destroy := newSubscriptionTestDatastore(t)
+	defer destroy()
+	node := &OpenBazaarNode{Datastore: datastore}
+
+	contract := newSubscriptionTestContract(t)
+	if contract.BuyerOrder.Items[0].ListingHash == contract.BuyerOrder.SubscriptionPeriod.Subscription.ListingHash {
+		t.Fatal("expected the order to be for an edited listing")
+	}
+	if err := node.validateOrderSubscription(contract); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]func(c *pb.RicardianContract){
+		"another slug":     func(c *pb.RicardianContract) { c.VendorListings[0].Slug = "weekly-retainer" },
+		"another vendor":   func(c *pb.RicardianContract) { c.BuyerOrder.SubscriptionPeriod.Subscription.VendorID = "QmOtherVendor" },
+		"another quantity": func(c *pb.RicardianContract) { c.BuyerOrder.SubscriptionPeriod.Subscription.Quantity++ },
+		"no periods left":  func(c *pb.RicardianContract) { c.BuyerOrder.SubscriptionPeriod.Period = 12 },
+		"hourly listing": func(c *pb.RicardianContract) {
+			c.VendorListings[0].Metadata.ServiceRateMethod = pb.Listing_Metadata_PER_HOUR
+		},
+		"invalid signature":  func(c *pb.RicardianContract) { c.BuyerOrder.SubscriptionPeriod.Signature = []byte("bad") },
+		"missing subscriber": func(c *pb.RicardianContract) { c.BuyerOrder.SubscriptionPeriod.Subscription = nil },
+	}
+	for name, modify := range tests {
+		c := newSubscriptionTestContract(t)
+		modify(c)
+		if err := node.validateOrderSubscription(c); err == nil {
+			t.Errorf("expected subscription order with %s to fail validation", name)
+		}
+	}
+
+	subscriptionID, err := SubscriptionID(contract.BuyerOrder.SubscriptionPeriod.Subscription)
+	if err != nil {
+		t.Fatal(err)
+	}
+	record := &repo.SubscriptionRecord{
+		SubscriptionID: subscriptionID,
+		Subscription:   contract.BuyerOrder.SubscriptionPeriod.Subscription,
+		IsSale:         true,
+		PeerID:         contract.BuyerOrder.BuyerID.PeerID,
+		Period:         1,
+		NextRenewal:    time.Now(),
+		Timestamp:      time.Now(),
+	}
+	for state, expected := range map[pb.Subscription_State]error{
+		pb.Subscription_ACTIVE:   nil,
+		pb.Subscription_PAUSED:   ErrSubscriptionPaused,
+		pb.Subscription_CANCELED: ErrSubscriptionClosed,
+	} {
+		record.State = state
+		if err := datastore.Subscriptions().Put(record); err != nil {
+			t.Fatal(err)
+		}
+		if err := node.validateOrderSubscription(contract); err != expected {
+			t.Errorf("expected %s subscription to return %v, got %v", state, expected, err)
+		}
+	}
+}
+
+func TestSubscriptionRenewerFail(t *testing.T) {
+	datastore, destroy := newSubscriptionTestDatastore(t)
+	defer destroy()
+	broadcast := make(chan repo.Notifier, 1)
+	service := &subscriptionTestService{sent: make(map[peer.ID][]*pb.Message)}
+	renewer := &subscriptionRenewer{
+		node:      &OpenBazaarNode{Datastore: datastore, Service: service},
+		datastore: datastore,
+		broadcast: broadcast,
+		logger:    logging.MustGetLogger("testSubscriptionRenewer"),
+	}
+
+	_, vendorID := newQuoteTestID(t)
+	record := &repo.SubscriptionRecord{
+		SubscriptionID: "QmSubscription",
+		Subscription:   &pb.Subscription{VendorID: vendorID.PeerID, ListingSlug: "monthly-retainer"},
+		PeerID:         vendorID.PeerID,
+		State:          pb.Subscription_ACTIVE,
+		Period:         2,
+		NextRenewal:    time.Now().Add(-time.Hour),
+		Timestamp:      time.Now(),
+	}
+	if err := datastore.Subscriptions().Put(record); err != nil {
+		t.Fatal(err)
+	}
+	if err := renewer.fail(record, ErrNotSubscriptionListing); err != nil {
+		t.Fatal(err)
+	}
+
+	saved, err := datastore.Subscriptions().Get(record.SubscriptionID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.State != pb.Subscription_PAUSED {
+		t.Errorf("expected the failed subscription to be paused, got %s", saved.State)
+	}
+	due, err := datastore.Subscriptions().GetDueBefore(time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(due) != 0 {
+		t.Errorf("expected the paused subscription not to be retried, got %d due", len(due))
+	}
+
+	n, ok := (<-broadcast).(repo.SubscriptionRenewalFailedNotification)
+	if !ok {
+		t.Fatal("expected a renewal failed notification to be broadcast")
+	}
+	if n.SubscriptionID != record.SubscriptionID || n.Period != 2 || n.Reason != ErrNotSubscriptionListing.Error() {
+		t.Errorf("unexpected notification %+v", n)
+	}
+	notifications, _, err := datastore.Notifications().GetAll("", -1, []string{string(repo.NotifierTypeSubscriptionRenewalFailed)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(notifications) != 1 {
+		t.Errorf("expected the notification to be saved, found %d", len(notifications))
+	}
+
+	vendor, err := peer.IDB58Decode(vendorID.PeerID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(service.sent[vendor]) != 1 || service.sent[vendor][0].MessageType != pb.Message_SUBSCRIPTION_UPDATE {
+		t.Fatalf("expected a subscription update to be sent to the vendor, got %v", service.sent[vendor])
+	}
+	update := new(pb.SubscriptionUpdate)
+	if err := ptypes.UnmarshalAny(service.sent[vendor][0].Payload, update); err != nil {
+		t.Fatal(err)
+	}
+	if update.SubscriptionID != record.SubscriptionID || update.State != pb.Subscription_PAUSED {
+		t.Errorf("expected the vendor to be told the subscription is paused, got %+v", update)
+	}
+}
@@ -0,0 +1,445 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	libp2p "gx/ipfs/QmTW4SdgBWq9GjsBsHeUx8WuGxzhgzAf88UMH2w62PC8yK/go-libp2p-crypto"
+
+	"github.com/OpenBazaar/wallet-interface"
+	btc "github.com/btcsuite/btcutil"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/kimitzu/kimitzu-go/pb"
+)
+
+const (
+	// MaxTimesheetEntries - max number of timesheet entries on a single order
+	MaxTimesheetEntries = 1000
+)
+
+var (
+	// ErrNotTimesheetOrder - the order is not billed from a timesheet
+	ErrNotTimesheetOrder = errors.New("order is not billed from a timesheet")
+	// ErrTimesheetClosed - the timesheet can no longer change once the order has been fulfilled
+	ErrTimesheetClosed = errors.New("the timesheet is closed once the order has been fulfilled")
+	// ErrTimesheetEntriesPending - some timesheet entries have not been reviewed by the buyer
+	ErrTimesheetEntriesPending = errors.New("all timesheet entries must be reviewed by the buyer before fulfillment")
+)
+
+// IsTimesheetOrder returns true if the payout of the order is driven by the vendor's timesheet
+func IsTimesheetOrder(contract *pb.RicardianContract) bool {
+	return contract.BuyerOrder != nil && contract.BuyerOrder.BillingRate != nil
+}
+
+// BillingUnit returns the duration of one billable unit for the service rate method
+func BillingUnit(method pb.Listing_Metadata_ServiceRateMethod) time.Duration {
+	switch method {
+	case pb.Listing_Metadata_PER_HOUR:
+		return time.Hour
+	case pb.Listing_Metadata_PER_DAY:
+		return time.Hour * 24
+	}
+	return 0
+}
+
+// TimesheetEntryStatus returns the buyer's review status of the timesheet entry
+func TimesheetEntryStatus(contract *pb.RicardianContract, index uint32) pb.TimesheetReview_Status {
+	for _, r := range contract.BuyerTimesheetReviews {
+		if r.EntryIndex == index {
+			return r.Status
+		}
+	}
+	return pb.TimesheetReview_PENDING
+}
+
+// TimesheetEntryDuration returns the time covered by a timesheet entry
+func TimesheetEntryDuration(entry *pb.TimesheetEntry) (time.Duration, error) {
+	start, err := ptypes.Timestamp(entry.Start)
+	if err != nil {
+		return 0, err
+	}
+	end, err := ptypes.Timestamp(entry.End)
+	if err != nil {
+		return 0, err
+	}
+	return end.Sub(start), nil
+}
+
+// TimesheetDuration returns the total time of the entries with the given review status
+func TimesheetDuration(contract *pb.RicardianContract, status pb.TimesheetReview_Status) time.Duration {
+	var total time.Duration
+	for _, entry := range contract.VendorTimesheetEntries {
+		if TimesheetEntryStatus(contract, entry.Index) != status {
+			continue
+		}
+		d, err := TimesheetEntryDuration(entry)
+		if err != nil {
+			continue
+		}
+		total += d
+	}
+	return total
+}
+
+// ApprovedTimesheetAmount returns the amount, in the payment coin, the vendor has earned
+// for the timesheet entries approved by the buyer
+func ApprovedTimesheetAmount(contract *pb.RicardianContract) uint64 {
+	if !IsTimesheetOrder(contract) {
+		return 0
+	}
+	unit := BillingUnit(contract.BuyerOrder.BillingRate.RateMethod)
+	if unit == 0 {
+		return 0
+	}
+	approved := TimesheetDuration(contract, pb.TimesheetReview_APPROVED)
+	return uint64(float64(contract.BuyerOrder.BillingRate.UnitPrice) * (float64(approved) / float64(unit)))
+}
+
+// PayoutOutputs returns the outputs of the escrow transaction which pays out the order to the
+// vendor. Timesheet orders pay the vendor for the approved time only and refund the remainder
+// of the escrow to the buyer.
+func PayoutOutputs(wal wallet.Wallet, contract *pb.RicardianContract, outValue int64, payoutAddress btc.Address, feePerByte uint64) ([]wallet.TransactionOutput, error) {
+	outputs := []wallet.TransactionOutput{{Address: payoutAddress, Value: outValue}}
+	if !IsTimesheetOrder(contract) {
+		return outputs, nil
+	}
+	dust := int64(feePerByte * EscrowReleaseSize)
+	earned := int64(ApprovedTimesheetAmount(contract))
+	refund := outValue - earned
+	if refund <= dust {
+		return outputs, nil
+	}
+	refundAddress, err := wal.DecodeAddress(contract.BuyerOrder.RefundAddress)
+	if err != nil {
+		return nil, err
+	}
+	if earned <= dust {
+		return []wallet.TransactionOutput{{Address: refundAddress, Value: outValue}}, nil
+	}
+	outputs[0].Value = earned
+	return append(outputs, wallet.TransactionOutput{Address: refundAddress, Value: refund}), nil
+}
+
+// buildOrderBillingRate returns the billing rate for an order of a single PER_HOUR or
+// PER_DAY service. The quantity ordered is the buyer's estimate of the billable units.
+func buildOrderBillingRate(contract *pb.RicardianContract) *pb.Order_BillingRate {
+	if len(contract.BuyerOrder.Items) != 1 || len(contract.VendorListings) != 1 {
+		return nil
+	}
+	listing := contract.VendorListings[0]
+	if listing.Metadata.ContractType != pb.Listing_Metadata_SERVICE || BillingUnit(listing.Metadata.ServiceRateMethod) == 0 {
+		return nil
+	}
+	quantity := GetOrderQuantity(listing, contract.BuyerOrder.Items[0])
+	if quantity == 0 {
+		return nil
+	}
+	return &pb.Order_BillingRate{
+		RateMethod:     listing.Metadata.ServiceRateMethod,
+		UnitPrice:      contract.BuyerOrder.Payment.Amount / quantity,
+		EstimatedUnits: quantity,
+	}
+}
+
+// addOrderBillingRate attaches the billing rate to orders which are paid from a timesheet
+func addOrderBillingRate(contract *pb.RicardianContract) {
+	contract.BuyerOrder.BillingRate = buildOrderBillingRate(contract)
+}
+
+// validateOrderBillingRate checks the buyer derived the billing rate the same way we would
+func validateOrderBillingRate(contract *pb.RicardianContract) error {
+	expected := buildOrderBillingRate(contract)
+	if !proto.Equal(expected, contract.BuyerOrder.BillingRate) {
+		return errors.New("order billing rate does not match the listing")
+	}
+	return nil
+}
+
+// checkTimesheetOpen returns an error if entries and reviews can no longer be added to the order
+func checkTimesheetOpen(contract *pb.RicardianContract) error {
+	if !IsTimesheetOrder(contract) {
+		return ErrNotTimesheetOrder
+	}
+	if len(contract.VendorOrderFulfillment) > 0 {
+		return ErrTimesheetClosed
+	}
+	return nil
+}
+
+func validateTimesheetEntry(contract *pb.RicardianContract, entry *pb.TimesheetEntry) error {
+	if err := checkTimesheetOpen(contract); err != nil {
+		return err
+	}
+	if len(contract.VendorTimesheetEntries) >= MaxTimesheetEntries {
+		return fmt.Errorf("number of timesheet entries is greater than the max of %d", MaxTimesheetEntries)
+	}
+	if int(entry.Index) != len(contract.VendorTimesheetEntries) {
+		return errors.New("timesheet entry index is out of order")
+	}
+	if len(entry.Description) > DescriptionMaxCharacters {
+		return fmt.Errorf("timesheet entry description is longer than the max of %d characters", DescriptionMaxCharacters)
+	}
+	d, err := TimesheetEntryDuration(entry)
+	if err != nil {
+		return err
+	}
+	if d <= 0 {
+		return errors.New("timesheet entry must end after it starts")
+	}
+	end, err := ptypes.Timestamp(entry.End)
+	if err != nil {
+		return err
+	}
+	if entry.Timestamp != nil {
+		ts, err := ptypes.Timestamp(entry.Timestamp)
+		if err != nil {
+			return err
+		}
+		if end.After(ts) {
+			return errors.New("timesheet entry cannot end in the future")
+		}
+	}
+	return nil
+}
+
+func validateTimesheetReview(contract *pb.RicardianContract, review *pb.TimesheetReview) error {
+	if err := checkTimesheetOpen(contract); err != nil {
+		return err
+	}
+	if int(review.EntryIndex) >= len(contract.VendorTimesheetEntries) {
+		return errors.New("timesheet entry not found")
+	}
+	if TimesheetEntryStatus(contract, review.EntryIndex) != pb.TimesheetReview_PENDING {
+		return errors.New("timesheet entry has already been reviewed")
+	}
+	if review.Status != pb.TimesheetReview_APPROVED && review.Status != pb.TimesheetReview_CONTESTED {
+		return errors.New("timesheet review must either approve or contest the entry")
+	}
+	if len(review.Reason) > DescriptionMaxCharacters {
+		return fmt.Errorf("timesheet review reason is longer than the max of %d characters", DescriptionMaxCharacters)
+	}
+	return nil
+}
+
+// SubmitTimesheetEntry - record time worked on the order and send the signed entry to the buyer
+func (n *OpenBazaarNode) SubmitTimesheetEntry(contract *pb.RicardianContract, state pb.OrderState, start, end time.Time, description string) error {
+	orderID, err := n.CalcOrderID(contract.BuyerOrder)
+	if err != nil {
+		return err
+	}
+	entry := new(pb.TimesheetEntry)
+	entry.OrderId = orderID
+	entry.Index = uint32(len(contract.VendorTimesheetEntries))
+	entry.Description = description
+	entry.Start, err = ptypes.TimestampProto(start)
+	if err != nil {
+		return err
+	}
+	entry.End, err = ptypes.TimestampProto(end)
+	if err != nil {
+		return err
+	}
+	entry.Timestamp, err = ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return err
+	}
+	if err := validateTimesheetEntry(contract, entry); err != nil {
+		return err
+	}
+
+	rc := new(pb.RicardianContract)
+	rc.VendorTimesheetEntries = []*pb.TimesheetEntry{entry}
+	rc, err = n.SignTimesheetEntry(rc)
+	if err != nil {
+		return err
+	}
+	buyerkey, err := libp2p.UnmarshalPublicKey(contract.BuyerOrder.BuyerID.Pubkeys.Identity)
+	if err != nil {
+		return err
+	}
+	err = n.SendTimesheetEntry(contract.BuyerOrder.BuyerID.PeerID, &buyerkey, rc)
+	if err != nil {
+		return err
+	}
+
+	contract.VendorTimesheetEntries = append(contract.VendorTimesheetEntries, entry)
+	for _, sig := range rc.Signatures {
+		if sig.Section == pb.Signature_TIMESHEET_ENTRY {
+			contract.Signatures = append(contract.Signatures, sig)
+		}
+	}
+	return n.Datastore.Sales().Put(orderID, *contract, state, false)
+}
+
+// ReviewTimesheetEntry - approve or contest one of the vendor's timesheet entries
+func (n *OpenBazaarNode) ReviewTimesheetEntry(contract *pb.RicardianContract, state pb.OrderState, index uint32, status pb.TimesheetReview_Status, reason string) error {
+	orderID, err := n.CalcOrderID(contract.BuyerOrder)
+	if err != nil {
+		return err
+	}
+	review := new(pb.TimesheetReview)
+	review.OrderId = orderID
+	review.EntryIndex = index
+	review.Status = status
+	review.Reason = reason
+	review.Timestamp, err = ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return err
+	}
+	if err := validateTimesheetReview(contract, review); err != nil {
+		return err
+	}
+
+	rc := new(pb.RicardianContract)
+	rc.BuyerTimesheetReviews = []*pb.TimesheetReview{review}
+	rc, err = n.SignTimesheetReview(rc)
+	if err != nil {
+		return err
+	}
+	vendorkey, err := libp2p.UnmarshalPublicKey(contract.VendorListings[0].VendorID.Pubkeys.Identity)
+	if err != nil {
+		return err
+	}
+	err = n.SendTimesheetReview(contract.VendorListings[0].VendorID.PeerID, &vendorkey, rc)
+	if err != nil {
+		return err
+	}
+
+	contract.BuyerTimesheetReviews = append(contract.BuyerTimesheetReviews, review)
+	for _, sig := range rc.Signatures {
+		if sig.Section == pb.Signature_TIMESHEET_REVIEW {
+			contract.Signatures = append(contract.Signatures, sig)
+		}
+	}
+	return n.Datastore.Purchases().Put(orderID, *contract, state, true)
+}
+
+// SignTimesheetEntry - add signature to timesheet entry
+func (n *OpenBazaarNode) SignTimesheetEntry(contract *pb.RicardianContract) (*pb.RicardianContract, error) {
+	serializedEntry, err := proto.Marshal(contract.VendorTimesheetEntries[0])
+	if err != nil {
+		return contract, err
+	}
+	s := new(pb.Signature)
+	s.Section = pb.Signature_TIMESHEET_ENTRY
+	guidSig, err := n.IpfsNode.PrivateKey.Sign(serializedEntry)
+	if err != nil {
+		return contract, err
+	}
+	s.SignatureBytes = guidSig
+	contract.Signatures = append(contract.Signatures, s)
+	return contract, nil
+}
+
+// SignTimesheetReview - add signature to timesheet review
+func (n *OpenBazaarNode) SignTimesheetReview(contract *pb.RicardianContract) (*pb.RicardianContract, error) {
+	serializedReview, err := proto.Marshal(contract.BuyerTimesheetReviews[0])
+	if err != nil {
+		return contract, err
+	}
+	s := new(pb.Signature)
+	s.Section = pb.Signature_TIMESHEET_REVIEW
+	guidSig, err := n.IpfsNode.PrivateKey.Sign(serializedReview)
+	if err != nil {
+		return contract, err
+	}
+	s.SignatureBytes = guidSig
+	contract.Signatures = append(contract.Signatures, s)
+	return contract, nil
+}
+
+// ValidateTimesheetEntry - validate a timesheet entry received from the vendor against our
+// copy of the contract. sigs must be the signatures which accompanied the entry.
+func (n *OpenBazaarNode) ValidateTimesheetEntry(contract *pb.RicardianContract, entry *pb.TimesheetEntry, sigs []*pb.Signature) error {
+	if err := validateTimesheetEntry(contract, entry); err != nil {
+		return err
+	}
+	if err := verifyMessageSignature(
+		entry,
+		contract.VendorListings[0].VendorID.Pubkeys.Identity,
+		sigs,
+		pb.Signature_TIMESHEET_ENTRY,
+		contract.VendorListings[0].VendorID.PeerID,
+	); err != nil {
+		switch err.(type) {
+		case noSigError:
+			return errors.New("contract does not contain a signature for the timesheet entry")
+		case invalidSigError:
+			return errors.New("vendor's guid signature on timesheet entry failed to verify")
+		case matchKeyError:
+			return errors.New("public key in listing does not match reported vendor ID")
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateTimesheetReview - validate a timesheet review received from the buyer against our
+// copy of the contract. sigs must be the signatures which accompanied the review.
+func (n *OpenBazaarNode) ValidateTimesheetReview(contract *pb.RicardianContract, review *pb.TimesheetReview, sigs []*pb.Signature) error {
+	if err := validateTimesheetReview(contract, review); err != nil {
+		return err
+	}
+	if err := verifyMessageSignature(
+		review,
+		contract.BuyerOrder.BuyerID.Pubkeys.Identity,
+		sigs,
+		pb.Signature_TIMESHEET_REVIEW,
+		contract.BuyerOrder.BuyerID.PeerID,
+	); err != nil {
+		switch err.(type) {
+		case noSigError:
+			return errors.New("contract does not contain a signature for the timesheet review")
+		case invalidSigError:
+			return errors.New("buyer's guid signature on timesheet review failed to verify")
+		case matchKeyError:
+			return errors.New("public key in order does not match reported buyer ID")
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+// verifyTimesheetSignatures checks every timesheet entry and review in the contract is signed
+// by the party which submitted it. Used by moderators to verify the hours worked in a dispute.
+func verifyTimesheetSignatures(contract *pb.RicardianContract) []string {
+	var validationErrors []string
+	var entrySigs, reviewSigs []*pb.Signature
+	for _, sig := range contract.Signatures {
+		switch sig.Section {
+		case pb.Signature_TIMESHEET_ENTRY:
+			entrySigs = append(entrySigs, sig)
+		case pb.Signature_TIMESHEET_REVIEW:
+			reviewSigs = append(reviewSigs, sig)
+		}
+	}
+	if len(entrySigs) < len(contract.VendorTimesheetEntries) {
+		validationErrors = append(validationErrors, "Not all timesheet entries are signed by the vendor")
+	}
+	if len(reviewSigs) < len(contract.BuyerTimesheetReviews) {
+		validationErrors = append(validationErrors, "Not all timesheet reviews are signed by the buyer")
+	}
+	vendorID := contract.VendorListings[0].VendorID
+	for i, entry := range contract.VendorTimesheetEntries {
+		if i >= len(entrySigs) {
+			break
+		}
+		if err := verifyMessageSignature(entry, vendorID.Pubkeys.Identity, []*pb.Signature{entrySigs[i]}, pb.Signature_TIMESHEET_ENTRY, vendorID.PeerID); err != nil {
+			validationErrors = append(validationErrors, fmt.Sprintf("Invalid vendor signature on timesheet entry %d", i))
+		}
+	}
+	buyerID := contract.BuyerOrder.BuyerID
+	for i, review := range contract.BuyerTimesheetReviews {
+		if i >= len(reviewSigs) {
+			break
+		}
+		if err := verifyMessageSignature(review, buyerID.Pubkeys.Identity, []*pb.Signature{reviewSigs[i]}, pb.Signature_TIMESHEET_REVIEW, buyerID.PeerID); err != nil {
+			validationErrors = append(validationErrors, fmt.Sprintf("Invalid buyer signature on timesheet review %d", i))
+		}
+	}
+	return validationErrors
+}
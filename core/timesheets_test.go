@@ -0,0 +1,68 @@
+package core_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/kimitzu/kimitzu-go/core"
+	"github.com/kimitzu/kimitzu-go/pb"
+)
+
+func newTimesheetEntry(t *testing.T, index uint32, start time.Time, d time.Duration) *pb.TimesheetEntry {
+	s, err := ptypes.TimestampProto(start)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := ptypes.TimestampProto(start.Add(d))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &pb.TimesheetEntry{Index: index, Start: s, End: e}
+}
+
+func TestApprovedTimesheetAmount(t *testing.T) {
+	start := time.Now().Add(-time.Hour * 48)
+	contract := &pb.RicardianContract{
+		BuyerOrder: &pb.Order{
+			BillingRate: &pb.Order_BillingRate{
+				RateMethod:     pb.Listing_Metadata_PER_HOUR,
+				UnitPrice:      10000,
+				EstimatedUnits: 10,
+			},
+		},
+		VendorTimesheetEntries: []*pb.TimesheetEntry{
+			newTimesheetEntry(t, 0, start, time.Hour*2),
+			newTimesheetEntry(t, 1, start.Add(time.Hour*3), time.Minute*90),
+			newTimesheetEntry(t, 2, start.Add(time.Hour*6), time.Hour),
+			newTimesheetEntry(t, 3, start.Add(time.Hour*8), time.Hour*4),
+		},
+		BuyerTimesheetReviews: []*pb.TimesheetReview{
+			{EntryIndex: 0, Status: pb.TimesheetReview_APPROVED},
+			{EntryIndex: 1, Status: pb.TimesheetReview_APPROVED},
+			{EntryIndex: 2, Status: pb.TimesheetReview_CONTESTED},
+		},
+	}
+
+	if !core.IsTimesheetOrder(contract) {
+		t.Fatal("expected order with a billing rate to be a timesheet order")
+	}
+	if s := core.TimesheetEntryStatus(contract, 3); s != pb.TimesheetReview_PENDING {
+		t.Errorf("expected unreviewed entry to be PENDING, got %s", s)
+	}
+	if d := core.TimesheetDuration(contract, pb.TimesheetReview_APPROVED); d != time.Minute*210 {
+		t.Errorf("expected 3.5 approved hours, got %s", d)
+	}
+	if d := core.TimesheetDuration(contract, pb.TimesheetReview_CONTESTED); d != time.Hour {
+		t.Errorf("expected 1 contested hour, got %s", d)
+	}
+	if amount := core.ApprovedTimesheetAmount(contract); amount != 35000 {
+		t.Errorf("expected approved amount of 35000, got %d", amount)
+	}
+
+	contract.BuyerOrder.BillingRate.RateMethod = pb.Listing_Metadata_PER_DAY
+	contract.BuyerOrder.BillingRate.UnitPrice = 48000
+	if amount := core.ApprovedTimesheetAmount(contract); amount != 7000 {
+		t.Errorf("expected approved amount of 7000, got %d", amount)
+	}
+}
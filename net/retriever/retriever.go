@@ -416,15 +416,28 @@ var MessageProcessingOrder = []pb.Message_MessageType{
 	pb.Message_ORDER,
 	pb.Message_ORDER_CANCEL,
 	pb.Message_ORDER_REJECT,
+	pb.Message_ORDER_AMENDMENT,
+	pb.Message_ORDER_AMENDMENT_RESPONSE,
 	pb.Message_ORDER_CONFIRMATION,
 	pb.Message_ORDER_PAYMENT,
+	pb.Message_TIMESHEET_ENTRY,
+	pb.Message_TIMESHEET_REVIEW,
+	pb.Message_MILESTONE_RELEASE,
+	pb.Message_PARTIAL_REFUND,
 	pb.Message_ORDER_FULFILLMENT,
 	pb.Message_ORDER_COMPLETION,
 	pb.Message_DISPUTE_OPEN,
 	pb.Message_DISPUTE_UPDATE,
+	pb.Message_DISPUTE_PANEL_VOTE,
+	pb.Message_DISPUTE_PANEL_PAYOUT,
+	pb.Message_DISPUTE_EVIDENCE,
+	pb.Message_CASE_MESSAGE,
 	pb.Message_VENDOR_FINALIZED_PAYMENT,
 	pb.Message_DISPUTE_CLOSE,
 	pb.Message_REFUND,
+	pb.Message_SUBSCRIPTION_UPDATE,
+	pb.Message_QUOTE_REQUEST,
+	pb.Message_QUOTE,
 	pb.Message_CHAT,
 	pb.Message_FOLLOW,
 	pb.Message_UNFOLLOW,
@@ -59,10 +59,36 @@ func (service *OpenBazaarService) HandlerForMsgType(t pb.Message_MessageType) fu
 		return service.handleOrderFulfillment
 	case pb.Message_ORDER_COMPLETION:
 		return service.handleOrderCompletion
+	case pb.Message_MILESTONE_RELEASE:
+		return service.handleMilestoneRelease
+	case pb.Message_PARTIAL_REFUND:
+		return service.handlePartialRefund
+	case pb.Message_TIMESHEET_ENTRY:
+		return service.handleTimesheetEntry
+	case pb.Message_TIMESHEET_REVIEW:
+		return service.handleTimesheetReview
+	case pb.Message_ORDER_AMENDMENT:
+		return service.handleOrderAmendment
+	case pb.Message_ORDER_AMENDMENT_RESPONSE:
+		return service.handleOrderAmendmentResponse
+	case pb.Message_SUBSCRIPTION_UPDATE:
+		return service.handleSubscriptionUpdate
+	case pb.Message_QUOTE_REQUEST:
+		return service.handleQuoteRequest
+	case pb.Message_QUOTE:
+		return service.handleQuote
 	case pb.Message_DISPUTE_OPEN:
 		return service.handleDisputeOpen
 	case pb.Message_DISPUTE_UPDATE:
 		return service.handleDisputeUpdate
+	case pb.Message_DISPUTE_PANEL_VOTE:
+		return service.handleDisputePanelVote
+	case pb.Message_DISPUTE_PANEL_PAYOUT:
+		return service.handleDisputePanelPayout
+	case pb.Message_DISPUTE_EVIDENCE:
+		return service.handleDisputeEvidence
+	case pb.Message_CASE_MESSAGE:
+		return service.handleCaseMessage
 	case pb.Message_DISPUTE_CLOSE:
 		return service.handleDisputeClose
 	case pb.Message_CHAT:
@@ -285,8 +311,16 @@ func (service *OpenBazaarService) handleOfflineRelay(p peer.ID, pmes *pb.Message
 func (service *OpenBazaarService) handleOrder(peer peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
 	offline, _ := options.(bool)
 	contract := new(pb.RicardianContract)
-	var orderId string
+	var (
+		orderId string
+		booked  bool
+	)
 	errorResponse := func(errMsg string) *pb.Message {
+		if booked {
+			if err := service.datastore.Appointments().Delete(orderId); err != nil {
+				log.Errorf("failed releasing appointment for order (%s): %s", orderId, err)
+			}
+		}
 		e := &pb.Error{
 			Code:         0,
 			ErrorMessage: errMsg,
@@ -332,6 +366,22 @@ func (service *OpenBazaarService) handleOrder(peer peer.ID, pmes *pb.Message, op
 	if err != nil && (err != core.ErrPurchaseUnknownListing || !offline) {
 		return errorResponse(err.Error()), err
 	}
+	if err := service.node.RecordSubscriptionSale(contract, orderId); err != nil {
+		log.Errorf("failed recording subscription for order (%s): %s", orderId, err)
+	}
+	if err := service.node.RecordQuoteSale(contract, orderId); err != nil {
+		log.Errorf("failed recording quote for order (%s): %s", orderId, err)
+	}
+	if err := service.node.BookAppointment(contract, orderId, true); err != nil {
+		if !offline {
+			return errorResponse(err.Error()), err
+		}
+		// An offline order may have been placed before the slot was taken.
+		// Keep it so the vendor can decline it.
+		log.Warningf("Order %s booked a time slot which is no longer available: %s", orderId, err)
+	} else {
+		booked = contract.BuyerOrder.Appointment != nil
+	}
 
 	wal, err := service.node.Multiwallet.WalletForCurrencyCode(contract.BuyerOrder.Payment.Coin)
 	if err != nil {
@@ -541,6 +591,7 @@ func (service *OpenBazaarService) handleOrderCancel(p peer.ID, pmes *pb.Message,
 
 	// Set message state to canceled
 	service.datastore.Sales().Put(orderId, *contract, pb.OrderState_CANCELED, false)
+	service.datastore.Appointments().Delete(orderId)
 
 	var thumbnailTiny string
 	var thumbnailSmall string
@@ -623,7 +674,7 @@ func (service *OpenBazaarService) handleReject(p peer.ID, pmes *pb.Message, opti
 			}
 		}
 
-		chaincode, err := hex.DecodeString(contract.BuyerOrder.Payment.Chaincode)
+		chaincode, err := hex.DecodeString(pb.OrderPayment(contract).Chaincode)
 		if err != nil {
 			return nil, err
 		}
@@ -635,7 +686,7 @@ func (service *OpenBazaarService) handleReject(p peer.ID, pmes *pb.Message, opti
 		if err != nil {
 			return nil, err
 		}
-		redeemScript, err := hex.DecodeString(contract.BuyerOrder.Payment.RedeemScript)
+		redeemScript, err := hex.DecodeString(pb.OrderPayment(contract).RedeemScript)
 		if err != nil {
 			return nil, err
 		}
@@ -671,7 +722,7 @@ func (service *OpenBazaarService) handleReject(p peer.ID, pmes *pb.Message, opti
 			Value:   outValue,
 		}
 
-		chaincode, err := hex.DecodeString(contract.BuyerOrder.Payment.Chaincode)
+		chaincode, err := hex.DecodeString(pb.OrderPayment(contract).Chaincode)
 		if err != nil {
 			return nil, err
 		}
@@ -683,7 +734,7 @@ func (service *OpenBazaarService) handleReject(p peer.ID, pmes *pb.Message, opti
 		if err != nil {
 			return nil, err
 		}
-		redeemScript, err := hex.DecodeString(contract.BuyerOrder.Payment.RedeemScript)
+		redeemScript, err := hex.DecodeString(pb.OrderPayment(contract).RedeemScript)
 		if err != nil {
 			return nil, err
 		}
@@ -697,7 +748,7 @@ func (service *OpenBazaarService) handleReject(p peer.ID, pmes *pb.Message, opti
 			sig := wallet.Signature{InputIndex: s.InputIndex, Signature: s.Signature}
 			vendorSignatures = append(vendorSignatures, sig)
 		}
-		_, err = wal.Multisign(ins, []wallet.TransactionOutput{output}, buyerSignatures, vendorSignatures, redeemScript, contract.BuyerOrder.RefundFee, true)
+		err = core.MultisignEscrow(wal, pb.OrderPayment(contract), ins, []wallet.TransactionOutput{output}, buyerSignatures, vendorSignatures, redeemScript, contract.BuyerOrder.RefundFee)
 		if err != nil {
 			return nil, err
 		}
@@ -705,6 +756,7 @@ func (service *OpenBazaarService) handleReject(p peer.ID, pmes *pb.Message, opti
 
 	// Set message state to rejected
 	service.datastore.Purchases().Put(rejectMsg.OrderID, *contract, pb.OrderState_DECLINED, false)
+	service.datastore.Appointments().Delete(rejectMsg.OrderID)
 
 	var thumbnailTiny string
 	var thumbnailSmall string
@@ -763,7 +815,7 @@ func (service *OpenBazaarService) handleRefund(p peer.ID, pmes *pb.Message, opti
 		return nil, net.OutOfOrderMessage
 	}
 
-	if !(state == pb.OrderState_PARTIALLY_FULFILLED || state == pb.OrderState_AWAITING_FULFILLMENT) {
+	if !(state == pb.OrderState_PARTIALLY_FULFILLED || state == pb.OrderState_AWAITING_FULFILLMENT || state == pb.OrderState_PARTIALLY_REFUNDED) {
 		return nil, net.DuplicateMessage
 	}
 
@@ -796,7 +848,7 @@ func (service *OpenBazaarService) handleRefund(p peer.ID, pmes *pb.Message, opti
 			Value:   outValue,
 		}
 
-		chaincode, err := hex.DecodeString(contract.BuyerOrder.Payment.Chaincode)
+		chaincode, err := hex.DecodeString(pb.OrderPayment(contract).Chaincode)
 		if err != nil {
 			return nil, err
 		}
@@ -808,7 +860,7 @@ func (service *OpenBazaarService) handleRefund(p peer.ID, pmes *pb.Message, opti
 		if err != nil {
 			return nil, err
 		}
-		redeemScript, err := hex.DecodeString(contract.BuyerOrder.Payment.RedeemScript)
+		redeemScript, err := hex.DecodeString(pb.OrderPayment(contract).RedeemScript)
 		if err != nil {
 			return nil, err
 		}
@@ -822,7 +874,7 @@ func (service *OpenBazaarService) handleRefund(p peer.ID, pmes *pb.Message, opti
 			sig := wallet.Signature{InputIndex: s.InputIndex, Signature: s.Signature}
 			vendorSignatures = append(vendorSignatures, sig)
 		}
-		_, err = wal.Multisign(ins, []wallet.TransactionOutput{output}, buyerSignatures, vendorSignatures, redeemScript, contract.BuyerOrder.RefundFee, true)
+		err = core.MultisignEscrow(wal, pb.OrderPayment(contract), ins, []wallet.TransactionOutput{output}, buyerSignatures, vendorSignatures, redeemScript, contract.BuyerOrder.RefundFee)
 		if err != nil {
 			return nil, err
 		}
@@ -836,6 +888,8 @@ func (service *OpenBazaarService) handleRefund(p peer.ID, pmes *pb.Message, opti
 
 	// Set message state to refunded
 	service.datastore.Purchases().Put(contract.Refund.OrderID, *contract, pb.OrderState_REFUNDED, false)
+	service.datastore.Appointments().Delete(contract.Refund.OrderID)
+	core.RecordExchangeRates(service.datastore, service.node.Multiwallet, contract.BuyerOrder.Payment.Coin, repo.ExchangeRateReasonRefunded, contract.Refund.OrderID)
 
 	var thumbnailTiny string
 	var thumbnailSmall string
@@ -895,7 +949,7 @@ func (service *OpenBazaarService) handleOrderFulfillment(p peer.ID, pmes *pb.Mes
 		return nil, net.OutOfOrderMessage
 	}
 
-	if !(state == pb.OrderState_PARTIALLY_FULFILLED || state == pb.OrderState_AWAITING_FULFILLMENT) {
+	if !(state == pb.OrderState_PARTIALLY_FULFILLED || state == pb.OrderState_AWAITING_FULFILLMENT || state == pb.OrderState_PARTIALLY_REFUNDED || state == pb.OrderState_MILESTONE_FUNDED || state == pb.OrderState_MILESTONE_RELEASED) {
 		return nil, net.DuplicateMessage
 	}
 
@@ -911,7 +965,9 @@ func (service *OpenBazaarService) handleOrderFulfillment(p peer.ID, pmes *pb.Mes
 	}
 
 	// Set message state to fulfilled if all listings have a matching fulfillment message
-	if service.node.IsFulfilled(contract) {
+	if core.IsMilestoneOrder(contract) && !core.IsFinalMilestone(contract, rc.VendorOrderFulfillment[0].MilestoneIndex) {
+		service.datastore.Purchases().Put(rc.VendorOrderFulfillment[0].OrderId, *contract, pb.OrderState_MILESTONE_FULFILLED, false)
+	} else if service.node.IsFulfilled(contract) {
 		service.datastore.Purchases().Put(rc.VendorOrderFulfillment[0].OrderId, *contract, pb.OrderState_FULFILLED, false)
 	} else {
 		service.datastore.Purchases().Put(rc.VendorOrderFulfillment[0].OrderId, *contract, pb.OrderState_PARTIALLY_FULFILLED, false)
@@ -1005,25 +1061,25 @@ func (service *OpenBazaarService) handleOrderCompletion(p peer.ID, pmes *pb.Mess
 		}
 		var payoutAddress btcutil.Address
 		if len(contract.VendorOrderFulfillment) > 0 {
-			payoutAddress, err = wal.DecodeAddress(contract.VendorOrderFulfillment[0].Payout.PayoutAddress)
+			payoutAddress, err = wal.DecodeAddress(core.PayoutFulfillment(contract).Payout.PayoutAddress)
 			if err != nil {
 				return nil, err
 			}
 		} else {
 			payoutAddress = wal.CurrentAddress(wallet.EXTERNAL)
 		}
-		var output = wallet.TransactionOutput{
-			Address: payoutAddress,
-			Value:   outValue,
+		outputs, err := core.PayoutOutputs(wal, contract, outValue, payoutAddress, core.PayoutFulfillment(contract).Payout.PayoutFeePerByte)
+		if err != nil {
+			return nil, err
 		}
 
-		redeemScript, err := hex.DecodeString(contract.BuyerOrder.Payment.RedeemScript)
+		redeemScript, err := hex.DecodeString(pb.OrderPayment(contract).RedeemScript)
 		if err != nil {
 			return nil, err
 		}
 
 		var vendorSignatures []wallet.Signature
-		for _, s := range contract.VendorOrderFulfillment[0].Payout.Sigs {
+		for _, s := range core.PayoutFulfillment(contract).Payout.Sigs {
 			sig := wallet.Signature{InputIndex: s.InputIndex, Signature: s.Signature}
 			vendorSignatures = append(vendorSignatures, sig)
 		}
@@ -1033,7 +1089,7 @@ func (service *OpenBazaarService) handleOrderCompletion(p peer.ID, pmes *pb.Mess
 			buyerSignatures = append(buyerSignatures, sig)
 		}
 
-		_, err = wal.Multisign(ins, []wallet.TransactionOutput{output}, buyerSignatures, vendorSignatures, redeemScript, contract.VendorOrderFulfillment[0].Payout.PayoutFeePerByte, true)
+		err = core.MultisignEscrow(wal, pb.OrderPayment(contract), ins, outputs, buyerSignatures, vendorSignatures, redeemScript, core.PayoutFulfillment(contract).Payout.PayoutFeePerByte)
 		if err != nil {
 			return nil, err
 		}
@@ -1046,6 +1102,8 @@ func (service *OpenBazaarService) handleOrderCompletion(p peer.ID, pmes *pb.Mess
 
 	// Set message state to complete
 	service.datastore.Sales().Put(rc.BuyerOrderCompletion.OrderId, *contract, pb.OrderState_COMPLETED, false)
+	core.RecordExchangeRates(service.datastore, service.node.Multiwallet, contract.BuyerOrder.Payment.Coin, repo.ExchangeRateReasonReleased, rc.BuyerOrderCompletion.OrderId)
+	service.node.RefreshInvoice(rc.BuyerOrderCompletion.OrderId)
 
 	var thumbnailTiny string
 	var thumbnailSmall string
@@ -1075,6 +1133,641 @@ func (service *OpenBazaarService) handleOrderCompletion(p peer.ID, pmes *pb.Mess
 	return nil, nil
 }
 
+func (service *OpenBazaarService) handleMilestoneRelease(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
+	if pmes.Payload == nil {
+		return nil, ErrEmptyPayload
+	}
+	rc := new(pb.RicardianContract)
+	err := ptypes.UnmarshalAny(pmes.Payload, rc)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rc.BuyerMilestoneReleases) == 0 {
+		return nil, errors.New("received MILESTONE_RELEASE message with no BuyerMilestoneReleases objects")
+	}
+	release := rc.BuyerMilestoneReleases[0]
+
+	// Load the order
+	contract, state, _, records, _, _, err := service.datastore.Sales().GetByOrderId(release.OrderId)
+	if err != nil {
+		if err := service.SendProcessingError(p.Pretty(), release.OrderId, pb.Message_MILESTONE_RELEASE, nil); err != nil {
+			log.Errorf("failed sending ORDER_PROCESSING_FAILURE to peer (%s): %s", p.Pretty(), err)
+		}
+		return nil, net.OutOfOrderMessage
+	}
+
+	if int(release.MilestoneIndex) < len(contract.BuyerMilestoneReleases) {
+		return nil, net.DuplicateMessage
+	}
+	if state != pb.OrderState_MILESTONE_FULFILLED {
+		if err := service.SendProcessingError(p.Pretty(), release.OrderId, pb.Message_MILESTONE_RELEASE, contract); err != nil {
+			log.Errorf("failed sending ORDER_PROCESSING_FAILURE to peer (%s): %s", p.Pretty(), err)
+		}
+		return nil, net.OutOfOrderMessage
+	}
+
+	if err := service.node.ValidateMilestoneRelease(contract, release, rc.Signatures); err != nil {
+		return nil, err
+	}
+
+	contract.BuyerMilestoneReleases = append(contract.BuyerMilestoneReleases, release)
+	for _, sig := range rc.Signatures {
+		if sig.Section == pb.Signature_MILESTONE_RELEASE {
+			contract.Signatures = append(contract.Signatures, sig)
+		}
+	}
+
+	// The next milestone may already be covered by funds the buyer sent earlier
+	funded := core.MilestoneFunded(contract, records)
+	if funded {
+		service.datastore.Sales().Put(release.OrderId, *contract, pb.OrderState_MILESTONE_FUNDED, false)
+	} else {
+		service.datastore.Sales().Put(release.OrderId, *contract, pb.OrderState_MILESTONE_RELEASED, false)
+	}
+	service.datastore.Sales().UpdateFunding(release.OrderId, funded, records)
+	core.RecordExchangeRates(service.datastore, service.node.Multiwallet, contract.BuyerOrder.Payment.Coin, repo.ExchangeRateReasonReleased, release.OrderId)
+	service.node.RefreshInvoice(release.OrderId)
+
+	var thumbnailTiny string
+	var thumbnailSmall string
+	var buyerID string
+	var buyerHandle string
+	if len(contract.VendorListings) > 0 && contract.VendorListings[0].Item != nil && len(contract.VendorListings[0].Item.Images) > 0 {
+		thumbnailTiny = contract.VendorListings[0].Item.Images[0].Tiny
+		thumbnailSmall = contract.VendorListings[0].Item.Images[0].Small
+		if contract.BuyerOrder != nil && contract.BuyerOrder.BuyerID != nil {
+			buyerID = contract.BuyerOrder.BuyerID.PeerID
+			buyerHandle = contract.BuyerOrder.BuyerID.Handle
+		}
+	}
+
+	// Send notification to websocket
+	n := repo.MilestoneReleasedNotification{
+		ID:             repo.NewNotificationID(),
+		Type:           repo.NotifierTypeMilestoneReleasedNotification,
+		OrderId:        release.OrderId,
+		MilestoneIndex: release.MilestoneIndex,
+		Title:          contract.BuyerOrder.Milestones[release.MilestoneIndex].Title,
+		Thumbnail:      repo.Thumbnail{Tiny: thumbnailTiny, Small: thumbnailSmall},
+		BuyerHandle:    buyerHandle,
+		BuyerID:        buyerID,
+	}
+	service.broadcast <- n
+	service.datastore.Notifications().PutRecord(repo.NewNotification(n, time.Now(), false))
+	log.Debugf("received MILESTONE_RELEASE message from %s", p.Pretty())
+	return nil, nil
+}
+
+func (service *OpenBazaarService) handlePartialRefund(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
+	if pmes.Payload == nil {
+		return nil, ErrEmptyPayload
+	}
+	rc := new(pb.RicardianContract)
+	err := ptypes.UnmarshalAny(pmes.Payload, rc)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rc.PartialRefunds) == 0 {
+		return nil, errors.New("received PARTIAL_REFUND message with no PartialRefunds objects")
+	}
+	refund := rc.PartialRefunds[0]
+
+	// Load the order
+	contract, state, _, records, _, _, err := service.datastore.Purchases().GetByOrderId(refund.OrderID)
+	if err != nil {
+		if err := service.SendProcessingError(p.Pretty(), refund.OrderID, pb.Message_PARTIAL_REFUND, nil); err != nil {
+			log.Errorf("failed sending ORDER_PROCESSING_FAILURE to peer (%s): %s", p.Pretty(), err)
+		}
+		return nil, net.OutOfOrderMessage
+	}
+
+	if int(refund.Index) < len(contract.PartialRefunds) {
+		return nil, net.DuplicateMessage
+	}
+	if !(state == pb.OrderState_AWAITING_FULFILLMENT || state == pb.OrderState_PARTIALLY_FULFILLED || state == pb.OrderState_PARTIALLY_REFUNDED) {
+		if err := service.SendProcessingError(p.Pretty(), refund.OrderID, pb.Message_PARTIAL_REFUND, contract); err != nil {
+			log.Errorf("failed sending ORDER_PROCESSING_FAILURE to peer (%s): %s", p.Pretty(), err)
+		}
+		return nil, net.OutOfOrderMessage
+	}
+
+	if err := service.node.ValidatePartialRefund(contract, refund, rc.Signatures); err != nil {
+		return nil, err
+	}
+	if err := service.node.AcceptPartialRefund(contract, records, refund); err != nil {
+		return nil, err
+	}
+
+	contract.PartialRefunds = append(contract.PartialRefunds, refund)
+	for _, sig := range rc.Signatures {
+		if sig.Section == pb.Signature_PARTIAL_REFUND {
+			contract.Signatures = append(contract.Signatures, sig)
+		}
+	}
+	service.datastore.Purchases().Put(refund.OrderID, *contract, pb.OrderState_PARTIALLY_REFUNDED, false)
+	core.RecordExchangeRates(service.datastore, service.node.Multiwallet, contract.BuyerOrder.Payment.Coin, repo.ExchangeRateReasonRefunded, refund.OrderID)
+
+	var thumbnailTiny string
+	var thumbnailSmall string
+	var vendorID string
+	var vendorHandle string
+	if len(contract.VendorListings) > 0 && contract.VendorListings[0].Item != nil && len(contract.VendorListings[0].Item.Images) > 0 {
+		thumbnailTiny = contract.VendorListings[0].Item.Images[0].Tiny
+		thumbnailSmall = contract.VendorListings[0].Item.Images[0].Small
+		if contract.VendorListings[0].VendorID != nil {
+			vendorID = contract.VendorListings[0].VendorID.PeerID
+			vendorHandle = contract.VendorListings[0].VendorID.Handle
+		}
+	}
+
+	// Send notification to websocket
+	n := repo.PartialRefundNotification{
+		ID:           repo.NewNotificationID(),
+		Type:         repo.NotifierTypePartialRefundNotification,
+		OrderId:      refund.OrderID,
+		Amount:       refund.Amount,
+		Remaining:    pb.OrderPayment(contract).Amount - core.RefundedAmount(contract),
+		CurrencyCode: contract.BuyerOrder.Payment.Coin,
+		Memo:         refund.Memo,
+		Thumbnail:    repo.Thumbnail{Tiny: thumbnailTiny, Small: thumbnailSmall},
+		VendorHandle: vendorHandle,
+		VendorID:     vendorID,
+	}
+	service.broadcast <- n
+	service.datastore.Notifications().PutRecord(repo.NewNotification(n, time.Now(), false))
+	log.Debugf("received PARTIAL_REFUND message from %s", p.Pretty())
+	return nil, nil
+}
+
+func (service *OpenBazaarService) handleTimesheetEntry(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
+	if pmes.Payload == nil {
+		return nil, ErrEmptyPayload
+	}
+	rc := new(pb.RicardianContract)
+	err := ptypes.UnmarshalAny(pmes.Payload, rc)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rc.VendorTimesheetEntries) == 0 {
+		return nil, errors.New("received TIMESHEET_ENTRY message with no VendorTimesheetEntries objects")
+	}
+	entry := rc.VendorTimesheetEntries[0]
+
+	// Load the order
+	contract, state, _, _, _, _, err := service.datastore.Purchases().GetByOrderId(entry.OrderId)
+	if err != nil {
+		if err := service.SendProcessingError(p.Pretty(), entry.OrderId, pb.Message_TIMESHEET_ENTRY, nil); err != nil {
+			log.Errorf("failed sending ORDER_PROCESSING_FAILURE to peer (%s): %s", p.Pretty(), err)
+		}
+		return nil, net.OutOfOrderMessage
+	}
+
+	if int(entry.Index) < len(contract.VendorTimesheetEntries) {
+		return nil, net.DuplicateMessage
+	}
+
+	if err := service.node.ValidateTimesheetEntry(contract, entry, rc.Signatures); err != nil {
+		return nil, err
+	}
+
+	contract.VendorTimesheetEntries = append(contract.VendorTimesheetEntries, entry)
+	for _, sig := range rc.Signatures {
+		if sig.Section == pb.Signature_TIMESHEET_ENTRY {
+			contract.Signatures = append(contract.Signatures, sig)
+		}
+	}
+	service.datastore.Purchases().Put(entry.OrderId, *contract, state, false)
+
+	var thumbnailTiny string
+	var thumbnailSmall string
+	var vendorHandle string
+	if len(contract.VendorListings) > 0 && contract.VendorListings[0].Item != nil && len(contract.VendorListings[0].Item.Images) > 0 {
+		thumbnailTiny = contract.VendorListings[0].Item.Images[0].Tiny
+		thumbnailSmall = contract.VendorListings[0].Item.Images[0].Small
+		vendorHandle = contract.VendorListings[0].VendorID.Handle
+	}
+	duration, _ := core.TimesheetEntryDuration(entry)
+
+	// Send notification to websocket
+	n := repo.TimesheetEntryNotification{
+		ID:           repo.NewNotificationID(),
+		Type:         repo.NotifierTypeTimesheetEntryNotification,
+		OrderId:      entry.OrderId,
+		EntryIndex:   entry.Index,
+		Hours:        duration.Hours(),
+		Thumbnail:    repo.Thumbnail{Tiny: thumbnailTiny, Small: thumbnailSmall},
+		VendorHandle: vendorHandle,
+		VendorID:     p.Pretty(),
+	}
+	service.broadcast <- n
+	service.datastore.Notifications().PutRecord(repo.NewNotification(n, time.Now(), false))
+	log.Debugf("received TIMESHEET_ENTRY message from %s", p.Pretty())
+	return nil, nil
+}
+
+func (service *OpenBazaarService) handleTimesheetReview(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
+	if pmes.Payload == nil {
+		return nil, ErrEmptyPayload
+	}
+	rc := new(pb.RicardianContract)
+	err := ptypes.UnmarshalAny(pmes.Payload, rc)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rc.BuyerTimesheetReviews) == 0 {
+		return nil, errors.New("received TIMESHEET_REVIEW message with no BuyerTimesheetReviews objects")
+	}
+	review := rc.BuyerTimesheetReviews[0]
+
+	// Load the order
+	contract, state, _, _, _, _, err := service.datastore.Sales().GetByOrderId(review.OrderId)
+	if err != nil {
+		if err := service.SendProcessingError(p.Pretty(), review.OrderId, pb.Message_TIMESHEET_REVIEW, nil); err != nil {
+			log.Errorf("failed sending ORDER_PROCESSING_FAILURE to peer (%s): %s", p.Pretty(), err)
+		}
+		return nil, net.OutOfOrderMessage
+	}
+
+	if int(review.EntryIndex) < len(contract.VendorTimesheetEntries) &&
+		core.TimesheetEntryStatus(contract, review.EntryIndex) != pb.TimesheetReview_PENDING {
+		return nil, net.DuplicateMessage
+	}
+
+	if err := service.node.ValidateTimesheetReview(contract, review, rc.Signatures); err != nil {
+		return nil, err
+	}
+
+	contract.BuyerTimesheetReviews = append(contract.BuyerTimesheetReviews, review)
+	for _, sig := range rc.Signatures {
+		if sig.Section == pb.Signature_TIMESHEET_REVIEW {
+			contract.Signatures = append(contract.Signatures, sig)
+		}
+	}
+	service.datastore.Sales().Put(review.OrderId, *contract, state, false)
+
+	var thumbnailTiny string
+	var thumbnailSmall string
+	var buyerID string
+	var buyerHandle string
+	if len(contract.VendorListings) > 0 && contract.VendorListings[0].Item != nil && len(contract.VendorListings[0].Item.Images) > 0 {
+		thumbnailTiny = contract.VendorListings[0].Item.Images[0].Tiny
+		thumbnailSmall = contract.VendorListings[0].Item.Images[0].Small
+		if contract.BuyerOrder != nil && contract.BuyerOrder.BuyerID != nil {
+			buyerID = contract.BuyerOrder.BuyerID.PeerID
+			buyerHandle = contract.BuyerOrder.BuyerID.Handle
+		}
+	}
+
+	// Send notification to websocket
+	n := repo.TimesheetReviewNotification{
+		ID:          repo.NewNotificationID(),
+		Type:        repo.NotifierTypeTimesheetReviewNotification,
+		OrderId:     review.OrderId,
+		EntryIndex:  review.EntryIndex,
+		Status:      review.Status.String(),
+		Thumbnail:   repo.Thumbnail{Tiny: thumbnailTiny, Small: thumbnailSmall},
+		BuyerHandle: buyerHandle,
+		BuyerID:     buyerID,
+	}
+	service.broadcast <- n
+	service.datastore.Notifications().PutRecord(repo.NewNotification(n, time.Now(), false))
+	log.Debugf("received TIMESHEET_REVIEW message from %s", p.Pretty())
+	return nil, nil
+}
+
+func (service *OpenBazaarService) handleOrderAmendment(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
+	if pmes.Payload == nil {
+		return nil, ErrEmptyPayload
+	}
+	rc := new(pb.RicardianContract)
+	err := ptypes.UnmarshalAny(pmes.Payload, rc)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rc.OrderAmendments) == 0 || rc.OrderAmendments[0].Payment == nil {
+		return nil, errors.New("received ORDER_AMENDMENT message with no OrderAmendments objects")
+	}
+	amendment := rc.OrderAmendments[0]
+
+	// Load the order. Vendors propose amendments to our purchases and buyers to our sales.
+	var orders orderStore = service.datastore.Sales()
+	if amendment.Proposer == pb.OrderAmendment_VENDOR {
+		orders = service.datastore.Purchases()
+	}
+	contract, state, _, _, _, _, err := orders.GetByOrderId(amendment.OrderId)
+	if err != nil {
+		if err := service.SendProcessingError(p.Pretty(), amendment.OrderId, pb.Message_ORDER_AMENDMENT, nil); err != nil {
+			log.Errorf("failed sending ORDER_PROCESSING_FAILURE to peer (%s): %s", p.Pretty(), err)
+		}
+		return nil, net.OutOfOrderMessage
+	}
+
+	if int(amendment.Index) < len(contract.OrderAmendments) {
+		return nil, net.DuplicateMessage
+	}
+
+	if err := service.node.ValidateOrderAmendment(contract, state, amendment, rc.Signatures); err != nil {
+		return nil, err
+	}
+
+	contract.OrderAmendments = append(contract.OrderAmendments, amendment)
+	for _, sig := range rc.Signatures {
+		if sig.Section == pb.Signature_ORDER_AMENDMENT {
+			contract.Signatures = append(contract.Signatures, sig)
+		}
+	}
+	orders.Put(amendment.OrderId, *contract, state, false)
+
+	var thumbnailTiny string
+	var thumbnailSmall string
+	if len(contract.VendorListings) > 0 && contract.VendorListings[0].Item != nil && len(contract.VendorListings[0].Item.Images) > 0 {
+		thumbnailTiny = contract.VendorListings[0].Item.Images[0].Tiny
+		thumbnailSmall = contract.VendorListings[0].Item.Images[0].Small
+	}
+	var deliveryDate *repo.APITime
+	if amendment.DeliveryDate != nil {
+		date, _ := ptypes.Timestamp(amendment.DeliveryDate)
+		deliveryDate = repo.NewAPITime(date)
+	}
+
+	// Send notification to websocket
+	n := repo.OrderAmendmentNotification{
+		ID:             repo.NewNotificationID(),
+		Type:           repo.NotifierTypeOrderAmendmentNotification,
+		OrderId:        amendment.OrderId,
+		AmendmentIndex: amendment.Index,
+		Price:          amendment.Payment.Amount,
+		Coin:           amendment.Payment.Coin,
+		Quantity:       amendment.Quantity,
+		DeliveryDate:   deliveryDate,
+		Memo:           amendment.Memo,
+		Thumbnail:      repo.Thumbnail{Tiny: thumbnailTiny, Small: thumbnailSmall},
+		PeerHandle:     orderPartyHandle(contract, amendment.Proposer),
+		PeerID:         p.Pretty(),
+	}
+	service.broadcast <- n
+	service.datastore.Notifications().PutRecord(repo.NewNotification(n, time.Now(), false))
+	log.Debugf("received ORDER_AMENDMENT message from %s", p.Pretty())
+	return nil, nil
+}
+
+func (service *OpenBazaarService) handleOrderAmendmentResponse(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
+	if pmes.Payload == nil {
+		return nil, ErrEmptyPayload
+	}
+	rc := new(pb.RicardianContract)
+	err := ptypes.UnmarshalAny(pmes.Payload, rc)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rc.OrderAmendmentResponses) == 0 {
+		return nil, errors.New("received ORDER_AMENDMENT_RESPONSE message with no OrderAmendmentResponses objects")
+	}
+	response := rc.OrderAmendmentResponses[0]
+
+	// Load the order. Either side may have proposed the amendment being answered.
+	var orders orderStore = service.datastore.Purchases()
+	contract, state, _, _, _, _, err := orders.GetByOrderId(response.OrderId)
+	if err != nil {
+		orders = service.datastore.Sales()
+		contract, state, _, _, _, _, err = orders.GetByOrderId(response.OrderId)
+	}
+	if err != nil {
+		if err := service.SendProcessingError(p.Pretty(), response.OrderId, pb.Message_ORDER_AMENDMENT_RESPONSE, nil); err != nil {
+			log.Errorf("failed sending ORDER_PROCESSING_FAILURE to peer (%s): %s", p.Pretty(), err)
+		}
+		return nil, net.OutOfOrderMessage
+	}
+
+	if int(response.AmendmentIndex) < len(contract.OrderAmendments) &&
+		core.OrderAmendmentStatus(contract, response.AmendmentIndex) != "PENDING" {
+		return nil, net.DuplicateMessage
+	}
+
+	if err := service.node.ValidateOrderAmendmentResponse(contract, state, response, rc.Signatures); err != nil {
+		return nil, err
+	}
+
+	contract.OrderAmendmentResponses = append(contract.OrderAmendmentResponses, response)
+	for _, sig := range rc.Signatures {
+		if sig.Section == pb.Signature_ORDER_AMENDMENT_RESPONSE {
+			contract.Signatures = append(contract.Signatures, sig)
+		}
+	}
+	if response.Accepted {
+		if err := service.node.WatchAmendedPayment(contract); err != nil {
+			return nil, err
+		}
+	}
+	orders.Put(response.OrderId, *contract, state, false)
+
+	var thumbnailTiny string
+	var thumbnailSmall string
+	if len(contract.VendorListings) > 0 && contract.VendorListings[0].Item != nil && len(contract.VendorListings[0].Item.Images) > 0 {
+		thumbnailTiny = contract.VendorListings[0].Item.Images[0].Tiny
+		thumbnailSmall = contract.VendorListings[0].Item.Images[0].Small
+	}
+	// The response comes from the party which did not propose the amendment
+	responder := pb.OrderAmendment_BUYER
+	if contract.OrderAmendments[response.AmendmentIndex].Proposer == pb.OrderAmendment_BUYER {
+		responder = pb.OrderAmendment_VENDOR
+	}
+
+	// Send notification to websocket
+	n := repo.OrderAmendmentResponseNotification{
+		ID:             repo.NewNotificationID(),
+		Type:           repo.NotifierTypeOrderAmendmentResponse,
+		OrderId:        response.OrderId,
+		AmendmentIndex: response.AmendmentIndex,
+		Accepted:       response.Accepted,
+		Reason:         response.Reason,
+		Thumbnail:      repo.Thumbnail{Tiny: thumbnailTiny, Small: thumbnailSmall},
+		PeerHandle:     orderPartyHandle(contract, responder),
+		PeerID:         p.Pretty(),
+	}
+	service.broadcast <- n
+	service.datastore.Notifications().PutRecord(repo.NewNotification(n, time.Now(), false))
+	log.Debugf("received ORDER_AMENDMENT_RESPONSE message from %s", p.Pretty())
+	return nil, nil
+}
+
+// orderStore is the part of the sale and purchase stores needed to update
+// an order from either side
+type orderStore interface {
+	GetByOrderId(orderId string) (*pb.RicardianContract, pb.OrderState, bool, []*wallet.TransactionRecord, bool, *repo.CurrencyCode, error)
+	Put(orderID string, contract pb.RicardianContract, state pb.OrderState, read bool) error
+}
+
+// orderPartyHandle returns the handle of the given party to the order
+func orderPartyHandle(contract *pb.RicardianContract, party pb.OrderAmendment_Party) string {
+	if party == pb.OrderAmendment_VENDOR {
+		if len(contract.VendorListings) > 0 && contract.VendorListings[0].VendorID != nil {
+			return contract.VendorListings[0].VendorID.Handle
+		}
+		return ""
+	}
+	if contract.BuyerOrder != nil && contract.BuyerOrder.BuyerID != nil {
+		return contract.BuyerOrder.BuyerID.Handle
+	}
+	return ""
+}
+
+func (service *OpenBazaarService) handleSubscriptionUpdate(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
+	if pmes.Payload == nil {
+		return nil, ErrEmptyPayload
+	}
+	update := new(pb.SubscriptionUpdate)
+	err := ptypes.UnmarshalAny(pmes.Payload, update)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := service.datastore.Subscriptions().Get(update.SubscriptionID)
+	if err != nil {
+		return nil, net.OutOfOrderMessage
+	}
+	if !record.IsSale || record.PeerID != p.Pretty() {
+		return nil, errors.New("subscription update was not sent by the subscriber")
+	}
+	if record.State == update.State {
+		return nil, net.DuplicateMessage
+	}
+	if record.State == pb.Subscription_CANCELED || record.State == pb.Subscription_COMPLETED {
+		return nil, core.ErrSubscriptionClosed
+	}
+
+	var notifierType repo.NotificationType
+	switch update.State {
+	case pb.Subscription_PAUSED:
+		notifierType = repo.NotifierTypeSubscriptionPaused
+	case pb.Subscription_ACTIVE:
+		notifierType = repo.NotifierTypeSubscriptionResumed
+	case pb.Subscription_CANCELED:
+		notifierType = repo.NotifierTypeSubscriptionCanceled
+	default:
+		return nil, fmt.Errorf("subscription cannot be set to %s", update.State)
+	}
+	record.State = update.State
+	if err := service.datastore.Subscriptions().Put(record); err != nil {
+		return nil, err
+	}
+
+	n := repo.SubscriptionUpdateNotification{
+		ID:             repo.NewNotificationID(),
+		Type:           notifierType,
+		SubscriptionID: update.SubscriptionID,
+		Slug:           record.Subscription.ListingSlug,
+		BuyerID:        record.PeerID,
+	}
+	service.broadcast <- n
+	service.datastore.Notifications().PutRecord(repo.NewNotification(n, time.Now(), false))
+	log.Debugf("received SUBSCRIPTION_UPDATE message from %s", p.Pretty())
+	return nil, nil
+}
+
+func (service *OpenBazaarService) handleQuoteRequest(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
+	if pmes.Payload == nil {
+		return nil, ErrEmptyPayload
+	}
+	signed := new(pb.SignedQuoteRequest)
+	err := ptypes.UnmarshalAny(pmes.Payload, signed)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := service.node.ValidateQuoteRequest(p.Pretty(), signed); err != nil {
+		return nil, err
+	}
+	requestID, err := core.QuoteRequestID(signed.Request)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := service.datastore.Quotes().Get(requestID); err == nil {
+		return nil, net.DuplicateMessage
+	}
+	record := &repo.QuoteRecord{
+		RequestID: requestID,
+		History:   &pb.QuoteHistory{Request: signed},
+		IsSale:    true,
+		PeerID:    p.Pretty(),
+		Timestamp: time.Now(),
+	}
+	if err := service.datastore.Quotes().Put(record); err != nil {
+		return nil, err
+	}
+
+	n := repo.QuoteRequestNotification{
+		ID:          repo.NewNotificationID(),
+		Type:        repo.NotifierTypeQuoteRequestNotification,
+		RequestID:   requestID,
+		Slug:        signed.Request.ListingSlug,
+		BuyerHandle: signed.Request.BuyerID.Handle,
+		BuyerID:     p.Pretty(),
+	}
+	service.broadcast <- n
+	service.datastore.Notifications().PutRecord(repo.NewNotification(n, time.Now(), false))
+	log.Debugf("received QUOTE_REQUEST message from %s", p.Pretty())
+	return nil, nil
+}
+
+func (service *OpenBazaarService) handleQuote(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
+	if pmes.Payload == nil {
+		return nil, ErrEmptyPayload
+	}
+	signed := new(pb.SignedQuote)
+	err := ptypes.UnmarshalAny(pmes.Payload, signed)
+	if err != nil {
+		return nil, err
+	}
+	if signed.Quote == nil {
+		return nil, errors.New("received QUOTE message with no quote")
+	}
+
+	record, err := service.datastore.Quotes().Get(signed.Quote.RequestID)
+	if err != nil {
+		return nil, errors.New("quote is for an unknown request")
+	}
+	if int(signed.Quote.Revision) < len(record.History.Quotes) {
+		return nil, net.DuplicateMessage
+	}
+	if record.OrderID != "" {
+		return nil, core.ErrQuoteOrdered
+	}
+	if err := core.ValidateQuote(record, p.Pretty(), signed); err != nil {
+		return nil, err
+	}
+	record.History.Quotes = append(record.History.Quotes, signed)
+	record.Timestamp = time.Now()
+	if err := service.datastore.Quotes().Put(record); err != nil {
+		return nil, err
+	}
+
+	quote := signed.Quote
+	expiry, _ := ptypes.Timestamp(quote.Expiry)
+	n := repo.QuoteNotification{
+		ID:           repo.NewNotificationID(),
+		Type:         repo.NotifierTypeQuoteNotification,
+		RequestID:    quote.RequestID,
+		Slug:         record.Request().ListingSlug,
+		Revision:     quote.Revision,
+		Price:        quote.Price,
+		Currency:     quote.PricingCurrency,
+		Expiry:       repo.NewAPITime(expiry),
+		VendorHandle: quote.VendorID.Handle,
+		VendorID:     p.Pretty(),
+	}
+	service.broadcast <- n
+	service.datastore.Notifications().PutRecord(repo.NewNotification(n, time.Now(), false))
+	log.Debugf("received QUOTE message from %s", p.Pretty())
+	return nil, nil
+}
+
 func (service *OpenBazaarService) handleDisputeOpen(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
 
 	// Unmarshall
@@ -1196,6 +1889,128 @@ func (service *OpenBazaarService) handleDisputeUpdate(p peer.ID, pmes *pb.Messag
 	return nil, nil
 }
 
+func (service *OpenBazaarService) handleDisputePanelVote(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
+
+	// Make sure we aren't currently processing any disputes before proceeding
+	core.DisputeWg.Wait()
+
+	// Unmarshall
+	if pmes.Payload == nil {
+		return nil, ErrEmptyPayload
+	}
+	vote := new(pb.DisputeResolution_PanelVote)
+	err := ptypes.UnmarshalAny(pmes.Payload, vote)
+	if err != nil {
+		return nil, err
+	}
+	err = service.node.ProcessPanelVote(vote, p.Pretty())
+	if err == core.ErrCaseNotFound {
+		if err := service.SendProcessingError(p.Pretty(), vote.GetVote().GetOrderId(), pb.Message_DISPUTE_PANEL_VOTE, nil); err != nil {
+			log.Errorf("failed sending ORDER_PROCESSING_FAILURE to peer (%s): %s", p.Pretty(), err)
+		}
+		return nil, net.OutOfOrderMessage
+	} else if err != nil {
+		return nil, err
+	}
+	log.Debugf("received DISPUTE_PANEL_VOTE message from %s", p.Pretty())
+	return nil, nil
+}
+
+func (service *OpenBazaarService) handleDisputePanelPayout(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
+
+	// Make sure we aren't currently processing any disputes before proceeding
+	core.DisputeWg.Wait()
+
+	// Unmarshall
+	if pmes.Payload == nil {
+		return nil, ErrEmptyPayload
+	}
+	resolution := new(pb.DisputeResolution)
+	err := ptypes.UnmarshalAny(pmes.Payload, resolution)
+	if err != nil {
+		return nil, err
+	}
+	err = service.node.ProcessPanelPayout(resolution, p.Pretty())
+	if err == core.ErrCaseNotFound {
+		if err := service.SendProcessingError(p.Pretty(), resolution.OrderId, pb.Message_DISPUTE_PANEL_PAYOUT, nil); err != nil {
+			log.Errorf("failed sending ORDER_PROCESSING_FAILURE to peer (%s): %s", p.Pretty(), err)
+		}
+		return nil, net.OutOfOrderMessage
+	} else if err != nil {
+		return nil, err
+	}
+	log.Debugf("received DISPUTE_PANEL_PAYOUT message from %s", p.Pretty())
+	return nil, nil
+}
+
+func (service *OpenBazaarService) handleDisputeEvidence(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
+
+	// Make sure we aren't currently processing any disputes before proceeding
+	core.DisputeWg.Wait()
+
+	// Unmarshall
+	if pmes.Payload == nil {
+		return nil, ErrEmptyPayload
+	}
+	rc := new(pb.RicardianContract)
+	err := ptypes.UnmarshalAny(pmes.Payload, rc)
+	if err != nil {
+		return nil, err
+	}
+	err = service.node.ProcessDisputeEvidence(rc, p.Pretty())
+	switch {
+	case err == core.ErrCaseNotFound:
+		var orderID string
+		if len(rc.DisputeEvidence) > 0 {
+			orderID = rc.DisputeEvidence[0].OrderId
+		}
+		if err := service.SendProcessingError(p.Pretty(), orderID, pb.Message_DISPUTE_EVIDENCE, nil); err != nil {
+			log.Errorf("failed sending ORDER_PROCESSING_FAILURE to peer (%s): %s", p.Pretty(), err)
+		}
+		return nil, net.OutOfOrderMessage
+	case err == core.ErrDuplicateEvidence:
+		return nil, net.DuplicateMessage
+	case err != nil:
+		return nil, err
+	}
+	log.Debugf("received DISPUTE_EVIDENCE message from %s", p.Pretty())
+	return nil, nil
+}
+
+func (service *OpenBazaarService) handleCaseMessage(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
+
+	// Make sure we aren't currently processing any disputes before proceeding
+	core.DisputeWg.Wait()
+
+	// Unmarshall
+	if pmes.Payload == nil {
+		return nil, ErrEmptyPayload
+	}
+	message := new(pb.SignedCaseMessage)
+	err := ptypes.UnmarshalAny(pmes.Payload, message)
+	if err != nil {
+		return nil, err
+	}
+	err = service.node.ProcessCaseMessage(message, p.Pretty())
+	switch {
+	case err == core.ErrCaseNotFound:
+		var orderID string
+		if message.Message != nil {
+			orderID = message.Message.OrderId
+		}
+		if err := service.SendProcessingError(p.Pretty(), orderID, pb.Message_CASE_MESSAGE, nil); err != nil {
+			log.Errorf("failed sending ORDER_PROCESSING_FAILURE to peer (%s): %s", p.Pretty(), err)
+		}
+		return nil, net.OutOfOrderMessage
+	case err == repo.ErrDuplicateCaseMessage:
+		return nil, net.DuplicateMessage
+	case err != nil:
+		return nil, err
+	}
+	log.Debugf("received CASE_MESSAGE message from %s", p.Pretty())
+	return nil, nil
+}
+
 func (service *OpenBazaarService) handleDisputeClose(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
 
 	// Unmarshall
@@ -1592,6 +2407,7 @@ func (service *OpenBazaarService) handleVendorFinalizedPayment(pid peer.ID, pmes
 		return nil, errors.New("release escrow can only be called when sale is pending, fulfilled, or disputed")
 	}
 	service.datastore.Purchases().Put(paymentFinalizedMessage.OrderID, *contract, pb.OrderState_PAYMENT_FINALIZED, false)
+	core.RecordExchangeRates(service.datastore, service.node.Multiwallet, contract.BuyerOrder.Payment.Coin, repo.ExchangeRateReasonReleased, paymentFinalizedMessage.OrderID)
 
 	n := repo.VendorFinalizedPayment{
 		ID:      repo.NewNotificationID(),
@@ -34,6 +34,8 @@ func main() {
 			if core.Node != nil {
 				if core.Node.MessageRetriever != nil {
 					core.Node.RecordAgingNotifier.Stop()
+					core.Node.SubscriptionRenewer.Stop()
+					core.Node.ExchangeRateRecorder.Stop()
 					close(core.Node.MessageRetriever.DoneChan)
 					core.Node.MessageRetriever.Wait()
 				}
@@ -65,6 +67,14 @@ func main() {
 		"set API credentials",
 		"The API password field in the config file takes a SHA256 hash of the password. This command will generate the hash for you and save it to the config file.",
 		&cmd.SetAPICreds{})
+	parser.AddCommand("apitokens",
+		"manage scoped API tokens",
+		"Issues, lists and revokes API tokens. Tokens are sent as a Bearer token in the Authorization header and only allow the endpoints their scopes cover.",
+		&cmd.APITokens{})
+	parser.AddCommand("export",
+		"export an accounting ledger",
+		"Exports sales, purchases and wallet transactions over a date range as a CSV, OFX or QIF ledger, with fees, moderator fees, refunds and the fiat value at the order's prices.",
+		&cmd.Export{})
 	parser.AddCommand("start",
 		"start the OpenBazaar-Server",
 		"The start command starts the OpenBazaar-Server",
@@ -156,20 +156,21 @@ func (m *OrderRespApi) GetRefundAddressTransaction() *TransactionRecord {
 }
 
 type CaseRespApi struct {
-	Timestamp                      *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	BuyerContract                  *RicardianContract   `protobuf:"bytes,2,opt,name=buyerContract,proto3" json:"buyerContract,omitempty"`
-	VendorContract                 *RicardianContract   `protobuf:"bytes,3,opt,name=vendorContract,proto3" json:"vendorContract,omitempty"`
-	BuyerContractValidationErrors  []string             `protobuf:"bytes,4,rep,name=buyerContractValidationErrors,proto3" json:"buyerContractValidationErrors,omitempty"`
-	VendorContractValidationErrors []string             `protobuf:"bytes,5,rep,name=vendorContractValidationErrors,proto3" json:"vendorContractValidationErrors,omitempty"`
-	State                          OrderState           `protobuf:"varint,6,opt,name=state,proto3,enum=OrderState" json:"state,omitempty"`
-	Read                           bool                 `protobuf:"varint,7,opt,name=read,proto3" json:"read,omitempty"`
-	BuyerOpened                    bool                 `protobuf:"varint,8,opt,name=buyerOpened,proto3" json:"buyerOpened,omitempty"`
-	Claim                          string               `protobuf:"bytes,9,opt,name=claim,proto3" json:"claim,omitempty"`
-	UnreadChatMessages             uint64               `protobuf:"varint,10,opt,name=unreadChatMessages,proto3" json:"unreadChatMessages,omitempty"`
-	Resolution                     *DisputeResolution   `protobuf:"bytes,11,opt,name=resolution,proto3" json:"resolution,omitempty"`
-	XXX_NoUnkeyedLiteral           struct{}             `json:"-"`
-	XXX_unrecognized               []byte               `json:"-"`
-	XXX_sizecache                  int32                `json:"-"`
+	Timestamp                      *timestamp.Timestamp           `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	BuyerContract                  *RicardianContract             `protobuf:"bytes,2,opt,name=buyerContract,proto3" json:"buyerContract,omitempty"`
+	VendorContract                 *RicardianContract             `protobuf:"bytes,3,opt,name=vendorContract,proto3" json:"vendorContract,omitempty"`
+	BuyerContractValidationErrors  []string                       `protobuf:"bytes,4,rep,name=buyerContractValidationErrors,proto3" json:"buyerContractValidationErrors,omitempty"`
+	VendorContractValidationErrors []string                       `protobuf:"bytes,5,rep,name=vendorContractValidationErrors,proto3" json:"vendorContractValidationErrors,omitempty"`
+	State                          OrderState                     `protobuf:"varint,6,opt,name=state,proto3,enum=OrderState" json:"state,omitempty"`
+	Read                           bool                           `protobuf:"varint,7,opt,name=read,proto3" json:"read,omitempty"`
+	BuyerOpened                    bool                           `protobuf:"varint,8,opt,name=buyerOpened,proto3" json:"buyerOpened,omitempty"`
+	Claim                          string                         `protobuf:"bytes,9,opt,name=claim,proto3" json:"claim,omitempty"`
+	UnreadChatMessages             uint64                         `protobuf:"varint,10,opt,name=unreadChatMessages,proto3" json:"unreadChatMessages,omitempty"`
+	Resolution                     *DisputeResolution             `protobuf:"bytes,11,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	PanelVotes                     []*DisputeResolution_PanelVote `protobuf:"bytes,12,rep,name=panelVotes,proto3" json:"panelVotes,omitempty"`
+	XXX_NoUnkeyedLiteral           struct{}                       `json:"-"`
+	XXX_unrecognized               []byte                         `json:"-"`
+	XXX_sizecache                  int32                          `json:"-"`
 }
 
 func (m *CaseRespApi) Reset()         { *m = CaseRespApi{} }
@@ -274,6 +275,124 @@ func (m *CaseRespApi) GetResolution() *DisputeResolution {
 	return nil
 }
 
+func (m *CaseRespApi) GetPanelVotes() []*DisputeResolution_PanelVote {
+	if m != nil {
+		return m.PanelVotes
+	}
+	return nil
+}
+
+type CaseFile struct {
+	OrderId              string               `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	Role                 string               `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	State                OrderState           `protobuf:"varint,3,opt,name=state,proto3,enum=OrderState" json:"state,omitempty"`
+	Claim                string               `protobuf:"bytes,4,opt,name=claim,proto3" json:"claim,omitempty"`
+	BuyerContract        *RicardianContract   `protobuf:"bytes,5,opt,name=buyerContract,proto3" json:"buyerContract,omitempty"`
+	VendorContract       *RicardianContract   `protobuf:"bytes,6,opt,name=vendorContract,proto3" json:"vendorContract,omitempty"`
+	Resolution           *DisputeResolution   `protobuf:"bytes,7,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	Evidence             []*DisputeEvidence   `protobuf:"bytes,8,rep,name=evidence,proto3" json:"evidence,omitempty"`
+	Messages             []*SignedCaseMessage `protobuf:"bytes,9,rep,name=messages,proto3" json:"messages,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,10,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CaseFile) Reset()         { *m = CaseFile{} }
+func (m *CaseFile) String() string { return proto.CompactTextString(m) }
+func (*CaseFile) ProtoMessage()    {}
+func (*CaseFile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
+}
+
+func (m *CaseFile) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CaseFile.Unmarshal(m, b)
+}
+func (m *CaseFile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CaseFile.Marshal(b, m, deterministic)
+}
+func (m *CaseFile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CaseFile.Merge(m, src)
+}
+func (m *CaseFile) XXX_Size() int {
+	return xxx_messageInfo_CaseFile.Size(m)
+}
+func (m *CaseFile) XXX_DiscardUnknown() {
+	xxx_messageInfo_CaseFile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CaseFile proto.InternalMessageInfo
+
+func (m *CaseFile) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *CaseFile) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *CaseFile) GetState() OrderState {
+	if m != nil {
+		return m.State
+	}
+	return OrderState_PENDING
+}
+
+func (m *CaseFile) GetClaim() string {
+	if m != nil {
+		return m.Claim
+	}
+	return ""
+}
+
+func (m *CaseFile) GetBuyerContract() *RicardianContract {
+	if m != nil {
+		return m.BuyerContract
+	}
+	return nil
+}
+
+func (m *CaseFile) GetVendorContract() *RicardianContract {
+	if m != nil {
+		return m.VendorContract
+	}
+	return nil
+}
+
+func (m *CaseFile) GetResolution() *DisputeResolution {
+	if m != nil {
+		return m.Resolution
+	}
+	return nil
+}
+
+func (m *CaseFile) GetEvidence() []*DisputeEvidence {
+	if m != nil {
+		return m.Evidence
+	}
+	return nil
+}
+
+func (m *CaseFile) GetMessages() []*SignedCaseMessage {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+func (m *CaseFile) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
 type TransactionRecord struct {
 	Txid                 string               `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
 	Value                int64                `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -289,7 +408,7 @@ func (m *TransactionRecord) Reset()         { *m = TransactionRecord{} }
 func (m *TransactionRecord) String() string { return proto.CompactTextString(m) }
 func (*TransactionRecord) ProtoMessage()    {}
 func (*TransactionRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
 }
 
 func (m *TransactionRecord) XXX_Unmarshal(b []byte) error {
@@ -357,7 +476,7 @@ func (m *PeerAndProfile) Reset()         { *m = PeerAndProfile{} }
 func (m *PeerAndProfile) String() string { return proto.CompactTextString(m) }
 func (*PeerAndProfile) ProtoMessage()    {}
 func (*PeerAndProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
 }
 
 func (m *PeerAndProfile) XXX_Unmarshal(b []byte) error {
@@ -405,7 +524,7 @@ func (m *PeerAndProfileWithID) Reset()         { *m = PeerAndProfileWithID{} }
 func (m *PeerAndProfileWithID) String() string { return proto.CompactTextString(m) }
 func (*PeerAndProfileWithID) ProtoMessage()    {}
 func (*PeerAndProfileWithID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{6}
 }
 
 func (m *PeerAndProfileWithID) XXX_Unmarshal(b []byte) error {
@@ -460,7 +579,7 @@ func (m *RatingWithID) Reset()         { *m = RatingWithID{} }
 func (m *RatingWithID) String() string { return proto.CompactTextString(m) }
 func (*RatingWithID) ProtoMessage()    {}
 func (*RatingWithID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{6}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{7}
 }
 
 func (m *RatingWithID) XXX_Unmarshal(b []byte) error {
@@ -506,6 +625,7 @@ func init() {
 	proto.RegisterType((*Coupon)(nil), "Coupon")
 	proto.RegisterType((*OrderRespApi)(nil), "OrderRespApi")
 	proto.RegisterType((*CaseRespApi)(nil), "CaseRespApi")
+	proto.RegisterType((*CaseFile)(nil), "CaseFile")
 	proto.RegisterType((*TransactionRecord)(nil), "TransactionRecord")
 	proto.RegisterType((*PeerAndProfile)(nil), "PeerAndProfile")
 	proto.RegisterType((*PeerAndProfileWithID)(nil), "PeerAndProfileWithID")
@@ -515,44 +635,52 @@ func init() {
 func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
 
 var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 622 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0x13, 0x31,
-	0x10, 0x56, 0xf3, 0x9f, 0x49, 0x13, 0x84, 0x55, 0xa1, 0x55, 0x24, 0x68, 0x59, 0x71, 0xe0, 0xb4,
-	0x45, 0xe5, 0x82, 0xb8, 0x95, 0x14, 0xa4, 0x4a, 0x40, 0x2b, 0x53, 0x81, 0x04, 0x27, 0x27, 0x76,
-	0x12, 0x4b, 0x89, 0xbd, 0xb2, 0xbd, 0x15, 0x7d, 0x19, 0xde, 0x82, 0x07, 0xe2, 0x4d, 0xf0, 0xdf,
-	0xa6, 0x59, 0xd2, 0x6d, 0xc5, 0x29, 0x9e, 0x99, 0x6f, 0xbe, 0x99, 0x9d, 0xf9, 0x26, 0xd0, 0x27,
-	0x39, 0xcf, 0x72, 0x25, 0x8d, 0x1c, 0x3f, 0x9a, 0x49, 0x61, 0x14, 0x99, 0x19, 0x1d, 0x1d, 0xfb,
-	0x52, 0x51, 0xa6, 0x4a, 0x6b, 0x68, 0x7f, 0xe6, 0x7c, 0xc5, 0xa2, 0x79, 0xb8, 0x90, 0x72, 0xb1,
-	0x62, 0xc7, 0xde, 0x9a, 0x16, 0xf3, 0x63, 0xc3, 0xd7, 0x4c, 0x1b, 0xb2, 0xce, 0x03, 0x20, 0x7d,
-	0x05, 0x9d, 0x89, 0x2c, 0x72, 0x29, 0x10, 0x82, 0xd6, 0x92, 0xe8, 0x65, 0xb2, 0x77, 0xb4, 0xf7,
-	0xb2, 0x8f, 0xfd, 0xdb, 0xf9, 0x66, 0x92, 0xb2, 0xa4, 0x11, 0x7c, 0xee, 0x9d, 0xfe, 0x69, 0xc0,
-	0xfe, 0x85, 0x2b, 0x89, 0x99, 0xce, 0x4f, 0x73, 0x8e, 0x32, 0xe8, 0x95, 0x3d, 0xf9, 0xe4, 0xc1,
-	0x09, 0xca, 0x30, 0x9f, 0x11, 0x45, 0x39, 0x11, 0x93, 0x18, 0xc1, 0x1b, 0x0c, 0x7a, 0x0e, 0x6d,
-	0xdb, 0x81, 0x09, 0xac, 0xa3, 0x93, 0x41, 0xe6, 0xd9, 0xbe, 0x38, 0x17, 0x0e, 0x11, 0x57, 0x57,
-	0x31, 0x42, 0x93, 0xa6, 0x45, 0xf4, 0xb0, 0x7f, 0xa3, 0x27, 0xd0, 0x99, 0x17, 0x82, 0x32, 0x9a,
-	0xb4, 0xbc, 0x37, 0x5a, 0xb6, 0x3c, 0x2a, 0x84, 0x43, 0x4c, 0x96, 0xc4, 0x7c, 0x62, 0x5a, 0x93,
-	0x05, 0xd3, 0x49, 0xdb, 0x62, 0x5a, 0xf8, 0x8e, 0x08, 0xc2, 0x30, 0xce, 0xc9, 0xcd, 0x9a, 0x09,
-	0x73, 0x4a, 0xa9, 0xb2, 0xde, 0x2b, 0x45, 0x84, 0xb6, 0x8d, 0x71, 0x29, 0x74, 0xd2, 0x39, 0x6a,
-	0xfa, 0x0f, 0xd8, 0x72, 0x62, 0x36, 0xb3, 0x23, 0xc6, 0xf7, 0x64, 0xa1, 0xcf, 0x90, 0x28, 0xe6,
-	0xfa, 0xd9, 0x0d, 0x26, 0xdd, 0x38, 0x92, 0x5d, 0xc6, 0xda, 0x9c, 0xf4, 0x57, 0x0b, 0x06, 0x13,
-	0xa2, 0x59, 0x39, 0xe2, 0x37, 0xd0, 0xdf, 0x2c, 0x2e, 0xce, 0x78, 0x9c, 0x85, 0xd5, 0x66, 0xe5,
-	0x6a, 0xb3, 0xab, 0x12, 0x81, 0x6f, 0xc1, 0x36, 0x73, 0x38, 0x2d, 0x6e, 0x98, 0x2a, 0xf7, 0xe0,
-	0x87, 0x7e, 0xf7, 0x86, 0xaa, 0x40, 0xf4, 0x16, 0x46, 0xd7, 0x4c, 0x50, 0x79, 0x9b, 0xda, 0xac,
-	0x4d, 0xfd, 0x07, 0x89, 0xce, 0xe0, 0x69, 0x85, 0xec, 0x2b, 0x59, 0x71, 0x4a, 0xdc, 0xa7, 0xbd,
-	0x57, 0x4a, 0x2a, 0x6d, 0x57, 0xd8, 0xb4, 0x82, 0xba, 0x1f, 0x84, 0x3e, 0xc0, 0xb3, 0x2a, 0xef,
-	0x0e, 0x4d, 0xdb, 0xd3, 0x3c, 0x80, 0xba, 0x15, 0x5c, 0xe7, 0x41, 0xc1, 0x75, 0xb7, 0x04, 0x77,
-	0x04, 0x03, 0xdf, 0xdf, 0x45, 0xce, 0x84, 0x55, 0x5d, 0xcf, 0x87, 0xb6, 0x5d, 0xe8, 0x00, 0xda,
-	0xb3, 0x15, 0xe1, 0xeb, 0xa4, 0xef, 0xef, 0x23, 0x18, 0x35, 0x82, 0x84, 0x5a, 0x41, 0x9e, 0x00,
-	0xd8, 0xfd, 0xcb, 0x55, 0xe1, 0xe5, 0x32, 0x88, 0x43, 0x3e, 0xe3, 0x3a, 0x2f, 0x8c, 0x53, 0x40,
-	0x8c, 0xe0, 0x2d, 0x54, 0xfa, 0x7b, 0x0f, 0x1e, 0xef, 0x08, 0xca, 0x7d, 0x85, 0xf9, 0xc9, 0x69,
-	0x79, 0xc2, 0xee, 0xed, 0x7a, 0xbc, 0x26, 0xab, 0x22, 0x5c, 0x5b, 0x13, 0x07, 0x03, 0xbd, 0x80,
-	0xa1, 0xbd, 0xc7, 0x39, 0x57, 0x6b, 0x12, 0x74, 0xef, 0x76, 0x3b, 0xc4, 0x55, 0xa7, 0x3b, 0xb9,
-	0x25, 0xe3, 0x8b, 0xa5, 0xf1, 0x27, 0x37, 0xc4, 0xd1, 0xaa, 0xca, 0xb1, 0xfd, 0x1f, 0x72, 0x4c,
-	0x3f, 0xc2, 0xe8, 0x92, 0x31, 0x75, 0x2a, 0xe8, 0x65, 0xf8, 0x9f, 0x72, 0x35, 0x72, 0xeb, 0x39,
-	0x2f, 0xbb, 0x8e, 0x16, 0x4a, 0xa1, 0x1b, 0xff, 0xca, 0xa2, 0x64, 0x7b, 0x59, 0x4c, 0xc1, 0x65,
-	0x20, 0x9d, 0xc2, 0x41, 0x95, 0xed, 0x1b, 0x37, 0xcb, 0xf3, 0x33, 0x34, 0x82, 0xc6, 0x66, 0x0a,
-	0xf6, 0xb5, 0x55, 0xa3, 0x51, 0x57, 0xa3, 0x59, 0x57, 0xe3, 0x07, 0xec, 0x63, 0x3b, 0x0e, 0xb1,
-	0xa8, 0xe1, 0x1e, 0x43, 0x4f, 0xf9, 0xf8, 0x86, 0x7d, 0x63, 0xa3, 0x43, 0xe8, 0x84, 0x77, 0xa4,
-	0xef, 0x66, 0x81, 0x0a, 0x47, 0xf7, 0xbb, 0xd6, 0xf7, 0x46, 0x3e, 0x9d, 0x76, 0xfc, 0xcc, 0x5e,
-	0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x96, 0xc3, 0x9b, 0xe6, 0x05, 0x00, 0x00,
+	// 751 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xdd, 0x8e, 0xeb, 0x34,
+	0x10, 0x56, 0x9b, 0xfe, 0xa4, 0xd3, 0x1f, 0xc0, 0x3a, 0x42, 0x51, 0x05, 0x9c, 0x52, 0x71, 0xd1,
+	0x0b, 0x94, 0x83, 0x96, 0x9b, 0x23, 0xc4, 0xcd, 0xd2, 0x3d, 0x2b, 0xad, 0x04, 0xec, 0xca, 0xbb,
+	0x5a, 0x24, 0xb8, 0x72, 0x63, 0xb7, 0xb5, 0x94, 0xda, 0x91, 0xed, 0xac, 0xd8, 0xe7, 0x42, 0xbc,
+	0x0d, 0x37, 0xbc, 0x09, 0xb2, 0xe3, 0xa4, 0x09, 0x25, 0xfb, 0xc3, 0x9d, 0x67, 0xe6, 0x9b, 0xf1,
+	0xe4, 0xfb, 0xc6, 0x13, 0x18, 0x91, 0x8c, 0xc7, 0x99, 0x92, 0x46, 0xce, 0x3f, 0x4a, 0xa4, 0x30,
+	0x8a, 0x24, 0x46, 0x7b, 0xc7, 0xf4, 0xc0, 0xb4, 0x26, 0x3b, 0xe6, 0xcd, 0x89, 0x54, 0x94, 0xa9,
+	0x2a, 0x98, 0x29, 0xb9, 0xe5, 0x69, 0x19, 0x7c, 0xbb, 0x93, 0x72, 0x97, 0xb2, 0x77, 0xce, 0xda,
+	0xe4, 0xdb, 0x77, 0x86, 0x1f, 0x98, 0x36, 0xe4, 0x90, 0x15, 0x80, 0xe5, 0x37, 0x30, 0x58, 0xcb,
+	0x3c, 0x93, 0x02, 0x21, 0xe8, 0xed, 0x89, 0xde, 0x47, 0x9d, 0x45, 0x67, 0x35, 0xc2, 0xee, 0x6c,
+	0x7d, 0x89, 0xa4, 0x2c, 0xea, 0x16, 0x3e, 0x7b, 0x5e, 0xfe, 0xdd, 0x85, 0xc9, 0xb5, 0xbd, 0x12,
+	0x33, 0x9d, 0x9d, 0x67, 0x1c, 0xc5, 0x10, 0x96, 0x2d, 0xba, 0xe4, 0xf1, 0x19, 0x8a, 0x31, 0x4f,
+	0x88, 0xa2, 0x9c, 0x88, 0xb5, 0x8f, 0xe0, 0x0a, 0x83, 0xbe, 0x84, 0xbe, 0x36, 0xc4, 0x14, 0x55,
+	0x67, 0x67, 0xe3, 0xd8, 0x55, 0xbb, 0xb5, 0x2e, 0x5c, 0x44, 0xec, 0xbd, 0x8a, 0x11, 0x1a, 0x05,
+	0x8b, 0xce, 0x2a, 0xc4, 0xee, 0x8c, 0x3e, 0x85, 0xc1, 0x36, 0x17, 0x94, 0xd1, 0xa8, 0xe7, 0xbc,
+	0xde, 0x42, 0x31, 0xa0, 0x5c, 0x58, 0xc4, 0x7a, 0x4f, 0xcc, 0x4f, 0x05, 0x35, 0x3a, 0xea, 0x2f,
+	0x3a, 0xab, 0x1e, 0xfe, 0x8f, 0x08, 0xc2, 0x30, 0xcf, 0xc8, 0xe3, 0x81, 0x09, 0x73, 0x4e, 0xa9,
+	0x62, 0x5a, 0xdf, 0x29, 0x22, 0x34, 0x49, 0x0c, 0x97, 0x42, 0x47, 0x83, 0x45, 0xe0, 0x3e, 0xa0,
+	0xe6, 0xc4, 0x2c, 0x91, 0x8a, 0xe2, 0x27, 0xb2, 0xd0, 0xcf, 0x10, 0x29, 0x66, 0xfb, 0x39, 0x0d,
+	0x46, 0x43, 0x4f, 0xc9, 0x69, 0xc5, 0xd6, 0x9c, 0xe5, 0x5f, 0x3d, 0x18, 0xaf, 0x89, 0x66, 0x25,
+	0xc5, 0xef, 0x61, 0x54, 0x09, 0xe7, 0x39, 0x9e, 0xc7, 0x85, 0xb4, 0x71, 0x29, 0x6d, 0x7c, 0x57,
+	0x22, 0xf0, 0x11, 0x8c, 0xde, 0xc3, 0x74, 0x93, 0x3f, 0x32, 0x55, 0xea, 0x10, 0x75, 0x7d, 0x3b,
+	0xa7, 0x0a, 0x35, 0x81, 0xe8, 0x3b, 0x98, 0x3d, 0x30, 0x41, 0xe5, 0x31, 0x35, 0x68, 0x4d, 0xfd,
+	0x17, 0x12, 0x5d, 0xc0, 0xe7, 0x8d, 0x62, 0xf7, 0x24, 0xe5, 0x94, 0xd8, 0x4f, 0xfb, 0xa0, 0x94,
+	0x54, 0x3a, 0xea, 0x2d, 0x82, 0xd5, 0x08, 0x3f, 0x0d, 0x42, 0x97, 0xf0, 0x45, 0xb3, 0xee, 0x49,
+	0x99, 0xbe, 0x2b, 0xf3, 0x0c, 0xea, 0x38, 0x70, 0x83, 0x67, 0x07, 0x6e, 0x58, 0x1b, 0xb8, 0x05,
+	0x8c, 0x5d, 0x7f, 0xd7, 0x19, 0x13, 0x8c, 0x46, 0xa1, 0x0b, 0xd5, 0x5d, 0xe8, 0x0d, 0xf4, 0x93,
+	0x94, 0xf0, 0x43, 0x34, 0x72, 0xef, 0xa3, 0x30, 0x5a, 0x06, 0x12, 0x5a, 0x07, 0xf2, 0x0c, 0x40,
+	0x31, 0x2d, 0xd3, 0xdc, 0x8d, 0xcb, 0xd8, 0x93, 0x7c, 0xc1, 0x75, 0x96, 0x1b, 0x86, 0xab, 0x08,
+	0xae, 0xa1, 0xd0, 0xf7, 0x00, 0x19, 0x11, 0x2c, 0xbd, 0x97, 0x86, 0xe9, 0x68, 0xe2, 0x86, 0xf6,
+	0xb3, 0xd3, 0x9c, 0xf8, 0xa6, 0x04, 0xe1, 0x1a, 0x7e, 0xf9, 0x47, 0x00, 0xa1, 0x1d, 0xaf, 0x4b,
+	0x9e, 0x32, 0x14, 0xc1, 0xd0, 0x6d, 0x90, 0x2b, 0xea, 0x9f, 0x7e, 0x69, 0x3a, 0x52, 0x64, 0x5a,
+	0xbd, 0x7e, 0x7b, 0x3e, 0x72, 0x19, 0xb4, 0x72, 0x59, 0xb1, 0xd2, 0xab, 0xb3, 0x72, 0x32, 0x88,
+	0xfd, 0xff, 0x3f, 0x88, 0x83, 0x17, 0x0f, 0x62, 0x93, 0xdb, 0xe1, 0x8b, 0xb8, 0xfd, 0x1a, 0x42,
+	0xf6, 0xc0, 0x29, 0x13, 0x09, 0x8b, 0x42, 0xc7, 0xec, 0xc7, 0x65, 0xc6, 0x07, 0xef, 0xc7, 0x15,
+	0xc2, 0x6e, 0xbf, 0x43, 0xa9, 0xf1, 0xc8, 0x2f, 0x8f, 0x5b, 0xbe, 0x13, 0x8c, 0x5a, 0x86, 0xbd,
+	0xc8, 0xb8, 0xc2, 0x34, 0x9f, 0x32, 0xbc, 0xe2, 0x29, 0x2f, 0xff, 0xec, 0xc0, 0x27, 0x27, 0x4b,
+	0xc4, 0x8a, 0x64, 0x7e, 0xe7, 0xa5, 0x76, 0xee, 0x6c, 0x15, 0x78, 0x20, 0x69, 0x5e, 0x28, 0x17,
+	0xe0, 0xc2, 0x40, 0x5f, 0xc1, 0x34, 0x91, 0x62, 0xcb, 0xd5, 0x81, 0x14, 0xbb, 0xce, 0x4a, 0x38,
+	0xc5, 0x4d, 0xa7, 0x5d, 0xb3, 0x7b, 0xc6, 0x77, 0x7b, 0xe3, 0xe4, 0x9b, 0x62, 0x6f, 0x35, 0xfb,
+	0xee, 0xbf, 0xa6, 0xef, 0x1f, 0x61, 0x76, 0xc3, 0x98, 0x3a, 0x17, 0xf4, 0xa6, 0xf8, 0x37, 0xd9,
+	0x3b, 0x32, 0x56, 0x9b, 0x38, 0x6f, 0xa1, 0x25, 0x0c, 0xfd, 0xef, 0xcb, 0xaf, 0xa9, 0x30, 0xf6,
+	0x29, 0xb8, 0x0c, 0x2c, 0x37, 0xf0, 0xa6, 0x59, 0xed, 0x17, 0x6e, 0xf6, 0x57, 0x17, 0x68, 0x06,
+	0xdd, 0x8a, 0x85, 0x2e, 0xa7, 0xb5, 0x3b, 0xba, 0x6d, 0x77, 0x04, 0x6d, 0x77, 0xfc, 0x06, 0x13,
+	0x4c, 0x0c, 0x17, 0xbb, 0x96, 0xda, 0x73, 0x08, 0x95, 0x8b, 0x57, 0xd5, 0x2b, 0x1b, 0xbd, 0x85,
+	0x41, 0x71, 0xf6, 0xe5, 0x87, 0x71, 0x51, 0x0a, 0x7b, 0xf7, 0x0f, 0xbd, 0x5f, 0xbb, 0xd9, 0x66,
+	0x33, 0x70, 0x9c, 0x7d, 0xfb, 0xcf, 0x00, 0xa1, 0x8b, 0x22, 0xe6, 0xe9, 0x07, 0x00, 0x00,
 }
@@ -35,3 +35,47 @@ func DisputeResolutionPayoutOutputToAddress(w wallet.Wallet, output *DisputeReso
 	}
 	return addr, nil
 }
+
+// AcceptedOrderAmendment returns the latest amendment to the order which the
+// other party accepted, or nil if the order has not been amended
+func AcceptedOrderAmendment(contract *RicardianContract) *OrderAmendment {
+	for i := len(contract.OrderAmendmentResponses) - 1; i >= 0; i-- {
+		r := contract.OrderAmendmentResponses[i]
+		if r.Accepted && int(r.AmendmentIndex) < len(contract.OrderAmendments) {
+			return contract.OrderAmendments[r.AmendmentIndex]
+		}
+	}
+	return nil
+}
+
+// OrderPayment returns the payment terms of the order. An accepted amendment
+// replaces the terms the buyer ordered with, so funding and escrow releases
+// must use these rather than BuyerOrder.Payment.
+func OrderPayment(contract *RicardianContract) *Order_Payment {
+	if a := AcceptedOrderAmendment(contract); a != nil && a.Payment != nil {
+		return a.Payment
+	}
+	return contract.BuyerOrder.Payment
+}
+
+// PaymentModerators returns the moderators of a moderated payment along with
+// their escrow keys: every member of a moderator panel, or else the single
+// moderator of the order
+func PaymentModerators(payment *Order_Payment) ([]string, [][]byte) {
+	if payment.ModeratorPanel != nil {
+		return payment.ModeratorPanel.Moderators, payment.ModeratorPanel.ModeratorKeys
+	}
+	return []string{payment.Moderator}, [][]byte{payment.ModeratorKey}
+}
+
+// IsPaymentModerator returns whether the peer moderates the payment, alone or
+// as a member of its moderator panel
+func IsPaymentModerator(payment *Order_Payment, peerID string) bool {
+	moderators, _ := PaymentModerators(payment)
+	for _, m := range moderators {
+		if m == peerID {
+			return true
+		}
+	}
+	return false
+}
@@ -52,7 +52,7 @@ func (x Listing_Metadata_ServiceRateMethod) String() string {
 }
 
 func (Listing_Metadata_ServiceRateMethod) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{2, 0, 0}
+	return fileDescriptor_b6d125f880f9ca35, []int{2, 1, 0}
 }
 
 type Listing_Metadata_ContractType int32
@@ -86,7 +86,7 @@ func (x Listing_Metadata_ContractType) String() string {
 }
 
 func (Listing_Metadata_ContractType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{2, 0, 1}
+	return fileDescriptor_b6d125f880f9ca35, []int{2, 1, 1}
 }
 
 type Listing_Metadata_Format int32
@@ -111,7 +111,7 @@ func (x Listing_Metadata_Format) String() string {
 }
 
 func (Listing_Metadata_Format) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{2, 0, 2}
+	return fileDescriptor_b6d125f880f9ca35, []int{2, 1, 2}
 }
 
 type Listing_ShippingOption_ShippingType int32
@@ -136,7 +136,7 @@ func (x Listing_ShippingOption_ShippingType) String() string {
 }
 
 func (Listing_ShippingOption_ShippingType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{2, 2, 0}
+	return fileDescriptor_b6d125f880f9ca35, []int{2, 3, 0}
 }
 
 type Order_Payment_Method int32
@@ -164,7 +164,7 @@ func (x Order_Payment_Method) String() string {
 }
 
 func (Order_Payment_Method) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{3, 2, 0}
+	return fileDescriptor_b6d125f880f9ca35, []int{3, 6, 0}
 }
 
 type EntityRating_RatingFields_RatingType int32
@@ -198,39 +198,144 @@ func (EntityRating_RatingFields_RatingType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_b6d125f880f9ca35, []int{10, 0, 0}
 }
 
+type Subscription_State int32
+
+const (
+	Subscription_ACTIVE    Subscription_State = 0
+	Subscription_PAUSED    Subscription_State = 1
+	Subscription_CANCELED  Subscription_State = 2
+	Subscription_COMPLETED Subscription_State = 3
+)
+
+var Subscription_State_name = map[int32]string{
+	0: "ACTIVE",
+	1: "PAUSED",
+	2: "CANCELED",
+	3: "COMPLETED",
+}
+
+var Subscription_State_value = map[string]int32{
+	"ACTIVE":    0,
+	"PAUSED":    1,
+	"CANCELED":  2,
+	"COMPLETED": 3,
+}
+
+func (x Subscription_State) String() string {
+	return proto.EnumName(Subscription_State_name, int32(x))
+}
+
+func (Subscription_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{15, 0}
+}
+
+type TimesheetReview_Status int32
+
+const (
+	TimesheetReview_PENDING   TimesheetReview_Status = 0
+	TimesheetReview_APPROVED  TimesheetReview_Status = 1
+	TimesheetReview_CONTESTED TimesheetReview_Status = 2
+)
+
+var TimesheetReview_Status_name = map[int32]string{
+	0: "PENDING",
+	1: "APPROVED",
+	2: "CONTESTED",
+}
+
+var TimesheetReview_Status_value = map[string]int32{
+	"PENDING":   0,
+	"APPROVED":  1,
+	"CONTESTED": 2,
+}
+
+func (x TimesheetReview_Status) String() string {
+	return proto.EnumName(TimesheetReview_Status_name, int32(x))
+}
+
+func (TimesheetReview_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{23, 0}
+}
+
+type OrderAmendment_Party int32
+
+const (
+	OrderAmendment_BUYER  OrderAmendment_Party = 0
+	OrderAmendment_VENDOR OrderAmendment_Party = 1
+)
+
+var OrderAmendment_Party_name = map[int32]string{
+	0: "BUYER",
+	1: "VENDOR",
+}
+
+var OrderAmendment_Party_value = map[string]int32{
+	"BUYER":  0,
+	"VENDOR": 1,
+}
+
+func (x OrderAmendment_Party) String() string {
+	return proto.EnumName(OrderAmendment_Party_name, int32(x))
+}
+
+func (OrderAmendment_Party) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{24, 0}
+}
+
 type Signature_Section int32
 
 const (
-	Signature_LISTING            Signature_Section = 0
-	Signature_ORDER              Signature_Section = 1
-	Signature_ORDER_CONFIRMATION Signature_Section = 2
-	Signature_ORDER_FULFILLMENT  Signature_Section = 3
-	Signature_ORDER_COMPLETION   Signature_Section = 4
-	Signature_DISPUTE            Signature_Section = 5
-	Signature_DISPUTE_RESOLUTION Signature_Section = 6
-	Signature_REFUND             Signature_Section = 7
+	Signature_LISTING                  Signature_Section = 0
+	Signature_ORDER                    Signature_Section = 1
+	Signature_ORDER_CONFIRMATION       Signature_Section = 2
+	Signature_ORDER_FULFILLMENT        Signature_Section = 3
+	Signature_ORDER_COMPLETION         Signature_Section = 4
+	Signature_DISPUTE                  Signature_Section = 5
+	Signature_DISPUTE_RESOLUTION       Signature_Section = 6
+	Signature_REFUND                   Signature_Section = 7
+	Signature_MILESTONE_RELEASE        Signature_Section = 8
+	Signature_TIMESHEET_ENTRY          Signature_Section = 9
+	Signature_TIMESHEET_REVIEW         Signature_Section = 10
+	Signature_ORDER_AMENDMENT          Signature_Section = 11
+	Signature_ORDER_AMENDMENT_RESPONSE Signature_Section = 12
+	Signature_DISPUTE_EVIDENCE         Signature_Section = 13
+	Signature_PARTIAL_REFUND           Signature_Section = 14
 )
 
 var Signature_Section_name = map[int32]string{
-	0: "LISTING",
-	1: "ORDER",
-	2: "ORDER_CONFIRMATION",
-	3: "ORDER_FULFILLMENT",
-	4: "ORDER_COMPLETION",
-	5: "DISPUTE",
-	6: "DISPUTE_RESOLUTION",
-	7: "REFUND",
+	0:  "LISTING",
+	1:  "ORDER",
+	2:  "ORDER_CONFIRMATION",
+	3:  "ORDER_FULFILLMENT",
+	4:  "ORDER_COMPLETION",
+	5:  "DISPUTE",
+	6:  "DISPUTE_RESOLUTION",
+	7:  "REFUND",
+	8:  "MILESTONE_RELEASE",
+	9:  "TIMESHEET_ENTRY",
+	10: "TIMESHEET_REVIEW",
+	11: "ORDER_AMENDMENT",
+	12: "ORDER_AMENDMENT_RESPONSE",
+	13: "DISPUTE_EVIDENCE",
+	14: "PARTIAL_REFUND",
 }
 
 var Signature_Section_value = map[string]int32{
-	"LISTING":            0,
-	"ORDER":              1,
-	"ORDER_CONFIRMATION": 2,
-	"ORDER_FULFILLMENT":  3,
-	"ORDER_COMPLETION":   4,
-	"DISPUTE":            5,
-	"DISPUTE_RESOLUTION": 6,
-	"REFUND":             7,
+	"LISTING":                  0,
+	"ORDER":                    1,
+	"ORDER_CONFIRMATION":       2,
+	"ORDER_FULFILLMENT":        3,
+	"ORDER_COMPLETION":         4,
+	"DISPUTE":                  5,
+	"DISPUTE_RESOLUTION":       6,
+	"REFUND":                   7,
+	"MILESTONE_RELEASE":        8,
+	"TIMESHEET_ENTRY":          9,
+	"TIMESHEET_REVIEW":         10,
+	"ORDER_AMENDMENT":          11,
+	"ORDER_AMENDMENT_RESPONSE": 12,
+	"DISPUTE_EVIDENCE":         13,
+	"PARTIAL_REFUND":           14,
 }
 
 func (x Signature_Section) String() string {
@@ -238,24 +343,31 @@ func (x Signature_Section) String() string {
 }
 
 func (Signature_Section) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{21, 0}
+	return fileDescriptor_b6d125f880f9ca35, []int{37, 0}
 }
 
 type RicardianContract struct {
-	VendorListings          []*Listing          `protobuf:"bytes,1,rep,name=vendorListings,proto3" json:"vendorListings,omitempty"`
-	BuyerOrder              *Order              `protobuf:"bytes,2,opt,name=buyerOrder,proto3" json:"buyerOrder,omitempty"`
-	VendorOrderConfirmation *OrderConfirmation  `protobuf:"bytes,3,opt,name=vendorOrderConfirmation,proto3" json:"vendorOrderConfirmation,omitempty"`
-	VendorOrderFulfillment  []*OrderFulfillment `protobuf:"bytes,4,rep,name=vendorOrderFulfillment,proto3" json:"vendorOrderFulfillment,omitempty"`
-	BuyerOrderCompletion    *OrderCompletion    `protobuf:"bytes,5,opt,name=buyerOrderCompletion,proto3" json:"buyerOrderCompletion,omitempty"`
-	Dispute                 *Dispute            `protobuf:"bytes,6,opt,name=dispute,proto3" json:"dispute,omitempty"`
-	DisputeResolution       *DisputeResolution  `protobuf:"bytes,7,opt,name=disputeResolution,proto3" json:"disputeResolution,omitempty"`
-	DisputeAcceptance       *DisputeAcceptance  `protobuf:"bytes,8,opt,name=disputeAcceptance,proto3" json:"disputeAcceptance,omitempty"`
-	Refund                  *Refund             `protobuf:"bytes,9,opt,name=refund,proto3" json:"refund,omitempty"`
-	Signatures              []*Signature        `protobuf:"bytes,10,rep,name=signatures,proto3" json:"signatures,omitempty"`
-	Errors                  []string            `protobuf:"bytes,11,rep,name=errors,proto3" json:"errors,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{}            `json:"-"`
-	XXX_unrecognized        []byte              `json:"-"`
-	XXX_sizecache           int32               `json:"-"`
+	VendorListings          []*Listing                `protobuf:"bytes,1,rep,name=vendorListings,proto3" json:"vendorListings,omitempty"`
+	BuyerOrder              *Order                    `protobuf:"bytes,2,opt,name=buyerOrder,proto3" json:"buyerOrder,omitempty"`
+	VendorOrderConfirmation *OrderConfirmation        `protobuf:"bytes,3,opt,name=vendorOrderConfirmation,proto3" json:"vendorOrderConfirmation,omitempty"`
+	VendorOrderFulfillment  []*OrderFulfillment       `protobuf:"bytes,4,rep,name=vendorOrderFulfillment,proto3" json:"vendorOrderFulfillment,omitempty"`
+	BuyerOrderCompletion    *OrderCompletion          `protobuf:"bytes,5,opt,name=buyerOrderCompletion,proto3" json:"buyerOrderCompletion,omitempty"`
+	Dispute                 *Dispute                  `protobuf:"bytes,6,opt,name=dispute,proto3" json:"dispute,omitempty"`
+	DisputeResolution       *DisputeResolution        `protobuf:"bytes,7,opt,name=disputeResolution,proto3" json:"disputeResolution,omitempty"`
+	DisputeAcceptance       *DisputeAcceptance        `protobuf:"bytes,8,opt,name=disputeAcceptance,proto3" json:"disputeAcceptance,omitempty"`
+	Refund                  *Refund                   `protobuf:"bytes,9,opt,name=refund,proto3" json:"refund,omitempty"`
+	Signatures              []*Signature              `protobuf:"bytes,10,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	Errors                  []string                  `protobuf:"bytes,11,rep,name=errors,proto3" json:"errors,omitempty"`
+	BuyerMilestoneReleases  []*MilestoneRelease       `protobuf:"bytes,6660,rep,name=buyerMilestoneReleases,proto3" json:"buyerMilestoneReleases,omitempty"`
+	VendorTimesheetEntries  []*TimesheetEntry         `protobuf:"bytes,6661,rep,name=vendorTimesheetEntries,proto3" json:"vendorTimesheetEntries,omitempty"`
+	BuyerTimesheetReviews   []*TimesheetReview        `protobuf:"bytes,6662,rep,name=buyerTimesheetReviews,proto3" json:"buyerTimesheetReviews,omitempty"`
+	OrderAmendments         []*OrderAmendment         `protobuf:"bytes,6663,rep,name=orderAmendments,proto3" json:"orderAmendments,omitempty"`
+	OrderAmendmentResponses []*OrderAmendmentResponse `protobuf:"bytes,6664,rep,name=orderAmendmentResponses,proto3" json:"orderAmendmentResponses,omitempty"`
+	DisputeEvidence         []*DisputeEvidence        `protobuf:"bytes,6665,rep,name=disputeEvidence,proto3" json:"disputeEvidence,omitempty"`
+	PartialRefunds          []*PartialRefund          `protobuf:"bytes,6666,rep,name=partialRefunds,proto3" json:"partialRefunds,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}                  `json:"-"`
+	XXX_unrecognized        []byte                    `json:"-"`
+	XXX_sizecache           int32                     `json:"-"`
 }
 
 func (m *RicardianContract) Reset()         { *m = RicardianContract{} }
@@ -360,6 +472,55 @@ func (m *RicardianContract) GetErrors() []string {
 	return nil
 }
 
+func (m *RicardianContract) GetBuyerMilestoneReleases() []*MilestoneRelease {
+	if m != nil {
+		return m.BuyerMilestoneReleases
+	}
+	return nil
+}
+
+func (m *RicardianContract) GetVendorTimesheetEntries() []*TimesheetEntry {
+	if m != nil {
+		return m.VendorTimesheetEntries
+	}
+	return nil
+}
+
+func (m *RicardianContract) GetBuyerTimesheetReviews() []*TimesheetReview {
+	if m != nil {
+		return m.BuyerTimesheetReviews
+	}
+	return nil
+}
+
+func (m *RicardianContract) GetOrderAmendments() []*OrderAmendment {
+	if m != nil {
+		return m.OrderAmendments
+	}
+	return nil
+}
+
+func (m *RicardianContract) GetOrderAmendmentResponses() []*OrderAmendmentResponse {
+	if m != nil {
+		return m.OrderAmendmentResponses
+	}
+	return nil
+}
+
+func (m *RicardianContract) GetDisputeEvidence() []*DisputeEvidence {
+	if m != nil {
+		return m.DisputeEvidence
+	}
+	return nil
+}
+
+func (m *RicardianContract) GetPartialRefunds() []*PartialRefund {
+	if m != nil {
+		return m.PartialRefunds
+	}
+	return nil
+}
+
 type Contact struct {
 	Email                string   `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	PhoneNumber          string   `protobuf:"bytes,2,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
@@ -428,6 +589,7 @@ type Listing struct {
 	RefundPolicy         string                    `protobuf:"bytes,10,opt,name=refundPolicy,proto3" json:"refundPolicy,omitempty"`
 	Location             *Address                  `protobuf:"bytes,6661,opt,name=location,proto3" json:"location,omitempty"`
 	Contact              *Contact                  `protobuf:"bytes,6662,opt,name=contact,proto3" json:"contact,omitempty"`
+	Milestones           []*Listing_Milestone      `protobuf:"bytes,6663,rep,name=milestones,proto3" json:"milestones,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
 	XXX_unrecognized     []byte                    `json:"-"`
 	XXX_sizecache        int32                     `json:"-"`
@@ -542,6 +704,76 @@ func (m *Listing) GetContact() *Contact {
 	return nil
 }
 
+func (m *Listing) GetMilestones() []*Listing_Milestone {
+	if m != nil {
+		return m.Milestones
+	}
+	return nil
+}
+
+type Listing_Milestone struct {
+	Title                string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Price                uint64   `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	ProcessingTime       string   `protobuf:"bytes,4,opt,name=processingTime,proto3" json:"processingTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Listing_Milestone) Reset()         { *m = Listing_Milestone{} }
+func (m *Listing_Milestone) String() string { return proto.CompactTextString(m) }
+func (*Listing_Milestone) ProtoMessage()    {}
+func (*Listing_Milestone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{2, 0}
+}
+
+func (m *Listing_Milestone) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Listing_Milestone.Unmarshal(m, b)
+}
+func (m *Listing_Milestone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Listing_Milestone.Marshal(b, m, deterministic)
+}
+func (m *Listing_Milestone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Listing_Milestone.Merge(m, src)
+}
+func (m *Listing_Milestone) XXX_Size() int {
+	return xxx_messageInfo_Listing_Milestone.Size(m)
+}
+func (m *Listing_Milestone) XXX_DiscardUnknown() {
+	xxx_messageInfo_Listing_Milestone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Listing_Milestone proto.InternalMessageInfo
+
+func (m *Listing_Milestone) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Listing_Milestone) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Listing_Milestone) GetPrice() uint64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *Listing_Milestone) GetProcessingTime() string {
+	if m != nil {
+		return m.ProcessingTime
+	}
+	return ""
+}
+
 type Listing_Metadata struct {
 	Version               uint32                             `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 	ContractType          Listing_Metadata_ContractType      `protobuf:"varint,2,opt,name=contractType,proto3,enum=Listing_Metadata_ContractType" json:"contractType,omitempty"`
@@ -565,7 +797,7 @@ func (m *Listing_Metadata) Reset()         { *m = Listing_Metadata{} }
 func (m *Listing_Metadata) String() string { return proto.CompactTextString(m) }
 func (*Listing_Metadata) ProtoMessage()    {}
 func (*Listing_Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{2, 0}
+	return fileDescriptor_b6d125f880f9ca35, []int{2, 1}
 }
 
 func (m *Listing_Metadata) XXX_Unmarshal(b []byte) error {
@@ -699,7 +931,7 @@ func (m *Listing_Item) Reset()         { *m = Listing_Item{} }
 func (m *Listing_Item) String() string { return proto.CompactTextString(m) }
 func (*Listing_Item) ProtoMessage()    {}
 func (*Listing_Item) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{2, 1}
+	return fileDescriptor_b6d125f880f9ca35, []int{2, 2}
 }
 
 func (m *Listing_Item) XXX_Unmarshal(b []byte) error {
@@ -817,7 +1049,7 @@ func (m *Listing_Item_Option) Reset()         { *m = Listing_Item_Option{} }
 func (m *Listing_Item_Option) String() string { return proto.CompactTextString(m) }
 func (*Listing_Item_Option) ProtoMessage()    {}
 func (*Listing_Item_Option) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{2, 1, 0}
+	return fileDescriptor_b6d125f880f9ca35, []int{2, 2, 0}
 }
 
 func (m *Listing_Item_Option) XXX_Unmarshal(b []byte) error {
@@ -871,7 +1103,7 @@ func (m *Listing_Item_Option_Variant) Reset()         { *m = Listing_Item_Option
 func (m *Listing_Item_Option_Variant) String() string { return proto.CompactTextString(m) }
 func (*Listing_Item_Option_Variant) ProtoMessage()    {}
 func (*Listing_Item_Option_Variant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{2, 1, 0, 0}
+	return fileDescriptor_b6d125f880f9ca35, []int{2, 2, 0, 0}
 }
 
 func (m *Listing_Item_Option_Variant) XXX_Unmarshal(b []byte) error {
@@ -920,7 +1152,7 @@ func (m *Listing_Item_Sku) Reset()         { *m = Listing_Item_Sku{} }
 func (m *Listing_Item_Sku) String() string { return proto.CompactTextString(m) }
 func (*Listing_Item_Sku) ProtoMessage()    {}
 func (*Listing_Item_Sku) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{2, 1, 1}
+	return fileDescriptor_b6d125f880f9ca35, []int{2, 2, 1}
 }
 
 func (m *Listing_Item_Sku) XXX_Unmarshal(b []byte) error {
@@ -985,7 +1217,7 @@ func (m *Listing_Item_Image) Reset()         { *m = Listing_Item_Image{} }
 func (m *Listing_Item_Image) String() string { return proto.CompactTextString(m) }
 func (*Listing_Item_Image) ProtoMessage()    {}
 func (*Listing_Item_Image) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{2, 1, 2}
+	return fileDescriptor_b6d125f880f9ca35, []int{2, 2, 2}
 }
 
 func (m *Listing_Item_Image) XXX_Unmarshal(b []byte) error {
@@ -1062,7 +1294,7 @@ func (m *Listing_ShippingOption) Reset()         { *m = Listing_ShippingOption{}
 func (m *Listing_ShippingOption) String() string { return proto.CompactTextString(m) }
 func (*Listing_ShippingOption) ProtoMessage()    {}
 func (*Listing_ShippingOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{2, 2}
+	return fileDescriptor_b6d125f880f9ca35, []int{2, 3}
 }
 
 func (m *Listing_ShippingOption) XXX_Unmarshal(b []byte) error {
@@ -1125,7 +1357,7 @@ func (m *Listing_ShippingOption_Service) Reset()         { *m = Listing_Shipping
 func (m *Listing_ShippingOption_Service) String() string { return proto.CompactTextString(m) }
 func (*Listing_ShippingOption_Service) ProtoMessage()    {}
 func (*Listing_ShippingOption_Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{2, 2, 0}
+	return fileDescriptor_b6d125f880f9ca35, []int{2, 3, 0}
 }
 
 func (m *Listing_ShippingOption_Service) XXX_Unmarshal(b []byte) error {
@@ -1188,7 +1420,7 @@ func (m *Listing_Tax) Reset()         { *m = Listing_Tax{} }
 func (m *Listing_Tax) String() string { return proto.CompactTextString(m) }
 func (*Listing_Tax) ProtoMessage()    {}
 func (*Listing_Tax) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{2, 3}
+	return fileDescriptor_b6d125f880f9ca35, []int{2, 4}
 }
 
 func (m *Listing_Tax) XXX_Unmarshal(b []byte) error {
@@ -1256,7 +1488,7 @@ func (m *Listing_Coupon) Reset()         { *m = Listing_Coupon{} }
 func (m *Listing_Coupon) String() string { return proto.CompactTextString(m) }
 func (*Listing_Coupon) ProtoMessage()    {}
 func (*Listing_Coupon) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{2, 4}
+	return fileDescriptor_b6d125f880f9ca35, []int{2, 5}
 }
 
 func (m *Listing_Coupon) XXX_Unmarshal(b []byte) error {
@@ -1369,19 +1601,24 @@ func (*Listing_Coupon) XXX_OneofWrappers() []interface{} {
 }
 
 type Order struct {
-	RefundAddress        string               `protobuf:"bytes,1,opt,name=refundAddress,proto3" json:"refundAddress,omitempty"`
-	RefundFee            uint64               `protobuf:"varint,2,opt,name=refundFee,proto3" json:"refundFee,omitempty"`
-	Shipping             *Order_Shipping      `protobuf:"bytes,3,opt,name=shipping,proto3" json:"shipping,omitempty"`
-	BuyerID              *ID                  `protobuf:"bytes,4,opt,name=buyerID,proto3" json:"buyerID,omitempty"`
-	Timestamp            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Items                []*Order_Item        `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`
-	Payment              *Order_Payment       `protobuf:"bytes,7,opt,name=payment,proto3" json:"payment,omitempty"`
-	RatingKeys           [][]byte             `protobuf:"bytes,8,rep,name=ratingKeys,proto3" json:"ratingKeys,omitempty"`
-	AlternateContactInfo string               `protobuf:"bytes,9,opt,name=alternateContactInfo,proto3" json:"alternateContactInfo,omitempty"`
-	Version              uint32               `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	RefundAddress        string                    `protobuf:"bytes,1,opt,name=refundAddress,proto3" json:"refundAddress,omitempty"`
+	RefundFee            uint64                    `protobuf:"varint,2,opt,name=refundFee,proto3" json:"refundFee,omitempty"`
+	Shipping             *Order_Shipping           `protobuf:"bytes,3,opt,name=shipping,proto3" json:"shipping,omitempty"`
+	BuyerID              *ID                       `protobuf:"bytes,4,opt,name=buyerID,proto3" json:"buyerID,omitempty"`
+	Timestamp            *timestamp.Timestamp      `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Items                []*Order_Item             `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`
+	Payment              *Order_Payment            `protobuf:"bytes,7,opt,name=payment,proto3" json:"payment,omitempty"`
+	RatingKeys           [][]byte                  `protobuf:"bytes,8,rep,name=ratingKeys,proto3" json:"ratingKeys,omitempty"`
+	AlternateContactInfo string                    `protobuf:"bytes,9,opt,name=alternateContactInfo,proto3" json:"alternateContactInfo,omitempty"`
+	Version              uint32                    `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
+	Milestones           []*Order_Milestone        `protobuf:"bytes,6660,rep,name=milestones,proto3" json:"milestones,omitempty"`
+	BillingRate          *Order_BillingRate        `protobuf:"bytes,6661,opt,name=billingRate,proto3" json:"billingRate,omitempty"`
+	SubscriptionPeriod   *Order_SubscriptionPeriod `protobuf:"bytes,6662,opt,name=subscriptionPeriod,proto3" json:"subscriptionPeriod,omitempty"`
+	QuoteHistory         *QuoteHistory             `protobuf:"bytes,6663,opt,name=quoteHistory,proto3" json:"quoteHistory,omitempty"`
+	Appointment          *Order_Appointment        `protobuf:"bytes,6664,opt,name=appointment,proto3" json:"appointment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
 func (m *Order) Reset()         { *m = Order{} }
@@ -1479,131 +1716,386 @@ func (m *Order) GetVersion() uint32 {
 	return 0
 }
 
-type Order_Shipping struct {
-	ShipTo               string      `protobuf:"bytes,1,opt,name=shipTo,proto3" json:"shipTo,omitempty"`
-	Address              string      `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	City                 string      `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
-	State                string      `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
-	PostalCode           string      `protobuf:"bytes,5,opt,name=postalCode,proto3" json:"postalCode,omitempty"`
-	Country              CountryCode `protobuf:"varint,6,opt,name=country,proto3,enum=CountryCode" json:"country,omitempty"`
-	AddressNotes         string      `protobuf:"bytes,7,opt,name=addressNotes,proto3" json:"addressNotes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
-}
-
-func (m *Order_Shipping) Reset()         { *m = Order_Shipping{} }
-func (m *Order_Shipping) String() string { return proto.CompactTextString(m) }
-func (*Order_Shipping) ProtoMessage()    {}
-func (*Order_Shipping) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{3, 0}
-}
-
-func (m *Order_Shipping) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Order_Shipping.Unmarshal(m, b)
-}
-func (m *Order_Shipping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Order_Shipping.Marshal(b, m, deterministic)
-}
-func (m *Order_Shipping) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Order_Shipping.Merge(m, src)
-}
-func (m *Order_Shipping) XXX_Size() int {
-	return xxx_messageInfo_Order_Shipping.Size(m)
-}
-func (m *Order_Shipping) XXX_DiscardUnknown() {
-	xxx_messageInfo_Order_Shipping.DiscardUnknown(m)
+func (m *Order) GetMilestones() []*Order_Milestone {
+	if m != nil {
+		return m.Milestones
+	}
+	return nil
 }
 
-var xxx_messageInfo_Order_Shipping proto.InternalMessageInfo
-
-func (m *Order_Shipping) GetShipTo() string {
+func (m *Order) GetBillingRate() *Order_BillingRate {
 	if m != nil {
-		return m.ShipTo
+		return m.BillingRate
 	}
-	return ""
+	return nil
 }
 
-func (m *Order_Shipping) GetAddress() string {
+func (m *Order) GetSubscriptionPeriod() *Order_SubscriptionPeriod {
 	if m != nil {
-		return m.Address
+		return m.SubscriptionPeriod
 	}
-	return ""
+	return nil
 }
 
-func (m *Order_Shipping) GetCity() string {
+func (m *Order) GetQuoteHistory() *QuoteHistory {
 	if m != nil {
-		return m.City
+		return m.QuoteHistory
 	}
-	return ""
+	return nil
 }
 
-func (m *Order_Shipping) GetState() string {
+func (m *Order) GetAppointment() *Order_Appointment {
 	if m != nil {
-		return m.State
+		return m.Appointment
 	}
-	return ""
+	return nil
 }
 
-func (m *Order_Shipping) GetPostalCode() string {
+type Order_Milestone struct {
+	Index                uint32   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Title                string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Amount               uint64   `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Order_Milestone) Reset()         { *m = Order_Milestone{} }
+func (m *Order_Milestone) String() string { return proto.CompactTextString(m) }
+func (*Order_Milestone) ProtoMessage()    {}
+func (*Order_Milestone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{3, 0}
+}
+
+func (m *Order_Milestone) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Order_Milestone.Unmarshal(m, b)
+}
+func (m *Order_Milestone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Order_Milestone.Marshal(b, m, deterministic)
+}
+func (m *Order_Milestone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Order_Milestone.Merge(m, src)
+}
+func (m *Order_Milestone) XXX_Size() int {
+	return xxx_messageInfo_Order_Milestone.Size(m)
+}
+func (m *Order_Milestone) XXX_DiscardUnknown() {
+	xxx_messageInfo_Order_Milestone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Order_Milestone proto.InternalMessageInfo
+
+func (m *Order_Milestone) GetIndex() uint32 {
 	if m != nil {
-		return m.PostalCode
+		return m.Index
 	}
-	return ""
+	return 0
 }
 
-func (m *Order_Shipping) GetCountry() CountryCode {
+func (m *Order_Milestone) GetTitle() string {
 	if m != nil {
-		return m.Country
+		return m.Title
 	}
-	return CountryCode_NA
+	return ""
 }
 
-func (m *Order_Shipping) GetAddressNotes() string {
+func (m *Order_Milestone) GetAmount() uint64 {
 	if m != nil {
-		return m.AddressNotes
+		return m.Amount
 	}
-	return ""
+	return 0
 }
 
-type Order_Item struct {
-	ListingHash          string                     `protobuf:"bytes,1,opt,name=listingHash,proto3" json:"listingHash,omitempty"`
-	Quantity             uint32                     `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	Quantity64           uint64                     `protobuf:"varint,8,opt,name=quantity64,proto3" json:"quantity64,omitempty"`
-	Options              []*Order_Item_Option       `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
-	ShippingOption       *Order_Item_ShippingOption `protobuf:"bytes,4,opt,name=shippingOption,proto3" json:"shippingOption,omitempty"`
-	Memo                 string                     `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
-	CouponCodes          []string                   `protobuf:"bytes,6,rep,name=couponCodes,proto3" json:"couponCodes,omitempty"`
-	PaymentAddress       string                     `protobuf:"bytes,7,opt,name=paymentAddress,proto3" json:"paymentAddress,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+type Order_Appointment struct {
+	Start                *timestamp.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End                  *timestamp.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	Timezone             string               `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *Order_Item) Reset()         { *m = Order_Item{} }
-func (m *Order_Item) String() string { return proto.CompactTextString(m) }
-func (*Order_Item) ProtoMessage()    {}
-func (*Order_Item) Descriptor() ([]byte, []int) {
+func (m *Order_Appointment) Reset()         { *m = Order_Appointment{} }
+func (m *Order_Appointment) String() string { return proto.CompactTextString(m) }
+func (*Order_Appointment) ProtoMessage()    {}
+func (*Order_Appointment) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b6d125f880f9ca35, []int{3, 1}
 }
 
-func (m *Order_Item) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Order_Item.Unmarshal(m, b)
+func (m *Order_Appointment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Order_Appointment.Unmarshal(m, b)
 }
-func (m *Order_Item) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Order_Item.Marshal(b, m, deterministic)
+func (m *Order_Appointment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Order_Appointment.Marshal(b, m, deterministic)
 }
-func (m *Order_Item) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Order_Item.Merge(m, src)
+func (m *Order_Appointment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Order_Appointment.Merge(m, src)
 }
-func (m *Order_Item) XXX_Size() int {
-	return xxx_messageInfo_Order_Item.Size(m)
+func (m *Order_Appointment) XXX_Size() int {
+	return xxx_messageInfo_Order_Appointment.Size(m)
 }
-func (m *Order_Item) XXX_DiscardUnknown() {
-	xxx_messageInfo_Order_Item.DiscardUnknown(m)
+func (m *Order_Appointment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Order_Appointment.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Order_Item proto.InternalMessageInfo
+var xxx_messageInfo_Order_Appointment proto.InternalMessageInfo
+
+func (m *Order_Appointment) GetStart() *timestamp.Timestamp {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *Order_Appointment) GetEnd() *timestamp.Timestamp {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+func (m *Order_Appointment) GetTimezone() string {
+	if m != nil {
+		return m.Timezone
+	}
+	return ""
+}
+
+type Order_SubscriptionPeriod struct {
+	Subscription         *Subscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	Signature            []byte        `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Period               uint32        `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *Order_SubscriptionPeriod) Reset()         { *m = Order_SubscriptionPeriod{} }
+func (m *Order_SubscriptionPeriod) String() string { return proto.CompactTextString(m) }
+func (*Order_SubscriptionPeriod) ProtoMessage()    {}
+func (*Order_SubscriptionPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{3, 2}
+}
+
+func (m *Order_SubscriptionPeriod) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Order_SubscriptionPeriod.Unmarshal(m, b)
+}
+func (m *Order_SubscriptionPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Order_SubscriptionPeriod.Marshal(b, m, deterministic)
+}
+func (m *Order_SubscriptionPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Order_SubscriptionPeriod.Merge(m, src)
+}
+func (m *Order_SubscriptionPeriod) XXX_Size() int {
+	return xxx_messageInfo_Order_SubscriptionPeriod.Size(m)
+}
+func (m *Order_SubscriptionPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_Order_SubscriptionPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Order_SubscriptionPeriod proto.InternalMessageInfo
+
+func (m *Order_SubscriptionPeriod) GetSubscription() *Subscription {
+	if m != nil {
+		return m.Subscription
+	}
+	return nil
+}
+
+func (m *Order_SubscriptionPeriod) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *Order_SubscriptionPeriod) GetPeriod() uint32 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+type Order_BillingRate struct {
+	RateMethod           Listing_Metadata_ServiceRateMethod `protobuf:"varint,1,opt,name=rateMethod,proto3,enum=Listing_Metadata_ServiceRateMethod" json:"rateMethod,omitempty"`
+	UnitPrice            uint64                             `protobuf:"varint,2,opt,name=unitPrice,proto3" json:"unitPrice,omitempty"`
+	EstimatedUnits       uint64                             `protobuf:"varint,3,opt,name=estimatedUnits,proto3" json:"estimatedUnits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
+}
+
+func (m *Order_BillingRate) Reset()         { *m = Order_BillingRate{} }
+func (m *Order_BillingRate) String() string { return proto.CompactTextString(m) }
+func (*Order_BillingRate) ProtoMessage()    {}
+func (*Order_BillingRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{3, 3}
+}
+
+func (m *Order_BillingRate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Order_BillingRate.Unmarshal(m, b)
+}
+func (m *Order_BillingRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Order_BillingRate.Marshal(b, m, deterministic)
+}
+func (m *Order_BillingRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Order_BillingRate.Merge(m, src)
+}
+func (m *Order_BillingRate) XXX_Size() int {
+	return xxx_messageInfo_Order_BillingRate.Size(m)
+}
+func (m *Order_BillingRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_Order_BillingRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Order_BillingRate proto.InternalMessageInfo
+
+func (m *Order_BillingRate) GetRateMethod() Listing_Metadata_ServiceRateMethod {
+	if m != nil {
+		return m.RateMethod
+	}
+	return Listing_Metadata_FIXED
+}
+
+func (m *Order_BillingRate) GetUnitPrice() uint64 {
+	if m != nil {
+		return m.UnitPrice
+	}
+	return 0
+}
+
+func (m *Order_BillingRate) GetEstimatedUnits() uint64 {
+	if m != nil {
+		return m.EstimatedUnits
+	}
+	return 0
+}
+
+type Order_Shipping struct {
+	ShipTo               string      `protobuf:"bytes,1,opt,name=shipTo,proto3" json:"shipTo,omitempty"`
+	Address              string      `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	City                 string      `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	State                string      `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	PostalCode           string      `protobuf:"bytes,5,opt,name=postalCode,proto3" json:"postalCode,omitempty"`
+	Country              CountryCode `protobuf:"varint,6,opt,name=country,proto3,enum=CountryCode" json:"country,omitempty"`
+	AddressNotes         string      `protobuf:"bytes,7,opt,name=addressNotes,proto3" json:"addressNotes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *Order_Shipping) Reset()         { *m = Order_Shipping{} }
+func (m *Order_Shipping) String() string { return proto.CompactTextString(m) }
+func (*Order_Shipping) ProtoMessage()    {}
+func (*Order_Shipping) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{3, 4}
+}
+
+func (m *Order_Shipping) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Order_Shipping.Unmarshal(m, b)
+}
+func (m *Order_Shipping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Order_Shipping.Marshal(b, m, deterministic)
+}
+func (m *Order_Shipping) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Order_Shipping.Merge(m, src)
+}
+func (m *Order_Shipping) XXX_Size() int {
+	return xxx_messageInfo_Order_Shipping.Size(m)
+}
+func (m *Order_Shipping) XXX_DiscardUnknown() {
+	xxx_messageInfo_Order_Shipping.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Order_Shipping proto.InternalMessageInfo
+
+func (m *Order_Shipping) GetShipTo() string {
+	if m != nil {
+		return m.ShipTo
+	}
+	return ""
+}
+
+func (m *Order_Shipping) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Order_Shipping) GetCity() string {
+	if m != nil {
+		return m.City
+	}
+	return ""
+}
+
+func (m *Order_Shipping) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *Order_Shipping) GetPostalCode() string {
+	if m != nil {
+		return m.PostalCode
+	}
+	return ""
+}
+
+func (m *Order_Shipping) GetCountry() CountryCode {
+	if m != nil {
+		return m.Country
+	}
+	return CountryCode_NA
+}
+
+func (m *Order_Shipping) GetAddressNotes() string {
+	if m != nil {
+		return m.AddressNotes
+	}
+	return ""
+}
+
+type Order_Item struct {
+	ListingHash          string                     `protobuf:"bytes,1,opt,name=listingHash,proto3" json:"listingHash,omitempty"`
+	Quantity             uint32                     `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Quantity64           uint64                     `protobuf:"varint,8,opt,name=quantity64,proto3" json:"quantity64,omitempty"`
+	Options              []*Order_Item_Option       `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+	ShippingOption       *Order_Item_ShippingOption `protobuf:"bytes,4,opt,name=shippingOption,proto3" json:"shippingOption,omitempty"`
+	Memo                 string                     `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
+	CouponCodes          []string                   `protobuf:"bytes,6,rep,name=couponCodes,proto3" json:"couponCodes,omitempty"`
+	PaymentAddress       string                     `protobuf:"bytes,7,opt,name=paymentAddress,proto3" json:"paymentAddress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *Order_Item) Reset()         { *m = Order_Item{} }
+func (m *Order_Item) String() string { return proto.CompactTextString(m) }
+func (*Order_Item) ProtoMessage()    {}
+func (*Order_Item) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{3, 5}
+}
+
+func (m *Order_Item) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Order_Item.Unmarshal(m, b)
+}
+func (m *Order_Item) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Order_Item.Marshal(b, m, deterministic)
+}
+func (m *Order_Item) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Order_Item.Merge(m, src)
+}
+func (m *Order_Item) XXX_Size() int {
+	return xxx_messageInfo_Order_Item.Size(m)
+}
+func (m *Order_Item) XXX_DiscardUnknown() {
+	xxx_messageInfo_Order_Item.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Order_Item proto.InternalMessageInfo
 
 func (m *Order_Item) GetListingHash() string {
 	if m != nil {
@@ -1673,7 +2165,7 @@ func (m *Order_Item_Option) Reset()         { *m = Order_Item_Option{} }
 func (m *Order_Item_Option) String() string { return proto.CompactTextString(m) }
 func (*Order_Item_Option) ProtoMessage()    {}
 func (*Order_Item_Option) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{3, 1, 0}
+	return fileDescriptor_b6d125f880f9ca35, []int{3, 5, 0}
 }
 
 func (m *Order_Item_Option) XXX_Unmarshal(b []byte) error {
@@ -1720,7 +2212,7 @@ func (m *Order_Item_ShippingOption) Reset()         { *m = Order_Item_ShippingOp
 func (m *Order_Item_ShippingOption) String() string { return proto.CompactTextString(m) }
 func (*Order_Item_ShippingOption) ProtoMessage()    {}
 func (*Order_Item_ShippingOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{3, 1, 1}
+	return fileDescriptor_b6d125f880f9ca35, []int{3, 5, 1}
 }
 
 func (m *Order_Item_ShippingOption) XXX_Unmarshal(b []byte) error {
@@ -1756,24 +2248,25 @@ func (m *Order_Item_ShippingOption) GetService() string {
 }
 
 type Order_Payment struct {
-	Method               Order_Payment_Method `protobuf:"varint,1,opt,name=method,proto3,enum=Order_Payment_Method" json:"method,omitempty"`
-	Moderator            string               `protobuf:"bytes,2,opt,name=moderator,proto3" json:"moderator,omitempty"`
-	Amount               uint64               `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	Chaincode            string               `protobuf:"bytes,4,opt,name=chaincode,proto3" json:"chaincode,omitempty"`
-	Address              string               `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
-	RedeemScript         string               `protobuf:"bytes,6,opt,name=redeemScript,proto3" json:"redeemScript,omitempty"`
-	ModeratorKey         []byte               `protobuf:"bytes,7,opt,name=moderatorKey,proto3" json:"moderatorKey,omitempty"`
-	Coin                 string               `protobuf:"bytes,8,opt,name=coin,proto3" json:"coin,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Method               Order_Payment_Method          `protobuf:"varint,1,opt,name=method,proto3,enum=Order_Payment_Method" json:"method,omitempty"`
+	Moderator            string                        `protobuf:"bytes,2,opt,name=moderator,proto3" json:"moderator,omitempty"`
+	Amount               uint64                        `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Chaincode            string                        `protobuf:"bytes,4,opt,name=chaincode,proto3" json:"chaincode,omitempty"`
+	Address              string                        `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	RedeemScript         string                        `protobuf:"bytes,6,opt,name=redeemScript,proto3" json:"redeemScript,omitempty"`
+	ModeratorKey         []byte                        `protobuf:"bytes,7,opt,name=moderatorKey,proto3" json:"moderatorKey,omitempty"`
+	Coin                 string                        `protobuf:"bytes,8,opt,name=coin,proto3" json:"coin,omitempty"`
+	ModeratorPanel       *Order_Payment_ModeratorPanel `protobuf:"bytes,6660,opt,name=moderatorPanel,proto3" json:"moderatorPanel,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
 }
 
 func (m *Order_Payment) Reset()         { *m = Order_Payment{} }
 func (m *Order_Payment) String() string { return proto.CompactTextString(m) }
 func (*Order_Payment) ProtoMessage()    {}
 func (*Order_Payment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{3, 2}
+	return fileDescriptor_b6d125f880f9ca35, []int{3, 6}
 }
 
 func (m *Order_Payment) XXX_Unmarshal(b []byte) error {
@@ -1850,6 +2343,68 @@ func (m *Order_Payment) GetCoin() string {
 	return ""
 }
 
+func (m *Order_Payment) GetModeratorPanel() *Order_Payment_ModeratorPanel {
+	if m != nil {
+		return m.ModeratorPanel
+	}
+	return nil
+}
+
+type Order_Payment_ModeratorPanel struct {
+	Moderators           []string `protobuf:"bytes,1,rep,name=moderators,proto3" json:"moderators,omitempty"`
+	ModeratorKeys        [][]byte `protobuf:"bytes,2,rep,name=moderatorKeys,proto3" json:"moderatorKeys,omitempty"`
+	Threshold            uint32   `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Order_Payment_ModeratorPanel) Reset()         { *m = Order_Payment_ModeratorPanel{} }
+func (m *Order_Payment_ModeratorPanel) String() string { return proto.CompactTextString(m) }
+func (*Order_Payment_ModeratorPanel) ProtoMessage()    {}
+func (*Order_Payment_ModeratorPanel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{3, 6, 0}
+}
+
+func (m *Order_Payment_ModeratorPanel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Order_Payment_ModeratorPanel.Unmarshal(m, b)
+}
+func (m *Order_Payment_ModeratorPanel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Order_Payment_ModeratorPanel.Marshal(b, m, deterministic)
+}
+func (m *Order_Payment_ModeratorPanel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Order_Payment_ModeratorPanel.Merge(m, src)
+}
+func (m *Order_Payment_ModeratorPanel) XXX_Size() int {
+	return xxx_messageInfo_Order_Payment_ModeratorPanel.Size(m)
+}
+func (m *Order_Payment_ModeratorPanel) XXX_DiscardUnknown() {
+	xxx_messageInfo_Order_Payment_ModeratorPanel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Order_Payment_ModeratorPanel proto.InternalMessageInfo
+
+func (m *Order_Payment_ModeratorPanel) GetModerators() []string {
+	if m != nil {
+		return m.Moderators
+	}
+	return nil
+}
+
+func (m *Order_Payment_ModeratorPanel) GetModeratorKeys() [][]byte {
+	if m != nil {
+		return m.ModeratorKeys
+	}
+	return nil
+}
+
+func (m *Order_Payment_ModeratorPanel) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
 type OrderConfirmation struct {
 	OrderID   string               `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
 	Timestamp *timestamp.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
@@ -2109,8 +2664,10 @@ type RatingSignature_TransactionMetadata_Image struct {
 func (m *RatingSignature_TransactionMetadata_Image) Reset() {
 	*m = RatingSignature_TransactionMetadata_Image{}
 }
-func (m *RatingSignature_TransactionMetadata_Image) String() string { return proto.CompactTextString(m) }
-func (*RatingSignature_TransactionMetadata_Image) ProtoMessage()    {}
+func (m *RatingSignature_TransactionMetadata_Image) String() string {
+	return proto.CompactTextString(m)
+}
+func (*RatingSignature_TransactionMetadata_Image) ProtoMessage() {}
 func (*RatingSignature_TransactionMetadata_Image) Descriptor() ([]byte, []int) {
 	return fileDescriptor_b6d125f880f9ca35, []int{6, 0, 0}
 }
@@ -2231,6 +2788,8 @@ type OrderFulfillment struct {
 	CryptocurrencyDelivery []*OrderFulfillment_CryptocurrencyDelivery `protobuf:"bytes,9,rep,name=cryptocurrencyDelivery,proto3" json:"cryptocurrencyDelivery,omitempty"`
 	BuyerRating            *EntityRating                              `protobuf:"bytes,6660,opt,name=buyerRating,proto3" json:"buyerRating,omitempty"`
 	BuyerRatingSignature   []byte                                     `protobuf:"bytes,6661,opt,name=buyerRatingSignature,proto3" json:"buyerRatingSignature,omitempty"`
+	MilestoneIndex         uint32                                     `protobuf:"varint,6662,opt,name=milestoneIndex,proto3" json:"milestoneIndex,omitempty"`
+	SignedBuyerRating      *BuyerRating                               `protobuf:"bytes,6663,opt,name=signedBuyerRating,proto3" json:"signedBuyerRating,omitempty"`
 	XXX_NoUnkeyedLiteral   struct{}                                   `json:"-"`
 	XXX_unrecognized       []byte                                     `json:"-"`
 	XXX_sizecache          int32                                      `json:"-"`
@@ -2338,6 +2897,20 @@ func (m *OrderFulfillment) GetBuyerRatingSignature() []byte {
 	return nil
 }
 
+func (m *OrderFulfillment) GetMilestoneIndex() uint32 {
+	if m != nil {
+		return m.MilestoneIndex
+	}
+	return 0
+}
+
+func (m *OrderFulfillment) GetSignedBuyerRating() *BuyerRating {
+	if m != nil {
+		return m.SignedBuyerRating
+	}
+	return nil
+}
+
 type OrderFulfillment_PhysicalDelivery struct {
 	Shipper              string   `protobuf:"bytes,1,opt,name=shipper,proto3" json:"shipper,omitempty"`
 	TrackingNumber       string   `protobuf:"bytes,2,opt,name=trackingNumber,proto3" json:"trackingNumber,omitempty"`
@@ -2477,6 +3050,7 @@ type OrderFulfillment_Payout struct {
 	Sigs                 []*BitcoinSignature `protobuf:"bytes,1,rep,name=sigs,proto3" json:"sigs,omitempty"`
 	PayoutAddress        string              `protobuf:"bytes,2,opt,name=payoutAddress,proto3" json:"payoutAddress,omitempty"`
 	PayoutFeePerByte     uint64              `protobuf:"varint,3,opt,name=payoutFeePerByte,proto3" json:"payoutFeePerByte,omitempty"`
+	Inputs               []*Outpoint         `protobuf:"bytes,6660,rep,name=inputs,proto3" json:"inputs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -2528,6 +3102,13 @@ func (m *OrderFulfillment_Payout) GetPayoutFeePerByte() uint64 {
 	return 0
 }
 
+func (m *OrderFulfillment_Payout) GetInputs() []*Outpoint {
+	if m != nil {
+		return m.Inputs
+	}
+	return nil
+}
+
 type EntityRatingStore struct {
 	Type                 string          `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 	Ratings              []*EntityRating `protobuf:"bytes,2,rep,name=ratings,proto3" json:"ratings,omitempty"`
@@ -2693,65 +3274,1114 @@ func (m *EntityRating_RatingFields) GetWeight() uint64 {
 	return 0
 }
 
-type OrderCompletion struct {
-	OrderId              string               `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
-	Timestamp            *timestamp.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	PayoutSigs           []*BitcoinSignature  `protobuf:"bytes,3,rep,name=payoutSigs,proto3" json:"payoutSigs,omitempty"`
+type BuyerRating struct {
+	RatingData           *BuyerRating_BuyerRatingData `protobuf:"bytes,1,opt,name=ratingData,proto3" json:"ratingData,omitempty"`
+	Signature            []byte                       `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *BuyerRating) Reset()         { *m = BuyerRating{} }
+func (m *BuyerRating) String() string { return proto.CompactTextString(m) }
+func (*BuyerRating) ProtoMessage()    {}
+func (*BuyerRating) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{11}
+}
+
+func (m *BuyerRating) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BuyerRating.Unmarshal(m, b)
+}
+func (m *BuyerRating) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BuyerRating.Marshal(b, m, deterministic)
+}
+func (m *BuyerRating) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuyerRating.Merge(m, src)
+}
+func (m *BuyerRating) XXX_Size() int {
+	return xxx_messageInfo_BuyerRating.Size(m)
+}
+func (m *BuyerRating) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuyerRating.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuyerRating proto.InternalMessageInfo
+
+func (m *BuyerRating) GetRatingData() *BuyerRating_BuyerRatingData {
+	if m != nil {
+		return m.RatingData
+	}
+	return nil
+}
+
+func (m *BuyerRating) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type BuyerRating_BuyerRatingData struct {
+	VendorID             *ID                  `protobuf:"bytes,1,opt,name=vendorID,proto3" json:"vendorID,omitempty"`
+	BuyerID              *ID                  `protobuf:"bytes,2,opt,name=buyerID,proto3" json:"buyerID,omitempty"`
+	OrderID              string               `protobuf:"bytes,3,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	ListingSlug          string               `protobuf:"bytes,4,opt,name=listingSlug,proto3" json:"listingSlug,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Rating               *EntityRating        `protobuf:"bytes,6,opt,name=rating,proto3" json:"rating,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *BuyerRating_BuyerRatingData) Reset()         { *m = BuyerRating_BuyerRatingData{} }
+func (m *BuyerRating_BuyerRatingData) String() string { return proto.CompactTextString(m) }
+func (*BuyerRating_BuyerRatingData) ProtoMessage()    {}
+func (*BuyerRating_BuyerRatingData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{11, 0}
+}
+
+func (m *BuyerRating_BuyerRatingData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BuyerRating_BuyerRatingData.Unmarshal(m, b)
+}
+func (m *BuyerRating_BuyerRatingData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BuyerRating_BuyerRatingData.Marshal(b, m, deterministic)
+}
+func (m *BuyerRating_BuyerRatingData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuyerRating_BuyerRatingData.Merge(m, src)
+}
+func (m *BuyerRating_BuyerRatingData) XXX_Size() int {
+	return xxx_messageInfo_BuyerRating_BuyerRatingData.Size(m)
+}
+func (m *BuyerRating_BuyerRatingData) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuyerRating_BuyerRatingData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuyerRating_BuyerRatingData proto.InternalMessageInfo
+
+func (m *BuyerRating_BuyerRatingData) GetVendorID() *ID {
+	if m != nil {
+		return m.VendorID
+	}
+	return nil
+}
+
+func (m *BuyerRating_BuyerRatingData) GetBuyerID() *ID {
+	if m != nil {
+		return m.BuyerID
+	}
+	return nil
+}
+
+func (m *BuyerRating_BuyerRatingData) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+func (m *BuyerRating_BuyerRatingData) GetListingSlug() string {
+	if m != nil {
+		return m.ListingSlug
+	}
+	return ""
+}
+
+func (m *BuyerRating_BuyerRatingData) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *BuyerRating_BuyerRatingData) GetRating() *EntityRating {
+	if m != nil {
+		return m.Rating
+	}
+	return nil
+}
+
+type BuyerRatingIndex struct {
+	Ratings              []*BuyerRating `protobuf:"bytes,1,rep,name=ratings,proto3" json:"ratings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *BuyerRatingIndex) Reset()         { *m = BuyerRatingIndex{} }
+func (m *BuyerRatingIndex) String() string { return proto.CompactTextString(m) }
+func (*BuyerRatingIndex) ProtoMessage()    {}
+func (*BuyerRatingIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{12}
+}
+
+func (m *BuyerRatingIndex) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BuyerRatingIndex.Unmarshal(m, b)
+}
+func (m *BuyerRatingIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BuyerRatingIndex.Marshal(b, m, deterministic)
+}
+func (m *BuyerRatingIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuyerRatingIndex.Merge(m, src)
+}
+func (m *BuyerRatingIndex) XXX_Size() int {
+	return xxx_messageInfo_BuyerRatingIndex.Size(m)
+}
+func (m *BuyerRatingIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuyerRatingIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuyerRatingIndex proto.InternalMessageInfo
+
+func (m *BuyerRatingIndex) GetRatings() []*BuyerRating {
+	if m != nil {
+		return m.Ratings
+	}
+	return nil
+}
+
+type OrderCompletion struct {
+	OrderId              string               `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	PayoutSigs           []*BitcoinSignature  `protobuf:"bytes,3,rep,name=payoutSigs,proto3" json:"payoutSigs,omitempty"`
 	Ratings              []*Rating            `protobuf:"bytes,4,rep,name=ratings,proto3" json:"ratings,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *OrderCompletion) Reset()         { *m = OrderCompletion{} }
-func (m *OrderCompletion) String() string { return proto.CompactTextString(m) }
-func (*OrderCompletion) ProtoMessage()    {}
-func (*OrderCompletion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{11}
+func (m *OrderCompletion) Reset()         { *m = OrderCompletion{} }
+func (m *OrderCompletion) String() string { return proto.CompactTextString(m) }
+func (*OrderCompletion) ProtoMessage()    {}
+func (*OrderCompletion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{13}
+}
+
+func (m *OrderCompletion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderCompletion.Unmarshal(m, b)
+}
+func (m *OrderCompletion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderCompletion.Marshal(b, m, deterministic)
+}
+func (m *OrderCompletion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderCompletion.Merge(m, src)
+}
+func (m *OrderCompletion) XXX_Size() int {
+	return xxx_messageInfo_OrderCompletion.Size(m)
+}
+func (m *OrderCompletion) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderCompletion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderCompletion proto.InternalMessageInfo
+
+func (m *OrderCompletion) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *OrderCompletion) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *OrderCompletion) GetPayoutSigs() []*BitcoinSignature {
+	if m != nil {
+		return m.PayoutSigs
+	}
+	return nil
+}
+
+func (m *OrderCompletion) GetRatings() []*Rating {
+	if m != nil {
+		return m.Ratings
+	}
+	return nil
+}
+
+type MilestoneRelease struct {
+	OrderId              string               `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	MilestoneIndex       uint32               `protobuf:"varint,2,opt,name=milestoneIndex,proto3" json:"milestoneIndex,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	PayoutSigs           []*BitcoinSignature  `protobuf:"bytes,4,rep,name=payoutSigs,proto3" json:"payoutSigs,omitempty"`
+	Note                 string               `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *MilestoneRelease) Reset()         { *m = MilestoneRelease{} }
+func (m *MilestoneRelease) String() string { return proto.CompactTextString(m) }
+func (*MilestoneRelease) ProtoMessage()    {}
+func (*MilestoneRelease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{14}
+}
+
+func (m *MilestoneRelease) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MilestoneRelease.Unmarshal(m, b)
+}
+func (m *MilestoneRelease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MilestoneRelease.Marshal(b, m, deterministic)
+}
+func (m *MilestoneRelease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MilestoneRelease.Merge(m, src)
+}
+func (m *MilestoneRelease) XXX_Size() int {
+	return xxx_messageInfo_MilestoneRelease.Size(m)
+}
+func (m *MilestoneRelease) XXX_DiscardUnknown() {
+	xxx_messageInfo_MilestoneRelease.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MilestoneRelease proto.InternalMessageInfo
+
+func (m *MilestoneRelease) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *MilestoneRelease) GetMilestoneIndex() uint32 {
+	if m != nil {
+		return m.MilestoneIndex
+	}
+	return 0
+}
+
+func (m *MilestoneRelease) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *MilestoneRelease) GetPayoutSigs() []*BitcoinSignature {
+	if m != nil {
+		return m.PayoutSigs
+	}
+	return nil
+}
+
+func (m *MilestoneRelease) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+type Subscription struct {
+	BuyerID              *ID                  `protobuf:"bytes,1,opt,name=buyerID,proto3" json:"buyerID,omitempty"`
+	VendorID             string               `protobuf:"bytes,2,opt,name=vendorID,proto3" json:"vendorID,omitempty"`
+	ListingSlug          string               `protobuf:"bytes,3,opt,name=listingSlug,proto3" json:"listingSlug,omitempty"`
+	ListingHash          string               `protobuf:"bytes,4,opt,name=listingHash,proto3" json:"listingHash,omitempty"`
+	Quantity             uint64               `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Options              []*Order_Item_Option `protobuf:"bytes,6,rep,name=options,proto3" json:"options,omitempty"`
+	PaymentCoin          string               `protobuf:"bytes,7,opt,name=paymentCoin,proto3" json:"paymentCoin,omitempty"`
+	Moderator            string               `protobuf:"bytes,8,opt,name=moderator,proto3" json:"moderator,omitempty"`
+	Periods              uint32               `protobuf:"varint,9,opt,name=periods,proto3" json:"periods,omitempty"`
+	Start                *timestamp.Timestamp `protobuf:"bytes,10,opt,name=start,proto3" json:"start,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Subscription) Reset()         { *m = Subscription{} }
+func (m *Subscription) String() string { return proto.CompactTextString(m) }
+func (*Subscription) ProtoMessage()    {}
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{15}
+}
+
+func (m *Subscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Subscription.Unmarshal(m, b)
+}
+func (m *Subscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Subscription.Marshal(b, m, deterministic)
+}
+func (m *Subscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Subscription.Merge(m, src)
+}
+func (m *Subscription) XXX_Size() int {
+	return xxx_messageInfo_Subscription.Size(m)
+}
+func (m *Subscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_Subscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Subscription proto.InternalMessageInfo
+
+func (m *Subscription) GetBuyerID() *ID {
+	if m != nil {
+		return m.BuyerID
+	}
+	return nil
+}
+
+func (m *Subscription) GetVendorID() string {
+	if m != nil {
+		return m.VendorID
+	}
+	return ""
+}
+
+func (m *Subscription) GetListingSlug() string {
+	if m != nil {
+		return m.ListingSlug
+	}
+	return ""
+}
+
+func (m *Subscription) GetListingHash() string {
+	if m != nil {
+		return m.ListingHash
+	}
+	return ""
+}
+
+func (m *Subscription) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *Subscription) GetOptions() []*Order_Item_Option {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *Subscription) GetPaymentCoin() string {
+	if m != nil {
+		return m.PaymentCoin
+	}
+	return ""
+}
+
+func (m *Subscription) GetModerator() string {
+	if m != nil {
+		return m.Moderator
+	}
+	return ""
+}
+
+func (m *Subscription) GetPeriods() uint32 {
+	if m != nil {
+		return m.Periods
+	}
+	return 0
+}
+
+func (m *Subscription) GetStart() *timestamp.Timestamp {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+type SubscriptionUpdate struct {
+	SubscriptionID       string               `protobuf:"bytes,1,opt,name=subscriptionID,proto3" json:"subscriptionID,omitempty"`
+	State                Subscription_State   `protobuf:"varint,2,opt,name=state,proto3,enum=Subscription_State" json:"state,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SubscriptionUpdate) Reset()         { *m = SubscriptionUpdate{} }
+func (m *SubscriptionUpdate) String() string { return proto.CompactTextString(m) }
+func (*SubscriptionUpdate) ProtoMessage()    {}
+func (*SubscriptionUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{16}
+}
+
+func (m *SubscriptionUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscriptionUpdate.Unmarshal(m, b)
+}
+func (m *SubscriptionUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscriptionUpdate.Marshal(b, m, deterministic)
+}
+func (m *SubscriptionUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriptionUpdate.Merge(m, src)
+}
+func (m *SubscriptionUpdate) XXX_Size() int {
+	return xxx_messageInfo_SubscriptionUpdate.Size(m)
+}
+func (m *SubscriptionUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriptionUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscriptionUpdate proto.InternalMessageInfo
+
+func (m *SubscriptionUpdate) GetSubscriptionID() string {
+	if m != nil {
+		return m.SubscriptionID
+	}
+	return ""
+}
+
+func (m *SubscriptionUpdate) GetState() Subscription_State {
+	if m != nil {
+		return m.State
+	}
+	return Subscription_ACTIVE
+}
+
+func (m *SubscriptionUpdate) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+type QuoteRequest struct {
+	BuyerID              *ID                  `protobuf:"bytes,1,opt,name=buyerID,proto3" json:"buyerID,omitempty"`
+	VendorID             string               `protobuf:"bytes,2,opt,name=vendorID,proto3" json:"vendorID,omitempty"`
+	ListingSlug          string               `protobuf:"bytes,3,opt,name=listingSlug,proto3" json:"listingSlug,omitempty"`
+	Quantity             uint64               `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Options              []*Order_Item_Option `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty"`
+	Scope                string               `protobuf:"bytes,6,opt,name=scope,proto3" json:"scope,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *QuoteRequest) Reset()         { *m = QuoteRequest{} }
+func (m *QuoteRequest) String() string { return proto.CompactTextString(m) }
+func (*QuoteRequest) ProtoMessage()    {}
+func (*QuoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{17}
+}
+
+func (m *QuoteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuoteRequest.Unmarshal(m, b)
+}
+func (m *QuoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuoteRequest.Marshal(b, m, deterministic)
+}
+func (m *QuoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuoteRequest.Merge(m, src)
+}
+func (m *QuoteRequest) XXX_Size() int {
+	return xxx_messageInfo_QuoteRequest.Size(m)
+}
+func (m *QuoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuoteRequest proto.InternalMessageInfo
+
+func (m *QuoteRequest) GetBuyerID() *ID {
+	if m != nil {
+		return m.BuyerID
+	}
+	return nil
+}
+
+func (m *QuoteRequest) GetVendorID() string {
+	if m != nil {
+		return m.VendorID
+	}
+	return ""
+}
+
+func (m *QuoteRequest) GetListingSlug() string {
+	if m != nil {
+		return m.ListingSlug
+	}
+	return ""
+}
+
+func (m *QuoteRequest) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *QuoteRequest) GetOptions() []*Order_Item_Option {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+func (m *QuoteRequest) GetScope() string {
+	if m != nil {
+		return m.Scope
+	}
+	return ""
+}
+
+func (m *QuoteRequest) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+type Quote struct {
+	RequestID            string               `protobuf:"bytes,1,opt,name=requestID,proto3" json:"requestID,omitempty"`
+	Revision             uint32               `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	VendorID             *ID                  `protobuf:"bytes,3,opt,name=vendorID,proto3" json:"vendorID,omitempty"`
+	ListingHash          string               `protobuf:"bytes,4,opt,name=listingHash,proto3" json:"listingHash,omitempty"`
+	Price                uint64               `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	PricingCurrency      string               `protobuf:"bytes,6,opt,name=pricingCurrency,proto3" json:"pricingCurrency,omitempty"`
+	Scope                string               `protobuf:"bytes,7,opt,name=scope,proto3" json:"scope,omitempty"`
+	Expiry               *timestamp.Timestamp `protobuf:"bytes,8,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Quote) Reset()         { *m = Quote{} }
+func (m *Quote) String() string { return proto.CompactTextString(m) }
+func (*Quote) ProtoMessage()    {}
+func (*Quote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{18}
+}
+
+func (m *Quote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Quote.Unmarshal(m, b)
+}
+func (m *Quote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Quote.Marshal(b, m, deterministic)
+}
+func (m *Quote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Quote.Merge(m, src)
+}
+func (m *Quote) XXX_Size() int {
+	return xxx_messageInfo_Quote.Size(m)
+}
+func (m *Quote) XXX_DiscardUnknown() {
+	xxx_messageInfo_Quote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Quote proto.InternalMessageInfo
+
+func (m *Quote) GetRequestID() string {
+	if m != nil {
+		return m.RequestID
+	}
+	return ""
+}
+
+func (m *Quote) GetRevision() uint32 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *Quote) GetVendorID() *ID {
+	if m != nil {
+		return m.VendorID
+	}
+	return nil
+}
+
+func (m *Quote) GetListingHash() string {
+	if m != nil {
+		return m.ListingHash
+	}
+	return ""
+}
+
+func (m *Quote) GetPrice() uint64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *Quote) GetPricingCurrency() string {
+	if m != nil {
+		return m.PricingCurrency
+	}
+	return ""
+}
+
+func (m *Quote) GetScope() string {
+	if m != nil {
+		return m.Scope
+	}
+	return ""
+}
+
+func (m *Quote) GetExpiry() *timestamp.Timestamp {
+	if m != nil {
+		return m.Expiry
+	}
+	return nil
+}
+
+func (m *Quote) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+type SignedQuoteRequest struct {
+	Request              *QuoteRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	Signature            []byte        `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SignedQuoteRequest) Reset()         { *m = SignedQuoteRequest{} }
+func (m *SignedQuoteRequest) String() string { return proto.CompactTextString(m) }
+func (*SignedQuoteRequest) ProtoMessage()    {}
+func (*SignedQuoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{19}
+}
+
+func (m *SignedQuoteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignedQuoteRequest.Unmarshal(m, b)
+}
+func (m *SignedQuoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignedQuoteRequest.Marshal(b, m, deterministic)
+}
+func (m *SignedQuoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedQuoteRequest.Merge(m, src)
+}
+func (m *SignedQuoteRequest) XXX_Size() int {
+	return xxx_messageInfo_SignedQuoteRequest.Size(m)
+}
+func (m *SignedQuoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedQuoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignedQuoteRequest proto.InternalMessageInfo
+
+func (m *SignedQuoteRequest) GetRequest() *QuoteRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *SignedQuoteRequest) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type SignedQuote struct {
+	Quote                *Quote   `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	Signature            []byte   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignedQuote) Reset()         { *m = SignedQuote{} }
+func (m *SignedQuote) String() string { return proto.CompactTextString(m) }
+func (*SignedQuote) ProtoMessage()    {}
+func (*SignedQuote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{20}
+}
+
+func (m *SignedQuote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignedQuote.Unmarshal(m, b)
+}
+func (m *SignedQuote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignedQuote.Marshal(b, m, deterministic)
+}
+func (m *SignedQuote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedQuote.Merge(m, src)
+}
+func (m *SignedQuote) XXX_Size() int {
+	return xxx_messageInfo_SignedQuote.Size(m)
+}
+func (m *SignedQuote) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedQuote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignedQuote proto.InternalMessageInfo
+
+func (m *SignedQuote) GetQuote() *Quote {
+	if m != nil {
+		return m.Quote
+	}
+	return nil
+}
+
+func (m *SignedQuote) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type QuoteHistory struct {
+	Request              *SignedQuoteRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	Quotes               []*SignedQuote      `protobuf:"bytes,2,rep,name=quotes,proto3" json:"quotes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *QuoteHistory) Reset()         { *m = QuoteHistory{} }
+func (m *QuoteHistory) String() string { return proto.CompactTextString(m) }
+func (*QuoteHistory) ProtoMessage()    {}
+func (*QuoteHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{21}
+}
+
+func (m *QuoteHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuoteHistory.Unmarshal(m, b)
+}
+func (m *QuoteHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuoteHistory.Marshal(b, m, deterministic)
+}
+func (m *QuoteHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuoteHistory.Merge(m, src)
+}
+func (m *QuoteHistory) XXX_Size() int {
+	return xxx_messageInfo_QuoteHistory.Size(m)
+}
+func (m *QuoteHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuoteHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuoteHistory proto.InternalMessageInfo
+
+func (m *QuoteHistory) GetRequest() *SignedQuoteRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *QuoteHistory) GetQuotes() []*SignedQuote {
+	if m != nil {
+		return m.Quotes
+	}
+	return nil
+}
+
+type TimesheetEntry struct {
+	OrderId              string               `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	Index                uint32               `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Start                *timestamp.Timestamp `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	End                  *timestamp.Timestamp `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+	Description          string               `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *TimesheetEntry) Reset()         { *m = TimesheetEntry{} }
+func (m *TimesheetEntry) String() string { return proto.CompactTextString(m) }
+func (*TimesheetEntry) ProtoMessage()    {}
+func (*TimesheetEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{22}
+}
+
+func (m *TimesheetEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TimesheetEntry.Unmarshal(m, b)
+}
+func (m *TimesheetEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TimesheetEntry.Marshal(b, m, deterministic)
+}
+func (m *TimesheetEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimesheetEntry.Merge(m, src)
+}
+func (m *TimesheetEntry) XXX_Size() int {
+	return xxx_messageInfo_TimesheetEntry.Size(m)
+}
+func (m *TimesheetEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimesheetEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimesheetEntry proto.InternalMessageInfo
+
+func (m *TimesheetEntry) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *TimesheetEntry) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *TimesheetEntry) GetStart() *timestamp.Timestamp {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *TimesheetEntry) GetEnd() *timestamp.Timestamp {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+func (m *TimesheetEntry) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *TimesheetEntry) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+type TimesheetReview struct {
+	OrderId              string                 `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	EntryIndex           uint32                 `protobuf:"varint,2,opt,name=entryIndex,proto3" json:"entryIndex,omitempty"`
+	Status               TimesheetReview_Status `protobuf:"varint,3,opt,name=status,proto3,enum=TimesheetReview_Status" json:"status,omitempty"`
+	Reason               string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Timestamp            *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *TimesheetReview) Reset()         { *m = TimesheetReview{} }
+func (m *TimesheetReview) String() string { return proto.CompactTextString(m) }
+func (*TimesheetReview) ProtoMessage()    {}
+func (*TimesheetReview) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{23}
+}
+
+func (m *TimesheetReview) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TimesheetReview.Unmarshal(m, b)
+}
+func (m *TimesheetReview) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TimesheetReview.Marshal(b, m, deterministic)
+}
+func (m *TimesheetReview) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimesheetReview.Merge(m, src)
+}
+func (m *TimesheetReview) XXX_Size() int {
+	return xxx_messageInfo_TimesheetReview.Size(m)
+}
+func (m *TimesheetReview) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimesheetReview.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimesheetReview proto.InternalMessageInfo
+
+func (m *TimesheetReview) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *TimesheetReview) GetEntryIndex() uint32 {
+	if m != nil {
+		return m.EntryIndex
+	}
+	return 0
+}
+
+func (m *TimesheetReview) GetStatus() TimesheetReview_Status {
+	if m != nil {
+		return m.Status
+	}
+	return TimesheetReview_PENDING
+}
+
+func (m *TimesheetReview) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *TimesheetReview) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+type OrderAmendment struct {
+	OrderId              string               `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	Index                uint32               `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Proposer             OrderAmendment_Party `protobuf:"varint,3,opt,name=proposer,proto3,enum=OrderAmendment_Party" json:"proposer,omitempty"`
+	Quantity             uint64               `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	DeliveryDate         *timestamp.Timestamp `protobuf:"bytes,5,opt,name=deliveryDate,proto3" json:"deliveryDate,omitempty"`
+	Payment              *Order_Payment       `protobuf:"bytes,6,opt,name=payment,proto3" json:"payment,omitempty"`
+	Memo                 string               `protobuf:"bytes,7,opt,name=memo,proto3" json:"memo,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *OrderAmendment) Reset()         { *m = OrderAmendment{} }
+func (m *OrderAmendment) String() string { return proto.CompactTextString(m) }
+func (*OrderAmendment) ProtoMessage()    {}
+func (*OrderAmendment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{24}
 }
 
-func (m *OrderCompletion) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_OrderCompletion.Unmarshal(m, b)
+func (m *OrderAmendment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderAmendment.Unmarshal(m, b)
 }
-func (m *OrderCompletion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_OrderCompletion.Marshal(b, m, deterministic)
+func (m *OrderAmendment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderAmendment.Marshal(b, m, deterministic)
 }
-func (m *OrderCompletion) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_OrderCompletion.Merge(m, src)
+func (m *OrderAmendment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderAmendment.Merge(m, src)
 }
-func (m *OrderCompletion) XXX_Size() int {
-	return xxx_messageInfo_OrderCompletion.Size(m)
+func (m *OrderAmendment) XXX_Size() int {
+	return xxx_messageInfo_OrderAmendment.Size(m)
 }
-func (m *OrderCompletion) XXX_DiscardUnknown() {
-	xxx_messageInfo_OrderCompletion.DiscardUnknown(m)
+func (m *OrderAmendment) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderAmendment.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_OrderCompletion proto.InternalMessageInfo
+var xxx_messageInfo_OrderAmendment proto.InternalMessageInfo
 
-func (m *OrderCompletion) GetOrderId() string {
+func (m *OrderAmendment) GetOrderId() string {
 	if m != nil {
 		return m.OrderId
 	}
 	return ""
 }
 
-func (m *OrderCompletion) GetTimestamp() *timestamp.Timestamp {
+func (m *OrderAmendment) GetIndex() uint32 {
 	if m != nil {
-		return m.Timestamp
+		return m.Index
+	}
+	return 0
+}
+
+func (m *OrderAmendment) GetProposer() OrderAmendment_Party {
+	if m != nil {
+		return m.Proposer
+	}
+	return OrderAmendment_BUYER
+}
+
+func (m *OrderAmendment) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *OrderAmendment) GetDeliveryDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.DeliveryDate
 	}
 	return nil
 }
 
-func (m *OrderCompletion) GetPayoutSigs() []*BitcoinSignature {
+func (m *OrderAmendment) GetPayment() *Order_Payment {
 	if m != nil {
-		return m.PayoutSigs
+		return m.Payment
 	}
 	return nil
 }
 
-func (m *OrderCompletion) GetRatings() []*Rating {
+func (m *OrderAmendment) GetMemo() string {
 	if m != nil {
-		return m.Ratings
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *OrderAmendment) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+type OrderAmendmentResponse struct {
+	OrderId              string               `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	AmendmentIndex       uint32               `protobuf:"varint,2,opt,name=amendmentIndex,proto3" json:"amendmentIndex,omitempty"`
+	Accepted             bool                 `protobuf:"varint,3,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	Reason               string               `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *OrderAmendmentResponse) Reset()         { *m = OrderAmendmentResponse{} }
+func (m *OrderAmendmentResponse) String() string { return proto.CompactTextString(m) }
+func (*OrderAmendmentResponse) ProtoMessage()    {}
+func (*OrderAmendmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{25}
+}
+
+func (m *OrderAmendmentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderAmendmentResponse.Unmarshal(m, b)
+}
+func (m *OrderAmendmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderAmendmentResponse.Marshal(b, m, deterministic)
+}
+func (m *OrderAmendmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderAmendmentResponse.Merge(m, src)
+}
+func (m *OrderAmendmentResponse) XXX_Size() int {
+	return xxx_messageInfo_OrderAmendmentResponse.Size(m)
+}
+func (m *OrderAmendmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderAmendmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderAmendmentResponse proto.InternalMessageInfo
+
+func (m *OrderAmendmentResponse) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *OrderAmendmentResponse) GetAmendmentIndex() uint32 {
+	if m != nil {
+		return m.AmendmentIndex
+	}
+	return 0
+}
+
+func (m *OrderAmendmentResponse) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
+	}
+	return false
+}
+
+func (m *OrderAmendmentResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *OrderAmendmentResponse) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
 	}
 	return nil
 }
@@ -2769,7 +4399,7 @@ func (m *OrderProcessingFailure) Reset()         { *m = OrderProcessingFailure{}
 func (m *OrderProcessingFailure) String() string { return proto.CompactTextString(m) }
 func (*OrderProcessingFailure) ProtoMessage()    {}
 func (*OrderProcessingFailure) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{12}
+	return fileDescriptor_b6d125f880f9ca35, []int{26}
 }
 
 func (m *OrderProcessingFailure) XXX_Unmarshal(b []byte) error {
@@ -2823,7 +4453,7 @@ func (m *Rating) Reset()         { *m = Rating{} }
 func (m *Rating) String() string { return proto.CompactTextString(m) }
 func (*Rating) ProtoMessage()    {}
 func (*Rating) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{13}
+	return fileDescriptor_b6d125f880f9ca35, []int{27}
 }
 
 func (m *Rating) XXX_Unmarshal(b []byte) error {
@@ -2859,30 +4489,31 @@ func (m *Rating) GetSignature() []byte {
 }
 
 type Rating_RatingData struct {
-	RatingKey            []byte               `protobuf:"bytes,1,opt,name=ratingKey,proto3" json:"ratingKey,omitempty"`
-	VendorID             *ID                  `protobuf:"bytes,2,opt,name=vendorID,proto3" json:"vendorID,omitempty"`
-	VendorSig            *RatingSignature     `protobuf:"bytes,3,opt,name=vendorSig,proto3" json:"vendorSig,omitempty"`
-	BuyerID              *ID                  `protobuf:"bytes,4,opt,name=buyerID,proto3" json:"buyerID,omitempty"`
-	BuyerName            string               `protobuf:"bytes,5,opt,name=buyerName,proto3" json:"buyerName,omitempty"`
-	BuyerSig             []byte               `protobuf:"bytes,6,opt,name=buyerSig,proto3" json:"buyerSig,omitempty"`
-	ModeratorSig         []byte               `protobuf:"bytes,7,opt,name=moderatorSig,proto3" json:"moderatorSig,omitempty"`
-	Timestamp            *timestamp.Timestamp `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Overall              uint32               `protobuf:"varint,9,opt,name=overall,proto3" json:"overall,omitempty"`
-	Quality              uint32               `protobuf:"varint,10,opt,name=quality,proto3" json:"quality,omitempty"`
-	Description          uint32               `protobuf:"varint,11,opt,name=description,proto3" json:"description,omitempty"`
-	DeliverySpeed        uint32               `protobuf:"varint,12,opt,name=deliverySpeed,proto3" json:"deliverySpeed,omitempty"`
-	CustomerService      uint32               `protobuf:"varint,13,opt,name=customerService,proto3" json:"customerService,omitempty"`
-	Review               string               `protobuf:"bytes,14,opt,name=review,proto3" json:"review,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	RatingKey            []byte                       `protobuf:"bytes,1,opt,name=ratingKey,proto3" json:"ratingKey,omitempty"`
+	VendorID             *ID                          `protobuf:"bytes,2,opt,name=vendorID,proto3" json:"vendorID,omitempty"`
+	VendorSig            *RatingSignature             `protobuf:"bytes,3,opt,name=vendorSig,proto3" json:"vendorSig,omitempty"`
+	BuyerID              *ID                          `protobuf:"bytes,4,opt,name=buyerID,proto3" json:"buyerID,omitempty"`
+	BuyerName            string                       `protobuf:"bytes,5,opt,name=buyerName,proto3" json:"buyerName,omitempty"`
+	BuyerSig             []byte                       `protobuf:"bytes,6,opt,name=buyerSig,proto3" json:"buyerSig,omitempty"`
+	ModeratorSig         []byte                       `protobuf:"bytes,7,opt,name=moderatorSig,proto3" json:"moderatorSig,omitempty"`
+	Timestamp            *timestamp.Timestamp         `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Overall              uint32                       `protobuf:"varint,9,opt,name=overall,proto3" json:"overall,omitempty"`
+	Quality              uint32                       `protobuf:"varint,10,opt,name=quality,proto3" json:"quality,omitempty"`
+	Description          uint32                       `protobuf:"varint,11,opt,name=description,proto3" json:"description,omitempty"`
+	DeliverySpeed        uint32                       `protobuf:"varint,12,opt,name=deliverySpeed,proto3" json:"deliverySpeed,omitempty"`
+	CustomerService      uint32                       `protobuf:"varint,13,opt,name=customerService,proto3" json:"customerService,omitempty"`
+	Review               string                       `protobuf:"bytes,14,opt,name=review,proto3" json:"review,omitempty"`
+	Fields               []*EntityRating_RatingFields `protobuf:"bytes,6660,rep,name=fields,proto3" json:"fields,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
 }
 
 func (m *Rating_RatingData) Reset()         { *m = Rating_RatingData{} }
 func (m *Rating_RatingData) String() string { return proto.CompactTextString(m) }
 func (*Rating_RatingData) ProtoMessage()    {}
 func (*Rating_RatingData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{13, 0}
+	return fileDescriptor_b6d125f880f9ca35, []int{27, 0}
 }
 
 func (m *Rating_RatingData) XXX_Unmarshal(b []byte) error {
@@ -2973,122 +4604,282 @@ func (m *Rating_RatingData) GetQuality() uint32 {
 	return 0
 }
 
-func (m *Rating_RatingData) GetDescription() uint32 {
+func (m *Rating_RatingData) GetDescription() uint32 {
+	if m != nil {
+		return m.Description
+	}
+	return 0
+}
+
+func (m *Rating_RatingData) GetDeliverySpeed() uint32 {
+	if m != nil {
+		return m.DeliverySpeed
+	}
+	return 0
+}
+
+func (m *Rating_RatingData) GetCustomerService() uint32 {
+	if m != nil {
+		return m.CustomerService
+	}
+	return 0
+}
+
+func (m *Rating_RatingData) GetReview() string {
+	if m != nil {
+		return m.Review
+	}
+	return ""
+}
+
+func (m *Rating_RatingData) GetFields() []*EntityRating_RatingFields {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+type Dispute struct {
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Claim                string               `protobuf:"bytes,2,opt,name=claim,proto3" json:"claim,omitempty"`
+	PayoutAddress        string               `protobuf:"bytes,3,opt,name=payoutAddress,proto3" json:"payoutAddress,omitempty"`
+	Outpoints            []*Outpoint          `protobuf:"bytes,4,rep,name=outpoints,proto3" json:"outpoints,omitempty"`
+	SerializedContract   []byte               `protobuf:"bytes,5,opt,name=serializedContract,proto3" json:"serializedContract,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Dispute) Reset()         { *m = Dispute{} }
+func (m *Dispute) String() string { return proto.CompactTextString(m) }
+func (*Dispute) ProtoMessage()    {}
+func (*Dispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{28}
+}
+
+func (m *Dispute) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Dispute.Unmarshal(m, b)
+}
+func (m *Dispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Dispute.Marshal(b, m, deterministic)
+}
+func (m *Dispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Dispute.Merge(m, src)
+}
+func (m *Dispute) XXX_Size() int {
+	return xxx_messageInfo_Dispute.Size(m)
+}
+func (m *Dispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_Dispute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Dispute proto.InternalMessageInfo
+
+func (m *Dispute) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *Dispute) GetClaim() string {
+	if m != nil {
+		return m.Claim
+	}
+	return ""
+}
+
+func (m *Dispute) GetPayoutAddress() string {
+	if m != nil {
+		return m.PayoutAddress
+	}
+	return ""
+}
+
+func (m *Dispute) GetOutpoints() []*Outpoint {
+	if m != nil {
+		return m.Outpoints
+	}
+	return nil
+}
+
+func (m *Dispute) GetSerializedContract() []byte {
+	if m != nil {
+		return m.SerializedContract
+	}
+	return nil
+}
+
+type DisputeEvidence struct {
+	OrderId              string                  `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	Submitter            OrderAmendment_Party    `protobuf:"varint,2,opt,name=submitter,proto3,enum=OrderAmendment_Party" json:"submitter,omitempty"`
+	Filename             string                  `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	MediaType            string                  `protobuf:"bytes,4,opt,name=mediaType,proto3" json:"mediaType,omitempty"`
+	Description          string                  `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Size                 uint64                  `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	Hash                 string                  `protobuf:"bytes,7,opt,name=hash,proto3" json:"hash,omitempty"`
+	Copies               []*DisputeEvidence_Copy `protobuf:"bytes,8,rep,name=copies,proto3" json:"copies,omitempty"`
+	Timestamp            *timestamp.Timestamp    `protobuf:"bytes,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *DisputeEvidence) Reset()         { *m = DisputeEvidence{} }
+func (m *DisputeEvidence) String() string { return proto.CompactTextString(m) }
+func (*DisputeEvidence) ProtoMessage()    {}
+func (*DisputeEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{29}
+}
+
+func (m *DisputeEvidence) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisputeEvidence.Unmarshal(m, b)
+}
+func (m *DisputeEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisputeEvidence.Marshal(b, m, deterministic)
+}
+func (m *DisputeEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisputeEvidence.Merge(m, src)
+}
+func (m *DisputeEvidence) XXX_Size() int {
+	return xxx_messageInfo_DisputeEvidence.Size(m)
+}
+func (m *DisputeEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisputeEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisputeEvidence proto.InternalMessageInfo
+
+func (m *DisputeEvidence) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *DisputeEvidence) GetSubmitter() OrderAmendment_Party {
+	if m != nil {
+		return m.Submitter
+	}
+	return OrderAmendment_BUYER
+}
+
+func (m *DisputeEvidence) GetFilename() string {
+	if m != nil {
+		return m.Filename
+	}
+	return ""
+}
+
+func (m *DisputeEvidence) GetMediaType() string {
+	if m != nil {
+		return m.MediaType
+	}
+	return ""
+}
+
+func (m *DisputeEvidence) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *DisputeEvidence) GetSize() uint64 {
 	if m != nil {
-		return m.Description
+		return m.Size
 	}
 	return 0
 }
 
-func (m *Rating_RatingData) GetDeliverySpeed() uint32 {
+func (m *DisputeEvidence) GetHash() string {
 	if m != nil {
-		return m.DeliverySpeed
+		return m.Hash
 	}
-	return 0
+	return ""
 }
 
-func (m *Rating_RatingData) GetCustomerService() uint32 {
+func (m *DisputeEvidence) GetCopies() []*DisputeEvidence_Copy {
 	if m != nil {
-		return m.CustomerService
+		return m.Copies
 	}
-	return 0
+	return nil
 }
 
-func (m *Rating_RatingData) GetReview() string {
+func (m *DisputeEvidence) GetTimestamp() *timestamp.Timestamp {
 	if m != nil {
-		return m.Review
+		return m.Timestamp
 	}
-	return ""
+	return nil
 }
 
-type Dispute struct {
-	Timestamp            *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Claim                string               `protobuf:"bytes,2,opt,name=claim,proto3" json:"claim,omitempty"`
-	PayoutAddress        string               `protobuf:"bytes,3,opt,name=payoutAddress,proto3" json:"payoutAddress,omitempty"`
-	Outpoints            []*Outpoint          `protobuf:"bytes,4,rep,name=outpoints,proto3" json:"outpoints,omitempty"`
-	SerializedContract   []byte               `protobuf:"bytes,5,opt,name=serializedContract,proto3" json:"serializedContract,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+type DisputeEvidence_Copy struct {
+	Recipient            string   `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Cid                  string   `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Dispute) Reset()         { *m = Dispute{} }
-func (m *Dispute) String() string { return proto.CompactTextString(m) }
-func (*Dispute) ProtoMessage()    {}
-func (*Dispute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{14}
+func (m *DisputeEvidence_Copy) Reset()         { *m = DisputeEvidence_Copy{} }
+func (m *DisputeEvidence_Copy) String() string { return proto.CompactTextString(m) }
+func (*DisputeEvidence_Copy) ProtoMessage()    {}
+func (*DisputeEvidence_Copy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{29, 0}
 }
 
-func (m *Dispute) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Dispute.Unmarshal(m, b)
+func (m *DisputeEvidence_Copy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisputeEvidence_Copy.Unmarshal(m, b)
 }
-func (m *Dispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Dispute.Marshal(b, m, deterministic)
+func (m *DisputeEvidence_Copy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisputeEvidence_Copy.Marshal(b, m, deterministic)
 }
-func (m *Dispute) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Dispute.Merge(m, src)
+func (m *DisputeEvidence_Copy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisputeEvidence_Copy.Merge(m, src)
 }
-func (m *Dispute) XXX_Size() int {
-	return xxx_messageInfo_Dispute.Size(m)
+func (m *DisputeEvidence_Copy) XXX_Size() int {
+	return xxx_messageInfo_DisputeEvidence_Copy.Size(m)
 }
-func (m *Dispute) XXX_DiscardUnknown() {
-	xxx_messageInfo_Dispute.DiscardUnknown(m)
+func (m *DisputeEvidence_Copy) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisputeEvidence_Copy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Dispute proto.InternalMessageInfo
-
-func (m *Dispute) GetTimestamp() *timestamp.Timestamp {
-	if m != nil {
-		return m.Timestamp
-	}
-	return nil
-}
+var xxx_messageInfo_DisputeEvidence_Copy proto.InternalMessageInfo
 
-func (m *Dispute) GetClaim() string {
+func (m *DisputeEvidence_Copy) GetRecipient() string {
 	if m != nil {
-		return m.Claim
+		return m.Recipient
 	}
 	return ""
 }
 
-func (m *Dispute) GetPayoutAddress() string {
+func (m *DisputeEvidence_Copy) GetCid() string {
 	if m != nil {
-		return m.PayoutAddress
+		return m.Cid
 	}
 	return ""
 }
 
-func (m *Dispute) GetOutpoints() []*Outpoint {
-	if m != nil {
-		return m.Outpoints
-	}
-	return nil
-}
-
-func (m *Dispute) GetSerializedContract() []byte {
-	if m != nil {
-		return m.SerializedContract
-	}
-	return nil
-}
-
 type DisputeResolution struct {
-	Timestamp            *timestamp.Timestamp      `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	OrderId              string                    `protobuf:"bytes,2,opt,name=orderId,proto3" json:"orderId,omitempty"`
-	ProposedBy           string                    `protobuf:"bytes,3,opt,name=proposedBy,proto3" json:"proposedBy,omitempty"`
-	Resolution           string                    `protobuf:"bytes,4,opt,name=resolution,proto3" json:"resolution,omitempty"`
-	Payout               *DisputeResolution_Payout `protobuf:"bytes,5,opt,name=payout,proto3" json:"payout,omitempty"`
-	ModeratorRatingSigs  [][]byte                  `protobuf:"bytes,6,rep,name=moderatorRatingSigs,proto3" json:"moderatorRatingSigs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+	Timestamp            *timestamp.Timestamp           `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	OrderId              string                         `protobuf:"bytes,2,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	ProposedBy           string                         `protobuf:"bytes,3,opt,name=proposedBy,proto3" json:"proposedBy,omitempty"`
+	Resolution           string                         `protobuf:"bytes,4,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	Payout               *DisputeResolution_Payout      `protobuf:"bytes,5,opt,name=payout,proto3" json:"payout,omitempty"`
+	ModeratorRatingSigs  [][]byte                       `protobuf:"bytes,6,rep,name=moderatorRatingSigs,proto3" json:"moderatorRatingSigs,omitempty"`
+	BuyerPercentage      float32                        `protobuf:"fixed32,6660,opt,name=buyerPercentage,proto3" json:"buyerPercentage,omitempty"`
+	VendorPercentage     float32                        `protobuf:"fixed32,6661,opt,name=vendorPercentage,proto3" json:"vendorPercentage,omitempty"`
+	PanelVotes           []*DisputeResolution_PanelVote `protobuf:"bytes,6662,rep,name=panelVotes,proto3" json:"panelVotes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
 
 func (m *DisputeResolution) Reset()         { *m = DisputeResolution{} }
 func (m *DisputeResolution) String() string { return proto.CompactTextString(m) }
 func (*DisputeResolution) ProtoMessage()    {}
 func (*DisputeResolution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{15}
+	return fileDescriptor_b6d125f880f9ca35, []int{30}
 }
 
 func (m *DisputeResolution) XXX_Unmarshal(b []byte) error {
@@ -3151,22 +4942,45 @@ func (m *DisputeResolution) GetModeratorRatingSigs() [][]byte {
 	return nil
 }
 
+func (m *DisputeResolution) GetBuyerPercentage() float32 {
+	if m != nil {
+		return m.BuyerPercentage
+	}
+	return 0
+}
+
+func (m *DisputeResolution) GetVendorPercentage() float32 {
+	if m != nil {
+		return m.VendorPercentage
+	}
+	return 0
+}
+
+func (m *DisputeResolution) GetPanelVotes() []*DisputeResolution_PanelVote {
+	if m != nil {
+		return m.PanelVotes
+	}
+	return nil
+}
+
 type DisputeResolution_Payout struct {
-	Sigs                 []*BitcoinSignature              `protobuf:"bytes,1,rep,name=sigs,proto3" json:"sigs,omitempty"`
-	Inputs               []*Outpoint                      `protobuf:"bytes,2,rep,name=inputs,proto3" json:"inputs,omitempty"`
-	BuyerOutput          *DisputeResolution_Payout_Output `protobuf:"bytes,3,opt,name=buyerOutput,proto3" json:"buyerOutput,omitempty"`
-	VendorOutput         *DisputeResolution_Payout_Output `protobuf:"bytes,4,opt,name=vendorOutput,proto3" json:"vendorOutput,omitempty"`
-	ModeratorOutput      *DisputeResolution_Payout_Output `protobuf:"bytes,5,opt,name=moderatorOutput,proto3" json:"moderatorOutput,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
-	XXX_unrecognized     []byte                           `json:"-"`
-	XXX_sizecache        int32                            `json:"-"`
+	Sigs                 []*BitcoinSignature                         `protobuf:"bytes,1,rep,name=sigs,proto3" json:"sigs,omitempty"`
+	Inputs               []*Outpoint                                 `protobuf:"bytes,2,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	BuyerOutput          *DisputeResolution_Payout_Output            `protobuf:"bytes,3,opt,name=buyerOutput,proto3" json:"buyerOutput,omitempty"`
+	VendorOutput         *DisputeResolution_Payout_Output            `protobuf:"bytes,4,opt,name=vendorOutput,proto3" json:"vendorOutput,omitempty"`
+	ModeratorOutput      *DisputeResolution_Payout_Output            `protobuf:"bytes,5,opt,name=moderatorOutput,proto3" json:"moderatorOutput,omitempty"`
+	PanelOutputs         []*DisputeResolution_Payout_Output          `protobuf:"bytes,6660,rep,name=panelOutputs,proto3" json:"panelOutputs,omitempty"`
+	PanelSigs            []*DisputeResolution_Payout_PanelSignatures `protobuf:"bytes,6661,rep,name=panelSigs,proto3" json:"panelSigs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                    `json:"-"`
+	XXX_unrecognized     []byte                                      `json:"-"`
+	XXX_sizecache        int32                                       `json:"-"`
 }
 
 func (m *DisputeResolution_Payout) Reset()         { *m = DisputeResolution_Payout{} }
 func (m *DisputeResolution_Payout) String() string { return proto.CompactTextString(m) }
 func (*DisputeResolution_Payout) ProtoMessage()    {}
 func (*DisputeResolution_Payout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{15, 0}
+	return fileDescriptor_b6d125f880f9ca35, []int{30, 0}
 }
 
 func (m *DisputeResolution_Payout) XXX_Unmarshal(b []byte) error {
@@ -3222,6 +5036,20 @@ func (m *DisputeResolution_Payout) GetModeratorOutput() *DisputeResolution_Payou
 	return nil
 }
 
+func (m *DisputeResolution_Payout) GetPanelOutputs() []*DisputeResolution_Payout_Output {
+	if m != nil {
+		return m.PanelOutputs
+	}
+	return nil
+}
+
+func (m *DisputeResolution_Payout) GetPanelSigs() []*DisputeResolution_Payout_PanelSignatures {
+	if m != nil {
+		return m.PanelSigs
+	}
+	return nil
+}
+
 type DisputeResolution_Payout_Output struct {
 	// Types that are valid to be assigned to ScriptOrAddress:
 	//	*DisputeResolution_Payout_Output_Script
@@ -3237,7 +5065,7 @@ func (m *DisputeResolution_Payout_Output) Reset()         { *m = DisputeResoluti
 func (m *DisputeResolution_Payout_Output) String() string { return proto.CompactTextString(m) }
 func (*DisputeResolution_Payout_Output) ProtoMessage()    {}
 func (*DisputeResolution_Payout_Output) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{15, 0, 0}
+	return fileDescriptor_b6d125f880f9ca35, []int{30, 0, 0}
 }
 
 func (m *DisputeResolution_Payout_Output) XXX_Unmarshal(b []byte) error {
@@ -3310,6 +5138,110 @@ func (*DisputeResolution_Payout_Output) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+type DisputeResolution_Payout_PanelSignatures struct {
+	Moderator            string              `protobuf:"bytes,1,opt,name=moderator,proto3" json:"moderator,omitempty"`
+	Sigs                 []*BitcoinSignature `protobuf:"bytes,2,rep,name=sigs,proto3" json:"sigs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *DisputeResolution_Payout_PanelSignatures) Reset() {
+	*m = DisputeResolution_Payout_PanelSignatures{}
+}
+func (m *DisputeResolution_Payout_PanelSignatures) String() string { return proto.CompactTextString(m) }
+func (*DisputeResolution_Payout_PanelSignatures) ProtoMessage()    {}
+func (*DisputeResolution_Payout_PanelSignatures) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{30, 0, 1}
+}
+
+func (m *DisputeResolution_Payout_PanelSignatures) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisputeResolution_Payout_PanelSignatures.Unmarshal(m, b)
+}
+func (m *DisputeResolution_Payout_PanelSignatures) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisputeResolution_Payout_PanelSignatures.Marshal(b, m, deterministic)
+}
+func (m *DisputeResolution_Payout_PanelSignatures) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisputeResolution_Payout_PanelSignatures.Merge(m, src)
+}
+func (m *DisputeResolution_Payout_PanelSignatures) XXX_Size() int {
+	return xxx_messageInfo_DisputeResolution_Payout_PanelSignatures.Size(m)
+}
+func (m *DisputeResolution_Payout_PanelSignatures) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisputeResolution_Payout_PanelSignatures.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisputeResolution_Payout_PanelSignatures proto.InternalMessageInfo
+
+func (m *DisputeResolution_Payout_PanelSignatures) GetModerator() string {
+	if m != nil {
+		return m.Moderator
+	}
+	return ""
+}
+
+func (m *DisputeResolution_Payout_PanelSignatures) GetSigs() []*BitcoinSignature {
+	if m != nil {
+		return m.Sigs
+	}
+	return nil
+}
+
+type DisputeResolution_PanelVote struct {
+	Vote                 *DisputeResolution `protobuf:"bytes,1,opt,name=vote,proto3" json:"vote,omitempty"`
+	Pubkey               []byte             `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Signature            []byte             `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *DisputeResolution_PanelVote) Reset()         { *m = DisputeResolution_PanelVote{} }
+func (m *DisputeResolution_PanelVote) String() string { return proto.CompactTextString(m) }
+func (*DisputeResolution_PanelVote) ProtoMessage()    {}
+func (*DisputeResolution_PanelVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{30, 1}
+}
+
+func (m *DisputeResolution_PanelVote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisputeResolution_PanelVote.Unmarshal(m, b)
+}
+func (m *DisputeResolution_PanelVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisputeResolution_PanelVote.Marshal(b, m, deterministic)
+}
+func (m *DisputeResolution_PanelVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisputeResolution_PanelVote.Merge(m, src)
+}
+func (m *DisputeResolution_PanelVote) XXX_Size() int {
+	return xxx_messageInfo_DisputeResolution_PanelVote.Size(m)
+}
+func (m *DisputeResolution_PanelVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisputeResolution_PanelVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisputeResolution_PanelVote proto.InternalMessageInfo
+
+func (m *DisputeResolution_PanelVote) GetVote() *DisputeResolution {
+	if m != nil {
+		return m.Vote
+	}
+	return nil
+}
+
+func (m *DisputeResolution_PanelVote) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+func (m *DisputeResolution_PanelVote) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 type DisputeAcceptance struct {
 	Timestamp            *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	ClosedBy             string               `protobuf:"bytes,2,opt,name=closedBy,proto3" json:"closedBy,omitempty"`
@@ -3322,7 +5254,7 @@ func (m *DisputeAcceptance) Reset()         { *m = DisputeAcceptance{} }
 func (m *DisputeAcceptance) String() string { return proto.CompactTextString(m) }
 func (*DisputeAcceptance) ProtoMessage()    {}
 func (*DisputeAcceptance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{16}
+	return fileDescriptor_b6d125f880f9ca35, []int{31}
 }
 
 func (m *DisputeAcceptance) XXX_Unmarshal(b []byte) error {
@@ -3370,7 +5302,7 @@ func (m *Outpoint) Reset()         { *m = Outpoint{} }
 func (m *Outpoint) String() string { return proto.CompactTextString(m) }
 func (*Outpoint) ProtoMessage()    {}
 func (*Outpoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{17}
+	return fileDescriptor_b6d125f880f9ca35, []int{32}
 }
 
 func (m *Outpoint) XXX_Unmarshal(b []byte) error {
@@ -3427,7 +5359,7 @@ func (m *Refund) Reset()         { *m = Refund{} }
 func (m *Refund) String() string { return proto.CompactTextString(m) }
 func (*Refund) ProtoMessage()    {}
 func (*Refund) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{18}
+	return fileDescriptor_b6d125f880f9ca35, []int{33}
 }
 
 func (m *Refund) XXX_Unmarshal(b []byte) error {
@@ -3495,7 +5427,7 @@ func (m *Refund_TransactionInfo) Reset()         { *m = Refund_TransactionInfo{}
 func (m *Refund_TransactionInfo) String() string { return proto.CompactTextString(m) }
 func (*Refund_TransactionInfo) ProtoMessage()    {}
 func (*Refund_TransactionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{18, 0}
+	return fileDescriptor_b6d125f880f9ca35, []int{33, 0}
 }
 
 func (m *Refund_TransactionInfo) XXX_Unmarshal(b []byte) error {
@@ -3530,6 +5462,148 @@ func (m *Refund_TransactionInfo) GetValue() uint64 {
 	return 0
 }
 
+type PartialRefund struct {
+	OrderID              string                  `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	Index                uint32                  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Timestamp            *timestamp.Timestamp    `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Amount               uint64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Items                []*PartialRefund_Item   `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	Sigs                 []*BitcoinSignature     `protobuf:"bytes,6,rep,name=sigs,proto3" json:"sigs,omitempty"`
+	RefundTransaction    *Refund_TransactionInfo `protobuf:"bytes,7,opt,name=refundTransaction,proto3" json:"refundTransaction,omitempty"`
+	Memo                 string                  `protobuf:"bytes,8,opt,name=memo,proto3" json:"memo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *PartialRefund) Reset()         { *m = PartialRefund{} }
+func (m *PartialRefund) String() string { return proto.CompactTextString(m) }
+func (*PartialRefund) ProtoMessage()    {}
+func (*PartialRefund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{34}
+}
+
+func (m *PartialRefund) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PartialRefund.Unmarshal(m, b)
+}
+func (m *PartialRefund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PartialRefund.Marshal(b, m, deterministic)
+}
+func (m *PartialRefund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartialRefund.Merge(m, src)
+}
+func (m *PartialRefund) XXX_Size() int {
+	return xxx_messageInfo_PartialRefund.Size(m)
+}
+func (m *PartialRefund) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartialRefund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartialRefund proto.InternalMessageInfo
+
+func (m *PartialRefund) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+func (m *PartialRefund) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *PartialRefund) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *PartialRefund) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *PartialRefund) GetItems() []*PartialRefund_Item {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *PartialRefund) GetSigs() []*BitcoinSignature {
+	if m != nil {
+		return m.Sigs
+	}
+	return nil
+}
+
+func (m *PartialRefund) GetRefundTransaction() *Refund_TransactionInfo {
+	if m != nil {
+		return m.RefundTransaction
+	}
+	return nil
+}
+
+func (m *PartialRefund) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+type PartialRefund_Item struct {
+	Index                uint32   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Quantity             uint64   `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PartialRefund_Item) Reset()         { *m = PartialRefund_Item{} }
+func (m *PartialRefund_Item) String() string { return proto.CompactTextString(m) }
+func (*PartialRefund_Item) ProtoMessage()    {}
+func (*PartialRefund_Item) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{34, 0}
+}
+
+func (m *PartialRefund_Item) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PartialRefund_Item.Unmarshal(m, b)
+}
+func (m *PartialRefund_Item) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PartialRefund_Item.Marshal(b, m, deterministic)
+}
+func (m *PartialRefund_Item) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartialRefund_Item.Merge(m, src)
+}
+func (m *PartialRefund_Item) XXX_Size() int {
+	return xxx_messageInfo_PartialRefund_Item.Size(m)
+}
+func (m *PartialRefund_Item) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartialRefund_Item.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartialRefund_Item proto.InternalMessageInfo
+
+func (m *PartialRefund_Item) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *PartialRefund_Item) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
 type VendorFinalizedPayment struct {
 	OrderID              string   `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -3541,7 +5615,7 @@ func (m *VendorFinalizedPayment) Reset()         { *m = VendorFinalizedPayment{}
 func (m *VendorFinalizedPayment) String() string { return proto.CompactTextString(m) }
 func (*VendorFinalizedPayment) ProtoMessage()    {}
 func (*VendorFinalizedPayment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{19}
+	return fileDescriptor_b6d125f880f9ca35, []int{35}
 }
 
 func (m *VendorFinalizedPayment) XXX_Unmarshal(b []byte) error {
@@ -3583,7 +5657,7 @@ func (m *ID) Reset()         { *m = ID{} }
 func (m *ID) String() string { return proto.CompactTextString(m) }
 func (*ID) ProtoMessage()    {}
 func (*ID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{20}
+	return fileDescriptor_b6d125f880f9ca35, []int{36}
 }
 
 func (m *ID) XXX_Unmarshal(b []byte) error {
@@ -3644,7 +5718,7 @@ func (m *ID_Pubkeys) Reset()         { *m = ID_Pubkeys{} }
 func (m *ID_Pubkeys) String() string { return proto.CompactTextString(m) }
 func (*ID_Pubkeys) ProtoMessage()    {}
 func (*ID_Pubkeys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{20, 0}
+	return fileDescriptor_b6d125f880f9ca35, []int{36, 0}
 }
 
 func (m *ID_Pubkeys) XXX_Unmarshal(b []byte) error {
@@ -3691,7 +5765,7 @@ func (m *Signature) Reset()         { *m = Signature{} }
 func (m *Signature) String() string { return proto.CompactTextString(m) }
 func (*Signature) ProtoMessage()    {}
 func (*Signature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{21}
+	return fileDescriptor_b6d125f880f9ca35, []int{37}
 }
 
 func (m *Signature) XXX_Unmarshal(b []byte) error {
@@ -3739,7 +5813,7 @@ func (m *SignedListing) Reset()         { *m = SignedListing{} }
 func (m *SignedListing) String() string { return proto.CompactTextString(m) }
 func (*SignedListing) ProtoMessage()    {}
 func (*SignedListing) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{22}
+	return fileDescriptor_b6d125f880f9ca35, []int{38}
 }
 
 func (m *SignedListing) XXX_Unmarshal(b []byte) error {
@@ -3788,10 +5862,14 @@ func init() {
 	proto.RegisterEnum("Listing_ShippingOption_ShippingType", Listing_ShippingOption_ShippingType_name, Listing_ShippingOption_ShippingType_value)
 	proto.RegisterEnum("Order_Payment_Method", Order_Payment_Method_name, Order_Payment_Method_value)
 	proto.RegisterEnum("EntityRating_RatingFields_RatingType", EntityRating_RatingFields_RatingType_name, EntityRating_RatingFields_RatingType_value)
+	proto.RegisterEnum("Subscription_State", Subscription_State_name, Subscription_State_value)
+	proto.RegisterEnum("TimesheetReview_Status", TimesheetReview_Status_name, TimesheetReview_Status_value)
+	proto.RegisterEnum("OrderAmendment_Party", OrderAmendment_Party_name, OrderAmendment_Party_value)
 	proto.RegisterEnum("Signature_Section", Signature_Section_name, Signature_Section_value)
 	proto.RegisterType((*RicardianContract)(nil), "RicardianContract")
 	proto.RegisterType((*Contact)(nil), "Contact")
 	proto.RegisterType((*Listing)(nil), "Listing")
+	proto.RegisterType((*Listing_Milestone)(nil), "Listing.Milestone")
 	proto.RegisterType((*Listing_Metadata)(nil), "Listing.Metadata")
 	proto.RegisterType((*Listing_Item)(nil), "Listing.Item")
 	proto.RegisterType((*Listing_Item_Option)(nil), "Listing.Item.Option")
@@ -3803,11 +5881,16 @@ func init() {
 	proto.RegisterType((*Listing_Tax)(nil), "Listing.Tax")
 	proto.RegisterType((*Listing_Coupon)(nil), "Listing.Coupon")
 	proto.RegisterType((*Order)(nil), "Order")
+	proto.RegisterType((*Order_Milestone)(nil), "Order.Milestone")
+	proto.RegisterType((*Order_Appointment)(nil), "Order.Appointment")
+	proto.RegisterType((*Order_SubscriptionPeriod)(nil), "Order.SubscriptionPeriod")
+	proto.RegisterType((*Order_BillingRate)(nil), "Order.BillingRate")
 	proto.RegisterType((*Order_Shipping)(nil), "Order.Shipping")
 	proto.RegisterType((*Order_Item)(nil), "Order.Item")
 	proto.RegisterType((*Order_Item_Option)(nil), "Order.Item.Option")
 	proto.RegisterType((*Order_Item_ShippingOption)(nil), "Order.Item.ShippingOption")
 	proto.RegisterType((*Order_Payment)(nil), "Order.Payment")
+	proto.RegisterType((*Order_Payment_ModeratorPanel)(nil), "Order.Payment.ModeratorPanel")
 	proto.RegisterType((*OrderConfirmation)(nil), "OrderConfirmation")
 	proto.RegisterType((*OrderReject)(nil), "OrderReject")
 	proto.RegisterType((*RatingSignature)(nil), "RatingSignature")
@@ -3822,18 +5905,39 @@ func init() {
 	proto.RegisterType((*EntityRatingStore)(nil), "EntityRatingStore")
 	proto.RegisterType((*EntityRating)(nil), "EntityRating")
 	proto.RegisterType((*EntityRating_RatingFields)(nil), "EntityRating.RatingFields")
+	proto.RegisterType((*BuyerRating)(nil), "BuyerRating")
+	proto.RegisterType((*BuyerRating_BuyerRatingData)(nil), "BuyerRating.BuyerRatingData")
+	proto.RegisterType((*BuyerRatingIndex)(nil), "BuyerRatingIndex")
 	proto.RegisterType((*OrderCompletion)(nil), "OrderCompletion")
+	proto.RegisterType((*MilestoneRelease)(nil), "MilestoneRelease")
+	proto.RegisterType((*Subscription)(nil), "Subscription")
+	proto.RegisterType((*SubscriptionUpdate)(nil), "SubscriptionUpdate")
+	proto.RegisterType((*QuoteRequest)(nil), "QuoteRequest")
+	proto.RegisterType((*Quote)(nil), "Quote")
+	proto.RegisterType((*SignedQuoteRequest)(nil), "SignedQuoteRequest")
+	proto.RegisterType((*SignedQuote)(nil), "SignedQuote")
+	proto.RegisterType((*QuoteHistory)(nil), "QuoteHistory")
+	proto.RegisterType((*TimesheetEntry)(nil), "TimesheetEntry")
+	proto.RegisterType((*TimesheetReview)(nil), "TimesheetReview")
+	proto.RegisterType((*OrderAmendment)(nil), "OrderAmendment")
+	proto.RegisterType((*OrderAmendmentResponse)(nil), "OrderAmendmentResponse")
 	proto.RegisterType((*OrderProcessingFailure)(nil), "OrderProcessingFailure")
 	proto.RegisterType((*Rating)(nil), "Rating")
 	proto.RegisterType((*Rating_RatingData)(nil), "Rating.RatingData")
 	proto.RegisterType((*Dispute)(nil), "Dispute")
+	proto.RegisterType((*DisputeEvidence)(nil), "DisputeEvidence")
+	proto.RegisterType((*DisputeEvidence_Copy)(nil), "DisputeEvidence.Copy")
 	proto.RegisterType((*DisputeResolution)(nil), "DisputeResolution")
 	proto.RegisterType((*DisputeResolution_Payout)(nil), "DisputeResolution.Payout")
 	proto.RegisterType((*DisputeResolution_Payout_Output)(nil), "DisputeResolution.Payout.Output")
+	proto.RegisterType((*DisputeResolution_Payout_PanelSignatures)(nil), "DisputeResolution.Payout.PanelSignatures")
+	proto.RegisterType((*DisputeResolution_PanelVote)(nil), "DisputeResolution.PanelVote")
 	proto.RegisterType((*DisputeAcceptance)(nil), "DisputeAcceptance")
 	proto.RegisterType((*Outpoint)(nil), "Outpoint")
 	proto.RegisterType((*Refund)(nil), "Refund")
 	proto.RegisterType((*Refund_TransactionInfo)(nil), "Refund.TransactionInfo")
+	proto.RegisterType((*PartialRefund)(nil), "PartialRefund")
+	proto.RegisterType((*PartialRefund_Item)(nil), "PartialRefund.Item")
 	proto.RegisterType((*VendorFinalizedPayment)(nil), "VendorFinalizedPayment")
 	proto.RegisterType((*ID)(nil), "ID")
 	proto.RegisterType((*ID_Pubkeys)(nil), "ID.Pubkeys")
@@ -3844,239 +5948,340 @@ func init() {
 func init() { proto.RegisterFile("contracts.proto", fileDescriptor_b6d125f880f9ca35) }
 
 var fileDescriptor_b6d125f880f9ca35 = []byte{
-	// 3742 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xc4, 0x5a, 0xcd, 0x6f, 0x23, 0x47,
-	0x76, 0x1f, 0x7e, 0x88, 0x1f, 0x25, 0x4a, 0xa2, 0x6a, 0xc6, 0x33, 0x0c, 0xe1, 0xd8, 0x63, 0xda,
-	0x8e, 0x3f, 0x62, 0xb7, 0x6d, 0xd9, 0x09, 0x9c, 0x38, 0x48, 0x2c, 0x91, 0x94, 0x45, 0x8f, 0x24,
-	0x32, 0x45, 0xca, 0xce, 0x24, 0x01, 0x94, 0x16, 0xbb, 0x24, 0x75, 0x86, 0x64, 0xd3, 0xdd, 0xcd,
-	0x99, 0x51, 0x72, 0x0a, 0x90, 0x04, 0x41, 0x10, 0x20, 0x07, 0x1f, 0x7c, 0xc8, 0x2d, 0xa7, 0x1c,
-	0xf2, 0x1f, 0xc4, 0xb9, 0xec, 0x75, 0xb1, 0x97, 0x3d, 0x79, 0x0f, 0x8b, 0x05, 0x16, 0x0b, 0xec,
-	0x75, 0x6f, 0x7b, 0xd8, 0xcb, 0xbe, 0xf7, 0xaa, 0xaa, 0xbf, 0x48, 0x8d, 0x67, 0xbc, 0x58, 0xec,
-	0xad, 0xdf, 0x47, 0x55, 0x57, 0x57, 0xbd, 0xfa, 0xbd, 0xdf, 0x7b, 0x24, 0xdb, 0x1a, 0x7b, 0xb3,
-	0xd0, 0xb7, 0xc7, 0x61, 0x60, 0xcd, 0x7d, 0x2f, 0xf4, 0x9a, 0x7c, 0xec, 0x2d, 0x40, 0x73, 0x35,
-	0xf6, 0x1c, 0x69, 0x74, 0x1b, 0x53, 0x19, 0x04, 0xf6, 0x85, 0xd4, 0xe2, 0x8b, 0x17, 0x9e, 0x77,
-	0x31, 0x91, 0xef, 0x90, 0x74, 0xb6, 0x38, 0x7f, 0x27, 0x74, 0xc1, 0x21, 0xb4, 0xa7, 0x73, 0xed,
-	0x70, 0x47, 0x3e, 0x0e, 0xe5, 0xcc, 0x91, 0xce, 0xe9, 0xc4, 0x1b, 0xdb, 0xa1, 0xeb, 0xcd, 0x94,
-	0xa1, 0xf5, 0xa3, 0x22, 0xdb, 0x16, 0xee, 0xd8, 0xf6, 0x1d, 0xd7, 0x9e, 0xb5, 0xf5, 0x9b, 0xf9,
-	0xbb, 0x6c, 0xf3, 0x21, 0xb8, 0x7b, 0xfe, 0xa1, 0x1b, 0x84, 0xee, 0xec, 0x22, 0x68, 0xe4, 0xee,
-	0x16, 0x5e, 0x5f, 0xdf, 0xa9, 0x58, 0x5a, 0x21, 0x32, 0x76, 0xfe, 0x07, 0x8c, 0x9d, 0x2d, 0xae,
-	0xa4, 0xdf, 0xf7, 0x1d, 0xe9, 0x37, 0xf2, 0x77, 0x73, 0xe0, 0x5d, 0xb2, 0x48, 0x12, 0x09, 0x0b,
-	0x3f, 0x64, 0x77, 0xd4, 0x48, 0x12, 0xe1, 0x85, 0xe7, 0xae, 0x3f, 0xa5, 0x05, 0x35, 0x0a, 0x34,
-	0x88, 0x5b, 0x4b, 0x16, 0x71, 0xdd, 0x10, 0xde, 0x63, 0xb7, 0x13, 0xa6, 0xfd, 0xc5, 0xe4, 0xdc,
-	0x9d, 0x4c, 0xa6, 0x72, 0x16, 0x36, 0x8a, 0xb4, 0xde, 0x6d, 0x2b, 0x6b, 0x10, 0xd7, 0x0c, 0xe0,
-	0x1d, 0x76, 0x2b, 0x5e, 0x66, 0xdb, 0x9b, 0xce, 0x27, 0x92, 0x56, 0xb5, 0x46, 0xab, 0xaa, 0x5b,
-	0x19, 0xbd, 0x58, 0xe9, 0xcd, 0x5b, 0xac, 0xec, 0xb8, 0xc1, 0x7c, 0x11, 0xca, 0x46, 0x89, 0x06,
-	0x56, 0xac, 0x8e, 0x92, 0x85, 0x31, 0xf0, 0x8f, 0xd9, 0xb6, 0x7e, 0x14, 0x32, 0xf0, 0x26, 0x0b,
-	0x7a, 0x4d, 0x59, 0x7f, 0x7c, 0x27, 0x6b, 0x11, 0xcb, 0xce, 0x89, 0x19, 0x76, 0xc7, 0x63, 0x39,
-	0x0f, 0xed, 0xd9, 0x58, 0x36, 0x2a, 0xe9, 0x19, 0x62, 0x8b, 0x58, 0x76, 0xe6, 0x2f, 0xb2, 0x92,
-	0x2f, 0xcf, 0x17, 0x33, 0xa7, 0x51, 0xa5, 0x61, 0x65, 0x4b, 0x90, 0x28, 0xb4, 0x9a, 0xbf, 0xc9,
-	0x58, 0xe0, 0x5e, 0xcc, 0xec, 0x70, 0xe1, 0xcb, 0xa0, 0xc1, 0x68, 0x37, 0x99, 0x35, 0x34, 0x2a,
-	0x91, 0xb0, 0xf2, 0xdb, 0xac, 0x24, 0x7d, 0xdf, 0xf3, 0x83, 0xc6, 0x3a, 0xf8, 0x55, 0x85, 0x96,
-	0x5a, 0x7f, 0xc3, 0xca, 0x18, 0x51, 0x18, 0x50, 0xb7, 0xd8, 0x9a, 0x9c, 0xda, 0xee, 0x04, 0xe2,
-	0x28, 0x07, 0x1e, 0x4a, 0xe0, 0x77, 0xd9, 0xfa, 0xfc, 0xd2, 0x9b, 0xc9, 0xe3, 0xc5, 0xf4, 0x4c,
-	0x47, 0x4d, 0x55, 0x24, 0x55, 0xbc, 0xc1, 0xca, 0x8f, 0xe4, 0x59, 0xe0, 0xc2, 0x7e, 0x16, 0xc8,
-	0x6a, 0xc4, 0xd6, 0xbf, 0xdf, 0x61, 0x65, 0x1d, 0x7d, 0x9c, 0xb3, 0x62, 0x30, 0x59, 0x5c, 0xe8,
-	0xc9, 0xe9, 0x19, 0xbe, 0xb0, 0xa2, 0x4e, 0xba, 0xd7, 0xd1, 0xe1, 0x58, 0xb0, 0x7a, 0x1d, 0x11,
-	0x29, 0xf9, 0xdb, 0xac, 0x32, 0x95, 0xa1, 0xed, 0xd8, 0xa1, 0xad, 0x43, 0x6f, 0xdb, 0x44, 0xb7,
-	0x75, 0xa4, 0x0d, 0x22, 0x72, 0xe1, 0x2f, 0xb1, 0x22, 0xbc, 0x76, 0x0a, 0x81, 0x85, 0xae, 0x1b,
-	0x91, 0x6b, 0x0f, 0x94, 0x82, 0x4c, 0x7c, 0x97, 0x6d, 0x05, 0x97, 0xee, 0x7c, 0x0e, 0xea, 0xfe,
-	0x1c, 0x0f, 0x2a, 0x80, 0xe8, 0xc1, 0x8d, 0xbb, 0x13, 0x79, 0x0f, 0x53, 0x76, 0x91, 0xf5, 0x87,
-	0xf8, 0x59, 0x0b, 0xed, 0xc7, 0xb0, 0xe3, 0x25, 0x1a, 0x58, 0x8b, 0x06, 0x8e, 0xec, 0xc7, 0x42,
-	0x99, 0xf8, 0x1b, 0xac, 0x0c, 0x88, 0x30, 0xc7, 0xe9, 0xcb, 0xe4, 0xb5, 0x15, 0x79, 0xb5, 0x49,
-	0x2f, 0x8c, 0x9d, 0xbf, 0xc0, 0xd8, 0x14, 0x50, 0xc3, 0xb7, 0x43, 0x3c, 0x9d, 0x0a, 0x9d, 0x4e,
-	0x42, 0xc3, 0x2d, 0xc6, 0x43, 0xe9, 0x4f, 0x83, 0xdd, 0x99, 0x03, 0x27, 0xe5, 0xb8, 0x6a, 0xd1,
-	0x55, 0xda, 0xc6, 0x15, 0x16, 0x58, 0x5e, 0x4d, 0xc5, 0xc7, 0xc0, 0x9b, 0xb8, 0xe3, 0x2b, 0x88,
-	0x0b, 0xf4, 0x4c, 0xe9, 0xf8, 0xab, 0xac, 0x62, 0x30, 0xa6, 0xf1, 0x2f, 0x1f, 0xe8, 0x4b, 0xb0,
-	0xeb, 0x38, 0x10, 0x29, 0x81, 0x88, 0x4c, 0xfc, 0x65, 0xfc, 0x0a, 0x0a, 0x8e, 0xc6, 0xbf, 0x1a,
-	0x2f, 0x1d, 0x2d, 0xc2, 0x58, 0x9a, 0x3f, 0x2e, 0xb1, 0x8a, 0x39, 0x0b, 0x8c, 0x85, 0x87, 0xd2,
-	0x0f, 0x70, 0x5e, 0x3c, 0xe8, 0x0d, 0x61, 0x44, 0xbe, 0xc7, 0x6a, 0x06, 0x34, 0x47, 0x57, 0x73,
-	0x49, 0xe7, 0xbd, 0xb9, 0xf3, 0xc2, 0xd2, 0x71, 0x5a, 0xed, 0x84, 0x97, 0x48, 0x8d, 0x01, 0xc8,
-	0x2b, 0x9d, 0x7b, 0x88, 0x2b, 0x14, 0x0c, 0x9b, 0x3b, 0x8d, 0xe5, 0xd1, 0xfb, 0x64, 0x17, 0xda,
-	0x8f, 0xef, 0x40, 0xd8, 0x3f, 0x9e, 0xbb, 0xfe, 0x95, 0x8e, 0x89, 0xa6, 0xa5, 0x50, 0xd8, 0x32,
-	0x28, 0x6c, 0x8d, 0x0c, 0x0a, 0x0b, 0xed, 0x89, 0x1b, 0x6e, 0xd3, 0x2d, 0x94, 0x4e, 0x7b, 0xe1,
-	0xfb, 0x72, 0x36, 0x76, 0xa5, 0x8a, 0x12, 0xd8, 0xf0, 0x65, 0x0b, 0x7f, 0x9d, 0x6d, 0xcd, 0x7d,
-	0x77, 0x0c, 0xcb, 0xd0, 0xca, 0x2b, 0xc2, 0x95, 0xaa, 0xc8, 0xaa, 0x79, 0x13, 0xb6, 0xdd, 0x9e,
-	0x5d, 0x2c, 0x20, 0x29, 0x10, 0x98, 0x54, 0x45, 0x24, 0xe3, 0x5b, 0x65, 0x30, 0xf6, 0xbd, 0x47,
-	0xb8, 0x20, 0x6f, 0x11, 0x1e, 0x78, 0x0b, 0x0a, 0x07, 0xdc, 0xc4, 0x15, 0x16, 0x9c, 0x6b, 0xec,
-	0xb9, 0x33, 0xda, 0x4b, 0x15, 0x0c, 0x91, 0x0c, 0xc0, 0x50, 0xc7, 0xe7, 0x8e, 0xfb, 0xd0, 0x0d,
-	0xdc, 0x33, 0x77, 0xe2, 0x86, 0x2a, 0x0c, 0x36, 0xc4, 0x92, 0x9e, 0xbf, 0xc2, 0x36, 0x70, 0x99,
-	0xf2, 0xc8, 0x73, 0xdc, 0x73, 0x17, 0x6e, 0xf8, 0x3a, 0x38, 0xe6, 0x45, 0x5a, 0xc9, 0x05, 0xdb,
-	0x0e, 0xa4, 0xff, 0x10, 0x54, 0xc2, 0x0e, 0x25, 0xec, 0xf6, 0xa5, 0xe7, 0xa8, 0xc8, 0xd9, 0xdc,
-	0x79, 0x79, 0xf9, 0x14, 0x86, 0x59, 0x5f, 0xb1, 0x3c, 0x9c, 0xff, 0x11, 0x7b, 0x4e, 0x2b, 0xdb,
-	0x13, 0x3b, 0x08, 0xe0, 0x45, 0x3a, 0x22, 0x29, 0xd6, 0xaa, 0x62, 0xb5, 0x15, 0x10, 0x6b, 0x7b,
-	0x69, 0x7a, 0x5e, 0x65, 0x6b, 0xfb, 0xbd, 0xbf, 0xea, 0x76, 0xea, 0x37, 0x78, 0x8d, 0x55, 0x06,
-	0x5d, 0x71, 0x7a, 0xd0, 0x3f, 0x11, 0xf5, 0x1c, 0x5f, 0x67, 0x65, 0x94, 0x3a, 0xbb, 0xf7, 0xeb,
-	0x79, 0xbe, 0xc1, 0xaa, 0x28, 0x1c, 0xf5, 0x8f, 0x47, 0x07, 0xf5, 0x02, 0xdf, 0x66, 0x1b, 0x24,
-	0xf6, 0x0e, 0xbb, 0xc3, 0x51, 0xff, 0xb8, 0x5b, 0x5f, 0x6b, 0x39, 0xac, 0x96, 0x8c, 0x3f, 0x72,
-	0x39, 0xb8, 0x3f, 0xec, 0xb5, 0x77, 0x0f, 0x4f, 0x3f, 0xe9, 0xf7, 0x71, 0xfe, 0x3a, 0xab, 0x75,
-	0x7a, 0x9f, 0xf4, 0x46, 0x46, 0x43, 0xef, 0x18, 0x76, 0xc5, 0x67, 0xbd, 0x76, 0x17, 0xde, 0xb1,
-	0xc9, 0x58, 0x5b, 0xf4, 0x3f, 0xef, 0x9c, 0xee, 0x9f, 0x1c, 0x77, 0xe0, 0x25, 0x9c, 0x6d, 0xb6,
-	0xc5, 0xfd, 0xc1, 0xa8, 0xdf, 0x3e, 0x11, 0xa2, 0x7b, 0xdc, 0xbe, 0x5f, 0x2f, 0xb6, 0xfe, 0x90,
-	0x95, 0x54, 0x9c, 0xf2, 0x2d, 0xb6, 0x4e, 0xeb, 0x3e, 0x1d, 0x08, 0x1c, 0x4e, 0xb3, 0x1f, 0xed,
-	0x8a, 0x7b, 0xdd, 0x91, 0xd6, 0xe4, 0x9b, 0x3f, 0x29, 0xb1, 0x22, 0x02, 0x18, 0xe2, 0x73, 0xe8,
-	0x86, 0x13, 0x69, 0xf0, 0x99, 0x04, 0xc4, 0x67, 0x07, 0xc3, 0xc3, 0x25, 0x74, 0x32, 0xf8, 0x9c,
-	0x50, 0x41, 0xda, 0xdf, 0x84, 0x68, 0x1f, 0xc3, 0xd5, 0x86, 0x33, 0xc2, 0x18, 0xd2, 0x30, 0x9d,
-	0xd1, 0xe2, 0xfc, 0x74, 0xe8, 0x74, 0x55, 0x8a, 0x42, 0x09, 0x88, 0xdb, 0xb3, 0xe0, 0xfc, 0x11,
-	0xe5, 0xd8, 0x8a, 0xa0, 0x67, 0xd4, 0x85, 0xf6, 0x85, 0x02, 0x40, 0xc0, 0x72, 0x7c, 0xe6, 0xf0,
-	0x4d, 0xee, 0x14, 0x02, 0xd9, 0x00, 0xde, 0xcd, 0x14, 0xfa, 0x5a, 0x3d, 0xb4, 0x09, 0xed, 0x82,
-	0x98, 0x07, 0xa7, 0x29, 0x2f, 0x3c, 0x1f, 0xaf, 0x96, 0xc6, 0xbc, 0x58, 0x83, 0x4b, 0xb9, 0xf0,
-	0xed, 0xa9, 0x82, 0xb9, 0xbc, 0x50, 0x02, 0x7f, 0x9e, 0x55, 0xc7, 0x06, 0xe7, 0x34, 0xac, 0xc5,
-	0x0a, 0xb8, 0x40, 0x65, 0x4f, 0x23, 0xfa, 0x3a, 0xad, 0xe0, 0x56, 0x7a, 0x05, 0x1a, 0xce, 0x8d,
-	0x13, 0x60, 0x60, 0x31, 0x78, 0xb0, 0x08, 0x1a, 0x35, 0xcd, 0x42, 0x52, 0xce, 0xc3, 0x07, 0x0b,
-	0x41, 0xe6, 0xe6, 0xf7, 0x72, 0xac, 0xa4, 0x86, 0xd2, 0x56, 0xd8, 0x53, 0xb3, 0xff, 0xf4, 0xfc,
-	0x14, 0xdb, 0xff, 0x21, 0x24, 0x39, 0xdb, 0x07, 0xe6, 0x16, 0x06, 0xb0, 0xf1, 0xf8, 0xae, 0xe7,
-	0x57, 0x2d, 0xcc, 0xfa, 0x4c, 0x39, 0x89, 0xc8, 0xbb, 0x79, 0xc0, 0xca, 0x5a, 0xb9, 0xf2, 0xd5,
-	0x6f, 0xb0, 0x35, 0xda, 0x4e, 0x9d, 0x3a, 0x57, 0x6e, 0xb8, 0xf2, 0x68, 0xfe, 0x53, 0x8e, 0x15,
-	0xe0, 0x93, 0x30, 0x37, 0xe8, 0xd9, 0x81, 0x0f, 0x9d, 0x79, 0xc4, 0x18, 0x37, 0x44, 0x4a, 0x87,
-	0xbb, 0x0c, 0x81, 0xe1, 0x2c, 0xc6, 0xa1, 0xce, 0xca, 0xb0, 0xcb, 0x91, 0x02, 0xad, 0xc1, 0xc2,
-	0x1f, 0x5f, 0xda, 0xfe, 0x85, 0x8a, 0xa3, 0x82, 0x88, 0x15, 0x08, 0x4a, 0x5f, 0x2c, 0x60, 0x26,
-	0x04, 0x9c, 0x22, 0x19, 0x23, 0xb9, 0xf9, 0x55, 0x8e, 0xad, 0xd1, 0xa2, 0xd0, 0x0b, 0x28, 0x9d,
-	0x4c, 0x7c, 0x50, 0x24, 0xa3, 0x0d, 0x42, 0xe0, 0xc2, 0x9d, 0xd9, 0x13, 0xfd, 0xf2, 0x48, 0xc6,
-	0xa8, 0x98, 0x44, 0xef, 0x85, 0x0b, 0x40, 0x02, 0x32, 0x9b, 0xa9, 0x74, 0xdc, 0x85, 0x4a, 0xfb,
-	0xc0, 0x6c, 0x94, 0x84, 0xde, 0xc1, 0xd4, 0x9e, 0x4c, 0x28, 0x72, 0xc1, 0x9b, 0x04, 0x0a, 0x5d,
-	0x77, 0x66, 0x10, 0x9a, 0x9e, 0x9b, 0xff, 0x51, 0x60, 0x9b, 0xe9, 0xa4, 0xbf, 0x72, 0xbf, 0x3f,
-	0x84, 0xa1, 0x71, 0xe6, 0x7a, 0xe5, 0x1a, 0xbe, 0x10, 0x89, 0x94, 0xbf, 0x68, 0x04, 0xdc, 0xc0,
-	0xb2, 0x2f, 0x2f, 0x28, 0x34, 0x31, 0x02, 0x36, 0x81, 0x33, 0xb4, 0x55, 0xbd, 0xd0, 0x86, 0x3c,
-	0x2f, 0x8c, 0x91, 0x7f, 0xc4, 0x2a, 0x1a, 0xf3, 0x0c, 0x2b, 0x79, 0xf1, 0xda, 0xb7, 0x68, 0x08,
-	0x8c, 0x06, 0x34, 0xbf, 0xcc, 0x01, 0x0c, 0x29, 0x61, 0xe5, 0xf2, 0xa3, 0xeb, 0x9d, 0x4f, 0x5e,
-	0xef, 0xb7, 0xd8, 0x36, 0xa4, 0x3f, 0x88, 0x12, 0xc8, 0x69, 0x1d, 0x39, 0x71, 0x21, 0x5d, 0x5f,
-	0xe9, 0xfd, 0x5d, 0x36, 0x40, 0x02, 0xbe, 0x69, 0x3b, 0xea, 0xbe, 0xd9, 0x13, 0x0c, 0xb3, 0x41,
-	0x02, 0x30, 0x56, 0x99, 0x5a, 0xef, 0xb1, 0x5a, 0x72, 0x43, 0x10, 0xdf, 0x0e, 0xfb, 0x88, 0xa6,
-	0x83, 0x5e, 0xfb, 0xde, 0xc9, 0x00, 0x10, 0x2f, 0x03, 0x81, 0xb9, 0xe6, 0x7f, 0x42, 0xb0, 0x02,
-	0x95, 0x42, 0x2e, 0x01, 0x64, 0x8a, 0x12, 0x9c, 0xfa, 0x0e, 0x23, 0xc2, 0xa2, 0x19, 0x3c, 0x0a,
-	0xbd, 0xa5, 0xf9, 0x15, 0x5b, 0x9a, 0xb0, 0xe3, 0x15, 0x05, 0xc9, 0xac, 0x82, 0x3e, 0xae, 0x22,
-	0x92, 0x2a, 0x84, 0xa3, 0xb9, 0xf4, 0xc7, 0x50, 0x62, 0xe0, 0x75, 0x2a, 0x12, 0xe6, 0x24, 0x34,
-	0x84, 0x01, 0x8a, 0xb6, 0x5d, 0x03, 0xc2, 0xb7, 0x58, 0xf1, 0xd2, 0x0e, 0x2e, 0x55, 0xc4, 0x1e,
-	0xdc, 0x10, 0x24, 0x41, 0x6a, 0xad, 0x01, 0xab, 0xa7, 0xca, 0x10, 0x17, 0xa5, 0xb6, 0x15, 0xac,
-	0x29, 0x2d, 0x24, 0xeb, 0x2d, 0xfd, 0xaa, 0x8e, 0x56, 0x53, 0xc4, 0xe6, 0x0f, 0x72, 0x22, 0x6b,
-	0x80, 0x40, 0x52, 0x79, 0x39, 0xf2, 0xc4, 0x30, 0x2e, 0x82, 0x67, 0x5a, 0xbd, 0x07, 0x29, 0x03,
-	0x2b, 0xd1, 0x3d, 0xc6, 0x2a, 0xe6, 0x5d, 0xad, 0xef, 0x33, 0xb6, 0xa6, 0xea, 0x3b, 0x48, 0xf9,
-	0x8a, 0x0d, 0x6a, 0xc6, 0xa7, 0xbf, 0x25, 0xad, 0xc4, 0x9b, 0xae, 0x14, 0xfb, 0xd2, 0xc4, 0x4c,
-	0xac, 0x00, 0xb8, 0xaf, 0x04, 0xc9, 0x1d, 0x45, 0x86, 0x4b, 0xb3, 0x47, 0x81, 0x2a, 0x22, 0x07,
-	0xfe, 0xfb, 0xac, 0x4c, 0x95, 0x18, 0x00, 0x4a, 0x31, 0xa6, 0xf9, 0x46, 0x07, 0x17, 0xab, 0x1a,
-	0xd5, 0xc2, 0xba, 0x96, 0x7b, 0x12, 0x4f, 0x8b, 0x9d, 0x81, 0xf0, 0xaf, 0x21, 0xab, 0x37, 0x54,
-	0x7c, 0x5d, 0x2f, 0x81, 0xf8, 0xbe, 0xb2, 0x00, 0x3b, 0x2b, 0xcf, 0xed, 0x2b, 0xaa, 0x37, 0x55,
-	0xfd, 0xb6, 0xa9, 0x9d, 0x06, 0x4a, 0x2b, 0x8c, 0x19, 0xa3, 0x00, 0x28, 0x37, 0xac, 0xf7, 0x9e,
-	0xbc, 0x52, 0x49, 0xa9, 0x26, 0x12, 0x1a, 0xe0, 0x92, 0xb7, 0xec, 0x09, 0x10, 0x6e, 0x28, 0xa9,
-	0xa4, 0x66, 0xc1, 0xbd, 0xd9, 0xb9, 0xa7, 0xd9, 0xd7, 0x4a, 0x5b, 0x92, 0x0f, 0xb3, 0x14, 0x1f,
-	0x6e, 0xfe, 0x30, 0xc7, 0x2a, 0x51, 0x00, 0x02, 0x86, 0xe1, 0x66, 0x8d, 0x3c, 0x7d, 0x14, 0x5a,
-	0xc2, 0xe1, 0xb6, 0x3e, 0x23, 0x05, 0x86, 0x46, 0xc4, 0x1b, 0x3e, 0x46, 0x94, 0x55, 0x57, 0x95,
-	0x9e, 0x09, 0xf1, 0x42, 0x58, 0x80, 0x06, 0x42, 0x25, 0x50, 0x70, 0x7b, 0xf0, 0x38, 0xa1, 0x18,
-	0x54, 0x60, 0x98, 0xd0, 0x20, 0x38, 0xe9, 0xe6, 0x05, 0x45, 0xd3, 0x12, 0x38, 0x69, 0x23, 0xe6,
-	0x0e, 0xfd, 0xf2, 0x63, 0x2f, 0xa4, 0x34, 0x4f, 0x75, 0x45, 0x52, 0xd7, 0xfc, 0x9f, 0x82, 0xe6,
-	0x2a, 0x70, 0xe7, 0x26, 0x0a, 0xb8, 0x0e, 0xf0, 0x5e, 0xa8, 0xaf, 0x4a, 0xaa, 0x52, 0xa9, 0x22,
-	0x4f, 0x5b, 0x13, 0xc9, 0xb8, 0x64, 0xf3, 0xfc, 0xc7, 0x1f, 0x10, 0x07, 0x2e, 0x8a, 0x84, 0x06,
-	0xee, 0x7f, 0x94, 0xea, 0x55, 0x46, 0xe5, 0x89, 0x83, 0x5f, 0x4a, 0xf4, 0x7b, 0x6c, 0x33, 0x5d,
-	0xc2, 0x45, 0xb5, 0x40, 0x62, 0x50, 0xa6, 0xe8, 0xcb, 0x8c, 0xc0, 0xed, 0x9e, 0xca, 0xa9, 0xa7,
-	0xb7, 0x8f, 0x9e, 0xf1, 0x1b, 0x55, 0x0d, 0x87, 0xfb, 0x64, 0xc8, 0x50, 0x52, 0x45, 0xcc, 0x4b,
-	0x05, 0x97, 0xb9, 0x69, 0x65, 0xcd, 0xbc, 0x52, 0xda, 0xe6, 0xce, 0x13, 0x29, 0x06, 0x1c, 0xeb,
-	0x43, 0x7b, 0xb2, 0x90, 0x3a, 0x04, 0x94, 0xd0, 0xfc, 0xf3, 0xa7, 0xca, 0x59, 0x10, 0x40, 0x3a,
-	0x41, 0x98, 0x00, 0xd2, 0x62, 0xf3, 0xeb, 0x3c, 0x30, 0x63, 0x1d, 0xf9, 0x6f, 0x63, 0x0a, 0x25,
-	0x4a, 0x9f, 0xa3, 0x08, 0x78, 0x2e, 0x7d, 0x45, 0x2c, 0xcd, 0xe1, 0xb5, 0x13, 0x22, 0x43, 0x54,
-	0x9f, 0x1a, 0x86, 0x10, 0x29, 0x30, 0x96, 0xed, 0x29, 0x81, 0x53, 0x81, 0x0e, 0x4e, 0x4b, 0xc4,
-	0xde, 0x2e, 0x6d, 0x77, 0x86, 0xc0, 0xa4, 0x23, 0x34, 0x56, 0x24, 0x23, 0x7d, 0x2d, 0x1d, 0xe9,
-	0x54, 0xcf, 0x3a, 0x52, 0x4e, 0x87, 0x44, 0xa9, 0x74, 0xe6, 0x4e, 0xe9, 0xd0, 0x27, 0x5a, 0x00,
-	0xdc, 0x55, 0xda, 0xe6, 0x9a, 0x48, 0xe9, 0xe8, 0xc6, 0x40, 0xf1, 0x43, 0xe1, 0x84, 0x37, 0x06,
-	0x9e, 0x5b, 0x1f, 0xb2, 0x92, 0x2e, 0x20, 0x6e, 0xb2, 0xad, 0xdd, 0x4e, 0x47, 0x74, 0x87, 0xc3,
-	0x53, 0xd1, 0xfd, 0xcb, 0x13, 0x28, 0x08, 0x20, 0x35, 0x31, 0x56, 0xea, 0xf4, 0x44, 0xb7, 0x3d,
-	0x02, 0x92, 0x0f, 0xb5, 0xc3, 0x51, 0xbf, 0xd3, 0x15, 0xbb, 0x23, 0xa8, 0x32, 0xf2, 0xad, 0x5f,
-	0xe6, 0xd8, 0xf6, 0x72, 0xaf, 0x0b, 0xbe, 0xc2, 0x43, 0x25, 0x00, 0x9d, 0x4e, 0x59, 0x5a, 0x4c,
-	0x63, 0x5c, 0xfe, 0x59, 0x30, 0x6e, 0x39, 0x88, 0x0a, 0xab, 0x82, 0x08, 0xcb, 0x50, 0x5f, 0x7e,
-	0xb1, 0x80, 0x51, 0xd2, 0xd9, 0x55, 0x07, 0xa0, 0xf2, 0x72, 0x56, 0xcd, 0xff, 0x8c, 0xd5, 0x15,
-	0xac, 0x0d, 0xe3, 0xee, 0x91, 0xa2, 0x1b, 0x75, 0x4b, 0xa4, 0x0d, 0x62, 0xc9, 0xb3, 0xf5, 0x6f,
-	0x39, 0xb6, 0xae, 0x7a, 0x86, 0xf2, 0xef, 0xe5, 0x38, 0xfc, 0xad, 0x7c, 0x33, 0x72, 0x73, 0xf7,
-	0xc2, 0xdc, 0xee, 0x6d, 0x6b, 0xcf, 0x0d, 0xf1, 0xbc, 0xe2, 0x65, 0x91, 0xb9, 0xf5, 0x4d, 0x81,
-	0x6d, 0x65, 0x16, 0xcc, 0x3f, 0x4e, 0xb4, 0x8c, 0x72, 0xf4, 0xce, 0x57, 0xb2, 0x1f, 0x65, 0x8d,
-	0x7c, 0x7b, 0x16, 0x00, 0x44, 0xc3, 0x91, 0xad, 0xe8, 0x22, 0x21, 0xc5, 0x35, 0xae, 0xb4, 0xec,
-	0x9a, 0x88, 0x15, 0xcd, 0x9f, 0xe5, 0xd9, 0xcd, 0x15, 0xe3, 0x13, 0x88, 0x37, 0x8c, 0xdb, 0x5c,
-	0x49, 0x15, 0x25, 0x54, 0x93, 0x4d, 0xcc, 0xbc, 0x91, 0x62, 0x29, 0x84, 0x0b, 0x2b, 0x42, 0x18,
-	0x7c, 0xf4, 0x84, 0x23, 0xe2, 0x20, 0xea, 0x16, 0xa5, 0x74, 0xfc, 0x00, 0x36, 0xfd, 0x72, 0x31,
-	0x3d, 0x9b, 0x61, 0x27, 0x4f, 0x25, 0xd3, 0x37, 0x9f, 0x66, 0x03, 0x74, 0xc1, 0x10, 0x0f, 0x6e,
-	0xfe, 0xa3, 0xe1, 0xeb, 0x86, 0x33, 0xe7, 0x62, 0xce, 0x1c, 0xb3, 0xeb, 0x7c, 0x92, 0x5d, 0xc7,
-	0x5c, 0xbc, 0x90, 0xe5, 0xe2, 0x8a, 0xb9, 0x17, 0x93, 0xcc, 0x3d, 0xc9, 0xf5, 0xd7, 0xd2, 0x5c,
-	0xbf, 0x35, 0x60, 0xf5, 0xec, 0xa1, 0x63, 0x5a, 0x70, 0x67, 0xf3, 0x05, 0x64, 0x56, 0x47, 0x3e,
-	0xd6, 0xfd, 0xa5, 0x84, 0xe6, 0xc9, 0x07, 0xd7, 0xfa, 0xff, 0x32, 0xab, 0x2f, 0x75, 0x94, 0xa3,
-	0xe0, 0x75, 0xd2, 0xc1, 0xeb, 0x44, 0xfd, 0xca, 0x7c, 0xa2, 0x5f, 0x99, 0x0a, 0xe8, 0xc2, 0xb3,
-	0x04, 0xf4, 0x31, 0xab, 0xcf, 0x2f, 0xaf, 0x02, 0x77, 0x6c, 0x4f, 0x22, 0x96, 0xad, 0xda, 0xdf,
-	0xad, 0xa5, 0xf6, 0xb7, 0x35, 0xc8, 0x78, 0x8a, 0xa5, 0xb1, 0xfc, 0x1e, 0xdb, 0x72, 0x60, 0xa7,
-	0xc2, 0xc4, 0x74, 0xea, 0x06, 0xbf, 0xb4, 0x3c, 0x5d, 0x27, 0xed, 0x28, 0xb2, 0x23, 0xb1, 0xad,
-	0x06, 0x58, 0xe2, 0x2d, 0x42, 0xdd, 0x0f, 0x6f, 0xac, 0x58, 0x12, 0xd9, 0x85, 0xf6, 0xe3, 0x7f,
-	0x0a, 0x58, 0x93, 0x0e, 0x29, 0x4d, 0xae, 0x96, 0x01, 0x24, 0xeb, 0x48, 0x69, 0x0a, 0xc8, 0x82,
-	0xc1, 0x61, 0x7c, 0xe6, 0x7f, 0xc7, 0x6e, 0x8f, 0xfd, 0xab, 0x79, 0xe8, 0x8d, 0x75, 0xab, 0x2c,
-	0xfa, 0xaa, 0x2a, 0x7d, 0xd5, 0xeb, 0xcb, 0x2b, 0x6a, 0xaf, 0xf4, 0x17, 0xd7, 0xcc, 0x03, 0xdf,
-	0xb8, 0x4e, 0x74, 0x53, 0x2d, 0xaf, 0xf1, 0xcf, 0x1f, 0xe8, 0x16, 0x71, 0x97, 0x38, 0x85, 0xd2,
-	0x8a, 0xa4, 0x0b, 0x7f, 0x5f, 0xff, 0xd8, 0x90, 0xf9, 0x20, 0xd5, 0xf5, 0xaa, 0x89, 0x95, 0xc6,
-	0xe6, 0x88, 0xd5, 0xb3, 0xa7, 0x47, 0x39, 0x18, 0x33, 0xb5, 0xf4, 0x4d, 0x8c, 0x69, 0x11, 0xa1,
-	0x1d, 0x5b, 0x4d, 0x0f, 0x60, 0x8a, 0x54, 0x7b, 0x3d, 0xa3, 0x6d, 0xfe, 0x05, 0xdb, 0xca, 0x1c,
-	0x22, 0xd4, 0x51, 0x85, 0x85, 0x6f, 0x5a, 0xf5, 0xf8, 0x88, 0xb7, 0x69, 0x6e, 0x07, 0xc1, 0x23,
-	0x08, 0x60, 0x53, 0x39, 0x1b, 0x19, 0xc8, 0xc2, 0xed, 0xd5, 0xfb, 0x85, 0xb5, 0x40, 0x18, 0x83,
-	0x41, 0x84, 0xe1, 0x69, 0x25, 0xf6, 0x0f, 0x4a, 0x2a, 0x04, 0x22, 0x68, 0xce, 0x3d, 0x11, 0x9a,
-	0xa9, 0xad, 0x48, 0x03, 0x76, 0x53, 0xfc, 0x35, 0xad, 0xc4, 0x46, 0xa5, 0x52, 0x40, 0x49, 0x31,
-	0x90, 0xfe, 0xde, 0x95, 0xfe, 0x0d, 0xa1, 0x28, 0x96, 0xf4, 0x80, 0x08, 0xdb, 0xc9, 0xc3, 0x1a,
-	0x02, 0x26, 0x2a, 0x68, 0x8a, 0x0b, 0x44, 0x55, 0x6d, 0xbf, 0x06, 0xd5, 0xb6, 0xad, 0x7e, 0x11,
-	0xcb, 0xd3, 0x22, 0x33, 0xa7, 0x6c, 0xac, 0xad, 0x9f, 0xe6, 0x59, 0x2d, 0x69, 0xc1, 0x93, 0x1a,
-	0x7b, 0x53, 0xaa, 0x15, 0xf4, 0x49, 0x69, 0x11, 0xfb, 0xc8, 0xe7, 0xae, 0x9c, 0x38, 0x66, 0xca,
-	0x66, 0x6a, 0x4a, 0x1d, 0xf4, 0xfb, 0xe4, 0x21, 0xb4, 0x27, 0x1e, 0x48, 0xf4, 0xeb, 0x86, 0x82,
-	0xc3, 0x48, 0x6e, 0xfe, 0x3c, 0xc7, 0x6a, 0xc9, 0x41, 0xfc, 0x4f, 0x12, 0x1f, 0xb2, 0xb9, 0xf3,
-	0xea, 0xf5, 0xd3, 0x6b, 0x21, 0xd1, 0x5d, 0x40, 0x28, 0x1e, 0x7b, 0x7e, 0x54, 0xd8, 0x93, 0x80,
-	0x01, 0x32, 0xb5, 0x1f, 0xeb, 0xdd, 0xc4, 0x47, 0x04, 0xe7, 0x47, 0xd2, 0xbd, 0xb8, 0x34, 0xbc,
-	0x40, 0x4b, 0xad, 0xbf, 0x65, 0x2c, 0x9e, 0x93, 0x3f, 0x07, 0xbc, 0xe6, 0x64, 0x34, 0xec, 0x75,
-	0xba, 0xa7, 0x9f, 0xf7, 0xc5, 0xbd, 0xd3, 0x76, 0xff, 0x68, 0xa0, 0xfa, 0x92, 0xa2, 0xbb, 0xdb,
-	0x39, 0x3d, 0xec, 0x0d, 0x47, 0xbd, 0xe3, 0x4f, 0x80, 0x10, 0x71, 0x20, 0xa0, 0xed, 0xfe, 0xa0,
-	0x7b, 0xba, 0xdb, 0x6e, 0x9f, 0x20, 0x2d, 0xaa, 0xe7, 0xb1, 0x5d, 0xba, 0xbf, 0x3b, 0x1c, 0x01,
-	0x85, 0x1a, 0x0e, 0xfa, 0xc7, 0xc3, 0x6e, 0xbd, 0xd0, 0xfa, 0xbf, 0x1c, 0xdb, 0xca, 0xfe, 0x02,
-	0x77, 0x3d, 0xea, 0x7e, 0x77, 0xca, 0xf0, 0x1e, 0x94, 0x39, 0x14, 0x32, 0xc3, 0x27, 0x12, 0x87,
-	0x84, 0x13, 0x54, 0x8f, 0x51, 0xa0, 0x28, 0x2c, 0x2e, 0x5b, 0xd9, 0x10, 0xf9, 0xdf, 0x1c, 0xbb,
-	0x4d, 0xab, 0x1f, 0x44, 0xbd, 0xd2, 0x7d, 0xc8, 0x8d, 0x88, 0x63, 0xd7, 0xf3, 0x9e, 0x03, 0x28,
-	0x14, 0x43, 0x28, 0x2a, 0xf0, 0x77, 0x82, 0x23, 0xf5, 0x1b, 0x70, 0xe2, 0x27, 0x8f, 0x5b, 0x96,
-	0xd6, 0x59, 0x09, 0x9b, 0x58, 0x39, 0x82, 0x5b, 0xd8, 0xe4, 0x57, 0xed, 0xe8, 0xe8, 0xa7, 0xd7,
-	0xa5, 0x5f, 0x82, 0x45, 0xe4, 0xd3, 0xfa, 0x41, 0x91, 0x95, 0x74, 0x2c, 0xef, 0x98, 0x6a, 0xb6,
-	0x13, 0x33, 0x21, 0x6e, 0xa5, 0x02, 0x0a, 0x2d, 0x22, 0xe1, 0xf5, 0x2d, 0xcc, 0xe7, 0x17, 0x05,
-	0x13, 0x28, 0xc6, 0x39, 0xa6, 0x33, 0xb9, 0x2c, 0x9d, 0xf9, 0xd6, 0x9f, 0xf6, 0x2c, 0x56, 0x55,
-	0xcf, 0x70, 0x14, 0xfa, 0xdb, 0x96, 0x93, 0x47, 0xec, 0xf2, 0x6d, 0x3d, 0x04, 0x58, 0x0d, 0x3d,
-	0x1e, 0x63, 0x05, 0xa4, 0xc8, 0x44, 0xac, 0xc0, 0xab, 0x48, 0x02, 0xbe, 0xab, 0x44, 0x4b, 0x8d,
-	0xe4, 0x14, 0xf1, 0x42, 0x7b, 0xb6, 0x76, 0x40, 0x9f, 0x54, 0x58, 0x56, 0x9e, 0x25, 0x2c, 0x31,
-	0x4a, 0x00, 0x68, 0x91, 0x29, 0x55, 0x55, 0x03, 0x40, 0x8b, 0x68, 0x81, 0x92, 0x36, 0xf1, 0xdb,
-	0x8c, 0x11, 0xb3, 0x3d, 0xe5, 0x75, 0xb2, 0xa6, 0x7a, 0xca, 0x80, 0xae, 0x8e, 0x46, 0xf0, 0xe1,
-	0x5c, 0x4a, 0xa7, 0x51, 0x23, 0x9f, 0xb4, 0x12, 0x2b, 0x82, 0xf1, 0x22, 0x08, 0xbd, 0x29, 0x7c,
-	0xa8, 0x2e, 0x02, 0x37, 0xc8, 0x2f, 0xab, 0x46, 0x68, 0xf0, 0xe5, 0x43, 0x57, 0x3e, 0x6a, 0x6c,
-	0x2a, 0xde, 0xa6, 0xa4, 0xd6, 0x37, 0x39, 0x56, 0xd6, 0xbf, 0x55, 0xa7, 0xf7, 0x20, 0xf7, 0x2c,
-	0x7b, 0x00, 0x00, 0x35, 0x9e, 0xd8, 0xee, 0xd4, 0x70, 0x45, 0x12, 0x96, 0x33, 0x44, 0x61, 0x55,
-	0x86, 0x78, 0x8d, 0x55, 0x41, 0x9a, 0xc3, 0x1d, 0x0e, 0xcd, 0x2d, 0xad, 0x5a, 0x7d, 0xad, 0x11,
-	0xb1, 0x0d, 0x7f, 0x3f, 0x83, 0xd2, 0xd6, 0x85, 0x1d, 0xfc, 0x07, 0xe9, 0x98, 0xab, 0x41, 0x91,
-	0x50, 0x13, 0x2b, 0x2c, 0xad, 0xff, 0x5e, 0x63, 0xdb, 0x4b, 0x3f, 0xe4, 0xff, 0x06, 0x1f, 0x99,
-	0xc0, 0xb4, 0x7c, 0x1a, 0xd3, 0xb0, 0x01, 0xe3, 0x7b, 0x73, 0x2f, 0x90, 0xce, 0x9e, 0x69, 0xd8,
-	0x24, 0x34, 0xd4, 0x77, 0x8a, 0xff, 0x64, 0xa0, 0x18, 0x72, 0x42, 0x03, 0xc8, 0x66, 0xe8, 0x99,
-	0xa2, 0xf3, 0xbf, 0xb7, 0xfc, 0x07, 0x84, 0x2c, 0x3f, 0x7b, 0x97, 0xdd, 0x8c, 0xe2, 0x37, 0xba,
-	0x53, 0xaa, 0x45, 0x51, 0x13, 0xab, 0x4c, 0xcd, 0x2f, 0x0b, 0xcf, 0x9a, 0xe1, 0x5f, 0x62, 0x25,
-	0xe2, 0xde, 0x26, 0x25, 0x26, 0x8e, 0x45, 0x1b, 0xf8, 0x9e, 0x26, 0x5d, 0x68, 0x58, 0x18, 0x04,
-	0xbb, 0x7b, 0xed, 0xf2, 0x2d, 0xe5, 0x27, 0x92, 0x83, 0x78, 0x87, 0xd5, 0xf4, 0xbf, 0x41, 0xd4,
-	0x24, 0xc5, 0xa7, 0x9c, 0x24, 0x35, 0x8a, 0x7f, 0xca, 0xb6, 0xa2, 0xaf, 0xd6, 0x13, 0xad, 0x3d,
-	0xe5, 0x44, 0xd9, 0x81, 0x4d, 0x97, 0x95, 0xf4, 0xac, 0x0d, 0x56, 0x52, 0x77, 0x52, 0x65, 0x80,
-	0x83, 0x1b, 0x42, 0xcb, 0x00, 0x38, 0x51, 0x3b, 0xc3, 0x74, 0x7d, 0xa3, 0x86, 0x46, 0xdc, 0x20,
-	0xc9, 0x27, 0x1b, 0x24, 0x7b, 0xdb, 0x6c, 0x4b, 0x8d, 0xee, 0xfb, 0x3a, 0xfa, 0x5b, 0x6e, 0x14,
-	0xa3, 0x89, 0xff, 0x85, 0x7c, 0xf7, 0x18, 0xc5, 0xdf, 0x8c, 0x27, 0x3a, 0x0e, 0x35, 0x45, 0x34,
-	0x72, 0xeb, 0x53, 0x56, 0x31, 0xe7, 0x87, 0xac, 0xea, 0x32, 0x6e, 0xdb, 0xa9, 0x66, 0x36, 0x5c,
-	0x62, 0x97, 0xea, 0x2e, 0xd5, 0xac, 0x53, 0x42, 0xdc, 0x9b, 0x52, 0x3c, 0x43, 0x09, 0xad, 0xff,
-	0xca, 0x43, 0x1a, 0x52, 0xff, 0x51, 0xf9, 0xdd, 0x75, 0x07, 0x78, 0x97, 0x6d, 0xab, 0x7e, 0x75,
-	0xa2, 0xda, 0xd5, 0xe1, 0x73, 0x47, 0xff, 0x95, 0x26, 0x59, 0x08, 0x63, 0xbf, 0x56, 0x2c, 0x8f,
-	0x58, 0xd5, 0xfa, 0x6b, 0x7e, 0xc4, 0xb6, 0x32, 0x23, 0x89, 0x89, 0x3e, 0x76, 0x9d, 0x88, 0x89,
-	0xc2, 0x73, 0xba, 0x73, 0x17, 0xed, 0xce, 0x0e, 0xbb, 0xfd, 0x19, 0xc5, 0xe6, 0x3e, 0x56, 0xba,
-	0x08, 0x4a, 0xa6, 0x0f, 0x77, 0xed, 0x66, 0xb5, 0xbe, 0xce, 0xb1, 0x3c, 0xec, 0x19, 0x84, 0x0e,
-	0x20, 0x7b, 0x6c, 0xd7, 0x12, 0xea, 0x2f, 0xed, 0x99, 0x33, 0x31, 0x5d, 0x3e, 0x2d, 0xc1, 0x4e,
-	0x95, 0xe7, 0x8b, 0xb3, 0x07, 0xd8, 0xcf, 0x56, 0x97, 0x6f, 0x1d, 0x92, 0xa6, 0x35, 0x50, 0x2a,
-	0x61, 0x6c, 0x88, 0x40, 0x67, 0xd1, 0x1e, 0xd2, 0x16, 0xd5, 0x44, 0x42, 0x03, 0xf5, 0x47, 0x59,
-	0x8f, 0xc1, 0x10, 0x72, 0x1d, 0xa9, 0xda, 0xb6, 0x2a, 0xe9, 0x47, 0x32, 0x2e, 0x5f, 0x0f, 0xd2,
-	0xe4, 0xc1, 0x88, 0xad, 0x5f, 0xe5, 0x58, 0x35, 0xae, 0x00, 0xdf, 0xc2, 0xa6, 0xa4, 0x3a, 0x0e,
-	0x45, 0x77, 0x79, 0xfc, 0xa7, 0x25, 0x6b, 0xa8, 0x2c, 0xc2, 0xb8, 0x60, 0x91, 0x14, 0x71, 0x10,
-	0x2c, 0x04, 0x02, 0x3d, 0x79, 0x46, 0xdb, 0xfa, 0x8a, 0x7e, 0xff, 0x52, 0x63, 0xd6, 0x59, 0xd9,
-	0x10, 0xd5, 0x1b, 0xf8, 0xdf, 0x80, 0xbe, 0xe8, 0x74, 0xf1, 0xdf, 0x00, 0xb7, 0x19, 0xa7, 0x47,
-	0x60, 0xb5, 0xc7, 0xfb, 0x3d, 0x71, 0xb4, 0x3b, 0xea, 0xf5, 0x8f, 0x81, 0xb7, 0x22, 0xe9, 0x25,
-	0xfd, 0xfe, 0xc9, 0xe1, 0x7e, 0xef, 0xf0, 0xf0, 0xa8, 0x7b, 0x3c, 0xaa, 0x17, 0xe0, 0xfc, 0xea,
-	0xc6, 0xfd, 0x68, 0x70, 0xd8, 0x25, 0xe7, 0x22, 0x4e, 0xde, 0xe9, 0x0d, 0x07, 0x27, 0xc0, 0x78,
-	0xd7, 0x70, 0x46, 0x2d, 0x20, 0xe9, 0xed, 0x1f, 0x9e, 0x90, 0x53, 0x09, 0x5b, 0x87, 0xa2, 0x4b,
-	0x7f, 0x01, 0x28, 0xb7, 0x24, 0xdb, 0xc0, 0xef, 0x93, 0x8e, 0xf9, 0x2f, 0x54, 0x8b, 0x95, 0x75,
-	0xcf, 0x46, 0xdf, 0xdf, 0xf8, 0x3f, 0x7b, 0xc6, 0x10, 0xdd, 0xc1, 0x7c, 0xe2, 0x0e, 0xa6, 0xf8,
-	0x59, 0x21, 0xc3, 0xcf, 0xf6, 0x8a, 0x7f, 0x9d, 0x9f, 0x9f, 0x9d, 0x95, 0xe8, 0xee, 0xbc, 0xff,
-	0xeb, 0x00, 0x00, 0x00, 0xff, 0xff, 0x79, 0x9c, 0x6a, 0x9e, 0xa3, 0x28, 0x00, 0x00,
+	// 5351 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x3c, 0x4d, 0x8c, 0x23, 0xd9,
+	0x59, 0xe3, 0x7f, 0xfb, 0x6b, 0xb7, 0xdb, 0xfd, 0x66, 0x76, 0xc6, 0x58, 0x93, 0xdd, 0x59, 0x67,
+	0x76, 0x32, 0xfb, 0x93, 0xca, 0x4c, 0x6f, 0x12, 0x96, 0x6c, 0x48, 0xe2, 0xb6, 0xab, 0xb7, 0xbd,
+	0xd3, 0xdd, 0x76, 0x9e, 0xdd, 0xb3, 0x0c, 0x8b, 0xd4, 0x54, 0xdb, 0x6f, 0xdc, 0xc5, 0xda, 0x55,
+	0xde, 0xaa, 0xf2, 0xcc, 0xf4, 0x22, 0x0e, 0x44, 0xd9, 0x64, 0x83, 0x40, 0x1c, 0x38, 0x80, 0xe0,
+	0xb4, 0x87, 0x48, 0x1c, 0x38, 0x73, 0x01, 0x71, 0x80, 0x03, 0x39, 0x23, 0x21, 0x45, 0x48, 0x08,
+	0x09, 0x21, 0xe5, 0xc6, 0x15, 0x84, 0x72, 0x40, 0xdf, 0xfb, 0xa9, 0x7a, 0x55, 0xae, 0xee, 0xe9,
+	0x69, 0xb4, 0xe2, 0x56, 0xdf, 0xcf, 0x7b, 0x7e, 0xef, 0xab, 0xef, 0x7d, 0xbf, 0xaf, 0x0c, 0x1b,
+	0x63, 0xd7, 0x09, 0x3c, 0x6b, 0x1c, 0xf8, 0xc6, 0xc2, 0x73, 0x03, 0xb7, 0x49, 0xc6, 0xee, 0xd2,
+	0x09, 0xbc, 0xd3, 0xb1, 0x3b, 0x61, 0x0a, 0xb7, 0x3e, 0x67, 0xbe, 0x6f, 0x4d, 0x99, 0x04, 0x5f,
+	0x99, 0xba, 0xee, 0x74, 0xc6, 0xbe, 0xc6, 0xa1, 0xe3, 0xe5, 0xe3, 0xaf, 0x05, 0xf6, 0x9c, 0xf9,
+	0x81, 0x35, 0x5f, 0x48, 0x86, 0x1b, 0xec, 0x59, 0xc0, 0x9c, 0x09, 0x9b, 0x1c, 0xcd, 0xdc, 0xb1,
+	0x15, 0xd8, 0xae, 0x23, 0x08, 0xad, 0xbf, 0x2b, 0xc3, 0x26, 0xb5, 0xc7, 0x96, 0x37, 0xb1, 0x2d,
+	0xa7, 0x23, 0x7f, 0x99, 0xdc, 0x83, 0xda, 0x13, 0xe6, 0x4c, 0x5c, 0x6f, 0xcf, 0xf6, 0x03, 0xdb,
+	0x99, 0xfa, 0x8d, 0xcc, 0xad, 0xdc, 0xdd, 0xb5, 0xad, 0xb2, 0x21, 0x11, 0x34, 0x41, 0x27, 0x77,
+	0x00, 0x8e, 0x97, 0xa7, 0xcc, 0xeb, 0x7b, 0x13, 0xe6, 0x35, 0xb2, 0xb7, 0x32, 0x77, 0xd7, 0xb6,
+	0x8a, 0x06, 0x87, 0xa8, 0x46, 0x21, 0x7b, 0x70, 0x43, 0x8c, 0xe4, 0x60, 0xc7, 0x75, 0x1e, 0xdb,
+	0xde, 0x9c, 0x2f, 0xa8, 0x91, 0xe3, 0x83, 0x88, 0xb1, 0x42, 0xa1, 0x67, 0x0d, 0x21, 0x3d, 0xb8,
+	0xae, 0x91, 0x76, 0x96, 0xb3, 0xc7, 0xf6, 0x6c, 0x36, 0x67, 0x4e, 0xd0, 0xc8, 0xf3, 0xf5, 0x6e,
+	0x1a, 0x49, 0x02, 0x3d, 0x63, 0x00, 0xe9, 0xc2, 0xb5, 0x68, 0x99, 0x1d, 0x77, 0xbe, 0x98, 0x31,
+	0xbe, 0xaa, 0x02, 0x5f, 0x55, 0xdd, 0x48, 0xe0, 0x69, 0x2a, 0x37, 0x69, 0x41, 0x69, 0x62, 0xfb,
+	0x8b, 0x65, 0xc0, 0x1a, 0x45, 0x3e, 0xb0, 0x6c, 0x74, 0x05, 0x4c, 0x15, 0x81, 0x7c, 0x0f, 0x36,
+	0xe5, 0x23, 0x65, 0xbe, 0x3b, 0x5b, 0xf2, 0x9f, 0x29, 0xc9, 0xcd, 0x77, 0x93, 0x14, 0xba, 0xca,
+	0xac, 0xcd, 0xd0, 0x1e, 0x8f, 0xd9, 0x22, 0xb0, 0x9c, 0x31, 0x6b, 0x94, 0xe3, 0x33, 0x44, 0x14,
+	0xba, 0xca, 0x4c, 0x5e, 0x81, 0xa2, 0xc7, 0x1e, 0x2f, 0x9d, 0x49, 0xa3, 0xc2, 0x87, 0x95, 0x0c,
+	0xca, 0x41, 0x2a, 0xd1, 0xe4, 0x0d, 0x00, 0xdf, 0x9e, 0x3a, 0x56, 0xb0, 0xf4, 0x98, 0xdf, 0x00,
+	0x2e, 0x4d, 0x30, 0x86, 0x0a, 0x45, 0x35, 0x2a, 0xb9, 0x0e, 0x45, 0xe6, 0x79, 0xae, 0xe7, 0x37,
+	0xd6, 0x6e, 0xe5, 0xee, 0x56, 0xa8, 0x84, 0xc8, 0xfb, 0x70, 0x9d, 0x0b, 0x69, 0xdf, 0x9e, 0x31,
+	0x3f, 0x70, 0x1d, 0x46, 0xd9, 0x8c, 0x59, 0x3e, 0xf3, 0x1b, 0x3f, 0xfc, 0xba, 0x7c, 0x3d, 0x49,
+	0x12, 0x3d, 0x63, 0x04, 0xd9, 0x55, 0x6f, 0x7a, 0x84, 0x9a, 0x7d, 0xc2, 0x58, 0x60, 0x3a, 0x81,
+	0x67, 0x33, 0xbf, 0xf1, 0xa9, 0x98, 0x6b, 0xc3, 0x88, 0x51, 0x4e, 0xe9, 0x19, 0xfc, 0xe4, 0x3d,
+	0x78, 0x89, 0xff, 0x46, 0x48, 0xa0, 0xec, 0x89, 0xcd, 0x9e, 0xfa, 0x8d, 0x1f, 0x89, 0x89, 0xea,
+	0x46, 0x82, 0x42, 0xd3, 0xf9, 0xc9, 0xb7, 0x60, 0xc3, 0xc5, 0xd7, 0xdf, 0x9e, 0x33, 0x67, 0x82,
+	0x3a, 0xe4, 0x37, 0x7e, 0xac, 0xd6, 0xd2, 0x8f, 0x11, 0x68, 0x92, 0x91, 0x50, 0xb8, 0x11, 0x47,
+	0x51, 0xe6, 0x2f, 0x5c, 0x07, 0x65, 0xf3, 0x99, 0x98, 0xe3, 0x86, 0xd1, 0x4f, 0x65, 0xa0, 0x67,
+	0x0d, 0x24, 0xef, 0xc2, 0x86, 0x7c, 0xd1, 0xe6, 0x13, 0x7b, 0xc2, 0x50, 0x27, 0x7e, 0xa2, 0xb6,
+	0xd4, 0x8d, 0x13, 0x68, 0x92, 0x93, 0xfc, 0x2a, 0xd4, 0x16, 0x96, 0x17, 0xd8, 0xd6, 0x4c, 0x28,
+	0x82, 0xdf, 0xf8, 0x03, 0x31, 0xb6, 0x66, 0x0c, 0x74, 0x3c, 0x4d, 0xb0, 0xb5, 0x3e, 0x84, 0x12,
+	0x9a, 0x0d, 0xb4, 0x1a, 0xd7, 0xa0, 0xc0, 0xe6, 0x96, 0x3d, 0x6b, 0x64, 0x6e, 0x65, 0xee, 0x56,
+	0xa8, 0x00, 0xc8, 0x2d, 0x58, 0x5b, 0x9c, 0xb8, 0x0e, 0x3b, 0x58, 0xce, 0x8f, 0xa5, 0x69, 0xa8,
+	0x50, 0x1d, 0x45, 0x1a, 0x50, 0x7a, 0xca, 0x8e, 0x7d, 0x3b, 0x60, 0xdc, 0x06, 0x54, 0xa8, 0x02,
+	0x5b, 0xff, 0xd0, 0x80, 0x92, 0x34, 0x31, 0x84, 0x40, 0xde, 0x9f, 0x2d, 0xa7, 0x72, 0x72, 0xfe,
+	0x4c, 0x5e, 0x81, 0xb2, 0x78, 0xcb, 0xbd, 0xae, 0xb4, 0x39, 0x39, 0xa3, 0xd7, 0xa5, 0x21, 0x92,
+	0x7c, 0x15, 0xca, 0x73, 0x16, 0x58, 0x13, 0x2b, 0xb0, 0xa4, 0x7d, 0xd9, 0x54, 0x26, 0xcc, 0xd8,
+	0x97, 0x04, 0x1a, 0xb2, 0x90, 0x57, 0x21, 0x6f, 0x07, 0x6c, 0xde, 0xc8, 0x73, 0xd6, 0xf5, 0x90,
+	0xb5, 0x17, 0xb0, 0x39, 0xe5, 0x24, 0xd2, 0x86, 0x0d, 0xff, 0xc4, 0x5e, 0x2c, 0x6c, 0x67, 0xda,
+	0x5f, 0xe0, 0x69, 0xf4, 0x1b, 0x05, 0xf9, 0xc2, 0x14, 0xf7, 0x30, 0x46, 0xa7, 0x49, 0x7e, 0xd2,
+	0x82, 0x42, 0x60, 0x3d, 0x63, 0x7e, 0xa3, 0xc8, 0x07, 0x56, 0xc3, 0x81, 0x23, 0xeb, 0x19, 0x15,
+	0x24, 0xf2, 0x3a, 0x94, 0xc6, 0xee, 0x12, 0xdf, 0x6c, 0xa3, 0x24, 0x75, 0x4a, 0x71, 0x75, 0x38,
+	0x9e, 0x2a, 0x3a, 0x79, 0x19, 0x60, 0xee, 0x4e, 0x98, 0x67, 0x05, 0x78, 0x04, 0xcb, 0xfc, 0x08,
+	0x6a, 0x18, 0x62, 0x00, 0x09, 0x98, 0x37, 0xf7, 0xdb, 0xce, 0xa4, 0xe3, 0x3a, 0x13, 0x5b, 0x2c,
+	0xba, 0xc2, 0xc5, 0x98, 0x42, 0x21, 0x2d, 0xa8, 0x0a, 0x23, 0x30, 0x70, 0x67, 0xf6, 0xf8, 0xb4,
+	0x01, 0x9c, 0x33, 0x86, 0x23, 0xaf, 0x41, 0x59, 0x39, 0x12, 0x3c, 0x80, 0xc2, 0xd2, 0xb5, 0x27,
+	0x13, 0x8f, 0xf9, 0x3e, 0x0d, 0x49, 0xe4, 0xcb, 0xb8, 0x0b, 0xae, 0x1c, 0x8d, 0x1f, 0x29, 0x2e,
+	0xa9, 0x2d, 0x54, 0x51, 0xc8, 0xdb, 0x00, 0x73, 0x75, 0xde, 0xc3, 0x23, 0x44, 0xa2, 0xd7, 0xa4,
+	0x68, 0x54, 0x63, 0x6b, 0xfe, 0x7e, 0x06, 0x2a, 0x21, 0x05, 0x35, 0x2f, 0xb0, 0x83, 0x19, 0x53,
+	0x9a, 0xc7, 0x01, 0xd4, 0xbc, 0x09, 0xf3, 0xc7, 0x9e, 0xcd, 0xe5, 0xae, 0x34, 0x4f, 0x43, 0xe1,
+	0xb8, 0x85, 0x67, 0x8f, 0x85, 0xde, 0xe5, 0xa9, 0x00, 0xc8, 0x1d, 0xa8, 0x2d, 0x3c, 0x77, 0xcc,
+	0x7c, 0xdf, 0x76, 0xa6, 0x78, 0xec, 0xb9, 0x3e, 0x54, 0x68, 0x02, 0xdb, 0xfc, 0xd7, 0x22, 0x94,
+	0x95, 0x12, 0xa1, 0x12, 0x3f, 0x61, 0x9e, 0x8f, 0x3f, 0x84, 0x8b, 0x58, 0xa7, 0x0a, 0x24, 0xdb,
+	0x50, 0x55, 0x2e, 0x7d, 0x74, 0xba, 0x60, 0x7c, 0x1d, 0xb5, 0xad, 0x97, 0x57, 0xf4, 0xd0, 0xe8,
+	0x68, 0x5c, 0x34, 0x36, 0x86, 0xdc, 0x83, 0xe2, 0x63, 0x17, 0xbd, 0x1e, 0x5f, 0x69, 0x6d, 0xab,
+	0xb1, 0x3a, 0x7a, 0x87, 0xd3, 0xa9, 0xe4, 0x23, 0x5b, 0x50, 0x64, 0xcf, 0x16, 0xb6, 0x77, 0x2a,
+	0x95, 0xb9, 0x69, 0x88, 0x18, 0xc1, 0x50, 0x31, 0x82, 0x31, 0x52, 0x31, 0x02, 0x95, 0x9c, 0xa8,
+	0x29, 0x16, 0xf7, 0x11, 0x6c, 0xd2, 0x59, 0x7a, 0x1e, 0x73, 0xc6, 0x36, 0x13, 0xea, 0x5d, 0xa1,
+	0x29, 0x14, 0x72, 0x17, 0x36, 0x50, 0x62, 0xb6, 0x33, 0x95, 0xc8, 0x53, 0xee, 0xf5, 0x2a, 0x34,
+	0x89, 0x26, 0x4d, 0x28, 0xcf, 0x2c, 0x67, 0xba, 0xb4, 0xa6, 0x8c, 0xbb, 0xba, 0x0a, 0x0d, 0x61,
+	0xfc, 0x55, 0x7c, 0x25, 0xee, 0x53, 0x5c, 0x90, 0xbb, 0x0c, 0x76, 0xdd, 0x25, 0xd7, 0x63, 0x14,
+	0x62, 0x0a, 0x05, 0xe7, 0x1a, 0xbb, 0xb6, 0xc3, 0x65, 0x29, 0xb4, 0x38, 0x84, 0xc9, 0x1b, 0x50,
+	0xc7, 0xe7, 0xae, 0xfd, 0xc4, 0xf6, 0xed, 0x63, 0x7b, 0x66, 0x07, 0x42, 0x7f, 0xd7, 0xe9, 0x0a,
+	0x9e, 0xdc, 0x86, 0x75, 0xfe, 0xbe, 0xf7, 0xdd, 0x89, 0xfd, 0xd8, 0x66, 0x5e, 0x63, 0xed, 0x56,
+	0xe6, 0x6e, 0x96, 0xc6, 0x91, 0x84, 0xc2, 0xa6, 0xcf, 0xbc, 0x27, 0xf6, 0x98, 0x51, 0x2b, 0x60,
+	0xfb, 0x2c, 0x38, 0x71, 0x27, 0x42, 0xe5, 0x6b, 0x5b, 0x5f, 0x5e, 0x7d, 0x0b, 0xc3, 0x24, 0x2f,
+	0x5d, 0x1d, 0x4e, 0xbe, 0x01, 0x2f, 0x49, 0x64, 0x67, 0x66, 0xf9, 0xbe, 0xfd, 0xd8, 0x96, 0x47,
+	0x89, 0x1f, 0x92, 0x0a, 0x4d, 0xa7, 0xb6, 0x3e, 0x84, 0xcd, 0x95, 0xe9, 0x49, 0x05, 0x0a, 0x3b,
+	0xbd, 0xdf, 0x30, 0xbb, 0xf5, 0x2b, 0xa4, 0x0a, 0xe5, 0x81, 0x49, 0x8f, 0x76, 0xfb, 0x87, 0xb4,
+	0x9e, 0x21, 0x6b, 0x50, 0x42, 0xa8, 0xdb, 0x7e, 0x54, 0xcf, 0x92, 0x75, 0xa8, 0x20, 0xb0, 0xdf,
+	0x3f, 0x18, 0xed, 0xd6, 0x73, 0x64, 0x13, 0xd6, 0x39, 0xd8, 0xdb, 0x33, 0x87, 0xa3, 0xfe, 0x81,
+	0x59, 0x2f, 0xb4, 0x26, 0x50, 0xd5, 0xf5, 0x8f, 0xb3, 0xec, 0x3e, 0x1a, 0xf6, 0x3a, 0xed, 0xbd,
+	0xa3, 0xf7, 0xfa, 0x7d, 0x9c, 0xbf, 0x0e, 0xd5, 0x6e, 0xef, 0xbd, 0xde, 0x48, 0x61, 0xf8, 0x6f,
+	0x0c, 0x4d, 0xfa, 0xb0, 0xd7, 0x31, 0xeb, 0x59, 0x52, 0x03, 0xe8, 0xd0, 0xfe, 0x07, 0xdd, 0xa3,
+	0x9d, 0xc3, 0x83, 0x6e, 0x3d, 0x47, 0x08, 0xd4, 0x3a, 0xf4, 0xd1, 0x60, 0xd4, 0xef, 0x1c, 0x52,
+	0x6a, 0x1e, 0x74, 0x1e, 0xd5, 0xf3, 0xad, 0x37, 0xa1, 0x28, 0xf4, 0x94, 0x6c, 0xc0, 0x1a, 0x5f,
+	0xf7, 0xd1, 0x80, 0xe2, 0x70, 0x3e, 0xfb, 0x7e, 0x9b, 0x3e, 0x30, 0x47, 0x12, 0x93, 0x6d, 0xfe,
+	0x5b, 0x11, 0xf2, 0x68, 0x79, 0x2f, 0x7d, 0xbc, 0x57, 0x0f, 0x72, 0x2e, 0xed, 0x20, 0x47, 0x66,
+	0x20, 0xaf, 0x9b, 0x01, 0x02, 0x79, 0xc7, 0x7f, 0xfc, 0x94, 0x47, 0x80, 0x65, 0xca, 0x9f, 0x11,
+	0x17, 0x58, 0x53, 0x61, 0xb9, 0x2b, 0x94, 0x3f, 0x93, 0x37, 0xa1, 0x68, 0xcf, 0xad, 0x29, 0x53,
+	0x96, 0xfa, 0x6a, 0xcc, 0x6d, 0x18, 0x3d, 0xa4, 0x51, 0xc9, 0x82, 0xc6, 0x7a, 0x6c, 0x05, 0x6c,
+	0xea, 0xf2, 0xd8, 0x45, 0x1a, 0xeb, 0x08, 0x83, 0x4b, 0x99, 0x7a, 0xd6, 0x5c, 0xd8, 0xe7, 0x2c,
+	0x15, 0x00, 0xb9, 0x09, 0x95, 0xb1, 0x32, 0xd0, 0xd2, 0x1e, 0x47, 0x08, 0x62, 0x40, 0xc9, 0x95,
+	0xae, 0x68, 0x8d, 0xaf, 0xe0, 0x5a, 0x7c, 0x05, 0xd2, 0x0f, 0x29, 0x26, 0xf2, 0x1a, 0xe4, 0xfd,
+	0x8f, 0x96, 0x7e, 0xa3, 0x2a, 0x83, 0xb0, 0x18, 0xf3, 0xf0, 0xa3, 0x25, 0xe5, 0xe4, 0xe6, 0xdf,
+	0x67, 0xa0, 0x28, 0x86, 0x72, 0x51, 0x58, 0x73, 0x25, 0x7f, 0xfe, 0x7c, 0x01, 0xf1, 0xbf, 0x03,
+	0xe5, 0x27, 0x96, 0x67, 0x5b, 0x18, 0x19, 0xe5, 0xf8, 0x6f, 0xdd, 0x4c, 0x5b, 0x98, 0xf1, 0x50,
+	0x30, 0xd1, 0x90, 0xbb, 0xb9, 0x0b, 0x25, 0x89, 0x4c, 0xfd, 0xe9, 0xd7, 0xa1, 0xc0, 0xc5, 0x29,
+	0x7d, 0x7e, 0xaa, 0xc0, 0x05, 0x07, 0xfa, 0x89, 0xdc, 0xf0, 0xa3, 0x25, 0x3a, 0x35, 0x39, 0x7b,
+	0xc7, 0x9d, 0x1f, 0xbb, 0x3c, 0x9f, 0x59, 0xa7, 0x31, 0x1c, 0x4a, 0x79, 0xe1, 0xb9, 0x93, 0xe5,
+	0x38, 0x90, 0xe1, 0x44, 0x85, 0x46, 0x08, 0xa4, 0xfa, 0x4b, 0x6f, 0x7c, 0x62, 0x79, 0x53, 0xa1,
+	0x47, 0x39, 0x1a, 0x21, 0xd0, 0x28, 0x7d, 0xbc, 0xb4, 0x9c, 0x00, 0x0d, 0x4e, 0x9e, 0x13, 0x43,
+	0xb8, 0xf9, 0xa7, 0x19, 0x28, 0xf0, 0x45, 0x21, 0xd7, 0x63, 0x7b, 0xc6, 0xb4, 0x0d, 0x85, 0x30,
+	0xd2, 0x5c, 0xcf, 0x9e, 0xda, 0x8e, 0x35, 0x93, 0x3f, 0x1e, 0xc2, 0xa8, 0x15, 0xb3, 0xf0, 0x77,
+	0x2b, 0x54, 0x00, 0x18, 0x77, 0xcf, 0xd9, 0xc4, 0x5e, 0xce, 0xa5, 0x7f, 0x92, 0x10, 0x72, 0xfb,
+	0x73, 0x6b, 0x36, 0xe3, 0x9a, 0x5b, 0xa1, 0x02, 0xe0, 0xaa, 0x6b, 0x3b, 0xca, 0x42, 0xf3, 0xe7,
+	0xe6, 0x1f, 0xe6, 0xa0, 0x16, 0x8f, 0x56, 0x52, 0xe5, 0xfd, 0x0e, 0xe4, 0x83, 0xc8, 0x73, 0xdd,
+	0x3e, 0x23, 0xd0, 0x09, 0x41, 0xee, 0xbf, 0xf8, 0x08, 0x72, 0x07, 0x4a, 0x1e, 0x9b, 0x72, 0xd5,
+	0x44, 0x0d, 0xa8, 0x6d, 0x55, 0x31, 0x7c, 0xc1, 0xf8, 0xbc, 0xe3, 0x4e, 0x18, 0x55, 0x44, 0xf2,
+	0x2e, 0x94, 0xa5, 0xcd, 0x53, 0xe1, 0xd4, 0x2b, 0x67, 0xfe, 0x8a, 0xe0, 0xa3, 0xe1, 0x80, 0xe6,
+	0x9f, 0x64, 0xa0, 0x24, 0xb1, 0xa9, 0xcb, 0x0f, 0x8f, 0x77, 0x56, 0x3f, 0xde, 0x6f, 0xc1, 0x26,
+	0xf3, 0x03, 0x7b, 0x6e, 0x05, 0x6c, 0xd2, 0x65, 0x33, 0xfb, 0x09, 0xf3, 0x4e, 0xa5, 0x7c, 0x57,
+	0x09, 0xe4, 0x1e, 0x5c, 0xb5, 0x26, 0xe2, 0xbc, 0x59, 0x33, 0x54, 0xb3, 0x81, 0x66, 0x30, 0xd2,
+	0x48, 0xad, 0xfb, 0x50, 0xd5, 0x05, 0x82, 0xf6, 0x6d, 0xaf, 0x8f, 0xd6, 0x74, 0xd0, 0xeb, 0x3c,
+	0x38, 0x1c, 0xd4, 0xaf, 0x24, 0x4d, 0x60, 0xa6, 0xf9, 0xc7, 0x19, 0xc8, 0x8d, 0xac, 0x67, 0x18,
+	0x4b, 0x04, 0xd6, 0x33, 0x1c, 0x25, 0xf7, 0xa1, 0x40, 0xf2, 0x16, 0x40, 0x60, 0x3d, 0xa3, 0x52,
+	0xa4, 0xd9, 0x14, 0x91, 0x6a, 0x74, 0x3c, 0xa2, 0x81, 0xf5, 0x4c, 0xad, 0x82, 0x6f, 0xae, 0x4c,
+	0x75, 0x14, 0x9a, 0xa3, 0x05, 0xf3, 0xc6, 0xcc, 0x09, 0xac, 0xa9, 0xd8, 0x4d, 0x96, 0x6a, 0x18,
+	0x6e, 0x03, 0x44, 0xbc, 0x79, 0x86, 0x11, 0xbe, 0x06, 0xf9, 0x13, 0xcb, 0x3f, 0x11, 0x1a, 0xbb,
+	0x7b, 0x85, 0x72, 0x88, 0xdc, 0x86, 0xea, 0xc4, 0xf6, 0x79, 0xdd, 0x02, 0x17, 0x25, 0xc4, 0xba,
+	0x7b, 0x85, 0xc6, 0xb0, 0xe4, 0x0d, 0xd8, 0x90, 0x3f, 0xd5, 0x95, 0x68, 0xae, 0xb1, 0xd9, 0xdd,
+	0x0c, 0x4d, 0x12, 0xc8, 0x1d, 0xe9, 0xac, 0x43, 0x4e, 0x54, 0xe3, 0xfc, 0x6e, 0x86, 0xc6, 0xd1,
+	0xdb, 0x45, 0xc8, 0x63, 0x9d, 0x64, 0x1b, 0xa0, 0xac, 0x7e, 0xab, 0xf5, 0x39, 0x81, 0x82, 0xa8,
+	0x3e, 0xdc, 0x86, 0x75, 0x11, 0xc6, 0xca, 0x50, 0x55, 0xee, 0x25, 0x8e, 0xc4, 0x93, 0x2e, 0x10,
+	0x3b, 0x4c, 0xe9, 0x4c, 0x84, 0x20, 0x6f, 0x42, 0xd9, 0xd7, 0x25, 0x1a, 0xa6, 0x7b, 0xa1, 0xa2,
+	0xd2, 0x90, 0x81, 0x7c, 0x09, 0x4a, 0x3c, 0x79, 0xec, 0x75, 0x1b, 0xf9, 0x28, 0x3f, 0x51, 0x38,
+	0xf2, 0x0e, 0x54, 0xc2, 0x4a, 0x4d, 0xa3, 0xf0, 0xdc, 0x38, 0x2d, 0x62, 0x26, 0xaf, 0x42, 0xc1,
+	0x0e, 0xd8, 0x5c, 0xe5, 0x10, 0x6b, 0x72, 0x09, 0x3c, 0x51, 0x11, 0x14, 0x72, 0x17, 0x4a, 0x0b,
+	0xeb, 0x94, 0x57, 0x43, 0x44, 0x75, 0xa1, 0x26, 0x99, 0x06, 0x02, 0x4b, 0x15, 0x19, 0xb5, 0xc0,
+	0xb3, 0xf0, 0xac, 0x3d, 0x60, 0xa7, 0xc2, 0x29, 0x55, 0xa9, 0x86, 0x21, 0x5b, 0x70, 0xcd, 0x9a,
+	0x05, 0xcc, 0x73, 0xac, 0x80, 0xc9, 0xf0, 0xbd, 0xe7, 0x3c, 0x76, 0x65, 0xf4, 0x95, 0x4a, 0xd3,
+	0xe3, 0x61, 0x88, 0xc7, 0xc3, 0xf7, 0x63, 0xf1, 0xfe, 0x0f, 0x55, 0x8a, 0x2a, 0xd6, 0x96, 0x1a,
+	0xed, 0x93, 0x6f, 0xc0, 0xda, 0xb1, 0x3d, 0x9b, 0xa1, 0x6c, 0xad, 0x80, 0xa9, 0x8c, 0x43, 0x96,
+	0x8a, 0x8c, 0xed, 0x88, 0x44, 0x75, 0x3e, 0xf2, 0x3e, 0x10, 0x7f, 0x79, 0x1c, 0x3a, 0xa4, 0x01,
+	0xf3, 0x6c, 0x77, 0xa2, 0x32, 0x91, 0x5f, 0x51, 0x6f, 0x6d, 0x85, 0x83, 0xa6, 0x8c, 0x22, 0x5b,
+	0x50, 0xfd, 0x78, 0xe9, 0x06, 0x6c, 0xd7, 0xf6, 0x03, 0xd7, 0x3b, 0x6d, 0xfc, 0x58, 0xcc, 0xb2,
+	0x6e, 0x7c, 0x5f, 0xc3, 0xd2, 0x18, 0x0f, 0x2e, 0xdb, 0x5a, 0x2c, 0x5c, 0xdb, 0x09, 0xf8, 0x5b,
+	0xf8, 0x2c, 0xbe, 0xec, 0x76, 0x44, 0xa2, 0x3a, 0x5f, 0xb3, 0x9f, 0x48, 0x6d, 0x6c, 0x67, 0xc2,
+	0x9e, 0xc9, 0xac, 0x42, 0x00, 0xd1, 0x61, 0xcc, 0xea, 0x87, 0xf1, 0x3a, 0x14, 0xad, 0x39, 0x3f,
+	0x1d, 0x22, 0x9f, 0x91, 0x50, 0xf3, 0x27, 0x19, 0x58, 0xd3, 0x7e, 0x8d, 0xdc, 0x83, 0x82, 0x1f,
+	0x58, 0x5e, 0xd0, 0xc8, 0x3c, 0x57, 0xe5, 0x04, 0x23, 0x79, 0x0b, 0x72, 0xcc, 0x99, 0x34, 0xb2,
+	0xcf, 0xe5, 0x47, 0x36, 0x74, 0x65, 0xa8, 0xa9, 0x9f, 0xb8, 0x8e, 0xf2, 0x58, 0x21, 0xdc, 0xfc,
+	0x3d, 0x20, 0xab, 0x12, 0x27, 0xf7, 0xa1, 0xaa, 0xcb, 0x5c, 0x2e, 0x6c, 0x3d, 0xf6, 0x72, 0x68,
+	0x8c, 0x85, 0xfb, 0x63, 0x55, 0x83, 0xe2, 0x0b, 0xab, 0xd2, 0x08, 0x81, 0xa2, 0x58, 0x88, 0xd7,
+	0x9d, 0xe3, 0x72, 0x93, 0x50, 0xf3, 0xcf, 0x32, 0xb0, 0xa6, 0xe9, 0x0b, 0xe9, 0x70, 0xd5, 0x57,
+	0x71, 0x7d, 0xe6, 0xe2, 0x61, 0xbd, 0x36, 0x0c, 0x97, 0xb2, 0x74, 0xec, 0x60, 0xa0, 0x39, 0x99,
+	0x08, 0x81, 0x51, 0x68, 0xe8, 0x4f, 0x0e, 0x1d, 0x9b, 0x07, 0x43, 0xc8, 0x92, 0xc0, 0x36, 0xff,
+	0x29, 0x03, 0xe5, 0xd0, 0x30, 0x5f, 0x87, 0x22, 0x1a, 0x91, 0x91, 0x2b, 0x4d, 0x94, 0x84, 0xf0,
+	0x58, 0x59, 0xd2, 0x76, 0x89, 0x57, 0xaf, 0x40, 0xf4, 0x7c, 0x63, 0x8c, 0x3e, 0x84, 0xc0, 0xf9,
+	0x33, 0x8f, 0x04, 0x02, 0x3c, 0x31, 0x79, 0x19, 0x09, 0x20, 0xc0, 0x8d, 0xbe, 0xeb, 0x07, 0xd6,
+	0x8c, 0xdb, 0x66, 0x11, 0x24, 0x68, 0x18, 0x74, 0xda, 0xb2, 0xe4, 0xcc, 0xad, 0xec, 0x8a, 0xd3,
+	0x96, 0x44, 0x8c, 0xa9, 0xe4, 0x8f, 0x1f, 0xb8, 0x01, 0x0f, 0x7f, 0x79, 0xa1, 0x40, 0xc7, 0x35,
+	0xff, 0x32, 0x27, 0x63, 0xf8, 0x5b, 0xb0, 0x36, 0x13, 0x52, 0xdd, 0x45, 0x7f, 0x21, 0x76, 0xa5,
+	0xa3, 0x62, 0x21, 0x54, 0x96, 0xbf, 0xb4, 0x10, 0xc6, 0x25, 0xab, 0xe7, 0x6f, 0x7e, 0x9d, 0xe7,
+	0x86, 0x79, 0xaa, 0x61, 0xc8, 0x5b, 0x51, 0x08, 0x9c, 0xbb, 0x95, 0xd3, 0x0e, 0x59, 0x6a, 0x00,
+	0xbc, 0x0d, 0xb5, 0x78, 0x4d, 0x26, 0xcc, 0x91, 0xb5, 0x41, 0x89, 0x2a, 0x4e, 0x62, 0x04, 0x8a,
+	0x7b, 0xce, 0xe6, 0xae, 0x14, 0x1f, 0x7f, 0xc6, 0x3d, 0x8a, 0xa2, 0x0c, 0xca, 0x49, 0x25, 0x09,
+	0x3a, 0x8a, 0x67, 0x24, 0xc2, 0xe8, 0x2a, 0x0f, 0x54, 0x92, 0x19, 0x49, 0x0c, 0xdb, 0xdc, 0x3a,
+	0x37, 0xf4, 0xbe, 0x06, 0x85, 0x27, 0xd6, 0x6c, 0x19, 0x9e, 0x7e, 0x0e, 0x34, 0xbf, 0x73, 0xa1,
+	0x58, 0xae, 0x01, 0x25, 0x19, 0x38, 0x29, 0x05, 0x92, 0x60, 0xf3, 0x7f, 0x72, 0x50, 0x92, 0xae,
+	0x81, 0x7c, 0x15, 0x43, 0x4b, 0xed, 0x48, 0xbc, 0x14, 0x77, 0x1d, 0x86, 0x3c, 0x04, 0xc5, 0x79,
+	0x78, 0x00, 0xc2, 0x82, 0x93, 0x8a, 0x9c, 0x43, 0xc4, 0x59, 0x66, 0x09, 0x47, 0x8d, 0x4f, 0x2c,
+	0xdb, 0x41, 0x87, 0x2d, 0x35, 0x34, 0x42, 0xe8, 0x9a, 0x5e, 0x88, 0x6b, 0x3a, 0x2f, 0x50, 0x4d,
+	0x18, 0x9b, 0x0f, 0xb9, 0x31, 0x90, 0x11, 0x6d, 0x0c, 0x87, 0x3c, 0xe1, 0x02, 0x1e, 0xb0, 0x53,
+	0x2e, 0xe6, 0x2a, 0x8d, 0xe1, 0xf8, 0x89, 0x71, 0x6d, 0xa7, 0x51, 0x96, 0x27, 0xc6, 0xb5, 0x1d,
+	0xb2, 0x03, 0xb5, 0x90, 0x67, 0x60, 0x39, 0x6c, 0x86, 0x0e, 0x0a, 0x75, 0xe3, 0x4b, 0x49, 0x09,
+	0xc4, 0xb8, 0x68, 0x62, 0x54, 0x33, 0x80, 0x5a, 0x9c, 0x23, 0x51, 0xa6, 0xcb, 0xac, 0x94, 0xe9,
+	0x6e, 0xc3, 0xba, 0xbe, 0x3a, 0x11, 0xdd, 0x55, 0x69, 0x1c, 0x89, 0x32, 0x0b, 0x4e, 0x3c, 0xe6,
+	0x9f, 0xb8, 0x33, 0x65, 0xda, 0x22, 0x44, 0xeb, 0x1d, 0x28, 0x4a, 0x93, 0x74, 0x15, 0x36, 0xda,
+	0xdd, 0x2e, 0x35, 0x87, 0xc3, 0x23, 0x6a, 0x7e, 0xff, 0xd0, 0x1c, 0x8e, 0xea, 0x57, 0x08, 0x40,
+	0xb1, 0xdb, 0xa3, 0x66, 0x67, 0x54, 0xcf, 0x60, 0x45, 0x60, 0xbf, 0xdf, 0x35, 0x69, 0x7b, 0x64,
+	0x76, 0xeb, 0xd9, 0xd6, 0x7f, 0x65, 0x60, 0x73, 0xb5, 0xbf, 0xd2, 0x80, 0x12, 0xaf, 0x36, 0xf7,
+	0xba, 0x2a, 0x10, 0x95, 0x60, 0x3c, 0x72, 0xc9, 0xbe, 0x48, 0xe4, 0xb2, 0x7a, 0x04, 0x72, 0x69,
+	0x47, 0x00, 0x8b, 0x4b, 0x1e, 0xfb, 0x78, 0xc9, 0xfc, 0x80, 0x4d, 0xda, 0x42, 0x7d, 0x44, 0xb4,
+	0x9d, 0x44, 0x93, 0x6f, 0x43, 0x5d, 0x04, 0x2b, 0xc3, 0xa8, 0x63, 0x51, 0x90, 0x51, 0x05, 0x8d,
+	0x13, 0xe8, 0x0a, 0x67, 0xeb, 0xb3, 0x0c, 0xac, 0xf1, 0x9d, 0x53, 0xf6, 0x3b, 0x6c, 0x1c, 0x7c,
+	0x21, 0x7b, 0xc6, 0x8c, 0xdb, 0x9e, 0x2a, 0xdb, 0xb4, 0x69, 0x6c, 0xdb, 0x01, 0x6a, 0x5b, 0xb4,
+	0x2c, 0x4e, 0x6e, 0xfd, 0x3c, 0x07, 0x1b, 0x89, 0x05, 0x93, 0xef, 0x69, 0x15, 0x6c, 0xe1, 0x15,
+	0x6f, 0x27, 0x37, 0x65, 0x8c, 0x3c, 0xcb, 0xf1, 0xad, 0x31, 0xbe, 0xb2, 0x94, 0xa2, 0xf6, 0xb9,
+	0x8e, 0xb2, 0xf9, 0x1f, 0x59, 0xb8, 0x9a, 0x32, 0x5e, 0xb3, 0xd7, 0xc3, 0xa8, 0xea, 0xae, 0xa3,
+	0x70, 0xde, 0x30, 0x46, 0x54, 0xf3, 0x86, 0x88, 0x95, 0x03, 0x98, 0x4b, 0x39, 0x80, 0x2d, 0xa8,
+	0xca, 0x09, 0x47, 0x3c, 0x98, 0x11, 0x36, 0x20, 0x86, 0x23, 0xbb, 0xa8, 0xf0, 0xcb, 0xf9, 0xb1,
+	0x83, 0x8d, 0x05, 0x11, 0x22, 0xbf, 0x71, 0x11, 0x01, 0xc8, 0x32, 0x40, 0x34, 0xb8, 0xf9, 0xbb,
+	0x2a, 0x0b, 0x57, 0x99, 0x70, 0x26, 0xca, 0x84, 0xa3, 0x9c, 0x39, 0xab, 0xe7, 0xcc, 0x51, 0x86,
+	0x9d, 0x4b, 0x66, 0xd8, 0x22, 0x1f, 0xcf, 0xeb, 0xf9, 0xb8, 0x9e, 0xc1, 0x17, 0xe2, 0x19, 0x7c,
+	0x6b, 0x00, 0xf5, 0xe4, 0x4b, 0x47, 0x8b, 0x60, 0x3b, 0x8b, 0x65, 0xd0, 0xd3, 0xe2, 0x3b, 0x0d,
+	0x73, 0xfe, 0x8b, 0x6b, 0xfd, 0x63, 0x19, 0xea, 0x2b, 0x5d, 0xcc, 0x50, 0x79, 0x27, 0x71, 0xe5,
+	0x9d, 0x84, 0xed, 0x93, 0xac, 0xd6, 0x3e, 0x89, 0x29, 0x74, 0xee, 0x45, 0x14, 0xfa, 0x00, 0xea,
+	0x8b, 0x93, 0x53, 0xdf, 0x1e, 0x5b, 0xb3, 0x30, 0x77, 0x16, 0x2d, 0xd7, 0xd6, 0x4a, 0xcb, 0xd5,
+	0x18, 0x24, 0x38, 0xe9, 0xca, 0x58, 0xf2, 0x00, 0x7b, 0x57, 0x53, 0x3b, 0xd0, 0xa6, 0x13, 0x27,
+	0xf8, 0xd5, 0xd5, 0xe9, 0xba, 0x71, 0x46, 0x9a, 0x1c, 0x89, 0xc5, 0xf2, 0x85, 0x75, 0xea, 0x2e,
+	0x03, 0xd9, 0x83, 0x6d, 0xa4, 0x2c, 0x89, 0xd3, 0xa9, 0xe4, 0xc3, 0x56, 0x5e, 0xc2, 0x2e, 0xc8,
+	0x94, 0x69, 0xd5, 0x80, 0x24, 0x19, 0xb9, 0x93, 0x75, 0x03, 0xa6, 0xbc, 0x08, 0x3e, 0x93, 0xdf,
+	0x86, 0xeb, 0x63, 0xef, 0x74, 0x11, 0xb8, 0x63, 0x59, 0x00, 0x0f, 0x77, 0x55, 0xe1, 0xbb, 0xba,
+	0xbb, 0xba, 0xa2, 0x4e, 0x2a, 0x3f, 0x3d, 0x63, 0x1e, 0x72, 0x0f, 0xd6, 0x78, 0x12, 0x29, 0x96,
+	0xa7, 0x9c, 0xd4, 0xba, 0x61, 0xf2, 0x88, 0x48, 0x60, 0xa9, 0xce, 0x42, 0xde, 0x86, 0x6b, 0x1a,
+	0x18, 0x6d, 0x94, 0x27, 0x53, 0x55, 0x9a, 0x4a, 0x24, 0x5f, 0x81, 0x5a, 0x98, 0x86, 0x09, 0x35,
+	0xe5, 0xd9, 0xd3, 0x3a, 0x4d, 0xa0, 0xc9, 0xbb, 0xb0, 0x89, 0xaa, 0xc9, 0x26, 0xdb, 0xda, 0xaa,
+	0x64, 0x8e, 0x54, 0x35, 0x34, 0x24, 0x5d, 0xe5, 0x6b, 0x8e, 0xa0, 0x9e, 0xd4, 0x11, 0x1e, 0xa7,
+	0x60, 0x34, 0xc3, 0x3c, 0xa5, 0xc9, 0x12, 0x44, 0x07, 0x82, 0x65, 0xea, 0x8f, 0x6c, 0x67, 0x1a,
+	0xeb, 0x29, 0x26, 0xb0, 0xcd, 0xef, 0xc2, 0x46, 0x42, 0x55, 0x48, 0x1d, 0x72, 0x4b, 0x4f, 0xf5,
+	0x27, 0xf1, 0x11, 0xcf, 0xec, 0xc2, 0xf2, 0xfd, 0xa7, 0xae, 0x37, 0x51, 0x55, 0x37, 0x05, 0x37,
+	0xbf, 0x03, 0xd7, 0xd3, 0xdf, 0x0a, 0xfa, 0xea, 0x20, 0x32, 0x39, 0xa1, 0xa7, 0x88, 0x23, 0x9b,
+	0x3f, 0xcd, 0x40, 0x51, 0x28, 0x5a, 0xe8, 0x00, 0x32, 0xe7, 0x3a, 0x00, 0x9c, 0x57, 0x68, 0x64,
+	0x3b, 0x16, 0xe3, 0xc7, 0x91, 0xd8, 0xe4, 0x10, 0x88, 0x1d, 0xc6, 0x06, 0xcc, 0xdb, 0x3e, 0x0d,
+	0x54, 0x03, 0x6b, 0x05, 0x4f, 0x5a, 0x50, 0xe4, 0x16, 0x25, 0x4c, 0xb4, 0x2b, 0x46, 0x7f, 0x19,
+	0xf0, 0x54, 0x90, 0x4a, 0x4a, 0x6b, 0x00, 0x9b, 0xba, 0xda, 0x0c, 0x03, 0x57, 0xa8, 0x75, 0x10,
+	0x15, 0xa0, 0xf8, 0x33, 0xf9, 0x0a, 0x94, 0x84, 0xf6, 0x8b, 0xe0, 0x64, 0x45, 0xdf, 0x14, 0xb5,
+	0xf5, 0xef, 0x59, 0xa8, 0xea, 0x14, 0x7c, 0x9b, 0x63, 0x77, 0xce, 0xb3, 0x60, 0xf9, 0x36, 0x25,
+	0x88, 0x7d, 0xaa, 0xc7, 0x36, 0x9b, 0x4d, 0xd4, 0x94, 0xcd, 0xd8, 0x94, 0xf2, 0xf8, 0xed, 0x70,
+	0x0e, 0x2a, 0x39, 0xf1, 0xa5, 0x85, 0x6d, 0x5f, 0x99, 0x5f, 0x2a, 0xb8, 0xf9, 0x8b, 0x0c, 0x54,
+	0xf5, 0x41, 0xe4, 0xd7, 0xb4, 0x8d, 0xd4, 0xb6, 0x5e, 0x3b, 0x7b, 0x7a, 0x09, 0x68, 0xd5, 0x4b,
+	0x74, 0x0a, 0x63, 0xd7, 0x0b, 0x0b, 0x87, 0x1c, 0x40, 0x25, 0x9a, 0x5b, 0xcf, 0xa4, 0xc4, 0xf1,
+	0x11, 0xdd, 0xc4, 0x53, 0x66, 0x4f, 0x4f, 0x54, 0x84, 0x22, 0xa1, 0xd6, 0x6f, 0x01, 0x44, 0x73,
+	0x92, 0x97, 0x60, 0xb3, 0x7f, 0x38, 0x1a, 0xf6, 0xba, 0xe6, 0xd1, 0x07, 0x7d, 0xfa, 0xe0, 0xa8,
+	0xd3, 0xdf, 0x1f, 0x88, 0xbe, 0x07, 0x35, 0xdb, 0xdd, 0xa3, 0xbd, 0xde, 0x70, 0xd4, 0x3b, 0x78,
+	0xaf, 0x9e, 0xc1, 0xc6, 0xc9, 0xb0, 0xd3, 0x1f, 0x98, 0x47, 0xed, 0x4e, 0xe7, 0x10, 0x03, 0xb4,
+	0x7a, 0x16, 0xdb, 0x31, 0x3b, 0xed, 0xe1, 0xe8, 0x88, 0x9a, 0xc3, 0x41, 0xff, 0x60, 0x68, 0xd6,
+	0x73, 0xad, 0x7f, 0xc9, 0xc2, 0x9a, 0x76, 0x8a, 0xc8, 0xb7, 0x55, 0x15, 0xa7, 0x1b, 0xc5, 0x0a,
+	0x37, 0xf5, 0xa3, 0xa7, 0x3f, 0x23, 0x0f, 0xd5, 0xf8, 0x9f, 0x13, 0x25, 0xfc, 0x67, 0x06, 0x36,
+	0x12, 0xa3, 0x63, 0xcd, 0xf7, 0x4c, 0x5a, 0xf3, 0x5d, 0x2b, 0x7e, 0x65, 0x53, 0x8a, 0x5f, 0x5a,
+	0xa0, 0x95, 0x8b, 0x07, 0x5a, 0x89, 0xd8, 0x23, 0xbf, 0x1a, 0x7b, 0x5c, 0xbe, 0x70, 0xf6, 0x1a,
+	0x14, 0xc5, 0xae, 0xa5, 0x73, 0x48, 0xa8, 0xb0, 0x24, 0xb6, 0xbe, 0x05, 0x75, 0x6d, 0xbf, 0xc2,
+	0xc6, 0xdd, 0x89, 0xd4, 0x3f, 0x23, 0x3b, 0xf7, 0x1a, 0x4f, 0xa4, 0xfd, 0x7f, 0x93, 0x81, 0x8d,
+	0xe4, 0xc5, 0xa0, 0xb3, 0x1d, 0xf3, 0xe5, 0xa3, 0xca, 0xfb, 0x00, 0xe2, 0xbc, 0x0f, 0xcf, 0x8d,
+	0x2d, 0x35, 0x26, 0xf2, 0x6a, 0xb4, 0x05, 0xe1, 0xae, 0x4b, 0x46, 0x72, 0xf5, 0xff, 0x9c, 0x81,
+	0x7a, 0xf2, 0xfe, 0xcd, 0x39, 0xcb, 0xbf, 0xb3, 0xe2, 0x21, 0xb2, 0xa9, 0x0e, 0xe2, 0xf2, 0xb1,
+	0x46, 0x7c, 0x9b, 0xf9, 0x8b, 0x6c, 0x53, 0xf9, 0xe4, 0x42, 0xe4, 0x93, 0x5b, 0x9f, 0xe7, 0xa0,
+	0xaa, 0x97, 0x93, 0x74, 0xf5, 0xcc, 0xa4, 0xa8, 0x67, 0x33, 0x71, 0xb7, 0x44, 0x33, 0x32, 0x49,
+	0x05, 0xcd, 0xad, 0x2a, 0x68, 0xa2, 0xdc, 0x91, 0x3f, 0xbf, 0xdc, 0x51, 0xe0, 0x66, 0x23, 0x84,
+	0xf5, 0x72, 0x46, 0xf1, 0xf9, 0xe5, 0x0c, 0xbc, 0x61, 0x23, 0x72, 0xa7, 0x0e, 0xa6, 0xb3, 0xa2,
+	0xa2, 0xa0, 0xa3, 0xe2, 0xf9, 0x79, 0x39, 0x99, 0x9f, 0x37, 0xa0, 0x24, 0xaa, 0x63, 0xa2, 0xeb,
+	0xb8, 0x4e, 0x15, 0x18, 0x15, 0x0a, 0xe1, 0x82, 0x85, 0xc2, 0xd6, 0xb7, 0xa1, 0x30, 0xe4, 0x45,
+	0x26, 0x80, 0x62, 0xbb, 0x33, 0xea, 0x3d, 0x34, 0x45, 0xde, 0x39, 0x68, 0x1f, 0x0e, 0x4d, 0x6c,
+	0x19, 0x57, 0xa1, 0xdc, 0x69, 0x1f, 0x74, 0xcc, 0x3d, 0x4c, 0x3b, 0x31, 0x0b, 0x45, 0x33, 0xb8,
+	0x67, 0x62, 0x16, 0x9a, 0x6b, 0x7d, 0x9e, 0x89, 0x57, 0x07, 0x0f, 0x17, 0x13, 0x9c, 0xeb, 0x0e,
+	0xd4, 0xf4, 0xd2, 0x5f, 0xe8, 0x6f, 0x13, 0x58, 0xec, 0x0b, 0x8a, 0x72, 0x97, 0x68, 0x54, 0x5d,
+	0x8d, 0x95, 0x0f, 0x0d, 0xbe, 0x2e, 0x55, 0x03, 0xbb, 0xb4, 0x3a, 0xb6, 0x3e, 0xcd, 0x42, 0x95,
+	0xd7, 0x7c, 0xa9, 0x48, 0x43, 0xbf, 0x58, 0x3d, 0x4a, 0xf6, 0x15, 0xcf, 0xd0, 0x92, 0xc2, 0xf3,
+	0xb5, 0x44, 0x38, 0xb3, 0x05, 0x93, 0xe5, 0x12, 0x01, 0xc4, 0xe5, 0x50, 0x7a, 0x11, 0x39, 0xfc,
+	0x2c, 0x0b, 0x05, 0x2e, 0x07, 0xd1, 0x2f, 0xe1, 0xb2, 0x08, 0xdf, 0x4c, 0x84, 0xc0, 0x1d, 0x78,
+	0x0c, 0xaf, 0x5d, 0xc8, 0x26, 0xf1, 0x3a, 0x0d, 0xe1, 0x98, 0x0b, 0xc9, 0xa5, 0xb9, 0x90, 0xe7,
+	0x1f, 0xa3, 0xb0, 0xb9, 0x57, 0xd0, 0x9b, 0x7b, 0x17, 0xbf, 0x99, 0x12, 0x8a, 0xa5, 0xa4, 0x8b,
+	0x25, 0xba, 0x3d, 0x53, 0xbe, 0xf0, 0xed, 0x99, 0x98, 0x28, 0x2b, 0x2f, 0x22, 0xca, 0x0f, 0x81,
+	0x0c, 0x79, 0x50, 0x1c, 0xd3, 0x2b, 0x8c, 0xb6, 0xc4, 0x63, 0x58, 0x0e, 0xd7, 0xe9, 0x54, 0x51,
+	0x9f, 0x93, 0x27, 0xf6, 0x60, 0x4d, 0x9b, 0x9c, 0xdc, 0x84, 0x02, 0xef, 0x51, 0xc8, 0x39, 0x8b,
+	0x72, 0x4e, 0x81, 0x7c, 0xce, 0x54, 0x63, 0xa9, 0xf9, 0xaa, 0xbf, 0xf1, 0xd5, 0xe4, 0x0a, 0xaf,
+	0x1a, 0xab, 0xfb, 0x88, 0xd6, 0x79, 0x1b, 0x8a, 0xfc, 0x57, 0x54, 0xa8, 0x57, 0x8d, 0x71, 0x4b,
+	0x5a, 0xeb, 0x97, 0x19, 0xa8, 0xc5, 0xaf, 0x72, 0x9e, 0xe3, 0x7d, 0xc2, 0xee, 0x48, 0x56, 0xef,
+	0x8e, 0x84, 0x66, 0x2b, 0xf7, 0x82, 0xfd, 0x8d, 0xfc, 0xc5, 0xfa, 0x1b, 0x89, 0xab, 0x0f, 0x85,
+	0xb4, 0xab, 0x0f, 0x9a, 0x2e, 0x14, 0x5f, 0x44, 0x17, 0x7e, 0x90, 0x85, 0x8d, 0xc4, 0x55, 0xd3,
+	0x73, 0xf6, 0xff, 0x32, 0x00, 0x43, 0x11, 0xe9, 0x9e, 0x57, 0xc3, 0x90, 0xaf, 0x41, 0x11, 0xed,
+	0xdd, 0xd2, 0x97, 0xf7, 0xc6, 0x6e, 0x24, 0x2f, 0xb7, 0x72, 0xab, 0xb8, 0xf4, 0xa9, 0x64, 0xc3,
+	0x50, 0xd6, 0x63, 0x96, 0x2f, 0x4b, 0xe2, 0x15, 0x2a, 0xa1, 0xcb, 0x07, 0x5c, 0xad, 0x2d, 0x28,
+	0x8a, 0xdf, 0x10, 0x37, 0x92, 0x0e, 0xba, 0x18, 0xe4, 0xf2, 0xcb, 0x4a, 0xed, 0xc1, 0x80, 0xf6,
+	0x1f, 0x72, 0xaf, 0xc0, 0xfd, 0xc0, 0xc1, 0xc8, 0x1c, 0x8a, 0x6a, 0xe4, 0x2f, 0xb2, 0x50, 0x8b,
+	0x5f, 0x7f, 0x7d, 0x61, 0x1d, 0xb8, 0x0f, 0xe5, 0x85, 0xe7, 0x2e, 0x5c, 0x9f, 0x79, 0x8d, 0x9c,
+	0x5e, 0xc3, 0x0e, 0xa7, 0xe4, 0x17, 0x5b, 0x4f, 0x69, 0xc8, 0x76, 0xae, 0xad, 0xfd, 0x0e, 0x54,
+	0x27, 0x32, 0xfb, 0xeb, 0x5a, 0x01, 0xbb, 0x80, 0x08, 0x62, 0xfc, 0x7a, 0x33, 0xb6, 0x78, 0x7e,
+	0x33, 0x56, 0x35, 0x16, 0x4a, 0x5a, 0x63, 0x21, 0x26, 0xfd, 0xf2, 0x8b, 0x48, 0xff, 0x65, 0x28,
+	0xf0, 0x6d, 0xe2, 0x3d, 0xb1, 0xed, 0xc3, 0x47, 0x26, 0x15, 0xee, 0xf8, 0xa1, 0x79, 0xd0, 0xed,
+	0xd3, 0x7a, 0xa6, 0xf5, 0xb3, 0x0c, 0x5c, 0x4f, 0xbf, 0x68, 0x7c, 0x7e, 0xcc, 0x67, 0x29, 0xf6,
+	0x58, 0xcc, 0x17, 0xc7, 0xa2, 0x40, 0xd5, 0xad, 0x41, 0x79, 0xf9, 0x20, 0x84, 0xbf, 0x00, 0x45,
+	0xfb, 0x2b, 0xb5, 0x95, 0x41, 0x78, 0xbb, 0x6b, 0xc7, 0xb2, 0x67, 0x4b, 0x4f, 0xdb, 0xca, 0x4a,
+	0x4d, 0x77, 0x17, 0xae, 0x59, 0x41, 0xc0, 0xe6, 0xb8, 0xa6, 0x7d, 0xf1, 0x4d, 0x85, 0x76, 0x49,
+	0xf3, 0x9a, 0x21, 0x71, 0x86, 0x46, 0xa3, 0xa9, 0x23, 0x88, 0x81, 0xd7, 0x12, 0xc5, 0x05, 0xba,
+	0xf0, 0x53, 0x86, 0x95, 0x2f, 0x2b, 0x68, 0xc8, 0xd3, 0xfa, 0x41, 0x01, 0x8a, 0x32, 0x73, 0xdb,
+	0x4a, 0xc9, 0xdc, 0x88, 0x11, 0x4b, 0x51, 0x5f, 0x30, 0x5f, 0xfb, 0x69, 0x5e, 0xa5, 0x9e, 0x8a,
+	0x39, 0x2a, 0xd5, 0x66, 0x92, 0xa5, 0xda, 0xe7, 0xde, 0xa2, 0x36, 0xa0, 0x22, 0x9e, 0x87, 0xb6,
+	0xba, 0xf3, 0xb0, 0x5a, 0x18, 0x8b, 0x58, 0x9e, 0x77, 0xeb, 0xe1, 0x26, 0x54, 0xf8, 0xe3, 0x01,
+	0xf6, 0xa6, 0x84, 0xf1, 0x8c, 0x10, 0xa8, 0x34, 0x1c, 0xc0, 0xdf, 0x2a, 0xf2, 0xa5, 0x86, 0x70,
+	0xac, 0xa8, 0x8c, 0xf4, 0x64, 0x57, 0x07, 0x79, 0x2e, 0x7d, 0x56, 0xb8, 0x96, 0x3c, 0x61, 0x1e,
+	0x56, 0x81, 0x65, 0x1c, 0x2c, 0x41, 0xa4, 0x7c, 0xbc, 0xb4, 0xb4, 0xdb, 0xa4, 0x0a, 0x4c, 0xba,
+	0x82, 0x35, 0x4e, 0xd5, 0x51, 0x58, 0xd3, 0x51, 0x96, 0x60, 0xb8, 0x60, 0x6c, 0xd2, 0xa8, 0x72,
+	0x9e, 0x38, 0x12, 0x03, 0x96, 0xf1, 0xd2, 0x0f, 0xdc, 0x39, 0xf3, 0x64, 0xaf, 0xb9, 0xb1, 0xce,
+	0xf9, 0x92, 0x68, 0x71, 0x70, 0xd0, 0x74, 0x37, 0x6a, 0xea, 0xe0, 0x20, 0x44, 0xde, 0x0e, 0x0b,
+	0x29, 0xb2, 0xd2, 0x73, 0x81, 0x4a, 0x4a, 0xeb, 0xe7, 0x19, 0x28, 0xc9, 0x6f, 0x03, 0xe2, 0x82,
+	0xcb, 0xbc, 0x88, 0xe0, 0xae, 0x41, 0x61, 0x3c, 0xb3, 0xec, 0xb9, 0x2a, 0x9e, 0x73, 0x60, 0xb5,
+	0x98, 0x95, 0x4b, 0x2b, 0x66, 0x7d, 0x05, 0x2a, 0xae, 0x2c, 0x48, 0xa9, 0xe4, 0x4e, 0x2b, 0x51,
+	0x45, 0x34, 0xbc, 0x26, 0xec, 0x33, 0xcf, 0xb6, 0x66, 0xf6, 0x27, 0x6c, 0xa2, 0xce, 0x13, 0x57,
+	0x9f, 0x2a, 0x4d, 0xa1, 0xb4, 0x3e, 0xcd, 0xc1, 0x86, 0xdc, 0x5a, 0xf8, 0x95, 0xc3, 0xd9, 0x26,
+	0xed, 0x6d, 0xbc, 0xdd, 0x77, 0x3c, 0xb7, 0x83, 0x40, 0xd6, 0x13, 0xcf, 0xf4, 0x17, 0x11, 0x5f,
+	0xec, 0x3a, 0x5f, 0x2e, 0x71, 0x9d, 0x0f, 0x53, 0x2e, 0x36, 0xb1, 0x2d, 0x6e, 0x4d, 0x64, 0x73,
+	0x33, 0x44, 0x5c, 0x20, 0x82, 0xc0, 0x7a, 0xbd, 0xfd, 0x89, 0x88, 0xd6, 0xf3, 0x94, 0x3f, 0x23,
+	0x8e, 0x5f, 0xb6, 0x92, 0xae, 0x01, 0x9f, 0xb1, 0x53, 0x3b, 0x76, 0x17, 0xea, 0x32, 0xe9, 0xda,
+	0xd6, 0x4b, 0xc9, 0x6f, 0x3d, 0x8c, 0x8e, 0xbb, 0x38, 0xa5, 0x92, 0xe9, 0xf2, 0x41, 0x6a, 0xf3,
+	0x9b, 0x90, 0xc7, 0x99, 0x44, 0xb4, 0x3f, 0xb6, 0x17, 0x76, 0x54, 0xcc, 0x8b, 0x10, 0x58, 0x1c,
+	0x1b, 0xdb, 0xaa, 0x94, 0x8a, 0x8f, 0xad, 0x3f, 0xaa, 0xc0, 0xe6, 0xca, 0x57, 0x4d, 0xff, 0x07,
+	0x65, 0xd3, 0xde, 0x61, 0x76, 0x25, 0x18, 0x92, 0xbe, 0x7c, 0xb2, 0xad, 0xee, 0x41, 0x68, 0x18,
+	0xa4, 0x7b, 0xe1, 0x0a, 0xe4, 0x3b, 0xd1, 0x30, 0xe4, 0x7e, 0xd8, 0x37, 0x28, 0xc8, 0x1b, 0x42,
+	0x2b, 0xeb, 0x4e, 0x36, 0x0e, 0xee, 0xc1, 0xd5, 0xd0, 0xf8, 0x84, 0x06, 0x51, 0x24, 0xed, 0x55,
+	0x9a, 0x46, 0x22, 0xaf, 0xc3, 0x06, 0x37, 0x67, 0x83, 0xe8, 0xda, 0x1d, 0x2f, 0xde, 0x67, 0x69,
+	0x12, 0x4f, 0xde, 0x84, 0xba, 0xb0, 0xa9, 0x1a, 0xef, 0xa7, 0x82, 0x77, 0x85, 0x40, 0x7e, 0x1d,
+	0xab, 0x24, 0x0e, 0x9b, 0x3d, 0xe4, 0x01, 0xb6, 0xfc, 0x96, 0xe9, 0x66, 0xea, 0x0e, 0x24, 0x17,
+	0xd5, 0x06, 0x34, 0x7f, 0x99, 0x7f, 0xd1, 0x5a, 0xf5, 0xab, 0x61, 0x65, 0x39, 0x7b, 0x46, 0x61,
+	0x99, 0x6c, 0xcb, 0x26, 0x05, 0x12, 0x96, 0xca, 0x2b, 0xde, 0x3a, 0x53, 0xaa, 0x86, 0xe0, 0xa3,
+	0xfa, 0x20, 0xd2, 0x85, 0xaa, 0xfc, 0x62, 0x4f, 0x4c, 0x92, 0xbf, 0xe0, 0x24, 0xb1, 0x51, 0xe4,
+	0x7d, 0xd8, 0x08, 0x5f, 0x86, 0x9c, 0xa8, 0x70, 0xc1, 0x89, 0x92, 0x03, 0x89, 0x09, 0x55, 0x2e,
+	0x38, 0x01, 0x86, 0xe6, 0xf6, 0x02, 0x4b, 0xd2, 0x87, 0x61, 0x63, 0x93, 0xc3, 0x5c, 0x61, 0xe4,
+	0x47, 0x6c, 0xaf, 0x9f, 0x3d, 0xc7, 0x40, 0xf2, 0xca, 0xb6, 0x35, 0x8d, 0x06, 0x37, 0x6d, 0x28,
+	0xca, 0xa5, 0x35, 0xa0, 0x28, 0x2c, 0x88, 0x38, 0x98, 0xbb, 0x57, 0xa8, 0x84, 0x49, 0x33, 0xba,
+	0x4d, 0xa1, 0x2e, 0x63, 0x2a, 0x84, 0x76, 0x3f, 0x23, 0xab, 0xdf, 0xcf, 0xd8, 0xde, 0x84, 0x0d,
+	0x31, 0xba, 0xef, 0xa9, 0x7b, 0x29, 0x0f, 0x61, 0x23, 0xb1, 0x90, 0x78, 0x6d, 0x29, 0x93, 0xac,
+	0x2d, 0x29, 0x65, 0xca, 0x9e, 0xab, 0x4c, 0x4d, 0x1b, 0x2a, 0xa1, 0x5e, 0x92, 0x3b, 0x90, 0x7f,
+	0x12, 0x65, 0xa8, 0x69, 0xdf, 0x44, 0x72, 0x3a, 0xae, 0x7b, 0xb1, 0x3c, 0xfe, 0x28, 0xec, 0x3e,
+	0x4b, 0x28, 0x1e, 0x1a, 0xe5, 0x92, 0x49, 0xac, 0x1d, 0x9a, 0x23, 0xed, 0x7b, 0xc8, 0xcb, 0x9b,
+	0x23, 0xfc, 0x1a, 0x65, 0x26, 0x4d, 0x8e, 0x2c, 0xef, 0x28, 0xb8, 0xf5, 0x3e, 0x94, 0xd5, 0x99,
+	0x08, 0x6d, 0x77, 0x46, 0xb3, 0xdd, 0xe9, 0x99, 0x4b, 0x78, 0xbb, 0x47, 0x7e, 0x94, 0xc4, 0x81,
+	0xd6, 0x5f, 0x64, 0xa1, 0x28, 0xbe, 0xb9, 0xfb, 0x7f, 0xbc, 0xa1, 0x40, 0x4c, 0xd8, 0x14, 0x37,
+	0x61, 0xb5, 0x8e, 0xbb, 0x3c, 0x92, 0x37, 0xe4, 0x27, 0xa4, 0x7a, 0x33, 0x1e, 0x6f, 0x82, 0xd2,
+	0xd5, 0x11, 0x69, 0x97, 0xa7, 0x9a, 0xef, 0xc2, 0x46, 0x62, 0x24, 0xb2, 0x05, 0xcf, 0x6c, 0xe5,
+	0xab, 0xf9, 0x73, 0xfc, 0xee, 0x53, 0x28, 0x9d, 0xff, 0xce, 0xc2, 0x7a, 0xec, 0x3b, 0xc5, 0x73,
+	0x84, 0x94, 0x2e, 0xf5, 0xcb, 0xd7, 0xa7, 0xa3, 0xe3, 0x93, 0x8f, 0x5d, 0x6f, 0x7a, 0x5d, 0x5d,
+	0xd1, 0x2d, 0xc8, 0xcf, 0x42, 0x62, 0x0b, 0x8c, 0x5d, 0xd5, 0x55, 0xd2, 0x2f, 0x5e, 0x42, 0xfa,
+	0xa5, 0x4b, 0x4b, 0xbf, 0xac, 0x49, 0xff, 0x9d, 0xe8, 0x53, 0x9b, 0x94, 0xeb, 0xa6, 0xc9, 0xab,
+	0x79, 0x5a, 0x66, 0xdc, 0xda, 0x82, 0xeb, 0x0f, 0xb9, 0xa9, 0xdd, 0xb1, 0x1d, 0x11, 0x82, 0xa9,
+	0x4b, 0x64, 0x67, 0xbe, 0x82, 0xd6, 0xdf, 0x66, 0x20, 0xdb, 0xeb, 0xf2, 0x03, 0xcc, 0x34, 0xba,
+	0x84, 0x10, 0x7f, 0x62, 0x39, 0x93, 0xf0, 0x7a, 0xab, 0x84, 0xc8, 0x6b, 0x50, 0x12, 0x47, 0xdc,
+	0x97, 0x6f, 0x68, 0xcd, 0xe8, 0x75, 0x8d, 0x81, 0x40, 0x51, 0x45, 0x43, 0x3f, 0x7f, 0x1c, 0x0a,
+	0x90, 0xbf, 0x94, 0x2a, 0xd5, 0x30, 0xcd, 0xef, 0x42, 0x49, 0x8e, 0xc1, 0x8d, 0x61, 0x94, 0xc4,
+	0x37, 0x26, 0xf2, 0xa2, 0x10, 0xc6, 0xe5, 0xcb, 0x41, 0xd2, 0xbe, 0x28, 0xb0, 0xf5, 0xe7, 0x39,
+	0xa8, 0x44, 0x3d, 0xf2, 0xb7, 0xf0, 0x46, 0xdd, 0x38, 0xbc, 0xb6, 0x5a, 0xdb, 0x22, 0xd1, 0x77,
+	0xd2, 0xc6, 0x50, 0x50, 0xa8, 0x62, 0xe1, 0xb5, 0x6c, 0x45, 0xc5, 0x0e, 0xad, 0x2f, 0x27, 0x4f,
+	0x60, 0x5b, 0x7f, 0x9d, 0xc5, 0x8f, 0x1a, 0xc4, 0x98, 0x35, 0x28, 0xa9, 0xee, 0xe0, 0x15, 0x4c,
+	0xe4, 0xfb, 0xb4, 0x6b, 0xe2, 0x27, 0x5e, 0xd7, 0x81, 0xf0, 0xc7, 0xa3, 0x4e, 0xff, 0x60, 0xa7,
+	0x47, 0xf7, 0xdb, 0xa3, 0x5e, 0xff, 0xa0, 0x9e, 0xe5, 0x9d, 0x46, 0x8e, 0xdf, 0x39, 0xdc, 0xdb,
+	0xe9, 0xed, 0xed, 0xed, 0x9b, 0x07, 0xa3, 0x7a, 0x8e, 0x5c, 0x83, 0xba, 0x62, 0xe7, 0x25, 0x77,
+	0x64, 0xce, 0xe3, 0xe4, 0xdd, 0xde, 0x70, 0x70, 0x38, 0x32, 0xeb, 0x05, 0x9c, 0x51, 0x02, 0xd8,
+	0x69, 0xec, 0xef, 0x1d, 0x72, 0xa6, 0x22, 0x96, 0x0c, 0xa8, 0xc9, 0xbf, 0xeb, 0x2a, 0xe1, 0xec,
+	0xe1, 0x87, 0x63, 0x47, 0xd4, 0xdc, 0x33, 0xdb, 0x43, 0xb3, 0x5e, 0xc6, 0x1b, 0x67, 0xa3, 0xde,
+	0xbe, 0x39, 0xdc, 0x35, 0xcd, 0xd1, 0x91, 0x79, 0x30, 0xa2, 0x8f, 0xea, 0x15, 0xfc, 0xc9, 0x08,
+	0x49, 0xcd, 0x87, 0x3d, 0xf3, 0x83, 0x3a, 0x20, 0xab, 0x58, 0x48, 0x7b, 0xdf, 0x3c, 0xe8, 0xf2,
+	0xd5, 0xad, 0x91, 0x9b, 0xd0, 0x48, 0x20, 0xa3, 0x66, 0x67, 0x15, 0x27, 0x52, 0x0b, 0x33, 0x1f,
+	0xf6, 0xba, 0xe6, 0x41, 0xc7, 0xac, 0xaf, 0x63, 0xa7, 0x74, 0xd0, 0xa6, 0xa3, 0x5e, 0x7b, 0xef,
+	0x48, 0x2e, 0xaf, 0xd6, 0x62, 0xb0, 0x2e, 0xca, 0x8a, 0xea, 0xc3, 0xe1, 0x16, 0x94, 0x64, 0xc1,
+	0x58, 0x5a, 0xf6, 0xe8, 0x5f, 0x0c, 0x14, 0x21, 0xb4, 0xce, 0x59, 0xcd, 0x3a, 0x9f, 0xeb, 0x46,
+	0xb6, 0xf3, 0xbf, 0x99, 0x5d, 0x1c, 0x1f, 0x17, 0xb9, 0x69, 0x78, 0xfb, 0x7f, 0x07, 0x00, 0x84,
+	0x82, 0x89, 0xb5, 0xb5, 0x41, 0x00, 0x00,
 }
@@ -47,6 +47,19 @@ const (
 	Message_BLOCK                    Message_MessageType = 19
 	Message_VENDOR_FINALIZED_PAYMENT Message_MessageType = 20
 	Message_ORDER_PAYMENT            Message_MessageType = 21
+	Message_MILESTONE_RELEASE        Message_MessageType = 22
+	Message_TIMESHEET_ENTRY          Message_MessageType = 23
+	Message_TIMESHEET_REVIEW         Message_MessageType = 24
+	Message_SUBSCRIPTION_UPDATE      Message_MessageType = 25
+	Message_QUOTE_REQUEST            Message_MessageType = 26
+	Message_QUOTE                    Message_MessageType = 27
+	Message_ORDER_AMENDMENT          Message_MessageType = 28
+	Message_ORDER_AMENDMENT_RESPONSE Message_MessageType = 29
+	Message_DISPUTE_PANEL_VOTE       Message_MessageType = 30
+	Message_DISPUTE_EVIDENCE         Message_MessageType = 31
+	Message_CASE_MESSAGE             Message_MessageType = 32
+	Message_PARTIAL_REFUND           Message_MessageType = 33
+	Message_DISPUTE_PANEL_PAYOUT     Message_MessageType = 34
 	Message_ERROR                    Message_MessageType = 500
 	Message_ORDER_PROCESSING_FAILURE Message_MessageType = 501
 )
@@ -74,6 +87,19 @@ var Message_MessageType_name = map[int32]string{
 	19:  "BLOCK",
 	20:  "VENDOR_FINALIZED_PAYMENT",
 	21:  "ORDER_PAYMENT",
+	22:  "MILESTONE_RELEASE",
+	23:  "TIMESHEET_ENTRY",
+	24:  "TIMESHEET_REVIEW",
+	25:  "SUBSCRIPTION_UPDATE",
+	26:  "QUOTE_REQUEST",
+	27:  "QUOTE",
+	28:  "ORDER_AMENDMENT",
+	29:  "ORDER_AMENDMENT_RESPONSE",
+	30:  "DISPUTE_PANEL_VOTE",
+	31:  "DISPUTE_EVIDENCE",
+	32:  "CASE_MESSAGE",
+	33:  "PARTIAL_REFUND",
+	34:  "DISPUTE_PANEL_PAYOUT",
 	500: "ERROR",
 	501: "ORDER_PROCESSING_FAILURE",
 }
@@ -101,6 +127,19 @@ var Message_MessageType_value = map[string]int32{
 	"BLOCK":                    19,
 	"VENDOR_FINALIZED_PAYMENT": 20,
 	"ORDER_PAYMENT":            21,
+	"MILESTONE_RELEASE":        22,
+	"TIMESHEET_ENTRY":          23,
+	"TIMESHEET_REVIEW":         24,
+	"SUBSCRIPTION_UPDATE":      25,
+	"QUOTE_REQUEST":            26,
+	"QUOTE":                    27,
+	"ORDER_AMENDMENT":          28,
+	"ORDER_AMENDMENT_RESPONSE": 29,
+	"DISPUTE_PANEL_VOTE":       30,
+	"DISPUTE_EVIDENCE":         31,
+	"CASE_MESSAGE":             32,
+	"PARTIAL_REFUND":           33,
+	"DISPUTE_PANEL_PAYOUT":     34,
 	"ERROR":                    500,
 	"ORDER_PROCESSING_FAILURE": 501,
 }
@@ -141,6 +180,37 @@ func (Chat_Flag) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_33c57e4bae7b9afd, []int{2, 0}
 }
 
+type CaseMessage_Channel int32
+
+const (
+	CaseMessage_CASE       CaseMessage_Channel = 0
+	CaseMessage_BUYER      CaseMessage_Channel = 1
+	CaseMessage_VENDOR     CaseMessage_Channel = 2
+	CaseMessage_MODERATORS CaseMessage_Channel = 3
+)
+
+var CaseMessage_Channel_name = map[int32]string{
+	0: "CASE",
+	1: "BUYER",
+	2: "VENDOR",
+	3: "MODERATORS",
+}
+
+var CaseMessage_Channel_value = map[string]int32{
+	"CASE":       0,
+	"BUYER":      1,
+	"VENDOR":     2,
+	"MODERATORS": 3,
+}
+
+func (x CaseMessage_Channel) String() string {
+	return proto.EnumName(CaseMessage_Channel_name, int32(x))
+}
+
+func (CaseMessage_Channel) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{3, 0}
+}
+
 type Message struct {
 	MessageType          Message_MessageType `protobuf:"varint,1,opt,name=messageType,proto3,enum=Message_MessageType" json:"messageType,omitempty"`
 	Payload              *any.Any            `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
@@ -330,6 +400,148 @@ func (m *Chat) GetFlag() Chat_Flag {
 	return Chat_MESSAGE
 }
 
+type CaseMessage struct {
+	MessageId            string               `protobuf:"bytes,1,opt,name=messageId,proto3" json:"messageId,omitempty"`
+	OrderId              string               `protobuf:"bytes,2,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	Channel              CaseMessage_Channel  `protobuf:"varint,3,opt,name=channel,proto3,enum=CaseMessage_Channel" json:"channel,omitempty"`
+	ThreadId             string               `protobuf:"bytes,4,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	Message              string               `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CaseMessage) Reset()         { *m = CaseMessage{} }
+func (m *CaseMessage) String() string { return proto.CompactTextString(m) }
+func (*CaseMessage) ProtoMessage()    {}
+func (*CaseMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{3}
+}
+
+func (m *CaseMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CaseMessage.Unmarshal(m, b)
+}
+func (m *CaseMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CaseMessage.Marshal(b, m, deterministic)
+}
+func (m *CaseMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CaseMessage.Merge(m, src)
+}
+func (m *CaseMessage) XXX_Size() int {
+	return xxx_messageInfo_CaseMessage.Size(m)
+}
+func (m *CaseMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_CaseMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CaseMessage proto.InternalMessageInfo
+
+func (m *CaseMessage) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
+func (m *CaseMessage) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *CaseMessage) GetChannel() CaseMessage_Channel {
+	if m != nil {
+		return m.Channel
+	}
+	return CaseMessage_CASE
+}
+
+func (m *CaseMessage) GetThreadId() string {
+	if m != nil {
+		return m.ThreadId
+	}
+	return ""
+}
+
+func (m *CaseMessage) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *CaseMessage) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+type SignedCaseMessage struct {
+	Message              *CaseMessage `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	SenderID             string       `protobuf:"bytes,2,opt,name=senderID,proto3" json:"senderID,omitempty"`
+	SenderPubkey         []byte       `protobuf:"bytes,3,opt,name=senderPubkey,proto3" json:"senderPubkey,omitempty"`
+	Signature            []byte       `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *SignedCaseMessage) Reset()         { *m = SignedCaseMessage{} }
+func (m *SignedCaseMessage) String() string { return proto.CompactTextString(m) }
+func (*SignedCaseMessage) ProtoMessage()    {}
+func (*SignedCaseMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_33c57e4bae7b9afd, []int{4}
+}
+
+func (m *SignedCaseMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignedCaseMessage.Unmarshal(m, b)
+}
+func (m *SignedCaseMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignedCaseMessage.Marshal(b, m, deterministic)
+}
+func (m *SignedCaseMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedCaseMessage.Merge(m, src)
+}
+func (m *SignedCaseMessage) XXX_Size() int {
+	return xxx_messageInfo_SignedCaseMessage.Size(m)
+}
+func (m *SignedCaseMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedCaseMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignedCaseMessage proto.InternalMessageInfo
+
+func (m *SignedCaseMessage) GetMessage() *CaseMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *SignedCaseMessage) GetSenderID() string {
+	if m != nil {
+		return m.SenderID
+	}
+	return ""
+}
+
+func (m *SignedCaseMessage) GetSenderPubkey() []byte {
+	if m != nil {
+		return m.SenderPubkey
+	}
+	return nil
+}
+
+func (m *SignedCaseMessage) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 type SignedData struct {
 	SenderPubkey         []byte   `protobuf:"bytes,1,opt,name=senderPubkey,proto3" json:"senderPubkey,omitempty"`
 	SerializedData       []byte   `protobuf:"bytes,2,opt,name=serializedData,proto3" json:"serializedData,omitempty"`
@@ -343,7 +555,7 @@ func (m *SignedData) Reset()         { *m = SignedData{} }
 func (m *SignedData) String() string { return proto.CompactTextString(m) }
 func (*SignedData) ProtoMessage()    {}
 func (*SignedData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33c57e4bae7b9afd, []int{3}
+	return fileDescriptor_33c57e4bae7b9afd, []int{5}
 }
 
 func (m *SignedData) XXX_Unmarshal(b []byte) error {
@@ -398,7 +610,7 @@ func (m *SignedData_Command) Reset()         { *m = SignedData_Command{} }
 func (m *SignedData_Command) String() string { return proto.CompactTextString(m) }
 func (*SignedData_Command) ProtoMessage()    {}
 func (*SignedData_Command) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33c57e4bae7b9afd, []int{3, 0}
+	return fileDescriptor_33c57e4bae7b9afd, []int{5, 0}
 }
 
 func (m *SignedData_Command) XXX_Unmarshal(b []byte) error {
@@ -451,7 +663,7 @@ func (m *CidList) Reset()         { *m = CidList{} }
 func (m *CidList) String() string { return proto.CompactTextString(m) }
 func (*CidList) ProtoMessage()    {}
 func (*CidList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33c57e4bae7b9afd, []int{4}
+	return fileDescriptor_33c57e4bae7b9afd, []int{6}
 }
 
 func (m *CidList) XXX_Unmarshal(b []byte) error {
@@ -491,7 +703,7 @@ func (m *Block) Reset()         { *m = Block{} }
 func (m *Block) String() string { return proto.CompactTextString(m) }
 func (*Block) ProtoMessage()    {}
 func (*Block) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33c57e4bae7b9afd, []int{5}
+	return fileDescriptor_33c57e4bae7b9afd, []int{7}
 }
 
 func (m *Block) XXX_Unmarshal(b []byte) error {
@@ -539,7 +751,7 @@ func (m *Error) Reset()         { *m = Error{} }
 func (m *Error) String() string { return proto.CompactTextString(m) }
 func (*Error) ProtoMessage()    {}
 func (*Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33c57e4bae7b9afd, []int{6}
+	return fileDescriptor_33c57e4bae7b9afd, []int{8}
 }
 
 func (m *Error) XXX_Unmarshal(b []byte) error {
@@ -595,7 +807,7 @@ func (m *OrderPaymentTxn) Reset()         { *m = OrderPaymentTxn{} }
 func (m *OrderPaymentTxn) String() string { return proto.CompactTextString(m) }
 func (*OrderPaymentTxn) ProtoMessage()    {}
 func (*OrderPaymentTxn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_33c57e4bae7b9afd, []int{7}
+	return fileDescriptor_33c57e4bae7b9afd, []int{9}
 }
 
 func (m *OrderPaymentTxn) XXX_Unmarshal(b []byte) error {
@@ -647,9 +859,12 @@ func (m *OrderPaymentTxn) GetWithInput() bool {
 func init() {
 	proto.RegisterEnum("Message_MessageType", Message_MessageType_name, Message_MessageType_value)
 	proto.RegisterEnum("Chat_Flag", Chat_Flag_name, Chat_Flag_value)
+	proto.RegisterEnum("CaseMessage_Channel", CaseMessage_Channel_name, CaseMessage_Channel_value)
 	proto.RegisterType((*Message)(nil), "Message")
 	proto.RegisterType((*Envelope)(nil), "Envelope")
 	proto.RegisterType((*Chat)(nil), "Chat")
+	proto.RegisterType((*CaseMessage)(nil), "CaseMessage")
+	proto.RegisterType((*SignedCaseMessage)(nil), "SignedCaseMessage")
 	proto.RegisterType((*SignedData)(nil), "SignedData")
 	proto.RegisterType((*SignedData_Command)(nil), "SignedData.Command")
 	proto.RegisterType((*CidList)(nil), "CidList")
@@ -661,59 +876,78 @@ func init() {
 func init() { proto.RegisterFile("message.proto", fileDescriptor_33c57e4bae7b9afd) }
 
 var fileDescriptor_33c57e4bae7b9afd = []byte{
-	// 864 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x94, 0x54, 0x51, 0x8f, 0xdb, 0x44,
-	0x10, 0xc6, 0xb1, 0x73, 0x49, 0x26, 0xc9, 0xdd, 0xde, 0x72, 0x45, 0xe1, 0x44, 0x01, 0x59, 0x08,
-	0x95, 0x97, 0x54, 0xba, 0x4a, 0x88, 0x57, 0x37, 0x5e, 0x17, 0x83, 0x63, 0x47, 0x1b, 0xa7, 0xe8,
-	0xfa, 0x12, 0x39, 0xe7, 0x6d, 0x6a, 0x9a, 0xd8, 0xc6, 0x76, 0x28, 0xe1, 0x15, 0xf1, 0x8b, 0xf8,
-	0x3d, 0x3c, 0xf2, 0x0f, 0xe0, 0x15, 0xb1, 0xbb, 0x5e, 0x37, 0x49, 0x91, 0x4e, 0xea, 0x93, 0x67,
-	0xbe, 0x99, 0x9d, 0x99, 0xfd, 0xe6, 0x5b, 0xc3, 0x70, 0xcb, 0xca, 0x32, 0x5a, 0xb3, 0x71, 0x5e,
-	0x64, 0x55, 0x76, 0xfd, 0xf1, 0x3a, 0xcb, 0xd6, 0x1b, 0xf6, 0x58, 0x7a, 0xab, 0xdd, 0xcb, 0xc7,
-	0x51, 0xba, 0x57, 0xa1, 0xcf, 0xde, 0x0d, 0x55, 0x09, 0x3f, 0x5b, 0x45, 0xdb, 0xbc, 0x4e, 0x30,
-	0xff, 0x32, 0xa0, 0x33, 0xad, 0xab, 0xe1, 0xaf, 0xa1, 0xaf, 0x0a, 0x87, 0xfb, 0x9c, 0x8d, 0xb4,
-	0xcf, 0xb5, 0x47, 0xe7, 0x37, 0x57, 0x63, 0x15, 0x6e, 0xbe, 0x22, 0x46, 0x8f, 0x13, 0xf1, 0x18,
-	0x3a, 0x79, 0xb4, 0xdf, 0x64, 0x51, 0x3c, 0x6a, 0xf1, 0x33, 0x7d, 0x7e, 0xa6, 0x6e, 0x3b, 0x6e,
-	0xda, 0x8e, 0xad, 0x74, 0x4f, 0x9b, 0x24, 0xfc, 0x09, 0xf4, 0x0a, 0xf6, 0xd3, 0x8e, 0xcf, 0xe1,
-	0xc6, 0x23, 0x9d, 0x9f, 0x68, 0xd3, 0x03, 0x80, 0x3f, 0x05, 0x48, 0x4a, 0xca, 0xca, 0x3c, 0x4b,
-	0x4b, 0x36, 0x32, 0x78, 0xb8, 0x4b, 0x8f, 0x10, 0xf3, 0x0f, 0x1d, 0xfa, 0x47, 0xa3, 0xe0, 0x2e,
-	0x18, 0x33, 0xd7, 0x7f, 0x86, 0x3e, 0x10, 0xd6, 0xe4, 0x5b, 0x2b, 0x44, 0x1a, 0x06, 0x38, 0x73,
-	0x02, 0xcf, 0x0b, 0x7e, 0x40, 0x2d, 0x3c, 0x80, 0xee, 0xc2, 0x57, 0x9e, 0x8e, 0x7b, 0xd0, 0x0e,
-	0xa8, 0x4d, 0x28, 0x32, 0x30, 0x82, 0x81, 0x34, 0x97, 0x94, 0x7c, 0x47, 0x26, 0x21, 0x6a, 0x1f,
-	0x90, 0x89, 0xe5, 0x4f, 0x88, 0x87, 0xce, 0xf0, 0x47, 0x80, 0x15, 0x12, 0xf8, 0x8e, 0x4b, 0xa7,
-	0x56, 0xe8, 0x06, 0x3e, 0xea, 0xe0, 0x07, 0x70, 0x59, 0xe3, 0xce, 0xc2, 0x73, 0x5c, 0xcf, 0x9b,
-	0x12, 0x3f, 0x44, 0x5d, 0x7c, 0x05, 0xa8, 0x49, 0x9f, 0xce, 0x3c, 0x22, 0x93, 0x7b, 0xa2, 0xac,
-	0xed, 0xce, 0x67, 0x8b, 0x90, 0x2c, 0x83, 0x19, 0xf1, 0x11, 0x60, 0x0c, 0xe7, 0x0d, 0xb2, 0x98,
-	0xd9, 0x56, 0x48, 0x50, 0x1f, 0x5f, 0xc2, 0xb0, 0xc1, 0x26, 0x5e, 0x30, 0x27, 0x68, 0x20, 0xae,
-	0x41, 0x89, 0xb3, 0xf0, 0x6d, 0x34, 0xc4, 0x17, 0xd0, 0x0f, 0x1c, 0xc7, 0x73, 0x7d, 0xb2, 0xb4,
-	0x26, 0xdf, 0xa3, 0x73, 0x91, 0xdf, 0x00, 0x94, 0x78, 0xd6, 0x2d, 0xba, 0x10, 0xd0, 0x34, 0xe0,
-	0xed, 0xad, 0x30, 0xa0, 0x4b, 0xcb, 0xb6, 0x11, 0x12, 0x13, 0x1d, 0x20, 0x4a, 0xa6, 0xc1, 0x73,
-	0x82, 0x2e, 0x05, 0x0b, 0x73, 0x0e, 0x10, 0x84, 0x85, 0xf9, 0xd4, 0x0b, 0x78, 0xc5, 0x0f, 0xf9,
-	0x5e, 0x46, 0xcf, 0x89, 0x6f, 0xf3, 0x44, 0xc7, 0xf5, 0x2d, 0xcf, 0x7d, 0x41, 0xec, 0xe5, 0xcc,
-	0xba, 0x95, 0x77, 0xbb, 0x92, 0xfd, 0xe4, 0xdd, 0x1a, 0xe8, 0x01, 0x9f, 0xaf, 0x4d, 0x28, 0x0d,
-	0x28, 0xfa, 0x5b, 0xc7, 0x0f, 0x61, 0xa4, 0xc2, 0x34, 0x98, 0x90, 0xf9, 0x9c, 0xaf, 0x64, 0xe9,
-	0x58, 0xae, 0xb7, 0xe0, 0x5d, 0xfe, 0xd1, 0xcd, 0x18, 0xba, 0x24, 0xfd, 0x99, 0x6d, 0x32, 0xbe,
-	0x31, 0x13, 0x3a, 0x4a, 0x3e, 0x52, 0x63, 0xfd, 0x9b, 0x6e, 0xa3, 0x2d, 0xda, 0x04, 0x38, 0xf1,
-	0x67, 0xf9, 0x6e, 0xf5, 0x9a, 0xed, 0xa5, 0xa4, 0x06, 0x54, 0x79, 0x42, 0x3b, 0x65, 0xb2, 0x4e,
-	0xa3, 0x6a, 0x57, 0x30, 0xa9, 0x9d, 0x01, 0x3d, 0x00, 0xe6, 0x9f, 0x1a, 0x97, 0xc0, 0xab, 0xa8,
-	0x12, 0x69, 0xaa, 0x12, 0x97, 0x98, 0x68, 0xd2, 0xa3, 0x07, 0x00, 0x8f, 0xa0, 0x53, 0xee, 0x56,
-	0x3f, 0xb2, 0xbb, 0x4a, 0x56, 0xef, 0xd1, 0xc6, 0x15, 0x91, 0x66, 0x34, 0xbd, 0x8e, 0x34, 0x03,
-	0x7d, 0x03, 0xbd, 0xb7, 0x6f, 0x47, 0xaa, 0xb2, 0x7f, 0x73, 0xfd, 0x3f, 0x99, 0x87, 0x4d, 0x06,
-	0x3d, 0x24, 0x73, 0x41, 0x1b, 0x2f, 0x37, 0xd1, 0x7a, 0xd4, 0x96, 0xef, 0x09, 0xc6, 0x62, 0xc0,
-	0xb1, 0xc3, 0x11, 0x2a, 0x71, 0xf3, 0x2b, 0x30, 0x84, 0x87, 0xfb, 0xfc, 0x25, 0x72, 0xe2, 0xac,
-	0x67, 0x84, 0x6b, 0x99, 0xaf, 0x3e, 0xbc, 0x95, 0xba, 0xd6, 0x84, 0xae, 0x29, 0xb1, 0x6c, 0xd4,
-	0x32, 0xff, 0xd5, 0x00, 0xe6, 0xfc, 0xb6, 0x2c, 0xb6, 0xa3, 0x2a, 0xe2, 0x44, 0x0e, 0x4a, 0x96,
-	0xc6, 0xac, 0x98, 0xd5, 0x54, 0x69, 0x92, 0x8f, 0x13, 0x0c, 0x7f, 0x09, 0xe7, 0x25, 0x2b, 0x92,
-	0x68, 0x93, 0xfc, 0x5a, 0x9f, 0x52, 0x84, 0xbe, 0x83, 0xde, 0x4f, 0xec, 0xf5, 0xef, 0x1a, 0x74,
-	0x26, 0xd9, 0x76, 0x1b, 0xa5, 0xb1, 0x5c, 0x0d, 0x63, 0x85, 0x6b, 0x2b, 0x62, 0x95, 0x87, 0x1f,
-	0x81, 0x51, 0x89, 0xff, 0x46, 0xeb, 0x9e, 0xff, 0x86, 0xcc, 0x38, 0xe5, 0x52, 0x7f, 0x0f, 0x2e,
-	0xcd, 0x87, 0x7c, 0x8c, 0x24, 0xf6, 0x92, 0xb2, 0xe2, 0x6f, 0xc8, 0xb8, 0x4b, 0xe2, 0x92, 0x0f,
-	0xa1, 0xf3, 0x21, 0xa4, 0x6d, 0x3e, 0xe1, 0x62, 0xde, 0x64, 0x77, 0xaf, 0xc5, 0x1e, 0x8b, 0xe8,
-	0x8d, 0xbc, 0x6e, 0x4d, 0x4a, 0xe3, 0xf2, 0xc7, 0xa8, 0xf3, 0x54, 0xb5, 0x77, 0x61, 0x9a, 0xb7,
-	0x5c, 0xc5, 0x45, 0x91, 0x15, 0xb2, 0x62, 0x16, 0xd7, 0xa2, 0x1c, 0x52, 0x69, 0x0b, 0x8a, 0x99,
-	0x08, 0xaa, 0x4b, 0xa8, 0x73, 0x27, 0x98, 0x68, 0x96, 0x15, 0xb1, 0x64, 0x44, 0x89, 0x46, 0xb9,
-	0xe6, 0x6f, 0x1a, 0x5c, 0x04, 0xc2, 0x9e, 0x45, 0xfb, 0x2d, 0x4b, 0xab, 0xf0, 0x97, 0xb4, 0xee,
-	0x92, 0xa4, 0x8a, 0x3c, 0x69, 0x1f, 0x57, 0x68, 0x9d, 0x54, 0xc0, 0x5f, 0xc0, 0xb0, 0x2a, 0xa2,
-	0xb4, 0x8c, 0xee, 0xaa, 0x24, 0x4b, 0xdf, 0x76, 0x38, 0x05, 0xc5, 0xf2, 0xde, 0x24, 0xd5, 0x2b,
-	0x37, 0xcd, 0x77, 0x95, 0xfa, 0x65, 0x1e, 0x80, 0xa7, 0xc6, 0x8b, 0x56, 0xbe, 0x5a, 0x9d, 0x49,
-	0x66, 0x9f, 0xfc, 0x17, 0x00, 0x00, 0xff, 0xff, 0x0b, 0x9f, 0x9a, 0x3b, 0x3d, 0x06, 0x00, 0x00,
+	// 1153 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x0e, 0xf5, 0x63, 0x49, 0x23, 0xd9, 0x5e, 0x6f, 0x9c, 0x44, 0x71, 0xf3, 0xe3, 0x12, 0x45,
+	0xe0, 0x5e, 0x14, 0xc0, 0x01, 0x8a, 0xa2, 0x37, 0x9a, 0x5c, 0x25, 0x6c, 0x28, 0x92, 0x59, 0x52,
+	0x0e, 0x94, 0x0b, 0x41, 0x9b, 0x1b, 0x99, 0x8d, 0x44, 0xaa, 0x24, 0xd5, 0x54, 0xbd, 0x16, 0x7d,
+	0x88, 0x1e, 0xfb, 0x40, 0x7d, 0x84, 0xbe, 0x45, 0x7b, 0x6d, 0x8b, 0x5d, 0x72, 0x2d, 0xc9, 0x29,
+	0x52, 0xe4, 0x36, 0xf3, 0xcd, 0x68, 0xf6, 0x9b, 0x99, 0xfd, 0x96, 0x82, 0xdd, 0x39, 0xcb, 0xf3,
+	0x70, 0xca, 0x06, 0x8b, 0x2c, 0x2d, 0xd2, 0xa3, 0xfb, 0xd3, 0x34, 0x9d, 0xce, 0xd8, 0x53, 0xe1,
+	0x5d, 0x2c, 0xdf, 0x3e, 0x0d, 0x93, 0x55, 0x15, 0x7a, 0x7c, 0x33, 0x54, 0xc4, 0x73, 0x96, 0x17,
+	0xe1, 0x7c, 0x51, 0x26, 0xa8, 0xff, 0xec, 0x40, 0x6b, 0x54, 0x56, 0xc3, 0x5f, 0x41, 0xb7, 0x2a,
+	0xec, 0xaf, 0x16, 0xac, 0xaf, 0x1c, 0x2b, 0x27, 0x7b, 0xa7, 0x87, 0x83, 0x2a, 0x3c, 0x18, 0xad,
+	0x63, 0x74, 0x33, 0x11, 0x0f, 0xa0, 0xb5, 0x08, 0x57, 0xb3, 0x34, 0x8c, 0xfa, 0xb5, 0x63, 0xe5,
+	0xa4, 0x7b, 0x7a, 0x38, 0x28, 0x8f, 0x1d, 0xc8, 0x63, 0x07, 0x5a, 0xb2, 0xa2, 0x32, 0x09, 0x3f,
+	0x80, 0x4e, 0xc6, 0xbe, 0x5f, 0xb2, 0xbc, 0x30, 0xa3, 0x7e, 0xfd, 0x58, 0x39, 0x69, 0xd2, 0x35,
+	0x80, 0x1f, 0x01, 0xc4, 0x39, 0x65, 0xf9, 0x22, 0x4d, 0x72, 0xd6, 0x6f, 0x1c, 0x2b, 0x27, 0x6d,
+	0xba, 0x81, 0xa8, 0xbf, 0x37, 0xa1, 0xbb, 0x41, 0x05, 0xb7, 0xa1, 0xe1, 0x9a, 0xf6, 0x73, 0x74,
+	0x8b, 0x5b, 0xfa, 0x0b, 0xcd, 0x47, 0x0a, 0x06, 0xd8, 0x19, 0x3a, 0x96, 0xe5, 0xbc, 0x46, 0x35,
+	0xdc, 0x83, 0xf6, 0xd8, 0xae, 0xbc, 0x3a, 0xee, 0x40, 0xd3, 0xa1, 0x06, 0xa1, 0xa8, 0x81, 0x11,
+	0xf4, 0x84, 0x19, 0x50, 0xf2, 0x2d, 0xd1, 0x7d, 0xd4, 0x5c, 0x23, 0xba, 0x66, 0xeb, 0xc4, 0x42,
+	0x3b, 0xf8, 0x2e, 0xe0, 0x0a, 0x71, 0xec, 0xa1, 0x49, 0x47, 0x9a, 0x6f, 0x3a, 0x36, 0x6a, 0xe1,
+	0x3b, 0x70, 0x50, 0xe2, 0xc3, 0xb1, 0x35, 0x34, 0x2d, 0x6b, 0x44, 0x6c, 0x1f, 0xb5, 0xf1, 0x21,
+	0x20, 0x99, 0x3e, 0x72, 0x2d, 0x22, 0x92, 0x3b, 0xbc, 0xac, 0x61, 0x7a, 0xee, 0xd8, 0x27, 0x81,
+	0xe3, 0x12, 0x1b, 0x01, 0xc6, 0xb0, 0x27, 0x91, 0xb1, 0x6b, 0x68, 0x3e, 0x41, 0x5d, 0x7c, 0x00,
+	0xbb, 0x12, 0xd3, 0x2d, 0xc7, 0x23, 0xa8, 0xc7, 0xdb, 0xa0, 0x64, 0x38, 0xb6, 0x0d, 0xb4, 0x8b,
+	0xf7, 0xa1, 0xeb, 0x0c, 0x87, 0x96, 0x69, 0x93, 0x40, 0xd3, 0x5f, 0xa2, 0x3d, 0x9e, 0x2f, 0x01,
+	0x4a, 0x2c, 0x6d, 0x82, 0xf6, 0x39, 0x34, 0x72, 0x0c, 0x42, 0x35, 0xdf, 0xa1, 0x81, 0x66, 0x18,
+	0x08, 0x71, 0x46, 0x6b, 0x88, 0x92, 0x91, 0x73, 0x4e, 0xd0, 0x01, 0x9f, 0x82, 0xe7, 0x3b, 0x94,
+	0x20, 0xcc, 0xcd, 0x33, 0xcb, 0xd1, 0x5f, 0xa2, 0xdb, 0xf8, 0x01, 0xf4, 0xcf, 0x89, 0x6d, 0x38,
+	0x34, 0x18, 0x9a, 0xb6, 0x66, 0x99, 0x6f, 0x88, 0x11, 0xb8, 0xda, 0x44, 0xf4, 0x76, 0x28, 0xce,
+	0x13, 0xbd, 0x49, 0xe8, 0x0e, 0x9f, 0xc2, 0xc8, 0xb4, 0x88, 0xe7, 0x3b, 0x25, 0x09, 0xa2, 0x79,
+	0x04, 0xdd, 0xc5, 0xb7, 0x61, 0xdf, 0x37, 0x47, 0xc4, 0x7b, 0x41, 0x88, 0x1f, 0x10, 0xdb, 0xa7,
+	0x13, 0x74, 0x8f, 0x13, 0x59, 0x83, 0x94, 0x9c, 0x9b, 0xe4, 0x35, 0xea, 0xe3, 0x7b, 0x70, 0xdb,
+	0x1b, 0x9f, 0x79, 0x3a, 0x35, 0x5d, 0x3e, 0x2c, 0x39, 0x8d, 0xfb, 0xfc, 0xb4, 0x57, 0x63, 0xc7,
+	0xe7, 0x65, 0x5f, 0x8d, 0x89, 0xe7, 0xa3, 0x23, 0xce, 0x54, 0x40, 0xe8, 0x33, 0x7e, 0x42, 0xc9,
+	0x45, 0x1b, 0x11, 0xdb, 0x10, 0x6c, 0x1e, 0x70, 0xfa, 0x37, 0xc0, 0x80, 0x12, 0xcf, 0x75, 0x6c,
+	0x8f, 0xa0, 0x87, 0x7c, 0x93, 0x72, 0xbc, 0xae, 0x66, 0x13, 0x2b, 0x38, 0xe7, 0xa5, 0x1e, 0x71,
+	0x5e, 0x12, 0x27, 0xe7, 0xa6, 0x41, 0x6c, 0x9d, 0xa0, 0xc7, 0x7c, 0x65, 0xba, 0xe6, 0x91, 0x60,
+	0x44, 0x3c, 0x4f, 0x7b, 0x4e, 0xd0, 0x31, 0x5f, 0x99, 0xab, 0x51, 0xdf, 0xd4, 0xac, 0xa0, 0xda,
+	0xc9, 0xe7, 0xb8, 0x0f, 0x87, 0xdb, 0x35, 0x5d, 0x6d, 0xe2, 0x8c, 0x7d, 0xa4, 0x62, 0x80, 0x26,
+	0xa1, 0xd4, 0xa1, 0xe8, 0xcf, 0x3a, 0x7e, 0x28, 0x79, 0xb9, 0xd4, 0xd1, 0x89, 0xe7, 0x99, 0xf6,
+	0xf3, 0x60, 0xa8, 0x99, 0xd6, 0x98, 0x12, 0xf4, 0x57, 0x5d, 0x8d, 0xa0, 0x4d, 0x92, 0x1f, 0xd8,
+	0x2c, 0x5d, 0x30, 0xac, 0x42, 0xab, 0x12, 0x96, 0x50, 0x5f, 0xf7, 0xb4, 0x2d, 0x55, 0x47, 0x65,
+	0x00, 0xdf, 0x85, 0x9d, 0xc5, 0xf2, 0xe2, 0x1d, 0x5b, 0x09, 0xb1, 0xf5, 0x68, 0xe5, 0x71, 0x55,
+	0xe5, 0xf1, 0x34, 0x09, 0x8b, 0x65, 0xc6, 0x84, 0xaa, 0x7a, 0x74, 0x0d, 0xa8, 0x7f, 0x28, 0xd0,
+	0xd0, 0xaf, 0xc2, 0x82, 0xa7, 0x55, 0x95, 0xcc, 0x48, 0x1c, 0xd2, 0xa1, 0x6b, 0x00, 0xf7, 0xa1,
+	0x95, 0x2f, 0x2f, 0xbe, 0x63, 0x97, 0x85, 0xa8, 0xde, 0xa1, 0xd2, 0xe5, 0x11, 0x49, 0xad, 0x5e,
+	0x46, 0x24, 0xa1, 0xaf, 0xa1, 0x73, 0xfd, 0xaa, 0x08, 0xbd, 0x76, 0x4f, 0x8f, 0x3e, 0x78, 0x00,
+	0x7c, 0x99, 0x41, 0xd7, 0xc9, 0xf8, 0x11, 0x34, 0xde, 0xce, 0xc2, 0x69, 0xbf, 0x29, 0x5e, 0x1a,
+	0x18, 0x70, 0x82, 0x83, 0xe1, 0x2c, 0x9c, 0x52, 0x81, 0xab, 0x5f, 0x42, 0x83, 0x7b, 0xb8, 0x0b,
+	0x2d, 0xb9, 0x88, 0x5b, 0x5c, 0x14, 0xfe, 0x44, 0x28, 0x5e, 0xe1, 0x8a, 0xa7, 0x44, 0x33, 0x50,
+	0x4d, 0xfd, 0xad, 0x06, 0x5d, 0x3d, 0xcc, 0x99, 0x7c, 0xcb, 0xfe, 0xb7, 0xcd, 0x34, 0x8b, 0x58,
+	0x66, 0x46, 0xb2, 0xcd, 0xca, 0xe5, 0x6f, 0xd9, 0xe5, 0x55, 0x98, 0x24, 0x6c, 0xd6, 0xaf, 0x57,
+	0xef, 0xdf, 0x46, 0xd9, 0x81, 0x5e, 0xc6, 0xa8, 0x4c, 0xc2, 0x47, 0xd0, 0x2e, 0xae, 0x32, 0x16,
+	0x46, 0x66, 0x24, 0x7a, 0xef, 0xd0, 0x6b, 0x7f, 0x73, 0x64, 0xcd, 0x8f, 0x8c, 0x6c, 0xe7, 0x13,
+	0x46, 0xa6, 0x7e, 0x03, 0xad, 0x8a, 0x83, 0x78, 0xee, 0xb8, 0xe0, 0x6e, 0x09, 0x0d, 0x8f, 0x27,
+	0x84, 0x96, 0x2f, 0x5f, 0xa9, 0x61, 0x54, 0xc3, 0x7b, 0x00, 0xd7, 0xda, 0xf7, 0x50, 0x5d, 0xfd,
+	0x55, 0x81, 0x03, 0x2f, 0x9e, 0x26, 0x2c, 0xda, 0x9c, 0xd4, 0x93, 0x9b, 0x77, 0xae, 0xb7, 0xd9,
+	0xf1, 0x9a, 0xf3, 0x11, 0xb4, 0x73, 0x96, 0xf0, 0x29, 0x19, 0xd5, 0xd0, 0xae, 0x7d, 0xac, 0x42,
+	0xaf, 0xb4, 0xdd, 0xf2, 0x66, 0x96, 0xd7, 0x6f, 0x0b, 0xdb, 0xbe, 0x9f, 0x8d, 0x9b, 0xf7, 0xf3,
+	0x6f, 0x05, 0xa0, 0xe4, 0x66, 0x84, 0x45, 0xf8, 0x41, 0x41, 0xe5, 0x3f, 0x0a, 0x3e, 0x81, 0xbd,
+	0x9c, 0x65, 0x71, 0x38, 0x8b, 0x7f, 0x2a, 0x7f, 0x55, 0x09, 0xe2, 0x06, 0xfa, 0x71, 0x61, 0x1c,
+	0xfd, 0xa2, 0x40, 0x4b, 0x4f, 0xe7, 0xf3, 0x30, 0x89, 0x84, 0xb4, 0x98, 0x68, 0xb0, 0xbc, 0x31,
+	0x95, 0x87, 0x4f, 0xa0, 0x51, 0xf0, 0x2f, 0x62, 0xed, 0x23, 0x5f, 0x44, 0x91, 0xb1, 0xbd, 0xd8,
+	0xfa, 0xa7, 0x2c, 0xf6, 0x21, 0xb4, 0xf4, 0x38, 0xb2, 0xe2, 0xbc, 0xc0, 0x18, 0x1a, 0x97, 0x71,
+	0x94, 0xf7, 0x95, 0xe3, 0xfa, 0x49, 0x87, 0x0a, 0x5b, 0x7d, 0x06, 0xcd, 0xb3, 0x59, 0x7a, 0xf9,
+	0x8e, 0x5f, 0xaa, 0x2c, 0x7c, 0x2f, 0xda, 0x2d, 0x87, 0x22, 0x5d, 0x8c, 0xa0, 0x7e, 0x19, 0xcb,
+	0x0b, 0xcd, 0x4d, 0x75, 0x02, 0x4d, 0x92, 0x65, 0x69, 0x26, 0x2a, 0xa6, 0x51, 0xb9, 0xe0, 0x5d,
+	0x2a, 0x6c, 0x3e, 0x62, 0xc6, 0x83, 0x55, 0x13, 0xd5, 0xef, 0xb6, 0xb0, 0xb5, 0x4e, 0x0c, 0x29,
+	0xfa, 0xca, 0x55, 0x7f, 0x56, 0x60, 0xdf, 0xe1, 0xb6, 0x1b, 0xae, 0xe6, 0x2c, 0x29, 0xfc, 0x1f,
+	0x93, 0xf2, 0x94, 0x38, 0xa9, 0x86, 0x27, 0xec, 0xcd, 0x0a, 0x5b, 0x4a, 0x33, 0xf0, 0x17, 0xb0,
+	0x5b, 0x64, 0x61, 0x92, 0x87, 0x97, 0x45, 0x9c, 0x26, 0xd7, 0x27, 0x6c, 0x83, 0x7c, 0x79, 0xef,
+	0xe3, 0xe2, 0xca, 0x4c, 0x16, 0xcb, 0xa2, 0xfa, 0x33, 0xb0, 0x06, 0xce, 0x1a, 0x6f, 0x6a, 0x8b,
+	0x8b, 0x8b, 0x1d, 0x31, 0xd9, 0x67, 0xff, 0x0e, 0x00, 0xe5, 0xb2, 0x70, 0xfd, 0x17, 0x09, 0x00,
+	0x00,
 }
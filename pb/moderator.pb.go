@@ -49,14 +49,16 @@ func (Moderator_Fee_FeeType) EnumDescriptor() ([]byte, []int) {
 }
 
 type Moderator struct {
-	Description          string         `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
-	TermsAndConditions   string         `protobuf:"bytes,2,opt,name=termsAndConditions,proto3" json:"termsAndConditions,omitempty"`
-	Languages            []string       `protobuf:"bytes,3,rep,name=languages,proto3" json:"languages,omitempty"`
-	AcceptedCurrencies   []string       `protobuf:"bytes,4,rep,name=acceptedCurrencies,proto3" json:"acceptedCurrencies,omitempty"`
-	Fee                  *Moderator_Fee `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Description          string           `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	TermsAndConditions   string           `protobuf:"bytes,2,opt,name=termsAndConditions,proto3" json:"termsAndConditions,omitempty"`
+	Languages            []string         `protobuf:"bytes,3,rep,name=languages,proto3" json:"languages,omitempty"`
+	AcceptedCurrencies   []string         `protobuf:"bytes,4,rep,name=acceptedCurrencies,proto3" json:"acceptedCurrencies,omitempty"`
+	Fee                  *Moderator_Fee   `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	PublishStats         bool             `protobuf:"varint,6,opt,name=publishStats,proto3" json:"publishStats,omitempty"`
+	Stats                *Moderator_Stats `protobuf:"bytes,7,opt,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *Moderator) Reset()         { *m = Moderator{} }
@@ -119,6 +121,20 @@ func (m *Moderator) GetFee() *Moderator_Fee {
 	return nil
 }
 
+func (m *Moderator) GetPublishStats() bool {
+	if m != nil {
+		return m.PublishStats
+	}
+	return false
+}
+
+func (m *Moderator) GetStats() *Moderator_Stats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
 type Moderator_Fee struct {
 	FixedFee             *Moderator_Price      `protobuf:"bytes,1,opt,name=fixedFee,proto3" json:"fixedFee,omitempty"`
 	Percentage           float32               `protobuf:"fixed32,2,opt,name=percentage,proto3" json:"percentage,omitempty"`
@@ -221,6 +237,77 @@ func (m *Moderator_Price) GetAmount() uint64 {
 	return 0
 }
 
+type Moderator_Stats struct {
+	OpenCases               uint32   `protobuf:"varint,1,opt,name=openCases,proto3" json:"openCases,omitempty"`
+	ClosedCases             uint32   `protobuf:"varint,2,opt,name=closedCases,proto3" json:"closedCases,omitempty"`
+	MedianResolutionSeconds int64    `protobuf:"varint,3,opt,name=medianResolutionSeconds,proto3" json:"medianResolutionSeconds,omitempty"`
+	BuyerWinRatio           float32  `protobuf:"fixed32,4,opt,name=buyerWinRatio,proto3" json:"buyerWinRatio,omitempty"`
+	VendorWinRatio          float32  `protobuf:"fixed32,5,opt,name=vendorWinRatio,proto3" json:"vendorWinRatio,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
+	XXX_unrecognized        []byte   `json:"-"`
+	XXX_sizecache           int32    `json:"-"`
+}
+
+func (m *Moderator_Stats) Reset()         { *m = Moderator_Stats{} }
+func (m *Moderator_Stats) String() string { return proto.CompactTextString(m) }
+func (*Moderator_Stats) ProtoMessage()    {}
+func (*Moderator_Stats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_44f20453d9230215, []int{0, 2}
+}
+
+func (m *Moderator_Stats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Moderator_Stats.Unmarshal(m, b)
+}
+func (m *Moderator_Stats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Moderator_Stats.Marshal(b, m, deterministic)
+}
+func (m *Moderator_Stats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Moderator_Stats.Merge(m, src)
+}
+func (m *Moderator_Stats) XXX_Size() int {
+	return xxx_messageInfo_Moderator_Stats.Size(m)
+}
+func (m *Moderator_Stats) XXX_DiscardUnknown() {
+	xxx_messageInfo_Moderator_Stats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Moderator_Stats proto.InternalMessageInfo
+
+func (m *Moderator_Stats) GetOpenCases() uint32 {
+	if m != nil {
+		return m.OpenCases
+	}
+	return 0
+}
+
+func (m *Moderator_Stats) GetClosedCases() uint32 {
+	if m != nil {
+		return m.ClosedCases
+	}
+	return 0
+}
+
+func (m *Moderator_Stats) GetMedianResolutionSeconds() int64 {
+	if m != nil {
+		return m.MedianResolutionSeconds
+	}
+	return 0
+}
+
+func (m *Moderator_Stats) GetBuyerWinRatio() float32 {
+	if m != nil {
+		return m.BuyerWinRatio
+	}
+	return 0
+}
+
+func (m *Moderator_Stats) GetVendorWinRatio() float32 {
+	if m != nil {
+		return m.VendorWinRatio
+	}
+	return 0
+}
+
 type DisputeUpdate struct {
 	OrderId              string      `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
 	PayoutAddress        string      `protobuf:"bytes,2,opt,name=payoutAddress,proto3" json:"payoutAddress,omitempty"`
@@ -289,38 +376,46 @@ func init() {
 	proto.RegisterType((*Moderator)(nil), "Moderator")
 	proto.RegisterType((*Moderator_Fee)(nil), "Moderator.Fee")
 	proto.RegisterType((*Moderator_Price)(nil), "Moderator.Price")
+	proto.RegisterType((*Moderator_Stats)(nil), "Moderator.Stats")
 	proto.RegisterType((*DisputeUpdate)(nil), "DisputeUpdate")
 }
 
 func init() { proto.RegisterFile("moderator.proto", fileDescriptor_44f20453d9230215) }
 
 var fileDescriptor_44f20453d9230215 = []byte{
-	// 426 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x6c, 0x52, 0x6d, 0x6a, 0x1b, 0x31,
-	0x10, 0xed, 0x7a, 0xed, 0xb8, 0x3b, 0x4e, 0x1c, 0x23, 0x68, 0xd8, 0x9a, 0x52, 0x8c, 0x29, 0xd4,
-	0x3f, 0xca, 0x52, 0xdc, 0x03, 0x14, 0x77, 0xe3, 0x94, 0x40, 0x3f, 0x8c, 0x9a, 0x40, 0xe9, 0x9f,
-	0xa0, 0x48, 0x93, 0x20, 0x88, 0x25, 0x21, 0x69, 0xa1, 0xee, 0x89, 0x7a, 0x92, 0x5e, 0xa2, 0x97,
-	0x89, 0x56, 0x5e, 0xc7, 0x36, 0xf8, 0xc7, 0xc2, 0xce, 0x7b, 0x8f, 0x99, 0x37, 0x7a, 0x03, 0xa7,
-	0x4b, 0x2d, 0xd0, 0x32, 0xaf, 0x6d, 0x61, 0xac, 0xf6, 0x7a, 0x78, 0xca, 0xb5, 0xf2, 0x96, 0x71,
-	0xef, 0xd6, 0xc0, 0xf8, 0x7f, 0x0a, 0xd9, 0xd7, 0x8d, 0x88, 0x8c, 0xa0, 0x27, 0xd0, 0x71, 0x2b,
-	0x8d, 0x97, 0x5a, 0xe5, 0xc9, 0x28, 0x99, 0x64, 0x74, 0x17, 0x22, 0x05, 0x10, 0x8f, 0x76, 0xe9,
-	0x66, 0x4a, 0x94, 0x5a, 0x09, 0x59, 0x83, 0x2e, 0x6f, 0x45, 0xe1, 0x01, 0x86, 0xbc, 0x82, 0xec,
-	0x81, 0xa9, 0xfb, 0x8a, 0xdd, 0xa3, 0xcb, 0xd3, 0x51, 0x1a, 0x64, 0x5b, 0xa0, 0xee, 0xc6, 0x38,
-	0x47, 0xe3, 0x51, 0x94, 0x95, 0xb5, 0xa8, 0xb8, 0x0c, 0xb2, 0x76, 0x94, 0x1d, 0x60, 0x82, 0xbf,
-	0xf4, 0x0e, 0x31, 0xef, 0x84, 0x71, 0xbd, 0x69, 0xbf, 0x78, 0x32, 0x5e, 0x5c, 0x20, 0xd2, 0x9a,
-	0x1a, 0xfe, 0x4b, 0x20, 0x0d, 0x05, 0x79, 0x07, 0xcf, 0xef, 0xe4, 0x6f, 0x14, 0xe1, 0x3f, 0xae,
-	0xd1, 0x9b, 0x0e, 0x76, 0xe4, 0x0b, 0x2b, 0x39, 0xd2, 0x27, 0x05, 0x79, 0x0d, 0x60, 0xd0, 0x72,
-	0x54, 0x3e, 0xd8, 0x8a, 0xdb, 0xb4, 0xe8, 0x0e, 0x42, 0xde, 0x43, 0x37, 0x34, 0xbf, 0x5a, 0x19,
-	0x0c, 0x3b, 0x24, 0x93, 0xfe, 0xf4, 0x6c, 0x7f, 0x76, 0xfd, 0xd5, 0x2c, 0xdd, 0xc8, 0xc6, 0x1f,
-	0xa1, 0xdb, 0x60, 0x24, 0x83, 0xce, 0xc5, 0xe5, 0xcf, 0xf9, 0xf9, 0xe0, 0x19, 0xe9, 0x03, 0x2c,
-	0xe6, 0xb4, 0x9c, 0x7f, 0xbb, 0x9a, 0x7d, 0x9e, 0x0f, 0x12, 0xf2, 0x12, 0x5e, 0x44, 0xea, 0x66,
-	0xf1, 0xe5, 0xfa, 0xc7, 0xcd, 0x0e, 0xd5, 0x1a, 0x96, 0xd0, 0x89, 0x2e, 0xc9, 0x18, 0x8e, 0xf9,
-	0xfa, 0x05, 0x56, 0x65, 0x98, 0xd9, 0x84, 0xb2, 0x87, 0x91, 0x33, 0x38, 0x62, 0x4b, 0x5d, 0x29,
-	0x1f, 0xbd, 0xb7, 0x69, 0x53, 0x8d, 0xff, 0x26, 0x70, 0x72, 0x2e, 0x9d, 0xa9, 0x3c, 0x5e, 0x1b,
-	0xc1, 0x3c, 0x92, 0x1c, 0xba, 0xda, 0x06, 0xe7, 0x97, 0xa2, 0x69, 0xb4, 0x29, 0xc9, 0x1b, 0x38,
-	0x31, 0x6c, 0xa5, 0x2b, 0x3f, 0x13, 0xc2, 0xa2, 0xdb, 0x84, 0xba, 0x0f, 0x92, 0xb7, 0x90, 0x85,
-	0xca, 0x68, 0xa9, 0xfc, 0x3a, 0xcf, 0xde, 0x34, 0x2b, 0xbe, 0x37, 0x08, 0xdd, 0x72, 0x75, 0xb4,
-	0x0e, 0xad, 0x64, 0x0f, 0xf2, 0x0f, 0xd6, 0x07, 0x11, 0xaf, 0x2e, 0x44, 0x9b, 0x4c, 0x8e, 0xe9,
-	0x01, 0xe6, 0x53, 0xfb, 0x57, 0xcb, 0xdc, 0xde, 0x1e, 0xc5, 0xab, 0xfc, 0xf0, 0x18, 0x00, 0x00,
-	0xff, 0xff, 0x85, 0xeb, 0x32, 0x4a, 0xb9, 0x02, 0x00, 0x00,
+	// 542 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x5f, 0x6f, 0xd3, 0x3c,
+	0x14, 0xc6, 0xdf, 0x34, 0xcd, 0xba, 0x9c, 0x6d, 0xdd, 0x64, 0xe9, 0x1d, 0xa1, 0x9a, 0x50, 0x54,
+	0xa1, 0xd1, 0x0b, 0x14, 0xa1, 0x71, 0xc3, 0x1d, 0x1a, 0x59, 0x8b, 0x26, 0xf1, 0x67, 0xf2, 0x36,
+	0x81, 0xb8, 0x99, 0xdc, 0xf8, 0xb4, 0x58, 0x6a, 0x6d, 0xcb, 0x76, 0x10, 0xe5, 0x13, 0xf1, 0x49,
+	0xb8, 0xe5, 0xf3, 0x70, 0x87, 0xe2, 0xa4, 0x6b, 0x0b, 0xe5, 0x32, 0xbf, 0xdf, 0xd3, 0x53, 0xe7,
+	0xc9, 0x31, 0x1c, 0xce, 0x15, 0x47, 0xc3, 0x9c, 0x32, 0x99, 0x36, 0xca, 0xa9, 0xde, 0x61, 0xa1,
+	0xa4, 0x33, 0xac, 0x70, 0xb6, 0x06, 0xfd, 0x5f, 0x11, 0xc4, 0x6f, 0x97, 0x21, 0x92, 0xc2, 0x1e,
+	0x47, 0x5b, 0x18, 0xa1, 0x9d, 0x50, 0x32, 0x09, 0xd2, 0x60, 0x10, 0xd3, 0x75, 0x44, 0x32, 0x20,
+	0x0e, 0xcd, 0xdc, 0x9e, 0x4b, 0x9e, 0x2b, 0xc9, 0x45, 0x05, 0x6d, 0xd2, 0xf2, 0xc1, 0x2d, 0x86,
+	0x9c, 0x40, 0x3c, 0x63, 0x72, 0x5a, 0xb2, 0x29, 0xda, 0x24, 0x4c, 0xc3, 0x41, 0x4c, 0x57, 0xa0,
+	0x9a, 0xc6, 0x8a, 0x02, 0xb5, 0x43, 0x9e, 0x97, 0xc6, 0xa0, 0x2c, 0x04, 0xda, 0xa4, 0xed, 0x63,
+	0x5b, 0x0c, 0x49, 0x21, 0x9c, 0x20, 0x26, 0x51, 0x1a, 0x0c, 0xf6, 0xce, 0xba, 0xd9, 0xfd, 0xc1,
+	0xb3, 0x11, 0x22, 0xad, 0x14, 0xe9, 0xc3, 0xbe, 0x2e, 0xc7, 0x33, 0x61, 0x3f, 0x5f, 0x3b, 0xe6,
+	0x6c, 0xb2, 0x93, 0x06, 0x83, 0x5d, 0xba, 0xc1, 0xc8, 0x29, 0x44, 0xd6, 0xcb, 0x8e, 0x9f, 0x73,
+	0xb4, 0x36, 0xc7, 0x07, 0x68, 0xad, 0x7b, 0x3f, 0x02, 0x08, 0x47, 0x88, 0xe4, 0x29, 0xec, 0x4e,
+	0xc4, 0x57, 0xe4, 0x23, 0xc4, 0x24, 0xf8, 0xeb, 0x27, 0x57, 0x46, 0x14, 0x48, 0xef, 0x13, 0xe4,
+	0x11, 0x80, 0x46, 0x53, 0xa0, 0x74, 0x6c, 0x8a, 0xbe, 0x99, 0x16, 0x5d, 0x23, 0xe4, 0x19, 0x74,
+	0x26, 0x88, 0x37, 0x0b, 0x8d, 0x49, 0x98, 0x06, 0x83, 0xee, 0xd9, 0xf1, 0xe6, 0x7b, 0x64, 0xa3,
+	0xda, 0xd2, 0x65, 0xac, 0xff, 0x12, 0x3a, 0x0d, 0x23, 0x31, 0x44, 0xa3, 0xcb, 0x8f, 0xc3, 0x8b,
+	0xa3, 0xff, 0x48, 0x17, 0xe0, 0x6a, 0x48, 0xf3, 0xe1, 0xbb, 0x9b, 0xf3, 0xd7, 0xc3, 0xa3, 0x80,
+	0x3c, 0x84, 0xff, 0xbd, 0xba, 0xbb, 0x7a, 0x73, 0x7b, 0x7d, 0xb7, 0xa6, 0x5a, 0xbd, 0x1c, 0x22,
+	0x7f, 0xca, 0xaa, 0x9d, 0xa2, 0x6e, 0x73, 0x91, 0x2b, 0x8e, 0xcd, 0x07, 0xde, 0x60, 0xe4, 0x18,
+	0x76, 0xd8, 0x5c, 0x95, 0xd2, 0xf9, 0xb3, 0xb7, 0x69, 0xf3, 0xd4, 0xfb, 0x19, 0x40, 0x54, 0xf7,
+	0x77, 0x02, 0xb1, 0xd2, 0x28, 0x73, 0x66, 0xd1, 0xfa, 0x11, 0x07, 0x74, 0x05, 0xaa, 0x1d, 0x2a,
+	0x66, 0xca, 0x22, 0xaf, 0x7d, 0xcb, 0xfb, 0x75, 0x44, 0x5e, 0xc0, 0x83, 0x39, 0x72, 0xc1, 0x24,
+	0x45, 0xab, 0x66, 0x65, 0xb5, 0x28, 0xd7, 0x58, 0x28, 0xc9, 0xad, 0x6f, 0x24, 0xa4, 0xff, 0xd2,
+	0xe4, 0x31, 0x1c, 0x8c, 0xcb, 0x05, 0x9a, 0x0f, 0x42, 0x52, 0xe6, 0x84, 0x4a, 0xda, 0xbe, 0xde,
+	0x4d, 0x48, 0x4e, 0xa1, 0xfb, 0x05, 0x25, 0x57, 0xab, 0x58, 0xe4, 0x63, 0x7f, 0xd0, 0xfe, 0xf7,
+	0x00, 0x0e, 0x2e, 0x84, 0xd5, 0xa5, 0xc3, 0x5b, 0xcd, 0x99, 0x43, 0x92, 0x40, 0x47, 0x19, 0x8e,
+	0xe6, 0x92, 0x37, 0xd5, 0x2c, 0x1f, 0xab, 0x7f, 0xd6, 0x6c, 0xa1, 0x4a, 0x77, 0xce, 0xb9, 0x41,
+	0xbb, 0x5c, 0xf9, 0x4d, 0x48, 0x9e, 0x40, 0xac, 0x4a, 0xa7, 0x95, 0x90, 0xae, 0xde, 0xf6, 0xbd,
+	0xb3, 0x38, 0x7b, 0xdf, 0x10, 0xba, 0x72, 0xd5, 0xe2, 0x5b, 0x34, 0x82, 0xcd, 0xc4, 0x37, 0xe4,
+	0x79, 0x73, 0x27, 0xfd, 0xdb, 0xec, 0xd3, 0x2d, 0xe6, 0x55, 0xfb, 0x53, 0x4b, 0x8f, 0xc7, 0x3b,
+	0xfe, 0xce, 0x3e, 0xff, 0x3d, 0x00, 0x1a, 0xdc, 0xcf, 0x89, 0xd7, 0x03, 0x00, 0x00,
 }
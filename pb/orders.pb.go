@@ -58,6 +58,14 @@ const (
 	// error occurred with an open connection between buyer and vendor the vendor just rejects the order on the spot neither party
 	// commits the order to the database.
 	OrderState_PROCESSING_ERROR OrderState = 14
+	// The current milestone of a milestone order has been funded and we're waiting for the vendor to fulfill it
+	OrderState_MILESTONE_FUNDED OrderState = 15
+	// Vendor has fulfilled the current milestone and we're waiting for the buyer to release it
+	OrderState_MILESTONE_FULFILLED OrderState = 16
+	// Buyer has released the current milestone and we're waiting for the next milestone to be funded
+	OrderState_MILESTONE_RELEASED OrderState = 17
+	// Vendor has refunded part of the order. The rest of the payment can still be released to the vendor.
+	OrderState_PARTIALLY_REFUNDED OrderState = 18
 )
 
 var OrderState_name = map[int32]string{
@@ -76,6 +84,10 @@ var OrderState_name = map[int32]string{
 	12: "RESOLVED",
 	13: "PAYMENT_FINALIZED",
 	14: "PROCESSING_ERROR",
+	15: "MILESTONE_FUNDED",
+	16: "MILESTONE_FULFILLED",
+	17: "MILESTONE_RELEASED",
+	18: "PARTIALLY_REFUNDED",
 }
 
 var OrderState_value = map[string]int32{
@@ -94,6 +106,10 @@ var OrderState_value = map[string]int32{
 	"RESOLVED":             12,
 	"PAYMENT_FINALIZED":    13,
 	"PROCESSING_ERROR":     14,
+	"MILESTONE_FUNDED":     15,
+	"MILESTONE_FULFILLED":  16,
+	"MILESTONE_RELEASED":   17,
+	"PARTIALLY_REFUNDED":   18,
 }
 
 func (x OrderState) String() string {
@@ -111,20 +127,23 @@ func init() {
 func init() { proto.RegisterFile("orders.proto", fileDescriptor_e0f5d4cf0fc9e41b) }
 
 var fileDescriptor_e0f5d4cf0fc9e41b = []byte{
-	// 240 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x44, 0x90, 0x4d, 0x4e, 0xc3, 0x30,
-	0x10, 0x85, 0x21, 0x94, 0xfe, 0x4c, 0x53, 0x30, 0x6e, 0x11, 0x9c, 0x81, 0x05, 0x1b, 0x4e, 0x60,
-	0x1c, 0x07, 0x8d, 0x98, 0xda, 0x96, 0x9d, 0x80, 0xda, 0x4d, 0x45, 0x45, 0xd7, 0xa9, 0x42, 0xae,
-	0xc2, 0x7d, 0xf1, 0x04, 0x68, 0x96, 0xdf, 0xfb, 0x9e, 0x25, 0xbf, 0x81, 0xbc, 0x69, 0x3f, 0x0f,
-	0xed, 0xd7, 0xe3, 0xb1, 0x6d, 0xba, 0xe6, 0xe1, 0x3b, 0x03, 0x70, 0x1c, 0xc4, 0xee, 0xa3, 0x3b,
-	0xc8, 0x39, 0x4c, 0xbc, 0xb1, 0x05, 0xda, 0x17, 0x71, 0x26, 0x57, 0x20, 0xd4, 0xbb, 0xc2, 0x2a,
-	0xd1, 0xce, 0xab, 0xcd, 0xda, 0xd8, 0x4a, 0x9c, 0xcb, 0x25, 0x5c, 0x0f, 0x29, 0xea, 0xd7, 0xda,
-	0x8b, 0x4c, 0xde, 0xc3, 0xea, 0x14, 0x96, 0x35, 0x95, 0x48, 0xd4, 0xd7, 0x2f, 0xe4, 0x1d, 0x2c,
-	0xbd, 0x0a, 0x15, 0x2a, 0xa2, 0xcd, 0xbf, 0x32, 0x85, 0x18, 0xc9, 0x05, 0xcc, 0x06, 0xbc, 0x64,
-	0xd4, 0x6e, 0xed, 0xc9, 0x54, 0x09, 0xc7, 0x32, 0x87, 0xa9, 0x56, 0x56, 0x1b, 0x96, 0x13, 0xa6,
-	0xc2, 0x68, 0x42, 0x9b, 0x68, 0xca, 0x14, 0x4c, 0x59, 0xdb, 0x22, 0xd1, 0xac, 0x77, 0x18, 0x7d,
-	0xcd, 0xef, 0x80, 0x07, 0xa4, 0x26, 0xb2, 0x9a, 0xff, 0x16, 0xa3, 0xa3, 0xb7, 0x44, 0xb9, 0xbc,
-	0x85, 0x9b, 0xbf, 0x15, 0xbb, 0x12, 0xad, 0x22, 0xdc, 0xa6, 0x78, 0xc1, 0x2b, 0x7d, 0x70, 0xda,
-	0xc4, 0xc8, 0x9f, 0x37, 0x21, 0xb8, 0x20, 0xae, 0x9e, 0x47, 0xdb, 0xec, 0xb8, 0xdf, 0x8f, 0xfb,
-	0x23, 0x3d, 0xfd, 0x04, 0x00, 0x00, 0xff, 0xff, 0xf5, 0xa4, 0x45, 0x88, 0x34, 0x01, 0x00, 0x00,
+	// 275 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xdd, 0x4e, 0x32, 0x31,
+	0x10, 0x86, 0xbf, 0x0f, 0x91, 0x9f, 0x01, 0x64, 0x28, 0xf8, 0x73, 0x0d, 0x1e, 0x78, 0xe2, 0x15,
+	0xd4, 0x76, 0x96, 0x4c, 0x2c, 0x6d, 0xd3, 0xee, 0x6a, 0xe0, 0x84, 0x48, 0xe4, 0x18, 0x82, 0x5c,
+	0xba, 0x17, 0x60, 0x66, 0x05, 0xd6, 0xc3, 0xf7, 0x79, 0x67, 0xb3, 0xef, 0x93, 0xc2, 0x70, 0x77,
+	0xf8, 0xdc, 0x1e, 0xbe, 0x9e, 0xf6, 0x87, 0xdd, 0x71, 0xf7, 0xf8, 0xdd, 0x02, 0x08, 0x02, 0xf2,
+	0xf1, 0xe3, 0xb8, 0x55, 0x03, 0xe8, 0x46, 0xf2, 0x96, 0xfd, 0x1c, 0xff, 0xa9, 0x19, 0xa0, 0x7e,
+	0xd7, 0x5c, 0xb2, 0x9f, 0xaf, 0xa3, 0x5e, 0x2e, 0xc8, 0x97, 0xf8, 0x5f, 0x4d, 0x61, 0xdc, 0x50,
+	0x36, 0xaf, 0x55, 0xc4, 0x96, 0x7a, 0x80, 0xd9, 0x05, 0x16, 0x95, 0x2b, 0xd8, 0xb9, 0xfa, 0xfc,
+	0x4a, 0xdd, 0xc3, 0x34, 0xea, 0x54, 0xb2, 0x76, 0x6e, 0x79, 0xae, 0xc8, 0x62, 0x5b, 0x8d, 0xa0,
+	0xdf, 0xc4, 0x6b, 0x89, 0x26, 0x2c, 0xa2, 0xa3, 0x92, 0x2c, 0x76, 0xd4, 0x10, 0x7a, 0x46, 0x7b,
+	0x43, 0x52, 0x76, 0x25, 0x59, 0x32, 0x8e, 0x3d, 0x59, 0xec, 0x49, 0x4a, 0x54, 0x54, 0xde, 0x92,
+	0xc5, 0x7e, 0xdd, 0x71, 0x8e, 0x95, 0x7c, 0x07, 0x22, 0x60, 0xc9, 0xb0, 0x54, 0x83, 0xdf, 0xc3,
+	0x1c, 0xdc, 0x1b, 0x59, 0x1c, 0xaa, 0x5b, 0x98, 0x9c, 0x2c, 0xd6, 0x05, 0x7b, 0xed, 0x78, 0x45,
+	0x16, 0x47, 0x62, 0x19, 0x53, 0x30, 0x94, 0xb3, 0x8c, 0xa7, 0x94, 0x42, 0xc2, 0x1b, 0xa1, 0x0b,
+	0x76, 0x94, 0xcb, 0xe0, 0x69, 0x7d, 0xfa, 0xd7, 0x58, 0x64, 0xfe, 0xd2, 0xf3, 0x7a, 0x54, 0x77,
+	0xa0, 0x9a, 0x22, 0x91, 0x23, 0x9d, 0xc9, 0xe2, 0x44, 0x78, 0x63, 0x7f, 0x19, 0xad, 0x5e, 0xda,
+	0xab, 0xd6, 0x7e, 0xb3, 0xe9, 0xd4, 0x6f, 0xf0, 0xfc, 0x33, 0x00, 0xaf, 0xef, 0xbb, 0x7b, 0x93,
+	0x01, 0x00, 0x00,
 }
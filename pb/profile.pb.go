@@ -914,15 +914,16 @@ func (m *Profile_Colors) GetHighlightText() string {
 }
 
 type Profile_Stats struct {
-	FollowerCount        uint32   `protobuf:"varint,1,opt,name=followerCount,proto3" json:"followerCount,omitempty"`
-	FollowingCount       uint32   `protobuf:"varint,2,opt,name=followingCount,proto3" json:"followingCount,omitempty"`
-	ListingCount         uint32   `protobuf:"varint,3,opt,name=listingCount,proto3" json:"listingCount,omitempty"`
-	RatingCount          uint32   `protobuf:"varint,4,opt,name=ratingCount,proto3" json:"ratingCount,omitempty"`
-	PostCount            uint32   `protobuf:"varint,5,opt,name=postCount,proto3" json:"postCount,omitempty"`
-	AverageRating        float32  `protobuf:"fixed32,6,opt,name=averageRating,proto3" json:"averageRating,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	FollowerCount        uint32                     `protobuf:"varint,1,opt,name=followerCount,proto3" json:"followerCount,omitempty"`
+	FollowingCount       uint32                     `protobuf:"varint,2,opt,name=followingCount,proto3" json:"followingCount,omitempty"`
+	ListingCount         uint32                     `protobuf:"varint,3,opt,name=listingCount,proto3" json:"listingCount,omitempty"`
+	RatingCount          uint32                     `protobuf:"varint,4,opt,name=ratingCount,proto3" json:"ratingCount,omitempty"`
+	PostCount            uint32                     `protobuf:"varint,5,opt,name=postCount,proto3" json:"postCount,omitempty"`
+	AverageRating        float32                    `protobuf:"fixed32,6,opt,name=averageRating,proto3" json:"averageRating,omitempty"`
+	RatingCriteria       []*Profile_RatingCriterion `protobuf:"bytes,6660,rep,name=ratingCriteria,proto3" json:"ratingCriteria,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
 
 func (m *Profile_Stats) Reset()         { *m = Profile_Stats{} }
@@ -992,6 +993,76 @@ func (m *Profile_Stats) GetAverageRating() float32 {
 	return 0
 }
 
+func (m *Profile_Stats) GetRatingCriteria() []*Profile_RatingCriterion {
+	if m != nil {
+		return m.RatingCriteria
+	}
+	return nil
+}
+
+type Profile_RatingCriterion struct {
+	Criterion            string   `protobuf:"bytes,1,opt,name=criterion,proto3" json:"criterion,omitempty"`
+	Count                uint32   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Average              float32  `protobuf:"fixed32,3,opt,name=average,proto3" json:"average,omitempty"`
+	Histogram            []uint32 `protobuf:"varint,4,rep,packed,name=histogram,proto3" json:"histogram,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Profile_RatingCriterion) Reset()         { *m = Profile_RatingCriterion{} }
+func (m *Profile_RatingCriterion) String() string { return proto.CompactTextString(m) }
+func (*Profile_RatingCriterion) ProtoMessage()    {}
+func (*Profile_RatingCriterion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_744bf7a47b381504, []int{0, 12}
+}
+
+func (m *Profile_RatingCriterion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Profile_RatingCriterion.Unmarshal(m, b)
+}
+func (m *Profile_RatingCriterion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Profile_RatingCriterion.Marshal(b, m, deterministic)
+}
+func (m *Profile_RatingCriterion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Profile_RatingCriterion.Merge(m, src)
+}
+func (m *Profile_RatingCriterion) XXX_Size() int {
+	return xxx_messageInfo_Profile_RatingCriterion.Size(m)
+}
+func (m *Profile_RatingCriterion) XXX_DiscardUnknown() {
+	xxx_messageInfo_Profile_RatingCriterion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Profile_RatingCriterion proto.InternalMessageInfo
+
+func (m *Profile_RatingCriterion) GetCriterion() string {
+	if m != nil {
+		return m.Criterion
+	}
+	return ""
+}
+
+func (m *Profile_RatingCriterion) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *Profile_RatingCriterion) GetAverage() float32 {
+	if m != nil {
+		return m.Average
+	}
+	return 0
+}
+
+func (m *Profile_RatingCriterion) GetHistogram() []uint32 {
+	if m != nil {
+		return m.Histogram
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Profile)(nil), "Profile")
 	proto.RegisterMapType((map[string]string)(nil), "Profile.CustomPropsEntry")
@@ -1008,86 +1079,91 @@ func init() {
 	proto.RegisterType((*Profile_Image)(nil), "Profile.Image")
 	proto.RegisterType((*Profile_Colors)(nil), "Profile.Colors")
 	proto.RegisterType((*Profile_Stats)(nil), "Profile.Stats")
+	proto.RegisterType((*Profile_RatingCriterion)(nil), "Profile.RatingCriterion")
 }
 
 func init() { proto.RegisterFile("profile.proto", fileDescriptor_744bf7a47b381504) }
 
 var fileDescriptor_744bf7a47b381504 = []byte{
-	// 1215 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x94, 0x56, 0x5f, 0x6f, 0x23, 0x35,
-	0x10, 0x57, 0xd2, 0x26, 0x4d, 0x9d, 0xa6, 0x4d, 0xcd, 0xa9, 0x2c, 0x2b, 0x04, 0xe5, 0x74, 0xc0,
-	0x81, 0x44, 0x8a, 0x7a, 0x95, 0xb8, 0x03, 0x74, 0x12, 0xd7, 0x16, 0x5d, 0x25, 0x0e, 0x55, 0xdb,
-	0xde, 0x0b, 0x2f, 0xc8, 0xd9, 0x75, 0x12, 0xeb, 0x76, 0xd7, 0x2b, 0xaf, 0xb7, 0x25, 0xe2, 0x95,
-	0xff, 0x3c, 0xf0, 0x8a, 0x04, 0x5f, 0x84, 0xcf, 0xc1, 0x07, 0xe0, 0xab, 0x60, 0x8f, 0xff, 0x64,
-	0x77, 0x5b, 0x1d, 0xe2, 0x21, 0x8a, 0xe7, 0x37, 0xbf, 0x99, 0x9d, 0x19, 0x8f, 0xc7, 0x46, 0xa3,
-	0x42, 0xf0, 0x19, 0x4b, 0xe9, 0x44, 0xfd, 0x4b, 0x1e, 0xbe, 0x39, 0xe7, 0x7c, 0x9e, 0xd2, 0x03,
-	0x90, 0xa6, 0xd5, 0xec, 0x40, 0xb2, 0x8c, 0x96, 0x92, 0x64, 0x85, 0x25, 0xec, 0x64, 0x3c, 0xa1,
-	0x82, 0x48, 0x2e, 0x2c, 0xf0, 0x2a, 0xfd, 0x46, 0xd2, 0x3c, 0xa1, 0xc9, 0xd7, 0x29, 0x8f, 0x89,
-	0x64, 0x3c, 0x37, 0x8a, 0xbb, 0x7f, 0xee, 0xa1, 0x8d, 0x73, 0xe3, 0x1c, 0xef, 0xa1, 0x7e, 0x41,
-	0xa9, 0x38, 0x3b, 0x09, 0x3a, 0xfb, 0x9d, 0xfb, 0x9b, 0x91, 0x95, 0x34, 0xbe, 0x20, 0x79, 0x92,
-	0xd2, 0xa0, 0x6b, 0x70, 0x23, 0x61, 0x8c, 0xd6, 0x73, 0x92, 0xd1, 0x60, 0x0d, 0x50, 0x58, 0xe3,
-	0x10, 0x0d, 0xdc, 0x17, 0x82, 0x75, 0xc0, 0xbd, 0x8c, 0xef, 0xa0, 0x1e, 0x99, 0xf2, 0x4a, 0x06,
-	0x3d, 0x50, 0x18, 0x01, 0xbf, 0x8f, 0xc6, 0xe5, 0x82, 0x0b, 0x79, 0x42, 0xcb, 0x58, 0xb0, 0x02,
-	0x2c, 0xfb, 0x40, 0xb8, 0x81, 0xc3, 0x17, 0xcb, 0xd9, 0x75, 0xb0, 0xa1, 0xf4, 0x83, 0x08, 0xd6,
-	0x3a, 0xba, 0x2b, 0x95, 0x1a, 0x17, 0xc1, 0x00, 0x50, 0x2b, 0xe1, 0xd7, 0xd1, 0xa6, 0xaf, 0x42,
-	0xb0, 0x09, 0xaa, 0x15, 0x80, 0x3f, 0x44, 0x23, 0x2f, 0x9c, 0xe5, 0x33, 0x1e, 0x20, 0xc5, 0x18,
-	0x1e, 0xa2, 0xc9, 0x33, 0x87, 0x46, 0x4d, 0x02, 0x3e, 0x44, 0xc3, 0x98, 0xe7, 0x92, 0xc4, 0x12,
-	0xf8, 0x43, 0xe0, 0x8f, 0x27, 0xb6, 0x78, 0x93, 0x63, 0xa3, 0x8b, 0xea, 0x24, 0xfc, 0x2e, 0xea,
-	0xc7, 0x3c, 0xe5, 0xa2, 0x0c, 0xb6, 0x80, 0xbe, 0x53, 0xa3, 0x6b, 0x38, 0xb2, 0x6a, 0xe5, 0x7c,
-	0x8b, 0x5c, 0x11, 0x49, 0xc4, 0x53, 0x52, 0x2e, 0x68, 0x19, 0x8c, 0x80, 0xbe, 0xed, 0xe9, 0x67,
-	0x19, 0x99, 0xd3, 0xa8, 0xc1, 0xd1, 0x36, 0x0b, 0x4a, 0x54, 0x88, 0xd6, 0x66, 0xfb, 0x76, 0x9b,
-	0x3a, 0x07, 0xdf, 0x43, 0x3d, 0xd5, 0x27, 0xb2, 0x0c, 0x76, 0x5a, 0xe4, 0x0b, 0x8d, 0x46, 0x46,
-	0xa9, 0x58, 0xa3, 0x29, 0x93, 0x31, 0x67, 0xf9, 0x79, 0x35, 0x7d, 0x41, 0x97, 0xc1, 0x18, 0xf6,
-	0xa3, 0x09, 0xe2, 0xc7, 0x68, 0x2b, 0x25, 0xa5, 0x54, 0x05, 0x63, 0x33, 0x46, 0x93, 0x60, 0x17,
-	0x5c, 0x86, 0x13, 0xd3, 0x9c, 0x13, 0xd7, 0x9c, 0x93, 0x4b, 0xd7, 0x9c, 0x51, 0x83, 0x8f, 0xdf,
-	0x40, 0x28, 0xae, 0x84, 0xa0, 0x79, 0xcc, 0x54, 0xf4, 0x78, 0x7f, 0x4d, 0x7d, 0xa2, 0x86, 0xe0,
-	0x03, 0x34, 0x54, 0x5d, 0xfb, 0x85, 0xeb, 0xa6, 0xef, 0x8e, 0xc0, 0xff, 0xd6, 0xe4, 0x74, 0x05,
-	0x46, 0x75, 0x06, 0x3e, 0x42, 0x68, 0x4a, 0xe2, 0x17, 0x73, 0xc1, 0xab, 0x3c, 0x09, 0xbe, 0x37,
-	0xfc, 0x57, 0x7c, 0x8a, 0x4f, 0xbc, 0x2e, 0xaa, 0xf1, 0xf0, 0x5b, 0x68, 0x68, 0x4f, 0xd7, 0xe5,
-	0xb2, 0xa0, 0xc1, 0x0f, 0x47, 0x90, 0x6b, 0x1d, 0xc3, 0x0f, 0xd0, 0x20, 0xa3, 0x92, 0x5c, 0x92,
-	0x79, 0x19, 0xfc, 0x78, 0xa4, 0x02, 0x1d, 0x1e, 0xee, 0x79, 0xb7, 0xcf, 0xac, 0xe6, 0x34, 0x97,
-	0x62, 0x19, 0x79, 0x22, 0xfe, 0x48, 0xfb, 0xa5, 0x33, 0xaa, 0xd3, 0x51, 0xf9, 0xfd, 0x64, 0xc2,
-	0xb9, 0xe3, 0xed, 0xce, 0x57, 0xca, 0xa8, 0xce, 0xc4, 0x8f, 0xd0, 0x56, 0x5c, 0x95, 0x92, 0x67,
-	0x9f, 0x33, 0x9a, 0x26, 0x65, 0xf0, 0xb3, 0xf9, 0xe2, 0xca, 0xf2, 0x78, 0xa5, 0x8d, 0x1a, 0x54,
-	0xfc, 0xa9, 0xea, 0x51, 0x90, 0x15, 0xb5, 0x28, 0x83, 0x5f, 0x8c, 0xe5, 0x6b, 0x2d, 0x4b, 0x50,
-	0x9a, 0x70, 0xeb, 0xf4, 0xf0, 0x13, 0x34, 0x6a, 0x24, 0x83, 0xc7, 0x68, 0x4d, 0xef, 0xbe, 0x99,
-	0x06, 0x7a, 0xa9, 0x8f, 0xf0, 0x15, 0x49, 0x2b, 0x37, 0x09, 0x8c, 0xf0, 0x71, 0xf7, 0x61, 0x27,
-	0x7c, 0x8c, 0xc6, 0x6d, 0xef, 0xff, 0xcb, 0xfe, 0x11, 0x1a, 0xd6, 0xf2, 0xd2, 0xc4, 0x94, 0x4c,
-	0x69, 0x6a, 0x8d, 0x8d, 0x70, 0xbb, 0x79, 0xf8, 0x57, 0x07, 0x0d, 0x6b, 0xd5, 0xc4, 0xf7, 0xd1,
-	0x8e, 0x6d, 0xa3, 0xe5, 0x09, 0x2b, 0x8b, 0x94, 0xb8, 0x10, 0xda, 0xb0, 0x9e, 0x27, 0x33, 0x46,
-	0xa4, 0x75, 0x07, 0x6b, 0xfc, 0x0e, 0xda, 0x8e, 0xc5, 0xb2, 0x90, 0xdc, 0x91, 0xed, 0x7c, 0x6b,
-	0xa1, 0x30, 0xe9, 0x48, 0x3e, 0xaf, 0xd4, 0x21, 0xf3, 0x93, 0xce, 0xca, 0x3a, 0x82, 0x8c, 0x92,
-	0xb2, 0x12, 0x34, 0xa3, 0xb9, 0x7c, 0x9e, 0x33, 0x37, 0xf3, 0xda, 0x70, 0xf8, 0xc7, 0x3a, 0x42,
-	0xab, 0xc6, 0xc4, 0xe7, 0x68, 0x4c, 0x93, 0xca, 0xf4, 0xf3, 0x53, 0xa6, 0xca, 0x21, 0x74, 0xec,
-	0x7a, 0x13, 0xef, 0xdd, 0xd2, 0xc7, 0x93, 0xd3, 0x16, 0x37, 0xba, 0x61, 0x8d, 0x2f, 0xd0, 0x2e,
-	0xcd, 0x8a, 0x94, 0x2f, 0xf5, 0x27, 0x9d, 0xcb, 0x2e, 0xb8, 0x7c, 0xfb, 0x56, 0x97, 0x6d, 0x72,
-	0x74, 0xd3, 0x3e, 0xfc, 0xad, 0x83, 0xc6, 0xed, 0x6f, 0xeb, 0x41, 0x5c, 0xc6, 0x0b, 0xce, 0xdd,
-	0x9e, 0x59, 0x09, 0xbf, 0xa7, 0xaf, 0x15, 0xc1, 0x78, 0x02, 0x65, 0x1e, 0x1e, 0xee, 0xfa, 0xcf,
-	0xc2, 0x64, 0x28, 0x48, 0x1e, 0x59, 0x82, 0x76, 0x91, 0xd0, 0xb9, 0xa0, 0xee, 0x4e, 0xb1, 0x12,
-	0xde, 0x47, 0xc3, 0xa4, 0x76, 0x3d, 0x98, 0x72, 0xd7, 0xa1, 0xf0, 0xef, 0x0e, 0xda, 0xbd, 0x11,
-	0x3a, 0x0e, 0xd0, 0x46, 0xcc, 0x33, 0xf5, 0x05, 0xd7, 0x01, 0x4e, 0x6c, 0x7b, 0xec, 0xde, 0xf0,
-	0x88, 0x3f, 0xa8, 0xdd, 0x64, 0x6b, 0xad, 0xc0, 0xfd, 0xfc, 0x69, 0x5c, 0x6e, 0x92, 0xc9, 0xd4,
-	0xf5, 0x82, 0x11, 0x74, 0x83, 0x09, 0xae, 0x40, 0xb3, 0xfb, 0xb0, 0xae, 0xd5, 0xa3, 0xff, 0x1f,
-	0xf5, 0x08, 0x67, 0x68, 0xe0, 0x30, 0x3c, 0x51, 0xbd, 0x2a, 0x78, 0x06, 0x89, 0xbc, 0x7c, 0xcc,
-	0x02, 0x4f, 0xdd, 0xab, 0x5d, 0xc9, 0x6d, 0xc9, 0x5f, 0xc6, 0x56, 0xac, 0xf0, 0x21, 0x1a, 0xf8,
-	0x29, 0xaa, 0x42, 0x8e, 0x99, 0x74, 0x05, 0x83, 0xb5, 0xa9, 0x63, 0xa5, 0xcf, 0xb4, 0xad, 0x94,
-	0x13, 0xc3, 0x5f, 0x3b, 0x68, 0xc3, 0x5e, 0x7d, 0x9a, 0x75, 0x4d, 0xa7, 0x25, 0x93, 0xd4, 0x55,
-	0xdb, 0x8a, 0xba, 0x38, 0x34, 0x23, 0x2c, 0x75, 0xe7, 0x16, 0x04, 0xbd, 0x07, 0xc5, 0x82, 0xe7,
-	0xf4, 0xcb, 0x2a, 0x9b, 0x52, 0x61, 0xb7, 0xbc, 0x0e, 0xa9, 0x9c, 0xfb, 0x25, 0x8f, 0x19, 0x49,
-	0x55, 0x55, 0x9b, 0x53, 0xf7, 0x02, 0xe0, 0xcf, 0x62, 0x88, 0x23, 0xb2, 0xac, 0xf0, 0x39, 0x1a,
-	0x35, 0x14, 0x3a, 0x19, 0xa9, 0xa7, 0xba, 0x4d, 0x46, 0xaf, 0xf5, 0xc1, 0xad, 0x4a, 0x2a, 0xe0,
-	0xe9, 0x62, 0xe2, 0xf1, 0xb2, 0x0e, 0x54, 0x95, 0x88, 0xcf, 0x6c, 0x30, 0x46, 0x08, 0xbf, 0x45,
-	0x3d, 0xb8, 0x4c, 0xc1, 0x1d, 0xf3, 0xcd, 0x04, 0x6b, 0x6d, 0x52, 0x66, 0x24, 0xf5, 0xb9, 0x81,
-	0xa0, 0x3b, 0x39, 0xa3, 0x09, 0xab, 0x32, 0xd7, 0xc9, 0x46, 0x32, 0x73, 0x4d, 0xf8, 0x91, 0x61,
-	0x04, 0x1d, 0x12, 0x17, 0x6c, 0xce, 0x72, 0x95, 0xa9, 0x69, 0x15, 0x2f, 0x87, 0xbf, 0x77, 0x50,
-	0xdf, 0xbc, 0x16, 0x74, 0x81, 0x0b, 0xc1, 0x32, 0x22, 0x7c, 0x3b, 0x5b, 0x51, 0x3f, 0x76, 0x4a,
-	0xaa, 0x5e, 0x1e, 0x09, 0xf1, 0x5b, 0xb4, 0x02, 0x20, 0x6c, 0x75, 0x51, 0xba, 0x87, 0x9a, 0x5e,
-	0x6b, 0x8b, 0x05, 0x9b, 0x2f, 0x52, 0xf5, 0x93, 0x36, 0x98, 0x15, 0xa0, 0x5f, 0x00, 0x5e, 0xb8,
-	0xd4, 0xa6, 0x26, 0xaa, 0x26, 0x18, 0xfe, 0xd3, 0x41, 0xbd, 0x0b, 0xf7, 0x62, 0x98, 0xf1, 0x34,
-	0xe5, 0xd7, 0x54, 0x1c, 0xeb, 0xc2, 0x43, 0x7c, 0xa3, 0xa8, 0x09, 0xea, 0xd1, 0x6a, 0x00, 0x96,
-	0xcf, 0x0d, 0xad, 0x0b, 0xb4, 0x16, 0x8a, 0xef, 0xaa, 0x97, 0x85, 0x3a, 0xc1, 0x9e, 0xb5, 0x06,
-	0xac, 0x06, 0xa6, 0x9b, 0x47, 0xbd, 0xcd, 0x3c, 0x65, 0x1d, 0x28, 0x75, 0x48, 0x67, 0x58, 0xf0,
-	0x52, 0x1a, 0x7d, 0x0f, 0xf4, 0x2b, 0x40, 0x47, 0x4c, 0xae, 0xd4, 0xf3, 0x4e, 0x3d, 0x91, 0xc0,
-	0x06, 0x0e, 0x63, 0x37, 0x6a, 0x82, 0x4f, 0xd6, 0xbf, 0xea, 0x16, 0xd3, 0x69, 0x1f, 0x8e, 0xcd,
-	0x83, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x71, 0x50, 0x57, 0xf0, 0x87, 0x0b, 0x00, 0x00,
+	// 1270 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4b, 0x6f, 0x1c, 0x45,
+	0x10, 0xd6, 0xae, 0xd7, 0xaf, 0x5a, 0xaf, 0xbd, 0x6e, 0xa2, 0xa4, 0x19, 0x21, 0x30, 0x51, 0x00,
+	0x13, 0x89, 0x0d, 0x72, 0x2c, 0x91, 0x00, 0x8a, 0x94, 0x17, 0x4a, 0x24, 0x82, 0xac, 0xb1, 0x73,
+	0xe1, 0x82, 0x7a, 0x67, 0x6a, 0x67, 0x5b, 0x99, 0x99, 0x1e, 0x75, 0xf7, 0x38, 0x59, 0x21, 0x6e,
+	0xbc, 0x39, 0x70, 0x45, 0xe2, 0xc6, 0xbf, 0xe0, 0x77, 0x70, 0xe7, 0xb7, 0xa0, 0x7e, 0xcd, 0xce,
+	0x8e, 0x2d, 0x45, 0xdc, 0xfa, 0xfb, 0xea, 0xab, 0x9a, 0xea, 0xea, 0xea, 0x9a, 0x86, 0x51, 0x25,
+	0xc5, 0x8c, 0xe7, 0x38, 0xa9, 0xa4, 0xd0, 0x22, 0x7a, 0x27, 0x13, 0x22, 0xcb, 0xf1, 0x96, 0x45,
+	0xd3, 0x7a, 0x76, 0x4b, 0xf3, 0x02, 0x95, 0x66, 0x45, 0xe5, 0x05, 0x7b, 0x85, 0x48, 0x51, 0x32,
+	0x2d, 0xa4, 0x27, 0xae, 0xe1, 0x2b, 0x8d, 0x65, 0x8a, 0xe9, 0x37, 0xb9, 0x48, 0x98, 0xe6, 0xa2,
+	0x74, 0x86, 0xeb, 0xff, 0x5e, 0x83, 0xcd, 0x13, 0x17, 0x9c, 0x5c, 0x85, 0x8d, 0x0a, 0x51, 0x3e,
+	0x7d, 0x44, 0x7b, 0x07, 0xbd, 0xc3, 0xed, 0xd8, 0x23, 0xc3, 0xcf, 0x59, 0x99, 0xe6, 0x48, 0xfb,
+	0x8e, 0x77, 0x88, 0x10, 0x18, 0x94, 0xac, 0x40, 0xba, 0x66, 0x59, 0xbb, 0x26, 0x11, 0x6c, 0x85,
+	0x2f, 0xd0, 0x81, 0xe5, 0x1b, 0x4c, 0xae, 0xc0, 0x3a, 0x9b, 0x8a, 0x5a, 0xd3, 0x75, 0x6b, 0x70,
+	0x80, 0xdc, 0x84, 0xb1, 0x9a, 0x0b, 0xa9, 0x1f, 0xa1, 0x4a, 0x24, 0xaf, 0xac, 0xe7, 0x86, 0x15,
+	0x5c, 0xe0, 0xed, 0x17, 0xd5, 0xec, 0x25, 0xdd, 0x3c, 0xe8, 0x1d, 0x6e, 0xc5, 0x76, 0x6d, 0xb2,
+	0x3b, 0xc7, 0x32, 0x15, 0x92, 0x6e, 0x59, 0xd6, 0x23, 0xf2, 0x16, 0x6c, 0x37, 0x55, 0xa0, 0xdb,
+	0xd6, 0xb4, 0x24, 0xc8, 0xc7, 0x30, 0x6a, 0xc0, 0xd3, 0x72, 0x26, 0x28, 0x1c, 0xf4, 0x0e, 0x87,
+	0x47, 0x30, 0x79, 0x16, 0xd8, 0x78, 0x55, 0x40, 0x8e, 0x60, 0x98, 0x88, 0x52, 0xb3, 0x44, 0x5b,
+	0xfd, 0xd0, 0xea, 0xc7, 0x13, 0x5f, 0xbc, 0xc9, 0x43, 0x67, 0x8b, 0xdb, 0x22, 0xf2, 0x01, 0x6c,
+	0x24, 0x22, 0x17, 0x52, 0xd1, 0x1d, 0x2b, 0xdf, 0x6b, 0xc9, 0x0d, 0x1d, 0x7b, 0x33, 0x39, 0x82,
+	0x1d, 0x76, 0xce, 0x34, 0x93, 0x4f, 0x98, 0x9a, 0xa3, 0xa2, 0x23, 0x2b, 0xdf, 0x6d, 0xe4, 0x4f,
+	0x0b, 0x96, 0x61, 0xbc, 0xa2, 0x31, 0x3e, 0x73, 0x64, 0x29, 0x06, 0x9f, 0xdd, 0xcb, 0x7d, 0xda,
+	0x1a, 0x72, 0x03, 0xd6, 0x95, 0x66, 0x5a, 0xd1, 0xbd, 0x8e, 0xf8, 0xd4, 0xb0, 0xb1, 0x33, 0x92,
+	0x1b, 0x30, 0x9a, 0x72, 0x9d, 0x08, 0x5e, 0x9e, 0xd4, 0xd3, 0x17, 0xb8, 0xa0, 0x63, 0x7b, 0x1e,
+	0xab, 0x24, 0xb9, 0x07, 0x3b, 0x39, 0x53, 0xfa, 0x99, 0x48, 0xf9, 0x8c, 0x63, 0x4a, 0xf7, 0x6d,
+	0xc8, 0x68, 0xe2, 0x9a, 0x73, 0x12, 0x9a, 0x73, 0x72, 0x16, 0x9a, 0x33, 0x5e, 0xd1, 0x93, 0xb7,
+	0x01, 0x92, 0x5a, 0x4a, 0x2c, 0x13, 0x8e, 0x8a, 0x92, 0x83, 0xb5, 0xc3, 0xed, 0xb8, 0xc5, 0x90,
+	0x5b, 0x30, 0xc4, 0x57, 0xfa, 0xcb, 0xd0, 0x4d, 0xdf, 0x1f, 0xdb, 0xf8, 0x3b, 0x93, 0xc7, 0x4b,
+	0x32, 0x6e, 0x2b, 0xc8, 0x31, 0xc0, 0x94, 0x25, 0x2f, 0x32, 0x29, 0xea, 0x32, 0xa5, 0x3f, 0x38,
+	0xfd, 0x1b, 0xcd, 0x16, 0x1f, 0x34, 0xb6, 0xb8, 0xa5, 0x23, 0xef, 0xc2, 0xd0, 0xdf, 0xae, 0xb3,
+	0x45, 0x85, 0xf4, 0xc7, 0x63, 0xbb, 0xd7, 0x36, 0x47, 0x6e, 0xc3, 0x56, 0x81, 0x9a, 0x9d, 0xb1,
+	0x4c, 0xd1, 0x9f, 0x8e, 0x0f, 0xd6, 0x0e, 0x87, 0x47, 0x57, 0x9b, 0xb0, 0xcf, 0xbc, 0xe5, 0x71,
+	0xa9, 0xe5, 0x22, 0x6e, 0x84, 0xe4, 0x13, 0x13, 0x17, 0x67, 0x68, 0xb6, 0x83, 0x8a, 0xfe, 0xec,
+	0xd2, 0xb9, 0xd2, 0xf8, 0x9d, 0x2c, 0x8d, 0x71, 0x5b, 0x49, 0xee, 0xc2, 0x4e, 0x52, 0x2b, 0x2d,
+	0x8a, 0x2f, 0x38, 0xe6, 0xa9, 0xa2, 0xbf, 0xb8, 0x2f, 0x2e, 0x3d, 0x1f, 0x2e, 0xad, 0xf1, 0x8a,
+	0x94, 0x7c, 0x0e, 0x43, 0x87, 0x4f, 0xa4, 0xa8, 0x14, 0xfd, 0xd5, 0x79, 0xbe, 0xd9, 0xf1, 0xb4,
+	0x46, 0x97, 0x6e, 0x5b, 0x1e, 0x7d, 0x06, 0xa3, 0x95, 0xcd, 0x90, 0x31, 0xac, 0x99, 0xd3, 0x77,
+	0xd3, 0xc0, 0x2c, 0xcd, 0x15, 0x3e, 0x67, 0x79, 0x1d, 0x26, 0x81, 0x03, 0x9f, 0xf6, 0xef, 0xf4,
+	0xa2, 0x7b, 0x30, 0xee, 0x46, 0xff, 0x5f, 0xfe, 0x77, 0x61, 0xd8, 0xda, 0x97, 0x11, 0xe6, 0x6c,
+	0x8a, 0xb9, 0x77, 0x76, 0xe0, 0x72, 0xf7, 0xe8, 0xef, 0x1e, 0x0c, 0x5b, 0xd5, 0x24, 0x87, 0xb0,
+	0xe7, 0xdb, 0x68, 0xf1, 0x88, 0xab, 0x2a, 0x67, 0x21, 0x85, 0x2e, 0x6d, 0xe6, 0xc9, 0x8c, 0x33,
+	0xed, 0xc3, 0xd9, 0x35, 0x79, 0x1f, 0x76, 0x13, 0xb9, 0xa8, 0xb4, 0x08, 0x62, 0x3f, 0xdf, 0x3a,
+	0xac, 0x9d, 0x74, 0xac, 0xcc, 0x6a, 0x96, 0x61, 0x33, 0xe9, 0x3c, 0x36, 0x19, 0x14, 0xc8, 0x54,
+	0x2d, 0xb1, 0xc0, 0x52, 0x3f, 0x2f, 0x79, 0x98, 0x79, 0x5d, 0x3a, 0xfa, 0x73, 0x00, 0xb0, 0x6c,
+	0x4c, 0x72, 0x02, 0x63, 0x4c, 0x6b, 0xd7, 0xcf, 0x4f, 0xb8, 0xd2, 0x42, 0x9a, 0xdc, 0xcd, 0x21,
+	0xde, 0xb8, 0xa4, 0x8f, 0x27, 0x8f, 0x3b, 0xda, 0xf8, 0x82, 0x37, 0x39, 0x85, 0x7d, 0x2c, 0xaa,
+	0x5c, 0x2c, 0xcc, 0x27, 0x43, 0xc8, 0xbe, 0x0d, 0xf9, 0xde, 0xa5, 0x21, 0xbb, 0xe2, 0xf8, 0xa2,
+	0x7f, 0xf4, 0x7b, 0x0f, 0xc6, 0xdd, 0x6f, 0x9b, 0x41, 0xac, 0x92, 0xb9, 0x10, 0xe1, 0xcc, 0x3c,
+	0x22, 0x1f, 0x9a, 0xdf, 0x8a, 0xe4, 0x22, 0xb5, 0x65, 0x1e, 0x1e, 0xed, 0x37, 0x9f, 0xb5, 0x93,
+	0xa1, 0x62, 0x65, 0xec, 0x05, 0x26, 0x44, 0x8a, 0x99, 0xc4, 0xf0, 0x4f, 0xf1, 0x88, 0x1c, 0xc0,
+	0x30, 0x6d, 0xfd, 0x1e, 0x5c, 0xb9, 0xdb, 0x54, 0xf4, 0x4f, 0x0f, 0xf6, 0x2f, 0xa4, 0x4e, 0x28,
+	0x6c, 0x26, 0xa2, 0xa8, 0x58, 0x19, 0x3a, 0x20, 0xc0, 0x6e, 0xc4, 0xfe, 0x85, 0x88, 0xe4, 0xa3,
+	0xd6, 0x9f, 0x6c, 0xad, 0x93, 0x78, 0x33, 0x7f, 0x56, 0x7e, 0x6e, 0x9a, 0xeb, 0x3c, 0xf4, 0x82,
+	0x03, 0xa6, 0xc1, 0xa4, 0xc8, 0xd1, 0x9f, 0xbe, 0x5d, 0xb7, 0xea, 0xb1, 0xf1, 0x9a, 0x7a, 0x44,
+	0x33, 0xd8, 0x0a, 0x1c, 0x99, 0xc0, 0x60, 0x26, 0x45, 0x41, 0x7b, 0xaf, 0x1d, 0xb3, 0x56, 0x47,
+	0x6e, 0x42, 0x5f, 0x0b, 0xda, 0x7f, 0xad, 0xba, 0xaf, 0x45, 0x74, 0x07, 0xb6, 0x9a, 0x29, 0x4a,
+	0x60, 0x90, 0x70, 0x1d, 0x0a, 0x66, 0xd7, 0xae, 0x8e, 0xb5, 0xb9, 0xd3, 0xbe, 0x52, 0x01, 0x46,
+	0xbf, 0xf5, 0x60, 0xd3, 0xff, 0xfa, 0x8c, 0xea, 0x25, 0x4e, 0x15, 0xd7, 0x18, 0xaa, 0xed, 0xa1,
+	0x29, 0x0e, 0x16, 0x8c, 0xe7, 0xe1, 0xde, 0x5a, 0x60, 0xce, 0xa0, 0x9a, 0x8b, 0x12, 0xbf, 0xaa,
+	0x8b, 0x29, 0x4a, 0x7f, 0xe4, 0x6d, 0x8a, 0x4c, 0x60, 0x43, 0x89, 0x84, 0xb3, 0x9c, 0x0e, 0x3a,
+	0x53, 0xf7, 0xd4, 0xd2, 0xf7, 0x13, 0x9b, 0x47, 0xec, 0x55, 0xd1, 0x73, 0x18, 0xad, 0x18, 0xcc,
+	0x66, 0xf4, 0xa2, 0x0a, 0xf9, 0xd8, 0xb5, 0xb9, 0xb8, 0xb5, 0x42, 0x69, 0x9f, 0x2e, 0x2e, 0x9f,
+	0x06, 0x9b, 0x44, 0x2b, 0x29, 0xc4, 0xcc, 0x27, 0xe3, 0x40, 0xf4, 0x2d, 0xac, 0xdb, 0x9f, 0xa9,
+	0x0d, 0xc7, 0x9b, 0x66, 0xb2, 0x6b, 0xe3, 0xa2, 0x0a, 0x96, 0x37, 0x7b, 0xb3, 0xc0, 0x74, 0x72,
+	0x81, 0x29, 0xaf, 0x8b, 0xd0, 0xc9, 0x0e, 0xb9, 0xb9, 0x26, 0x9b, 0x91, 0xe1, 0x80, 0x49, 0x49,
+	0x48, 0x9e, 0xf1, 0x92, 0xe5, 0xbe, 0x55, 0x1a, 0x1c, 0xfd, 0xd1, 0x83, 0x0d, 0xf7, 0x5a, 0x30,
+	0x05, 0xae, 0x24, 0x2f, 0x98, 0x6c, 0xda, 0xd9, 0x43, 0xf3, 0xd8, 0x51, 0x98, 0x88, 0x32, 0x65,
+	0xcd, 0x11, 0x2d, 0x09, 0x9b, 0x36, 0xbe, 0xd2, 0xe1, 0xa1, 0x66, 0xd6, 0xc6, 0x63, 0xce, 0xb3,
+	0x79, 0xce, 0xb3, 0xb9, 0xf6, 0xc9, 0x2c, 0x09, 0xf3, 0x02, 0x68, 0xc0, 0x99, 0x71, 0x75, 0x59,
+	0xad, 0x92, 0xd1, 0x5f, 0x7d, 0x58, 0x3f, 0x0d, 0x2f, 0x86, 0x99, 0xc8, 0x73, 0xf1, 0x12, 0xe5,
+	0x43, 0x53, 0x78, 0x9b, 0xdf, 0x28, 0x5e, 0x25, 0xcd, 0x68, 0x75, 0x04, 0x2f, 0x33, 0x27, 0xeb,
+	0x5b, 0x59, 0x87, 0x25, 0xd7, 0x61, 0x27, 0xe7, 0x4a, 0x37, 0xaa, 0x35, 0xab, 0x5a, 0xe1, 0x4c,
+	0xf3, 0x48, 0xb6, 0x94, 0x0c, 0xac, 0xa4, 0x4d, 0x99, 0x1d, 0x56, 0x42, 0x69, 0x67, 0x5f, 0xb7,
+	0xf6, 0x25, 0x61, 0x32, 0x66, 0xe7, 0x28, 0xcd, 0x13, 0xc9, 0xfa, 0xd8, 0xcb, 0xd8, 0x8f, 0x57,
+	0x49, 0x72, 0x1f, 0x76, 0x7d, 0x48, 0xc9, 0x35, 0x4a, 0xce, 0xcc, 0x33, 0xc4, 0x74, 0x22, 0x6d,
+	0x3a, 0x31, 0x6e, 0xdb, 0x45, 0x19, 0x77, 0x1c, 0xa2, 0xef, 0x60, 0xaf, 0x23, 0x31, 0x99, 0x25,
+	0x01, 0xf8, 0x93, 0x5c, 0x12, 0xa6, 0x45, 0x92, 0x56, 0x71, 0x1c, 0x30, 0x67, 0xef, 0x53, 0xb3,
+	0xe5, 0xe8, 0xc7, 0x01, 0xba, 0x93, 0x54, 0x5a, 0x64, 0x92, 0x15, 0xf6, 0x9e, 0x8c, 0xe2, 0x25,
+	0xf1, 0x60, 0xf0, 0x75, 0xbf, 0x9a, 0x4e, 0x37, 0xec, 0xc5, 0xbf, 0xfd, 0xdf, 0x00, 0x5e, 0xf8,
+	0xb6, 0x49, 0x49, 0x0c, 0x00, 0x00,
 }
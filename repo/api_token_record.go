@@ -0,0 +1,105 @@
+package repo
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// APITokenPrefix marks the API tokens issued by the node
+const APITokenPrefix = "kmz_"
+
+// APITokenScope grants an API token access to a group of endpoints
+type APITokenScope string
+
+const (
+	// APITokenScopeReadOnly allows the GET endpoints which do not expose secrets
+	APITokenScopeReadOnly APITokenScope = "read-only"
+	// APITokenScopeListingsWrite allows managing listings, inventory, images and posts
+	APITokenScopeListingsWrite APITokenScope = "listings-write"
+	// APITokenScopeOrders allows purchasing and moving orders through their states
+	APITokenScopeOrders APITokenScope = "orders"
+	// APITokenScopeWalletSpend allows spending from the wallets
+	APITokenScopeWalletSpend APITokenScope = "wallet-spend"
+	// APITokenScopeModerator allows acting as a moderator
+	APITokenScopeModerator APITokenScope = "moderator"
+)
+
+// APITokenScopes lists every scope a token can be issued with
+var APITokenScopes = []APITokenScope{
+	APITokenScopeReadOnly,
+	APITokenScopeListingsWrite,
+	APITokenScopeOrders,
+	APITokenScopeWalletSpend,
+	APITokenScopeModerator,
+}
+
+func (s APITokenScope) String() string { return string(s) }
+
+// Valid reports whether the scope is one of APITokenScopes
+func (s APITokenScope) Valid() bool {
+	for _, scope := range APITokenScopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// APITokenRecord represents a one-to-one relationship with records in the
+// apitokens table. Only the hash of the token is stored, the token itself is
+// shown once when it is issued.
+type APITokenRecord struct {
+	TokenID string
+	Name    string
+	Hash    string
+	Scopes  []APITokenScope
+	// Expires is zero for tokens which do not expire
+	Expires  time.Time
+	Created  time.Time
+	LastUsed time.Time
+}
+
+// HasScope reports whether the token was issued with the scope
+func (r *APITokenRecord) HasScope(scope APITokenScope) bool {
+	for _, s := range r.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// Expired reports whether the token is no longer valid at the given time
+func (r *APITokenRecord) Expired(t time.Time) bool {
+	return !r.Expires.IsZero() && !t.Before(r.Expires)
+}
+
+// HashAPIToken returns the hash an API token is stored and looked up by
+func HashAPIToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
+
+// NewAPIToken generates a token with the given name, scopes and expiry. The
+// returned token has to be handed to the user, the record only holds its hash.
+func NewAPIToken(name string, scopes []APITokenScope, expires time.Time) (string, *APITokenRecord, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", nil, err
+	}
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return "", nil, err
+	}
+	token := APITokenPrefix + hex.EncodeToString(secret)
+	return token, &APITokenRecord{
+		TokenID: hex.EncodeToString(id),
+		Name:    name,
+		Hash:    HashAPIToken(token),
+		Scopes:  scopes,
+		Expires: expires,
+		Created: time.Now(),
+	}, nil
+}
@@ -0,0 +1,28 @@
+package repo
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrAppointmentConflict - the vendor already has an appointment overlapping the slot
+var ErrAppointmentConflict = errors.New("the selected time slot is already booked")
+
+// AppointmentRecord represents a one-to-one relationship with records in the
+// appointments table. Sales hold the slots booked with us and purchases the
+// slots we booked with vendors.
+type AppointmentRecord struct {
+	OrderID  string
+	Slug     string
+	Title    string
+	PeerID   string
+	IsSale   bool
+	Start    time.Time
+	End      time.Time
+	Timezone string
+}
+
+// Overlaps returns whether the appointment shares any time with [start, end)
+func (r *AppointmentRecord) Overlaps(start, end time.Time) bool {
+	return r.Start.Before(end) && start.Before(r.End)
+}
@@ -0,0 +1,53 @@
+package repo
+
+import (
+	"time"
+)
+
+// AuditSource is where an audited action came from
+type AuditSource string
+
+const (
+	// AuditSourceAPI is a state-changing call to the JSON API
+	AuditSourceAPI AuditSource = "api"
+	// AuditSourceProtocol is an order lifecycle message sent to a peer
+	AuditSourceProtocol AuditSource = "protocol"
+)
+
+func (s AuditSource) String() string { return string(s) }
+
+// AuditRecord represents a one-to-one relationship with records in the
+// auditlog table. Records are only ever appended, the table refuses updates
+// and deletes.
+type AuditRecord struct {
+	AuditID   int64
+	Timestamp time.Time
+	Source    AuditSource
+	// Actor is who made the call: token:<tokenID>, user:<username>, cookie,
+	// local or anonymous for API calls and node for protocol messages
+	Actor      string
+	RemoteAddr string
+	// Action is the method and path of an API call or the message type of
+	// a protocol message
+	Action string
+	// Target is the peer a protocol message was sent to
+	Target string
+	// ParamsHash is the hex encoded SHA256 hash of the request or message,
+	// enough to match an entry to a request without storing its contents
+	ParamsHash string
+	Success    bool
+	Result     string
+}
+
+// AuditQuery selects audit records. Empty fields match every record and a
+// limit of zero returns all matching records.
+type AuditQuery struct {
+	Source AuditSource
+	Actor  string
+	// Action matches records whose action starts with it
+	Action string
+	Since  time.Time
+	Until  time.Time
+	Offset int
+	Limit  int
+}
@@ -0,0 +1,17 @@
+package repo
+
+// CaseStats aggregates the disputes a moderator has taken on. Win ratios are
+// the share of closed cases resolved in favour of each party; cases split
+// evenly count for neither. Fees are in the smallest unit of each coin.
+type CaseStats struct {
+	Open                    int               `json:"open"`
+	Unread                  int               `json:"unread"`
+	Closed                  int               `json:"closed"`
+	MedianResolutionSeconds int64             `json:"medianResolutionSeconds"`
+	BuyerWins               int               `json:"buyerWins"`
+	VendorWins              int               `json:"vendorWins"`
+	Splits                  int               `json:"splits"`
+	BuyerWinRatio           float64           `json:"buyerWinRatio"`
+	VendorWinRatio          float64           `json:"vendorWinRatio"`
+	FeesEarned              map[string]uint64 `json:"feesEarned"`
+}
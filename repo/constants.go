@@ -6,33 +6,50 @@ const (
 	// Number of hours after dispute begins before it is resolved automatically
 	DisputeTotalDurationHours int = 45 * 24
 
+	NotifierTypeAPICredentialsChanged         NotificationType = "apiCredentialsChanged"
 	NotifierTypeBuyerDisputeTimeout           NotificationType = "buyerDisputeTimeout"
 	NotifierTypeBuyerDisputeExpiry            NotificationType = "buyerDisputeExpiry"
+	NotifierTypeCaseMessageNotification       NotificationType = "caseMessage"
 	NotifierTypeChatMessage                   NotificationType = "chatMessage"
 	NotifierTypeChatRead                      NotificationType = "chatRead"
 	NotifierTypeChatTyping                    NotificationType = "chatTyping"
 	NotifierTypeCompletionNotification        NotificationType = "orderComplete"
 	NotifierTypeDisputeAcceptedNotification   NotificationType = "disputeAccepted"
 	NotifierTypeDisputeCloseNotification      NotificationType = "disputeClose"
+	NotifierTypeDisputeEvidenceNotification   NotificationType = "disputeEvidence"
 	NotifierTypeDisputeOpenNotification       NotificationType = "disputeOpen"
+	NotifierTypeDisputePanelVoteNotification  NotificationType = "disputePanelVote"
 	NotifierTypeDisputeUpdateNotification     NotificationType = "disputeUpdate"
 	NotifierTypeFindModeratorResponse         NotificationType = "findModeratorResponse"
 	NotifierTypeFollowNotification            NotificationType = "follow"
 	NotifierTypeFulfillmentNotification       NotificationType = "fulfillment"
 	NotifierTypeIncomingTransaction           NotificationType = "incomingTransaction"
+	NotifierTypeMilestoneReleasedNotification NotificationType = "milestoneReleased"
 	NotifierTypeModeratorAddNotification      NotificationType = "moderatorAdd"
 	NotifierTypeModeratorDisputeExpiry        NotificationType = "moderatorDisputeExpiry"
 	NotifierTypeModeratorRemoveNotification   NotificationType = "moderatorRemove"
+	NotifierTypeOrderAmendmentNotification    NotificationType = "orderAmendment"
+	NotifierTypeOrderAmendmentResponse        NotificationType = "orderAmendmentResponse"
 	NotifierTypeOrderCancelNotification       NotificationType = "cancel"
 	NotifierTypeOrderConfirmationNotification NotificationType = "orderConfirmation"
 	NotifierTypeOrderDeclinedNotification     NotificationType = "orderDeclined"
 	NotifierTypeOrderNewNotification          NotificationType = "order"
+	NotifierTypePartialRefundNotification     NotificationType = "partialRefund"
 	NotifierTypePaymentNotification           NotificationType = "payment"
 	NotifierTypePremarshalledNotifier         NotificationType = "premarshalledNotifier"
 	NotifierTypeProcessingErrorNotification   NotificationType = "processingError"
+	NotifierTypeQuoteNotification             NotificationType = "quote"
+	NotifierTypeQuoteRequestNotification      NotificationType = "quoteRequest"
 	NotifierTypeRefundNotification            NotificationType = "refund"
 	NotifierTypeStatusUpdateNotification      NotificationType = "statusUpdate"
+	NotifierTypeSubscriptionCanceled          NotificationType = "subscriptionCanceled"
+	NotifierTypeSubscriptionPaused            NotificationType = "subscriptionPaused"
+	NotifierTypeSubscriptionRenewalFailed     NotificationType = "subscriptionRenewalFailed"
+	NotifierTypeSubscriptionRenewalReminder   NotificationType = "subscriptionRenewalReminder"
+	NotifierTypeSubscriptionResumed           NotificationType = "subscriptionResumed"
 	NotifierTypeTestNotification              NotificationType = "testNotification"
+	NotifierTypeTimesheetEntryNotification    NotificationType = "timesheetEntry"
+	NotifierTypeTimesheetReviewNotification   NotificationType = "timesheetReview"
 	NotifierTypeUnfollowNotification          NotificationType = "unfollow"
 	NotifierTypeVendorDisputeTimeout          NotificationType = "vendorDisputeTimeout"
 	NotifierTypeVendorFinalizedPayment        NotificationType = "vendorFinalizedPayment"
@@ -29,6 +29,17 @@ type Datastore interface {
 	TxMetadata() TransactionMetadataStore
 	ModeratedStores() ModeratedStore
 	Messages() MessageStore
+	Subscriptions() SubscriptionStore
+	Locations() LocationStore
+	Search() SearchStore
+	NotificationDeliveries() NotificationDeliveryStore
+	APITokens() APITokenStore
+	AuditLog() AuditStore
+	ExchangeRates() ExchangeRateStore
+	Invoices() InvoiceStore
+	Quotes() QuoteStore
+	Appointments() AppointmentStore
+	PanelVotes() PanelVoteStore
 	Ping() error
 	Close()
 }
@@ -314,6 +325,15 @@ type CaseStore interface {
 
 	// UpdateDisputesLastDisputeExpiryNotifiedAt accepts []*DisputeCaseRecord and updates each records lastDisputeExpiryNotifiedAt by its CaseID
 	UpdateDisputesLastDisputeExpiryNotifiedAt([]*DisputeCaseRecord) error
+
+	// Save a signed message posted to a thread of the case. Returns ErrDuplicateCaseMessage if the message is already saved.
+	PutMessage(caseID string, message *pb.SignedCaseMessage) error
+
+	// Return the messages posted to the case in the order they were sent
+	GetMessages(caseID string) ([]*pb.SignedCaseMessage, error)
+
+	// GetStats aggregates every case in the database. Fees are those paid to moderatorID.
+	GetStats(moderatorID string) (*CaseStats, error)
 }
 
 type ChatStore interface {
@@ -443,3 +463,186 @@ type MessageStore interface {
 	// GetByOrderIDType returns the message for specified order and type
 	GetByOrderIDType(orderID string, mType pb.Message_MessageType) (*Message, string, error)
 }
+
+// SubscriptionStore is the subscriptions table interface
+type SubscriptionStore interface {
+	Queryable
+
+	// Put a subscription record to the database, replacing any existing record with the same ID
+	Put(record *SubscriptionRecord) error
+
+	// Get the subscription record with the given ID
+	Get(subscriptionID string) (*SubscriptionRecord, error)
+
+	// GetAll returns all subscription records. The stateFilter argument can be used to
+	// return only subscriptions in the given states.
+	GetAll(stateFilter []pb.Subscription_State) ([]*SubscriptionRecord, error)
+
+	// GetDueBefore returns the active subscriptions we are buying which renew before the given time
+	GetDueBefore(t time.Time) ([]*SubscriptionRecord, error)
+}
+
+// LocationStore is the spatial index over listing and profile coordinates
+type LocationStore interface {
+	Queryable
+
+	// Put a location record to the database, replacing any existing record for the same peerID and slug
+	Put(record *LocationRecord) error
+
+	// Delete the location record for the given peerID and slug
+	Delete(peerID, slug string) error
+
+	// GetWithin returns the location records inside the given bounding box. West may be
+	// greater than east when the box crosses the antimeridian. The typeFilter argument
+	// can be used to return only records of the given types.
+	GetWithin(south, west, north, east float64, typeFilter []LocationType) ([]*LocationRecord, error)
+}
+
+// SearchStore is the full text index over listings, posts and chat messages
+type SearchStore interface {
+	Queryable
+
+	// Put a document into the index, replacing any existing document with the same type and ID
+	Put(doc *SearchDocument) error
+
+	// Delete the document with the given type and ID from the index
+	Delete(docType SearchDocumentType, id string) error
+
+	// Search returns one page of the documents matching the query, most relevant
+	// first, along with the total number of matching documents
+	Search(query SearchQuery) ([]*SearchResult, int, error)
+}
+
+// NotificationDeliveryStore is the durable queue of outbound notification deliveries
+type NotificationDeliveryStore interface {
+	Queryable
+
+	// Put a delivery record to the database, replacing any existing record with the same ID
+	Put(record *NotificationDeliveryRecord) error
+
+	// Get the delivery record with the given ID
+	Get(deliveryID string) (*NotificationDeliveryRecord, error)
+
+	// GetAll returns the delivery records, most recent first. The stateFilter and
+	// channel arguments can be used to return only the matching records. A limit
+	// of zero returns all records.
+	GetAll(stateFilter []NotificationDeliveryState, channel string, offset, limit int) ([]*NotificationDeliveryRecord, error)
+
+	// GetDue returns the pending deliveries whose next attempt is at or before the given time
+	GetDue(t time.Time) ([]*NotificationDeliveryRecord, error)
+
+	// Delete the delivery record with the given ID
+	Delete(deliveryID string) error
+
+	// DeleteDeliveredBefore removes the delivered records last updated before the given time
+	DeleteDeliveredBefore(t time.Time) error
+}
+
+type AuditStore interface {
+	Queryable
+
+	// Put appends a record to the audit log and sets its AuditID
+	Put(record *AuditRecord) error
+
+	// GetAll returns the records matching the query, most recent first
+	GetAll(query AuditQuery) ([]*AuditRecord, error)
+
+	// Count returns the number of records matching the query, ignoring its
+	// offset and limit
+	Count(query AuditQuery) (int, error)
+}
+
+type ExchangeRateStore interface {
+	Queryable
+
+	// Put saves a snapshot and sets its SnapshotID
+	Put(snapshot *ExchangeRateSnapshot) error
+
+	// GetAll returns the snapshots matching the query, most recent first
+	GetAll(query ExchangeRateQuery) ([]*ExchangeRateSnapshot, error)
+
+	// GetAt returns the most recent snapshot of the coin taken at or before
+	// the given time
+	GetAt(coin string, t time.Time) (*ExchangeRateSnapshot, error)
+}
+
+type InvoiceStore interface {
+	Queryable
+
+	// Put inserts or updates the invoice of an order
+	Put(record *InvoiceRecord) error
+
+	// NextInvoiceNumber returns the number following the highest invoice
+	// number issued so far, starting at 1
+	NextInvoiceNumber() (uint64, error)
+
+	// Get returns the invoice of an order
+	Get(orderID string) (*InvoiceRecord, error)
+}
+
+// QuoteStore is the quotes table interface
+type QuoteStore interface {
+	Queryable
+
+	// Put a quote record to the database, replacing any existing record with the same request ID
+	Put(record *QuoteRecord) error
+
+	// Get the quote record with the given request ID
+	Get(requestID string) (*QuoteRecord, error)
+
+	// GetAll returns all quote records, most recently updated first
+	GetAll() ([]*QuoteRecord, error)
+}
+
+// AppointmentStore is the appointments table interface
+type AppointmentStore interface {
+	Queryable
+
+	// Put an appointment record to the database, replacing any existing record
+	// for the same order. Returns ErrAppointmentConflict if the record is a
+	// sale overlapping another booked sale.
+	Put(record *AppointmentRecord) error
+
+	// Get the appointment booked by the given order
+	Get(orderID string) (*AppointmentRecord, error)
+
+	// GetAll returns the appointments overlapping [from, to) in order of start
+	// time. A zero to returns every appointment after from.
+	GetAll(from, to time.Time) ([]*AppointmentRecord, error)
+
+	// Delete releases the appointment booked by the given order
+	Delete(orderID string) error
+}
+
+// PanelVoteStore is the panelvotes table interface
+type PanelVoteStore interface {
+	Queryable
+
+	// Put a panel member's vote on a case, replacing the member's earlier vote
+	Put(caseID string, vote *pb.DisputeResolution_PanelVote) error
+
+	// GetAll returns the votes cast on a case in the order they were cast
+	GetAll(caseID string) ([]*pb.DisputeResolution_PanelVote, error)
+}
+
+type APITokenStore interface {
+	Queryable
+
+	// Put an API token record to the database, replacing any existing record with the same ID
+	Put(record *APITokenRecord) error
+
+	// Get the API token record with the given ID
+	Get(tokenID string) (*APITokenRecord, error)
+
+	// GetByHash returns the API token record whose token hashes to the given hash
+	GetByHash(hash string) (*APITokenRecord, error)
+
+	// GetAll returns every API token record, oldest first
+	GetAll() ([]*APITokenRecord, error)
+
+	// Delete the API token record with the given ID
+	Delete(tokenID string) error
+
+	// UpdateLastUsed records when the token was last used
+	UpdateLastUsed(tokenID string, t time.Time) error
+}
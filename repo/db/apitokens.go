@@ -0,0 +1,160 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+// APITokensDB represents the apitokens table
+type APITokensDB struct {
+	modelStore
+}
+
+// NewAPITokenStore return new APITokensDB
+func NewAPITokenStore(db *sql.DB, lock *sync.Mutex) repo.APITokenStore {
+	return &APITokensDB{modelStore{db, lock}}
+}
+
+const selectAPITokensSQL = "select tokenID, name, hash, scopes, expires, created, lastUsed from apitokens"
+
+// Put will insert or replace a record in the apitokens table
+func (a *APITokensDB) Put(record *repo.APITokenRecord) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	tx, err := a.db.Begin()
+	if err != nil {
+		return err
+	}
+	stm := `insert or replace into apitokens(tokenID, name, hash, scopes, expires, created, lastUsed) values(?,?,?,?,?,?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	defer stmt.Close()
+
+	var scopes []string
+	for _, s := range record.Scopes {
+		scopes = append(scopes, s.String())
+	}
+	_, err = stmt.Exec(
+		record.TokenID,
+		record.Name,
+		record.Hash,
+		strings.Join(scopes, ","),
+		unixOrZero(record.Expires),
+		record.Created.Unix(),
+		unixOrZero(record.LastUsed),
+	)
+	if err != nil {
+		rErr := tx.Rollback()
+		if rErr != nil {
+			return fmt.Errorf("api token put fail: %s and rollback failed: %s", err.Error(), rErr.Error())
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// Get returns the API token record with the given ID
+func (a *APITokensDB) Get(tokenID string) (*repo.APITokenRecord, error) {
+	return a.getOne(selectAPITokensSQL+" where tokenID=?", tokenID)
+}
+
+// GetByHash returns the API token record whose token hashes to the given hash
+func (a *APITokensDB) GetByHash(hash string) (*repo.APITokenRecord, error) {
+	return a.getOne(selectAPITokensSQL+" where hash=?", hash)
+}
+
+func (a *APITokensDB) getOne(stm string, arg string) (*repo.APITokenRecord, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	rows, err := a.db.Query(stm, arg)
+	if err != nil {
+		return nil, err
+	}
+	records, err := scanAPITokens(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return records[0], nil
+}
+
+// GetAll returns every API token record, oldest first
+func (a *APITokensDB) GetAll() ([]*repo.APITokenRecord, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	rows, err := a.db.Query(selectAPITokensSQL + " order by created asc, rowid asc")
+	if err != nil {
+		return nil, err
+	}
+	return scanAPITokens(rows)
+}
+
+// Delete removes the API token record with the given ID
+func (a *APITokensDB) Delete(tokenID string) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	_, err := a.db.Exec("delete from apitokens where tokenID=?", tokenID)
+	return err
+}
+
+// UpdateLastUsed records when the token was last used
+func (a *APITokensDB) UpdateLastUsed(tokenID string, t time.Time) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	_, err := a.db.Exec("update apitokens set lastUsed=? where tokenID=?", t.Unix(), tokenID)
+	return err
+}
+
+func scanAPITokens(rows *sql.Rows) ([]*repo.APITokenRecord, error) {
+	defer rows.Close()
+
+	var ret []*repo.APITokenRecord
+	for rows.Next() {
+		var (
+			scopes                     string
+			expires, created, lastUsed int64
+			r                          = new(repo.APITokenRecord)
+		)
+		if err := rows.Scan(&r.TokenID, &r.Name, &r.Hash, &scopes, &expires, &created, &lastUsed); err != nil {
+			return nil, err
+		}
+		for _, s := range strings.Split(scopes, ",") {
+			if s != "" {
+				r.Scopes = append(r.Scopes, repo.APITokenScope(s))
+			}
+		}
+		r.Expires = timeOrZero(expires)
+		r.Created = time.Unix(created, 0)
+		r.LastUsed = timeOrZero(lastUsed)
+		ret = append(ret, r)
+	}
+	return ret, rows.Err()
+}
+
+// unixOrZero stores the zero time as 0 instead of its large negative Unix time
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+func timeOrZero(unix int64) time.Time {
+	if unix == 0 {
+		return time.Time{}
+	}
+	return time.Unix(unix, 0)
+}
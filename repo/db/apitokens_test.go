@@ -0,0 +1,142 @@
+package db_test
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kimitzu/kimitzu-go/repo"
+	"github.com/kimitzu/kimitzu-go/repo/db"
+	"github.com/kimitzu/kimitzu-go/schema"
+)
+
+func buildNewAPITokenStore() (repo.APITokenStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewAPITokenStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func TestAPITokensDB_PutGet(t *testing.T) {
+	tokens, teardown, err := buildNewAPITokenStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	expires := time.Unix(time.Now().Add(time.Hour).Unix(), 0)
+	token, record, err := repo.NewAPIToken("storefront", []repo.APITokenScope{repo.APITokenScopeReadOnly, repo.APITokenScopeOrders}, expires)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tokens.Put(record); err != nil {
+		t.Fatal(err)
+	}
+
+	ret, err := tokens.GetByHash(repo.HashAPIToken(token))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ret.TokenID != record.TokenID || ret.Name != "storefront" || ret.Hash != record.Hash {
+		t.Errorf("unexpected record %+v", ret)
+	}
+	if len(ret.Scopes) != 2 || !ret.HasScope(repo.APITokenScopeOrders) || ret.HasScope(repo.APITokenScopeWalletSpend) {
+		t.Errorf("unexpected scopes %v", ret.Scopes)
+	}
+	if !ret.Expires.Equal(expires) {
+		t.Errorf("expected expiry %s, got %s", expires, ret.Expires)
+	}
+	if !ret.LastUsed.IsZero() {
+		t.Error("new token should not have been used")
+	}
+
+	if _, err := tokens.Get(record.TokenID); err != nil {
+		t.Error(err)
+	}
+	if _, err := tokens.GetByHash(repo.HashAPIToken("kmz_unknown")); err != sql.ErrNoRows {
+		t.Errorf("expected sql.ErrNoRows, got %v", err)
+	}
+}
+
+func TestAPITokensDB_UpdateLastUsed(t *testing.T) {
+	tokens, teardown, err := buildNewAPITokenStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	_, record, err := repo.NewAPIToken("bot", []repo.APITokenScope{repo.APITokenScopeReadOnly}, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tokens.Put(record); err != nil {
+		t.Fatal(err)
+	}
+	used := time.Unix(time.Now().Unix(), 0)
+	if err := tokens.UpdateLastUsed(record.TokenID, used); err != nil {
+		t.Fatal(err)
+	}
+	ret, err := tokens.Get(record.TokenID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ret.LastUsed.Equal(used) {
+		t.Errorf("expected last use %s, got %s", used, ret.LastUsed)
+	}
+	if !ret.Expires.IsZero() || ret.Expired(time.Now().Add(time.Hour*24*365)) {
+		t.Error("token without expiry should never expire")
+	}
+}
+
+func TestAPITokensDB_GetAllDelete(t *testing.T) {
+	tokens, teardown, err := buildNewAPITokenStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	var ids []string
+	for n, name := range []string{"first", "second"} {
+		_, record, err := repo.NewAPIToken(name, []repo.APITokenScope{repo.APITokenScopeReadOnly}, time.Time{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		record.Created = time.Unix(int64(1000+n), 0)
+		if err := tokens.Put(record); err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, record.TokenID)
+	}
+	all, err := tokens.GetAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 2 || all[0].Name != "first" || all[1].Name != "second" {
+		t.Fatalf("unexpected records %+v", all)
+	}
+	if err := tokens.Delete(ids[0]); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tokens.Get(ids[0]); err != sql.ErrNoRows {
+		t.Errorf("expected sql.ErrNoRows, got %v", err)
+	}
+	all, err = tokens.GetAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 1 || all[0].TokenID != ids[1] {
+		t.Errorf("unexpected records %+v", all)
+	}
+}
@@ -0,0 +1,144 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+// AppointmentsDB represents the appointments table
+type AppointmentsDB struct {
+	modelStore
+}
+
+// NewAppointmentStore return new AppointmentsDB
+func NewAppointmentStore(db *sql.DB, lock *sync.Mutex) repo.AppointmentStore {
+	return &AppointmentsDB{modelStore{db, lock}}
+}
+
+const selectAppointmentsSQL = "select orderID, slug, title, peerID, isSale, startTime, endTime, timezone from appointments"
+
+// Put will insert or replace a record in the appointments table. The check
+// for overlapping sales runs in the same transaction as the insert so two
+// orders for the same slot cannot both be booked.
+func (a *AppointmentsDB) Put(record *repo.AppointmentRecord) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	tx, err := a.db.Begin()
+	if err != nil {
+		return err
+	}
+	isSale := 0
+	if record.IsSale {
+		isSale = 1
+		var conflicts int
+		err := tx.QueryRow("select count(*) from appointments where isSale=1 and orderID!=? and startTime<? and endTime>?",
+			record.OrderID, record.End.Unix(), record.Start.Unix()).Scan(&conflicts)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+		if conflicts > 0 {
+			tx.Rollback()
+			return repo.ErrAppointmentConflict
+		}
+	}
+	stm := `insert or replace into appointments(orderID, slug, title, peerID, isSale, startTime, endTime, timezone) values(?,?,?,?,?,?,?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(
+		record.OrderID,
+		record.Slug,
+		record.Title,
+		record.PeerID,
+		isSale,
+		record.Start.Unix(),
+		record.End.Unix(),
+		record.Timezone,
+	)
+	if err != nil {
+		rErr := tx.Rollback()
+		if rErr != nil {
+			return fmt.Errorf("appointment put fail: %s and rollback failed: %s", err.Error(), rErr.Error())
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// Get returns the appointment booked by the given order
+func (a *AppointmentsDB) Get(orderID string) (*repo.AppointmentRecord, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	rows, err := a.db.Query(selectAppointmentsSQL+" where orderID=?", orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	records, err := scanAppointments(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return records[0], nil
+}
+
+// GetAll returns the appointments overlapping [from, to) in order of start time
+func (a *AppointmentsDB) GetAll(from, to time.Time) ([]*repo.AppointmentRecord, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	query, args := selectAppointmentsSQL+" where endTime>?", []interface{}{from.Unix()}
+	if !to.IsZero() {
+		query += " and startTime<?"
+		args = append(args, to.Unix())
+	}
+	rows, err := a.db.Query(query+" order by startTime asc", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanAppointments(rows)
+}
+
+// Delete releases the appointment booked by the given order
+func (a *AppointmentsDB) Delete(orderID string) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	_, err := a.db.Exec("delete from appointments where orderID=?", orderID)
+	return err
+}
+
+func scanAppointments(rows *sql.Rows) ([]*repo.AppointmentRecord, error) {
+	var ret []*repo.AppointmentRecord
+	for rows.Next() {
+		var (
+			orderID, slug, title, peerID, timezone string
+			isSale                                 int
+			start, end                             int64
+		)
+		if err := rows.Scan(&orderID, &slug, &title, &peerID, &isSale, &start, &end, &timezone); err != nil {
+			return nil, err
+		}
+		ret = append(ret, &repo.AppointmentRecord{
+			OrderID:  orderID,
+			Slug:     slug,
+			Title:    title,
+			PeerID:   peerID,
+			IsSale:   isSale == 1,
+			Start:    time.Unix(start, 0).UTC(),
+			End:      time.Unix(end, 0).UTC(),
+			Timezone: timezone,
+		})
+	}
+	return ret, rows.Err()
+}
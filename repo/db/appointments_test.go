@@ -0,0 +1,116 @@
+package db_test
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kimitzu/kimitzu-go/repo"
+	"github.com/kimitzu/kimitzu-go/repo/db"
+	"github.com/kimitzu/kimitzu-go/schema"
+)
+
+func buildNewAppointmentStore() (repo.AppointmentStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewAppointmentStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func newAppointmentRecord(orderID string, start time.Time, isSale bool) *repo.AppointmentRecord {
+	return &repo.AppointmentRecord{
+		OrderID:  orderID,
+		Slug:     "consultation",
+		Title:    "One hour consultation",
+		PeerID:   "QmBuyer",
+		IsSale:   isSale,
+		Start:    start,
+		End:      start.Add(time.Hour),
+		Timezone: "Europe/Berlin",
+	}
+}
+
+func TestAppointmentsDB_PutGetDelete(t *testing.T) {
+	appointments, teardown, err := buildNewAppointmentStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	if _, err := appointments.Get("order1"); err != sql.ErrNoRows {
+		t.Errorf("expected sql.ErrNoRows, got %v", err)
+	}
+	start := time.Date(2026, 11, 2, 9, 0, 0, 0, time.UTC)
+	if err := appointments.Put(newAppointmentRecord("order1", start, true)); err != nil {
+		t.Fatal(err)
+	}
+	ret, err := appointments.Get("order1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ret.IsSale || !ret.Start.Equal(start) || !ret.End.Equal(start.Add(time.Hour)) || ret.Timezone != "Europe/Berlin" {
+		t.Errorf("unexpected record %+v", ret)
+	}
+
+	if err := appointments.Delete("order1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := appointments.Get("order1"); err != sql.ErrNoRows {
+		t.Errorf("expected sql.ErrNoRows, got %v", err)
+	}
+}
+
+func TestAppointmentsDB_Conflict(t *testing.T) {
+	appointments, teardown, err := buildNewAppointmentStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	start := time.Date(2026, 11, 2, 9, 0, 0, 0, time.UTC)
+	if err := appointments.Put(newAppointmentRecord("order1", start, true)); err != nil {
+		t.Fatal(err)
+	}
+	if err := appointments.Put(newAppointmentRecord("order2", start.Add(30*time.Minute), true)); err != repo.ErrAppointmentConflict {
+		t.Errorf("expected %s, got %v", repo.ErrAppointmentConflict, err)
+	}
+	// Back to back slots do not overlap
+	if err := appointments.Put(newAppointmentRecord("order2", start.Add(time.Hour), true)); err != nil {
+		t.Error(err)
+	}
+	// Rebooking the same order does not conflict with itself
+	if err := appointments.Put(newAppointmentRecord("order1", start.Add(-30*time.Minute), true)); err != nil {
+		t.Error(err)
+	}
+	// Purchases are not checked against our sales
+	if err := appointments.Put(newAppointmentRecord("order3", start, false)); err != nil {
+		t.Error(err)
+	}
+
+	records, err := appointments.GetAll(start, start.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 || records[0].OrderID != "order1" || records[1].OrderID != "order3" {
+		t.Errorf("expected order1 and order3, got %d records", len(records))
+	}
+	records, err = appointments.GetAll(start, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 3 {
+		t.Errorf("expected 3 records, got %d", len(records))
+	}
+}
@@ -0,0 +1,127 @@
+package db
+
+import (
+	"database/sql"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+// AuditDB represents the auditlog table
+type AuditDB struct {
+	modelStore
+}
+
+// NewAuditStore return new AuditDB
+func NewAuditStore(db *sql.DB, lock *sync.Mutex) repo.AuditStore {
+	return &AuditDB{modelStore{db, lock}}
+}
+
+const selectAuditLogSQL = "select auditID, timestamp, source, actor, remoteAddr, action, target, paramsHash, success, result from auditlog"
+
+// Put appends a record to the auditlog table
+func (a *AuditDB) Put(record *repo.AuditRecord) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	success := 0
+	if record.Success {
+		success = 1
+	}
+	res, err := a.db.Exec(`insert into auditlog(timestamp, source, actor, remoteAddr, action, target, paramsHash, success, result) values(?,?,?,?,?,?,?,?,?)`,
+		record.Timestamp.Unix(),
+		record.Source.String(),
+		record.Actor,
+		record.RemoteAddr,
+		record.Action,
+		record.Target,
+		record.ParamsHash,
+		success,
+		record.Result,
+	)
+	if err != nil {
+		return err
+	}
+	record.AuditID, err = res.LastInsertId()
+	return err
+}
+
+// GetAll returns the records matching the query, most recent first
+func (a *AuditDB) GetAll(query repo.AuditQuery) ([]*repo.AuditRecord, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	where, args := auditQueryClauses(query)
+	stm := selectAuditLogSQL + where + " order by auditID desc"
+	if query.Limit > 0 {
+		stm += " limit ? offset ?"
+		args = append(args, query.Limit, query.Offset)
+	}
+	rows, err := a.db.Query(stm, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ret []*repo.AuditRecord
+	for rows.Next() {
+		var (
+			timestamp int64
+			source    string
+			success   int
+			r         = new(repo.AuditRecord)
+		)
+		if err := rows.Scan(&r.AuditID, &timestamp, &source, &r.Actor, &r.RemoteAddr, &r.Action, &r.Target, &r.ParamsHash, &success, &r.Result); err != nil {
+			return nil, err
+		}
+		r.Timestamp = time.Unix(timestamp, 0)
+		r.Source = repo.AuditSource(source)
+		r.Success = success == 1
+		ret = append(ret, r)
+	}
+	return ret, rows.Err()
+}
+
+// Count returns the number of records matching the query
+func (a *AuditDB) Count(query repo.AuditQuery) (int, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	where, args := auditQueryClauses(query)
+	var count int
+	err := a.db.QueryRow("select count(*) from auditlog"+where, args...).Scan(&count)
+	return count, err
+}
+
+func auditQueryClauses(query repo.AuditQuery) (string, []interface{}) {
+	var (
+		clauses []string
+		args    []interface{}
+	)
+	if query.Source != "" {
+		clauses = append(clauses, "source=?")
+		args = append(args, query.Source.String())
+	}
+	if query.Actor != "" {
+		clauses = append(clauses, "actor=?")
+		args = append(args, query.Actor)
+	}
+	if query.Action != "" {
+		clauses = append(clauses, "substr(action, 1, ?)=?")
+		args = append(args, len(query.Action), query.Action)
+	}
+	if !query.Since.IsZero() {
+		clauses = append(clauses, "timestamp>=?")
+		args = append(args, query.Since.Unix())
+	}
+	if !query.Until.IsZero() {
+		clauses = append(clauses, "timestamp<?")
+		args = append(args, query.Until.Unix())
+	}
+	if len(clauses) == 0 {
+		return "", nil
+	}
+	return " where " + strings.Join(clauses, " and "), args
+}
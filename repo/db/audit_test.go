@@ -0,0 +1,130 @@
+package db_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kimitzu/kimitzu-go/repo"
+	"github.com/kimitzu/kimitzu-go/repo/db"
+	"github.com/kimitzu/kimitzu-go/schema"
+)
+
+func buildNewAuditStore() (repo.AuditStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewAuditStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func TestAuditDB_PutGetAll(t *testing.T) {
+	audit, teardown, err := buildNewAuditStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	start := time.Unix(time.Now().Unix(), 0)
+	records := []*repo.AuditRecord{
+		{Timestamp: start, Source: repo.AuditSourceAPI, Actor: "user:test", RemoteAddr: "127.0.0.1:1234", Action: "POST /ob/purchase", ParamsHash: "aa", Success: true, Result: "200 OK"},
+		{Timestamp: start.Add(time.Minute), Source: repo.AuditSourceProtocol, Actor: "node", Action: "ORDER_CONFIRMATION", Target: "QmPeer", ParamsHash: "bb", Success: true, Result: "delivered"},
+		{Timestamp: start.Add(2 * time.Minute), Source: repo.AuditSourceAPI, Actor: "token:abc", Action: "POST /ob/orderfulfillment", ParamsHash: "cc", Result: "400 Bad Request: invalid order"},
+	}
+	for _, r := range records {
+		if err := audit.Put(r); err != nil {
+			t.Fatal(err)
+		}
+		if r.AuditID == 0 {
+			t.Error("expected the audit ID to be set")
+		}
+	}
+
+	all, err := audit.GetAll(repo.AuditQuery{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 3 {
+		t.Fatalf("expected 3 records, got %d", len(all))
+	}
+	if all[0].AuditID != records[2].AuditID {
+		t.Error("expected the most recent record first")
+	}
+	last := all[0]
+	if last.Actor != "token:abc" || last.Source != repo.AuditSourceAPI || last.Success || last.Result != records[2].Result || !last.Timestamp.Equal(records[2].Timestamp) {
+		t.Errorf("unexpected record %+v", last)
+	}
+	if all[1].Target != "QmPeer" || !all[1].Success {
+		t.Errorf("unexpected record %+v", all[1])
+	}
+
+	for _, c := range []struct {
+		query repo.AuditQuery
+		ids   []int64
+	}{
+		{repo.AuditQuery{Source: repo.AuditSourceAPI}, []int64{records[2].AuditID, records[0].AuditID}},
+		{repo.AuditQuery{Actor: "node"}, []int64{records[1].AuditID}},
+		{repo.AuditQuery{Action: "POST /ob/"}, []int64{records[2].AuditID, records[0].AuditID}},
+		{repo.AuditQuery{Since: start.Add(time.Minute)}, []int64{records[2].AuditID, records[1].AuditID}},
+		{repo.AuditQuery{Until: start.Add(time.Minute)}, []int64{records[0].AuditID}},
+		{repo.AuditQuery{Limit: 1, Offset: 1}, []int64{records[1].AuditID}},
+	} {
+		ret, err := audit.GetAll(c.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ret) != len(c.ids) {
+			t.Errorf("query %+v: expected %d records, got %d", c.query, len(c.ids), len(ret))
+			continue
+		}
+		for n, r := range ret {
+			if r.AuditID != c.ids[n] {
+				t.Errorf("query %+v: expected record %d, got %d", c.query, c.ids[n], r.AuditID)
+			}
+		}
+	}
+
+	count, err := audit.Count(repo.AuditQuery{Source: repo.AuditSourceAPI, Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Errorf("expected a count of 2, got %d", count)
+	}
+}
+
+func TestAuditDB_AppendOnly(t *testing.T) {
+	audit, teardown, err := buildNewAuditStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	record := &repo.AuditRecord{Timestamp: time.Now(), Source: repo.AuditSourceAPI, Actor: "cookie", Action: "DELETE /ob/listing", Success: true, Result: "200 OK"}
+	if err := audit.Put(record); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := audit.ExecuteQuery("update auditlog set result='changed' where auditID=?", record.AuditID); err == nil {
+		t.Error("expected updating the audit log to fail")
+	}
+	if _, err := audit.ExecuteQuery("delete from auditlog where auditID=?", record.AuditID); err == nil {
+		t.Error("expected deleting from the audit log to fail")
+	}
+	all, err := audit.GetAll(repo.AuditQuery{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 1 || all[0].Result != "200 OK" {
+		t.Errorf("expected the record to be unchanged, got %+v", all)
+	}
+}
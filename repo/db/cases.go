@@ -5,10 +5,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/OpenBazaar/jsonpb"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/kimitzu/kimitzu-go/pb"
 	"github.com/kimitzu/kimitzu-go/repo"
 )
@@ -205,6 +207,10 @@ func (c *CasesDB) Delete(orderID string) error {
 	if err != nil {
 		return err
 	}
+	_, err = c.db.Exec("delete from casemessages where caseID=?", orderID)
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -276,7 +282,7 @@ func (c *CasesDB) GetAll(stateFilter []pb.OrderState, searchTerm string, sortByA
 				buyerHandle = contract.BuyerOrder.BuyerID.Handle
 			}
 			if contract.BuyerOrder.Payment != nil {
-				total = contract.BuyerOrder.Payment.Amount
+				total = pb.OrderPayment(contract).Amount
 			}
 		}
 
@@ -571,3 +577,183 @@ func (c *CasesDB) UpdateDisputesLastDisputeExpiryNotifiedAt(disputeCases []*repo
 
 	return nil
 }
+
+// PutMessage saves a signed message posted to a thread of the case
+func (c *CasesDB) PutMessage(caseID string, message *pb.SignedCaseMessage) error {
+	if message.Message == nil {
+		return fmt.Errorf("message on case (%s) is empty", caseID)
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(message)
+	if err != nil {
+		return err
+	}
+	var timestamp int64
+	if ts, err := ptypes.Timestamp(message.Message.Timestamp); err == nil {
+		timestamp = ts.UnixNano()
+	}
+
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	stmt, err := tx.Prepare(`insert or ignore into casemessages(messageID, caseID, channel, threadID, senderID, message, timestamp) values(?,?,?,?,?,?,?)`)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	defer stmt.Close()
+
+	res, err := stmt.Exec(
+		message.Message.MessageId,
+		caseID,
+		int(message.Message.Channel),
+		message.Message.ThreadId,
+		message.SenderID,
+		out,
+		timestamp,
+	)
+	if err != nil {
+		rErr := tx.Rollback()
+		if rErr != nil {
+			return fmt.Errorf("case message put fail: %s and rollback failed: %s", err.Error(), rErr.Error())
+		}
+		return err
+	}
+	if rows, err := res.RowsAffected(); err == nil && rows == 0 {
+		tx.Rollback()
+		return repo.ErrDuplicateCaseMessage
+	}
+	return tx.Commit()
+}
+
+// GetMessages returns the messages posted to the case in the order they were sent
+func (c *CasesDB) GetMessages(caseID string) ([]*pb.SignedCaseMessage, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	rows, err := c.db.Query("select messageID, message from casemessages where caseID=? order by timestamp asc", caseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ret []*pb.SignedCaseMessage
+	for rows.Next() {
+		var messageID, serialized string
+		if err := rows.Scan(&messageID, &serialized); err != nil {
+			return nil, err
+		}
+		message := new(pb.SignedCaseMessage)
+		if err := jsonpb.UnmarshalString(serialized, message); err != nil {
+			log.Errorf("failed unmarshaling case message (%s, %s): %s", caseID, messageID, err)
+			continue
+		}
+		ret = append(ret, message)
+	}
+	return ret, rows.Err()
+}
+
+// GetStats aggregates every case in the database. Fees are those paid to
+// moderatorID, either as the moderator of the order or as a voting member of
+// its moderator panel.
+func (c *CasesDB) GetStats(moderatorID string) (*repo.CaseStats, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	rows, err := c.db.Query("select caseID, state, read, timestamp, paymentCoin, disputeResolution from cases")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stats := &repo.CaseStats{FeesEarned: make(map[string]uint64)}
+	var durations []int64
+	for rows.Next() {
+		var (
+			caseID, paymentCoin string
+			stateInt, readInt   int
+			createdAt           int64
+			resolutionJSON      []byte
+		)
+		if err := rows.Scan(&caseID, &stateInt, &readInt, &createdAt, &paymentCoin, &resolutionJSON); err != nil {
+			return nil, err
+		}
+		if len(resolutionJSON) == 0 {
+			if pb.OrderState(stateInt) == pb.OrderState_DISPUTED {
+				stats.Open++
+				if readInt == 0 {
+					stats.Unread++
+				}
+			}
+			continue
+		}
+		resolution := new(pb.DisputeResolution)
+		if err := jsonpb.UnmarshalString(string(resolutionJSON), resolution); err != nil {
+			log.Errorf("failed unmarshaling dispute resolution of case (%s): %s", caseID, err)
+			continue
+		}
+		stats.Closed++
+		switch {
+		case resolution.BuyerPercentage > resolution.VendorPercentage:
+			stats.BuyerWins++
+		case resolution.BuyerPercentage < resolution.VendorPercentage:
+			stats.VendorWins++
+		default:
+			stats.Splits++
+		}
+		if resolvedAt, err := ptypes.Timestamp(resolution.Timestamp); err == nil && createdAt > 0 {
+			durations = append(durations, resolvedAt.Unix()-createdAt)
+		}
+		if fee := moderatorFee(resolution, moderatorID); fee > 0 {
+			stats.FeesEarned[paymentCoin] += fee
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if stats.Closed > 0 {
+		stats.BuyerWinRatio = float64(stats.BuyerWins) / float64(stats.Closed)
+		stats.VendorWinRatio = float64(stats.VendorWins) / float64(stats.Closed)
+	}
+	if len(durations) > 0 {
+		sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+		mid := len(durations) / 2
+		stats.MedianResolutionSeconds = durations[mid]
+		if len(durations)%2 == 0 {
+			stats.MedianResolutionSeconds = (durations[mid-1] + durations[mid]) / 2
+		}
+	}
+	return stats, nil
+}
+
+// moderatorFee returns the amount the resolution pays the moderator. Panel
+// members are paid to the address named in their own vote.
+func moderatorFee(resolution *pb.DisputeResolution, moderatorID string) uint64 {
+	payout := resolution.Payout
+	if payout == nil {
+		return 0
+	}
+	if payout.ModeratorOutput != nil {
+		return payout.ModeratorOutput.Amount
+	}
+	for _, v := range resolution.PanelVotes {
+		if v.Vote == nil || v.Vote.ProposedBy != moderatorID {
+			continue
+		}
+		address := v.Vote.GetPayout().GetModeratorOutput().GetAddress()
+		for _, o := range payout.PanelOutputs {
+			if address != "" && o.GetAddress() == address {
+				return o.Amount
+			}
+		}
+	}
+	return 0
+}
@@ -1056,3 +1056,133 @@ func TestCasesDB_Put_CoinType(t *testing.T) {
 		teardown()
 	}
 }
+
+func TestCasesDB_Messages(t *testing.T) {
+	casesdb, teardown, err := buildNewCaseStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	newMessage := func(id, threadID string, channel pb.CaseMessage_Channel, sent time.Time) *pb.SignedCaseMessage {
+		ts, _ := ptypes.TimestampProto(sent)
+		return &pb.SignedCaseMessage{
+			Message: &pb.CaseMessage{
+				MessageId: id,
+				OrderId:   "caseID",
+				Channel:   channel,
+				ThreadId:  threadID,
+				Message:   "message " + id,
+				Timestamp: ts,
+			},
+			SenderID:  "senderID",
+			Signature: []byte("signature"),
+		}
+	}
+	now := time.Now()
+	reply := newMessage("reply", "root", pb.CaseMessage_CASE, now)
+	root := newMessage("root", "", pb.CaseMessage_CASE, now.Add(-time.Minute))
+	private := newMessage("private", "", pb.CaseMessage_BUYER, now.Add(time.Minute))
+	for _, m := range []*pb.SignedCaseMessage{reply, root, private} {
+		if err := casesdb.PutMessage("caseID", m); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := casesdb.PutMessage("caseID", root); err != repo.ErrDuplicateCaseMessage {
+		t.Errorf("expected %s, got %v", repo.ErrDuplicateCaseMessage, err)
+	}
+	if err := casesdb.PutMessage("otherCaseID", newMessage("other", "", pb.CaseMessage_CASE, now)); err != nil {
+		t.Fatal(err)
+	}
+
+	messages, err := casesdb.GetMessages("caseID")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(messages) != 3 {
+		t.Fatalf("expected 3 messages, got %d", len(messages))
+	}
+	for i, expected := range []*pb.SignedCaseMessage{root, reply, private} {
+		if !proto.Equal(messages[i], expected) {
+			t.Errorf("expected message %d to be %s, got %s", i, expected.Message.MessageId, messages[i].Message.MessageId)
+		}
+	}
+
+	if err := casesdb.Delete("caseID"); err != nil {
+		t.Fatal(err)
+	}
+	if messages, err := casesdb.GetMessages("caseID"); err != nil || len(messages) != 0 {
+		t.Errorf("expected the messages to be deleted with the case, got %d (%v)", len(messages), err)
+	}
+	if messages, err := casesdb.GetMessages("otherCaseID"); err != nil || len(messages) != 1 {
+		t.Errorf("expected the messages of other cases to remain, got %d (%v)", len(messages), err)
+	}
+}
+
+func TestCasesDB_GetStats(t *testing.T) {
+	casesdb, teardown, err := buildNewCaseStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	now := time.Now()
+	for _, id := range []string{"open", "openRead", "buyerWin", "vendorWin", "panelSplit"} {
+		if err := casesdb.Put(id, pb.OrderState_DISPUTED, true, "claim", "btc", "btc"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := casesdb.MarkAsRead("openRead"); err != nil {
+		t.Fatal(err)
+	}
+	newResolution := func(buyer, vendor float32, resolvedAfter time.Duration) *pb.DisputeResolution {
+		ts, _ := ptypes.TimestampProto(now.Add(resolvedAfter))
+		return &pb.DisputeResolution{
+			Timestamp:        ts,
+			BuyerPercentage:  buyer,
+			VendorPercentage: vendor,
+			Payout:           &pb.DisputeResolution_Payout{},
+		}
+	}
+	buyerWin := newResolution(100, 0, time.Hour)
+	buyerWin.Payout.ModeratorOutput = &pb.DisputeResolution_Payout_Output{Amount: 1000}
+	vendorWin := newResolution(20, 80, 2*time.Hour)
+	vendorWin.Payout.ModeratorOutput = &pb.DisputeResolution_Payout_Output{Amount: 500}
+	panelSplit := newResolution(50, 50, 6*time.Hour)
+	for _, member := range []string{"QmModerator", "QmOtherModerator"} {
+		address := &pb.DisputeResolution_Payout_Output_Address{Address: "address" + member}
+		panelSplit.PanelVotes = append(panelSplit.PanelVotes, &pb.DisputeResolution_PanelVote{
+			Vote: &pb.DisputeResolution{
+				ProposedBy: member,
+				Payout:     &pb.DisputeResolution_Payout{ModeratorOutput: &pb.DisputeResolution_Payout_Output{ScriptOrAddress: address, Amount: 300}},
+			},
+		})
+		panelSplit.Payout.PanelOutputs = append(panelSplit.Payout.PanelOutputs, &pb.DisputeResolution_Payout_Output{ScriptOrAddress: address, Amount: 250})
+	}
+	for id, resolution := range map[string]*pb.DisputeResolution{"buyerWin": buyerWin, "vendorWin": vendorWin, "panelSplit": panelSplit} {
+		if err := casesdb.MarkAsClosed(id, resolution); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	stats, err := casesdb.GetStats("QmModerator")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Open != 2 || stats.Unread != 1 || stats.Closed != 3 {
+		t.Errorf("expected 2 open cases, 1 unread and 3 closed, got %d, %d and %d", stats.Open, stats.Unread, stats.Closed)
+	}
+	if stats.BuyerWins != 1 || stats.VendorWins != 1 || stats.Splits != 1 {
+		t.Errorf("expected a buyer win, a vendor win and a split, got %d, %d and %d", stats.BuyerWins, stats.VendorWins, stats.Splits)
+	}
+	if stats.BuyerWinRatio != 1.0/3 || stats.VendorWinRatio != 1.0/3 {
+		t.Errorf("expected win ratios of a third, got %f and %f", stats.BuyerWinRatio, stats.VendorWinRatio)
+	}
+	// Case timestamps are stored in whole seconds
+	if median := stats.MedianResolutionSeconds; median < 2*3600-1 || median > 2*3600 {
+		t.Errorf("expected a median time to resolution of 2 hours, got %d seconds", median)
+	}
+	if len(stats.FeesEarned) != 1 || stats.FeesEarned["BTC"] != 1750 {
+		t.Errorf("expected 1750 BTC in fees, got %v", stats.FeesEarned)
+	}
+}
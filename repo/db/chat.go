@@ -61,6 +61,18 @@ func (c *ChatDB) Put(messageId string, peerId string, subject string, message st
 		tx.Rollback()
 		return err
 	}
+	err = putSearchDocument(tx, &repo.SearchDocument{
+		Type:      repo.SearchDocumentTypeChat,
+		ID:        messageId,
+		PeerID:    peerId,
+		Title:     subject,
+		Body:      message,
+		Timestamp: timestamp,
+	})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
 	tx.Commit()
 	return nil
 }
@@ -250,6 +262,7 @@ func (c *ChatDB) GetUnreadCount(subject string) (int, error) {
 func (c *ChatDB) DeleteMessage(msgID string) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
+	c.db.Exec("delete from search where type=? and documentID=?", repo.SearchDocumentTypeChat.String(), msgID)
 	c.db.Exec("delete from chat where messageID=?", msgID)
 	return nil
 }
@@ -257,6 +270,7 @@ func (c *ChatDB) DeleteMessage(msgID string) error {
 func (c *ChatDB) DeleteConversation(peerId string) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
+	c.db.Exec("delete from search where type=? and documentID in (select messageID from chat where peerId=? and subject='')", repo.SearchDocumentTypeChat.String(), peerId)
 	c.db.Exec("delete from chat where peerId=? and subject=''", peerId)
 	return nil
 }
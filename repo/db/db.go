@@ -37,6 +37,17 @@ type SQLiteDatastore struct {
 	txMetadata      repo.TransactionMetadataStore
 	moderatedStores repo.ModeratedStore
 	messages        repo.MessageStore
+	subscriptions   repo.SubscriptionStore
+	locations       repo.LocationStore
+	search          repo.SearchStore
+	deliveries      repo.NotificationDeliveryStore
+	apiTokens       repo.APITokenStore
+	auditLog        repo.AuditStore
+	exchangeRates   repo.ExchangeRateStore
+	invoices        repo.InvoiceStore
+	quotes          repo.QuoteStore
+	appointments    repo.AppointmentStore
+	panelVotes      repo.PanelVoteStore
 	db              *sql.DB
 	lock            *sync.Mutex
 }
@@ -83,6 +94,17 @@ func NewSQLiteDatastore(db *sql.DB, l *sync.Mutex, coinType wallet.CoinType) *SQ
 		txMetadata:      NewTransactionMetadataStore(db, l),
 		moderatedStores: NewModeratedStore(db, l),
 		messages:        NewMessageStore(db, l),
+		subscriptions:   NewSubscriptionStore(db, l),
+		locations:       NewLocationStore(db, l),
+		search:          NewSearchStore(db, l),
+		deliveries:      NewNotificationDeliveryStore(db, l),
+		apiTokens:       NewAPITokenStore(db, l),
+		auditLog:        NewAuditStore(db, l),
+		exchangeRates:   NewExchangeRateStore(db, l),
+		invoices:        NewInvoiceStore(db, l),
+		quotes:          NewQuoteStore(db, l),
+		appointments:    NewAppointmentStore(db, l),
+		panelVotes:      NewPanelVoteStore(db, l),
 		db:              db,
 		lock:            l,
 	}
@@ -190,6 +212,61 @@ func (d *SQLiteDatastore) Messages() repo.MessageStore {
 	return d.messages
 }
 
+// Subscriptions - return the subscriptions datastore
+func (d *SQLiteDatastore) Subscriptions() repo.SubscriptionStore {
+	return d.subscriptions
+}
+
+// Locations - return the locations datastore
+func (d *SQLiteDatastore) Locations() repo.LocationStore {
+	return d.locations
+}
+
+// Search - return the full text search datastore
+func (d *SQLiteDatastore) Search() repo.SearchStore {
+	return d.search
+}
+
+// NotificationDeliveries - return the outbound notification delivery datastore
+func (d *SQLiteDatastore) NotificationDeliveries() repo.NotificationDeliveryStore {
+	return d.deliveries
+}
+
+// APITokens - return the scoped API token datastore
+func (d *SQLiteDatastore) APITokens() repo.APITokenStore {
+	return d.apiTokens
+}
+
+// AuditLog - return the audit log datastore
+func (d *SQLiteDatastore) AuditLog() repo.AuditStore {
+	return d.auditLog
+}
+
+// ExchangeRates - return the exchange rate history datastore
+func (d *SQLiteDatastore) ExchangeRates() repo.ExchangeRateStore {
+	return d.exchangeRates
+}
+
+// Invoices - return the invoice datastore
+func (d *SQLiteDatastore) Invoices() repo.InvoiceStore {
+	return d.invoices
+}
+
+// Quotes - return the quote datastore
+func (d *SQLiteDatastore) Quotes() repo.QuoteStore {
+	return d.quotes
+}
+
+// Appointments - return the appointment datastore
+func (d *SQLiteDatastore) Appointments() repo.AppointmentStore {
+	return d.appointments
+}
+
+// PanelVotes - return the panel vote datastore
+func (d *SQLiteDatastore) PanelVotes() repo.PanelVoteStore {
+	return d.panelVotes
+}
+
 func (d *SQLiteDatastore) Copy(dbPath string, password string) error {
 	d.lock.Lock()
 	defer d.lock.Unlock()
@@ -0,0 +1,132 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+// ExchangeRatesDB represents the exchangerates table
+type ExchangeRatesDB struct {
+	modelStore
+}
+
+// NewExchangeRateStore return new ExchangeRatesDB
+func NewExchangeRateStore(db *sql.DB, lock *sync.Mutex) repo.ExchangeRateStore {
+	return &ExchangeRatesDB{modelStore{db, lock}}
+}
+
+const selectExchangeRatesSQL = "select snapshotID, timestamp, coin, reason, orderID, rates from exchangerates"
+
+// Put saves a snapshot to the exchangerates table
+func (e *ExchangeRatesDB) Put(snapshot *repo.ExchangeRateSnapshot) error {
+	rates, err := json.Marshal(snapshot.Rates)
+	if err != nil {
+		return err
+	}
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	res, err := e.db.Exec(`insert into exchangerates(timestamp, coin, reason, orderID, rates) values(?,?,?,?,?)`,
+		snapshot.Timestamp.Unix(),
+		strings.ToUpper(snapshot.Coin),
+		snapshot.Reason.String(),
+		snapshot.OrderID,
+		rates,
+	)
+	if err != nil {
+		return err
+	}
+	snapshot.SnapshotID, err = res.LastInsertId()
+	return err
+}
+
+// GetAll returns the snapshots matching the query, most recent first
+func (e *ExchangeRatesDB) GetAll(query repo.ExchangeRateQuery) ([]*repo.ExchangeRateSnapshot, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	var (
+		clauses []string
+		args    []interface{}
+	)
+	if query.Coin != "" {
+		clauses = append(clauses, "coin=?")
+		args = append(args, strings.ToUpper(query.Coin))
+	}
+	if query.Reason != "" {
+		clauses = append(clauses, "reason=?")
+		args = append(args, query.Reason.String())
+	}
+	if query.OrderID != "" {
+		clauses = append(clauses, "orderID=?")
+		args = append(args, query.OrderID)
+	}
+	if !query.Since.IsZero() {
+		clauses = append(clauses, "timestamp>=?")
+		args = append(args, query.Since.Unix())
+	}
+	if !query.Until.IsZero() {
+		clauses = append(clauses, "timestamp<?")
+		args = append(args, query.Until.Unix())
+	}
+	stm := selectExchangeRatesSQL
+	if len(clauses) > 0 {
+		stm += " where " + strings.Join(clauses, " and ")
+	}
+	stm += " order by timestamp desc, snapshotID desc"
+	if query.Limit > 0 {
+		stm += " limit ? offset ?"
+		args = append(args, query.Limit, query.Offset)
+	}
+	rows, err := e.db.Query(stm, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ret []*repo.ExchangeRateSnapshot
+	for rows.Next() {
+		snapshot, err := scanExchangeRateSnapshot(rows)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, snapshot)
+	}
+	return ret, rows.Err()
+}
+
+// GetAt returns the most recent snapshot of the coin taken at or before t
+func (e *ExchangeRatesDB) GetAt(coin string, t time.Time) (*repo.ExchangeRateSnapshot, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	row := e.db.QueryRow(selectExchangeRatesSQL+" where coin=? and timestamp<=? order by timestamp desc, snapshotID desc limit 1", strings.ToUpper(coin), t.Unix())
+	return scanExchangeRateSnapshot(row)
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanExchangeRateSnapshot(row rowScanner) (*repo.ExchangeRateSnapshot, error) {
+	var (
+		timestamp int64
+		reason    string
+		rates     []byte
+		s         = new(repo.ExchangeRateSnapshot)
+	)
+	if err := row.Scan(&s.SnapshotID, &timestamp, &s.Coin, &reason, &s.OrderID, &rates); err != nil {
+		return nil, err
+	}
+	s.Timestamp = time.Unix(timestamp, 0)
+	s.Reason = repo.ExchangeRateReason(reason)
+	if err := json.Unmarshal(rates, &s.Rates); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
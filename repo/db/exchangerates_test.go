@@ -0,0 +1,127 @@
+package db_test
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kimitzu/kimitzu-go/repo"
+	"github.com/kimitzu/kimitzu-go/repo/db"
+	"github.com/kimitzu/kimitzu-go/schema"
+)
+
+func buildNewExchangeRateStore() (repo.ExchangeRateStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewExchangeRateStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func TestExchangeRatesDB_PutGetAll(t *testing.T) {
+	rates, teardown, err := buildNewExchangeRateStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	start := time.Unix(time.Now().Unix(), 0)
+	snapshots := []*repo.ExchangeRateSnapshot{
+		{Timestamp: start, Coin: "btc", Reason: repo.ExchangeRateReasonPeriodic, Rates: map[string]float64{"USD": 9000, "EUR": 8000}},
+		{Timestamp: start.Add(time.Hour), Coin: "BTC", Reason: repo.ExchangeRateReasonFunded, OrderID: "order1", Rates: map[string]float64{"USD": 9100}},
+		{Timestamp: start.Add(2 * time.Hour), Coin: "LTC", Reason: repo.ExchangeRateReasonPeriodic, Rates: map[string]float64{"USD": 60}},
+	}
+	for _, s := range snapshots {
+		if err := rates.Put(s); err != nil {
+			t.Fatal(err)
+		}
+		if s.SnapshotID == 0 {
+			t.Error("expected the snapshot ID to be set")
+		}
+	}
+
+	all, err := rates.GetAll(repo.ExchangeRateQuery{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 3 || all[0].SnapshotID != snapshots[2].SnapshotID {
+		t.Fatalf("expected 3 snapshots, most recent first, got %+v", all)
+	}
+
+	btc, err := rates.GetAll(repo.ExchangeRateQuery{Coin: "btc"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(btc) != 2 {
+		t.Fatalf("expected 2 BTC snapshots, got %d", len(btc))
+	}
+	s := btc[1]
+	if s.Coin != "BTC" || s.Reason != repo.ExchangeRateReasonPeriodic || !s.Timestamp.Equal(start) || s.Rates["EUR"] != 8000 {
+		t.Errorf("unexpected snapshot %+v", s)
+	}
+
+	funded, err := rates.GetAll(repo.ExchangeRateQuery{Reason: repo.ExchangeRateReasonFunded, OrderID: "order1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(funded) != 1 || funded[0].Rates["USD"] != 9100 {
+		t.Errorf("unexpected funded snapshots %+v", funded)
+	}
+
+	ranged, err := rates.GetAll(repo.ExchangeRateQuery{Since: start.Add(time.Hour), Until: start.Add(2 * time.Hour)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ranged) != 1 || ranged[0].SnapshotID != snapshots[1].SnapshotID {
+		t.Errorf("unexpected snapshots in range %+v", ranged)
+	}
+
+	page, err := rates.GetAll(repo.ExchangeRateQuery{Offset: 1, Limit: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page) != 1 || page[0].SnapshotID != snapshots[1].SnapshotID {
+		t.Errorf("unexpected page %+v", page)
+	}
+}
+
+func TestExchangeRatesDB_GetAt(t *testing.T) {
+	rates, teardown, err := buildNewExchangeRateStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	start := time.Unix(time.Now().Unix(), 0)
+	for i, usd := range []float64{9000, 9100, 9200} {
+		err := rates.Put(&repo.ExchangeRateSnapshot{Timestamp: start.Add(time.Duration(i) * time.Hour), Coin: "BTC", Reason: repo.ExchangeRateReasonPeriodic, Rates: map[string]float64{"USD": usd}})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s, err := rates.GetAt("BTC", start.Add(90*time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Rates["USD"] != 9100 {
+		t.Errorf("expected the 9100 snapshot, got %+v", s)
+	}
+	if _, err := rates.GetAt("BTC", start.Add(-time.Minute)); err != sql.ErrNoRows {
+		t.Errorf("expected sql.ErrNoRows, got %v", err)
+	}
+	if _, err := rates.GetAt("LTC", start.Add(time.Hour)); err != sql.ErrNoRows {
+		t.Errorf("expected sql.ErrNoRows, got %v", err)
+	}
+}
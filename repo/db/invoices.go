@@ -0,0 +1,83 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+// InvoicesDB represents the invoices table
+type InvoicesDB struct {
+	modelStore
+}
+
+// NewInvoiceStore return new InvoicesDB
+func NewInvoiceStore(db *sql.DB, lock *sync.Mutex) repo.InvoiceStore {
+	return &InvoicesDB{modelStore{db, lock}}
+}
+
+// Put will insert or update the invoice of an order. Unlike insert or
+// replace, reusing the number of another order's invoice fails rather than
+// deleting that invoice.
+func (i *InvoicesDB) Put(record *repo.InvoiceRecord) error {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	res, err := i.db.Exec(`update invoices set invoiceNumber=?, revision=?, issued=?, updated=?, invoice=?, signature=? where orderID=?`,
+		record.InvoiceNumber,
+		record.Revision,
+		record.Issued.Unix(),
+		record.Updated.Unix(),
+		record.Invoice,
+		record.Signature,
+		record.OrderID,
+	)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil || n > 0 {
+		return err
+	}
+	_, err = i.db.Exec(`insert into invoices(orderID, invoiceNumber, revision, issued, updated, invoice, signature) values(?,?,?,?,?,?,?)`,
+		record.OrderID,
+		record.InvoiceNumber,
+		record.Revision,
+		record.Issued.Unix(),
+		record.Updated.Unix(),
+		record.Invoice,
+		record.Signature,
+	)
+	return err
+}
+
+// NextInvoiceNumber returns the number following the highest invoice number
+// issued so far
+func (i *InvoicesDB) NextInvoiceNumber() (uint64, error) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	var number uint64
+	err := i.db.QueryRow("select coalesce(max(invoiceNumber), 0) + 1 from invoices").Scan(&number)
+	return number, err
+}
+
+// Get returns the invoice of an order
+func (i *InvoicesDB) Get(orderID string) (*repo.InvoiceRecord, error) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	var (
+		issued, updated int64
+		r               = &repo.InvoiceRecord{OrderID: orderID}
+	)
+	err := i.db.QueryRow("select invoiceNumber, revision, issued, updated, invoice, signature from invoices where orderID=?", orderID).
+		Scan(&r.InvoiceNumber, &r.Revision, &issued, &updated, &r.Invoice, &r.Signature)
+	if err != nil {
+		return nil, err
+	}
+	r.Issued = time.Unix(issued, 0)
+	r.Updated = time.Unix(updated, 0)
+	return r, nil
+}
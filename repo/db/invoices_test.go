@@ -0,0 +1,89 @@
+package db_test
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kimitzu/kimitzu-go/repo"
+	"github.com/kimitzu/kimitzu-go/repo/db"
+	"github.com/kimitzu/kimitzu-go/schema"
+)
+
+func buildNewInvoiceStore() (repo.InvoiceStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewInvoiceStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func TestInvoicesDB_PutGet(t *testing.T) {
+	invoices, teardown, err := buildNewInvoiceStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	if _, err := invoices.Get("order1"); err != sql.ErrNoRows {
+		t.Errorf("expected sql.ErrNoRows, got %v", err)
+	}
+	number, err := invoices.NextInvoiceNumber()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if number != 1 {
+		t.Errorf("expected the first invoice number to be 1, got %d", number)
+	}
+
+	issued := time.Unix(time.Now().Unix(), 0)
+	record := &repo.InvoiceRecord{
+		OrderID:       "order1",
+		InvoiceNumber: number,
+		Revision:      1,
+		Issued:        issued,
+		Updated:       issued,
+		Invoice:       []byte(`{"number":"INV-000001"}`),
+		Signature:     []byte("sig"),
+	}
+	if err := invoices.Put(record); err != nil {
+		t.Fatal(err)
+	}
+	if number, err = invoices.NextInvoiceNumber(); err != nil || number != 2 {
+		t.Errorf("expected the next invoice number to be 2, got %d (%v)", number, err)
+	}
+
+	// A new revision replaces the old one
+	record.Revision = 2
+	record.Updated = issued.Add(time.Hour)
+	record.Signature = []byte("sig2")
+	if err := invoices.Put(record); err != nil {
+		t.Fatal(err)
+	}
+	got, err := invoices.Get("order1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.InvoiceNumber != 1 || got.Revision != 2 || !got.Issued.Equal(issued) || !got.Updated.Equal(record.Updated) ||
+		string(got.Invoice) != `{"number":"INV-000001"}` || string(got.Signature) != "sig2" {
+		t.Errorf("unexpected invoice %+v", got)
+	}
+
+	// Invoice numbers are unique
+	dup := *record
+	dup.OrderID = "order2"
+	if err := invoices.Put(&dup); err == nil {
+		t.Error("expected reusing an invoice number to fail")
+	}
+}
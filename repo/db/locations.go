@@ -0,0 +1,121 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+// LocationsDB represents the locations table
+type LocationsDB struct {
+	modelStore
+}
+
+// NewLocationStore return new LocationsDB
+func NewLocationStore(db *sql.DB, lock *sync.Mutex) repo.LocationStore {
+	return &LocationsDB{modelStore{db, lock}}
+}
+
+// Put will insert or replace a record in the locations table
+func (l *LocationsDB) Put(record *repo.LocationRecord) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	tx, err := l.db.Begin()
+	if err != nil {
+		return err
+	}
+	stm := `insert or replace into locations(peerID, slug, type, hash, title, thumbnail, latitude, longitude, plusCode, timestamp) values(?,?,?,?,?,?,?,?,?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	defer stmt.Close()
+
+	_, err = stmt.Exec(
+		record.PeerID,
+		record.Slug,
+		record.Type.String(),
+		record.Hash,
+		record.Title,
+		record.Thumbnail,
+		record.Latitude,
+		record.Longitude,
+		record.PlusCode,
+		record.Timestamp.Unix(),
+	)
+	if err != nil {
+		rErr := tx.Rollback()
+		if rErr != nil {
+			return fmt.Errorf("location put fail: %s and rollback failed: %s", err.Error(), rErr.Error())
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// Delete removes the location record for the peerID and slug
+func (l *LocationsDB) Delete(peerID, slug string) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	_, err := l.db.Exec("delete from locations where peerID=? and slug=?", peerID, slug)
+	return err
+}
+
+// GetWithin returns the location records inside the bounding box
+func (l *LocationsDB) GetWithin(south, west, north, east float64, typeFilter []repo.LocationType) ([]*repo.LocationRecord, error) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	stm := "select peerID, slug, type, hash, title, thumbnail, latitude, longitude, plusCode, timestamp from locations where latitude between ? and ?"
+	args := []interface{}{south, north}
+	if west <= east {
+		stm += " and longitude between ? and ?"
+	} else {
+		stm += " and (longitude >= ? or longitude <= ?)"
+	}
+	args = append(args, west, east)
+	if len(typeFilter) > 0 {
+		var placeholders []string
+		for _, t := range typeFilter {
+			placeholders = append(placeholders, "?")
+			args = append(args, t.String())
+		}
+		stm += " and type in (" + strings.Join(placeholders, ",") + ")"
+	}
+	rows, err := l.db.Query(stm, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ret []*repo.LocationRecord
+	for rows.Next() {
+		var (
+			peerID, slug, locationType, hash, title, thumbnail, plusCode string
+			latitude, longitude                                          float64
+			timestamp                                                    int64
+		)
+		if err := rows.Scan(&peerID, &slug, &locationType, &hash, &title, &thumbnail, &latitude, &longitude, &plusCode, &timestamp); err != nil {
+			return nil, err
+		}
+		ret = append(ret, &repo.LocationRecord{
+			PeerID:    peerID,
+			Slug:      slug,
+			Type:      repo.LocationType(locationType),
+			Hash:      hash,
+			Title:     title,
+			Thumbnail: thumbnail,
+			Latitude:  latitude,
+			Longitude: longitude,
+			PlusCode:  plusCode,
+			Timestamp: time.Unix(timestamp, 0),
+		})
+	}
+	return ret, rows.Err()
+}
@@ -0,0 +1,98 @@
+package db_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kimitzu/kimitzu-go/repo"
+	"github.com/kimitzu/kimitzu-go/repo/db"
+	"github.com/kimitzu/kimitzu-go/schema"
+)
+
+func buildNewLocationStore() (repo.LocationStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewLocationStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func TestLocationsDB_GetWithin(t *testing.T) {
+	locdb, teardown, err := buildNewLocationStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	records := []*repo.LocationRecord{
+		{PeerID: "QmManila", Slug: "plumbing", Type: repo.LocationTypeListing, Title: "Plumbing", Latitude: 14.5995, Longitude: 120.9842, Timestamp: time.Now()},
+		{PeerID: "QmManila", Type: repo.LocationTypeProfile, Title: "Manila vendor", Latitude: 14.5995, Longitude: 120.9842, Timestamp: time.Now()},
+		{PeerID: "QmCebu", Slug: "tutoring", Type: repo.LocationTypeListing, Title: "Tutoring", Latitude: 10.3157, Longitude: 123.8854, Timestamp: time.Now()},
+		{PeerID: "QmFiji", Slug: "diving", Type: repo.LocationTypeListing, Title: "Diving", Latitude: -17.7134, Longitude: 179.9, Timestamp: time.Now()},
+	}
+	for _, r := range records {
+		if err := locdb.Put(r); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ret, err := locdb.GetWithin(14, 120, 15, 121, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ret) != 2 {
+		t.Errorf("expected 2 records near Manila, got %d", len(ret))
+	}
+
+	ret, err = locdb.GetWithin(14, 120, 15, 121, []repo.LocationType{repo.LocationTypeListing})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ret) != 1 || ret[0].Slug != "plumbing" || ret[0].Title != "Plumbing" {
+		t.Error("expected only the Manila listing")
+	}
+
+	// Bounding box crossing the antimeridian
+	ret, err = locdb.GetWithin(-18, 179, -17, -179, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ret) != 1 || ret[0].PeerID != "QmFiji" {
+		t.Error("expected the listing across the antimeridian")
+	}
+
+	// Replacing and deleting
+	records[2].Latitude = 14.6
+	records[2].Longitude = 121
+	if err := locdb.Put(records[2]); err != nil {
+		t.Fatal(err)
+	}
+	ret, err = locdb.GetWithin(14, 120, 15, 121, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ret) != 3 {
+		t.Errorf("expected 3 records after moving a listing, got %d", len(ret))
+	}
+	if err := locdb.Delete("QmCebu", "tutoring"); err != nil {
+		t.Fatal(err)
+	}
+	ret, err = locdb.GetWithin(-90, -180, 90, 180, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ret) != 3 {
+		t.Errorf("expected 3 records after delete, got %d", len(ret))
+	}
+}
@@ -0,0 +1,173 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+// NotificationDeliveriesDB represents the notificationdeliveries table
+type NotificationDeliveriesDB struct {
+	modelStore
+}
+
+// NewNotificationDeliveryStore return new NotificationDeliveriesDB
+func NewNotificationDeliveryStore(db *sql.DB, lock *sync.Mutex) repo.NotificationDeliveryStore {
+	return &NotificationDeliveriesDB{modelStore{db, lock}}
+}
+
+const selectNotificationDeliveriesSQL = "select deliveryID, channel, notificationID, type, title, body, data, state, attempts, lastError, nextAttempt, created, updated from notificationdeliveries"
+
+// Put will insert or replace a record in the notificationdeliveries table
+func (n *NotificationDeliveriesDB) Put(record *repo.NotificationDeliveryRecord) error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	tx, err := n.db.Begin()
+	if err != nil {
+		return err
+	}
+	stm := `insert or replace into notificationdeliveries(deliveryID, channel, notificationID, type, title, body, data, state, attempts, lastError, nextAttempt, created, updated) values(?,?,?,?,?,?,?,?,?,?,?,?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	defer stmt.Close()
+
+	_, err = stmt.Exec(
+		record.DeliveryID,
+		record.Channel,
+		record.NotificationID,
+		record.Type.String(),
+		record.Title,
+		record.Body,
+		record.Data,
+		record.State.String(),
+		record.Attempts,
+		record.LastError,
+		record.NextAttempt.Unix(),
+		record.Created.Unix(),
+		record.Updated.Unix(),
+	)
+	if err != nil {
+		rErr := tx.Rollback()
+		if rErr != nil {
+			return fmt.Errorf("notification delivery put fail: %s and rollback failed: %s", err.Error(), rErr.Error())
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// Get returns the delivery record with the given ID
+func (n *NotificationDeliveriesDB) Get(deliveryID string) (*repo.NotificationDeliveryRecord, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	rows, err := n.db.Query(selectNotificationDeliveriesSQL+" where deliveryID=?", deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	records, err := scanNotificationDeliveries(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return records[0], nil
+}
+
+// GetAll returns a page of the delivery records, most recent first
+func (n *NotificationDeliveriesDB) GetAll(stateFilter []repo.NotificationDeliveryState, channel string, offset, limit int) ([]*repo.NotificationDeliveryRecord, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	var (
+		clauses []string
+		args    []interface{}
+		stm     = selectNotificationDeliveriesSQL
+	)
+	if len(stateFilter) > 0 {
+		var placeholders []string
+		for _, s := range stateFilter {
+			placeholders = append(placeholders, "?")
+			args = append(args, s.String())
+		}
+		clauses = append(clauses, "state in ("+strings.Join(placeholders, ",")+")")
+	}
+	if channel != "" {
+		clauses = append(clauses, "channel=?")
+		args = append(args, channel)
+	}
+	if len(clauses) > 0 {
+		stm += " where " + strings.Join(clauses, " and ")
+	}
+	stm += " order by created desc, rowid desc"
+	if limit > 0 {
+		stm += " limit ? offset ?"
+		args = append(args, limit, offset)
+	}
+	rows, err := n.db.Query(stm, args...)
+	if err != nil {
+		return nil, err
+	}
+	return scanNotificationDeliveries(rows)
+}
+
+// GetDue returns the pending deliveries which should be attempted by the given time
+func (n *NotificationDeliveriesDB) GetDue(t time.Time) ([]*repo.NotificationDeliveryRecord, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	rows, err := n.db.Query(selectNotificationDeliveriesSQL+" where state=? and nextAttempt<=? order by nextAttempt asc",
+		repo.NotificationDeliveryPending.String(), t.Unix())
+	if err != nil {
+		return nil, err
+	}
+	return scanNotificationDeliveries(rows)
+}
+
+// Delete removes the delivery record with the given ID
+func (n *NotificationDeliveriesDB) Delete(deliveryID string) error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	_, err := n.db.Exec("delete from notificationdeliveries where deliveryID=?", deliveryID)
+	return err
+}
+
+// DeleteDeliveredBefore removes the delivered records last updated before the given time
+func (n *NotificationDeliveriesDB) DeleteDeliveredBefore(t time.Time) error {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	_, err := n.db.Exec("delete from notificationdeliveries where state=? and updated<?", repo.NotificationDeliveryDelivered.String(), t.Unix())
+	return err
+}
+
+func scanNotificationDeliveries(rows *sql.Rows) ([]*repo.NotificationDeliveryRecord, error) {
+	defer rows.Close()
+
+	var ret []*repo.NotificationDeliveryRecord
+	for rows.Next() {
+		var (
+			notificationType, state       string
+			nextAttempt, created, updated int64
+			r                             = new(repo.NotificationDeliveryRecord)
+		)
+		if err := rows.Scan(&r.DeliveryID, &r.Channel, &r.NotificationID, &notificationType, &r.Title, &r.Body, &r.Data, &state, &r.Attempts, &r.LastError, &nextAttempt, &created, &updated); err != nil {
+			return nil, err
+		}
+		r.Type = repo.NotificationType(notificationType)
+		r.State = repo.NotificationDeliveryState(state)
+		r.NextAttempt = time.Unix(nextAttempt, 0)
+		r.Created = time.Unix(created, 0)
+		r.Updated = time.Unix(updated, 0)
+		ret = append(ret, r)
+	}
+	return ret, rows.Err()
+}
@@ -0,0 +1,153 @@
+package db_test
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kimitzu/kimitzu-go/repo"
+	"github.com/kimitzu/kimitzu-go/repo/db"
+	"github.com/kimitzu/kimitzu-go/schema"
+)
+
+func buildNewNotificationDeliveryStore() (repo.NotificationDeliveryStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewNotificationDeliveryStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func TestNotificationDeliveriesDB_PutGet(t *testing.T) {
+	deliveries, teardown, err := buildNewNotificationDeliveryStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	now := time.Unix(time.Now().Unix(), 0)
+	record := &repo.NotificationDeliveryRecord{
+		DeliveryID:     "delivery1",
+		Channel:        "webhook",
+		NotificationID: "notif1",
+		Type:           repo.NotifierTypeTestNotification,
+		Title:          "Test Notification Head",
+		Body:           "Test Notification Body",
+		Data:           []byte(`{"type":"testNotification"}`),
+		State:          repo.NotificationDeliveryPending,
+		NextAttempt:    now,
+		Created:        now,
+		Updated:        now,
+	}
+	if err := deliveries.Put(record); err != nil {
+		t.Fatal(err)
+	}
+	record.Attempts = 1
+	record.LastError = "connection refused"
+	if err := deliveries.Put(record); err != nil {
+		t.Fatal(err)
+	}
+
+	ret, err := deliveries.Get("delivery1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ret.Channel != "webhook" || ret.Type != repo.NotifierTypeTestNotification || ret.State != repo.NotificationDeliveryPending {
+		t.Errorf("unexpected record %+v", ret)
+	}
+	if ret.Attempts != 1 || ret.LastError != "connection refused" || string(ret.Data) != string(record.Data) {
+		t.Errorf("expected the update to replace the record, got %+v", ret)
+	}
+	if !ret.NextAttempt.Equal(now) || !ret.Created.Equal(now) {
+		t.Errorf("unexpected times %s %s", ret.NextAttempt, ret.Created)
+	}
+
+	if _, err := deliveries.Get("missing"); err != sql.ErrNoRows {
+		t.Errorf("expected sql.ErrNoRows for a missing delivery, got %v", err)
+	}
+
+	if err := deliveries.Delete("delivery1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := deliveries.Get("delivery1"); err == nil {
+		t.Error("expected the delivery to be deleted")
+	}
+}
+
+func TestNotificationDeliveriesDB_Queries(t *testing.T) {
+	deliveries, teardown, err := buildNewNotificationDeliveryStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	now := time.Now()
+	records := []*repo.NotificationDeliveryRecord{
+		{DeliveryID: "due", Channel: "webhook", State: repo.NotificationDeliveryPending, NextAttempt: now.Add(-time.Minute), Created: now.Add(-time.Hour * 4), Updated: now},
+		{DeliveryID: "later", Channel: "push", State: repo.NotificationDeliveryPending, NextAttempt: now.Add(time.Hour), Created: now.Add(-time.Hour * 3), Updated: now},
+		{DeliveryID: "dead", Channel: "webhook", State: repo.NotificationDeliveryDead, NextAttempt: now.Add(-time.Hour), Created: now.Add(-time.Hour * 2), Updated: now},
+		{DeliveryID: "old", Channel: "matrix", State: repo.NotificationDeliveryDelivered, Created: now.Add(-time.Hour * 24 * 10), Updated: now.Add(-time.Hour * 24 * 10)},
+		{DeliveryID: "recent", Channel: "matrix", State: repo.NotificationDeliveryDelivered, Created: now.Add(-time.Hour), Updated: now},
+	}
+	for _, r := range records {
+		if err := deliveries.Put(r); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	due, err := deliveries.GetDue(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(due) != 1 || due[0].DeliveryID != "due" {
+		t.Errorf("expected only the due pending delivery, got %d", len(due))
+	}
+
+	all, err := deliveries.GetAll(nil, "", 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 5 || all[0].DeliveryID != "recent" || all[4].DeliveryID != "old" {
+		t.Errorf("expected all deliveries most recent first, got %d", len(all))
+	}
+
+	page, err := deliveries.GetAll(nil, "", 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page) != 2 || page[0].DeliveryID != "dead" || page[1].DeliveryID != "later" {
+		t.Errorf("unexpected page %+v", page)
+	}
+
+	filtered, err := deliveries.GetAll([]repo.NotificationDeliveryState{repo.NotificationDeliveryPending, repo.NotificationDeliveryDead}, "webhook", 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(filtered) != 2 {
+		t.Errorf("expected 2 webhook deliveries, got %d", len(filtered))
+	}
+
+	if err := deliveries.DeleteDeliveredBefore(now.Add(-time.Hour * 24 * 7)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := deliveries.Get("old"); err == nil {
+		t.Error("expected the old delivered record to be purged")
+	}
+	if _, err := deliveries.Get("recent"); err != nil {
+		t.Error("expected the recent delivered record to be kept")
+	}
+	if _, err := deliveries.Get("dead"); err != nil {
+		t.Error("expected dead deliveries to be kept")
+	}
+}
@@ -0,0 +1,90 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/kimitzu/kimitzu-go/pb"
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+// PanelVotesDB represents the panelvotes table
+type PanelVotesDB struct {
+	modelStore
+}
+
+// NewPanelVoteStore return new PanelVotesDB
+func NewPanelVoteStore(db *sql.DB, lock *sync.Mutex) repo.PanelVoteStore {
+	return &PanelVotesDB{modelStore{db, lock}}
+}
+
+// Put will insert or replace the vote of a panel member on a case
+func (p *PanelVotesDB) Put(caseID string, vote *pb.DisputeResolution_PanelVote) error {
+	if vote.Vote == nil {
+		return fmt.Errorf("panel vote on case (%s) is empty", caseID)
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(vote)
+	if err != nil {
+		return err
+	}
+
+	tx, err := p.db.Begin()
+	if err != nil {
+		return err
+	}
+	stm := `insert or replace into panelvotes(caseID, moderatorID, vote, timestamp) values(?,?,?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	defer stmt.Close()
+
+	_, err = stmt.Exec(caseID, vote.Vote.ProposedBy, out, time.Now().UnixNano())
+	if err != nil {
+		rErr := tx.Rollback()
+		if rErr != nil {
+			return fmt.Errorf("panel vote put fail: %s and rollback failed: %s", err.Error(), rErr.Error())
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// GetAll returns the votes cast on a case in the order they were cast
+func (p *PanelVotesDB) GetAll(caseID string) ([]*pb.DisputeResolution_PanelVote, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	rows, err := p.db.Query("select moderatorID, vote from panelvotes where caseID=? order by timestamp asc", caseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ret []*pb.DisputeResolution_PanelVote
+	for rows.Next() {
+		var moderatorID, serialized string
+		if err := rows.Scan(&moderatorID, &serialized); err != nil {
+			return nil, err
+		}
+		vote := new(pb.DisputeResolution_PanelVote)
+		if err := jsonpb.UnmarshalString(serialized, vote); err != nil {
+			log.Errorf("failed unmarshaling panel vote (%s, %s): %s", caseID, moderatorID, err)
+			continue
+		}
+		ret = append(ret, vote)
+	}
+	return ret, rows.Err()
+}
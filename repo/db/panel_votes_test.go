@@ -0,0 +1,82 @@
+package db_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/kimitzu/kimitzu-go/pb"
+	"github.com/kimitzu/kimitzu-go/repo"
+	"github.com/kimitzu/kimitzu-go/repo/db"
+	"github.com/kimitzu/kimitzu-go/schema"
+)
+
+func buildNewPanelVoteStore() (repo.PanelVoteStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewPanelVoteStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func newPanelVote(moderatorID string, buyerPercentage float32) *pb.DisputeResolution_PanelVote {
+	return &pb.DisputeResolution_PanelVote{
+		Vote: &pb.DisputeResolution{
+			OrderId:          "QmCase",
+			ProposedBy:       moderatorID,
+			BuyerPercentage:  buyerPercentage,
+			VendorPercentage: 100 - buyerPercentage,
+		},
+		Pubkey:    []byte("pubkey"),
+		Signature: []byte("signature"),
+	}
+}
+
+func TestPanelVotesDB_PutGetAll(t *testing.T) {
+	votes, teardown, err := buildNewPanelVoteStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	for _, v := range []*pb.DisputeResolution_PanelVote{
+		newPanelVote("QmModerator1", 100),
+		newPanelVote("QmModerator2", 50),
+		// A member voting again replaces their earlier vote
+		newPanelVote("QmModerator1", 50),
+	} {
+		if err := votes.Put("QmCase", v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := votes.Put("QmOtherCase", newPanelVote("QmModerator3", 0)); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := votes.GetAll("QmCase")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("expected 2 votes, got %d", len(got))
+	}
+	if got[0].Vote.ProposedBy != "QmModerator2" || got[1].Vote.ProposedBy != "QmModerator1" {
+		t.Errorf("expected the votes in the order they were cast, got %s and %s", got[0].Vote.ProposedBy, got[1].Vote.ProposedBy)
+	}
+	if got[1].Vote.BuyerPercentage != 50 || string(got[1].Signature) != "signature" {
+		t.Errorf("expected the replaced vote, got %v", got[1])
+	}
+
+	if err := votes.Put("QmCase", &pb.DisputeResolution_PanelVote{}); err == nil {
+		t.Error("expected an empty vote to fail")
+	}
+}
@@ -45,7 +45,7 @@ func (p *PurchasesDB) Put(orderID string, contract pb.RicardianContract, state p
 	if err != nil {
 		return err
 	}
-	stm := `insert or replace into purchases(orderID, contract, state, read, timestamp, total, thumbnail, vendorID, vendorHandle, title, shippingName, shippingAddress, paymentAddr, paymentCoin, coinType, funded, transactions, disputedAt) values(?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,(select funded from purchases where orderID="` + orderID + `"),(select transactions from purchases where orderID="` + orderID + `"),?)`
+	stm := `insert or replace into purchases(orderID, contract, state, read, timestamp, total, thumbnail, vendorID, vendorHandle, title, shippingName, shippingAddress, paymentAddr, paymentCoin, coinType, milestones, milestonesReleased, funded, transactions, disputedAt) values(?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,(select funded from purchases where orderID="` + orderID + `"),(select transactions from purchases where orderID="` + orderID + `"),?)`
 	stmt, err := tx.Prepare(stm)
 	if err != nil {
 		return err
@@ -62,7 +62,7 @@ func (p *PurchasesDB) Put(orderID string, contract pb.RicardianContract, state p
 		shippingAddress = contract.BuyerOrder.Shipping.Address
 	}
 	if contract.BuyerOrder.Payment.Method == pb.Order_Payment_DIRECT || contract.BuyerOrder.Payment.Method == pb.Order_Payment_MODERATED {
-		paymentAddr = contract.BuyerOrder.Payment.Address
+		paymentAddr = pb.OrderPayment(&contract).Address
 	} else if contract.BuyerOrder.Payment.Method == pb.Order_Payment_ADDRESS_REQUEST {
 		paymentAddr = contract.VendorOrderConfirmation.PaymentAddress
 	}
@@ -78,7 +78,7 @@ func (p *PurchasesDB) Put(orderID string, contract pb.RicardianContract, state p
 		int(state),
 		readInt,
 		int(contract.BuyerOrder.Timestamp.Seconds),
-		int(contract.BuyerOrder.Payment.Amount),
+		int(pb.OrderPayment(&contract).Amount),
 		contract.VendorListings[0].Item.Images[0].Tiny,
 		contract.VendorListings[0].VendorID.PeerID,
 		handle,
@@ -88,6 +88,8 @@ func (p *PurchasesDB) Put(orderID string, contract pb.RicardianContract, state p
 		paymentAddr,
 		PaymentCoinForContract(&contract),
 		CoinTypeForContract(&contract),
+		len(contract.BuyerOrder.Milestones),
+		len(contract.BuyerMilestoneReleases),
 		disputedAt,
 	)
 	if err != nil {
@@ -152,7 +154,7 @@ func (p *PurchasesDB) GetAll(stateFilter []pb.OrderState, searchTerm string, sor
 
 	q := query{
 		table:           "purchases",
-		columns:         []string{"orderID", "contract", "timestamp", "total", "title", "thumbnail", "vendorID", "vendorHandle", "shippingName", "shippingAddress", "state", "read", "coinType", "paymentCoin"},
+		columns:         []string{"orderID", "contract", "timestamp", "total", "title", "thumbnail", "vendorID", "vendorHandle", "shippingName", "shippingAddress", "state", "read", "coinType", "paymentCoin", "milestones", "milestonesReleased"},
 		stateFilter:     stateFilter,
 		searchTerm:      searchTerm,
 		searchColumns:   []string{"orderID", "timestamp", "total", "title", "thumbnail", "vendorID", "vendorHandle", "shippingName", "shippingAddress", "paymentAddr"},
@@ -172,8 +174,8 @@ func (p *PurchasesDB) GetAll(stateFilter []pb.OrderState, searchTerm string, sor
 	for rows.Next() {
 		var orderID, title, thumbnail, vendorID, vendorHandle, shippingName, shippingAddr, coinType, paymentCoin string
 		var contract []byte
-		var timestamp, total, stateInt, readInt int
-		if err := rows.Scan(&orderID, &contract, &timestamp, &total, &title, &thumbnail, &vendorID, &vendorHandle, &shippingName, &shippingAddr, &stateInt, &readInt, &coinType, &paymentCoin); err != nil {
+		var timestamp, total, stateInt, readInt, milestones, milestonesReleased int
+		if err := rows.Scan(&orderID, &contract, &timestamp, &total, &title, &thumbnail, &vendorID, &vendorHandle, &shippingName, &shippingAddr, &stateInt, &readInt, &coinType, &paymentCoin, &milestones, &milestonesReleased); err != nil {
 			return ret, 0, err
 		}
 		read := false
@@ -195,21 +197,23 @@ func (p *PurchasesDB) GetAll(stateFilter []pb.OrderState, searchTerm string, sor
 		}
 
 		ret = append(ret, repo.Purchase{
-			OrderId:         orderID,
-			Slug:            slug,
-			Timestamp:       time.Unix(int64(timestamp), 0),
-			Title:           title,
-			Thumbnail:       thumbnail,
-			Total:           uint64(total),
-			VendorId:        vendorID,
-			VendorHandle:    vendorHandle,
-			ShippingName:    shippingName,
-			ShippingAddress: shippingAddr,
-			CoinType:        coinType,
-			PaymentCoin:     paymentCoin,
-			State:           pb.OrderState(stateInt).String(),
-			Moderated:       moderated,
-			Read:            read,
+			OrderId:            orderID,
+			Slug:               slug,
+			Timestamp:          time.Unix(int64(timestamp), 0),
+			Title:              title,
+			Thumbnail:          thumbnail,
+			Total:              uint64(total),
+			VendorId:           vendorID,
+			VendorHandle:       vendorHandle,
+			ShippingName:       shippingName,
+			ShippingAddress:    shippingAddr,
+			CoinType:           coinType,
+			PaymentCoin:        paymentCoin,
+			State:              pb.OrderState(stateInt).String(),
+			Moderated:          moderated,
+			Read:               read,
+			Milestones:         milestones,
+			MilestonesReleased: milestonesReleased,
 		})
 	}
 	q.columns = []string{"Count(*)"}
@@ -400,10 +404,11 @@ func (p *PurchasesDB) GetPurchasesForDisputeTimeoutNotification() ([]*repo.Purch
 	p.lock.Lock()
 	defer p.lock.Unlock()
 
-	s := fmt.Sprintf("select orderID, contract, state, timestamp, lastDisputeTimeoutNotifiedAt from purchases where (lastDisputeTimeoutNotifiedAt - timestamp) < %d and state in (%d, %d, %d)",
+	s := fmt.Sprintf("select orderID, contract, state, timestamp, lastDisputeTimeoutNotifiedAt from purchases where (lastDisputeTimeoutNotifiedAt - timestamp) < %d and state in (%d, %d, %d, %d)",
 		int(repo.BuyerDisputeTimeout_totalDuration.Seconds()),
 		pb.OrderState_PENDING,
 		pb.OrderState_AWAITING_FULFILLMENT,
+		pb.OrderState_PARTIALLY_REFUNDED,
 		pb.OrderState_FULFILLED,
 	)
 	rows, err := p.db.Query(s)
@@ -0,0 +1,133 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/kimitzu/kimitzu-go/pb"
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+// QuotesDB represents the quotes table
+type QuotesDB struct {
+	modelStore
+}
+
+// NewQuoteStore return new QuotesDB
+func NewQuoteStore(db *sql.DB, lock *sync.Mutex) repo.QuoteStore {
+	return &QuotesDB{modelStore{db, lock}}
+}
+
+const selectQuotesSQL = "select requestID, history, isSale, peerID, orderID, timestamp from quotes"
+
+// Put will insert or replace a record in the quotes table
+func (q *QuotesDB) Put(record *repo.QuoteRecord) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(record.History)
+	if err != nil {
+		return err
+	}
+
+	tx, err := q.db.Begin()
+	if err != nil {
+		return err
+	}
+	stm := `insert or replace into quotes(requestID, history, isSale, peerID, orderID, timestamp) values(?,?,?,?,?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	defer stmt.Close()
+
+	isSale := 0
+	if record.IsSale {
+		isSale = 1
+	}
+	_, err = stmt.Exec(
+		record.RequestID,
+		out,
+		isSale,
+		record.PeerID,
+		record.OrderID,
+		record.Timestamp.Unix(),
+	)
+	if err != nil {
+		rErr := tx.Rollback()
+		if rErr != nil {
+			return fmt.Errorf("quote put fail: %s and rollback failed: %s", err.Error(), rErr.Error())
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// Get returns the quote record with the given request ID
+func (q *QuotesDB) Get(requestID string) (*repo.QuoteRecord, error) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	rows, err := q.db.Query(selectQuotesSQL+" where requestID=?", requestID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	records, err := scanQuotes(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return records[0], nil
+}
+
+// GetAll returns all quote records, most recently updated first
+func (q *QuotesDB) GetAll() ([]*repo.QuoteRecord, error) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	rows, err := q.db.Query(selectQuotesSQL + " order by timestamp desc")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanQuotes(rows)
+}
+
+func scanQuotes(rows *sql.Rows) ([]*repo.QuoteRecord, error) {
+	var ret []*repo.QuoteRecord
+	for rows.Next() {
+		var (
+			requestID, serialized, peerID, orderID string
+			isSale                                 int
+			timestamp                              int64
+		)
+		if err := rows.Scan(&requestID, &serialized, &isSale, &peerID, &orderID, &timestamp); err != nil {
+			return nil, err
+		}
+		history := new(pb.QuoteHistory)
+		if err := jsonpb.UnmarshalString(serialized, history); err != nil {
+			log.Errorf("failed unmarshaling quote history (%s): %s", requestID, err)
+			continue
+		}
+		ret = append(ret, &repo.QuoteRecord{
+			RequestID: requestID,
+			History:   history,
+			IsSale:    isSale == 1,
+			PeerID:    peerID,
+			OrderID:   orderID,
+			Timestamp: time.Unix(timestamp, 0),
+		})
+	}
+	return ret, rows.Err()
+}
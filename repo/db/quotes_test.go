@@ -0,0 +1,137 @@
+package db_test
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/kimitzu/kimitzu-go/pb"
+	"github.com/kimitzu/kimitzu-go/repo"
+	"github.com/kimitzu/kimitzu-go/repo/db"
+	"github.com/kimitzu/kimitzu-go/schema"
+)
+
+func buildNewQuoteStore() (repo.QuoteStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewQuoteStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func newQuoteRecord(requestID string, timestamp time.Time) *repo.QuoteRecord {
+	return &repo.QuoteRecord{
+		RequestID: requestID,
+		History: &pb.QuoteHistory{
+			Request: &pb.SignedQuoteRequest{
+				Request: &pb.QuoteRequest{
+					BuyerID:     &pb.ID{PeerID: "QmBuyer"},
+					VendorID:    "QmVendor",
+					ListingSlug: "logo-design",
+					Quantity:    1,
+					Scope:       "A logo in three colors",
+				},
+				Signature: []byte("signature"),
+			},
+		},
+		IsSale:    true,
+		PeerID:    "QmBuyer",
+		Timestamp: timestamp,
+	}
+}
+
+func TestQuotesDB_PutGet(t *testing.T) {
+	quotes, teardown, err := buildNewQuoteStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	if _, err := quotes.Get("request1"); err != sql.ErrNoRows {
+		t.Errorf("expected sql.ErrNoRows, got %v", err)
+	}
+
+	record := newQuoteRecord("request1", time.Now())
+	if err := quotes.Put(record); err != nil {
+		t.Fatal(err)
+	}
+	ret, err := quotes.Get("request1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ret.IsSale || ret.PeerID != "QmBuyer" || ret.Request().ListingSlug != "logo-design" || ret.LatestQuote() != nil {
+		t.Errorf("unexpected record %+v", ret)
+	}
+	if ret.State(time.Now()) != repo.QuoteStateRequested {
+		t.Errorf("expected state %s, got %s", repo.QuoteStateRequested, ret.State(time.Now()))
+	}
+
+	// Answering the request replaces the record
+	expiry, _ := ptypes.TimestampProto(time.Now().Add(time.Hour))
+	record.History.Quotes = append(record.History.Quotes, &pb.SignedQuote{
+		Quote:     &pb.Quote{RequestID: "request1", Price: 15000, PricingCurrency: "USD", Expiry: expiry},
+		Signature: []byte("signature"),
+	})
+	if err := quotes.Put(record); err != nil {
+		t.Fatal(err)
+	}
+	ret, err = quotes.Get("request1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ret.LatestQuote() == nil || ret.LatestQuote().Price != 15000 {
+		t.Errorf("expected the quote to be stored, got %+v", ret.History)
+	}
+	if ret.State(time.Now()) != repo.QuoteStateQuoted {
+		t.Errorf("expected state %s, got %s", repo.QuoteStateQuoted, ret.State(time.Now()))
+	}
+	if ret.State(time.Now().Add(2*time.Hour)) != repo.QuoteStateExpired {
+		t.Errorf("expected state %s, got %s", repo.QuoteStateExpired, ret.State(time.Now().Add(2*time.Hour)))
+	}
+
+	record.OrderID = "order1"
+	if err := quotes.Put(record); err != nil {
+		t.Fatal(err)
+	}
+	ret, err = quotes.Get("request1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ret.State(time.Now()) != repo.QuoteStateOrdered {
+		t.Errorf("expected state %s, got %s", repo.QuoteStateOrdered, ret.State(time.Now()))
+	}
+}
+
+func TestQuotesDB_GetAll(t *testing.T) {
+	quotes, teardown, err := buildNewQuoteStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	now := time.Now()
+	for i, id := range []string{"request1", "request2", "request3"} {
+		if err := quotes.Put(newQuoteRecord(id, now.Add(time.Duration(i)*time.Minute))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	records, err := quotes.GetAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 3 || records[0].RequestID != "request3" || records[2].RequestID != "request1" {
+		t.Errorf("expected the newest record first, got %d records", len(records))
+	}
+}
@@ -50,7 +50,7 @@ func (s *SalesDB) Put(orderID string, contract pb.RicardianContract, state pb.Or
 	if err != nil {
 		return err
 	}
-	stm := `insert or replace into sales(orderID, contract, state, read, timestamp, total, thumbnail, buyerID, buyerHandle, title, shippingName, shippingAddress, paymentAddr, paymentCoin, coinType, funded, transactions) values(?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,(select funded from sales where orderID="` + orderID + `"),(select transactions from sales where orderID="` + orderID + `"))`
+	stm := `insert or replace into sales(orderID, contract, state, read, timestamp, total, thumbnail, buyerID, buyerHandle, title, shippingName, shippingAddress, paymentAddr, paymentCoin, coinType, milestones, milestonesReleased, funded, transactions) values(?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,?,(select funded from sales where orderID="` + orderID + `"),(select transactions from sales where orderID="` + orderID + `"))`
 	stmt, err := tx.Prepare(stm)
 	if err != nil {
 		return err
@@ -65,7 +65,7 @@ func (s *SalesDB) Put(orderID string, contract pb.RicardianContract, state pb.Or
 	}
 	var address string
 	if contract.BuyerOrder.Payment.Method == pb.Order_Payment_DIRECT || contract.BuyerOrder.Payment.Method == pb.Order_Payment_MODERATED {
-		address = contract.BuyerOrder.Payment.Address
+		address = pb.OrderPayment(&contract).Address
 	} else if contract.BuyerOrder.Payment.Method == pb.Order_Payment_ADDRESS_REQUEST {
 		address = contract.VendorOrderConfirmation.PaymentAddress
 	}
@@ -77,7 +77,7 @@ func (s *SalesDB) Put(orderID string, contract pb.RicardianContract, state pb.Or
 		int(state),
 		readInt,
 		int(contract.BuyerOrder.Timestamp.Seconds),
-		int(contract.BuyerOrder.Payment.Amount),
+		int(pb.OrderPayment(&contract).Amount),
 		contract.VendorListings[0].Item.Images[0].Tiny,
 		contract.BuyerOrder.BuyerID.PeerID,
 		handle,
@@ -87,6 +87,8 @@ func (s *SalesDB) Put(orderID string, contract pb.RicardianContract, state pb.Or
 		address,
 		PaymentCoinForContract(&contract),
 		CoinTypeForContract(&contract),
+		len(contract.BuyerOrder.Milestones),
+		len(contract.BuyerMilestoneReleases),
 	)
 	if err != nil {
 		tx.Rollback()
@@ -150,7 +152,7 @@ func (s *SalesDB) GetAll(stateFilter []pb.OrderState, searchTerm string, sortByA
 	defer s.lock.Unlock()
 	q := query{
 		table:           "sales",
-		columns:         []string{"orderID", "contract", "timestamp", "total", "title", "thumbnail", "buyerID", "buyerHandle", "shippingName", "shippingAddress", "state", "read", "coinType", "paymentCoin"},
+		columns:         []string{"orderID", "contract", "timestamp", "total", "title", "thumbnail", "buyerID", "buyerHandle", "shippingName", "shippingAddress", "state", "read", "coinType", "paymentCoin", "milestones", "milestonesReleased"},
 		stateFilter:     stateFilter,
 		searchTerm:      searchTerm,
 		searchColumns:   []string{"orderID", "timestamp", "total", "title", "thumbnail", "buyerID", "buyerHandle", "shippingName", "shippingAddress", "paymentAddr"},
@@ -169,9 +171,9 @@ func (s *SalesDB) GetAll(stateFilter []pb.OrderState, searchTerm string, sortByA
 	var ret []repo.Sale
 	for rows.Next() {
 		var orderID, title, thumbnail, buyerID, buyerHandle, shippingName, shippingAddr, coinType, paymentCoin string
-		var timestamp, total, stateInt, readInt int
+		var timestamp, total, stateInt, readInt, milestones, milestonesReleased int
 		var contract []byte
-		if err := rows.Scan(&orderID, &contract, &timestamp, &total, &title, &thumbnail, &buyerID, &buyerHandle, &shippingName, &shippingAddr, &stateInt, &readInt, &coinType, &paymentCoin); err != nil {
+		if err := rows.Scan(&orderID, &contract, &timestamp, &total, &title, &thumbnail, &buyerID, &buyerHandle, &shippingName, &shippingAddr, &stateInt, &readInt, &coinType, &paymentCoin, &milestones, &milestonesReleased); err != nil {
 			return ret, 0, err
 		}
 		read := false
@@ -194,21 +196,23 @@ func (s *SalesDB) GetAll(stateFilter []pb.OrderState, searchTerm string, sortByA
 		}
 
 		ret = append(ret, repo.Sale{
-			OrderId:         orderID,
-			Slug:            slug,
-			Timestamp:       time.Unix(int64(timestamp), 0),
-			Title:           title,
-			Thumbnail:       thumbnail,
-			Total:           uint64(total),
-			BuyerId:         buyerID,
-			BuyerHandle:     buyerHandle,
-			ShippingName:    shippingName,
-			ShippingAddress: shippingAddr,
-			CoinType:        coinType,
-			PaymentCoin:     paymentCoin,
-			State:           pb.OrderState(stateInt).String(),
-			Read:            read,
-			Moderated:       moderated,
+			OrderId:            orderID,
+			Slug:               slug,
+			Timestamp:          time.Unix(int64(timestamp), 0),
+			Title:              title,
+			Thumbnail:          thumbnail,
+			Total:              uint64(total),
+			BuyerId:            buyerID,
+			BuyerHandle:        buyerHandle,
+			ShippingName:       shippingName,
+			ShippingAddress:    shippingAddr,
+			CoinType:           coinType,
+			PaymentCoin:        paymentCoin,
+			State:              pb.OrderState(stateInt).String(),
+			Read:               read,
+			Moderated:          moderated,
+			Milestones:         milestones,
+			MilestonesReleased: milestonesReleased,
 		})
 	}
 	q.columns = []string{"Count(*)"}
@@ -0,0 +1,269 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+// The bundled SQLCipher is built on SQLite 3.8 which predates FTS5 so the
+// index is an FTS4 table ranked with the matchinfo() statistics.
+
+// searchColumnWeights are the ranking weights of the columns of the search
+// table in declaration order. Non-indexed columns have no weight.
+var searchColumnWeights = []float64{
+	0,  // type
+	0,  // documentID
+	0,  // peerID
+	10, // title
+	1,  // body
+	5,  // tags
+	3,  // categories
+	3,  // serviceClassification
+	0,  // timestamp
+}
+
+// searchListSeparator joins tags and categories in a single column. It is
+// a token separator so each entry is matched on its own words.
+const searchListSeparator = "\n"
+
+// SearchDB represents the search full text index
+type SearchDB struct {
+	modelStore
+}
+
+// NewSearchStore return new SearchDB
+func NewSearchStore(db *sql.DB, lock *sync.Mutex) repo.SearchStore {
+	return &SearchDB{modelStore{db, lock}}
+}
+
+// Put will insert or replace a document in the search index
+func (s *SearchDB) Put(doc *repo.SearchDocument) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	if err := putSearchDocument(tx, doc); err != nil {
+		rErr := tx.Rollback()
+		if rErr != nil {
+			return fmt.Errorf("search put fail: %s and rollback failed: %s", err.Error(), rErr.Error())
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// Delete will remove a document from the search index
+func (s *SearchDB) Delete(docType repo.SearchDocumentType, id string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, err := s.db.Exec("delete from search where type=? and documentID=?", docType.String(), id)
+	return err
+}
+
+// Search returns a page of the documents matching the query ordered by rank
+// and then by most recent, along with the total number of matches
+func (s *SearchDB) Search(query repo.SearchQuery) ([]*repo.SearchResult, int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var (
+		clauses []string
+		args    []interface{}
+		stm     = "select type, documentID, peerID, title, body, tags, categories, serviceClassification, timestamp"
+	)
+	match := searchMatchExpression(query)
+	if match != "" {
+		stm += ", matchinfo(search, 'pcx'), snippet(search, '<b>', '</b>', '...', -1, 16) from search"
+		clauses = append(clauses, "search match ?")
+		args = append(args, match)
+	} else {
+		stm += ", null, '' from search"
+	}
+	if len(query.Types) > 0 {
+		placeholders := make([]string, 0, len(query.Types))
+		for _, t := range query.Types {
+			placeholders = append(placeholders, "?")
+			args = append(args, t.String())
+		}
+		clauses = append(clauses, "type in ("+strings.Join(placeholders, ",")+")")
+	}
+	if len(clauses) > 0 {
+		stm += " where " + strings.Join(clauses, " and ")
+	}
+
+	rows, err := s.db.Query(stm+";", args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var results []*repo.SearchResult
+	for rows.Next() {
+		var (
+			docType, tags, categories string
+			timestamp                 int64
+			matchInfo                 []byte
+			r                         = new(repo.SearchResult)
+		)
+		if err := rows.Scan(&docType, &r.ID, &r.PeerID, &r.Title, &r.Body, &tags, &categories, &r.ServiceClassification, &timestamp, &matchInfo, &r.Snippet); err != nil {
+			return nil, 0, err
+		}
+		r.Type = repo.SearchDocumentType(docType)
+		r.Tags = splitSearchList(tags)
+		r.Categories = splitSearchList(categories)
+		r.Timestamp = time.Unix(timestamp, 0)
+		if !matchesSearchFilters(&r.SearchDocument, query) {
+			continue
+		}
+		r.Rank = rankMatchInfo(matchInfo)
+		results = append(results, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Rank != results[j].Rank {
+			return results[i].Rank > results[j].Rank
+		}
+		return results[i].Timestamp.After(results[j].Timestamp)
+	})
+	total := len(results)
+	if query.Offset > 0 {
+		if query.Offset >= len(results) {
+			return []*repo.SearchResult{}, total, nil
+		}
+		results = results[query.Offset:]
+	}
+	if query.Limit > 0 && len(results) > query.Limit {
+		results = results[:query.Limit]
+	}
+	return results, total, nil
+}
+
+func putSearchDocument(tx *sql.Tx, doc *repo.SearchDocument) error {
+	if _, err := tx.Exec("delete from search where type=? and documentID=?", doc.Type.String(), doc.ID); err != nil {
+		return err
+	}
+	stm := `insert into search(type, documentID, peerID, title, body, tags, categories, serviceClassification, timestamp) values(?,?,?,?,?,?,?,?,?)`
+	_, err := tx.Exec(stm,
+		doc.Type.String(),
+		doc.ID,
+		doc.PeerID,
+		doc.Title,
+		doc.Body,
+		strings.Join(doc.Tags, searchListSeparator),
+		strings.Join(doc.Categories, searchListSeparator),
+		doc.ServiceClassification,
+		doc.Timestamp.Unix(),
+	)
+	return err
+}
+
+func splitSearchList(s string) []string {
+	if s == "" {
+		return []string{}
+	}
+	return strings.Split(s, searchListSeparator)
+}
+
+// searchMatchExpression builds the FTS query for the search terms and field
+// filters. Terms are quoted so user input cannot form query operators, a
+// trailing * is kept as a prefix search.
+func searchMatchExpression(query repo.SearchQuery) string {
+	var terms []string
+	for _, word := range strings.Fields(query.Query) {
+		prefix := strings.HasSuffix(word, "*")
+		word = strings.Replace(strings.TrimRight(word, "*"), `"`, "", -1)
+		if word == "" {
+			continue
+		}
+		if prefix {
+			word += "*"
+		}
+		terms = append(terms, `"`+word+`"`)
+	}
+	// Column filters cannot be applied to quoted phrases so each word of a
+	// filter is matched on its own. Lower case words are never operators.
+	filter := func(column, s string) {
+		words := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+		for _, word := range words {
+			terms = append(terms, column+":"+word)
+		}
+	}
+	for _, tag := range query.Tags {
+		filter("tags", tag)
+	}
+	for _, category := range query.Categories {
+		filter("categories", category)
+	}
+	filter("serviceClassification", query.ServiceClassification)
+	return strings.Join(terms, " ")
+}
+
+// matchesSearchFilters checks the field filters exactly, the match
+// expression only guarantees their words appear in the right column
+func matchesSearchFilters(doc *repo.SearchDocument, query repo.SearchQuery) bool {
+	contains := func(list []string, s string) bool {
+		for _, l := range list {
+			if strings.EqualFold(strings.TrimSpace(l), strings.TrimSpace(s)) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, tag := range query.Tags {
+		if !contains(doc.Tags, tag) {
+			return false
+		}
+	}
+	for _, category := range query.Categories {
+		if !contains(doc.Categories, category) {
+			return false
+		}
+	}
+	if query.ServiceClassification != "" && !strings.EqualFold(doc.ServiceClassification, query.ServiceClassification) {
+		return false
+	}
+	return true
+}
+
+// rankMatchInfo scores a row from its matchinfo 'pcx' blob. For every phrase
+// and column, the share of all hits of the phrase in that column which fall
+// in this row is added in proportion to the column weight.
+func rankMatchInfo(info []byte) float64 {
+	if len(info) < 8 {
+		return 0
+	}
+	value := func(i int) uint32 {
+		return binary.LittleEndian.Uint32(info[i*4:])
+	}
+	phrases, columns := int(value(0)), int(value(1))
+	if len(info) < (2+3*phrases*columns)*4 {
+		return 0
+	}
+	var rank float64
+	for p := 0; p < phrases; p++ {
+		for c := 0; c < columns && c < len(searchColumnWeights); c++ {
+			i := 2 + 3*(p*columns+c)
+			hitsThisRow, hitsAllRows := value(i), value(i+1)
+			if hitsAllRows > 0 {
+				rank += searchColumnWeights[c] * float64(hitsThisRow) / float64(hitsAllRows)
+			}
+		}
+	}
+	return rank
+}
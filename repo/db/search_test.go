@@ -0,0 +1,206 @@
+package db_test
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kimitzu/kimitzu-go/repo"
+	"github.com/kimitzu/kimitzu-go/repo/db"
+	"github.com/kimitzu/kimitzu-go/schema"
+)
+
+func buildNewSearchStore() (repo.SearchStore, *sql.DB, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return db.NewSearchStore(database, new(sync.Mutex)), database, appSchema.DestroySchemaDirectories, nil
+}
+
+func searchIDs(results []*repo.SearchResult) []string {
+	var ids []string
+	for _, r := range results {
+		ids = append(ids, r.ID)
+	}
+	return ids
+}
+
+func TestSearchDB_Search(t *testing.T) {
+	searchdb, _, teardown, err := buildNewSearchStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	now := time.Now()
+	docs := []*repo.SearchDocument{
+		{Type: repo.SearchDocumentTypeListing, ID: "plumbing", PeerID: "QmVendor", Title: "Emergency plumbing repair", Body: "Leaking pipes fixed fast", Tags: []string{"plumbing", "home repair"}, Categories: []string{"Home"}, ServiceClassification: "construction", Timestamp: now},
+		{Type: repo.SearchDocumentTypeListing, ID: "tutoring", PeerID: "QmVendor", Title: "Math tutoring", Body: "Help with algebra and repair of bad study habits", Tags: []string{"education"}, Categories: []string{"Education"}, ServiceClassification: "education", Timestamp: now.Add(-time.Hour)},
+		{Type: repo.SearchDocumentTypePost, ID: "announcement", PeerID: "QmVendor", Title: "Now offering repair services", Body: "Book a repair today", Tags: []string{"home"}, Timestamp: now.Add(-2 * time.Hour)},
+		{Type: repo.SearchDocumentTypeChat, ID: "msg1", PeerID: "QmBuyer", Body: "Can you repair my sink?", Timestamp: now.Add(-3 * time.Hour)},
+	}
+	for _, doc := range docs {
+		if err := searchdb.Put(doc); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	results, total, err := searchdb.Search(repo.SearchQuery{Query: "repair"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 4 || len(results) != 4 {
+		t.Fatalf("Expected 4 results got %d of %d", len(results), total)
+	}
+	if results[0].ID != "plumbing" {
+		t.Errorf("Expected the title and tag match to rank first got %v", searchIDs(results))
+	}
+	if results[0].Snippet == "" {
+		t.Error("Expected a snippet for the match")
+	}
+
+	// Pagination
+	page, total, err := searchdb.Search(repo.SearchQuery{Query: "repair", Offset: 1, Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 4 || len(page) != 2 || page[0].ID != results[1].ID || page[1].ID != results[2].ID {
+		t.Errorf("Expected the second page of results got %v of %d", searchIDs(page), total)
+	}
+	page, _, err = searchdb.Search(repo.SearchQuery{Query: "repair", Offset: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page) != 0 {
+		t.Errorf("Expected no results past the end got %v", searchIDs(page))
+	}
+
+	// Field filters
+	results, _, err = searchdb.Search(repo.SearchQuery{Query: "repair", Types: []repo.SearchDocumentType{repo.SearchDocumentTypePost, repo.SearchDocumentTypeChat}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 {
+		t.Errorf("Expected only the post and chat message got %v", searchIDs(results))
+	}
+	results, _, err = searchdb.Search(repo.SearchQuery{Tags: []string{"Home Repair"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].ID != "plumbing" {
+		t.Errorf("Expected the tagged listing got %v", searchIDs(results))
+	}
+	results, _, err = searchdb.Search(repo.SearchQuery{Tags: []string{"home"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].ID != "announcement" {
+		t.Errorf("Expected tags to match exactly got %v", searchIDs(results))
+	}
+	results, _, err = searchdb.Search(repo.SearchQuery{Query: "repair", Categories: []string{"Education"}, ServiceClassification: "education"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].ID != "tutoring" {
+		t.Errorf("Expected the education listing got %v", searchIDs(results))
+	}
+
+	// Prefix search and operators in user input
+	results, _, err = searchdb.Search(repo.SearchQuery{Query: "plumb*"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 {
+		t.Errorf("Expected a prefix match got %v", searchIDs(results))
+	}
+	if _, _, err = searchdb.Search(repo.SearchQuery{Query: `repair" OR "NEAR(`}); err != nil {
+		t.Errorf("Expected user input to be quoted got %s", err)
+	}
+
+	// No query returns the most recent first
+	results, total, err = searchdb.Search(repo.SearchQuery{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 4 || results[0].ID != "plumbing" || results[3].ID != "msg1" {
+		t.Errorf("Expected all documents newest first got %v", searchIDs(results))
+	}
+}
+
+func TestSearchDB_PutReplacesAndDeletes(t *testing.T) {
+	searchdb, _, teardown, err := buildNewSearchStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	doc := &repo.SearchDocument{Type: repo.SearchDocumentTypeListing, ID: "bike", Title: "Red bicycle", Timestamp: time.Now()}
+	if err := searchdb.Put(doc); err != nil {
+		t.Fatal(err)
+	}
+	doc.Title = "Blue bicycle"
+	if err := searchdb.Put(doc); err != nil {
+		t.Fatal(err)
+	}
+	results, total, err := searchdb.Search(repo.SearchQuery{Query: "bicycle"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 1 || results[0].Title != "Blue bicycle" {
+		t.Errorf("Expected the document to be replaced got %d results", total)
+	}
+
+	if err := searchdb.Delete(repo.SearchDocumentTypeListing, "bike"); err != nil {
+		t.Fatal(err)
+	}
+	_, total, err = searchdb.Search(repo.SearchQuery{Query: "bicycle"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 0 {
+		t.Errorf("Expected the document to be deleted got %d results", total)
+	}
+}
+
+func TestSearchDB_IndexesChat(t *testing.T) {
+	searchdb, database, teardown, err := buildNewSearchStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+	chatdb := db.NewChatStore(database, new(sync.Mutex))
+
+	if err := chatdb.Put("msg1", "QmPeer", "", "is the bicycle still available?", time.Now(), false, false); err != nil {
+		t.Fatal(err)
+	}
+	results, _, err := searchdb.Search(repo.SearchQuery{Query: "bicycle", Types: []repo.SearchDocumentType{repo.SearchDocumentTypeChat}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].ID != "msg1" || results[0].PeerID != "QmPeer" {
+		t.Errorf("Expected the chat message to be indexed got %v", searchIDs(results))
+	}
+
+	if err := chatdb.DeleteConversation("QmPeer"); err != nil {
+		t.Fatal(err)
+	}
+	_, total, err := searchdb.Search(repo.SearchQuery{Query: "bicycle"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 0 {
+		t.Errorf("Expected the deleted conversation to be removed from the index got %d results", total)
+	}
+}
@@ -112,6 +112,15 @@ func (s *SettingsDB) Update(settings repo.SettingsData) error {
 	if settings.SMTPSettings == nil {
 		settings.SMTPSettings = current.SMTPSettings
 	}
+	if settings.WebhookSettings == nil {
+		settings.WebhookSettings = current.WebhookSettings
+	}
+	if settings.MatrixSettings == nil {
+		settings.MatrixSettings = current.MatrixSettings
+	}
+	if settings.PushSettings == nil {
+		settings.PushSettings = current.PushSettings
+	}
 	if settings.Version == nil {
 		settings.Version = current.Version
 	}
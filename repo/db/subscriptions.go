@@ -0,0 +1,169 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/kimitzu/kimitzu-go/pb"
+	"github.com/kimitzu/kimitzu-go/repo"
+)
+
+// SubscriptionsDB represents the subscriptions table
+type SubscriptionsDB struct {
+	modelStore
+}
+
+// NewSubscriptionStore return new SubscriptionsDB
+func NewSubscriptionStore(db *sql.DB, lock *sync.Mutex) repo.SubscriptionStore {
+	return &SubscriptionsDB{modelStore{db, lock}}
+}
+
+const selectSubscriptionsSQL = "select subscriptionID, subscription, signature, isSale, peerID, state, period, nextRenewal, remindedPeriod, lastOrderID, timestamp from subscriptions"
+
+// Put will insert or replace a record in the subscriptions table
+func (s *SubscriptionsDB) Put(record *repo.SubscriptionRecord) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(record.Subscription)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	stm := `insert or replace into subscriptions(subscriptionID, subscription, signature, isSale, peerID, state, period, nextRenewal, remindedPeriod, lastOrderID, timestamp) values(?,?,?,?,?,?,?,?,?,?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	defer stmt.Close()
+
+	isSale := 0
+	if record.IsSale {
+		isSale = 1
+	}
+	_, err = stmt.Exec(
+		record.SubscriptionID,
+		out,
+		record.Signature,
+		isSale,
+		record.PeerID,
+		int(record.State),
+		int(record.Period),
+		record.NextRenewal.Unix(),
+		int(record.RemindedPeriod),
+		record.LastOrderID,
+		record.Timestamp.Unix(),
+	)
+	if err != nil {
+		rErr := tx.Rollback()
+		if rErr != nil {
+			return fmt.Errorf("subscription put fail: %s and rollback failed: %s", err.Error(), rErr.Error())
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+// Get returns the subscription record with the given ID
+func (s *SubscriptionsDB) Get(subscriptionID string) (*repo.SubscriptionRecord, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	rows, err := s.db.Query(selectSubscriptionsSQL+" where subscriptionID=?", subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	records, err := scanSubscriptions(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return records[0], nil
+}
+
+// GetAll returns all subscription records, newest first, optionally filtered by state
+func (s *SubscriptionsDB) GetAll(stateFilter []pb.Subscription_State) ([]*repo.SubscriptionRecord, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	var (
+		stm  = selectSubscriptionsSQL
+		args []interface{}
+	)
+	if len(stateFilter) > 0 {
+		var placeholders []string
+		for _, state := range stateFilter {
+			placeholders = append(placeholders, "?")
+			args = append(args, int(state))
+		}
+		stm += " where state in (" + strings.Join(placeholders, ",") + ")"
+	}
+	rows, err := s.db.Query(stm+" order by timestamp desc", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanSubscriptions(rows)
+}
+
+// GetDueBefore returns the active purchased subscriptions which renew before t
+func (s *SubscriptionsDB) GetDueBefore(t time.Time) ([]*repo.SubscriptionRecord, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	rows, err := s.db.Query(selectSubscriptionsSQL+" where isSale=0 and state=? and nextRenewal<=? order by nextRenewal", int(pb.Subscription_ACTIVE), t.Unix())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanSubscriptions(rows)
+}
+
+func scanSubscriptions(rows *sql.Rows) ([]*repo.SubscriptionRecord, error) {
+	var ret []*repo.SubscriptionRecord
+	for rows.Next() {
+		var (
+			subscriptionID, serialized, peerID, lastOrderID string
+			signature                                       []byte
+			isSale, state, period, remindedPeriod           int
+			nextRenewal, timestamp                          int64
+		)
+		if err := rows.Scan(&subscriptionID, &serialized, &signature, &isSale, &peerID, &state, &period, &nextRenewal, &remindedPeriod, &lastOrderID, &timestamp); err != nil {
+			return nil, err
+		}
+		subscription := new(pb.Subscription)
+		if err := jsonpb.UnmarshalString(serialized, subscription); err != nil {
+			log.Errorf("failed unmarshaling subscription (%s): %s", subscriptionID, err)
+			continue
+		}
+		ret = append(ret, &repo.SubscriptionRecord{
+			SubscriptionID: subscriptionID,
+			Subscription:   subscription,
+			Signature:      signature,
+			IsSale:         isSale == 1,
+			PeerID:         peerID,
+			State:          pb.Subscription_State(state),
+			Period:         uint32(period),
+			NextRenewal:    time.Unix(nextRenewal, 0),
+			RemindedPeriod: uint32(remindedPeriod),
+			LastOrderID:    lastOrderID,
+			Timestamp:      time.Unix(timestamp, 0),
+		})
+	}
+	return ret, rows.Err()
+}
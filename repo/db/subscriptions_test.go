@@ -0,0 +1,137 @@
+package db_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kimitzu/kimitzu-go/pb"
+	"github.com/kimitzu/kimitzu-go/repo"
+	"github.com/kimitzu/kimitzu-go/repo/db"
+	"github.com/kimitzu/kimitzu-go/schema"
+)
+
+func buildNewSubscriptionStore() (repo.SubscriptionStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewSubscriptionStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func newSubscriptionRecord(id string, state pb.Subscription_State, isSale bool, nextRenewal time.Time) *repo.SubscriptionRecord {
+	return &repo.SubscriptionRecord{
+		SubscriptionID: id,
+		Subscription: &pb.Subscription{
+			VendorID:    "QmVendor",
+			ListingSlug: "monthly-retainer",
+			Quantity:    1,
+			PaymentCoin: "TBTC",
+			Periods:     12,
+		},
+		Signature:   []byte("signature"),
+		IsSale:      isSale,
+		PeerID:      "QmPeer",
+		State:       state,
+		Period:      1,
+		NextRenewal: nextRenewal,
+		LastOrderID: "orderID1",
+		Timestamp:   time.Now(),
+	}
+}
+
+func TestSubscriptionsDB_PutGet(t *testing.T) {
+	subdb, teardown, err := buildNewSubscriptionStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	record := newSubscriptionRecord("sub1", pb.Subscription_ACTIVE, false, time.Now().Add(time.Hour))
+	if err := subdb.Put(record); err != nil {
+		t.Fatal(err)
+	}
+	ret, err := subdb.Get("sub1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ret.Subscription.ListingSlug != record.Subscription.ListingSlug {
+		t.Errorf("expected slug %s, got %s", record.Subscription.ListingSlug, ret.Subscription.ListingSlug)
+	}
+	if ret.State != pb.Subscription_ACTIVE || ret.Period != 1 || ret.LastOrderID != "orderID1" || ret.IsSale {
+		t.Error("returned subscription record does not match the one saved")
+	}
+	if ret.NextRenewal.Unix() != record.NextRenewal.Unix() {
+		t.Errorf("expected next renewal %s, got %s", record.NextRenewal, ret.NextRenewal)
+	}
+
+	record.State = pb.Subscription_PAUSED
+	if err := subdb.Put(record); err != nil {
+		t.Fatal(err)
+	}
+	ret, err = subdb.Get("sub1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ret.State != pb.Subscription_PAUSED {
+		t.Errorf("expected state PAUSED, got %s", ret.State)
+	}
+
+	if _, err := subdb.Get("missing"); err == nil {
+		t.Error("expected an error for an unknown subscription")
+	}
+}
+
+func TestSubscriptionsDB_GetAllAndDue(t *testing.T) {
+	subdb, teardown, err := buildNewSubscriptionStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	now := time.Now()
+	records := []*repo.SubscriptionRecord{
+		newSubscriptionRecord("due", pb.Subscription_ACTIVE, false, now.Add(-time.Hour)),
+		newSubscriptionRecord("later", pb.Subscription_ACTIVE, false, now.Add(time.Hour*24*10)),
+		newSubscriptionRecord("paused", pb.Subscription_PAUSED, false, now.Add(-time.Hour)),
+		newSubscriptionRecord("sale", pb.Subscription_ACTIVE, true, now.Add(-time.Hour)),
+	}
+	for _, r := range records {
+		if err := subdb.Put(r); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	all, err := subdb.GetAll(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 4 {
+		t.Errorf("expected 4 subscriptions, got %d", len(all))
+	}
+	active, err := subdb.GetAll([]pb.Subscription_State{pb.Subscription_ACTIVE})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(active) != 3 {
+		t.Errorf("expected 3 active subscriptions, got %d", len(active))
+	}
+
+	due, err := subdb.GetDueBefore(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(due) != 1 || due[0].SubscriptionID != "due" {
+		t.Errorf("expected only the due purchase subscription, got %d records", len(due))
+	}
+}
@@ -1,6 +1,7 @@
 package repo
 
 import (
+	"errors"
 	"time"
 
 	"github.com/kimitzu/kimitzu-go/pb"
@@ -13,6 +14,9 @@ var (
 	ModeratorDisputeExpiry_lastInterval   = time.Duration(45*24) * time.Hour
 )
 
+// ErrDuplicateCaseMessage - the message is already saved with the case
+var ErrDuplicateCaseMessage = errors.New("case message already exists")
+
 // DisputeCaseRecord is a one-to-one relationship with records in the
 // SQL datastore
 type DisputeCaseRecord struct {
@@ -0,0 +1,55 @@
+package repo
+
+import (
+	"time"
+)
+
+// ExchangeRateReason is why an exchange rate snapshot was taken
+type ExchangeRateReason string
+
+const (
+	// ExchangeRateReasonPeriodic is a snapshot taken on a schedule
+	ExchangeRateReasonPeriodic ExchangeRateReason = "periodic"
+	// ExchangeRateReasonFunded is taken when an order is funded
+	ExchangeRateReasonFunded ExchangeRateReason = "funded"
+	// ExchangeRateReasonReleased is taken when the funds of an order are
+	// released to the vendor or paid out after a dispute
+	ExchangeRateReasonReleased ExchangeRateReason = "released"
+	// ExchangeRateReasonRefunded is taken when an order is refunded
+	ExchangeRateReasonRefunded ExchangeRateReason = "refunded"
+)
+
+func (r ExchangeRateReason) String() string { return string(r) }
+
+// Valid reports whether the reason is one of the known reasons
+func (r ExchangeRateReason) Valid() bool {
+	switch r {
+	case ExchangeRateReasonPeriodic, ExchangeRateReasonFunded, ExchangeRateReasonReleased, ExchangeRateReasonRefunded:
+		return true
+	}
+	return false
+}
+
+// ExchangeRateSnapshot represents a one-to-one relationship with records in
+// the exchangerates table. Rates maps currency codes to the units of that
+// currency one coin was worth, as the exchange rate provider returns them.
+type ExchangeRateSnapshot struct {
+	SnapshotID int64
+	Timestamp  time.Time
+	Coin       string
+	Reason     ExchangeRateReason
+	OrderID    string
+	Rates      map[string]float64
+}
+
+// ExchangeRateQuery selects exchange rate snapshots. Empty fields match every
+// snapshot and a limit of zero returns all matching snapshots.
+type ExchangeRateQuery struct {
+	Coin    string
+	Reason  ExchangeRateReason
+	OrderID string
+	Since   time.Time
+	Until   time.Time
+	Offset  int
+	Limit   int
+}
@@ -0,0 +1,20 @@
+package repo
+
+import (
+	"time"
+)
+
+// InvoiceRecord represents a one-to-one relationship with records in the
+// invoices table. Each sale has at most one invoice which keeps its number
+// when the invoice is regenerated and a new revision replaces the old one.
+type InvoiceRecord struct {
+	OrderID       string
+	InvoiceNumber uint64
+	Revision      uint32
+	Issued        time.Time
+	Updated       time.Time
+	// Invoice is the serialized invoice document and Signature the vendor's
+	// identity key signature covering exactly those bytes
+	Invoice   []byte
+	Signature []byte
+}
@@ -0,0 +1,31 @@
+package repo
+
+import (
+	"time"
+)
+
+// LocationType is the kind of object a location record points at
+type LocationType string
+
+const (
+	LocationTypeListing LocationType = "listing"
+	LocationTypeProfile LocationType = "profile"
+)
+
+func (t LocationType) String() string { return string(t) }
+
+// LocationRecord represents a one-to-one relationship with records
+// in the locations table. Listings are keyed by peerID and slug, profiles
+// by peerID with an empty slug.
+type LocationRecord struct {
+	PeerID    string
+	Slug      string
+	Type      LocationType
+	Hash      string
+	Title     string
+	Thumbnail string
+	Latitude  float64
+	Longitude float64
+	PlusCode  string
+	Timestamp time.Time
+}
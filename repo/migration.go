@@ -47,6 +47,20 @@ var (
 		migrations.Migration024{},
 		migrations.Migration025{},
 		migrations.Migration026{},
+		migrations.Migration027{},
+		migrations.Migration028{},
+		migrations.Migration029{},
+		migrations.Migration030{},
+		migrations.Migration031{},
+		migrations.Migration032{},
+		migrations.Migration033{},
+		migrations.Migration034{},
+		migrations.Migration035{},
+		migrations.Migration036{},
+		migrations.Migration037{},
+		migrations.Migration038{},
+		migrations.Migration039{},
+		migrations.Migration040{},
 	}
 )
 
@@ -0,0 +1,108 @@
+package migrations
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Migration027 adds the milestones and milestonesReleased columns to the sales
+// and purchases tables so milestone orders can be tracked without parsing the contract.
+type Migration027 struct{}
+
+func (Migration027) Up(repoPath, databasePassword string, testnetEnabled bool) error {
+	db, err := OpenDB(repoPath, databasePassword, testnetEnabled)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+
+	const (
+		alterSalesSQL         = "alter table sales rename to sales_old;"
+		createNewSalesSQL     = "create table sales (orderID text primary key not null, contract blob, state integer, read integer, timestamp integer, total integer, thumbnail text, buyerID text, buyerHandle text, title text, shippingName text, shippingAddress text, paymentAddr text, funded integer, transactions blob, lastDisputeTimeoutNotifiedAt integer not null default 0, coinType not null default '', paymentCoin not null default '', milestones integer not null default 0, milestonesReleased integer not null default 0);"
+		insertSalesSQL        = "insert into sales select orderID, contract, state, read, timestamp, total, thumbnail, buyerID, buyerHandle, title, shippingName, shippingAddress, paymentAddr, funded, transactions, lastDisputeTimeoutNotifiedAt, coinType, paymentCoin, 0, 0 from sales_old;"
+		dropSalesTableSQL     = "drop table sales_old;"
+		createSalesIndexSQL   = "create index if not exists index_sales on sales (paymentAddr, timestamp);"
+		alterPurchasesSQL     = "alter table purchases rename to purchases_old;"
+		createNewPurchasesSQL = "create table purchases (orderID text primary key not null, contract blob, state integer, read integer, timestamp integer, total integer, thumbnail text, vendorID text, vendorHandle text, title text, shippingName text, shippingAddress text, paymentAddr text, funded integer, transactions blob, lastDisputeTimeoutNotifiedAt integer not null default 0, lastDisputeExpiryNotifiedAt integer not null default 0, disputedAt integer not null default 0, coinType not null default '', paymentCoin not null default '', milestones integer not null default 0, milestonesReleased integer not null default 0);"
+		insertPurchasesSQL    = "insert into purchases select orderID, contract, state, read, timestamp, total, thumbnail, vendorID, vendorHandle, title, shippingName, shippingAddress, paymentAddr, funded, transactions, lastDisputeTimeoutNotifiedAt, lastDisputeExpiryNotifiedAt, disputedAt, coinType, paymentCoin, 0, 0 from purchases_old;"
+		dropPurchasesTableSQL = "drop table purchases_old;"
+		createPurchasesIndex  = "create index if not exists index_purchases on purchases (paymentAddr, timestamp);"
+	)
+
+	migration := strings.Join([]string{
+		alterSalesSQL,
+		createNewSalesSQL,
+		insertSalesSQL,
+		dropSalesTableSQL,
+		createSalesIndexSQL,
+		alterPurchasesSQL,
+		createNewPurchasesSQL,
+		insertPurchasesSQL,
+		dropPurchasesTableSQL,
+		createPurchasesIndex,
+	}, " ")
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(migration); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	if err := writeRepoVer(repoPath, 28); err != nil {
+		return fmt.Errorf("bumping repover to 28: %s", err.Error())
+	}
+	return nil
+}
+
+func (Migration027) Down(repoPath, databasePassword string, testnetEnabled bool) error {
+	db, err := OpenDB(repoPath, databasePassword, testnetEnabled)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+
+	const (
+		alterSalesSQL         = "alter table sales rename to sales_old;"
+		createNewSalesSQL     = "create table sales (orderID text primary key not null, contract blob, state integer, read integer, timestamp integer, total integer, thumbnail text, buyerID text, buyerHandle text, title text, shippingName text, shippingAddress text, paymentAddr text, funded integer, transactions blob, lastDisputeTimeoutNotifiedAt integer not null default 0, coinType not null default '', paymentCoin not null default '');"
+		insertSalesSQL        = "insert into sales select orderID, contract, state, read, timestamp, total, thumbnail, buyerID, buyerHandle, title, shippingName, shippingAddress, paymentAddr, funded, transactions, lastDisputeTimeoutNotifiedAt, coinType, paymentCoin from sales_old;"
+		dropSalesTableSQL     = "drop table sales_old;"
+		createSalesIndexSQL   = "create index if not exists index_sales on sales (paymentAddr, timestamp);"
+		alterPurchasesSQL     = "alter table purchases rename to purchases_old;"
+		createNewPurchasesSQL = "create table purchases (orderID text primary key not null, contract blob, state integer, read integer, timestamp integer, total integer, thumbnail text, vendorID text, vendorHandle text, title text, shippingName text, shippingAddress text, paymentAddr text, funded integer, transactions blob, lastDisputeTimeoutNotifiedAt integer not null default 0, lastDisputeExpiryNotifiedAt integer not null default 0, disputedAt integer not null default 0, coinType not null default '', paymentCoin not null default '');"
+		insertPurchasesSQL    = "insert into purchases select orderID, contract, state, read, timestamp, total, thumbnail, vendorID, vendorHandle, title, shippingName, shippingAddress, paymentAddr, funded, transactions, lastDisputeTimeoutNotifiedAt, lastDisputeExpiryNotifiedAt, disputedAt, coinType, paymentCoin from purchases_old;"
+		dropPurchasesTableSQL = "drop table purchases_old;"
+		createPurchasesIndex  = "create index if not exists index_purchases on purchases (paymentAddr, timestamp);"
+	)
+
+	migration := strings.Join([]string{
+		alterSalesSQL,
+		createNewSalesSQL,
+		insertSalesSQL,
+		dropSalesTableSQL,
+		createSalesIndexSQL,
+		alterPurchasesSQL,
+		createNewPurchasesSQL,
+		insertPurchasesSQL,
+		dropPurchasesTableSQL,
+		createPurchasesIndex,
+	}, " ")
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(migration); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	if err := writeRepoVer(repoPath, 27); err != nil {
+		return fmt.Errorf("dropping repover to 27: %s", err.Error())
+	}
+	return nil
+}
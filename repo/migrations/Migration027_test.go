@@ -0,0 +1,72 @@
+package migrations
+
+import (
+	"testing"
+
+	"github.com/kimitzu/kimitzu-go/schema"
+)
+
+func TestMigration027(t *testing.T) {
+	// Setup
+	basePath := schema.GenerateTempPath()
+	testRepoPath, err := schema.OpenbazaarPathTransform(basePath, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	appSchema, err := schema.NewCustomSchemaManager(schema.SchemaContext{DataPath: testRepoPath, TestModeEnabled: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = appSchema.BuildSchemaDirectories(); err != nil {
+		t.Fatal(err)
+	}
+	defer appSchema.DestroySchemaDirectories()
+	var (
+		selectSalesSQL     = "select orderID from sales where milestonesReleased=1"
+		selectPurchasesSQL = "select orderID from purchases where milestonesReleased=1"
+	)
+
+	if err := appSchema.InitializeDatabase(); err != nil {
+		t.Fatal(err)
+	}
+
+	migration := Migration027{}
+	if err := migration.Up(appSchema.DataPath(), "", true); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := appSchema.OpenDatabase()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = db.Exec(selectSalesSQL); err != nil {
+		t.Errorf("Expected nil error got %s", err)
+	}
+	if _, err = db.Exec(selectPurchasesSQL); err != nil {
+		t.Errorf("Expected nil error got %s", err)
+	}
+
+	if err = appSchema.VerifySchemaVersion("28"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := migration.Down(appSchema.DataPath(), "", true); err != nil {
+		t.Fatal(err)
+	}
+
+	expectedErr := "no such column: milestonesReleased"
+	_, err = db.Exec(selectSalesSQL)
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("Expected %s got %s", expectedErr, err)
+	}
+	_, err = db.Exec(selectPurchasesSQL)
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("Expected %s got %s", expectedErr, err)
+	}
+
+	// assert repo version reverted
+	if err = appSchema.VerifySchemaVersion("27"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,76 @@
+package migrations
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Migration028 creates the subscriptions table which tracks recurring
+// PER_MONTH service orders.
+type Migration028 struct{}
+
+func (Migration028) Up(repoPath, databasePassword string, testnetEnabled bool) error {
+	db, err := OpenDB(repoPath, databasePassword, testnetEnabled)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+
+	const (
+		createSubscriptionsSQL      = "create table subscriptions (subscriptionID text primary key not null, subscription blob, signature blob, isSale integer, peerID text, state integer, period integer, nextRenewal integer, remindedPeriod integer, lastOrderID text, timestamp integer);"
+		createSubscriptionsIndexSQL = "create index index_subscriptions on subscriptions (state, nextRenewal);"
+	)
+
+	migration := strings.Join([]string{
+		createSubscriptionsSQL,
+		createSubscriptionsIndexSQL,
+	}, " ")
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(migration); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	if err := writeRepoVer(repoPath, 29); err != nil {
+		return fmt.Errorf("bumping repover to 29: %s", err.Error())
+	}
+	return nil
+}
+
+func (Migration028) Down(repoPath, databasePassword string, testnetEnabled bool) error {
+	db, err := OpenDB(repoPath, databasePassword, testnetEnabled)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+
+	const (
+		dropSubscriptionsIndexSQL = "drop index if exists index_subscriptions;"
+		dropSubscriptionsSQL      = "drop table if exists subscriptions;"
+	)
+
+	migration := strings.Join([]string{
+		dropSubscriptionsIndexSQL,
+		dropSubscriptionsSQL,
+	}, " ")
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(migration); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	if err := writeRepoVer(repoPath, 28); err != nil {
+		return fmt.Errorf("dropping repover to 28: %s", err.Error())
+	}
+	return nil
+}
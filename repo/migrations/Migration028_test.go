@@ -0,0 +1,69 @@
+package migrations
+
+import (
+	"testing"
+
+	"github.com/kimitzu/kimitzu-go/schema"
+)
+
+func TestMigration028(t *testing.T) {
+	// Setup
+	basePath := schema.GenerateTempPath()
+	testRepoPath, err := schema.OpenbazaarPathTransform(basePath, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	appSchema, err := schema.NewCustomSchemaManager(schema.SchemaContext{DataPath: testRepoPath, TestModeEnabled: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = appSchema.BuildSchemaDirectories(); err != nil {
+		t.Fatal(err)
+	}
+	defer appSchema.DestroySchemaDirectories()
+	var (
+		dropSubscriptionsSQL   = "drop table if exists subscriptions;"
+		selectSubscriptionsSQL = "select subscriptionID from subscriptions where state=0"
+	)
+
+	if err := appSchema.InitializeDatabase(); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := appSchema.OpenDatabase()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Start from a database which predates the subscriptions table
+	if _, err = db.Exec(dropSubscriptionsSQL); err != nil {
+		t.Fatal(err)
+	}
+
+	migration := Migration028{}
+	if err := migration.Up(appSchema.DataPath(), "", true); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = db.Exec(selectSubscriptionsSQL); err != nil {
+		t.Errorf("Expected nil error got %s", err)
+	}
+
+	if err = appSchema.VerifySchemaVersion("29"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := migration.Down(appSchema.DataPath(), "", true); err != nil {
+		t.Fatal(err)
+	}
+
+	expectedErr := "no such table: subscriptions"
+	_, err = db.Exec(selectSubscriptionsSQL)
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("Expected %s got %s", expectedErr, err)
+	}
+
+	// assert repo version reverted
+	if err = appSchema.VerifySchemaVersion("28"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,76 @@
+package migrations
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Migration030 creates the locations table which indexes the coordinates
+// of our own and cached remote listings and profiles.
+type Migration030 struct{}
+
+func (Migration030) Up(repoPath, databasePassword string, testnetEnabled bool) error {
+	db, err := OpenDB(repoPath, databasePassword, testnetEnabled)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+
+	const (
+		createLocationsSQL      = "create table locations (peerID text not null, slug text not null, type text, hash text, title text, thumbnail text, latitude real, longitude real, plusCode text, timestamp integer, primary key (peerID, slug));"
+		createLocationsIndexSQL = "create index index_locations on locations (latitude, longitude);"
+	)
+
+	migration := strings.Join([]string{
+		createLocationsSQL,
+		createLocationsIndexSQL,
+	}, " ")
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(migration); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	if err := writeRepoVer(repoPath, 31); err != nil {
+		return fmt.Errorf("bumping repover to 31: %s", err.Error())
+	}
+	return nil
+}
+
+func (Migration030) Down(repoPath, databasePassword string, testnetEnabled bool) error {
+	db, err := OpenDB(repoPath, databasePassword, testnetEnabled)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+
+	const (
+		dropLocationsIndexSQL = "drop index if exists index_locations;"
+		dropLocationsSQL      = "drop table if exists locations;"
+	)
+
+	migration := strings.Join([]string{
+		dropLocationsIndexSQL,
+		dropLocationsSQL,
+	}, " ")
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(migration); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	if err := writeRepoVer(repoPath, 30); err != nil {
+		return fmt.Errorf("dropping repover to 30: %s", err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package migrations
+
+import (
+	"testing"
+
+	"github.com/kimitzu/kimitzu-go/schema"
+)
+
+func TestMigration030(t *testing.T) {
+	// Setup
+	basePath := schema.GenerateTempPath()
+	testRepoPath, err := schema.OpenbazaarPathTransform(basePath, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	appSchema, err := schema.NewCustomSchemaManager(schema.SchemaContext{DataPath: testRepoPath, TestModeEnabled: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = appSchema.BuildSchemaDirectories(); err != nil {
+		t.Fatal(err)
+	}
+	defer appSchema.DestroySchemaDirectories()
+	var (
+		dropLocationsSQL   = "drop table if exists locations;"
+		selectLocationsSQL = "select peerID from locations where latitude between 0 and 1"
+	)
+
+	if err := appSchema.InitializeDatabase(); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := appSchema.OpenDatabase()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Start from a database which predates the locations table
+	if _, err = db.Exec(dropLocationsSQL); err != nil {
+		t.Fatal(err)
+	}
+
+	migration := Migration030{}
+	if err := migration.Up(appSchema.DataPath(), "", true); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = db.Exec(selectLocationsSQL); err != nil {
+		t.Errorf("Expected nil error got %s", err)
+	}
+
+	if err = appSchema.VerifySchemaVersion("31"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := migration.Down(appSchema.DataPath(), "", true); err != nil {
+		t.Fatal(err)
+	}
+
+	expectedErr := "no such table: locations"
+	_, err = db.Exec(selectLocationsSQL)
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("Expected %s got %s", expectedErr, err)
+	}
+
+	// assert repo version reverted
+	if err = appSchema.VerifySchemaVersion("30"); err != nil {
+		t.Fatal(err)
+	}
+}
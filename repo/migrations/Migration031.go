@@ -0,0 +1,182 @@
+package migrations
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Migration031 creates the search full text index and fills it with the
+// existing chat messages and our own listings and posts.
+type Migration031 struct{}
+
+type Migration031_SignedListing struct {
+	Listing struct {
+		Slug     string `json:"slug"`
+		VendorID struct {
+			PeerID string `json:"peerID"`
+		} `json:"vendorID"`
+		Metadata struct {
+			ServiceClassification string `json:"serviceClassification"`
+		} `json:"metadata"`
+		Item struct {
+			Title       string   `json:"title"`
+			Description string   `json:"description"`
+			Tags        []string `json:"tags"`
+			Categories  []string `json:"categories"`
+		} `json:"item"`
+	} `json:"listing"`
+}
+
+type Migration031_SignedPost struct {
+	Post struct {
+		Slug     string `json:"slug"`
+		VendorID struct {
+			PeerID string `json:"peerID"`
+		} `json:"vendorID"`
+		Status    string    `json:"status"`
+		LongForm  string    `json:"longForm"`
+		Tags      []string  `json:"tags"`
+		Timestamp time.Time `json:"timestamp"`
+	} `json:"post"`
+}
+
+const (
+	migration031CreateSearchSQL = "create virtual table search using fts4(type, documentID, peerID, title, body, tags, categories, serviceClassification, timestamp, notindexed=type, notindexed=documentID, notindexed=peerID, notindexed=timestamp, tokenize=unicode61);"
+	migration031IndexChatSQL    = "insert into search(type, documentID, peerID, title, body, tags, categories, serviceClassification, timestamp) select 'chat', messageID, peerID, subject, message, '', '', '', timestamp / 1000000000 from chat;"
+	migration031InsertSQL       = "insert into search(type, documentID, peerID, title, body, tags, categories, serviceClassification, timestamp) values(?,?,?,?,?,?,?,?,?);"
+	migration031ListSeparator   = "\n"
+)
+
+func (Migration031) Up(repoPath, databasePassword string, testnetEnabled bool) error {
+	db, err := OpenDB(repoPath, databasePassword, testnetEnabled)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(migration031CreateSearchSQL); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if _, err = tx.Exec(migration031IndexChatSQL); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err = migration031IndexListings(tx, repoPath); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("indexing listings: %s", err.Error())
+	}
+	if err = migration031IndexPosts(tx, repoPath); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("indexing posts: %s", err.Error())
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	if err := writeRepoVer(repoPath, 32); err != nil {
+		return fmt.Errorf("bumping repover to 32: %s", err.Error())
+	}
+	return nil
+}
+
+func (Migration031) Down(repoPath, databasePassword string, testnetEnabled bool) error {
+	db, err := OpenDB(repoPath, databasePassword, testnetEnabled)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec("drop table if exists search;"); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	if err := writeRepoVer(repoPath, 31); err != nil {
+		return fmt.Errorf("dropping repover to 31: %s", err.Error())
+	}
+	return nil
+}
+
+func migration031IndexListings(tx *sql.Tx, repoPath string) error {
+	// Not every node has listings, a missing directory matches no files
+	files, err := filepath.Glob(path.Join(repoPath, "root", "listings", "*.json"))
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		b, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		var sl Migration031_SignedListing
+		if err := json.Unmarshal(b, &sl); err != nil {
+			return err
+		}
+		l := sl.Listing
+		_, err = tx.Exec(migration031InsertSQL,
+			"listing",
+			l.Slug,
+			l.VendorID.PeerID,
+			l.Item.Title,
+			l.Item.Description,
+			strings.Join(l.Item.Tags, migration031ListSeparator),
+			strings.Join(l.Item.Categories, migration031ListSeparator),
+			l.Metadata.ServiceClassification,
+			time.Now().Unix(),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func migration031IndexPosts(tx *sql.Tx, repoPath string) error {
+	files, err := filepath.Glob(path.Join(repoPath, "root", "posts", "*.json"))
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		b, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		var sp Migration031_SignedPost
+		if err := json.Unmarshal(b, &sp); err != nil {
+			return err
+		}
+		p := sp.Post
+		if p.Timestamp.IsZero() {
+			p.Timestamp = time.Now()
+		}
+		_, err = tx.Exec(migration031InsertSQL,
+			"post",
+			p.Slug,
+			p.VendorID.PeerID,
+			p.Status,
+			p.LongForm,
+			strings.Join(p.Tags, migration031ListSeparator),
+			"",
+			"",
+			p.Timestamp.Unix(),
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package migrations
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/kimitzu/kimitzu-go/schema"
+)
+
+func TestMigration031(t *testing.T) {
+	// Setup
+	basePath := schema.GenerateTempPath()
+	testRepoPath, err := schema.OpenbazaarPathTransform(basePath, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	appSchema, err := schema.NewCustomSchemaManager(schema.SchemaContext{DataPath: testRepoPath, TestModeEnabled: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = appSchema.BuildSchemaDirectories(); err != nil {
+		t.Fatal(err)
+	}
+	defer appSchema.DestroySchemaDirectories()
+	var (
+		dropSearchSQL   = "drop table if exists search;"
+		insertChatSQL   = "insert into chat(messageID, peerID, subject, message, read, timestamp, outgoing) values('msg1', 'QmPeer', '', 'is the bicycle still available?', 0, 1500000000000000000, 0);"
+		selectSearchSQL = "select type, documentID, timestamp from search where search match ? order by type;"
+		listingJSON     = `{"listing": {"slug": "red-bicycle", "vendorID": {"peerID": "QmVendor"}, "metadata": {"serviceClassification": "transport"}, "item": {"title": "Red bicycle", "description": "A lightly used bicycle", "tags": ["bike"], "categories": ["Sports"]}}}`
+		listingPath     = path.Join(appSchema.DataPath(), "root", "listings", "red-bicycle.json")
+	)
+
+	if err := appSchema.InitializeDatabase(); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := appSchema.OpenDatabase()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Start from a database which predates the search index
+	if _, err = db.Exec(dropSearchSQL); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = db.Exec(insertChatSQL); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.MkdirAll(path.Dir(listingPath), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(listingPath, []byte(listingJSON), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	migration := Migration031{}
+	if err := migration.Up(appSchema.DataPath(), "", true); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := db.Query(selectSearchSQL, "bicycle")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var found []string
+	for rows.Next() {
+		var (
+			docType, id string
+			timestamp   int64
+		)
+		if err := rows.Scan(&docType, &id, &timestamp); err != nil {
+			t.Fatal(err)
+		}
+		if docType == "chat" && timestamp != 1500000000 {
+			t.Errorf("Expected chat timestamp in seconds got %d", timestamp)
+		}
+		found = append(found, docType+"/"+id)
+	}
+	rows.Close()
+	if len(found) != 2 || found[0] != "chat/msg1" || found[1] != "listing/red-bicycle" {
+		t.Errorf("Expected the chat message and listing to be indexed got %v", found)
+	}
+
+	if err = appSchema.VerifySchemaVersion("32"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := migration.Down(appSchema.DataPath(), "", true); err != nil {
+		t.Fatal(err)
+	}
+
+	expectedErr := "no such table: search"
+	_, err = db.Exec(selectSearchSQL, "bicycle")
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("Expected %s got %s", expectedErr, err)
+	}
+
+	// assert repo version reverted
+	if err = appSchema.VerifySchemaVersion("31"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,76 @@
+package migrations
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Migration032 creates the notificationdeliveries table which queues the
+// outbound notification deliveries so they survive restarts.
+type Migration032 struct{}
+
+func (Migration032) Up(repoPath, databasePassword string, testnetEnabled bool) error {
+	db, err := OpenDB(repoPath, databasePassword, testnetEnabled)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+
+	const (
+		createNotificationDeliveriesSQL      = "create table notificationdeliveries (deliveryID text primary key not null, channel text, notificationID text, type text, title text, body text, data blob, state text, attempts integer, lastError text, nextAttempt integer, created integer, updated integer);"
+		createNotificationDeliveriesIndexSQL = "create index index_notificationdeliveries on notificationdeliveries (state, nextAttempt);"
+	)
+
+	migration := strings.Join([]string{
+		createNotificationDeliveriesSQL,
+		createNotificationDeliveriesIndexSQL,
+	}, " ")
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(migration); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	if err := writeRepoVer(repoPath, 33); err != nil {
+		return fmt.Errorf("bumping repover to 33: %s", err.Error())
+	}
+	return nil
+}
+
+func (Migration032) Down(repoPath, databasePassword string, testnetEnabled bool) error {
+	db, err := OpenDB(repoPath, databasePassword, testnetEnabled)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+
+	const (
+		dropNotificationDeliveriesIndexSQL = "drop index if exists index_notificationdeliveries;"
+		dropNotificationDeliveriesSQL      = "drop table if exists notificationdeliveries;"
+	)
+
+	migration := strings.Join([]string{
+		dropNotificationDeliveriesIndexSQL,
+		dropNotificationDeliveriesSQL,
+	}, " ")
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(migration); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	if err := writeRepoVer(repoPath, 32); err != nil {
+		return fmt.Errorf("dropping repover to 32: %s", err.Error())
+	}
+	return nil
+}
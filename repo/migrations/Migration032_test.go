@@ -0,0 +1,69 @@
+package migrations
+
+import (
+	"testing"
+
+	"github.com/kimitzu/kimitzu-go/schema"
+)
+
+func TestMigration032(t *testing.T) {
+	// Setup
+	basePath := schema.GenerateTempPath()
+	testRepoPath, err := schema.OpenbazaarPathTransform(basePath, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	appSchema, err := schema.NewCustomSchemaManager(schema.SchemaContext{DataPath: testRepoPath, TestModeEnabled: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = appSchema.BuildSchemaDirectories(); err != nil {
+		t.Fatal(err)
+	}
+	defer appSchema.DestroySchemaDirectories()
+	var (
+		dropDeliveriesSQL   = "drop table if exists notificationdeliveries;"
+		selectDeliveriesSQL = "select deliveryID from notificationdeliveries where state = 'pending'"
+	)
+
+	if err := appSchema.InitializeDatabase(); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := appSchema.OpenDatabase()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Start from a database which predates the notificationdeliveries table
+	if _, err = db.Exec(dropDeliveriesSQL); err != nil {
+		t.Fatal(err)
+	}
+
+	migration := Migration032{}
+	if err := migration.Up(appSchema.DataPath(), "", true); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = db.Exec(selectDeliveriesSQL); err != nil {
+		t.Errorf("Expected nil error got %s", err)
+	}
+
+	if err = appSchema.VerifySchemaVersion("33"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := migration.Down(appSchema.DataPath(), "", true); err != nil {
+		t.Fatal(err)
+	}
+
+	expectedErr := "no such table: notificationdeliveries"
+	_, err = db.Exec(selectDeliveriesSQL)
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("Expected %s got %s", expectedErr, err)
+	}
+
+	// assert repo version reverted
+	if err = appSchema.VerifySchemaVersion("32"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,59 @@
+package migrations
+
+import (
+	"fmt"
+)
+
+// Migration033 creates the apitokens table which holds the hashes of the
+// scoped API tokens.
+type Migration033 struct{}
+
+func (Migration033) Up(repoPath, databasePassword string, testnetEnabled bool) error {
+	db, err := OpenDB(repoPath, databasePassword, testnetEnabled)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+
+	const createAPITokensSQL = "create table apitokens (tokenID text primary key not null, name text, hash text unique not null, scopes text, expires integer, created integer, lastUsed integer);"
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(createAPITokensSQL); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	if err := writeRepoVer(repoPath, 34); err != nil {
+		return fmt.Errorf("bumping repover to 34: %s", err.Error())
+	}
+	return nil
+}
+
+func (Migration033) Down(repoPath, databasePassword string, testnetEnabled bool) error {
+	db, err := OpenDB(repoPath, databasePassword, testnetEnabled)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+
+	const dropAPITokensSQL = "drop table if exists apitokens;"
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(dropAPITokensSQL); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	if err := writeRepoVer(repoPath, 33); err != nil {
+		return fmt.Errorf("dropping repover to 33: %s", err.Error())
+	}
+	return nil
+}
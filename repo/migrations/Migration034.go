@@ -0,0 +1,67 @@
+package migrations
+
+import (
+	"fmt"
+)
+
+// Migration034 creates the append-only auditlog table. Triggers refuse
+// updates and deletes so entries cannot be changed after the fact.
+type Migration034 struct{}
+
+func (Migration034) Up(repoPath, databasePassword string, testnetEnabled bool) error {
+	db, err := OpenDB(repoPath, databasePassword, testnetEnabled)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+
+	var statements = []string{
+		"create table auditlog (auditID integer primary key autoincrement, timestamp integer, source text, actor text, remoteAddr text, action text, target text, paramsHash text, success integer, result text);",
+		"create index index_auditlog on auditlog (timestamp);",
+		"create trigger auditlog_no_update before update on auditlog begin select raise(abort, 'the audit log is append-only'); end;",
+		"create trigger auditlog_no_delete before delete on auditlog begin select raise(abort, 'the audit log is append-only'); end;",
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	for _, stmt := range statements {
+		if _, err = tx.Exec(stmt); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	if err := writeRepoVer(repoPath, 35); err != nil {
+		return fmt.Errorf("bumping repover to 35: %s", err.Error())
+	}
+	return nil
+}
+
+func (Migration034) Down(repoPath, databasePassword string, testnetEnabled bool) error {
+	db, err := OpenDB(repoPath, databasePassword, testnetEnabled)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+
+	// Dropping the table drops its index and triggers too
+	const dropAuditLogSQL = "drop table if exists auditlog;"
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(dropAuditLogSQL); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	if err := writeRepoVer(repoPath, 34); err != nil {
+		return fmt.Errorf("dropping repover to 34: %s", err.Error())
+	}
+	return nil
+}
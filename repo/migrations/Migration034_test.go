@@ -0,0 +1,78 @@
+package migrations
+
+import (
+	"testing"
+
+	"github.com/kimitzu/kimitzu-go/schema"
+)
+
+func TestMigration034(t *testing.T) {
+	// Setup
+	basePath := schema.GenerateTempPath()
+	testRepoPath, err := schema.OpenbazaarPathTransform(basePath, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	appSchema, err := schema.NewCustomSchemaManager(schema.SchemaContext{DataPath: testRepoPath, TestModeEnabled: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = appSchema.BuildSchemaDirectories(); err != nil {
+		t.Fatal(err)
+	}
+	defer appSchema.DestroySchemaDirectories()
+	var (
+		dropAuditLogSQL   = "drop table if exists auditlog;"
+		selectAuditLogSQL = "select auditID from auditlog where actor = ''"
+	)
+
+	if err := appSchema.InitializeDatabase(); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := appSchema.OpenDatabase()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Start from a database which predates the auditlog table
+	if _, err = db.Exec(dropAuditLogSQL); err != nil {
+		t.Fatal(err)
+	}
+
+	migration := Migration034{}
+	if err := migration.Up(appSchema.DataPath(), "", true); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = db.Exec(selectAuditLogSQL); err != nil {
+		t.Errorf("Expected nil error got %s", err)
+	}
+	if _, err = db.Exec("insert into auditlog(timestamp, actor) values(1, 'node')"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = db.Exec("update auditlog set actor = 'someone else'"); err == nil {
+		t.Error("Expected updating the audit log to fail")
+	}
+	if _, err = db.Exec("delete from auditlog"); err == nil {
+		t.Error("Expected deleting from the audit log to fail")
+	}
+
+	if err = appSchema.VerifySchemaVersion("35"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := migration.Down(appSchema.DataPath(), "", true); err != nil {
+		t.Fatal(err)
+	}
+
+	expectedErr := "no such table: auditlog"
+	_, err = db.Exec(selectAuditLogSQL)
+	if err == nil || err.Error() != expectedErr {
+		t.Errorf("Expected %s got %s", expectedErr, err)
+	}
+
+	// assert repo version reverted
+	if err = appSchema.VerifySchemaVersion("34"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,64 @@
+package migrations
+
+import (
+	"fmt"
+)
+
+// Migration035 creates the exchangerates table which keeps snapshots of the
+// exchange rates taken periodically and when orders change hands
+type Migration035 struct{}
+
+func (Migration035) Up(repoPath, databasePassword string, testnetEnabled bool) error {
+	db, err := OpenDB(repoPath, databasePassword, testnetEnabled)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+
+	var statements = []string{
+		"create table exchangerates (snapshotID integer primary key autoincrement, timestamp integer, coin text, reason text, orderID text, rates blob);",
+		"create index index_exchangerates on exchangerates (coin, timestamp);",
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	for _, stmt := range statements {
+		if _, err = tx.Exec(stmt); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	if err := writeRepoVer(repoPath, 36); err != nil {
+		return fmt.Errorf("bumping repover to 36: %s", err.Error())
+	}
+	return nil
+}
+
+func (Migration035) Down(repoPath, databasePassword string, testnetEnabled bool) error {
+	db, err := OpenDB(repoPath, databasePassword, testnetEnabled)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+
+	const dropExchangeRatesSQL = "drop table if exists exchangerates;"
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(dropExchangeRatesSQL); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	if err := writeRepoVer(repoPath, 35); err != nil {
+		return fmt.Errorf("dropping repover to 35: %s", err.Error())
+	}
+	return nil
+}
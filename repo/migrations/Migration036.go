@@ -0,0 +1,59 @@
+package migrations
+
+import (
+	"fmt"
+)
+
+// Migration036 creates the invoices table which keeps the signed invoice of
+// each confirmed sale
+type Migration036 struct{}
+
+func (Migration036) Up(repoPath, databasePassword string, testnetEnabled bool) error {
+	db, err := OpenDB(repoPath, databasePassword, testnetEnabled)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+
+	const createInvoicesSQL = "create table invoices (orderID text primary key not null, invoiceNumber integer unique, revision integer, issued integer, updated integer, invoice blob, signature blob);"
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(createInvoicesSQL); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	if err := writeRepoVer(repoPath, 37); err != nil {
+		return fmt.Errorf("bumping repover to 37: %s", err.Error())
+	}
+	return nil
+}
+
+func (Migration036) Down(repoPath, databasePassword string, testnetEnabled bool) error {
+	db, err := OpenDB(repoPath, databasePassword, testnetEnabled)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+
+	const dropInvoicesSQL = "drop table if exists invoices;"
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(dropInvoicesSQL); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	if err := writeRepoVer(repoPath, 36); err != nil {
+		return fmt.Errorf("dropping repover to 36: %s", err.Error())
+	}
+	return nil
+}
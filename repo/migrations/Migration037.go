@@ -0,0 +1,59 @@
+package migrations
+
+import (
+	"fmt"
+)
+
+// Migration037 creates the quotes table which keeps the quote requests we
+// sent and received along with the vendor's answers
+type Migration037 struct{}
+
+func (Migration037) Up(repoPath, databasePassword string, testnetEnabled bool) error {
+	db, err := OpenDB(repoPath, databasePassword, testnetEnabled)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+
+	const createQuotesSQL = "create table quotes (requestID text primary key not null, history blob, isSale integer, peerID text, orderID text, timestamp integer);"
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(createQuotesSQL); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	if err := writeRepoVer(repoPath, 38); err != nil {
+		return fmt.Errorf("bumping repover to 38: %s", err.Error())
+	}
+	return nil
+}
+
+func (Migration037) Down(repoPath, databasePassword string, testnetEnabled bool) error {
+	db, err := OpenDB(repoPath, databasePassword, testnetEnabled)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+
+	const dropQuotesSQL = "drop table if exists quotes;"
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(dropQuotesSQL); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	if err := writeRepoVer(repoPath, 37); err != nil {
+		return fmt.Errorf("dropping repover to 37: %s", err.Error())
+	}
+	return nil
+}
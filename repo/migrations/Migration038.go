@@ -0,0 +1,64 @@
+package migrations
+
+import (
+	"fmt"
+)
+
+// Migration038 creates the appointments table which keeps the time slots
+// booked with us and by us
+type Migration038 struct{}
+
+func (Migration038) Up(repoPath, databasePassword string, testnetEnabled bool) error {
+	db, err := OpenDB(repoPath, databasePassword, testnetEnabled)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+
+	const (
+		createAppointmentsSQL = "create table appointments (orderID text primary key not null, slug text, title text, peerID text, isSale integer, startTime integer, endTime integer, timezone text);"
+		createIndexSQL        = "create index index_appointments on appointments (isSale, startTime);"
+	)
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	for _, stmt := range []string{createAppointmentsSQL, createIndexSQL} {
+		if _, err = tx.Exec(stmt); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	if err := writeRepoVer(repoPath, 39); err != nil {
+		return fmt.Errorf("bumping repover to 39: %s", err.Error())
+	}
+	return nil
+}
+
+func (Migration038) Down(repoPath, databasePassword string, testnetEnabled bool) error {
+	db, err := OpenDB(repoPath, databasePassword, testnetEnabled)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+
+	const dropAppointmentsSQL = "drop table if exists appointments;"
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(dropAppointmentsSQL); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	if err := writeRepoVer(repoPath, 38); err != nil {
+		return fmt.Errorf("dropping repover to 38: %s", err.Error())
+	}
+	return nil
+}
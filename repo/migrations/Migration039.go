@@ -0,0 +1,59 @@
+package migrations
+
+import (
+	"fmt"
+)
+
+// Migration039 creates the panelvotes table which keeps the votes members of
+// a moderator panel cast on a dispute
+type Migration039 struct{}
+
+func (Migration039) Up(repoPath, databasePassword string, testnetEnabled bool) error {
+	db, err := OpenDB(repoPath, databasePassword, testnetEnabled)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+
+	const createPanelVotesSQL = "create table panelvotes (caseID text not null, moderatorID text not null, vote blob, timestamp integer, primary key (caseID, moderatorID));"
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(createPanelVotesSQL); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	if err := writeRepoVer(repoPath, 40); err != nil {
+		return fmt.Errorf("bumping repover to 40: %s", err.Error())
+	}
+	return nil
+}
+
+func (Migration039) Down(repoPath, databasePassword string, testnetEnabled bool) error {
+	db, err := OpenDB(repoPath, databasePassword, testnetEnabled)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+
+	const dropPanelVotesSQL = "drop table if exists panelvotes;"
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(dropPanelVotesSQL); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	if err := writeRepoVer(repoPath, 39); err != nil {
+		return fmt.Errorf("dropping repover to 39: %s", err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package migrations
+
+import (
+	"fmt"
+)
+
+// Migration040 creates the casemessages table which keeps the signed messages
+// posted to the threads of a dispute
+type Migration040 struct{}
+
+func (Migration040) Up(repoPath, databasePassword string, testnetEnabled bool) error {
+	db, err := OpenDB(repoPath, databasePassword, testnetEnabled)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+
+	const (
+		createCaseMessagesSQL      = "create table casemessages (messageID text primary key not null, caseID text, channel integer, threadID text, senderID text, message blob, timestamp integer);"
+		createIndexCaseMessagesSQL = "create index index_casemessages on casemessages (caseID, timestamp);"
+	)
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	for _, stmt := range []string{createCaseMessagesSQL, createIndexCaseMessagesSQL} {
+		if _, err = tx.Exec(stmt); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	if err := writeRepoVer(repoPath, 41); err != nil {
+		return fmt.Errorf("bumping repover to 41: %s", err.Error())
+	}
+	return nil
+}
+
+func (Migration040) Down(repoPath, databasePassword string, testnetEnabled bool) error {
+	db, err := OpenDB(repoPath, databasePassword, testnetEnabled)
+	if err != nil {
+		return fmt.Errorf("opening db: %s", err.Error())
+	}
+
+	const dropCaseMessagesSQL = "drop table if exists casemessages;"
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(dropCaseMessagesSQL); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+	if err := writeRepoVer(repoPath, 40); err != nil {
+		return fmt.Errorf("dropping repover to 40: %s", err.Error())
+	}
+	return nil
+}
@@ -18,6 +18,9 @@ type SettingsData struct {
 	RecentModerators        *[]string          `json:"recentModerators"`
 	MisPaymentBuffer        *float32           `json:"mispaymentBuffer"`
 	SMTPSettings            *SMTPSettings      `json:"smtpSettings"`
+	WebhookSettings         *WebhookSettings   `json:"webhookSettings"`
+	MatrixSettings          *MatrixSettings    `json:"matrixSettings"`
+	PushSettings            *PushSettings      `json:"pushSettings"`
 	Version                 *string            `json:"version"`
 	PreferredCurrencies     *[]string          `json:"preferredCurrencies"`
 	OnlineBroadcastInterval *int			   `json:"onlineBroadcastInterval"`
@@ -36,12 +39,40 @@ type ShippingAddress struct {
 }
 
 type SMTPSettings struct {
-	Notifications  bool   `json:"notifications"`
-	ServerAddress  string `json:"serverAddress"`
-	Username       string `json:"username"`
-	Password       string `json:"password"`
-	SenderEmail    string `json:"senderEmail"`
-	RecipientEmail string `json:"recipientEmail"`
+	Notifications     bool               `json:"notifications"`
+	ServerAddress     string             `json:"serverAddress"`
+	Username          string             `json:"username"`
+	Password          string             `json:"password"`
+	SenderEmail       string             `json:"senderEmail"`
+	RecipientEmail    string             `json:"recipientEmail"`
+	NotificationTypes []NotificationType `json:"notificationTypes"`
+}
+
+// WebhookSettings configures notifications POSTed as JSON to a URL. When a
+// secret is set the body is signed with HMAC-SHA256.
+type WebhookSettings struct {
+	Notifications     bool               `json:"notifications"`
+	URL               string             `json:"url"`
+	Secret            string             `json:"secret"`
+	NotificationTypes []NotificationType `json:"notificationTypes"`
+}
+
+// MatrixSettings configures notifications sent as messages to a Matrix room
+type MatrixSettings struct {
+	Notifications     bool               `json:"notifications"`
+	HomeserverURL     string             `json:"homeserverUrl"`
+	AccessToken       string             `json:"accessToken"`
+	RoomID            string             `json:"roomId"`
+	NotificationTypes []NotificationType `json:"notificationTypes"`
+}
+
+// PushSettings configures notifications published to an ntfy style push topic
+type PushSettings struct {
+	Notifications     bool               `json:"notifications"`
+	ServerURL         string             `json:"serverUrl"`
+	Topic             string             `json:"topic"`
+	AccessToken       string             `json:"accessToken"`
+	NotificationTypes []NotificationType `json:"notificationTypes"`
 }
 
 type Follower struct {
@@ -80,6 +111,8 @@ type Purchase struct {
 	State              string    `json:"state"`
 	Read               bool      `json:"read"`
 	Moderated          bool      `json:"moderated"`
+	Milestones         int       `json:"milestones"`
+	MilestonesReleased int       `json:"milestonesReleased"`
 	UnreadChatMessages int       `json:"unreadChatMessages"`
 }
 
@@ -99,6 +132,8 @@ type Sale struct {
 	State              string    `json:"state"`
 	Read               bool      `json:"read"`
 	Moderated          bool      `json:"moderated"`
+	Milestones         int       `json:"milestones"`
+	MilestonesReleased int       `json:"milestonesReleased"`
 	UnreadChatMessages int       `json:"unreadChatMessages"`
 }
 
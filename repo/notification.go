@@ -220,6 +220,12 @@ func (n *Notification) UnmarshalJSON(data []byte) error {
 	}
 
 	switch payload.NotifierType {
+	case NotifierTypeAPICredentialsChanged:
+		var notifier = APICredentialsNotification{}
+		if err := json.Unmarshal(payload.NotifierData, &notifier); err != nil {
+			return err
+		}
+		n.NotifierData = notifier
 	case NotifierTypeBuyerDisputeTimeout:
 		var notifier = BuyerDisputeTimeout{}
 		if err := json.Unmarshal(payload.NotifierData, &notifier); err != nil {
@@ -232,6 +238,12 @@ func (n *Notification) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		n.NotifierData = notifier
+	case NotifierTypeCaseMessageNotification:
+		var notifier = CaseMessageNotification{}
+		if err := json.Unmarshal(payload.NotifierData, &notifier); err != nil {
+			return err
+		}
+		n.NotifierData = notifier
 	case NotifierTypeCompletionNotification:
 		var notifier = CompletionNotification{}
 		if err := json.Unmarshal(payload.NotifierData, &notifier); err != nil {
@@ -262,6 +274,18 @@ func (n *Notification) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		n.NotifierData = notifier
+	case NotifierTypeDisputeEvidenceNotification:
+		var notifier = DisputeEvidenceNotification{}
+		if err := json.Unmarshal(payload.NotifierData, &notifier); err != nil {
+			return err
+		}
+		n.NotifierData = notifier
+	case NotifierTypeDisputePanelVoteNotification:
+		var notifier = DisputePanelVoteNotification{}
+		if err := json.Unmarshal(payload.NotifierData, &notifier); err != nil {
+			return err
+		}
+		n.NotifierData = notifier
 	case NotifierTypeFollowNotification:
 		var notifier = FollowNotification{}
 		if err := json.Unmarshal(payload.NotifierData, &notifier); err != nil {
@@ -274,6 +298,66 @@ func (n *Notification) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		n.NotifierData = notifier
+	case NotifierTypeMilestoneReleasedNotification:
+		var notifier = MilestoneReleasedNotification{}
+		if err := json.Unmarshal(payload.NotifierData, &notifier); err != nil {
+			return err
+		}
+		n.NotifierData = notifier
+	case NotifierTypeTimesheetEntryNotification:
+		var notifier = TimesheetEntryNotification{}
+		if err := json.Unmarshal(payload.NotifierData, &notifier); err != nil {
+			return err
+		}
+		n.NotifierData = notifier
+	case NotifierTypeTimesheetReviewNotification:
+		var notifier = TimesheetReviewNotification{}
+		if err := json.Unmarshal(payload.NotifierData, &notifier); err != nil {
+			return err
+		}
+		n.NotifierData = notifier
+	case NotifierTypeSubscriptionPaused, NotifierTypeSubscriptionResumed, NotifierTypeSubscriptionCanceled:
+		var notifier = SubscriptionUpdateNotification{}
+		if err := json.Unmarshal(payload.NotifierData, &notifier); err != nil {
+			return err
+		}
+		n.NotifierData = notifier
+	case NotifierTypeSubscriptionRenewalReminder:
+		var notifier = SubscriptionRenewalReminderNotification{}
+		if err := json.Unmarshal(payload.NotifierData, &notifier); err != nil {
+			return err
+		}
+		n.NotifierData = notifier
+	case NotifierTypeSubscriptionRenewalFailed:
+		var notifier = SubscriptionRenewalFailedNotification{}
+		if err := json.Unmarshal(payload.NotifierData, &notifier); err != nil {
+			return err
+		}
+		n.NotifierData = notifier
+	case NotifierTypeQuoteRequestNotification:
+		var notifier = QuoteRequestNotification{}
+		if err := json.Unmarshal(payload.NotifierData, &notifier); err != nil {
+			return err
+		}
+		n.NotifierData = notifier
+	case NotifierTypeQuoteNotification:
+		var notifier = QuoteNotification{}
+		if err := json.Unmarshal(payload.NotifierData, &notifier); err != nil {
+			return err
+		}
+		n.NotifierData = notifier
+	case NotifierTypeOrderAmendmentNotification:
+		var notifier = OrderAmendmentNotification{}
+		if err := json.Unmarshal(payload.NotifierData, &notifier); err != nil {
+			return err
+		}
+		n.NotifierData = notifier
+	case NotifierTypeOrderAmendmentResponse:
+		var notifier = OrderAmendmentResponseNotification{}
+		if err := json.Unmarshal(payload.NotifierData, &notifier); err != nil {
+			return err
+		}
+		n.NotifierData = notifier
 	case NotifierTypeModeratorAddNotification:
 		var notifier = ModeratorAddNotification{}
 		if err := json.Unmarshal(payload.NotifierData, &notifier); err != nil {
@@ -328,6 +412,12 @@ func (n *Notification) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		n.NotifierData = notifier
+	case NotifierTypePartialRefundNotification:
+		var notifier = PartialRefundNotification{}
+		if err := json.Unmarshal(payload.NotifierData, &notifier); err != nil {
+			return err
+		}
+		n.NotifierData = notifier
 	case NotifierTypeRefundNotification:
 		var notifier = RefundNotification{}
 		if err := json.Unmarshal(payload.NotifierData, &notifier); err != nil {
@@ -550,6 +640,36 @@ func (n RefundNotification) GetSMTPTitleAndBody() (string, string, bool) {
 	return "Payment refunded", fmt.Sprintf(form, n.OrderId), true
 }
 
+// PartialRefundNotification represents a notification that the vendor
+// refunded part of an order. Amount and Remaining are in the payment coin.
+type PartialRefundNotification struct {
+	ID           string           `json:"notificationId"`
+	Type         NotificationType `json:"type"`
+	OrderId      string           `json:"orderId"`
+	Amount       uint64           `json:"amount"`
+	Remaining    uint64           `json:"remaining"`
+	CurrencyCode string           `json:"currencyCode"`
+	Memo         string           `json:"memo"`
+	Thumbnail    Thumbnail        `json:"thumbnail"`
+	VendorHandle string           `json:"vendorHandle"`
+	VendorID     string           `json:"vendorId"`
+}
+
+func (n PartialRefundNotification) Data() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n PartialRefundNotification) WebsocketData() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n PartialRefundNotification) GetID() string { return n.ID }
+func (n PartialRefundNotification) GetType() NotificationType {
+	return NotifierTypePartialRefundNotification
+}
+func (n PartialRefundNotification) GetSMTPTitleAndBody() (string, string, bool) {
+	form := "Part of the payment for order \"%s\" was refunded."
+	return "Payment partially refunded", fmt.Sprintf(form, n.OrderId), true
+}
+
 type FulfillmentNotification struct {
 	ID           string           `json:"notificationId"`
 	Type         NotificationType `json:"type"`
@@ -574,6 +694,408 @@ func (n FulfillmentNotification) GetSMTPTitleAndBody() (string, string, bool) {
 	return "Order fulfilled", fmt.Sprintf(form, n.OrderId), true
 }
 
+type MilestoneReleasedNotification struct {
+	ID             string           `json:"notificationId"`
+	Type           NotificationType `json:"type"`
+	OrderId        string           `json:"orderId"`
+	MilestoneIndex uint32           `json:"milestoneIndex"`
+	Title          string           `json:"title"`
+	Thumbnail      Thumbnail        `json:"thumbnail"`
+	BuyerHandle    string           `json:"buyerHandle"`
+	BuyerID        string           `json:"buyerId"`
+}
+
+func (n MilestoneReleasedNotification) Data() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n MilestoneReleasedNotification) WebsocketData() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n MilestoneReleasedNotification) GetID() string { return n.ID }
+func (n MilestoneReleasedNotification) GetType() NotificationType {
+	return NotifierTypeMilestoneReleasedNotification
+}
+func (n MilestoneReleasedNotification) GetSMTPTitleAndBody() (string, string, bool) {
+	form := "Milestone \"%s\" of order \"%s\" was released."
+	return "Milestone released", fmt.Sprintf(form, n.Title, n.OrderId), true
+}
+
+type TimesheetEntryNotification struct {
+	ID           string           `json:"notificationId"`
+	Type         NotificationType `json:"type"`
+	OrderId      string           `json:"orderId"`
+	EntryIndex   uint32           `json:"entryIndex"`
+	Hours        float64          `json:"hours"`
+	Thumbnail    Thumbnail        `json:"thumbnail"`
+	VendorHandle string           `json:"vendorHandle"`
+	VendorID     string           `json:"vendorId"`
+}
+
+func (n TimesheetEntryNotification) Data() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n TimesheetEntryNotification) WebsocketData() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n TimesheetEntryNotification) GetID() string { return n.ID }
+func (n TimesheetEntryNotification) GetType() NotificationType {
+	return NotifierTypeTimesheetEntryNotification
+}
+func (n TimesheetEntryNotification) GetSMTPTitleAndBody() (string, string, bool) {
+	form := "The vendor has logged %.2f hours against order \"%s\" which need your review."
+	return "Timesheet entry submitted", fmt.Sprintf(form, n.Hours, n.OrderId), true
+}
+
+type TimesheetReviewNotification struct {
+	ID          string           `json:"notificationId"`
+	Type        NotificationType `json:"type"`
+	OrderId     string           `json:"orderId"`
+	EntryIndex  uint32           `json:"entryIndex"`
+	Status      string           `json:"status"`
+	Thumbnail   Thumbnail        `json:"thumbnail"`
+	BuyerHandle string           `json:"buyerHandle"`
+	BuyerID     string           `json:"buyerId"`
+}
+
+func (n TimesheetReviewNotification) Data() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n TimesheetReviewNotification) WebsocketData() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n TimesheetReviewNotification) GetID() string { return n.ID }
+func (n TimesheetReviewNotification) GetType() NotificationType {
+	return NotifierTypeTimesheetReviewNotification
+}
+func (n TimesheetReviewNotification) GetSMTPTitleAndBody() (string, string, bool) {
+	form := "The buyer has reviewed timesheet entry %d of order \"%s\": %s."
+	return "Timesheet entry reviewed", fmt.Sprintf(form, n.EntryIndex, n.OrderId, n.Status), true
+}
+
+// SubscriptionUpdateNotification represents a notification that the buyer
+// paused, resumed or canceled a subscription we are selling
+type SubscriptionUpdateNotification struct {
+	ID             string           `json:"notificationId"`
+	Type           NotificationType `json:"type"`
+	SubscriptionID string           `json:"subscriptionId"`
+	Slug           string           `json:"slug"`
+	BuyerID        string           `json:"buyerId"`
+}
+
+func (n SubscriptionUpdateNotification) Data() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n SubscriptionUpdateNotification) WebsocketData() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n SubscriptionUpdateNotification) GetID() string             { return n.ID }
+func (n SubscriptionUpdateNotification) GetType() NotificationType { return n.Type }
+func (n SubscriptionUpdateNotification) GetSMTPTitleAndBody() (string, string, bool) {
+	var action string
+	switch n.Type {
+	case NotifierTypeSubscriptionPaused:
+		action = "paused"
+	case NotifierTypeSubscriptionResumed:
+		action = "resumed"
+	case NotifierTypeSubscriptionCanceled:
+		action = "canceled"
+	default:
+		return "", "", false
+	}
+	form := "The buyer has %s their subscription to \"%s\"."
+	return "Subscription " + action, fmt.Sprintf(form, action, n.Slug), true
+}
+
+// SubscriptionRenewalReminderNotification represents a notification that
+// one of our subscriptions will soon place the order for its next period
+type SubscriptionRenewalReminderNotification struct {
+	ID             string           `json:"notificationId"`
+	Type           NotificationType `json:"type"`
+	SubscriptionID string           `json:"subscriptionId"`
+	Slug           string           `json:"slug"`
+	VendorID       string           `json:"vendorId"`
+	Period         uint32           `json:"period"`
+	RenewsAt       *APITime         `json:"renewsAt"`
+}
+
+func (n SubscriptionRenewalReminderNotification) Data() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n SubscriptionRenewalReminderNotification) WebsocketData() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n SubscriptionRenewalReminderNotification) GetID() string { return n.ID }
+func (n SubscriptionRenewalReminderNotification) GetType() NotificationType {
+	return NotifierTypeSubscriptionRenewalReminder
+}
+func (n SubscriptionRenewalReminderNotification) GetSMTPTitleAndBody() (string, string, bool) {
+	form := "Your subscription to \"%s\" will place the order for its next period soon. Pause or cancel it before then to skip the renewal."
+	return "Subscription renewal reminder", fmt.Sprintf(form, n.Slug), true
+}
+
+// SubscriptionRenewalFailedNotification represents a notification that the
+// order for the next period of one of our subscriptions could not be placed
+// and the subscription was paused
+type SubscriptionRenewalFailedNotification struct {
+	ID             string           `json:"notificationId"`
+	Type           NotificationType `json:"type"`
+	SubscriptionID string           `json:"subscriptionId"`
+	Slug           string           `json:"slug"`
+	VendorID       string           `json:"vendorId"`
+	Period         uint32           `json:"period"`
+	Reason         string           `json:"reason"`
+}
+
+func (n SubscriptionRenewalFailedNotification) Data() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n SubscriptionRenewalFailedNotification) WebsocketData() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n SubscriptionRenewalFailedNotification) GetID() string { return n.ID }
+func (n SubscriptionRenewalFailedNotification) GetType() NotificationType {
+	return NotifierTypeSubscriptionRenewalFailed
+}
+func (n SubscriptionRenewalFailedNotification) GetSMTPTitleAndBody() (string, string, bool) {
+	form := "Your subscription to \"%s\" could not place the order for its next period and has been paused: %s. Resume it to try again."
+	return "Subscription renewal failed", fmt.Sprintf(form, n.Slug, n.Reason), true
+}
+
+// APICredentialsNotification represents a notification that the API
+// credentials, the authentication cookie or whether the API requires
+// authentication were changed through the admin API
+type APICredentialsNotification struct {
+	ID            string           `json:"notificationId"`
+	Type          NotificationType `json:"type"`
+	Change        string           `json:"change"`
+	Username      string           `json:"username"`
+	Authenticated bool             `json:"authenticated"`
+	RemoteAddr    string           `json:"remoteAddr"`
+}
+
+func (n APICredentialsNotification) Data() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n APICredentialsNotification) WebsocketData() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n APICredentialsNotification) GetID() string { return n.ID }
+func (n APICredentialsNotification) GetType() NotificationType {
+	return NotifierTypeAPICredentialsChanged
+}
+func (n APICredentialsNotification) GetSMTPTitleAndBody() (string, string, bool) {
+	form := "The API %s of your node was changed from %s. If this was not you, rotate the API credentials right away."
+	return "API credentials changed", fmt.Sprintf(form, n.Change, n.RemoteAddr), true
+}
+
+// QuoteRequestNotification represents a notification that a buyer asked
+// us for a quote on one of our listings
+type QuoteRequestNotification struct {
+	ID          string           `json:"notificationId"`
+	Type        NotificationType `json:"type"`
+	RequestID   string           `json:"requestId"`
+	Slug        string           `json:"slug"`
+	BuyerHandle string           `json:"buyerHandle"`
+	BuyerID     string           `json:"buyerId"`
+}
+
+func (n QuoteRequestNotification) Data() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n QuoteRequestNotification) WebsocketData() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n QuoteRequestNotification) GetID() string { return n.ID }
+func (n QuoteRequestNotification) GetType() NotificationType {
+	return NotifierTypeQuoteRequestNotification
+}
+func (n QuoteRequestNotification) GetSMTPTitleAndBody() (string, string, bool) {
+	form := "A buyer has asked for a quote on \"%s\". Answer with a price, scope and expiry so they can order it."
+	return "Quote requested", fmt.Sprintf(form, n.Slug), true
+}
+
+// QuoteNotification represents a notification that a vendor answered one
+// of our quote requests
+type QuoteNotification struct {
+	ID           string           `json:"notificationId"`
+	Type         NotificationType `json:"type"`
+	RequestID    string           `json:"requestId"`
+	Slug         string           `json:"slug"`
+	Revision     uint32           `json:"revision"`
+	Price        uint64           `json:"price"`
+	Currency     string           `json:"currency"`
+	Expiry       *APITime         `json:"expiry"`
+	VendorHandle string           `json:"vendorHandle"`
+	VendorID     string           `json:"vendorId"`
+}
+
+func (n QuoteNotification) Data() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n QuoteNotification) WebsocketData() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n QuoteNotification) GetID() string { return n.ID }
+func (n QuoteNotification) GetType() NotificationType {
+	return NotifierTypeQuoteNotification
+}
+func (n QuoteNotification) GetSMTPTitleAndBody() (string, string, bool) {
+	form := "The vendor has sent you a quote for \"%s\". Order it before it expires to accept it."
+	return "Quote received", fmt.Sprintf(form, n.Slug), true
+}
+
+// OrderAmendmentNotification represents a notification that the other party
+// to an order proposed new terms for it
+type OrderAmendmentNotification struct {
+	ID             string           `json:"notificationId"`
+	Type           NotificationType `json:"type"`
+	OrderId        string           `json:"orderId"`
+	AmendmentIndex uint32           `json:"amendmentIndex"`
+	Price          uint64           `json:"price"`
+	Coin           string           `json:"coin"`
+	Quantity       uint64           `json:"quantity"`
+	DeliveryDate   *APITime         `json:"deliveryDate,omitempty"`
+	Memo           string           `json:"memo"`
+	Thumbnail      Thumbnail        `json:"thumbnail"`
+	PeerHandle     string           `json:"peerHandle"`
+	PeerID         string           `json:"peerId"`
+}
+
+func (n OrderAmendmentNotification) Data() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n OrderAmendmentNotification) WebsocketData() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n OrderAmendmentNotification) GetID() string { return n.ID }
+func (n OrderAmendmentNotification) GetType() NotificationType {
+	return NotifierTypeOrderAmendmentNotification
+}
+func (n OrderAmendmentNotification) GetSMTPTitleAndBody() (string, string, bool) {
+	form := "New terms were proposed for order %s. Accept them, decline them or send a counter-offer."
+	return "Order amendment proposed", fmt.Sprintf(form, n.OrderId), true
+}
+
+// OrderAmendmentResponseNotification represents a notification that the
+// other party to an order accepted or declined the terms we proposed
+type OrderAmendmentResponseNotification struct {
+	ID             string           `json:"notificationId"`
+	Type           NotificationType `json:"type"`
+	OrderId        string           `json:"orderId"`
+	AmendmentIndex uint32           `json:"amendmentIndex"`
+	Accepted       bool             `json:"accepted"`
+	Reason         string           `json:"reason"`
+	Thumbnail      Thumbnail        `json:"thumbnail"`
+	PeerHandle     string           `json:"peerHandle"`
+	PeerID         string           `json:"peerId"`
+}
+
+func (n OrderAmendmentResponseNotification) Data() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n OrderAmendmentResponseNotification) WebsocketData() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n OrderAmendmentResponseNotification) GetID() string { return n.ID }
+func (n OrderAmendmentResponseNotification) GetType() NotificationType {
+	return NotifierTypeOrderAmendmentResponse
+}
+func (n OrderAmendmentResponseNotification) GetSMTPTitleAndBody() (string, string, bool) {
+	form := "Your proposed terms for order %s were declined."
+	if n.Accepted {
+		form = "Your proposed terms for order %s were accepted."
+	}
+	return "Order amendment answered", fmt.Sprintf(form, n.OrderId), true
+}
+
+// DisputePanelVoteNotification represents a notification that another member
+// of a moderator panel voted on a dispute we moderate with them
+type DisputePanelVoteNotification struct {
+	ID               string           `json:"notificationId"`
+	Type             NotificationType `json:"type"`
+	OrderId          string           `json:"orderId"`
+	ModeratorID      string           `json:"moderatorId"`
+	BuyerPercentage  float32          `json:"buyerPercentage"`
+	VendorPercentage float32          `json:"vendorPercentage"`
+	Votes            int              `json:"votes"`
+	Threshold        uint32           `json:"threshold"`
+	Thumbnail        Thumbnail        `json:"thumbnail"`
+}
+
+func (n DisputePanelVoteNotification) Data() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n DisputePanelVoteNotification) WebsocketData() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n DisputePanelVoteNotification) GetID() string { return n.ID }
+func (n DisputePanelVoteNotification) GetType() NotificationType {
+	return NotifierTypeDisputePanelVoteNotification
+}
+func (n DisputePanelVoteNotification) GetSMTPTitleAndBody() (string, string, bool) {
+	form := "A member of the moderator panel voted on the dispute of order %s. %d of the %d votes needed agree."
+	return "Moderator panel vote", fmt.Sprintf(form, n.OrderId, n.Votes, n.Threshold), true
+}
+
+// DisputeEvidenceNotification represents a notification that a party to a
+// dispute attached a file to it
+type DisputeEvidenceNotification struct {
+	ID         string           `json:"notificationId"`
+	Type       NotificationType `json:"type"`
+	OrderId    string           `json:"orderId"`
+	PeerID     string           `json:"peerId"`
+	PeerHandle string           `json:"peerHandle"`
+	Filename   string           `json:"filename"`
+	Hash       string           `json:"hash"`
+	Thumbnail  Thumbnail        `json:"thumbnail"`
+}
+
+func (n DisputeEvidenceNotification) Data() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n DisputeEvidenceNotification) WebsocketData() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n DisputeEvidenceNotification) GetID() string { return n.ID }
+func (n DisputeEvidenceNotification) GetType() NotificationType {
+	return NotifierTypeDisputeEvidenceNotification
+}
+func (n DisputeEvidenceNotification) GetSMTPTitleAndBody() (string, string, bool) {
+	form := "%s attached %s to the dispute of order %s."
+	return "Dispute evidence", fmt.Sprintf(form, n.PeerID, n.Filename, n.OrderId), true
+}
+
+// CaseMessageNotification represents a notification that a message was
+// posted to a thread of a dispute
+type CaseMessageNotification struct {
+	ID         string           `json:"notificationId"`
+	Type       NotificationType `json:"type"`
+	OrderId    string           `json:"orderId"`
+	MessageId  string           `json:"messageId"`
+	ThreadId   string           `json:"threadId"`
+	Channel    string           `json:"channel"`
+	PeerID     string           `json:"peerId"`
+	PeerHandle string           `json:"peerHandle"`
+	Role       string           `json:"role"`
+	Message    string           `json:"message"`
+	Thumbnail  Thumbnail        `json:"thumbnail"`
+}
+
+func (n CaseMessageNotification) Data() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n CaseMessageNotification) WebsocketData() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n CaseMessageNotification) GetID() string { return n.ID }
+func (n CaseMessageNotification) GetType() NotificationType {
+	return NotifierTypeCaseMessageNotification
+}
+func (n CaseMessageNotification) GetSMTPTitleAndBody() (string, string, bool) {
+	form := "%s posted a message to the dispute of order %s:\n\n%s"
+	return "Dispute message", fmt.Sprintf(form, n.PeerID, n.OrderId, n.Message), true
+}
+
 type ProcessingErrorNotification struct {
 	ID           string           `json:"notificationId"`
 	Type         NotificationType `json:"type"`
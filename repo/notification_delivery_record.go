@@ -0,0 +1,38 @@
+package repo
+
+import (
+	"time"
+)
+
+// NotificationDeliveryState is the progress of an outbound notification delivery
+type NotificationDeliveryState string
+
+const (
+	// NotificationDeliveryPending is waiting for its first or next attempt
+	NotificationDeliveryPending NotificationDeliveryState = "pending"
+	// NotificationDeliveryDelivered was accepted by the receiving service
+	NotificationDeliveryDelivered NotificationDeliveryState = "delivered"
+	// NotificationDeliveryDead ran out of attempts and waits to be replayed
+	NotificationDeliveryDead NotificationDeliveryState = "dead"
+)
+
+func (s NotificationDeliveryState) String() string { return string(s) }
+
+// NotificationDeliveryRecord represents a one-to-one relationship with records
+// in the notificationdeliveries table. It holds a notification rendered for
+// one outbound channel so it can be retried after the node restarts.
+type NotificationDeliveryRecord struct {
+	DeliveryID     string
+	Channel        string
+	NotificationID string
+	Type           NotificationType
+	Title          string
+	Body           string
+	Data           []byte
+	State          NotificationDeliveryState
+	Attempts       int
+	LastError      string
+	NextAttempt    time.Time
+	Created        time.Time
+	Updated        time.Time
+}
@@ -143,6 +143,119 @@ func createNotificationExamples() []repo.Notifier {
 			Type:    repo.NotifierTypeVendorFinalizedPayment,
 			OrderID: repo.NewNotificationID(),
 		},
+		repo.PartialRefundNotification{
+			ID:           "partialRefundID",
+			Type:         repo.NotifierTypePartialRefundNotification,
+			OrderId:      repo.NewNotificationID(),
+			Amount:       40000,
+			Remaining:    60000,
+			CurrencyCode: "BTC",
+			Memo:         "half of the work was done",
+		},
+		repo.MilestoneReleasedNotification{
+			ID:             "milestoneReleasedID",
+			Type:           repo.NotifierTypeMilestoneReleasedNotification,
+			OrderId:        repo.NewNotificationID(),
+			MilestoneIndex: 1,
+		},
+		repo.TimesheetEntryNotification{
+			ID:         "timesheetEntryID",
+			Type:       repo.NotifierTypeTimesheetEntryNotification,
+			OrderId:    repo.NewNotificationID(),
+			EntryIndex: 2,
+			Hours:      1.5,
+		},
+		repo.TimesheetReviewNotification{
+			ID:         "timesheetReviewID",
+			Type:       repo.NotifierTypeTimesheetReviewNotification,
+			OrderId:    repo.NewNotificationID(),
+			EntryIndex: 2,
+			Status:     "APPROVED",
+		},
+		repo.SubscriptionUpdateNotification{
+			ID:             "subscriptionUpdateID",
+			Type:           repo.NotifierTypeSubscriptionPaused,
+			SubscriptionID: repo.NewNotificationID(),
+			Slug:           "monthly-retainer",
+		},
+		repo.SubscriptionRenewalReminderNotification{
+			ID:             "subscriptionRenewalReminderID",
+			Type:           repo.NotifierTypeSubscriptionRenewalReminder,
+			SubscriptionID: repo.NewNotificationID(),
+			Period:         3,
+		},
+		repo.SubscriptionRenewalFailedNotification{
+			ID:             "subscriptionRenewalFailedID",
+			Type:           repo.NotifierTypeSubscriptionRenewalFailed,
+			SubscriptionID: repo.NewNotificationID(),
+			Slug:           "monthly-retainer",
+			Period:         3,
+			Reason:         "listing no longer exists",
+		},
+		repo.QuoteRequestNotification{
+			ID:        "quoteRequestID",
+			Type:      repo.NotifierTypeQuoteRequestNotification,
+			RequestID: repo.NewNotificationID(),
+			Slug:      "logo-design",
+		},
+		repo.QuoteNotification{
+			ID:        "quoteID",
+			Type:      repo.NotifierTypeQuoteNotification,
+			RequestID: repo.NewNotificationID(),
+			Slug:      "logo-design",
+			Price:     15000,
+			Currency:  "USD",
+		},
+		repo.CaseMessageNotification{
+			ID:        "caseMessageID",
+			Type:      repo.NotifierTypeCaseMessageNotification,
+			OrderId:   "orderId",
+			MessageId: "messageId",
+			Channel:   "CASE",
+			PeerID:    "peerId",
+			Role:      "MODERATOR",
+			Message:   "Please attach the tracking receipt.",
+		},
+		repo.DisputeEvidenceNotification{
+			ID:       "disputeEvidenceID",
+			Type:     repo.NotifierTypeDisputeEvidenceNotification,
+			OrderId:  "orderId",
+			PeerID:   "peerId",
+			Filename: "delivery.png",
+			Hash:     "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae",
+		},
+		repo.DisputePanelVoteNotification{
+			ID:               "disputePanelVoteID",
+			Type:             repo.NotifierTypeDisputePanelVoteNotification,
+			OrderId:          "orderId",
+			ModeratorID:      "moderatorId",
+			BuyerPercentage:  60,
+			VendorPercentage: 40,
+			Votes:            2,
+			Threshold:        2,
+		},
+		repo.OrderAmendmentNotification{
+			ID:             "orderAmendmentID",
+			Type:           repo.NotifierTypeOrderAmendmentNotification,
+			OrderId:        "orderId",
+			AmendmentIndex: 1,
+			Price:          120000,
+			Coin:           "BTC",
+			Quantity:       2,
+		},
+		repo.OrderAmendmentResponseNotification{
+			ID:             "orderAmendmentResponseID",
+			Type:           repo.NotifierTypeOrderAmendmentResponse,
+			OrderId:        "orderId",
+			AmendmentIndex: 1,
+			Accepted:       true,
+		},
+		repo.APICredentialsNotification{
+			ID:            "apiCredentialsChangedID",
+			Type:          repo.NotifierTypeAPICredentialsChanged,
+			Change:        "cookie",
+			Authenticated: true,
+		},
 	},
 		createLegacyNotificationExamples()...)
 }
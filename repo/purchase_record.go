@@ -36,7 +36,7 @@ type PurchaseRecord struct {
 func (r *PurchaseRecord) IsDisputeable() bool {
 	if r.IsModeratedContract() {
 		switch r.OrderState {
-		case pb.OrderState_PENDING, pb.OrderState_AWAITING_FULFILLMENT, pb.OrderState_FULFILLED:
+		case pb.OrderState_PENDING, pb.OrderState_AWAITING_FULFILLMENT, pb.OrderState_PARTIALLY_REFUNDED, pb.OrderState_FULFILLED:
 			return true
 		}
 	}
@@ -0,0 +1,67 @@
+package repo
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/kimitzu/kimitzu-go/pb"
+)
+
+// QuoteState describes how far a quote request has progressed
+type QuoteState string
+
+const (
+	// QuoteStateRequested - the vendor has not answered the request yet
+	QuoteStateRequested QuoteState = "REQUESTED"
+	// QuoteStateQuoted - the latest quote can be ordered
+	QuoteStateQuoted QuoteState = "QUOTED"
+	// QuoteStateExpired - the latest quote expired before it was ordered
+	QuoteStateExpired QuoteState = "EXPIRED"
+	// QuoteStateOrdered - the buyer placed an order against the latest quote
+	QuoteStateOrdered QuoteState = "ORDERED"
+)
+
+// QuoteRecord represents a one-to-one relationship with records in the
+// quotes table. The history holds the buyer's signed request followed by
+// every quote the vendor sent for it.
+type QuoteRecord struct {
+	RequestID string
+	History   *pb.QuoteHistory
+	IsSale    bool
+	PeerID    string
+	OrderID   string
+	Timestamp time.Time
+}
+
+// Request returns the buyer's quote request
+func (r *QuoteRecord) Request() *pb.QuoteRequest {
+	if r.History == nil || r.History.Request == nil {
+		return nil
+	}
+	return r.History.Request.Request
+}
+
+// LatestQuote returns the most recent revision of the quote or nil if the
+// vendor has not answered yet
+func (r *QuoteRecord) LatestQuote() *pb.Quote {
+	if r.History == nil || len(r.History.Quotes) == 0 {
+		return nil
+	}
+	return r.History.Quotes[len(r.History.Quotes)-1].Quote
+}
+
+// State returns the state of the quote at the given time
+func (r *QuoteRecord) State(t time.Time) QuoteState {
+	if r.OrderID != "" {
+		return QuoteStateOrdered
+	}
+	quote := r.LatestQuote()
+	if quote == nil {
+		return QuoteStateRequested
+	}
+	expiry, err := ptypes.Timestamp(quote.Expiry)
+	if err != nil || !t.Before(expiry) {
+		return QuoteStateExpired
+	}
+	return QuoteStateQuoted
+}
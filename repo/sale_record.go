@@ -54,7 +54,7 @@ func (r *SaleRecord) SupportsTimedEscrowRelease() bool {
 func (r *SaleRecord) IsDisputeable() bool {
 	if r.IsModeratedContract() {
 		switch r.OrderState {
-		case pb.OrderState_PARTIALLY_FULFILLED, pb.OrderState_FULFILLED:
+		case pb.OrderState_PARTIALLY_FULFILLED, pb.OrderState_FULFILLED, pb.OrderState_MILESTONE_FULFILLED:
 			return true
 		}
 	}
@@ -0,0 +1,51 @@
+package repo
+
+import (
+	"time"
+)
+
+// SearchDocumentType is the kind of object indexed by the search store
+type SearchDocumentType string
+
+const (
+	SearchDocumentTypeListing SearchDocumentType = "listing"
+	SearchDocumentTypePost    SearchDocumentType = "post"
+	SearchDocumentTypeChat    SearchDocumentType = "chat"
+)
+
+func (t SearchDocumentType) String() string { return string(t) }
+
+// SearchDocument represents a one-to-one relationship with records in the
+// search index. Listings and posts are keyed by their slug, chat messages by
+// their message ID.
+type SearchDocument struct {
+	Type                  SearchDocumentType
+	ID                    string
+	PeerID                string
+	Title                 string
+	Body                  string
+	Tags                  []string
+	Categories            []string
+	ServiceClassification string
+	Timestamp             time.Time
+}
+
+// SearchQuery describes a full text search. Documents must match every
+// given tag and category.
+type SearchQuery struct {
+	Query                 string
+	Types                 []SearchDocumentType
+	Tags                  []string
+	Categories            []string
+	ServiceClassification string
+	Offset                int
+	Limit                 int
+}
+
+// SearchResult is a document matching a search query. Results with a higher
+// rank are more relevant.
+type SearchResult struct {
+	SearchDocument
+	Rank    float64
+	Snippet string
+}
@@ -0,0 +1,34 @@
+package repo
+
+import (
+	"time"
+
+	"github.com/kimitzu/kimitzu-go/pb"
+)
+
+// SubscriptionRecord represents a one-to-one relationship with records
+// in the subscriptions table
+type SubscriptionRecord struct {
+	SubscriptionID string
+	Subscription   *pb.Subscription
+	Signature      []byte
+	IsSale         bool
+	PeerID         string
+	State          pb.Subscription_State
+	Period         uint32
+	NextRenewal    time.Time
+	RemindedPeriod uint32
+	LastOrderID    string
+	Timestamp      time.Time
+}
+
+// IsActive returns whether the subscription will generate further orders
+func (r *SubscriptionRecord) IsActive() bool {
+	return r.State == pb.Subscription_ACTIVE
+}
+
+// IsFinalPeriod returns whether the last generated order was the final period
+// of a subscription with a fixed number of periods
+func (r *SubscriptionRecord) IsFinalPeriod() bool {
+	return r.Subscription != nil && r.Subscription.Periods > 0 && r.Period >= r.Subscription.Periods
+}
@@ -21,9 +21,9 @@ const (
 	CreateTableTransactionMetadataSQL       = "create table txmetadata (txid text primary key not null, address text, memo text, orderID text, thumbnail text, canBumpFee integer);"
 	CreateTableInventorySQL                 = "create table inventory (invID text primary key not null, slug text, variantIndex integer, count integer);"
 	CreateIndexInventorySQL                 = "create index index_inventory on inventory (slug);"
-	CreateTablePurchasesSQL                 = "create table purchases (orderID text primary key not null, contract blob, state integer, read integer, timestamp integer, total integer, thumbnail text, vendorID text, vendorHandle text, title text, shippingName text, shippingAddress text, paymentAddr text, funded integer, transactions blob, lastDisputeTimeoutNotifiedAt integer not null default 0, lastDisputeExpiryNotifiedAt integer not null default 0, disputedAt integer not null default 0, coinType not null default '', paymentCoin not null default '');"
+	CreateTablePurchasesSQL                 = "create table purchases (orderID text primary key not null, contract blob, state integer, read integer, timestamp integer, total integer, thumbnail text, vendorID text, vendorHandle text, title text, shippingName text, shippingAddress text, paymentAddr text, funded integer, transactions blob, lastDisputeTimeoutNotifiedAt integer not null default 0, lastDisputeExpiryNotifiedAt integer not null default 0, disputedAt integer not null default 0, coinType not null default '', paymentCoin not null default '', milestones integer not null default 0, milestonesReleased integer not null default 0);"
 	CreateIndexPurchasesSQL                 = "create index index_purchases on purchases (paymentAddr, timestamp);"
-	CreateTableSalesSQL                     = "create table sales (orderID text primary key not null, contract blob, state integer, read integer, timestamp integer, total integer, thumbnail text, buyerID text, buyerHandle text, title text, shippingName text, shippingAddress text, paymentAddr text, funded integer, transactions blob, lastDisputeTimeoutNotifiedAt integer not null default 0, coinType not null default '', paymentCoin not null default '');"
+	CreateTableSalesSQL                     = "create table sales (orderID text primary key not null, contract blob, state integer, read integer, timestamp integer, total integer, thumbnail text, buyerID text, buyerHandle text, title text, shippingName text, shippingAddress text, paymentAddr text, funded integer, transactions blob, lastDisputeTimeoutNotifiedAt integer not null default 0, coinType not null default '', paymentCoin not null default '', milestones integer not null default 0, milestonesReleased integer not null default 0);"
 	CreateIndexSalesSQL                     = "create index index_sales on sales (paymentAddr, timestamp);"
 	CreatedTableWatchedScriptsSQL           = "create table watchedscripts (scriptPubKey text primary key not null, coin text);"
 	CreateIndexWatchedScriptsSQL            = "create index index_watchscripts on watchedscripts (coin);"
@@ -40,6 +40,27 @@ const (
 	CreateIndexMessagesSQLMessageID         = "create index index_messages_messageID on messages (messageID);"
 	CreateIndexMessagesSQLOrderIDMType      = "create index index_messages_orderIDmType on messages (orderID, message_type);"
 	CreateIndexMessagesSQLPeerIDMType       = "create index index_messages_peerIDmType on messages (peerID, message_type);"
+	CreateTableSubscriptionsSQL             = "create table subscriptions (subscriptionID text primary key not null, subscription blob, signature blob, isSale integer, peerID text, state integer, period integer, nextRenewal integer, remindedPeriod integer, lastOrderID text, timestamp integer);"
+	CreateIndexSubscriptionsSQL             = "create index index_subscriptions on subscriptions (state, nextRenewal);"
+	CreateTableLocationsSQL                 = "create table locations (peerID text not null, slug text not null, type text, hash text, title text, thumbnail text, latitude real, longitude real, plusCode text, timestamp integer, primary key (peerID, slug));"
+	CreateIndexLocationsSQL                 = "create index index_locations on locations (latitude, longitude);"
+	CreateTableSearchSQL                    = "create virtual table search using fts4(type, documentID, peerID, title, body, tags, categories, serviceClassification, timestamp, notindexed=type, notindexed=documentID, notindexed=peerID, notindexed=timestamp, tokenize=unicode61);"
+	CreateTableNotificationDeliveriesSQL    = "create table notificationdeliveries (deliveryID text primary key not null, channel text, notificationID text, type text, title text, body text, data blob, state text, attempts integer, lastError text, nextAttempt integer, created integer, updated integer);"
+	CreateIndexNotificationDeliveriesSQL    = "create index index_notificationdeliveries on notificationdeliveries (state, nextAttempt);"
+	CreateTableAPITokensSQL                 = "create table apitokens (tokenID text primary key not null, name text, hash text unique not null, scopes text, expires integer, created integer, lastUsed integer);"
+	CreateTableAuditLogSQL                  = "create table auditlog (auditID integer primary key autoincrement, timestamp integer, source text, actor text, remoteAddr text, action text, target text, paramsHash text, success integer, result text);"
+	CreateIndexAuditLogSQL                  = "create index index_auditlog on auditlog (timestamp);"
+	CreateTriggerAuditLogNoUpdateSQL        = "create trigger auditlog_no_update before update on auditlog begin select raise(abort, 'the audit log is append-only'); end;"
+	CreateTriggerAuditLogNoDeleteSQL        = "create trigger auditlog_no_delete before delete on auditlog begin select raise(abort, 'the audit log is append-only'); end;"
+	CreateTableExchangeRatesSQL             = "create table exchangerates (snapshotID integer primary key autoincrement, timestamp integer, coin text, reason text, orderID text, rates blob);"
+	CreateIndexExchangeRatesSQL             = "create index index_exchangerates on exchangerates (coin, timestamp);"
+	CreateTableInvoicesSQL                  = "create table invoices (orderID text primary key not null, invoiceNumber integer unique, revision integer, issued integer, updated integer, invoice blob, signature blob);"
+	CreateTableQuotesSQL                    = "create table quotes (requestID text primary key not null, history blob, isSale integer, peerID text, orderID text, timestamp integer);"
+	CreateTableAppointmentsSQL              = "create table appointments (orderID text primary key not null, slug text, title text, peerID text, isSale integer, startTime integer, endTime integer, timezone text);"
+	CreateIndexAppointmentsSQL              = "create index index_appointments on appointments (isSale, startTime);"
+	CreateTablePanelVotesSQL                = "create table panelvotes (caseID text not null, moderatorID text not null, vote blob, timestamp integer, primary key (caseID, moderatorID));"
+	CreateTableCaseMessagesSQL              = "create table casemessages (messageID text primary key not null, caseID text, channel integer, threadID text, senderID text, message blob, timestamp integer);"
+	CreateIndexCaseMessagesSQL              = "create index index_casemessages on casemessages (caseID, timestamp);"
 	// End SQL Statements
 
 	// Configuration defaults
@@ -313,6 +313,27 @@ func InitializeDatabaseSQL(encryptionPassword string) string {
 		CreateIndexMessagesSQLMessageID,
 		CreateIndexMessagesSQLOrderIDMType,
 		CreateIndexMessagesSQLPeerIDMType,
+		CreateTableSubscriptionsSQL,
+		CreateIndexSubscriptionsSQL,
+		CreateTableLocationsSQL,
+		CreateIndexLocationsSQL,
+		CreateTableSearchSQL,
+		CreateTableNotificationDeliveriesSQL,
+		CreateIndexNotificationDeliveriesSQL,
+		CreateTableAPITokensSQL,
+		CreateTableAuditLogSQL,
+		CreateIndexAuditLogSQL,
+		CreateTriggerAuditLogNoUpdateSQL,
+		CreateTriggerAuditLogNoDeleteSQL,
+		CreateTableExchangeRatesSQL,
+		CreateIndexExchangeRatesSQL,
+		CreateTableInvoicesSQL,
+		CreateTableQuotesSQL,
+		CreateTableAppointmentsSQL,
+		CreateIndexAppointmentsSQL,
+		CreateTablePanelVotesSQL,
+		CreateTableCaseMessagesSQL,
+		CreateIndexCaseMessagesSQL,
 	}
 	return strings.Join(initializeStatement, " ")
 }
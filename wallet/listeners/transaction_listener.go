@@ -54,6 +54,15 @@ func (l *TransactionListener) getOrderDetails(orderID string, address btc.Addres
 func (l *TransactionListener) OnTransactionReceived(cb wallet.TransactionCallback) {
 	l.Lock()
 	defer l.Unlock()
+
+	// Outputs paid back to an address this transaction also spends from are change from an
+	// escrow release (milestone orders) and must not be treated as new funding.
+	spentFrom := make(map[string]bool)
+	for _, input := range cb.Inputs {
+		if input.LinkedAddress != nil {
+			spentFrom[input.LinkedAddress.String()] = true
+		}
+	}
 	for _, output := range cb.Outputs {
 		if output.Address == nil {
 			continue
@@ -68,12 +77,12 @@ func (l *TransactionListener) OnTransactionReceived(cb wallet.TransactionCallbac
 
 		//contract, state, funded, records, err := l.db.Sales().GetByPaymentAddress(output.Address)
 		if err == nil && state != pb.OrderState_PROCESSING_ERROR {
-			l.processSalePayment(cb.Txid, output, contract, state, funded, records)
+			l.processSalePayment(cb.Txid, output, contract, state, funded, records, spentFrom[output.Address.String()])
 			continue
 		}
 		contract, state, funded, records, err = l.getOrderDetails(output.OrderID, output.Address, false)
 		if err == nil {
-			l.processPurchasePayment(cb.Txid, output, contract, state, funded, records)
+			l.processPurchasePayment(cb.Txid, output, contract, state, funded, records, spentFrom[output.Address.String()])
 			continue
 		}
 	}
@@ -178,7 +187,7 @@ func (l *TransactionListener) OnTransactionReceived(cb wallet.TransactionCallbac
 	}
 }
 
-func (l *TransactionListener) processSalePayment(txid string, output wallet.TransactionOutput, contract *pb.RicardianContract, state pb.OrderState, funded bool, records []*wallet.TransactionRecord) {
+func (l *TransactionListener) processSalePayment(txid string, output wallet.TransactionOutput, contract *pb.RicardianContract, state pb.OrderState, funded bool, records []*wallet.TransactionRecord, isChange bool) {
 	var funding = output.Value
 	for _, r := range records {
 		funding += r.Value
@@ -191,8 +200,11 @@ func (l *TransactionListener) processSalePayment(txid string, output wallet.Tran
 	if err != nil {
 		return
 	}
-	if !funded {
-		requestedAmount := int64(contract.BuyerOrder.Payment.Amount)
+	if !funded && !isChange {
+		requestedAmount := int64(pb.OrderPayment(contract).Amount)
+		if core.IsMilestoneOrder(contract) {
+			requestedAmount = milestoneRequestedAmount(contract)
+		}
 		if funding >= requestedAmount {
 			log.Debugf("Received payment for order %s", orderId)
 			funded = true
@@ -201,8 +213,13 @@ func (l *TransactionListener) processSalePayment(txid string, output wallet.Tran
 				l.db.Sales().Put(orderId, *contract, pb.OrderState_AWAITING_FULFILLMENT, false)
 			} else if state == pb.OrderState_AWAITING_PAYMENT && contract.VendorOrderConfirmation == nil { // Unconfirmed orders go into PENDING
 				l.db.Sales().Put(orderId, *contract, pb.OrderState_PENDING, false)
+			} else if state == pb.OrderState_MILESTONE_RELEASED { // The next milestone has been funded
+				l.db.Sales().Put(orderId, *contract, pb.OrderState_MILESTONE_FUNDED, false)
+			}
+			if state != pb.OrderState_MILESTONE_RELEASED {
+				l.adjustInventory(contract)
 			}
-			l.adjustInventory(contract)
+			core.RecordExchangeRates(l.db, l.multiwallet, contract.BuyerOrder.Payment.Coin, repo.ExchangeRateReasonFunded, orderId)
 
 			n := repo.OrderNotification{
 				BuyerHandle: contract.BuyerOrder.BuyerID.Handle,
@@ -211,7 +228,7 @@ func (l *TransactionListener) processSalePayment(txid string, output wallet.Tran
 				ListingType: contract.VendorListings[0].Metadata.ContractType.String(),
 				OrderId:     orderId,
 				Price: repo.ListingPrice{
-					Amount:           contract.BuyerOrder.Payment.Amount,
+					Amount:           pb.OrderPayment(contract).Amount,
 					CoinDivisibility: currencyDivisibilityFromContract(l.multiwallet, contract),
 					CurrencyCode:     contract.BuyerOrder.Payment.Coin,
 					PriceModifier:    contract.VendorListings[0].Metadata.PriceModifier,
@@ -251,6 +268,17 @@ func (l *TransactionListener) processSalePayment(txid string, output wallet.Tran
 	l.db.TxMetadata().Put(repo.Metadata{txid, "", title, orderId, thumbnail, bumpable})
 }
 
+// milestoneRequestedAmount returns the funding level the payment address must reach before the
+// current milestone is considered funded. Earlier milestones have already been paid out of escrow
+// so the remaining balance only needs to cover the current one.
+func milestoneRequestedAmount(contract *pb.RicardianContract) int64 {
+	milestone, err := core.CurrentMilestone(contract)
+	if err != nil {
+		return int64(pb.OrderPayment(contract).Amount)
+	}
+	return int64(milestone.Amount)
+}
+
 func currencyDivisibilityFromContract(mw multiwallet.MultiWallet, contract *pb.RicardianContract) uint32 {
 	var currencyDivisibility = contract.VendorListings[0].Metadata.CoinDivisibility
 	if currencyDivisibility != 0 {
@@ -263,7 +291,7 @@ func currencyDivisibilityFromContract(mw multiwallet.MultiWallet, contract *pb.R
 	return core.DefaultCurrencyDivisibility
 }
 
-func (l *TransactionListener) processPurchasePayment(txid string, output wallet.TransactionOutput, contract *pb.RicardianContract, state pb.OrderState, funded bool, records []*wallet.TransactionRecord) {
+func (l *TransactionListener) processPurchasePayment(txid string, output wallet.TransactionOutput, contract *pb.RicardianContract, state pb.OrderState, funded bool, records []*wallet.TransactionRecord, isChange bool) {
 	funding := output.Value
 	for _, r := range records {
 		funding += r.Value
@@ -276,8 +304,11 @@ func (l *TransactionListener) processPurchasePayment(txid string, output wallet.
 	if err != nil {
 		return
 	}
-	if !funded {
-		requestedAmount := int64(contract.BuyerOrder.Payment.Amount)
+	if !funded && !isChange {
+		requestedAmount := int64(pb.OrderPayment(contract).Amount)
+		if core.IsMilestoneOrder(contract) {
+			requestedAmount = milestoneRequestedAmount(contract)
+		}
 		if funding >= requestedAmount {
 			log.Debugf("Payment for purchase %s detected", orderId)
 			funded = true
@@ -285,7 +316,10 @@ func (l *TransactionListener) processPurchasePayment(txid string, output wallet.
 				l.db.Purchases().Put(orderId, *contract, pb.OrderState_AWAITING_FULFILLMENT, false)
 			} else if state == pb.OrderState_AWAITING_PAYMENT && contract.VendorOrderConfirmation == nil { // Unconfirmed go into PENDING
 				l.db.Purchases().Put(orderId, *contract, pb.OrderState_PENDING, false)
+			} else if state == pb.OrderState_MILESTONE_RELEASED { // The next milestone has been funded
+				l.db.Purchases().Put(orderId, *contract, pb.OrderState_MILESTONE_FUNDED, false)
 			}
+			core.RecordExchangeRates(l.db, l.multiwallet, contract.BuyerOrder.Payment.Coin, repo.ExchangeRateReasonFunded, orderId)
 		}
 		n := repo.PaymentNotification{
 			repo.NewNotificationID(),
@@ -324,7 +358,7 @@ func (l *TransactionListener) adjustInventory(contract *pb.RicardianContract) {
 		if err != nil {
 			continue
 		}
-		q := int64(core.GetOrderQuantity(listing, item))
+		q := int64(core.OrderItemQuantity(contract, listing, item))
 		newCount := c - q
 		if c < 0 {
 			newCount = -1